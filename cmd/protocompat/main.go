@@ -0,0 +1,87 @@
+// Command protocompat reports the breaking changes between two revisions of
+// the proto contracts, each compiled to a FileDescriptorSet:
+//
+//	protoc --proto_path=old/proto --include_imports --descriptor_set_out=old.pb old/proto/*.proto
+//	protoc --proto_path=proto --include_imports --descriptor_set_out=new.pb proto/*.proto
+//	go run ./cmd/protocompat -old old.pb -new new.pb
+//
+// It exits with status 1 when a finding is at least as severe as -fail-on,
+// and 2 when the sets cannot be compared.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/FACorreiaa/loci-proto/compat"
+)
+
+func main() {
+	var (
+		oldPath  = flag.String("old", "", "FileDescriptorSet of the older revision")
+		newPath  = flag.String("new", "", "FileDescriptorSet of the newer revision")
+		minLevel = flag.String("min-severity", "info", "lowest severity printed: info, warning or error")
+		failOn   = flag.String("fail-on", "error", "lowest severity that fails the check: info, warning or error")
+		asJSON   = flag.Bool("json", false, "print the findings as JSON")
+	)
+	flag.Parse()
+
+	code, err := run(*oldPath, *newPath, *minLevel, *failOn, *asJSON)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "protocompat:", err)
+		os.Exit(2)
+	}
+	os.Exit(code)
+}
+
+func run(oldPath, newPath, minLevel, failOn string, asJSON bool) (int, error) {
+	if oldPath == "" || newPath == "" {
+		return 0, fmt.Errorf("both -old and -new are required")
+	}
+	lowest, err := compat.ParseSeverity(minLevel)
+	if err != nil {
+		return 0, err
+	}
+	fail, err := compat.ParseSeverity(failOn)
+	if err != nil {
+		return 0, err
+	}
+
+	older, err := compat.Load(oldPath)
+	if err != nil {
+		return 0, err
+	}
+	newer, err := compat.Load(newPath)
+	if err != nil {
+		return 0, err
+	}
+
+	report, err := compat.Check(older, newer)
+	if err != nil {
+		return 0, err
+	}
+
+	shown := report.AtLeast(lowest)
+	if asJSON {
+		if shown == nil {
+			shown = compat.Report{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(shown); err != nil {
+			return 0, err
+		}
+	} else {
+		for _, f := range shown {
+			fmt.Println(f)
+		}
+	}
+
+	if len(report.AtLeast(fail)) > 0 {
+		return 1, nil
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,261 @@
+// Package compat compares two revisions of the proto contracts, each given
+// as a FileDescriptorSet, and reports the changes that break clients built
+// against the older one.
+//
+// Nothing but field numbers keeps the generated messages compatible across
+// services, so the checks are about the wire: a renumbered field, a type
+// that no longer decodes, a field moved in or out of a oneof, a removed
+// enum value or a changed RPC signature are errors. Changes that keep the
+// wire format but break JSON or generated code, such as renames, are
+// warnings, and additions are reported for information.
+package compat
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Severity is how badly a change affects existing clients.
+type Severity int
+
+const (
+	// Info is a compatible change, such as an added field.
+	Info Severity = iota
+	// Warning is a change that keeps the wire format but breaks JSON, the
+	// generated code or future evolution, such as a renamed field.
+	Warning
+	// Error is a change that breaks the wire format.
+	Error
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Info:
+		return "info"
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// ParseSeverity reads the name of a Severity.
+func ParseSeverity(s string) (Severity, error) {
+	for _, sev := range []Severity{Info, Warning, Error} {
+		if strings.EqualFold(s, sev.String()) {
+			return sev, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown severity %q", s)
+}
+
+// MarshalText encodes the severity as its name.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Rule names a kind of change.
+type Rule string
+
+// The rules findings are reported under.
+const (
+	FileRemoved           Rule = "FILE_REMOVED"
+	PackageChanged        Rule = "PACKAGE_CHANGED"
+	MessageRemoved        Rule = "MESSAGE_REMOVED"
+	MessageAdded          Rule = "MESSAGE_ADDED"
+	FieldRemoved          Rule = "FIELD_REMOVED"
+	FieldAdded            Rule = "FIELD_ADDED"
+	FieldRenumbered       Rule = "FIELD_RENUMBERED"
+	FieldRenamed          Rule = "FIELD_RENAMED"
+	FieldTypeChanged      Rule = "FIELD_TYPE_CHANGED"
+	FieldCardinality      Rule = "FIELD_CARDINALITY_CHANGED"
+	FieldPresenceChanged  Rule = "FIELD_PRESENCE_CHANGED"
+	FieldOneofChanged     Rule = "FIELD_ONEOF_CHANGED"
+	FieldNumberReused     Rule = "FIELD_NUMBER_RESERVED_REUSED"
+	EnumRemoved           Rule = "ENUM_REMOVED"
+	EnumAdded             Rule = "ENUM_ADDED"
+	EnumValueRemoved      Rule = "ENUM_VALUE_REMOVED"
+	EnumValueAdded        Rule = "ENUM_VALUE_ADDED"
+	EnumValueRenumbered   Rule = "ENUM_VALUE_RENUMBERED"
+	EnumValueRenamed      Rule = "ENUM_VALUE_RENAMED"
+	ServiceRemoved        Rule = "SERVICE_REMOVED"
+	ServiceAdded          Rule = "SERVICE_ADDED"
+	MethodRemoved         Rule = "METHOD_REMOVED"
+	MethodAdded           Rule = "METHOD_ADDED"
+	MethodRequestChanged  Rule = "METHOD_REQUEST_CHANGED"
+	MethodResponseChanged Rule = "METHOD_RESPONSE_CHANGED"
+	MethodStreamChanged   Rule = "METHOD_STREAMING_CHANGED"
+)
+
+// Finding is one change between the two revisions.
+type Finding struct {
+	Severity Severity `json:"severity"`
+	Rule     Rule     `json:"rule"`
+	// Element is the full name of what changed, e.g.
+	// "ai_poi.chat.v1.ChatEvent.message", or the path of a removed file.
+	Element string `json:"element"`
+	Message string `json:"message"`
+	// File is the file of the newer revision the element is declared in,
+	// or of the older one when it was removed.
+	File string `json:"file"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s [%s] %s: %s", f.File, f.Severity, f.Rule, f.Element, f.Message)
+}
+
+// Report is the list of findings of a comparison, ordered by file and
+// element.
+type Report []Finding
+
+// Max returns the highest severity among the findings, and false when
+// there are none.
+func (r Report) Max() (Severity, bool) {
+	if len(r) == 0 {
+		return 0, false
+	}
+
+	return slices.MaxFunc(r, func(a, b Finding) int { return cmp.Compare(a.Severity, b.Severity) }).Severity, true
+}
+
+// AtLeast returns the findings of severity lowest or higher.
+func (r Report) AtLeast(lowest Severity) Report {
+	var out Report
+	for _, f := range r {
+		if f.Severity >= lowest {
+			out = append(out, f)
+		}
+	}
+
+	return out
+}
+
+// Load reads a binary FileDescriptorSet, as written by
+// `protoc --include_imports --descriptor_set_out` or `buf build`.
+func Load(path string) (*descriptorpb.FileDescriptorSet, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	set := new(descriptorpb.FileDescriptorSet)
+	if err := proto.Unmarshal(b, set); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return set, nil
+}
+
+// Check compares the files of the newer set with those of the older one.
+// Files only present in the newer set are new, and not reported. Imports
+// missing from a set are tolerated, and only compared by name.
+func Check(older, newer *descriptorpb.FileDescriptorSet) (Report, error) {
+	oldFiles, err := files(older)
+	if err != nil {
+		return nil, fmt.Errorf("older set: %w", err)
+	}
+	newFiles, err := files(newer)
+	if err != nil {
+		return nil, fmt.Errorf("newer set: %w", err)
+	}
+
+	c := &checker{newer: newFiles}
+	oldFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		nfd, err := newFiles.FindFileByPath(fd.Path())
+		if err != nil {
+			c.fileRemoved(fd)
+			return true
+		}
+		c.file(fd, nfd)
+		return true
+	})
+
+	slices.SortStableFunc(c.report, func(a, b Finding) int {
+		return cmp.Or(strings.Compare(a.File, b.File), strings.Compare(a.Element, b.Element))
+	})
+
+	return c.report, nil
+}
+
+func files(set *descriptorpb.FileDescriptorSet) (*protoregistry.Files, error) {
+	return protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(set)
+}
+
+// checker accumulates the findings of a comparison.
+type checker struct {
+	newer  *protoregistry.Files
+	report Report
+
+	// comparing holds the pairs of messages whose compatibility is being
+	// worked out, so that recursive messages end.
+	comparing map[[2]protoreflect.FullName]bool
+}
+
+func (c *checker) add(sev Severity, rule Rule, d protoreflect.Descriptor, format string, args ...any) {
+	c.report = append(c.report, Finding{
+		Severity: sev,
+		Rule:     rule,
+		Element:  string(d.FullName()),
+		Message:  fmt.Sprintf(format, args...),
+		File:     d.ParentFile().Path(),
+	})
+}
+
+// fileRemoved reports a file that is gone from the newer set, unless its
+// declarations moved to other files.
+func (c *checker) fileRemoved(fd protoreflect.FileDescriptor) {
+	moved := true
+	c.declarations(fd, func(d protoreflect.Descriptor) {
+		if _, err := c.newer.FindDescriptorByName(d.FullName()); err != nil {
+			moved = false
+		}
+	})
+	if moved {
+		c.report = append(c.report, Finding{Severity: Info, Rule: FileRemoved, Element: fd.Path(), Message: "file removed, its declarations moved to other files", File: fd.Path()})
+		return
+	}
+
+	c.report = append(c.report, Finding{Severity: Error, Rule: FileRemoved, Element: fd.Path(), Message: "file removed", File: fd.Path()})
+}
+
+// declarations calls f with the top-level declarations of fd.
+func (c *checker) declarations(fd protoreflect.FileDescriptor, f func(protoreflect.Descriptor)) {
+	for i := range fd.Messages().Len() {
+		f(fd.Messages().Get(i))
+	}
+	for i := range fd.Enums().Len() {
+		f(fd.Enums().Get(i))
+	}
+	for i := range fd.Services().Len() {
+		f(fd.Services().Get(i))
+	}
+}
+
+func (c *checker) file(older, newer protoreflect.FileDescriptor) {
+	if older.Package() != newer.Package() {
+		c.report = append(c.report, Finding{
+			Severity: Error,
+			Rule:     PackageChanged,
+			Element:  newer.Path(),
+			Message:  fmt.Sprintf("package changed from %s to %s, renaming every message and service", older.Package(), newer.Package()),
+			File:     newer.Path(),
+		})
+		return
+	}
+
+	c.messages(older.Messages(), newer.Messages())
+	c.enums(older.Enums(), newer.Enums())
+	c.services(older.Services(), newer.Services())
+}
@@ -0,0 +1,353 @@
+package compat
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func field(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.Enum(),
+		JsonName: proto.String(name),
+	}
+}
+
+func messageField(name string, number int32, typeName string) *descriptorpb.FieldDescriptorProto {
+	fd := field(name, number, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE)
+	fd.TypeName = proto.String(".test.v1." + typeName)
+
+	return fd
+}
+
+const (
+	typeString = descriptorpb.FieldDescriptorProto_TYPE_STRING
+	typeBytes  = descriptorpb.FieldDescriptorProto_TYPE_BYTES
+	typeInt32  = descriptorpb.FieldDescriptorProto_TYPE_INT32
+	typeInt64  = descriptorpb.FieldDescriptorProto_TYPE_INT64
+)
+
+// testFile returns, as test.proto:
+//
+//	package test.v1;
+//
+//	message Event {
+//	  string id = 1;
+//	  string data = 2;
+//	  oneof payload {
+//	    Message message = 3;
+//	    Failure failure = 4;
+//	  }
+//	  reserved 9;
+//	}
+//	message Message { string text = 1; int32 count = 2; }
+//	message Failure { string code = 1; }
+//	message Text { string text = 1; int64 count = 2; }
+//	enum Status { STATUS_UNSPECIFIED = 0; STATUS_OK = 1; STATUS_FAILED = 2; }
+//	service Events {
+//	  rpc Get(Message) returns (Event);
+//	  rpc Watch(Message) returns (stream Event);
+//	}
+func testFile() *descriptorpb.FileDescriptorProto {
+	inPayload := func(fd *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+		fd.OneofIndex = proto.Int32(0)
+		return fd
+	}
+	value := func(name string, number int32) *descriptorpb.EnumValueDescriptorProto {
+		return &descriptorpb.EnumValueDescriptorProto{Name: proto.String(name), Number: proto.Int32(number)}
+	}
+
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test.proto"),
+		Package: proto.String("test.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Event"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("id", 1, typeString),
+					field("data", 2, typeString),
+					inPayload(messageField("message", 3, "Message")),
+					inPayload(messageField("failure", 4, "Failure")),
+				},
+				OneofDecl:     []*descriptorpb.OneofDescriptorProto{{Name: proto.String("payload")}},
+				ReservedRange: []*descriptorpb.DescriptorProto_ReservedRange{{Start: proto.Int32(9), End: proto.Int32(10)}},
+			},
+			{
+				Name:  proto.String("Message"),
+				Field: []*descriptorpb.FieldDescriptorProto{field("text", 1, typeString), field("count", 2, typeInt32)},
+			},
+			{
+				Name:  proto.String("Failure"),
+				Field: []*descriptorpb.FieldDescriptorProto{field("code", 1, typeString)},
+			},
+			{
+				Name:  proto.String("Text"),
+				Field: []*descriptorpb.FieldDescriptorProto{field("text", 1, typeString), field("count", 2, typeInt64)},
+			},
+		},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name:  proto.String("Status"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{value("STATUS_UNSPECIFIED", 0), value("STATUS_OK", 1), value("STATUS_FAILED", 2)},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Events"),
+			Method: []*descriptorpb.MethodDescriptorProto{
+				{Name: proto.String("Get"), InputType: proto.String(".test.v1.Message"), OutputType: proto.String(".test.v1.Event")},
+				{Name: proto.String("Watch"), InputType: proto.String(".test.v1.Message"), OutputType: proto.String(".test.v1.Event"), ServerStreaming: proto.Bool(true)},
+			},
+		}},
+	}
+}
+
+// finding is the part of a Finding the tests compare.
+type finding struct {
+	Severity Severity
+	Rule     Rule
+	Element  string
+}
+
+func TestCheck(t *testing.T) {
+	event := func(f *descriptorpb.FileDescriptorProto) *descriptorpb.DescriptorProto { return f.MessageType[0] }
+
+	tests := []struct {
+		name   string
+		change func(f *descriptorpb.FileDescriptorProto)
+		want   []finding
+	}{
+		{
+			name:   "unchanged",
+			change: func(f *descriptorpb.FileDescriptorProto) {},
+		},
+		{
+			name: "field added",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field = append(event(f).Field, field("extra", 5, typeString))
+			},
+			want: []finding{{Info, FieldAdded, "test.v1.Event.extra"}},
+		},
+		{
+			name: "field renamed",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field[0] = field("ident", 1, typeString)
+			},
+			want: []finding{{Warning, FieldRenamed, "test.v1.Event.ident"}},
+		},
+		{
+			name: "fields swapped",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field[0].Number, event(f).Field[1].Number = proto.Int32(2), proto.Int32(1)
+			},
+			want: []finding{
+				{Error, FieldRenumbered, "test.v1.Event.data"},
+				{Error, FieldRenumbered, "test.v1.Event.id"},
+			},
+		},
+		{
+			name: "field renumbered and its number reused",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field[0].Number = proto.Int32(5)
+				event(f).Field = append(event(f).Field, field("name", 1, typeString))
+			},
+			want: []finding{{Error, FieldRenumbered, "test.v1.Event.id"}},
+		},
+		{
+			name: "field removed",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field = slices.Delete(event(f).Field, 1, 2)
+			},
+			want: []finding{{Error, FieldRemoved, "test.v1.Event.data"}},
+		},
+		{
+			name: "field removed and reserved",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field = slices.Delete(event(f).Field, 1, 2)
+				event(f).ReservedRange = append(event(f).ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{Start: proto.Int32(2), End: proto.Int32(3)})
+			},
+			want: []finding{{Warning, FieldRemoved, "test.v1.Event.data"}},
+		},
+		{
+			name: "reserved number reused",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).ReservedRange = nil
+				event(f).Field = append(event(f).Field, field("extra", 9, typeString))
+			},
+			want: []finding{{Error, FieldNumberReused, "test.v1.Event.extra"}},
+		},
+		{
+			name: "string to bytes",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field[1].Type = typeBytes.Enum()
+			},
+			want: []finding{{Warning, FieldTypeChanged, "test.v1.Event.data"}},
+		},
+		{
+			name: "string to int32",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field[1].Type = typeInt32.Enum()
+			},
+			want: []finding{{Error, FieldTypeChanged, "test.v1.Event.data"}},
+		},
+		{
+			name: "string to message",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field[1] = messageField("data", 2, "Message")
+			},
+			want: []finding{
+				{Error, FieldTypeChanged, "test.v1.Event.data"},
+				{Warning, FieldPresenceChanged, "test.v1.Event.data"},
+			},
+		},
+		{
+			name: "message to bytes",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				fd := field("message", 3, typeBytes)
+				fd.OneofIndex = proto.Int32(0)
+				event(f).Field[2] = fd
+			},
+			want: []finding{{Error, FieldTypeChanged, "test.v1.Event.message"}},
+		},
+		{
+			name: "int32 to int64",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				f.MessageType[1].Field[1].Type = typeInt64.Enum()
+			},
+			want: []finding{{Warning, FieldTypeChanged, "test.v1.Message.count"}},
+		},
+		{
+			name: "message to wire-compatible message",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field[2].TypeName = proto.String(".test.v1.Text")
+			},
+			want: []finding{{Warning, FieldTypeChanged, "test.v1.Event.message"}},
+		},
+		{
+			name: "message to incompatible message",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field[2].TypeName = proto.String(".test.v1.Failure")
+			},
+			want: []finding{{Error, FieldTypeChanged, "test.v1.Event.message"}},
+		},
+		{
+			name: "field made repeated",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field[1].Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			},
+			want: []finding{{Error, FieldCardinality, "test.v1.Event.data"}},
+		},
+		{
+			name: "field moved out of oneof",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				event(f).Field[3].OneofIndex = nil
+			},
+			want: []finding{{Error, FieldOneofChanged, "test.v1.Event.failure"}},
+		},
+		{
+			name: "message removed",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				f.MessageType = slices.Delete(f.MessageType, 3, 4)
+			},
+			want: []finding{{Error, MessageRemoved, "test.v1.Text"}},
+		},
+		{
+			name: "enum value removed",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				f.EnumType[0].Value = f.EnumType[0].Value[:2]
+			},
+			want: []finding{{Error, EnumValueRemoved, "test.v1.STATUS_FAILED"}},
+		},
+		{
+			name: "enum value renumbered",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				f.EnumType[0].Value[2].Number = proto.Int32(3)
+			},
+			want: []finding{{Error, EnumValueRenumbered, "test.v1.STATUS_FAILED"}},
+		},
+		{
+			name: "method removed",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				f.Service[0].Method = f.Service[0].Method[:1]
+			},
+			want: []finding{{Error, MethodRemoved, "test.v1.Events.Watch"}},
+		},
+		{
+			name: "method streaming changed",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				f.Service[0].Method[1].ClientStreaming = proto.Bool(true)
+			},
+			want: []finding{{Error, MethodStreamChanged, "test.v1.Events.Watch"}},
+		},
+		{
+			name: "method request changed",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				f.Service[0].Method[0].InputType = proto.String(".test.v1.Failure")
+			},
+			want: []finding{{Error, MethodRequestChanged, "test.v1.Events.Get"}},
+		},
+		{
+			name: "package changed",
+			change: func(f *descriptorpb.FileDescriptorProto) {
+				f.Package = proto.String("test.v2")
+				for _, m := range f.MessageType {
+					for _, fd := range m.Field {
+						if fd.TypeName != nil {
+							fd.TypeName = proto.String(".test.v2" + (*fd.TypeName)[len(".test.v1"):])
+						}
+					}
+				}
+				for _, m := range f.Service[0].Method {
+					m.InputType, m.OutputType = proto.String(".test.v2.Message"), proto.String(".test.v2.Event")
+				}
+			},
+			want: []finding{{Error, PackageChanged, "test.proto"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newer := testFile()
+			tt.change(newer)
+
+			report, err := Check(
+				&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{testFile()}},
+				&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{newer}},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []finding
+			for _, f := range report {
+				got = append(got, finding{f.Severity, f.Rule, f.Element})
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckFileRemoved(t *testing.T) {
+	other := &descriptorpb.FileDescriptorProto{Name: proto.String("other.proto"), Package: proto.String("test.v1"), Syntax: proto.String("proto3")}
+	older := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{testFile(), other}}
+
+	report, err := Check(older, &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{testFile()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Report{{Severity: Info, Rule: FileRemoved, Element: "other.proto", Message: "file removed, its declarations moved to other files", File: "other.proto"}}); !slices.Equal(report, want) {
+		t.Errorf("removing an empty file: Check() = %v, want %v", report, want)
+	}
+
+	report, err = Check(older, &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{other}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sev, _ := report.Max(); len(report) != 1 || report[0].Rule != FileRemoved || sev != Error {
+		t.Errorf("removing a file with declarations: Check() = %v, want a FILE_REMOVED error", report)
+	}
+}
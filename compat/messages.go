@@ -0,0 +1,268 @@
+package compat
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// wireTypes groups the kinds that share an encoding, so that a field can
+// change between them and still decode.
+var wireTypes = map[protoreflect.Kind]string{
+	protoreflect.BoolKind:     "varint",
+	protoreflect.EnumKind:     "varint",
+	protoreflect.Int32Kind:    "varint",
+	protoreflect.Int64Kind:    "varint",
+	protoreflect.Uint32Kind:   "varint",
+	protoreflect.Uint64Kind:   "varint",
+	protoreflect.Sint32Kind:   "zigzag",
+	protoreflect.Sint64Kind:   "zigzag",
+	protoreflect.Fixed32Kind:  "fixed32",
+	protoreflect.Sfixed32Kind: "fixed32",
+	protoreflect.Fixed64Kind:  "fixed64",
+	protoreflect.Sfixed64Kind: "fixed64",
+	protoreflect.StringKind:   "bytes",
+	protoreflect.BytesKind:    "bytes",
+	// Messages share the length-delimited encoding of strings and bytes,
+	// but old payloads of either do not decode as one.
+	protoreflect.MessageKind: "message",
+}
+
+func (c *checker) messages(older, newer protoreflect.MessageDescriptors) {
+	for i := range older.Len() {
+		md := older.Get(i)
+		if md.IsMapEntry() {
+			// Compared through the type of their map field.
+			continue
+		}
+		if nmd := newer.ByName(md.Name()); nmd != nil {
+			c.message(md, nmd)
+			continue
+		}
+
+		// Moved to another file of the same package.
+		if d, err := c.newer.FindDescriptorByName(md.FullName()); err == nil {
+			if nmd, ok := d.(protoreflect.MessageDescriptor); ok {
+				c.message(md, nmd)
+				continue
+			}
+		}
+		c.add(Error, MessageRemoved, md, "message removed")
+	}
+
+	for i := range newer.Len() {
+		if nmd := newer.Get(i); !nmd.IsMapEntry() && older.ByName(nmd.Name()) == nil {
+			c.add(Info, MessageAdded, nmd, "message added")
+		}
+	}
+}
+
+func (c *checker) message(older, newer protoreflect.MessageDescriptor) {
+	fields := older.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+
+		// A field keeps its name when renumbered, whether or not its old
+		// number went to another field.
+		if nfd := newer.Fields().ByName(fd.Name()); nfd != nil && nfd.Number() != fd.Number() {
+			c.add(Error, FieldRenumbered, nfd, "field renumbered from %d to %d", fd.Number(), nfd.Number())
+		}
+
+		switch nfd := newer.Fields().ByNumber(fd.Number()); {
+		case nfd == nil:
+			c.fieldRemoved(fd, newer)
+		case nfd.Name() != fd.Name() && (newer.Fields().ByName(fd.Name()) != nil || fields.ByName(nfd.Name()) != nil):
+			// The number went to another field, which is reported as
+			// renumbered rather than as a rename.
+		default:
+			c.field(fd, nfd)
+		}
+	}
+
+	for i := range newer.Fields().Len() {
+		nfd := newer.Fields().Get(i)
+		if fields.ByNumber(nfd.Number()) != nil || fields.ByName(nfd.Name()) != nil {
+			// Compared above, or reported as renumbered.
+			continue
+		}
+
+		switch {
+		case older.ReservedRanges().Has(nfd.Number()):
+			c.add(Error, FieldNumberReused, nfd, "field reuses reserved number %d", nfd.Number())
+		case older.ReservedNames().Has(nfd.Name()):
+			c.add(Warning, FieldNumberReused, nfd, "field reuses reserved name %s", nfd.Name())
+		default:
+			c.add(Info, FieldAdded, nfd, "field %d added", nfd.Number())
+		}
+	}
+
+	c.messages(older.Messages(), newer.Messages())
+	c.enums(older.Enums(), newer.Enums())
+}
+
+// fieldRemoved reports a field whose number is gone from the newer message,
+// unless it was renumbered, which message reports.
+func (c *checker) fieldRemoved(fd protoreflect.FieldDescriptor, newer protoreflect.MessageDescriptor) {
+	switch {
+	case newer.Fields().ByName(fd.Name()) != nil:
+	case newer.ReservedRanges().Has(fd.Number()):
+		c.add(Warning, FieldRemoved, fd, "field %d removed, its number is reserved", fd.Number())
+	default:
+		c.add(Error, FieldRemoved, fd, "field %d removed without reserving its number", fd.Number())
+	}
+}
+
+func (c *checker) field(older, newer protoreflect.FieldDescriptor) {
+	if older.Name() != newer.Name() {
+		c.add(Warning, FieldRenamed, newer, "field %d renamed from %s, changing its JSON name", newer.Number(), older.Name())
+	}
+
+	if older.Cardinality() == protoreflect.Repeated != (newer.Cardinality() == protoreflect.Repeated) || older.IsMap() != newer.IsMap() {
+		c.add(Error, FieldCardinality, newer, "field changed from %s to %s", cardinality(older), cardinality(newer))
+	} else {
+		c.fieldType(older, newer)
+	}
+
+	if older.Cardinality() != protoreflect.Repeated && older.HasPresence() != newer.HasPresence() && older.Message() == nil {
+		c.add(Warning, FieldPresenceChanged, newer, "field presence changed from %t to %t", older.HasPresence(), newer.HasPresence())
+	}
+
+	if from, to := oneof(older), oneof(newer); from != to {
+		switch {
+		case from == "":
+			c.add(Error, FieldOneofChanged, newer, "field moved into oneof %s", to)
+		case to == "":
+			c.add(Error, FieldOneofChanged, newer, "field moved out of oneof %s", from)
+		default:
+			c.add(Error, FieldOneofChanged, newer, "field moved from oneof %s to %s", from, to)
+		}
+	}
+}
+
+// fieldType reports a change of the type of a field. Types that share an
+// encoding still decode, possibly truncated, and messages of another name
+// are compared field by field.
+func (c *checker) fieldType(older, newer protoreflect.FieldDescriptor) {
+	from, to := typeName(older), typeName(newer)
+	if from == to {
+		return
+	}
+
+	switch {
+	case older.Message() != nil && newer.Message() != nil:
+		if c.compatible(older.Message(), newer.Message()) {
+			c.add(Warning, FieldTypeChanged, newer, "field type changed from %s to %s, which is wire-compatible", from, to)
+			return
+		}
+	case older.Kind() == newer.Kind() && older.Kind() == protoreflect.EnumKind:
+		c.add(Warning, FieldTypeChanged, newer, "field type changed from %s to %s, which decodes but changes the value names", from, to)
+		return
+	case wireTypes[older.Kind()] != "" && wireTypes[older.Kind()] == wireTypes[newer.Kind()]:
+		c.add(Warning, FieldTypeChanged, newer, "field type changed from %s to %s, which shares its encoding", from, to)
+		return
+	}
+
+	c.add(Error, FieldTypeChanged, newer, "field type changed from %s to %s", from, to)
+}
+
+// compatible reports whether messages of type older decode as newer
+// without errors. Messages being compared further up are assumed to be.
+func (c *checker) compatible(older, newer protoreflect.MessageDescriptor) bool {
+	pair := [2]protoreflect.FullName{older.FullName(), newer.FullName()}
+	if c.comparing[pair] {
+		return true
+	}
+
+	sub := &checker{newer: c.newer, comparing: map[[2]protoreflect.FullName]bool{pair: true}}
+	for k := range c.comparing {
+		sub.comparing[k] = true
+	}
+	sub.message(older, newer)
+
+	worst, ok := sub.report.Max()
+
+	return !ok || worst < Error
+}
+
+func (c *checker) enums(older, newer protoreflect.EnumDescriptors) {
+	for i := range older.Len() {
+		ed := older.Get(i)
+		if ned := newer.ByName(ed.Name()); ned != nil {
+			c.enum(ed, ned)
+			continue
+		}
+
+		if d, err := c.newer.FindDescriptorByName(ed.FullName()); err == nil {
+			if ned, ok := d.(protoreflect.EnumDescriptor); ok {
+				c.enum(ed, ned)
+				continue
+			}
+		}
+		c.add(Error, EnumRemoved, ed, "enum removed")
+	}
+
+	for i := range newer.Len() {
+		if ned := newer.Get(i); older.ByName(ned.Name()) == nil {
+			c.add(Info, EnumAdded, ned, "enum added")
+		}
+	}
+}
+
+func (c *checker) enum(older, newer protoreflect.EnumDescriptor) {
+	values := older.Values()
+	for i := range values.Len() {
+		v := values.Get(i)
+		nv := newer.Values().ByNumber(v.Number())
+
+		switch {
+		case nv == nil && newer.Values().ByName(v.Name()) != nil:
+			c.add(Error, EnumValueRenumbered, v, "enum value renumbered from %d to %d", v.Number(), newer.Values().ByName(v.Name()).Number())
+		case nv == nil && newer.ReservedRanges().Has(v.Number()):
+			c.add(Warning, EnumValueRemoved, v, "enum value %d removed, its number is reserved", v.Number())
+		case nv == nil:
+			c.add(Error, EnumValueRemoved, v, "enum value %d removed without reserving its number", v.Number())
+		case nv.Name() != v.Name():
+			c.add(Warning, EnumValueRenamed, nv, "enum value %d renamed from %s, changing its JSON name", v.Number(), v.Name())
+		}
+	}
+
+	for i := range newer.Values().Len() {
+		if nv := newer.Values().Get(i); values.ByNumber(nv.Number()) == nil && values.ByName(nv.Name()) == nil {
+			c.add(Info, EnumValueAdded, nv, "enum value %d added", nv.Number())
+		}
+	}
+}
+
+// typeName names the type of a field, e.g. "string" or
+// "ai_poi.chat.v1.ChatMessage".
+func typeName(fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.IsMap():
+		return "map<" + typeName(fd.MapKey()) + ", " + typeName(fd.MapValue()) + ">"
+	case fd.Message() != nil:
+		return string(fd.Message().FullName())
+	case fd.Enum() != nil:
+		return string(fd.Enum().FullName())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+func cardinality(fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.IsMap():
+		return "map"
+	case fd.Cardinality() == protoreflect.Repeated:
+		return "repeated"
+	default:
+		return "singular"
+	}
+}
+
+// oneof returns the name of the oneof holding fd, leaving out the
+// synthetic oneofs of proto3 optional fields.
+func oneof(fd protoreflect.FieldDescriptor) protoreflect.Name {
+	if o := fd.ContainingOneof(); o != nil && !o.IsSynthetic() {
+		return o.Name()
+	}
+
+	return ""
+}
@@ -0,0 +1,84 @@
+package compat
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func (c *checker) services(older, newer protoreflect.ServiceDescriptors) {
+	for i := range older.Len() {
+		sd := older.Get(i)
+		if nsd := newer.ByName(sd.Name()); nsd != nil {
+			c.service(sd, nsd)
+			continue
+		}
+
+		if d, err := c.newer.FindDescriptorByName(sd.FullName()); err == nil {
+			if nsd, ok := d.(protoreflect.ServiceDescriptor); ok {
+				c.service(sd, nsd)
+				continue
+			}
+		}
+		c.add(Error, ServiceRemoved, sd, "service removed")
+	}
+
+	for i := range newer.Len() {
+		if nsd := newer.Get(i); older.ByName(nsd.Name()) == nil {
+			c.add(Info, ServiceAdded, nsd, "service added")
+		}
+	}
+}
+
+func (c *checker) service(older, newer protoreflect.ServiceDescriptor) {
+	methods := older.Methods()
+	for i := range methods.Len() {
+		md := methods.Get(i)
+		nmd := newer.Methods().ByName(md.Name())
+		if nmd == nil {
+			c.add(Error, MethodRemoved, md, "method removed")
+			continue
+		}
+		c.method(md, nmd)
+	}
+
+	for i := range newer.Methods().Len() {
+		if nmd := newer.Methods().Get(i); methods.ByName(nmd.Name()) == nil {
+			c.add(Info, MethodAdded, nmd, "method added")
+		}
+	}
+}
+
+// method reports changes to the signature of an RPC. Request and response
+// types may be swapped for messages that decode the same.
+func (c *checker) method(older, newer protoreflect.MethodDescriptor) {
+	c.messageType(MethodRequestChanged, newer, "request", older.Input(), newer.Input())
+	c.messageType(MethodResponseChanged, newer, "response", older.Output(), newer.Output())
+
+	if older.IsStreamingClient() != newer.IsStreamingClient() || older.IsStreamingServer() != newer.IsStreamingServer() {
+		c.add(Error, MethodStreamChanged, newer, "method changed from %s to %s", streaming(older), streaming(newer))
+	}
+}
+
+func (c *checker) messageType(rule Rule, md protoreflect.MethodDescriptor, role string, older, newer protoreflect.MessageDescriptor) {
+	if older.FullName() == newer.FullName() {
+		return
+	}
+
+	if c.compatible(older, newer) {
+		c.add(Warning, rule, md, "%s type changed from %s to %s, which is wire-compatible", role, older.FullName(), newer.FullName())
+		return
+	}
+	c.add(Error, rule, md, "%s type changed from %s to %s", role, older.FullName(), newer.FullName())
+}
+
+func streaming(md protoreflect.MethodDescriptor) string {
+	switch {
+	case md.IsStreamingClient() && md.IsStreamingServer():
+		return "bidirectional streaming"
+	case md.IsStreamingClient():
+		return "client streaming"
+	case md.IsStreamingServer():
+		return "server streaming"
+	default:
+		return "unary"
+	}
+}
@@ -1,11 +1,69 @@
 package container
 
 import (
+	"context"
+	stderrors "errors"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/FACorreiaa/loci-proto/core"
+	aipoibroker "github.com/FACorreiaa/loci-proto/modules/ai_poi_service"
+	aipoi "github.com/FACorreiaa/loci-proto/modules/ai_poi_service/generated"
+	authbroker "github.com/FACorreiaa/loci-proto/modules/auth"
+	auth "github.com/FACorreiaa/loci-proto/modules/auth/generated"
+	chatbroker "github.com/FACorreiaa/loci-proto/modules/chat"
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	citybroker "github.com/FACorreiaa/loci-proto/modules/city"
+	city "github.com/FACorreiaa/loci-proto/modules/city/generated"
+	customerbroker "github.com/FACorreiaa/loci-proto/modules/customer"
 	customer "github.com/FACorreiaa/loci-proto/modules/customer/generated"
+	interestsbroker "github.com/FACorreiaa/loci-proto/modules/interests"
+	interests "github.com/FACorreiaa/loci-proto/modules/interests/generated"
+	listbroker "github.com/FACorreiaa/loci-proto/modules/list"
+	list "github.com/FACorreiaa/loci-proto/modules/list/generated"
+	poibroker "github.com/FACorreiaa/loci-proto/modules/poi"
+	poi "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+	profilesbroker "github.com/FACorreiaa/loci-proto/modules/profiles"
+	profiles "github.com/FACorreiaa/loci-proto/modules/profiles/generated"
+	recentsbroker "github.com/FACorreiaa/loci-proto/modules/recents"
+	recents "github.com/FACorreiaa/loci-proto/modules/recents/generated"
+	reviewbroker "github.com/FACorreiaa/loci-proto/modules/review"
+	review "github.com/FACorreiaa/loci-proto/modules/review/generated"
+	statisticsbroker "github.com/FACorreiaa/loci-proto/modules/statistics"
+	statistics "github.com/FACorreiaa/loci-proto/modules/statistics/generated"
+	tagsbroker "github.com/FACorreiaa/loci-proto/modules/tags"
+	tags "github.com/FACorreiaa/loci-proto/modules/tags/generated"
+	userbroker "github.com/FACorreiaa/loci-proto/modules/user"
+	user "github.com/FACorreiaa/loci-proto/modules/user/generated"
 
 	"github.com/FACorreiaa/loci-proto/utils"
 )
 
+// Service names an upstream service in the address map passed to NewBrokers.
+type Service string
+
+const (
+	ServiceAuth         Service = "auth"
+	ServiceChat         Service = "chat"
+	ServicePOI          Service = "poi"
+	ServiceList         Service = "list"
+	ServiceCity         Service = "city"
+	ServiceReview       Service = "review"
+	ServiceRecents      Service = "recents"
+	ServiceStatistics   Service = "statistics"
+	ServiceProfiles     Service = "profiles"
+	ServiceInterests    Service = "interests"
+	ServiceTags         Service = "tags"
+	ServiceUser         Service = "user"
+	ServiceAiPoiService Service = "ai_poi_service"
+	ServiceCustomer     Service = "customer"
+)
+
+// Addresses maps each upstream service to the host it should be dialed on.
+// Services that are missing from the map are left unset on the Brokers.
+type Addresses map[Service]string
+
 // Brokers is the encapsulation for all grpc clients. We use it this way so
 // that we can:
 //
@@ -15,18 +73,167 @@ import (
 //
 // Ensure that you're using the interface type here and not the implementation
 type Brokers struct {
-	Customer customer.CustomerClient
+	TransportUtils *utils.TransportUtils
+
+	Auth         auth.AuthServiceClient
+	Chat         chat.ChatServiceClient
+	POI          poi.POIServiceClient
+	List         list.ListServiceClient
+	City         city.CityServiceClient
+	Review       review.ReviewServiceClient
+	Recents      recents.RecentsServiceClient
+	Statistics   statistics.StatisticsServiceClient
+	Profiles     profiles.ProfilesServiceClient
+	Interests    interests.InterestsServiceClient
+	Tags         tags.TagsServiceClient
+	User         user.UserServiceClient
+	AiPoiService aipoi.AiPoiServiceClient
+	Customer     customer.CustomerClient
+
+	// connected keeps the concrete brokers around so their connections can be
+	// released on Close and Shutdown.
+	connected []core.Broker
 }
 
-// NewBrokers creates a common container instance for use with all cluster sevices
-func NewBrokers(transportUtils *utils.TransportUtils) *Brokers {
+// NewBrokers creates a common container instance for use with all cluster sevices.
+// A broker is created and connected for every service present in addresses,
+// instrumented with transportUtils and configured with opts.
+func NewBrokers(transportUtils *utils.TransportUtils, addresses Addresses, opts ...utils.Option) (*Brokers, error) {
 	if transportUtils == nil {
-		return nil
+		return nil, errors.New("transport utils are required")
 	}
 
 	brokers := new(Brokers)
 	brokers.TransportUtils = transportUtils
-	utils.Transport = transportUtils
 
-	return brokers
+	opts = append([]utils.Option{
+		utils.WithLogger(transportUtils.Logger),
+		utils.WithRegistry(transportUtils.Prometheus),
+		utils.WithTracerProvider(transportUtils.TraceProvider),
+	}, opts...)
+
+	wiring := []struct {
+		service Service
+		connect func(addr string) error
+	}{
+		{ServiceAuth, func(addr string) (err error) {
+			brokers.Auth, err = connect(brokers, addr, opts, authbroker.NewBroker)
+			return err
+		}},
+		{ServiceChat, func(addr string) (err error) {
+			brokers.Chat, err = connect(brokers, addr, opts, chatbroker.NewBroker)
+			return err
+		}},
+		{ServicePOI, func(addr string) (err error) {
+			brokers.POI, err = connect(brokers, addr, opts, poibroker.NewBroker)
+			return err
+		}},
+		{ServiceList, func(addr string) (err error) {
+			brokers.List, err = connect(brokers, addr, opts, listbroker.NewBroker)
+			return err
+		}},
+		{ServiceCity, func(addr string) (err error) {
+			brokers.City, err = connect(brokers, addr, opts, citybroker.NewBroker)
+			return err
+		}},
+		{ServiceReview, func(addr string) (err error) {
+			brokers.Review, err = connect(brokers, addr, opts, reviewbroker.NewBroker)
+			return err
+		}},
+		{ServiceRecents, func(addr string) (err error) {
+			brokers.Recents, err = connect(brokers, addr, opts, recentsbroker.NewBroker)
+			return err
+		}},
+		{ServiceStatistics, func(addr string) (err error) {
+			brokers.Statistics, err = connect(brokers, addr, opts, statisticsbroker.NewBroker)
+			return err
+		}},
+		{ServiceProfiles, func(addr string) (err error) {
+			brokers.Profiles, err = connect(brokers, addr, opts, profilesbroker.NewBroker)
+			return err
+		}},
+		{ServiceInterests, func(addr string) (err error) {
+			brokers.Interests, err = connect(brokers, addr, opts, interestsbroker.NewBroker)
+			return err
+		}},
+		{ServiceTags, func(addr string) (err error) {
+			brokers.Tags, err = connect(brokers, addr, opts, tagsbroker.NewBroker)
+			return err
+		}},
+		{ServiceUser, func(addr string) (err error) {
+			brokers.User, err = connect(brokers, addr, opts, userbroker.NewBroker)
+			return err
+		}},
+		{ServiceAiPoiService, func(addr string) (err error) {
+			brokers.AiPoiService, err = connect(brokers, addr, opts, aipoibroker.NewBroker)
+			return err
+		}},
+		{ServiceCustomer, func(addr string) (err error) {
+			brokers.Customer, err = connect(brokers, addr, opts, customerbroker.NewBroker)
+			return err
+		}},
+	}
+
+	for _, w := range wiring {
+		addr, ok := addresses[w.service]
+		if !ok {
+			continue
+		}
+
+		if err := w.connect(addr); err != nil {
+			_ = brokers.Close()
+			return nil, errors.Wrapf(err, "failed to wire %s broker", w.service)
+		}
+	}
+
+	return brokers, nil
+}
+
+// Close closes the connection of every broker immediately.
+func (b *Brokers) Close() error {
+	var errs []error
+	for _, broker := range b.connected {
+		if err := broker.Close(); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to close broker for %s", broker.GetAddress()))
+		}
+	}
+
+	return stderrors.Join(errs...)
+}
+
+// Shutdown drains the in-flight RPCs of every broker before closing its
+// connection. Brokers are shut down concurrently and share the deadline of ctx.
+func (b *Brokers) Shutdown(ctx context.Context) error {
+	errs := make([]error, len(b.connected))
+
+	var wg sync.WaitGroup
+	for i, broker := range b.connected {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := broker.Shutdown(ctx); err != nil {
+				errs[i] = errors.Wrapf(err, "failed to shut down broker for %s", broker.GetAddress())
+			}
+		}()
+	}
+	wg.Wait()
+
+	return stderrors.Join(errs...)
+}
+
+// connect creates a broker for addr, opens its upstream connection and
+// registers it for Close and Shutdown.
+func connect[B core.Broker](brokers *Brokers, addr string, opts []utils.Option, newBroker func(string, ...utils.Option) (B, error)) (B, error) {
+	b, err := newBroker(addr, opts...)
+	if err != nil {
+		return b, err
+	}
+
+	if _, err := b.NewConnection(); err != nil {
+		return b, err
+	}
+
+	brokers.connected = append(brokers.connected, b)
+
+	return b, nil
 }
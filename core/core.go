@@ -1,11 +1,21 @@
 package core
 
 import (
+	"context"
+
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 )
 
 // Broker is a common that we mainly use for testing that should be implemented by any concrete broker.
+//
+// The methods of a broker return the typed errors of the errors package,
+// such as *errors.ErrNotFound, for the statuses that have one.
 type Broker interface {
 	NewConnection() (*grpc.ClientConn, error)
 	GetAddress() string
+	State() connectivity.State
+	OnStateChange(fn func(connectivity.State))
+	Close() error
+	Shutdown(ctx context.Context) error
 }
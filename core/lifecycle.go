@@ -0,0 +1,290 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ErrNotConnected is matched by every NotConnectedError, so callers can use
+// errors.Is without caring about the address.
+var ErrNotConnected = errors.New("broker is not connected")
+
+// NotConnectedError is returned by a Broker that has no usable upstream
+// connection, either because it was closed or because dialing failed.
+type NotConnectedError struct {
+	Address string
+	Err     error
+}
+
+func (e *NotConnectedError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("broker for %q is not connected: %v", e.Address, e.Err)
+	}
+
+	return fmt.Sprintf("broker for %q is not connected", e.Address)
+}
+
+func (e *NotConnectedError) Unwrap() error {
+	return e.Err
+}
+
+func (e *NotConnectedError) Is(target error) bool {
+	return target == ErrNotConnected
+}
+
+// Conn is the upstream connection a Lifecycle manages. *grpc.ClientConn
+// satisfies it, as do the shared connections handed out by utils.Pool.
+type Conn interface {
+	grpc.ClientConnInterface
+	GetState() connectivity.State
+	WaitForStateChange(ctx context.Context, sourceState connectivity.State) bool
+	Connect()
+	Close() error
+}
+
+// DialFunc opens the upstream connection for address.
+type DialFunc func(address string) (Conn, error)
+
+// Lifecycle owns the upstream connection of a Broker. The connection is
+// dialed on first use, in-flight RPCs are tracked so that Shutdown can drain
+// them, and connectivity changes are reported to the registered watchers.
+//
+// Brokers embed it, which gives them Close, Shutdown, State and
+// OnStateChange for free.
+type Lifecycle struct {
+	address string
+	dial    DialFunc
+
+	mu        sync.Mutex
+	conn      Conn
+	closed    bool
+	inflight  sync.WaitGroup
+	watchers  []func(connectivity.State)
+	stopWatch context.CancelFunc
+}
+
+// NewLifecycle creates a lifecycle for address. Nothing is dialed until the
+// first call to Conn.
+func NewLifecycle(address string, dial DialFunc) *Lifecycle {
+	return &Lifecycle{
+		address: address,
+		dial:    dial,
+	}
+}
+
+// Conn returns the upstream connection, dialing it if this is the first use.
+// RPCs made through it count as in flight until they finish.
+func (l *Lifecycle) Conn() (grpc.ClientConnInterface, error) {
+	conn, err := l.Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	return &trackedConn{lifecycle: l, conn: conn}, nil
+}
+
+// ClientConn returns the underlying *grpc.ClientConn, dialing it if this is
+// the first use. Connections spread over several sub-connections return the
+// first of them.
+func (l *Lifecycle) ClientConn() (*grpc.ClientConn, error) {
+	conn, err := l.Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	switch c := conn.(type) {
+	case *grpc.ClientConn:
+		return c, nil
+	case interface{ ClientConns() []*grpc.ClientConn }:
+		return c.ClientConns()[0], nil
+	default:
+		return nil, &NotConnectedError{Address: l.address, Err: fmt.Errorf("%T is not backed by a *grpc.ClientConn", conn)}
+	}
+}
+
+// Dial returns the upstream connection, dialing it if this is the first use.
+func (l *Lifecycle) Dial() (Conn, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return nil, &NotConnectedError{Address: l.address, Err: errors.New("broker was closed")}
+	}
+
+	if l.conn != nil {
+		return l.conn, nil
+	}
+
+	conn, err := l.dial(l.address)
+	if err != nil {
+		return nil, &NotConnectedError{Address: l.address, Err: err}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l.conn = conn
+	l.stopWatch = cancel
+	go l.watch(ctx, conn)
+
+	return conn, nil
+}
+
+// State reports the connectivity state of the upstream connection. A broker
+// that has not been dialed yet is Idle, and a closed one is Shutdown.
+func (l *Lifecycle) State() connectivity.State {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	switch {
+	case l.closed:
+		return connectivity.Shutdown
+	case l.conn == nil:
+		return connectivity.Idle
+	default:
+		return l.conn.GetState()
+	}
+}
+
+// OnStateChange registers fn to be called every time the upstream
+// connection changes state.
+func (l *Lifecycle) OnStateChange(fn func(connectivity.State)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.watchers = append(l.watchers, fn)
+}
+
+// Close tears down the upstream connection immediately. RPCs still in flight
+// fail with codes.Canceled.
+func (l *Lifecycle) Close() error {
+	conn := l.markClosed()
+	if conn == nil {
+		return nil
+	}
+
+	return conn.Close()
+}
+
+// Shutdown stops accepting new RPCs and waits for the ones in flight to
+// finish before closing the upstream connection. If ctx expires first the
+// connection is closed anyway and ctx's error is returned.
+func (l *Lifecycle) Shutdown(ctx context.Context) error {
+	conn := l.markClosed()
+	if conn == nil {
+		return nil
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		l.inflight.Wait()
+		close(drained)
+	}()
+
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if closeErr := conn.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// markClosed flags the lifecycle as closed and hands back the connection, if
+// any, that the caller is now responsible for closing.
+func (l *Lifecycle) markClosed() Conn {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return nil
+	}
+
+	l.closed = true
+	if l.stopWatch != nil {
+		l.stopWatch()
+	}
+
+	return l.conn
+}
+
+// begin registers an RPC as in flight, unless the lifecycle is closed.
+func (l *Lifecycle) begin() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return &NotConnectedError{Address: l.address, Err: errors.New("broker is shutting down")}
+	}
+
+	l.inflight.Add(1)
+
+	return nil
+}
+
+// trackedConn counts the RPCs made through a Lifecycle's connection as in
+// flight, so that Shutdown can wait for them.
+type trackedConn struct {
+	lifecycle *Lifecycle
+	conn      Conn
+}
+
+func (t *trackedConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	if err := t.lifecycle.begin(); err != nil {
+		return err
+	}
+	defer t.lifecycle.inflight.Done()
+
+	return t.conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+func (t *trackedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := t.lifecycle.begin(); err != nil {
+		return nil, err
+	}
+
+	stream, err := t.conn.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		t.lifecycle.inflight.Done()
+		return nil, err
+	}
+
+	// The stream context is canceled once the stream finishes, whichever
+	// way it finishes, so that is when it stops counting as in flight.
+	go func() {
+		<-stream.Context().Done()
+		t.lifecycle.inflight.Done()
+	}()
+
+	return stream, nil
+}
+
+// watch follows the connectivity state of conn until ctx is canceled. An
+// idle connection is kicked back into connecting so that the next RPC does
+// not pay for the reconnect.
+func (l *Lifecycle) watch(ctx context.Context, conn Conn) {
+	state := conn.GetState()
+	for conn.WaitForStateChange(ctx, state) {
+		state = conn.GetState()
+
+		l.mu.Lock()
+		watchers := append([]func(connectivity.State){}, l.watchers...)
+		l.mu.Unlock()
+
+		for _, fn := range watchers {
+			fn(state)
+		}
+
+		if state == connectivity.Idle {
+			conn.Connect()
+		}
+	}
+}
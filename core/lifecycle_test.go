@@ -0,0 +1,200 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/FACorreiaa/loci-proto/core"
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	list "github.com/FACorreiaa/loci-proto/modules/list/generated"
+	"github.com/FACorreiaa/loci-proto/testing/fakes"
+	"github.com/FACorreiaa/loci-proto/utils"
+)
+
+// newLifecycle returns a lifecycle dialing the fakes, and the number of
+// times it dialed.
+func newLifecycle(t *testing.T) (*core.Lifecycle, *int) {
+	t.Helper()
+
+	srv, err := fakes.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Stop)
+
+	dialer := utils.NewOptions(srv.Options()...).Dialer()
+	dials := 0
+	l := core.NewLifecycle(fakes.Address, func(address string) (core.Conn, error) {
+		dials++
+		return dialer(address)
+	})
+	t.Cleanup(func() { _ = l.Close() })
+
+	return l, &dials
+}
+
+func createList(ctx context.Context, l *core.Lifecycle) error {
+	conn, err := l.Conn()
+	if err != nil {
+		return err
+	}
+	_, err = list.NewListServiceClient(conn).CreateList(ctx, &list.CreateListRequest{UserId: "u1", Name: "Lisbon"})
+
+	return err
+}
+
+func TestLifecycleDialsLazily(t *testing.T) {
+	l, dials := newLifecycle(t)
+	if *dials != 0 || l.State() != connectivity.Idle {
+		t.Fatalf("new lifecycle dialed %d times and is %v, want no dial and %v", *dials, l.State(), connectivity.Idle)
+	}
+
+	ready := make(chan struct{})
+	l.OnStateChange(func(state connectivity.State) {
+		if state == connectivity.Ready {
+			close(ready)
+		}
+	})
+
+	for range 2 {
+		if err := createList(context.Background(), l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if *dials != 1 {
+		t.Errorf("dialed %d times, want once", *dials)
+	}
+
+	select {
+	case <-ready:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watchers were not told the connection is ready")
+	}
+
+	if _, err := l.ClientConn(); err != nil {
+		t.Errorf("ClientConn() of a pooled connection: %v", err)
+	}
+}
+
+func TestLifecycleDialError(t *testing.T) {
+	errDial := errors.New("no route to host")
+	l := core.NewLifecycle("poi:8080", func(string) (core.Conn, error) {
+		return nil, errDial
+	})
+
+	_, err := l.Conn()
+	var notConnected *core.NotConnectedError
+	if !errors.As(err, &notConnected) || notConnected.Address != "poi:8080" {
+		t.Fatalf("Conn() = %v, want a NotConnectedError for poi:8080", err)
+	}
+	if !errors.Is(err, core.ErrNotConnected) || !errors.Is(err, errDial) {
+		t.Errorf("Conn() = %v, want it to match both ErrNotConnected and the dial error", err)
+	}
+}
+
+func TestLifecycleClose(t *testing.T) {
+	l, _ := newLifecycle(t)
+	if err := createList(context.Background(), l); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Close(); err != nil {
+		t.Errorf("second Close() = %v", err)
+	}
+	if l.State() != connectivity.Shutdown {
+		t.Errorf("State() = %v, want %v", l.State(), connectivity.Shutdown)
+	}
+	if err := createList(context.Background(), l); !errors.Is(err, core.ErrNotConnected) {
+		t.Errorf("call after Close() = %v, want %v", err, core.ErrNotConnected)
+	}
+}
+
+// openStream opens a chat stream through l that counts as in flight until
+// cancel is called, since nothing receives from it.
+func openStream(t *testing.T, l *core.Lifecycle) (cancel context.CancelFunc) {
+	t.Helper()
+
+	conn, err := l.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := chat.NewChatServiceClient(conn).StartChatStream(ctx, &chat.StartChatRequest{UserId: "u1"}); err != nil {
+		cancel()
+		t.Fatal(err)
+	}
+
+	return cancel
+}
+
+func TestLifecycleShutdownDrains(t *testing.T) {
+	l, _ := newLifecycle(t)
+	cancelStream := openStream(t, l)
+	defer cancelStream()
+
+	// Calls made on a connection taken before Shutdown must be turned away
+	// too.
+	conn, err := l.Conn()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shutdown := make(chan error, 1)
+	go func() {
+		shutdown <- l.Shutdown(context.Background())
+	}()
+
+	select {
+	case err := <-shutdown:
+		t.Fatalf("Shutdown() = %v with a stream in flight", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// New calls are turned away while draining.
+	_, err = list.NewListServiceClient(conn).CreateList(context.Background(), &list.CreateListRequest{UserId: "u1", Name: "Porto"})
+	if !errors.Is(err, core.ErrNotConnected) {
+		t.Errorf("call while shutting down = %v, want %v", err, core.ErrNotConnected)
+	}
+
+	cancelStream()
+	select {
+	case err := <-shutdown:
+		if err != nil {
+			t.Errorf("Shutdown() = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Shutdown() did not return once the stream finished")
+	}
+}
+
+func TestLifecycleShutdownTimeout(t *testing.T) {
+	l, _ := newLifecycle(t)
+	cancelStream := openStream(t, l)
+	defer cancelStream()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if l.State() != connectivity.Shutdown {
+		t.Errorf("State() = %v, want %v", l.State(), connectivity.Shutdown)
+	}
+}
+
+func TestLifecycleShutdownUnused(t *testing.T) {
+	l, dials := newLifecycle(t)
+	if err := l.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if *dials != 0 {
+		t.Errorf("Shutdown() of an unused lifecycle dialed %d times", *dials)
+	}
+}
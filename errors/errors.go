@@ -0,0 +1,375 @@
+// Package errors maps the ErrorDetails and FieldError messages of the common
+// package onto gRPC status details, and the resulting statuses onto typed Go
+// errors, so that callers can use errors.As instead of parsing messages.
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"maps"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// Domain is the ErrorInfo domain of the errors raised by loci services.
+const Domain = "loci"
+
+// traceIDKey is the ErrorInfo metadata key ErrorDetails.trace_id travels in.
+const traceIDKey = "trace_id"
+
+// ToStatus builds the status describing d. The code of d becomes the reason
+// of an ErrorInfo, along with its trace ID and details, and its field errors
+// become a BadRequest.
+func ToStatus(code codes.Code, d *common.ErrorDetails) *status.Status {
+	st := status.New(code, d.GetMessage())
+	if d == nil {
+		return st
+	}
+
+	var details []protoadapt.MessageV1
+	if d.GetCode() != "" || d.GetTraceId() != "" || len(d.GetDetails()) > 0 {
+		metadata := maps.Clone(d.GetDetails())
+		if d.GetTraceId() != "" {
+			if metadata == nil {
+				metadata = make(map[string]string, 1)
+			}
+			metadata[traceIDKey] = d.GetTraceId()
+		}
+
+		details = append(details, &errdetails.ErrorInfo{
+			Reason:   d.GetCode(),
+			Domain:   Domain,
+			Metadata: metadata,
+		})
+	}
+
+	if len(d.GetFieldErrors()) > 0 {
+		details = append(details, badRequest(d.GetFieldErrors()))
+	}
+
+	return withDetails(st, details...)
+}
+
+// WithRetryDelay adds a RetryInfo asking the client to wait for delay
+// before trying again.
+func WithRetryDelay(st *status.Status, delay time.Duration) *status.Status {
+	return withDetails(st, &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+}
+
+// FromStatus reads the ErrorDetails back out of st. Statuses that carry no
+// ErrorInfo are given the name of their code, e.g. "NOT_FOUND".
+func FromStatus(st *status.Status) *common.ErrorDetails {
+	if st == nil || st.Code() == codes.OK {
+		return nil
+	}
+
+	d := &common.ErrorDetails{
+		Code:    CodeName(st.Code()),
+		Message: st.Message(),
+	}
+
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if detail.GetReason() != "" {
+				d.Code = detail.GetReason()
+			}
+			for k, v := range detail.GetMetadata() {
+				if k == traceIDKey {
+					d.TraceId = v
+					continue
+				}
+				if d.Details == nil {
+					d.Details = make(map[string]string)
+				}
+				d.Details[k] = v
+			}
+		case *errdetails.BadRequest:
+			d.FieldErrors = append(d.FieldErrors, fieldErrors(detail)...)
+		}
+	}
+
+	return d
+}
+
+// RetryDelay returns the delay the RetryInfo of err asks for.
+func RetryDelay(err error) (time.Duration, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return 0, false
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+
+	return 0, false
+}
+
+// withDetails attaches details to st. Details that cannot be attached, such
+// as those of an OK status, are dropped.
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	if len(details) == 0 {
+		return st
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+func badRequest(fields []*common.FieldError) *errdetails.BadRequest {
+	br := &errdetails.BadRequest{FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(fields))}
+	for _, f := range fields {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       f.GetField(),
+			Description: f.GetMessage(),
+			Reason:      f.GetCode(),
+		})
+	}
+
+	return br
+}
+
+func fieldErrors(br *errdetails.BadRequest) []*common.FieldError {
+	fields := make([]*common.FieldError, 0, len(br.GetFieldViolations()))
+	for _, v := range br.GetFieldViolations() {
+		fields = append(fields, &common.FieldError{
+			Field:   v.GetField(),
+			Message: v.GetDescription(),
+			Code:    v.GetReason(),
+		})
+	}
+
+	return fields
+}
+
+// codeNames holds the canonical names of the gRPC codes, e.g. "NOT_FOUND",
+// indexed by code.
+var codeNames = func() []string {
+	names := make([]string, codes.Unauthenticated+1)
+	for c := range names {
+		names[c] = strings.ToUpper(snakeCase(codes.Code(c).String()))
+	}
+
+	return names
+}()
+
+// codeByName maps the canonical names of the gRPC codes back to the codes.
+var codeByName = func() map[string]codes.Code {
+	m := make(map[string]codes.Code, len(codeNames))
+	for c, name := range codeNames {
+		m[name] = codes.Code(c)
+	}
+
+	return m
+}()
+
+// CodeName returns the canonical name of c, e.g. "NOT_FOUND", as used in
+// ErrorDetails and in the retry policies of service configs.
+func CodeName(c codes.Code) string {
+	if int(c) < len(codeNames) {
+		return codeNames[c]
+	}
+
+	return strings.ToUpper(snakeCase(c.String()))
+}
+
+// snakeCase turns a Go identifier such as "InvalidArgument" or "Level2Name"
+// into "invalid_argument" or "level2_name".
+func snakeCase(name string) string {
+	var sb strings.Builder
+	prev := ' '
+	for _, r := range name {
+		if unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)) {
+			sb.WriteByte('_')
+		}
+		sb.WriteRune(unicode.ToLower(r))
+		prev = r
+	}
+
+	return sb.String()
+}
+
+// Status is embedded by the typed errors. It keeps the status they were
+// built from, so status.Code and status.FromError keep working on them.
+type Status struct {
+	status  *status.Status
+	details *common.ErrorDetails
+}
+
+func newStatus(st *status.Status) Status {
+	return Status{status: st, details: FromStatus(st)}
+}
+
+func (s *Status) Error() string {
+	return s.status.Err().Error()
+}
+
+// GRPCStatus returns the status the error was built from.
+func (s *Status) GRPCStatus() *status.Status {
+	return s.status
+}
+
+// Details returns the ErrorDetails carried by the status.
+func (s *Status) Details() *common.ErrorDetails {
+	return s.details
+}
+
+// ErrNotFound is returned when the requested resource does not exist.
+type ErrNotFound struct {
+	Status
+}
+
+// ErrValidation is returned when the request was rejected as invalid.
+// Fields lists the offending fields, if the server named them.
+type ErrValidation struct {
+	Status
+
+	Fields []*common.FieldError
+}
+
+// ErrUnauthenticated is returned when the call carried no valid credentials.
+type ErrUnauthenticated struct {
+	Status
+}
+
+// NotFound builds the error a server returns for a missing resource.
+func NotFound(d *common.ErrorDetails) error {
+	return ToStatus(codes.NotFound, d).Err()
+}
+
+// Validation builds the error a server returns for an invalid request.
+func Validation(message string, fields ...*common.FieldError) error {
+	return ToStatus(codes.InvalidArgument, &common.ErrorDetails{
+		Code:        "VALIDATION_FAILED",
+		Message:     message,
+		FieldErrors: fields,
+	}).Err()
+}
+
+// Unauthenticated builds the error a server returns for a call without
+// valid credentials.
+func Unauthenticated(d *common.ErrorDetails) error {
+	return ToStatus(codes.Unauthenticated, d).Err()
+}
+
+// FromError turns the gRPC error err into the matching typed error. Errors
+// without a typed counterpart, and errors that are not gRPC errors, are
+// returned unchanged.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var typed interface{ Details() *common.ErrorDetails }
+	if stderrors.As(err, &typed) {
+		return err
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return &ErrNotFound{Status: newStatus(st)}
+	case codes.InvalidArgument:
+		s := newStatus(st)
+		return &ErrValidation{Status: s, Fields: s.details.GetFieldErrors()}
+	case codes.Unauthenticated:
+		return &ErrUnauthenticated{Status: newStatus(st)}
+	default:
+		return err
+	}
+}
+
+// Response is implemented by the responses that report failures through a
+// success flag rather than a gRPC status, such as common.Response.
+type Response interface {
+	GetSuccess() bool
+	GetMessage() string
+	GetError() *common.ErrorDetails
+}
+
+// FromResponse returns the error a failed Response describes, typed the same
+// way as FromError, or nil when it succeeded. The code of its ErrorDetails
+// is matched against the names of the gRPC codes, falling back to Unknown.
+func FromResponse(resp Response) error {
+	if resp == nil || resp.GetSuccess() {
+		return nil
+	}
+
+	d := resp.GetError()
+	if d == nil {
+		d = &common.ErrorDetails{Message: resp.GetMessage()}
+	}
+
+	code, ok := codeByName[d.GetCode()]
+	if !ok {
+		code = codes.Unknown
+		if len(d.GetFieldErrors()) > 0 {
+			code = codes.InvalidArgument
+		}
+	}
+
+	return FromError(ToStatus(code, d).Err())
+}
+
+// UnaryClientInterceptor converts the errors of unary calls with FromError.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return FromError(invoker(ctx, method, req, reply, cc, opts...))
+	}
+}
+
+// StreamClientInterceptor converts the errors of opening, sending on and
+// receiving from streams with FromError.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, FromError(err)
+		}
+
+		return &clientStream{ClientStream: stream}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+}
+
+func (s *clientStream) SendMsg(m any) error {
+	return convertStreamError(s.ClientStream.SendMsg(m))
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	return convertStreamError(s.ClientStream.RecvMsg(m))
+}
+
+// convertStreamError leaves io.EOF alone, since callers compare it directly.
+func convertStreamError(err error) error {
+	if err == io.EOF {
+		return err
+	}
+
+	return FromError(err)
+}
@@ -0,0 +1,240 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// overTheWire returns st as a client receives it.
+func overTheWire(st *status.Status) *status.Status {
+	return status.FromProto(st.Proto())
+}
+
+var details = &common.ErrorDetails{
+	Code:    "POI_NOT_FOUND",
+	Message: "poi p1 not found",
+	TraceId: "trace-1",
+	Details: map[string]string{"poi_id": "p1"},
+	FieldErrors: []*common.FieldError{
+		{Field: "poi_id", Message: "unknown id", Code: InvalidValue},
+	},
+}
+
+func TestStatusRoundTrip(t *testing.T) {
+	st := overTheWire(ToStatus(codes.NotFound, details))
+	if st.Code() != codes.NotFound || st.Message() != details.GetMessage() {
+		t.Errorf("ToStatus() = %v %q, want %v %q", st.Code(), st.Message(), codes.NotFound, details.GetMessage())
+	}
+	if got := FromStatus(st); !proto.Equal(got, details) {
+		t.Errorf("FromStatus(ToStatus(d)) = %v, want %v", got, details)
+	}
+
+	tests := []struct {
+		name string
+		st   *status.Status
+		want *common.ErrorDetails
+	}{
+		{name: "no details", st: status.New(codes.DeadlineExceeded, "too slow"), want: &common.ErrorDetails{Code: "DEADLINE_EXCEEDED", Message: "too slow"}},
+		{name: "only a message", st: ToStatus(codes.Internal, &common.ErrorDetails{Message: "boom"}), want: &common.ErrorDetails{Code: "INTERNAL", Message: "boom"}},
+		{name: "nil details", st: ToStatus(codes.Unavailable, nil), want: &common.ErrorDetails{Code: "UNAVAILABLE"}},
+		{name: "OK"},
+	}
+	for _, tt := range tests {
+		var st *status.Status
+		if tt.st != nil {
+			st = overTheWire(tt.st)
+		}
+		if got := FromStatus(st); !proto.Equal(got, tt.want) {
+			t.Errorf("%s: FromStatus() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFromError(t *testing.T) {
+	notFound := overTheWire(ToStatus(codes.NotFound, details)).Err()
+	validation := overTheWire(status.Convert(Validation("bad request", details.GetFieldErrors()...))).Err()
+	unauthenticated := overTheWire(ToStatus(codes.Unauthenticated, &common.ErrorDetails{Code: "TOKEN_EXPIRED"})).Err()
+
+	t.Run("not found", func(t *testing.T) {
+		err := FromError(notFound)
+		var typed *ErrNotFound
+		if !stderrors.As(err, &typed) {
+			t.Fatalf("FromError() = %T, want *ErrNotFound", err)
+		}
+		if !proto.Equal(typed.Details(), details) {
+			t.Errorf("Details() = %v, want %v", typed.Details(), details)
+		}
+		if status.Code(err) != codes.NotFound || err.Error() != notFound.Error() {
+			t.Errorf("typed error is %v %q, want the status it was built from", status.Code(err), err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		var typed *ErrValidation
+		if err := FromError(validation); !stderrors.As(err, &typed) {
+			t.Fatalf("FromError() = %T, want *ErrValidation", err)
+		}
+		if len(typed.Fields) != 1 || !proto.Equal(typed.Fields[0], details.GetFieldErrors()[0]) {
+			t.Errorf("Fields = %v, want %v", typed.Fields, details.GetFieldErrors())
+		}
+		if typed.Details().GetCode() != "VALIDATION_FAILED" {
+			t.Errorf("Details().Code = %q, want VALIDATION_FAILED", typed.Details().GetCode())
+		}
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		var typed *ErrUnauthenticated
+		if err := FromError(unauthenticated); !stderrors.As(err, &typed) {
+			t.Fatalf("FromError() = %T, want *ErrUnauthenticated", err)
+		}
+		if typed.Details().GetCode() != "TOKEN_EXPIRED" {
+			t.Errorf("Details().Code = %q, want TOKEN_EXPIRED", typed.Details().GetCode())
+		}
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		typed := FromError(notFound)
+		plain := stderrors.New("plain")
+		internal := status.Error(codes.Internal, "boom")
+		for _, err := range []error{nil, plain, internal, typed} {
+			if got := FromError(err); got != err {
+				t.Errorf("FromError(%v) = %v, want it unchanged", err, got)
+			}
+		}
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		var typed *ErrNotFound
+		if err := FromError(fmt.Errorf("get poi: %w", notFound)); !stderrors.As(err, &typed) {
+			t.Errorf("FromError() of a wrapped status = %T, want *ErrNotFound", err)
+		}
+	})
+}
+
+func TestFromResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		resp     Response
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{name: "success", resp: &common.Response{Success: true}, wantCode: codes.OK},
+		{name: "nil", wantCode: codes.OK},
+		{name: "code name", resp: &common.Response{Error: &common.ErrorDetails{Code: "NOT_FOUND", Message: "gone"}}, wantCode: codes.NotFound, wantMsg: "gone"},
+		{name: "field errors", resp: &common.Response{Error: &common.ErrorDetails{Code: "BAD_NAME", FieldErrors: details.GetFieldErrors()}}, wantCode: codes.InvalidArgument},
+		{name: "unknown code", resp: &common.Response{Error: &common.ErrorDetails{Code: "QUOTA", Message: "over quota"}}, wantCode: codes.Unknown, wantMsg: "over quota"},
+		{name: "only a message", resp: &common.Response{Message: "failed"}, wantCode: codes.Unknown, wantMsg: "failed"},
+	}
+	for _, tt := range tests {
+		err := FromResponse(tt.resp)
+		if st := status.Convert(err); st.Code() != tt.wantCode || st.Message() != tt.wantMsg {
+			t.Errorf("%s: FromResponse() = %v, want %v %q", tt.name, err, tt.wantCode, tt.wantMsg)
+		}
+	}
+
+	var typed *ErrNotFound
+	err := FromResponse(&common.Response{Error: &common.ErrorDetails{Code: "NOT_FOUND", TraceId: "trace-1"}})
+	if !stderrors.As(err, &typed) || typed.Details().GetTraceId() != "trace-1" {
+		t.Errorf("FromResponse() = %#v, want *ErrNotFound with trace ID trace-1", err)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	err := overTheWire(WithRetryDelay(status.New(codes.ResourceExhausted, "slow down"), 3*time.Second)).Err()
+	if got, ok := RetryDelay(err); got != 3*time.Second || !ok {
+		t.Errorf("RetryDelay() = %v, %t, want 3s, true", got, ok)
+	}
+	if _, ok := RetryDelay(status.Error(codes.ResourceExhausted, "slow down")); ok {
+		t.Error("RetryDelay() of a status without RetryInfo reported a delay")
+	}
+}
+
+func TestCodeName(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want string
+	}{
+		{code: codes.OK, want: "OK"},
+		{code: codes.NotFound, want: "NOT_FOUND"},
+		{code: codes.DeadlineExceeded, want: "DEADLINE_EXCEEDED"},
+		{code: codes.Unauthenticated, want: "UNAUTHENTICATED"},
+	}
+	for _, tt := range tests {
+		if got := CodeName(tt.code); got != tt.want {
+			t.Errorf("CodeName(%v) = %q, want %q", tt.code, got, tt.want)
+		}
+		if got := codeByName[tt.want]; got != tt.code {
+			t.Errorf("codeByName[%q] = %v, want %v", tt.want, got, tt.code)
+		}
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		return NotFound(details)
+	}
+
+	var typed *ErrNotFound
+	if err := UnaryClientInterceptor()(context.Background(), "/test/Unary", nil, nil, nil, invoker); !stderrors.As(err, &typed) {
+		t.Errorf("call error = %T, want *ErrNotFound", err)
+	}
+}
+
+// failingStream is a client stream whose RecvMsg and SendMsg return err.
+type failingStream struct {
+	grpc.ClientStream
+
+	err error
+}
+
+func (s *failingStream) SendMsg(any) error { return s.err }
+func (s *failingStream) RecvMsg(any) error { return s.err }
+
+func TestStreamClientInterceptor(t *testing.T) {
+	open := func(openErr, err error) (grpc.ClientStream, error) {
+		streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+			if openErr != nil {
+				return nil, openErr
+			}
+			return &failingStream{err: err}, nil
+		}
+
+		return StreamClientInterceptor()(context.Background(), &grpc.StreamDesc{ServerStreams: true}, nil, "/test/Stream", streamer)
+	}
+
+	var unauthenticated *ErrUnauthenticated
+	if _, err := open(Unauthenticated(nil), nil); !stderrors.As(err, &unauthenticated) {
+		t.Errorf("open error = %T, want *ErrUnauthenticated", err)
+	}
+
+	stream, err := open(nil, Validation("bad message"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var validation *ErrValidation
+	if err := stream.SendMsg(nil); !stderrors.As(err, &validation) {
+		t.Errorf("SendMsg() error = %T, want *ErrValidation", err)
+	}
+	if err := stream.RecvMsg(nil); !stderrors.As(err, &validation) {
+		t.Errorf("RecvMsg() error = %T, want *ErrValidation", err)
+	}
+
+	stream, err = open(nil, io.EOF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.RecvMsg(nil); err != io.EOF {
+		t.Errorf("RecvMsg() at the end of the stream = %v, want io.EOF", err)
+	}
+}
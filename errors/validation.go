@@ -0,0 +1,101 @@
+package errors
+
+import (
+	stderrors "errors"
+	"strings"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// InvalidValue is the code of the field errors built from the validation
+// rules declared on the proto fields.
+const InvalidValue = "INVALID_VALUE"
+
+// fieldViolation is implemented by the ValidationError types generated for
+// every message.
+type fieldViolation interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is implemented by the MultiError types returned by the
+// generated ValidateAll methods.
+type multiError interface {
+	AllErrors() []error
+}
+
+// FromValidation builds the error a server returns for a request whose
+// generated Validate or ValidateAll method failed with err.
+func FromValidation(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return Validation("request failed validation", ValidationFieldErrors(err)...)
+}
+
+// ValidationFieldErrors flattens the error returned by a generated Validate
+// or ValidateAll method into field errors. Fields of embedded messages are
+// named by their path, e.g. "location.latitude" or "translations[0].text".
+func ValidationFieldErrors(err error) []*common.FieldError {
+	var fields []*common.FieldError
+	collectFieldErrors(&fields, "", err)
+
+	return fields
+}
+
+func collectFieldErrors(fields *[]*common.FieldError, prefix string, err error) {
+	if err == nil {
+		return
+	}
+
+	var multi multiError
+	if stderrors.As(err, &multi) {
+		for _, err := range multi.AllErrors() {
+			collectFieldErrors(fields, prefix, err)
+		}
+
+		return
+	}
+
+	var violation fieldViolation
+	if !stderrors.As(err, &violation) {
+		*fields = append(*fields, &common.FieldError{Field: prefix, Message: err.Error(), Code: InvalidValue})
+		return
+	}
+
+	path := joinPath(prefix, fieldPath(violation.Field()))
+	if cause := violation.Cause(); cause != nil {
+		var nested fieldViolation
+		if stderrors.As(cause, &multi) || stderrors.As(cause, &nested) {
+			collectFieldErrors(fields, path, cause)
+			return
+		}
+	}
+
+	*fields = append(*fields, &common.FieldError{Field: path, Message: violation.Reason(), Code: InvalidValue})
+}
+
+func joinPath(prefix, field string) string {
+	switch {
+	case prefix == "":
+		return field
+	case field == "":
+		return prefix
+	default:
+		return prefix + "." + field
+	}
+}
+
+// fieldPath turns the Go field name the generated code reports, e.g.
+// "SemanticWeight" or "TimeSlots[0]", into the proto field name. Map keys
+// and indexes are left alone.
+func fieldPath(field string) string {
+	name, index := field, ""
+	if i := strings.IndexByte(field, '['); i >= 0 {
+		name, index = field[:i], field[i:]
+	}
+
+	return snakeCase(name) + index
+}
@@ -0,0 +1,145 @@
+package geo
+
+import (
+	"math"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// Bounds is a box between two corners. Bounds crossing the antimeridian
+// have an SW longitude greater than their NE one.
+type Bounds struct {
+	SW Point
+	NE Point
+}
+
+// BoundsOf returns the corners of b.
+func BoundsOf(b *common.GeoBounds) Bounds {
+	return Bounds{SW: PointOf(b.GetSouthwest()), NE: PointOf(b.GetNortheast())}
+}
+
+// GeoBounds returns b as a common.GeoBounds.
+func (b Bounds) GeoBounds() *common.GeoBounds {
+	return &common.GeoBounds{Southwest: b.SW.Coordinates(), Northeast: b.NE.Coordinates()}
+}
+
+// BoundsAround returns the smallest box holding the circle of radius meters
+// around center. Circles reaching a pole span every longitude.
+func BoundsAround(center Point, radius float64) Bounds {
+	dLat := degrees(radius / EarthRadius)
+	south, north := center.Lat-dLat, center.Lat+dLat
+	if south <= -90 || north >= 90 {
+		return Bounds{
+			SW: Point{Lat: max(south, -90), Lng: -180},
+			NE: Point{Lat: min(north, 90), Lng: 180},
+		}
+	}
+
+	// The widest point of the circle is not on the parallel of its center,
+	// so the longitude span is derived from the sine of its angular radius.
+	dLng := degrees(math.Asin(math.Sin(radius/EarthRadius) / math.Cos(radians(center.Lat))))
+	if dLng >= 180 {
+		return Bounds{SW: Point{Lat: south, Lng: -180}, NE: Point{Lat: north, Lng: 180}}
+	}
+
+	return Bounds{
+		SW: Point{Lat: south, Lng: normalizeLng(center.Lng - dLng)},
+		NE: Point{Lat: north, Lng: normalizeLng(center.Lng + dLng)},
+	}
+}
+
+// BoundsOfPoints returns the smallest box holding every point.
+func BoundsOfPoints(points ...Point) Bounds {
+	if len(points) == 0 {
+		return Bounds{}
+	}
+
+	b := Bounds{SW: points[0], NE: points[0]}
+	for _, p := range points[1:] {
+		b = b.Extend(p)
+	}
+
+	return b
+}
+
+// crossesAntimeridian reports whether b wraps around longitude 180.
+func (b Bounds) crossesAntimeridian() bool {
+	return b.SW.Lng > b.NE.Lng
+}
+
+// Contains reports whether p lies within b, edges included.
+func (b Bounds) Contains(p Point) bool {
+	if p.Lat < b.SW.Lat || p.Lat > b.NE.Lat {
+		return false
+	}
+
+	return b.containsLng(p.Lng)
+}
+
+func (b Bounds) containsLng(lng float64) bool {
+	if b.crossesAntimeridian() {
+		return lng >= b.SW.Lng || lng <= b.NE.Lng
+	}
+
+	return lng >= b.SW.Lng && lng <= b.NE.Lng
+}
+
+// Extend returns the smallest box holding both b and p. When p lies east or
+// west of b, b grows in whichever direction is shorter.
+func (b Bounds) Extend(p Point) Bounds {
+	b.SW.Lat = min(b.SW.Lat, p.Lat)
+	b.NE.Lat = max(b.NE.Lat, p.Lat)
+	if b.containsLng(p.Lng) {
+		return b
+	}
+
+	east := math.Mod(p.Lng-b.NE.Lng+360, 360)
+	west := math.Mod(b.SW.Lng-p.Lng+360, 360)
+	if east < west {
+		b.NE.Lng = p.Lng
+	} else {
+		b.SW.Lng = p.Lng
+	}
+
+	return b
+}
+
+// Expand returns b grown by meters on every side.
+func (b Bounds) Expand(meters float64) Bounds {
+	dLat := degrees(meters / EarthRadius)
+	south, north := max(b.SW.Lat-dLat, -90), min(b.NE.Lat+dLat, 90)
+
+	// Widen by the amount needed at the parallel furthest from the
+	// equator, so the margin is at least meters everywhere.
+	lat := max(math.Abs(south), math.Abs(north))
+	if lat >= 90 {
+		return Bounds{SW: Point{Lat: south, Lng: -180}, NE: Point{Lat: north, Lng: 180}}
+	}
+
+	dLng := dLat / math.Cos(radians(lat))
+	if b.Width()+2*dLng >= 360 {
+		return Bounds{SW: Point{Lat: south, Lng: -180}, NE: Point{Lat: north, Lng: 180}}
+	}
+
+	return Bounds{
+		SW: Point{Lat: south, Lng: normalizeLng(b.SW.Lng - dLng)},
+		NE: Point{Lat: north, Lng: normalizeLng(b.NE.Lng + dLng)},
+	}
+}
+
+// Width returns the longitude span of b, in degrees.
+func (b Bounds) Width() float64 {
+	if b.crossesAntimeridian() {
+		return b.NE.Lng + 360 - b.SW.Lng
+	}
+
+	return b.NE.Lng - b.SW.Lng
+}
+
+// Center returns the point halfway between the corners of b.
+func (b Bounds) Center() Point {
+	return Point{
+		Lat: (b.SW.Lat + b.NE.Lat) / 2,
+		Lng: normalizeLng(b.SW.Lng + b.Width()/2),
+	}
+}
@@ -0,0 +1,79 @@
+package geo
+
+import (
+	"testing"
+)
+
+func TestBoundsAntimeridian(t *testing.T) {
+	b := BoundsOfPoints(Point{Lat: -10, Lng: 170}, Point{Lat: 10, Lng: -170})
+	if want := (Bounds{SW: Point{Lat: -10, Lng: 170}, NE: Point{Lat: 10, Lng: -170}}); b != want {
+		t.Fatalf("BoundsOfPoints() = %v, want %v", b, want)
+	}
+	if got := b.Width(); !near(got, 20, 1e-9) {
+		t.Errorf("Width() = %v, want 20", got)
+	}
+	if got := b.Center(); !near(got.Lat, 0, 1e-9) || !near(got.Lng, -180, 1e-9) {
+		t.Errorf("Center() = %v, want 0,-180", got)
+	}
+
+	for _, tt := range []struct {
+		p    Point
+		want bool
+	}{
+		{p: Point{Lng: 179}, want: true},
+		{p: Point{Lng: -179}, want: true},
+		{p: Point{Lng: 170}, want: true},
+		{p: Point{Lat: 10, Lng: -170}, want: true},
+		{p: Point{Lng: 0}, want: false},
+		{p: Point{Lat: 11, Lng: 180}, want: false},
+	} {
+		if got := b.Contains(tt.p); got != tt.want {
+			t.Errorf("%v.Contains(%v) = %t, want %t", b, tt.p, got, tt.want)
+		}
+	}
+
+	// Growing east is shorter than across the whole world westwards.
+	if got := b.Extend(Point{Lng: -160}); got.NE.Lng != -160 || got.SW.Lng != 170 {
+		t.Errorf("Extend() east = %v", got)
+	}
+	if got := b.Extend(Point{Lng: 160}); got.SW.Lng != 160 || got.NE.Lng != -170 {
+		t.Errorf("Extend() west = %v", got)
+	}
+}
+
+func TestBoundsAround(t *testing.T) {
+	for _, center := range []Point{{Lat: 38.7223, Lng: -9.1393}, {Lat: 60, Lng: 179.99}, {Lat: -45, Lng: -179.99}} {
+		const radius = 5000
+		b := BoundsAround(center, radius)
+		for bearing := 0.0; bearing < 360; bearing += 15 {
+			// Just short of the radius, as the circle touches the edges.
+			if p := Destination(center, bearing, radius*0.999); !b.Contains(p) {
+				t.Errorf("BoundsAround(%v, %v) = %v, missing %v at bearing %v", center, radius, b, p, bearing)
+			}
+		}
+		if p := Destination(center, 45, 2*radius); b.Contains(p) {
+			t.Errorf("BoundsAround(%v, %v) = %v, holding %v twice as far", center, radius, b, p)
+		}
+	}
+
+	if b := BoundsAround(Point{Lat: 89.99}, 5000); b.SW.Lng != -180 || b.NE.Lng != 180 || b.NE.Lat != 90 {
+		t.Errorf("BoundsAround() reaching the pole = %v, want every longitude", b)
+	}
+}
+
+func TestBoundsExpand(t *testing.T) {
+	b := Bounds{SW: Point{Lat: 38.7, Lng: -9.2}, NE: Point{Lat: 38.8, Lng: -9.1}}
+	const margin = 1000
+	got := b.Expand(margin)
+	for _, p := range []Point{b.SW, b.NE, {Lat: b.SW.Lat, Lng: b.NE.Lng}, {Lat: b.NE.Lat, Lng: b.SW.Lng}} {
+		for bearing := 0.0; bearing < 360; bearing += 45 {
+			if q := Destination(p, bearing, margin*0.999); !got.Contains(q) {
+				t.Errorf("Expand(%v) = %v, missing %v", margin, got, q)
+			}
+		}
+	}
+
+	if got := (Bounds{SW: Point{Lng: -179}, NE: Point{Lng: 179}}).Expand(1e6); got.SW.Lng != -180 || got.NE.Lng != 180 {
+		t.Errorf("Expand() past the whole world = %v, want every longitude", got)
+	}
+}
@@ -0,0 +1,159 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// MaxResolution is the finest resolution of a Cell, about half a meter
+// across at the equator.
+const MaxResolution = 24
+
+// maxMercatorLat is the latitude where the Web Mercator projection, and so
+// the cell grid, stops.
+const maxMercatorLat = 85.05112878
+
+// Cell identifies a hexagon of the grid the services bucket POIs with. Like
+// H3 cells, cells are hexagonal, come in resolutions from 0 to
+// MaxResolution, each twice as fine as the one before, and fit in a uint64
+// with a hexadecimal string form. Unlike H3, the grid is laid over the Web
+// Mercator projection, so cells shrink towards the poles, and the IDs are
+// not H3 IDs.
+//
+// The ID holds the resolution in its top 5 bits and the axial coordinates
+// of the hexagon in the 29 bits below each.
+type Cell uint64
+
+const (
+	cellAxisBits = 29
+	cellAxisMask = 1<<cellAxisBits - 1
+	cellAxisBias = 1 << (cellAxisBits - 1)
+)
+
+// CellAt returns the cell holding p at resolution res, clamped to
+// [0, MaxResolution].
+func CellAt(p Point, res int) Cell {
+	res = min(max(res, 0), MaxResolution)
+	x, y := mercator(p)
+	size := cellSize(res)
+
+	// Pointy-top axial coordinates, rounded through cube coordinates.
+	q := (math.Sqrt(3)/3*x - y/3) / size
+	r := (2.0 / 3 * y) / size
+	s := -q - r
+	rq, rr, rs := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(rq-q), math.Abs(rr-r), math.Abs(rs-s)
+	switch {
+	case dq > dr && dq > ds:
+		rq = -rr - rs
+	case dr > ds:
+		rr = -rq - rs
+	}
+
+	return newCell(res, int64(rq), int64(rr))
+}
+
+func newCell(res int, q, r int64) Cell {
+	return Cell(uint64(res)<<(2*cellAxisBits) |
+		uint64(q+cellAxisBias)&cellAxisMask<<cellAxisBits |
+		uint64(r+cellAxisBias)&cellAxisMask)
+}
+
+// ParseCell reads the hexadecimal form of a cell written by Cell.String.
+func ParseCell(s string) (Cell, error) {
+	v, err := strconv.ParseUint(s, 16, 64)
+	if err != nil || v>>(2*cellAxisBits) > MaxResolution {
+		return 0, fmt.Errorf("invalid cell %q", s)
+	}
+
+	return Cell(v), nil
+}
+
+// String returns the hexadecimal form of c.
+func (c Cell) String() string {
+	return strconv.FormatUint(uint64(c), 16)
+}
+
+// Resolution returns the resolution of c.
+func (c Cell) Resolution() int {
+	return int(c >> (2 * cellAxisBits))
+}
+
+func (c Cell) axial() (q, r int64) {
+	q = int64(c>>cellAxisBits&cellAxisMask) - cellAxisBias
+	r = int64(c&cellAxisMask) - cellAxisBias
+
+	return q, r
+}
+
+// Center returns the center of c.
+func (c Cell) Center() Point {
+	q, r := c.axial()
+	size := cellSize(c.Resolution())
+	x := size * (math.Sqrt(3)*float64(q) + math.Sqrt(3)/2*float64(r))
+	y := size * 1.5 * float64(r)
+
+	return unmercator(x, y)
+}
+
+// Boundary returns the six corners of c, clockwise from the top.
+func (c Cell) Boundary() []Point {
+	q, r := c.axial()
+	size := cellSize(c.Resolution())
+	cx := size * (math.Sqrt(3)*float64(q) + math.Sqrt(3)/2*float64(r))
+	cy := size * 1.5 * float64(r)
+
+	corners := make([]Point, 0, 6)
+	for i := range 6 {
+		angle := radians(float64(60*i - 90))
+		corners = append(corners, unmercator(cx+size*math.Cos(angle), cy+size*math.Sin(angle)))
+	}
+
+	return corners
+}
+
+// Parent returns the cell at the coarser resolution res holding the center
+// of c. Hexagons do not nest exactly, so a parent does not hold every point
+// of its children.
+func (c Cell) Parent(res int) Cell {
+	if res >= c.Resolution() {
+		return c
+	}
+
+	return CellAt(c.Center(), res)
+}
+
+// Neighbors returns the six cells sharing an edge with c.
+func (c Cell) Neighbors() []Cell {
+	q, r := c.axial()
+	res := c.Resolution()
+
+	neighbors := make([]Cell, 0, 6)
+	for _, d := range [][2]int64{{1, 0}, {1, -1}, {0, -1}, {-1, 0}, {-1, 1}, {0, 1}} {
+		neighbors = append(neighbors, newCell(res, q+d[0], r+d[1]))
+	}
+
+	return neighbors
+}
+
+// cellSize returns the circumradius of the cells of res, as a fraction of
+// the width of the projected world.
+func cellSize(res int) float64 {
+	return math.Ldexp(1, -(res + 3))
+}
+
+// mercator projects p onto the unit square, x growing east and y south.
+func mercator(p Point) (x, y float64) {
+	lat := radians(min(max(p.Lat, -maxMercatorLat), maxMercatorLat))
+	x = normalizeLng(p.Lng)/360 + 0.5
+	y = 0.5 - math.Log(math.Tan(math.Pi/4+lat/2))/(2*math.Pi)
+
+	return x, y
+}
+
+func unmercator(x, y float64) Point {
+	lat := degrees(2*math.Atan(math.Exp((0.5-y)*2*math.Pi)) - math.Pi/2)
+
+	return Point{Lat: lat, Lng: normalizeLng((x - 0.5) * 360)}
+}
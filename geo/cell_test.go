@@ -0,0 +1,47 @@
+package geo
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCell(t *testing.T) {
+	points := []Point{{Lat: 38.7223, Lng: -9.1393}, {Lat: -33.8688, Lng: 151.2093}, {Lat: 0, Lng: 179.999}, {Lat: 89, Lng: 0}}
+	for _, p := range points {
+		for _, res := range []int{0, 5, 12, MaxResolution} {
+			c := CellAt(p, res)
+			if c.Resolution() != res {
+				t.Errorf("CellAt(%v, %d).Resolution() = %d", p, res, c.Resolution())
+			}
+			if got, err := ParseCell(c.String()); err != nil || got != c {
+				t.Errorf("ParseCell(%q) = %v, %v, want %v", c.String(), got, err, c)
+			}
+			if got := CellAt(c.Center(), res); got != c {
+				t.Errorf("the center of %v is in %v", c, got)
+			}
+
+			neighbors := c.Neighbors()
+			for _, n := range neighbors {
+				if !slices.Contains(n.Neighbors(), c) {
+					t.Errorf("%v is a neighbor of %v, but not the other way around", n, c)
+				}
+			}
+			if slices.Contains(neighbors, c) {
+				t.Errorf("%v is its own neighbor", c)
+			}
+
+			if parent := c.Parent(res - 1); res > 0 && parent.Resolution() != res-1 {
+				t.Errorf("%v.Parent(%d) = %v", c, res-1, parent)
+			}
+		}
+	}
+
+	if got := CellAt(Point{}, MaxResolution+5).Resolution(); got != MaxResolution {
+		t.Errorf("CellAt() past MaxResolution has resolution %d", got)
+	}
+	for _, s := range []string{"", "xyz", "ffffffffffffffff"} {
+		if _, err := ParseCell(s); err == nil {
+			t.Errorf("ParseCell(%q) succeeded", s)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// EarthRadius is the mean radius of the Earth, in meters.
+const EarthRadius = 6371008.8
+
+// The WGS 84 ellipsoid Vincenty works on.
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+	wgs84B = wgs84A * (1 - wgs84F)
+)
+
+// ErrNoConvergence is returned by Vincenty for nearly antipodal points, for
+// which the formula does not converge.
+var ErrNoConvergence = errors.New("vincenty formula failed to converge")
+
+// Haversine returns the great-circle distance between a and b in meters,
+// taking the Earth for a sphere. It is off by up to 0.5% but cheap, which
+// makes it the one to sort and filter with.
+func Haversine(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(b.Lng - a.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Vincenty returns the distance between a and b in meters on the WGS 84
+// ellipsoid, accurate to within a millimeter.
+func Vincenty(a, b Point) (float64, error) {
+	l := radians(b.Lng - a.Lng)
+	u1 := math.Atan((1 - wgs84F) * math.Tan(radians(a.Lat)))
+	u2 := math.Atan((1 - wgs84F) * math.Tan(radians(b.Lat)))
+	sinU1, cosU1 := math.Sincos(u1)
+	sinU2, cosU2 := math.Sincos(u2)
+
+	lambda := l
+	for range 200 {
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma := math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			return 0, nil
+		}
+
+		cosSigma := sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma := math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cos2Alpha := 1 - sinAlpha*sinAlpha
+
+		// Both points lie on the equator.
+		cos2SigmaM := 0.0
+		if cos2Alpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cos2Alpha
+		}
+
+		c := wgs84F / 16 * cos2Alpha * (4 + wgs84F*(4-3*cos2Alpha))
+		prev := lambda
+		lambda = l + (1-c)*wgs84F*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-prev) > 1e-12 {
+			continue
+		}
+
+		uSq := cos2Alpha * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+		bigA := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+		bigB := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+		deltaSigma := bigB * sinSigma * (cos2SigmaM + bigB/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+			bigB/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+
+		return wgs84B * bigA * (sigma - deltaSigma), nil
+	}
+
+	return 0, ErrNoConvergence
+}
+
+// Bearing returns the initial bearing from a to b, in degrees clockwise
+// from north in [0, 360).
+func Bearing(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLng := radians(b.Lng - a.Lng)
+
+	y := math.Sin(dLng) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLng)
+
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+// Destination returns the point reached by travelling distance meters from
+// p along the great circle starting at bearing.
+func Destination(p Point, bearing, distance float64) Point {
+	lat1, lng1 := radians(p.Lat), radians(p.Lng)
+	theta := radians(bearing)
+	delta := distance / EarthRadius
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta))
+	lng2 := lng1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(lat1), math.Cos(delta)-math.Sin(lat1)*math.Sin(lat2))
+
+	return Point{Lat: degrees(lat2), Lng: normalizeLng(degrees(lng2))}
+}
+
+// distanceUnits maps the units upstreams write distances in to meters.
+var distanceUnits = map[string]float64{
+	"":           1,
+	"m":          1,
+	"meter":      1,
+	"meters":     1,
+	"metre":      1,
+	"metres":     1,
+	"km":         1000,
+	"kilometer":  1000,
+	"kilometers": 1000,
+	"kilometre":  1000,
+	"kilometres": 1000,
+	"mi":         1609.344,
+	"mile":       1609.344,
+	"miles":      1609.344,
+	"ft":         0.3048,
+	"feet":       0.3048,
+	"yd":         0.9144,
+	"yards":      0.9144,
+}
+
+// ParseDistance reads the free-form distance strings upstreams send in
+// POIDetailedInfo.distance, such as "350 m", "1.2km", "1,5 km" or
+// "0.3 miles", and returns the distance in meters. A bare number is taken
+// to be in meters.
+//
+// The number may have one decimal separator, either '.' or ','. Thousands
+// separators are not accepted, and a ',' followed by exactly three digits,
+// as in "1,200 m", is rejected rather than guessed at.
+func ParseDistance(s string) (float64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != ','
+	})
+	if end < 0 {
+		end = len(s)
+	}
+
+	number, unit := s[:end], strings.TrimSpace(s[end:])
+	switch i := strings.IndexAny(number, ".,"); {
+	case i < 0:
+	case strings.ContainsAny(number[i+1:], ".,"):
+		return 0, fmt.Errorf("invalid distance %q: more than one separator", s)
+	case number[i] == ',' && len(number)-i-1 == 3:
+		return 0, fmt.Errorf("invalid distance %q: ambiguous separator", s)
+	default:
+		number = number[:i] + "." + number[i+1:]
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid distance %q", s)
+	}
+
+	factor, ok := distanceUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid distance %q: unknown unit %q", s, unit)
+	}
+
+	return value * factor, nil
+}
+
+// FormatDistance writes meters the way the services do, e.g. "350 m" or
+// "1.2 km".
+func FormatDistance(meters float64) string {
+	if meters < 1000 {
+		return strconv.FormatFloat(math.Round(meters), 'f', -1, 64) + " m"
+	}
+
+	return strconv.FormatFloat(math.Round(meters/100)/10, 'f', -1, 64) + " km"
+}
@@ -0,0 +1,155 @@
+package geo
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func near(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func TestHaversine(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Point
+		want float64
+	}{
+		{name: "same point", a: Point{Lat: 38.72, Lng: -9.14}, b: Point{Lat: 38.72, Lng: -9.14}, want: 0},
+		{name: "degree of latitude", a: Point{}, b: Point{Lat: 1}, want: EarthRadius * math.Pi / 180},
+		{name: "degree of longitude at the equator", a: Point{}, b: Point{Lng: 1}, want: EarthRadius * math.Pi / 180},
+		{name: "across the antimeridian", a: Point{Lng: 179.5}, b: Point{Lng: -179.5}, want: EarthRadius * math.Pi / 180},
+		{name: "pole to pole", a: Point{Lat: 90}, b: Point{Lat: -90}, want: EarthRadius * math.Pi},
+		{name: "antipodes", a: Point{Lat: 10, Lng: 20}, b: Point{Lat: -10, Lng: -160}, want: EarthRadius * math.Pi},
+	}
+	for _, tt := range tests {
+		if got := Haversine(tt.a, tt.b); !near(got, tt.want, 1e-6) {
+			t.Errorf("%s: Haversine(%v, %v) = %v, want %v", tt.name, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestVincenty(t *testing.T) {
+	// The example of Vincenty's paper, from Flinders Peak to Buninyong.
+	got, err := Vincenty(Point{Lat: -37.95103341666667, Lng: 144.42486788888888}, Point{Lat: -37.65282113888889, Lng: 143.92649552777777})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(got, 54972.271, 1e-3) {
+		t.Errorf("Vincenty(Flinders Peak, Buninyong) = %v, want 54972.271", got)
+	}
+
+	if got, err := Vincenty(Point{Lat: 1, Lng: 2}, Point{Lat: 1, Lng: 2}); err != nil || got != 0 {
+		t.Errorf("Vincenty() of the same point = %v, %v, want 0", got, err)
+	}
+
+	// Along the equator the ellipsoid is a circle of radius wgs84A.
+	if got, err := Vincenty(Point{}, Point{Lng: 90}); err != nil || !near(got, wgs84A*math.Pi/2, 1e-3) {
+		t.Errorf("Vincenty() along the equator = %v, %v, want %v", got, err, wgs84A*math.Pi/2)
+	}
+
+	if _, err := Vincenty(Point{}, Point{Lat: 0.5, Lng: 179.7}); !errors.Is(err, ErrNoConvergence) {
+		t.Errorf("Vincenty() of nearly antipodal points error = %v, want %v", err, ErrNoConvergence)
+	}
+}
+
+func TestBearing(t *testing.T) {
+	tests := []struct {
+		b    Point
+		want float64
+	}{
+		{b: Point{Lat: 1}, want: 0},
+		{b: Point{Lng: 1}, want: 90},
+		{b: Point{Lat: -1}, want: 180},
+		{b: Point{Lng: -1}, want: 270},
+		{b: Point{Lng: -179}, want: 270},
+	}
+	for _, tt := range tests {
+		if got := Bearing(Point{}, tt.b); !near(got, tt.want, 1e-9) {
+			t.Errorf("Bearing(0,0 to %v) = %v, want %v", tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDestination(t *testing.T) {
+	points := []Point{
+		{Lat: 38.7223, Lng: -9.1393},
+		{Lat: -33.8688, Lng: 151.2093},
+		{Lat: 64.1466, Lng: -21.9426},
+		{Lat: 0, Lng: 179.9},
+	}
+	for _, from := range points {
+		for _, to := range points {
+			if from == to {
+				continue
+			}
+			got := Destination(from, Bearing(from, to), Haversine(from, to))
+			if Haversine(got, to) > 1e-3 {
+				t.Errorf("Destination(%v) towards %v reached %v", from, to, got)
+			}
+		}
+	}
+
+	if got := Destination(Point{Lng: 179.5}, 90, EarthRadius*math.Pi/180); !near(got.Lng, -179.5, 1e-9) {
+		t.Errorf("Destination() across the antimeridian = %v, want longitude -179.5", got)
+	}
+}
+
+func TestParseDistance(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    float64
+		wantErr bool
+	}{
+		{s: "350 m", want: 350},
+		{s: "350", want: 350},
+		{s: "1.2km", want: 1200},
+		{s: "1,5 km", want: 1500},
+		{s: "1,25 km", want: 1250},
+		{s: " 0.3 Miles ", want: 0.3 * 1609.344},
+		{s: "100 ft", want: 30.48},
+		{s: ".5 km", want: 500},
+		{s: "1.200 km", want: 1200},
+		{s: "1,200 m", wantErr: true},
+		{s: "1,200.5 m", wantErr: true},
+		{s: "1.2.3 km", wantErr: true},
+		{s: "1,2,3 km", wantErr: true},
+		{s: "km", wantErr: true},
+		{s: "", wantErr: true},
+		{s: "5 parsecs", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDistance(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDistance(%q) error = %v, want error %t", tt.s, err, tt.wantErr)
+			continue
+		}
+		if !near(got, tt.want, 1e-9) {
+			t.Errorf("ParseDistance(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestFormatDistance(t *testing.T) {
+	tests := []struct {
+		meters float64
+		want   string
+	}{
+		{meters: 0, want: "0 m"},
+		{meters: 349.6, want: "350 m"},
+		{meters: 999.4, want: "999 m"},
+		{meters: 1000, want: "1 km"},
+		{meters: 1249, want: "1.2 km"},
+		{meters: 1250, want: "1.3 km"},
+		{meters: 42195, want: "42.2 km"},
+	}
+	for _, tt := range tests {
+		if got := FormatDistance(tt.meters); got != tt.want {
+			t.Errorf("FormatDistance(%v) = %q, want %q", tt.meters, got, tt.want)
+		}
+		if got, err := ParseDistance(FormatDistance(tt.meters)); err != nil || !near(got, tt.meters, 50) {
+			t.Errorf("ParseDistance(FormatDistance(%v)) = %v, %v", tt.meters, got, err)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package geo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxGeohashPrecision is the longest geohash Geohash produces, about 4 cm
+// across.
+const MaxGeohashPrecision = 12
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Geohash returns the geohash of p with precision characters, clamped to
+// [1, MaxGeohashPrecision].
+func Geohash(p Point, precision int) string {
+	precision = min(max(precision, 1), MaxGeohashPrecision)
+
+	lat := [2]float64{-90, 90}
+	lng := [2]float64{-180, 180}
+
+	var sb strings.Builder
+	sb.Grow(precision)
+	even := true
+	bits, ch := 0, 0
+	for sb.Len() < precision {
+		if even {
+			ch = ch<<1 | bisect(&lng, p.Lng)
+		} else {
+			ch = ch<<1 | bisect(&lat, p.Lat)
+		}
+		even = !even
+
+		if bits++; bits == 5 {
+			sb.WriteByte(geohashAlphabet[ch])
+			bits, ch = 0, 0
+		}
+	}
+
+	return sb.String()
+}
+
+// bisect halves r around v and returns 1 when v lies in the upper half.
+func bisect(r *[2]float64, v float64) int {
+	mid := (r[0] + r[1]) / 2
+	if v >= mid {
+		r[0] = mid
+		return 1
+	}
+
+	r[1] = mid
+	return 0
+}
+
+// DecodeGeohash returns the cell described by hash.
+func DecodeGeohash(hash string) (Bounds, error) {
+	if hash == "" {
+		return Bounds{}, fmt.Errorf("empty geohash")
+	}
+
+	lat := [2]float64{-90, 90}
+	lng := [2]float64{-180, 180}
+	even := true
+	for i := range len(hash) {
+		ch := strings.IndexByte(geohashAlphabet, lowerASCII(hash[i]))
+		if ch < 0 {
+			return Bounds{}, fmt.Errorf("invalid geohash %q", hash)
+		}
+
+		for bit := 4; bit >= 0; bit-- {
+			r := &lat
+			if even {
+				r = &lng
+			}
+			mid := (r[0] + r[1]) / 2
+			if ch>>bit&1 == 1 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+
+	return Bounds{SW: Point{Lat: lat[0], Lng: lng[0]}, NE: Point{Lat: lat[1], Lng: lng[1]}}, nil
+}
+
+// GeohashNeighbors returns the geohashes of the eight cells around hash,
+// clockwise from north. Together with hash they cover every point within
+// half a cell of it, which makes them the keys to look up for a proximity
+// search.
+func GeohashNeighbors(hash string) ([]string, error) {
+	cell, err := DecodeGeohash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	center := cell.Center()
+	dLat := cell.NE.Lat - cell.SW.Lat
+	dLng := cell.NE.Lng - cell.SW.Lng
+
+	neighbors := make([]string, 0, 8)
+	for _, d := range [][2]float64{{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}, {0, -1}, {1, -1}} {
+		lat := center.Lat + d[0]*dLat
+		if lat > 90 || lat < -90 {
+			continue
+		}
+		neighbors = append(neighbors, Geohash(Point{Lat: lat, Lng: normalizeLng(center.Lng + d[1]*dLng)}, len(hash)))
+	}
+
+	return neighbors, nil
+}
+
+func lowerASCII(b byte) byte {
+	if b >= 'A' && b <= 'Z' {
+		return b + 'a' - 'A'
+	}
+
+	return b
+}
@@ -0,0 +1,79 @@
+package geo
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGeohash(t *testing.T) {
+	p := Point{Lat: 57.64911, Lng: 10.40744}
+	tests := []struct {
+		precision int
+		want      string
+	}{
+		{precision: 11, want: "u4pruydqqvj"},
+		{precision: 1, want: "u"},
+		{precision: 0, want: "u"},
+		{precision: 20, want: "u4pruydqqvj8"},
+	}
+	for _, tt := range tests {
+		if got := Geohash(p, tt.precision); got != tt.want {
+			t.Errorf("Geohash(%v, %d) = %q, want %q", p, tt.precision, got, tt.want)
+		}
+	}
+
+	for _, hash := range []string{"u4pruydqqvj", "U4PRUYDQQVJ"} {
+		cell, err := DecodeGeohash(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cell.Contains(p) {
+			t.Errorf("DecodeGeohash(%q) = %v, not holding %v", hash, cell, p)
+		}
+	}
+
+	for _, hash := range []string{"", "u4pa", "u4p!"} {
+		if _, err := DecodeGeohash(hash); err == nil {
+			t.Errorf("DecodeGeohash(%q) succeeded", hash)
+		}
+	}
+}
+
+func TestGeohashNeighbors(t *testing.T) {
+	const hash = "u4pruyd"
+	cell, err := DecodeGeohash(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	neighbors, err := GeohashNeighbors(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(neighbors) != 8 {
+		t.Fatalf("GeohashNeighbors(%s) = %v, want 8 cells", hash, neighbors)
+	}
+
+	// The offsets, in cells, of the neighbors clockwise from north.
+	offsets := [][2]float64{{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}, {0, -1}, {1, -1}}
+	dLat, dLng := cell.NE.Lat-cell.SW.Lat, cell.NE.Lng-cell.SW.Lng
+	for i, n := range neighbors {
+		if len(n) != len(hash) || slices.Contains(neighbors[:i], n) || n == hash {
+			t.Errorf("GeohashNeighbors(%s) = %v", hash, neighbors)
+			break
+		}
+
+		got, err := DecodeGeohash(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := Point{Lat: cell.SW.Lat + offsets[i][0]*dLat, Lng: cell.SW.Lng + offsets[i][1]*dLng}
+		if !near(got.SW.Lat, want.Lat, 1e-9) || !near(got.SW.Lng, want.Lng, 1e-9) {
+			t.Errorf("neighbor %d of %s is %s at %v, want its corner at %v", i, hash, n, got.SW, want)
+		}
+	}
+
+	// Cells along a pole have no neighbors beyond it.
+	if got, err := GeohashNeighbors("z"); err != nil || len(got) != 5 {
+		t.Errorf("GeohashNeighbors(z) = %v, %v, want 5 cells", got, err)
+	}
+}
@@ -0,0 +1,81 @@
+// Package geo provides the geometry the loci clients need around
+// common.Coordinates, common.GeoBounds and the other location messages:
+// distances, bearings, bounding boxes, geohashes, hexagonal cells and
+// encoded polylines.
+package geo
+
+import (
+	"math"
+
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	poi "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+	recents "github.com/FACorreiaa/loci-proto/modules/recents/generated"
+)
+
+// Point is a position on the WGS 84 ellipsoid, in degrees.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// LatLng is implemented by every message carrying a latitude and a
+// longitude, such as common.Coordinates, poi.GeoPoint, recents.GeoLocation,
+// chat.POIReference and the POIDetailedInfo messages.
+type LatLng interface {
+	GetLatitude() float64
+	GetLongitude() float64
+}
+
+// PointOf returns the position of m. A nil m is the zero Point.
+func PointOf(m LatLng) Point {
+	if m == nil {
+		return Point{}
+	}
+
+	return Point{Lat: m.GetLatitude(), Lng: m.GetLongitude()}
+}
+
+// Valid reports whether p lies within the ranges of latitude and longitude.
+func (p Point) Valid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lng >= -180 && p.Lng <= 180 &&
+		!math.IsNaN(p.Lat) && !math.IsNaN(p.Lng)
+}
+
+// Coordinates returns p as a common.Coordinates.
+func (p Point) Coordinates() *common.Coordinates {
+	return &common.Coordinates{Latitude: p.Lat, Longitude: p.Lng}
+}
+
+// GeoPoint returns p as a poi.GeoPoint.
+func (p Point) GeoPoint() *poi.GeoPoint {
+	return &poi.GeoPoint{Latitude: p.Lat, Longitude: p.Lng}
+}
+
+// GeoLocation returns p as a recents.GeoLocation.
+func (p Point) GeoLocation() *recents.GeoLocation {
+	return &recents.GeoLocation{Latitude: p.Lat, Longitude: p.Lng}
+}
+
+// SetPOIReference moves ref to p.
+func (p Point) SetPOIReference(ref *chat.POIReference) {
+	ref.Latitude, ref.Longitude = p.Lat, p.Lng
+}
+
+// normalizeLng wraps lng into [-180, 180).
+func normalizeLng(lng float64) float64 {
+	lng = math.Mod(lng+180, 360)
+	if lng < 0 {
+		lng += 360
+	}
+
+	return lng - 180
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
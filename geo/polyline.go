@@ -0,0 +1,86 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// polylinePrecision is the number of decimals of the encoded polyline
+// format, as used by Google and OSRM.
+const polylinePrecision = 1e5
+
+// EncodePolyline returns points in the encoded polyline format.
+func EncodePolyline(points []Point) string {
+	var sb strings.Builder
+	var prevLat, prevLng int64
+	for _, p := range points {
+		lat := int64(math.Round(p.Lat * polylinePrecision))
+		lng := int64(math.Round(p.Lng * polylinePrecision))
+		writePolylineValue(&sb, lat-prevLat)
+		writePolylineValue(&sb, lng-prevLng)
+		prevLat, prevLng = lat, lng
+	}
+
+	return sb.String()
+}
+
+func writePolylineValue(sb *strings.Builder, v int64) {
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+
+	for u >= 0x20 {
+		sb.WriteByte(byte(0x20|u&0x1f) + 63)
+		u >>= 5
+	}
+	sb.WriteByte(byte(u) + 63)
+}
+
+// DecodePolyline reads the points of an encoded polyline.
+func DecodePolyline(s string) ([]Point, error) {
+	var points []Point
+	var lat, lng int64
+	for i := 0; i < len(s); {
+		dLat, n, err := readPolylineValue(s[i:])
+		if err != nil {
+			return nil, err
+		}
+		i += n
+
+		dLng, n, err := readPolylineValue(s[i:])
+		if err != nil {
+			return nil, err
+		}
+		i += n
+
+		lat, lng = lat+dLat, lng+dLng
+		points = append(points, Point{Lat: float64(lat) / polylinePrecision, Lng: float64(lng) / polylinePrecision})
+	}
+
+	return points, nil
+}
+
+func readPolylineValue(s string) (int64, int, error) {
+	var u uint64
+	for i, shift := 0, 0; i < len(s) && shift < 64; i, shift = i+1, shift+5 {
+		b := s[i]
+		if b < 63 || b > 127 {
+			return 0, 0, fmt.Errorf("invalid polyline character %q", b)
+		}
+
+		chunk := uint64(b - 63)
+		u |= chunk & 0x1f << shift
+		if chunk < 0x20 {
+			v := int64(u >> 1)
+			if u&1 == 1 {
+				v = ^v
+			}
+
+			return v, i + 1, nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("truncated polyline")
+}
@@ -0,0 +1,37 @@
+package geo
+
+import (
+	"testing"
+)
+
+func TestPolyline(t *testing.T) {
+	// The example of the format's documentation.
+	points := []Point{{Lat: 38.5, Lng: -120.2}, {Lat: 40.7, Lng: -120.95}, {Lat: 43.252, Lng: -126.453}}
+	const encoded = "_p~iF~ps|U_ulLnnqC_mqNvxq`@"
+
+	if got := EncodePolyline(points); got != encoded {
+		t.Errorf("EncodePolyline() = %q, want %q", got, encoded)
+	}
+
+	got, err := DecodePolyline(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(points) {
+		t.Fatalf("DecodePolyline() = %v, want %v", got, points)
+	}
+	for i := range points {
+		if !near(got[i].Lat, points[i].Lat, 1e-9) || !near(got[i].Lng, points[i].Lng, 1e-9) {
+			t.Errorf("DecodePolyline() = %v, want %v", got, points)
+		}
+	}
+
+	if got, err := DecodePolyline(""); err != nil || len(got) != 0 {
+		t.Errorf("DecodePolyline(\"\") = %v, %v", got, err)
+	}
+	for _, s := range []string{"_p~iF~ps|", "_p~iF", "_p~iF~ps|U ", "\x01"} {
+		if _, err := DecodePolyline(s); err == nil {
+			t.Errorf("DecodePolyline(%q) succeeded", s)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package hours
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// dayNames maps the names upstreams write days in, full, abbreviated and in
+// the two letter form of OpenStreetMap, to the days.
+var dayNames = func() map[string]common.DayOfWeek {
+	m := make(map[string]common.DayOfWeek)
+	for d, name := range []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"} {
+		day := common.DayOfWeek(d + 1)
+		m[name] = day
+		m[name[:2]] = day
+		m[name[:3]] = day
+	}
+	m["tues"] = common.DayOfWeek_DAY_OF_WEEK_TUESDAY
+	m["thur"] = common.DayOfWeek_DAY_OF_WEEK_THURSDAY
+	m["thurs"] = common.DayOfWeek_DAY_OF_WEEK_THURSDAY
+
+	return m
+}()
+
+// everyDay are the words standing for the whole week.
+var everyDay = map[string]bool{
+	"daily":     true,
+	"everyday":  true,
+	"every day": true,
+	"all week":  true,
+	"mo-su":     true,
+}
+
+// Parse reads the opening hours of the plain strings the POIDetailedInfo
+// messages carry, one or more days per string, such as:
+//
+//	Monday: 09:00-17:00
+//	Tuesday: 9:00 AM – 12:00 PM, 1:00 – 5:00 PM
+//	Sat-Sun: Closed
+//	Mo-Fr 08:00-18:00; Sa 10:00-14:00
+//	Daily: Open 24 hours
+//	24/7
+//
+// Days that are not mentioned are left out of the schedule, and so are
+// closed. The result has no timezone; set it before compiling the hours
+// with New if the times are not in UTC.
+func Parse(lines []string) (*common.OpeningHours, error) {
+	var days [8]*common.DaySchedule
+	h := new(common.OpeningHours)
+	for _, line := range lines {
+		for _, rule := range strings.Split(normalize(line), ";") {
+			rule = strings.TrimSpace(rule)
+			if rule == "" {
+				continue
+			}
+			if rule == "24/7" {
+				h.Is_24_7 = true
+				continue
+			}
+
+			dayPart, timePart := splitRule(rule)
+			which, err := parseDays(dayPart)
+			if err != nil {
+				return nil, fmt.Errorf("invalid opening hours %q: %w", line, err)
+			}
+
+			slots, closed, err := parseSlots(timePart)
+			if err != nil {
+				return nil, fmt.Errorf("invalid opening hours %q: %w", line, err)
+			}
+
+			for _, d := range which {
+				if days[d] == nil {
+					days[d] = &common.DaySchedule{Day: d}
+				}
+				if closed {
+					days[d].IsClosed, days[d].TimeSlots = true, nil
+					continue
+				}
+				days[d].IsClosed = false
+				days[d].TimeSlots = append(days[d].TimeSlots, slots...)
+			}
+		}
+	}
+
+	for _, d := range days {
+		if d != nil {
+			h.Schedule = append(h.Schedule, d)
+		}
+	}
+
+	return h, nil
+}
+
+// normalize lower-cases s and replaces the dashes and spaces of the
+// formats upstreams use, such as "9:00 AM – 5:00 PM", with plain
+// ones.
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '–' || r == '—' || r == '‒' || r == '−':
+			return '-'
+		case unicode.IsSpace(r):
+			return ' '
+		default:
+			return unicode.ToLower(r)
+		}
+	}, s)
+}
+
+// splitRule splits rule into the days it is for and their hours. The days
+// end at the first colon followed by a space or at the first digit or
+// keyword, whichever comes first.
+func splitRule(rule string) (string, string) {
+	end := len(rule)
+	if i := strings.IndexFunc(rule, unicode.IsDigit); i >= 0 {
+		end = i
+	}
+	for _, keyword := range []string{"closed", "open"} {
+		if i := strings.Index(rule, keyword); i >= 0 && i < end {
+			end = i
+		}
+	}
+	if i := strings.Index(rule, ": "); i >= 0 && i < end {
+		end = i
+	}
+
+	return strings.Trim(rule[:end], " :"), strings.TrimSpace(strings.TrimPrefix(rule[end:], ":"))
+}
+
+func parseDays(s string) ([]common.DayOfWeek, error) {
+	if s == "" || everyDay[s] {
+		return allDays(), nil
+	}
+
+	var days []common.DayOfWeek
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if everyDay[part] {
+			return allDays(), nil
+		}
+
+		from, to, isRange := strings.Cut(part, "-")
+		if !isRange {
+			from, to, isRange = strings.Cut(part, " to ")
+		}
+
+		first, err := parseDay(from)
+		if err != nil {
+			return nil, err
+		}
+		if !isRange {
+			days = append(days, first)
+			continue
+		}
+
+		last, err := parseDay(to)
+		if err != nil {
+			return nil, err
+		}
+
+		// Ranges such as "Fri-Mon" wrap around the end of the week.
+		for d := first; ; d = d%7 + 1 {
+			days = append(days, d)
+			if d == last {
+				break
+			}
+		}
+	}
+
+	return days, nil
+}
+
+func parseDay(s string) (common.DayOfWeek, error) {
+	if d, ok := dayNames[strings.TrimSuffix(strings.TrimSpace(s), ".")]; ok {
+		return d, nil
+	}
+
+	return 0, fmt.Errorf("unknown day %q", strings.TrimSpace(s))
+}
+
+func allDays() []common.DayOfWeek {
+	days := make([]common.DayOfWeek, 0, 7)
+	for d := common.DayOfWeek_DAY_OF_WEEK_MONDAY; d <= common.DayOfWeek_DAY_OF_WEEK_SUNDAY; d++ {
+		days = append(days, d)
+	}
+
+	return days
+}
+
+// parseSlots reads a comma-separated list of time ranges, or "closed", or
+// one of the ways of saying a place never closes.
+func parseSlots(s string) ([]*common.TimeSlot, bool, error) {
+	switch s {
+	case "closed", "off":
+		return nil, true, nil
+	case "open 24 hours", "24 hours", "open 24h", "24h":
+		return []*common.TimeSlot{{OpenTime: "00:00", CloseTime: "24:00"}}, false, nil
+	}
+
+	var slots []*common.TimeSlot
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		from, to, ok := strings.Cut(part, "-")
+		if !ok {
+			from, to, ok = strings.Cut(part, " to ")
+		}
+		if !ok {
+			return nil, false, fmt.Errorf("invalid time range %q", part)
+		}
+
+		open, openMeridiem, err := parseTime(from)
+		if err != nil {
+			return nil, false, err
+		}
+
+		closeAt, closeMeridiem, err := parseTime(to)
+		if err != nil {
+			return nil, false, err
+		}
+
+		// "9-11 am" gives the meridiem once for both times.
+		if openMeridiem == "" && closeMeridiem != "" {
+			if withMeridiem := applyMeridiem(open, closeMeridiem); withMeridiem <= closeAt {
+				open = withMeridiem
+			}
+		}
+
+		slots = append(slots, &common.TimeSlot{OpenTime: formatClock(open), CloseTime: formatClock(closeAt)})
+	}
+
+	if len(slots) == 0 {
+		return nil, false, fmt.Errorf("no hours in %q", s)
+	}
+
+	return slots, false, nil
+}
+
+// parseTime reads a time such as "9", "09:00", "9.30", "9am" or "5:00 pm"
+// into minutes since midnight. It also returns the meridiem, if any, so a
+// missing one can be taken from the other end of the range.
+func parseTime(s string) (int, string, error) {
+	s = strings.TrimSpace(s)
+	meridiem := ""
+	for _, m := range []string{"am", "pm", "a.m.", "p.m."} {
+		if rest, ok := strings.CutSuffix(s, m); ok {
+			s, meridiem = strings.TrimSpace(rest), m[:1]+"m"
+			break
+		}
+	}
+
+	hh, mm, hasMinutes := strings.Cut(s, ":")
+	if !hasMinutes {
+		hh, mm, hasMinutes = strings.Cut(s, ".")
+	}
+	if !hasMinutes {
+		mm = "0"
+	}
+
+	h, err := strconv.Atoi(hh)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid time %q", s)
+	}
+	m, err := strconv.Atoi(mm)
+	if err != nil || m < 0 || m > 59 || h < 0 || h > 24 || (meridiem != "" && (h < 1 || h > 12)) {
+		return 0, "", fmt.Errorf("invalid time %q", s)
+	}
+
+	minutes := h*60 + m
+	if minutes > 24*60 {
+		return 0, "", fmt.Errorf("invalid time %q", s)
+	}
+
+	return applyMeridiem(minutes, meridiem), meridiem, nil
+}
+
+func applyMeridiem(minutes int, meridiem string) int {
+	switch {
+	case meridiem == "am" && minutes >= 12*60:
+		return minutes - 12*60
+	case meridiem == "pm" && minutes < 12*60:
+		return minutes + 12*60
+	default:
+		return minutes
+	}
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
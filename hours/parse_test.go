@@ -0,0 +1,75 @@
+package hours
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// describe writes h as one "Mon 09:00-17:00" line per day, for comparing.
+func describe(h *common.OpeningHours) []string {
+	var out []string
+	if h.GetIs_24_7() {
+		out = append(out, "24/7")
+	}
+	for _, d := range h.GetSchedule() {
+		line := Weekday(d.GetDay()).String()[:3]
+		if d.GetIsClosed() {
+			line += " closed"
+		}
+		for _, ts := range d.GetTimeSlots() {
+			line += " " + ts.GetOpenTime() + "-" + ts.GetCloseTime()
+		}
+		out = append(out, line)
+	}
+
+	return out
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		lines []string
+		want  []string
+	}{
+		{lines: []string{"Monday: 09:00-17:00"}, want: []string{"Mon 09:00-17:00"}},
+		{lines: []string{"Tuesday: 9:00 AM – 12:00 PM, 1:00 – 5:00 PM"}, want: []string{"Tue 09:00-12:00 13:00-17:00"}},
+		{lines: []string{"Sat-Sun: Closed"}, want: []string{"Sat closed", "Sun closed"}},
+		{
+			lines: []string{"Mo-Fr 08:00-18:00; Sa 10:00-14:00"},
+			want:  []string{"Mon 08:00-18:00", "Tue 08:00-18:00", "Wed 08:00-18:00", "Thu 08:00-18:00", "Fri 08:00-18:00", "Sat 10:00-14:00"},
+		},
+		{
+			lines: []string{"Daily: Open 24 hours"},
+			want:  []string{"Mon 00:00-24:00", "Tue 00:00-24:00", "Wed 00:00-24:00", "Thu 00:00-24:00", "Fri 00:00-24:00", "Sat 00:00-24:00", "Sun 00:00-24:00"},
+		},
+		{lines: []string{"24/7"}, want: []string{"24/7"}},
+		{lines: []string{"Fri-Mon 9-11 am"}, want: []string{"Mon 09:00-11:00", "Fri 09:00-11:00", "Sat 09:00-11:00", "Sun 09:00-11:00"}},
+		{lines: []string{"Thurs: 10pm-2am"}, want: []string{"Thu 22:00-02:00"}},
+		{lines: []string{"Wed: 12 PM - 12 AM"}, want: []string{"Wed 12:00-00:00"}},
+		{lines: []string{"Mon, Wed: 9.30-13.00"}, want: []string{"Mon 09:30-13:00", "Wed 09:30-13:00"}},
+		{lines: []string{"Monday: 09:00-12:00", "Monday: 14:00-18:00"}, want: []string{"Mon 09:00-12:00 14:00-18:00"}},
+		{lines: []string{"Monday: 09:00-12:00", "Monday: closed"}, want: []string{"Mon closed"}},
+		{lines: nil, want: nil},
+	}
+	for _, tt := range tests {
+		h, err := Parse(tt.lines)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.lines, err)
+			continue
+		}
+		if got := describe(h); !slices.Equal(got, tt.want) {
+			t.Errorf("Parse(%q) = %q, want %q", tt.lines, got, tt.want)
+		}
+		if _, err := New(h); err != nil {
+			t.Errorf("New(Parse(%q)) error = %v", tt.lines, err)
+		}
+	}
+
+	for _, line := range []string{"Funday: 9-5", "Monday: 9", "Monday: 13pm-2pm", "Monday: 25:00-26:00", "Monday: 9:75-10:00", "Monday:"} {
+		if h, err := Parse([]string{line}); err == nil {
+			t.Errorf("Parse(%q) = %s, want an error", line, strings.Join(describe(h), "; "))
+		}
+	}
+}
@@ -0,0 +1,367 @@
+// Package hours answers questions about common.OpeningHours: whether a
+// place is open at a given time, when it next opens or closes, and whether
+// it stays open over an interval. Times are worked out in the IANA timezone
+// of the opening hours, so overnight slots and DST transitions come out
+// right, and SpecialHours override the weekly schedule on their date.
+package hours
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"time"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// Horizon is how far ahead Schedule looks for the next change. A place
+// whose hours do not change within it is taken to never change.
+const Horizon = 366 * 24 * time.Hour
+
+// slot is a time slot in minutes since midnight. close is past 24*60 for
+// slots running past midnight.
+type slot struct {
+	open, close int
+}
+
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dateOf(t time.Time) date {
+	y, m, d := t.Date()
+	return date{y, m, d}
+}
+
+// Schedule is a compiled common.OpeningHours. It is safe for concurrent
+// use.
+type Schedule struct {
+	loc      *time.Location
+	always   bool
+	never    bool
+	weekly   [7][]slot // Indexed by time.Weekday
+	specials map[date][]slot
+}
+
+// New compiles h. Opening hours without a timezone are read in UTC.
+func New(h *common.OpeningHours) (*Schedule, error) {
+	loc := time.UTC
+	if tz := h.GetTimezone(); tz != "" {
+		var err error
+		if loc, err = time.LoadLocation(tz); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", tz, err)
+		}
+	}
+
+	s := &Schedule{
+		loc:      loc,
+		always:   h.GetIs_24_7(),
+		never:    h.GetIsClosed(),
+		specials: make(map[date][]slot),
+	}
+
+	for _, day := range h.GetSchedule() {
+		if day.GetDay() < common.DayOfWeek_DAY_OF_WEEK_MONDAY || day.GetDay() > common.DayOfWeek_DAY_OF_WEEK_SUNDAY {
+			return nil, fmt.Errorf("invalid day of week %v", day.GetDay())
+		}
+		if day.GetIsClosed() {
+			continue
+		}
+
+		slots, err := compileSlots(day.GetTimeSlots())
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", day.GetDay(), err)
+		}
+
+		wd := Weekday(day.GetDay())
+		s.weekly[wd] = sortSlots(append(s.weekly[wd], slots...))
+	}
+
+	// The date of a SpecialHours is a calendar date, read in UTC, as the
+	// services store midnight UTC for it.
+	for _, special := range h.GetSpecialHours() {
+		d := dateOf(special.GetDate().AsTime())
+		if special.GetIsClosed() {
+			s.specials[d] = nil
+			continue
+		}
+
+		slots, err := compileSlots(special.GetTimeSlots())
+		if err != nil {
+			return nil, fmt.Errorf("special hours on %d-%02d-%02d: %w", d.year, d.month, d.day, err)
+		}
+		s.specials[d] = sortSlots(append(s.specials[d], slots...))
+	}
+
+	return s, nil
+}
+
+// ForPOI compiles the opening hours of p, parsing its opening_hours_text
+// when it has no structured opening hours.
+func ForPOI(p *common.POI) (*Schedule, error) {
+	if h := p.GetOpeningHours(); h != nil {
+		return New(h)
+	}
+
+	h, err := Parse(p.GetOpeningHoursText())
+	if err != nil {
+		return nil, err
+	}
+
+	return New(h)
+}
+
+// FilterOpen returns the POIs open at t, which is how a client honours
+// FilterOptions.open_now for results it filters itself. POIs whose hours
+// are unknown or cannot be read are left out.
+func FilterOpen(pois []*common.POI, t time.Time) []*common.POI {
+	var open []*common.POI
+	for _, p := range pois {
+		if p.GetOpeningHours() == nil && len(p.GetOpeningHoursText()) == 0 {
+			continue
+		}
+
+		if s, err := ForPOI(p); err == nil && s.IsOpen(t) {
+			open = append(open, p)
+		}
+	}
+
+	return open
+}
+
+// Location returns the timezone s works in.
+func (s *Schedule) Location() *time.Location {
+	return s.loc
+}
+
+// IsOpen reports whether the place is open at t.
+func (s *Schedule) IsOpen(t time.Time) bool {
+	_, ok := s.spanAt(t)
+
+	return ok
+}
+
+// NextChange returns when the place next opens, if it is closed at t, or
+// closes, if it is open. It reports false when that does not happen within
+// the Horizon.
+func (s *Schedule) NextChange(t time.Time) (time.Time, bool) {
+	for sp := range s.spans(t) {
+		if !sp.end.After(t) {
+			continue
+		}
+		if sp.start.After(t) {
+			return sp.start, true
+		}
+		if sp.unbounded {
+			return time.Time{}, false
+		}
+
+		return sp.end, true
+	}
+
+	return time.Time{}, false
+}
+
+// NextOpen returns when the place next opens after t. A place that is open
+// at t only reopens after it has closed.
+func (s *Schedule) NextOpen(t time.Time) (time.Time, bool) {
+	for sp := range s.spans(t) {
+		if sp.start.After(t) {
+			return sp.start, true
+		}
+		if sp.unbounded {
+			break
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// NextClose returns when the place next closes after t, whether it is open
+// at t or opens later.
+func (s *Schedule) NextClose(t time.Time) (time.Time, bool) {
+	for sp := range s.spans(t) {
+		if !sp.end.After(t) {
+			continue
+		}
+		if sp.unbounded {
+			break
+		}
+
+		return sp.end, true
+	}
+
+	return time.Time{}, false
+}
+
+// IsOpenBetween reports whether the place is open for the whole of [a, b).
+func (s *Schedule) IsOpenBetween(a, b time.Time) bool {
+	if !b.After(a) {
+		return s.IsOpen(a)
+	}
+
+	sp, ok := s.spanAt(a)
+
+	return ok && (sp.unbounded || !sp.end.Before(b))
+}
+
+// span is a stretch of time the place is open for. Slots that touch or
+// overlap, such as the two halves of a slot split at midnight, make up a
+// single span. A span running to the Horizon is unbounded.
+type span struct {
+	start, end time.Time
+	unbounded  bool
+}
+
+func (s *Schedule) spanAt(t time.Time) (span, bool) {
+	for sp := range s.spans(t) {
+		if sp.start.After(t) {
+			break
+		}
+		if sp.end.After(t) {
+			return sp, true
+		}
+	}
+
+	return span{}, false
+}
+
+// spans yields the spans of s in order, starting with the ones that may
+// hold t and stopping at the Horizon.
+func (s *Schedule) spans(t time.Time) iter.Seq[span] {
+	return func(yield func(span) bool) {
+		if s.never {
+			return
+		}
+
+		t = t.In(s.loc)
+		end := t.Add(Horizon)
+
+		// Slots run past midnight by less than a day, so the day before t
+		// is the earliest one that can hold it.
+		y, m, d := t.Date()
+		day := time.Date(y, m, d-1, 0, 0, 0, 0, s.loc)
+
+		if s.always {
+			yield(span{start: day, end: end, unbounded: true})
+			return
+		}
+
+		var cur span
+		open := false
+		for !day.After(end) {
+			for _, sl := range s.slots(day) {
+				next := span{start: at(day, sl.open), end: at(day, sl.close)}
+				switch {
+				case !open:
+					cur, open = next, true
+				case !next.start.After(cur.end):
+					if next.end.After(cur.end) {
+						cur.end = next.end
+					}
+				default:
+					if !yield(cur) {
+						return
+					}
+					cur = next
+				}
+			}
+
+			y, m, d := day.Date()
+			day = time.Date(y, m, d+1, 0, 0, 0, 0, s.loc)
+		}
+
+		if open {
+			cur.unbounded = !cur.end.Before(day)
+			yield(cur)
+		}
+	}
+}
+
+// slots returns the slots of the local date day.
+func (s *Schedule) slots(day time.Time) []slot {
+	if slots, ok := s.specials[dateOf(day)]; ok {
+		return slots
+	}
+
+	return s.weekly[day.Weekday()]
+}
+
+// at returns the time minutes after the midnight of day, in wall clock
+// time: on DST transitions, 09:00 is still 09:00.
+func at(day time.Time, minutes int) time.Time {
+	y, m, d := day.Date()
+
+	return time.Date(y, m, d, minutes/60, minutes%60, 0, 0, day.Location())
+}
+
+func compileSlots(slots []*common.TimeSlot) ([]slot, error) {
+	out := make([]slot, 0, len(slots))
+	for _, ts := range slots {
+		open, err := parseClock(ts.GetOpenTime())
+		if err != nil {
+			return nil, err
+		}
+
+		closeAt, err := parseClock(ts.GetCloseTime())
+		if err != nil {
+			return nil, err
+		}
+
+		// A slot closing at or before it opens runs past midnight, and
+		// one opening and closing at midnight lasts the whole day.
+		if closeAt <= open {
+			closeAt += 24 * 60
+		}
+		out = append(out, slot{open: open, close: closeAt})
+	}
+
+	return out, nil
+}
+
+func sortSlots(slots []slot) []slot {
+	slices.SortFunc(slots, func(a, b slot) int { return a.open - b.open })
+
+	return slots
+}
+
+// parseClock reads an HH:MM time into minutes since midnight. "24:00" is
+// the end of the day.
+func parseClock(s string) (int, error) {
+	if len(s) != 5 || s[2] != ':' || !isDigits(s[:2]) || !isDigits(s[3:]) {
+		return 0, fmt.Errorf("invalid time %q, want HH:MM", s)
+	}
+
+	h, m := int(s[0]-'0')*10+int(s[1]-'0'), int(s[3]-'0')*10+int(s[4]-'0')
+	if h > 24 || m > 59 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("invalid time %q, want HH:MM", s)
+	}
+
+	return h*60 + m, nil
+}
+
+func isDigits(s string) bool {
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Weekday converts a common.DayOfWeek to a time.Weekday.
+func Weekday(d common.DayOfWeek) time.Weekday {
+	return time.Weekday(d % 7)
+}
+
+// DayOfWeek converts a time.Weekday to a common.DayOfWeek.
+func DayOfWeek(d time.Weekday) common.DayOfWeek {
+	if d == time.Sunday {
+		return common.DayOfWeek_DAY_OF_WEEK_SUNDAY
+	}
+
+	return common.DayOfWeek(d)
+}
@@ -0,0 +1,261 @@
+package hours
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+func day(d common.DayOfWeek, slots ...string) *common.DaySchedule {
+	s := &common.DaySchedule{Day: d}
+	for i := 0; i < len(slots); i += 2 {
+		s.TimeSlots = append(s.TimeSlots, &common.TimeSlot{OpenTime: slots[i], CloseTime: slots[i+1]})
+	}
+
+	return s
+}
+
+func mustNew(t *testing.T, h *common.OpeningHours) *Schedule {
+	t.Helper()
+
+	s, err := New(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+// local reads a "2006-01-02 15:04" wall clock time in the location of s.
+func local(t *testing.T, s *Schedule, value string) time.Time {
+	t.Helper()
+
+	tm, err := time.ParseInLocation("2006-01-02 15:04", value, s.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tm
+}
+
+func utc(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	tm, err := time.Parse("2006-01-02 15:04", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tm
+}
+
+// checkAt checks whether s is open at, and when it next changes.
+func checkAt(t *testing.T, s *Schedule, at time.Time, wantOpen bool, wantNext time.Time) {
+	t.Helper()
+
+	if got := s.IsOpen(at); got != wantOpen {
+		t.Errorf("IsOpen(%v) = %t, want %t", at, got, wantOpen)
+	}
+	if got, ok := s.NextChange(at); !ok || !got.Equal(wantNext) {
+		t.Errorf("NextChange(%v) = %v, %t, want %v", at, got, ok, wantNext)
+	}
+}
+
+func TestScheduleOvernight(t *testing.T) {
+	// A bar open late on Fridays, from Saturday evening into Sunday
+	// morning, which is split at midnight, and late on Sundays.
+	s := mustNew(t, &common.OpeningHours{
+		Timezone: "Europe/Lisbon",
+		Schedule: []*common.DaySchedule{
+			day(common.DayOfWeek_DAY_OF_WEEK_FRIDAY, "22:00", "02:00"),
+			day(common.DayOfWeek_DAY_OF_WEEK_SATURDAY, "18:00", "24:00"),
+			day(common.DayOfWeek_DAY_OF_WEEK_SUNDAY, "00:00", "03:00", "22:00", "01:00"),
+		},
+	})
+
+	tests := []struct {
+		at, next string
+		open     bool
+	}{
+		{at: "2026-10-15 12:00", next: "2026-10-16 22:00"},
+		{at: "2026-10-16 21:59", next: "2026-10-16 22:00"},
+		{at: "2026-10-16 22:00", next: "2026-10-17 02:00", open: true},
+		{at: "2026-10-17 01:30", next: "2026-10-17 02:00", open: true},
+		{at: "2026-10-17 02:00", next: "2026-10-17 18:00"},
+		{at: "2026-10-17 23:00", next: "2026-10-18 03:00", open: true},
+		{at: "2026-10-18 00:00", next: "2026-10-18 03:00", open: true},
+		{at: "2026-10-18 12:00", next: "2026-10-18 22:00"},
+		// Past the end of the week, into Monday.
+		{at: "2026-10-19 00:30", next: "2026-10-19 01:00", open: true},
+		{at: "2026-10-19 01:00", next: "2026-10-23 22:00"},
+	}
+	for _, tt := range tests {
+		checkAt(t, s, local(t, s, tt.at), tt.open, local(t, s, tt.next))
+	}
+
+	for _, tt := range []struct {
+		a, b string
+		want bool
+	}{
+		{a: "2026-10-17 19:00", b: "2026-10-18 02:59", want: true},
+		{a: "2026-10-17 19:00", b: "2026-10-18 03:01", want: false},
+		{a: "2026-10-16 23:00", b: "2026-10-17 03:00", want: false},
+		{a: "2026-10-17 12:00", b: "2026-10-17 19:00", want: false},
+	} {
+		if got := s.IsOpenBetween(local(t, s, tt.a), local(t, s, tt.b)); got != tt.want {
+			t.Errorf("IsOpenBetween(%s, %s) = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	if got, _ := s.NextOpen(local(t, s, "2026-10-16 23:00")); !got.Equal(local(t, s, "2026-10-17 18:00")) {
+		t.Errorf("NextOpen() while open = %v, want the next opening", got)
+	}
+	if got, _ := s.NextClose(local(t, s, "2026-10-17 12:00")); !got.Equal(local(t, s, "2026-10-18 03:00")) {
+		t.Errorf("NextClose() while closed = %v, want the end of the next span", got)
+	}
+
+	// The same instant, seen from another timezone.
+	if !s.IsOpen(utc(t, "2026-10-16 22:30")) || s.IsOpen(utc(t, "2026-10-16 20:30")) {
+		t.Error("IsOpen() depends on the location of its argument")
+	}
+}
+
+func TestScheduleDST(t *testing.T) {
+	// Open from midnight to 03:00, and in the day, every day.
+	var week []*common.DaySchedule
+	for d := common.DayOfWeek_DAY_OF_WEEK_MONDAY; d <= common.DayOfWeek_DAY_OF_WEEK_SUNDAY; d++ {
+		week = append(week, day(d, "00:00", "03:00", "09:00", "17:00"))
+	}
+	s := mustNew(t, &common.OpeningHours{Timezone: "Europe/Lisbon", Schedule: week})
+
+	tests := []struct {
+		name     string
+		at, next string
+		open     bool
+	}{
+		// On 2026-03-29 Lisbon moves from UTC to UTC+1 at 01:00 UTC, so the
+		// night slot lasts two hours.
+		{name: "before spring forward", at: "2026-03-29 00:30", next: "2026-03-29 02:00", open: true},
+		{name: "after spring forward", at: "2026-03-29 01:30", next: "2026-03-29 02:00", open: true},
+		{name: "opening after spring forward", at: "2026-03-29 07:30", next: "2026-03-29 08:00"},
+		{name: "opening before spring forward", at: "2026-03-28 08:30", next: "2026-03-28 09:00"},
+		// On 2026-10-25 it moves back at 01:00 UTC, so the night slot
+		// lasts four hours.
+		{name: "before fall back", at: "2026-10-24 23:30", next: "2026-10-25 03:00", open: true},
+		{name: "in the repeated hour", at: "2026-10-25 01:30", next: "2026-10-25 03:00", open: true},
+		{name: "after fall back", at: "2026-10-25 02:30", next: "2026-10-25 03:00", open: true},
+		{name: "opening after fall back", at: "2026-10-25 08:30", next: "2026-10-25 09:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkAt(t, s, utc(t, tt.at), tt.open, utc(t, tt.next))
+		})
+	}
+}
+
+func TestScheduleSpecialHours(t *testing.T) {
+	date := func(y int, m time.Month, d int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+	}
+
+	var week []*common.DaySchedule
+	for d := common.DayOfWeek_DAY_OF_WEEK_MONDAY; d <= common.DayOfWeek_DAY_OF_WEEK_SUNDAY; d++ {
+		week = append(week, day(d, "09:00", "17:00"))
+	}
+	s := mustNew(t, &common.OpeningHours{
+		Timezone: "America/New_York",
+		Schedule: week,
+		SpecialHours: []*common.SpecialHours{
+			{Date: date(2026, 12, 25), IsClosed: true},
+			{Date: date(2026, 12, 31), TimeSlots: []*common.TimeSlot{{OpenTime: "20:00", CloseTime: "04:00"}}},
+		},
+	})
+
+	tests := []struct {
+		at, next string
+		open     bool
+	}{
+		{at: "2026-12-24 16:00", next: "2026-12-24 17:00", open: true},
+		{at: "2026-12-25 12:00", next: "2026-12-26 09:00"},
+		{at: "2026-12-31 12:00", next: "2026-12-31 20:00"},
+		{at: "2027-01-01 02:00", next: "2027-01-01 04:00", open: true},
+		{at: "2027-01-01 05:00", next: "2027-01-01 09:00"},
+	}
+	for _, tt := range tests {
+		checkAt(t, s, local(t, s, tt.at), tt.open, local(t, s, tt.next))
+	}
+}
+
+func TestScheduleAlwaysAndNever(t *testing.T) {
+	now := utc(t, "2026-10-18 12:00")
+
+	always := mustNew(t, &common.OpeningHours{Is_24_7: true})
+	if !always.IsOpen(now) || !always.IsOpenBetween(now, now.Add(1000*time.Hour)) {
+		t.Error("a 24/7 place is not always open")
+	}
+	if _, ok := always.NextChange(now); ok {
+		t.Error("a 24/7 place changes")
+	}
+
+	never := mustNew(t, &common.OpeningHours{IsClosed: true, Schedule: []*common.DaySchedule{day(common.DayOfWeek_DAY_OF_WEEK_SUNDAY, "00:00", "24:00")}})
+	if never.IsOpen(now) {
+		t.Error("a closed place is open")
+	}
+	if _, ok := never.NextOpen(now); ok {
+		t.Error("a closed place opens")
+	}
+
+	empty := mustNew(t, &common.OpeningHours{})
+	if empty.IsOpen(now) {
+		t.Error("a place without hours is open")
+	}
+	if got := empty.Location(); got != time.UTC {
+		t.Errorf("Location() without a timezone = %v, want UTC", got)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	for name, h := range map[string]*common.OpeningHours{
+		"unknown timezone":  {Timezone: "Mars/Olympus_Mons"},
+		"unspecified day":   {Schedule: []*common.DaySchedule{day(common.DayOfWeek_DAY_OF_WEEK_UNSPECIFIED, "09:00", "17:00")}},
+		"hour out of range": {Schedule: []*common.DaySchedule{day(common.DayOfWeek_DAY_OF_WEEK_MONDAY, "09:00", "25:00")}},
+		"past the day":      {Schedule: []*common.DaySchedule{day(common.DayOfWeek_DAY_OF_WEEK_MONDAY, "24:30", "02:00")}},
+		"single digit hour": {Schedule: []*common.DaySchedule{day(common.DayOfWeek_DAY_OF_WEEK_MONDAY, "9:00", "17:00")}},
+		"bad special hours": {SpecialHours: []*common.SpecialHours{{TimeSlots: []*common.TimeSlot{{OpenTime: "noon"}}}}},
+	} {
+		if _, err := New(h); err == nil {
+			t.Errorf("%s: New() succeeded", name)
+		}
+	}
+}
+
+func TestFilterOpen(t *testing.T) {
+	office := &common.POI{Name: "office", OpeningHoursText: []string{"Mo-Fr 09:00-17:00"}}
+	shop := &common.POI{Name: "shop", OpeningHours: &common.OpeningHours{Schedule: []*common.DaySchedule{day(common.DayOfWeek_DAY_OF_WEEK_FRIDAY, "08:00", "12:00")}}}
+	unknown := &common.POI{Name: "unknown"}
+	unreadable := &common.POI{Name: "unreadable", OpeningHoursText: []string{"ask at the bar"}}
+	pois := []*common.POI{office, shop, unknown, unreadable}
+
+	for _, tt := range []struct {
+		at   string
+		want []*common.POI
+	}{
+		{at: "2026-10-16 10:00", want: []*common.POI{office, shop}},
+		{at: "2026-10-16 13:00", want: []*common.POI{office}},
+		{at: "2026-10-17 10:00", want: nil},
+	} {
+		got := FilterOpen(pois, utc(t, tt.at))
+		if len(got) != len(tt.want) {
+			t.Errorf("FilterOpen(%s) = %v, want %v", tt.at, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("FilterOpen(%s) = %v, want %v", tt.at, got, tt.want)
+			}
+		}
+	}
+}
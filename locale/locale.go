@@ -0,0 +1,154 @@
+// Package locale picks the translation of a common.MultilingualText that
+// best suits a user, matching BCP 47 language tags the way an
+// Accept-Language header is meant to be matched: "pt-PT" is served "pt-BR"
+// before falling back to the default language, and "zh-Hant" is not served
+// "zh-Hans".
+package locale
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// Parse reads the languages of an Accept-Language header, or of a single
+// tag such as UserProfile.language, best first. Malformed entries are
+// skipped.
+func Parse(accept string) []language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(accept)
+	if err == nil {
+		return tags
+	}
+
+	// A single bad entry fails the whole header, so fall back to the
+	// entries that do parse.
+	var valid []language.Tag
+	for _, part := range strings.Split(accept, ",") {
+		if t, _, err := language.ParseAcceptLanguage(part); err == nil {
+			valid = append(valid, t...)
+		}
+	}
+
+	return valid
+}
+
+// Resolve returns the translation of t best matching prefs, best first, and
+// its language. Without a good enough match it falls back to the default
+// language of t, then to its first translation. It reports false when t has
+// no translations.
+func Resolve(t *common.MultilingualText, prefs ...language.Tag) (string, language.Tag, bool) {
+	translations := t.GetTranslations()
+	if len(translations) == 0 {
+		return "", language.Und, false
+	}
+
+	supported := make([]language.Tag, 0, len(translations)+1)
+	indexes := make([]int, 0, len(translations)+1)
+
+	// The default language comes first, which makes it what the matcher
+	// falls back to.
+	if i := defaultIndex(t); i >= 0 {
+		supported = append(supported, tagOf(translations[i]))
+		indexes = append(indexes, i)
+	}
+	for i, tr := range translations {
+		supported = append(supported, tagOf(tr))
+		indexes = append(indexes, i)
+	}
+
+	if len(prefs) > 0 {
+		// A Low match is a different script, such as zh-Hans for zh-Hant,
+		// which readers of one cannot be assumed to read.
+		_, i, confidence := language.NewMatcher(supported).Match(prefs...)
+		if confidence > language.Low {
+			tr := translations[indexes[i]]
+			return tr.GetText(), tagOf(tr), true
+		}
+	}
+
+	tr := translations[indexes[0]]
+
+	return tr.GetText(), tagOf(tr), true
+}
+
+// Text resolves t like Resolve, falling back to fallback, usually the plain
+// string field t translates, when t has no translations:
+//
+//	description := locale.Text(city.GetLocalizedDescription(), city.GetDescription(), prefs...)
+func Text(t *common.MultilingualText, fallback string, prefs ...language.Tag) string {
+	if text, _, ok := Resolve(t, prefs...); ok {
+		return text
+	}
+
+	return fallback
+}
+
+// ResolveAcceptLanguage resolves t for the languages of an Accept-Language
+// header.
+func ResolveAcceptLanguage(t *common.MultilingualText, accept string) (string, bool) {
+	text, _, ok := Resolve(t, Parse(accept)...)
+
+	return text, ok
+}
+
+// Translation returns the translation of t into exactly lang, if it has one.
+func Translation(t *common.MultilingualText, lang language.Tag) (string, bool) {
+	for _, tr := range t.GetTranslations() {
+		if tagOf(tr) == lang {
+			return tr.GetText(), true
+		}
+	}
+
+	return "", false
+}
+
+// Set adds the translation of t into lang, replacing any it already had,
+// and returns t. A nil t is allocated, with lang as its default language.
+func Set(t *common.MultilingualText, lang language.Tag, text string) *common.MultilingualText {
+	if t == nil {
+		t = &common.MultilingualText{DefaultLanguage: lang.String()}
+	}
+
+	for _, tr := range t.GetTranslations() {
+		if tagOf(tr) == lang {
+			tr.Text = text
+			return t
+		}
+	}
+
+	t.Translations = append(t.Translations, &common.LocalizedString{LanguageCode: lang.String(), Text: text})
+
+	return t
+}
+
+func defaultIndex(t *common.MultilingualText) int {
+	if t.GetDefaultLanguage() == "" {
+		return -1
+	}
+
+	def, err := language.Parse(t.GetDefaultLanguage())
+	if err != nil {
+		return -1
+	}
+
+	for i, tr := range t.GetTranslations() {
+		if tagOf(tr) == def {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// tagOf returns the language of tr. Unreadable codes become language.Und,
+// which only matches as a last resort.
+func tagOf(tr *common.LocalizedString) language.Tag {
+	tag, err := language.Parse(tr.GetLanguageCode())
+	if err != nil {
+		return language.Und
+	}
+
+	return tag
+}
@@ -0,0 +1,95 @@
+package locale
+
+import (
+	"slices"
+	"testing"
+
+	"golang.org/x/text/language"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+func text(def string, translations ...string) *common.MultilingualText {
+	t := &common.MultilingualText{DefaultLanguage: def}
+	for i := 0; i < len(translations); i += 2 {
+		t.Translations = append(t.Translations, &common.LocalizedString{LanguageCode: translations[i], Text: translations[i+1]})
+	}
+
+	return t
+}
+
+func TestResolve(t *testing.T) {
+	city := text("en", "pt-BR", "Lisboa", "en", "Lisbon", "zh-Hans", "里斯本")
+
+	tests := []struct {
+		name     string
+		text     *common.MultilingualText
+		prefs    string
+		want     string
+		wantLang language.Tag
+		wantOK   bool
+	}{
+		{name: "exact", text: city, prefs: "pt-BR", want: "Lisboa", wantLang: language.MustParse("pt-BR"), wantOK: true},
+		{name: "same language, other region", text: city, prefs: "pt-PT", want: "Lisboa", wantLang: language.MustParse("pt-BR"), wantOK: true},
+		{name: "best first", text: city, prefs: "de, pt;q=0.8, en;q=0.5", want: "Lisboa", wantLang: language.MustParse("pt-BR"), wantOK: true},
+		{name: "other script falls back to the default", text: city, prefs: "zh-Hant", want: "Lisbon", wantLang: language.English, wantOK: true},
+		{name: "unknown language falls back to the default", text: city, prefs: "de", want: "Lisbon", wantLang: language.English, wantOK: true},
+		{name: "no preferences", text: city, want: "Lisbon", wantLang: language.English, wantOK: true},
+		{name: "no default falls back to the first", text: text("", "pt-BR", "Lisboa", "en", "Lisbon"), prefs: "de", want: "Lisboa", wantLang: language.MustParse("pt-BR"), wantOK: true},
+		{name: "unknown default falls back to the first", text: text("fr", "pt-BR", "Lisboa", "en", "Lisbon"), prefs: "de", want: "Lisboa", wantLang: language.MustParse("pt-BR"), wantOK: true},
+		{name: "no translations", text: text("en"), prefs: "en", wantLang: language.Und},
+		{name: "nil", prefs: "en", wantLang: language.Und},
+	}
+	for _, tt := range tests {
+		got, lang, ok := Resolve(tt.text, Parse(tt.prefs)...)
+		if got != tt.want || lang != tt.wantLang || ok != tt.wantOK {
+			t.Errorf("%s: Resolve(%q) = %q, %v, %t, want %q, %v, %t", tt.name, tt.prefs, got, lang, ok, tt.want, tt.wantLang, tt.wantOK)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   []language.Tag
+	}{
+		{accept: "pt-PT", want: []language.Tag{language.MustParse("pt-PT")}},
+		{accept: "en;q=0.5, pt-PT", want: []language.Tag{language.MustParse("pt-PT"), language.English}},
+		{accept: "pt-PT, !!, en", want: []language.Tag{language.MustParse("pt-PT"), language.English}},
+		{accept: ""},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.accept); !slices.Equal(got, tt.want) {
+			t.Errorf("Parse(%q) = %v, want %v", tt.accept, got, tt.want)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	if got := Text(nil, "Lisbon", language.English); got != "Lisbon" {
+		t.Errorf("Text(nil) = %q, want the fallback Lisbon", got)
+	}
+	if got, _ := ResolveAcceptLanguage(text("en", "en", "Lisbon", "pt-PT", "Lisboa"), "pt"); got != "Lisboa" {
+		t.Errorf("ResolveAcceptLanguage(pt) = %q, want Lisboa", got)
+	}
+}
+
+func TestSet(t *testing.T) {
+	pt := language.MustParse("pt-PT")
+	city := Set(nil, pt, "Lisboa")
+	city = Set(city, language.English, "Lisbon")
+	city = Set(city, pt, "Lisboa!")
+
+	if got := city.GetDefaultLanguage(); got != "pt-PT" {
+		t.Errorf("default language = %q, want pt-PT", got)
+	}
+	if got := len(city.GetTranslations()); got != 2 {
+		t.Errorf("got %d translations, want 2", got)
+	}
+	if got, ok := Translation(city, pt); got != "Lisboa!" || !ok {
+		t.Errorf("Translation(pt-PT) = %q, %t, want Lisboa!, true", got, ok)
+	}
+	if _, ok := Translation(city, language.MustParse("pt-BR")); ok {
+		t.Error("Translation(pt-BR) matched pt-PT")
+	}
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// LanguageKey is the metadata key carrying the languages the user reads, in
+// the format of an Accept-Language header.
+const LanguageKey = "accept-language"
+
+type languageKey struct{}
+
+// WithLanguage returns a copy of ctx carrying the languages of the user, a
+// tag such as UserProfile.language or a whole Accept-Language header, which
+// the LanguageInterceptors send along with every call made with it.
+func WithLanguage(ctx context.Context, languages string) context.Context {
+	return context.WithValue(ctx, languageKey{}, languages)
+}
+
+// LanguageFromContext returns the languages carried by ctx, set either by
+// WithLanguage or by the caller of an incoming call through LanguageKey.
+// Parse them with locale.Parse.
+func LanguageFromContext(ctx context.Context) (string, bool) {
+	if languages, ok := ctx.Value(languageKey{}).(string); ok && languages != "" {
+		return languages, true
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(LanguageKey); len(v) > 0 && v[0] != "" {
+			return v[0], true
+		}
+	}
+
+	return "", false
+}
+
+// LanguageInterceptors send the languages of the user as LanguageKey
+// metadata, so servers can pick the translations of MultilingualText
+// fields. The languages are taken from the context, or from the incoming
+// metadata of the call being served, falling back to fallback; with none of
+// them, nothing is sent. Calls that already carry the
+// metadata are left alone.
+func LanguageInterceptors(fallback string) ClientInterceptor {
+	return ClientInterceptor{
+		Unary: func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(withLanguageMetadata(ctx, fallback), method, req, reply, cc, opts...)
+		},
+		Stream: func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(withLanguageMetadata(ctx, fallback), desc, cc, method, opts...)
+		},
+	}
+}
+
+func withLanguageMetadata(ctx context.Context, fallback string) context.Context {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(LanguageKey)) > 0 {
+		return ctx
+	}
+
+	languages, ok := LanguageFromContext(ctx)
+	if !ok {
+		languages = fallback
+	}
+	if languages == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, LanguageKey, languages)
+}
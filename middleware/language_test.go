@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLanguageInterceptors(t *testing.T) {
+	incoming := metadata.NewIncomingContext(context.Background(), metadata.Pairs(LanguageKey, "fr-FR"))
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		fallback string
+		want     []string
+	}{
+		{name: "from context", ctx: WithLanguage(context.Background(), "pt-PT"), fallback: "en", want: []string{"pt-PT"}},
+		{name: "from incoming metadata", ctx: incoming, fallback: "en", want: []string{"fr-FR"}},
+		{name: "context over incoming metadata", ctx: WithLanguage(incoming, "pt-PT"), want: []string{"pt-PT"}},
+		{name: "fallback", ctx: context.Background(), fallback: "en", want: []string{"en"}},
+		{name: "none", ctx: context.Background()},
+		{name: "already sent", ctx: metadata.AppendToOutgoingContext(WithLanguage(context.Background(), "pt-PT"), LanguageKey, "de"), fallback: "en", want: []string{"de"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			got = md.Get(LanguageKey)
+			return nil
+		}
+		if err := LanguageInterceptors(tt.fallback).Unary(tt.ctx, "/test/Unary", nil, nil, nil, invoker); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: sent %s = %q, want %q", tt.name, LanguageKey, got, tt.want)
+		}
+	}
+}
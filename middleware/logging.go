@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	grpcZap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+)
+
+// ZapClientInterceptors creates client interceptors that log every call to
+// logger. Unlike upstream's grpclog.Interceptors it leaves the process-wide
+// gRPC logger alone, so each connection can log to its own logger.
+func ZapClientInterceptors(logger *zap.Logger) ClientInterceptor {
+	opts := []grpcZap.Option{
+		grpcZap.WithLevels(codeToLevel),
+	}
+
+	return ClientInterceptor{
+		Unary:  grpcZap.UnaryClientInterceptor(logger, opts...),
+		Stream: grpcZap.StreamClientInterceptor(logger, opts...),
+	}
+}
+
+// codeToLevel logs successful calls at debug level and defers to grpcZap
+// for everything else.
+func codeToLevel(code codes.Code) zapcore.Level {
+	if code == codes.OK {
+		return zap.DebugLevel
+	}
+
+	return grpcZap.DefaultCodeToLevel(code)
+}
@@ -28,9 +28,10 @@ func OpenTelemetryInterceptors() (ClientInterceptor, ServerInterceptor) {
 }
 
 // NewOtelClientHandler creates OpenTelemetry client stats handler
-func NewOtelClientHandler() ClientHandler {
-	client, _ := OpenTelemetryHandlers()
-	return client
+func NewOtelClientHandler(opts ...otelgrpc.Option) ClientHandler {
+	return ClientHandler{
+		Handler: otelgrpc.NewClientHandler(opts...),
+	}
 }
 
 // NewOtelServerHandler creates OpenTelemetry server stats handler
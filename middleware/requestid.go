@@ -0,0 +1,218 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RequestIDKey is the metadata key a request ID is read from on incoming
+// calls when the context carries none.
+const RequestIDKey = "x-request-id"
+
+type requestIDKey struct{}
+
+type responseInfoKey struct{}
+
+// WithRequestID returns a copy of ctx carrying id, which the
+// BaseRequestInterceptors put on every outgoing request made with it.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, set either by
+// WithRequestID or by the caller of an incoming call through RequestIDKey.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok && id != "" {
+		return id, true
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(RequestIDKey); len(v) > 0 && v[0] != "" {
+			return v[0], true
+		}
+	}
+
+	return "", false
+}
+
+// BaseRequestInterceptors fill in the BaseRequest of every outgoing
+// message: request_id is taken from the context, or a new UUID when it has
+// none, and downstream is set to service, the name of the calling service.
+// Fields the caller already set are left alone. The fields are set on a
+// copy, so a message the caller reuses or shares is not changed.
+func BaseRequestInterceptors(service string) ClientInterceptor {
+	return ClientInterceptor{
+		Unary: func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			ctx, req = fillBaseRequest(ctx, req, service)
+
+			return invoker(ctx, method, req, reply, cc, opts...)
+		},
+		Stream: func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			ctx = withRequestID(ctx)
+
+			stream, err := streamer(ctx, desc, cc, method, opts...)
+			if err != nil {
+				return nil, err
+			}
+
+			return &baseRequestStream{ClientStream: stream, ctx: ctx, service: service}, nil
+		},
+	}
+}
+
+// withRequestID makes sure ctx carries a request ID, so every message of a
+// stream gets the same one.
+func withRequestID(ctx context.Context) context.Context {
+	if _, ok := RequestIDFromContext(ctx); ok {
+		return ctx
+	}
+
+	return WithRequestID(ctx, uuid.NewString())
+}
+
+// fillBaseRequest returns ctx carrying the request ID of req, and req with
+// its BaseRequest filled in. req is cloned before it is changed.
+func fillBaseRequest(ctx context.Context, req any, service string) (context.Context, any) {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return ctx, req
+	}
+
+	fd := m.ProtoReflect().Descriptor().Fields().ByName("request")
+	if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		return ctx, req
+	}
+
+	fields := fd.Message().Fields()
+	idField := stringField(fields, "request_id")
+	downstreamField := stringField(fields, "downstream")
+	if idField == nil || downstreamField == nil {
+		return ctx, req
+	}
+
+	base := m.ProtoReflect().Get(fd).Message()
+	id := base.Get(idField).String()
+	if id != "" {
+		if _, ok := RequestIDFromContext(ctx); !ok {
+			ctx = WithRequestID(ctx, id)
+		}
+	} else {
+		ctx = withRequestID(ctx)
+		id, _ = RequestIDFromContext(ctx)
+	}
+
+	downstream := base.Get(downstreamField).String()
+	if downstream == "" {
+		downstream = service
+	}
+
+	if id == base.Get(idField).String() && downstream == base.Get(downstreamField).String() {
+		return ctx, req
+	}
+
+	clone := proto.Clone(m)
+	mutable := clone.ProtoReflect().Mutable(fd).Message()
+	mutable.Set(idField, protoreflect.ValueOfString(id))
+	if downstream != "" {
+		mutable.Set(downstreamField, protoreflect.ValueOfString(downstream))
+	}
+
+	return ctx, clone
+}
+
+func stringField(fields protoreflect.FieldDescriptors, name protoreflect.Name) protoreflect.FieldDescriptor {
+	fd := fields.ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return nil
+	}
+
+	return fd
+}
+
+type baseRequestStream struct {
+	grpc.ClientStream
+
+	ctx     context.Context
+	service string
+}
+
+func (s *baseRequestStream) SendMsg(m any) error {
+	_, m = fillBaseRequest(s.ctx, m, s.service)
+
+	return s.ClientStream.SendMsg(m)
+}
+
+// ResponseInfo is what a BaseResponse says about the upstream that served
+// a call.
+type ResponseInfo struct {
+	Upstream  string
+	RequestID string
+	Status    string
+}
+
+// ContextWithResponse returns a copy of ctx carrying the BaseResponse of
+// resp, if it has one, so that later log lines can be correlated with the
+// upstream call. The request ID is carried over as well.
+func ContextWithResponse(ctx context.Context, resp proto.Message) context.Context {
+	if resp == nil {
+		return ctx
+	}
+
+	msg := resp.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName("response")
+	if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() || !msg.Has(fd) {
+		return ctx
+	}
+
+	base := msg.Get(fd).Message()
+	fields := fd.Message().Fields()
+	get := func(name protoreflect.Name) string {
+		if f := stringField(fields, name); f != nil {
+			return base.Get(f).String()
+		}
+
+		return ""
+	}
+
+	info := ResponseInfo{
+		Upstream:  get("upstream"),
+		RequestID: get("request_id"),
+		Status:    get("status"),
+	}
+
+	ctx = context.WithValue(ctx, responseInfoKey{}, info)
+	if _, ok := RequestIDFromContext(ctx); !ok && info.RequestID != "" {
+		ctx = WithRequestID(ctx, info.RequestID)
+	}
+
+	return ctx
+}
+
+// ResponseInfoFromContext returns the BaseResponse stored by
+// ContextWithResponse.
+func ResponseInfoFromContext(ctx context.Context) (ResponseInfo, bool) {
+	info, ok := ctx.Value(responseInfoKey{}).(ResponseInfo)
+
+	return info, ok
+}
+
+// LogFields returns the zap fields correlating a log line with the request
+// and upstream carried by ctx.
+func LogFields(ctx context.Context) []zap.Field {
+	var fields []zap.Field
+	if id, ok := RequestIDFromContext(ctx); ok {
+		fields = append(fields, zap.String("request_id", id))
+	}
+
+	if info, ok := ResponseInfoFromContext(ctx); ok && info.Upstream != "" {
+		fields = append(fields, zap.String("upstream", info.Upstream))
+	}
+
+	return fields
+}
@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	city "github.com/FACorreiaa/loci-proto/modules/city/generated"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// invoke makes a unary call of req through the BaseRequestInterceptors of
+// service, returning the context and request the invoker got.
+func invoke(t *testing.T, ctx context.Context, service string, req any) (context.Context, any) {
+	t.Helper()
+
+	var gotCtx context.Context
+	var gotReq any
+	invoker := func(ctx context.Context, _ string, req, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		gotCtx, gotReq = ctx, req
+		return nil
+	}
+	if err := BaseRequestInterceptors(service).Unary(ctx, city.CityService_GetCity_FullMethodName, req, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+
+	return gotCtx, gotReq
+}
+
+func TestBaseRequestInterceptors(t *testing.T) {
+	incoming := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDKey, "incoming"))
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		base           *common.BaseRequest
+		wantID         string
+		wantDownstream string
+	}{
+		{name: "from context", ctx: WithRequestID(context.Background(), "ctx"), wantID: "ctx", wantDownstream: "gateway"},
+		{name: "from incoming metadata", ctx: incoming, wantID: "incoming", wantDownstream: "gateway"},
+		{name: "empty base", ctx: WithRequestID(context.Background(), "ctx"), base: &common.BaseRequest{}, wantID: "ctx", wantDownstream: "gateway"},
+		{name: "already set", ctx: WithRequestID(context.Background(), "ctx"), base: &common.BaseRequest{RequestId: "set", Downstream: "caller"}, wantID: "set", wantDownstream: "caller"},
+		{name: "only downstream set", ctx: incoming, base: &common.BaseRequest{Downstream: "caller"}, wantID: "incoming", wantDownstream: "caller"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &city.GetCityRequest{CityId: "lisbon", Request: tt.base}
+			orig := proto.Clone(req)
+
+			_, got := invoke(t, tt.ctx, "gateway", req)
+			sent := got.(*city.GetCityRequest)
+			if id := sent.GetRequest().GetRequestId(); id != tt.wantID {
+				t.Errorf("request_id = %q, want %q", id, tt.wantID)
+			}
+			if downstream := sent.GetRequest().GetDownstream(); downstream != tt.wantDownstream {
+				t.Errorf("downstream = %q, want %q", downstream, tt.wantDownstream)
+			}
+			if sent.GetCityId() != "lisbon" {
+				t.Errorf("city_id = %q, want lisbon", sent.GetCityId())
+			}
+			if !proto.Equal(req, orig) {
+				t.Errorf("the caller's request was changed to %v", req)
+			}
+		})
+	}
+}
+
+func TestBaseRequestInterceptorsNewID(t *testing.T) {
+	ctx, got := invoke(t, context.Background(), "", &city.GetCityRequest{})
+
+	sent := got.(*city.GetCityRequest)
+	id := sent.GetRequest().GetRequestId()
+	if id == "" {
+		t.Fatal("no request_id was generated")
+	}
+	if ctxID, _ := RequestIDFromContext(ctx); ctxID != id {
+		t.Errorf("the invoker's context carries request ID %q, want %q", ctxID, id)
+	}
+	if downstream := sent.GetRequest().GetDownstream(); downstream != "" {
+		t.Errorf("downstream = %q, want it unset without a service", downstream)
+	}
+
+	_, again := invoke(t, context.Background(), "", &city.GetCityRequest{})
+	if again.(*city.GetCityRequest).GetRequest().GetRequestId() == id {
+		t.Error("two calls without a request ID got the same one")
+	}
+}
+
+func TestBaseRequestInterceptorsPropagate(t *testing.T) {
+	ctx := WithRequestID(context.Background(), "ctx")
+	ctx, _ = invoke(t, ctx, "gateway", &city.GetCityRequest{Request: &common.BaseRequest{RequestId: "set"}})
+	if id, _ := RequestIDFromContext(ctx); id != "ctx" {
+		t.Errorf("RequestIDFromContext() = %q, want the caller's ctx", id)
+	}
+
+	// A request ID found on the request is carried on to later calls.
+	ctx, _ = invoke(t, context.Background(), "gateway", &city.GetCityRequest{Request: &common.BaseRequest{RequestId: "set"}})
+	if id, _ := RequestIDFromContext(ctx); id != "set" {
+		t.Errorf("RequestIDFromContext() = %q, want set", id)
+	}
+}
+
+func TestBaseRequestInterceptorsReuse(t *testing.T) {
+	req := &city.GetCityRequest{CityId: "lisbon"}
+	for _, id := range []string{"first", "second"} {
+		_, got := invoke(t, WithRequestID(context.Background(), id), "gateway", req)
+		if sent := got.(*city.GetCityRequest).GetRequest().GetRequestId(); sent != id {
+			t.Errorf("reused request sent request_id %q, want %q", sent, id)
+		}
+	}
+}
+
+type recordingStream struct {
+	grpc.ClientStream
+
+	sent []any
+}
+
+func (s *recordingStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestBaseRequestInterceptorsStream(t *testing.T) {
+	rec := &recordingStream{}
+	streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+		return rec, nil
+	}
+	stream, err := BaseRequestInterceptors("gateway").Stream(context.Background(), &grpc.StreamDesc{ClientStreams: true}, nil, "/test/Stream", streamer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &city.GetCityRequest{}
+	for range 2 {
+		if err := stream.SendMsg(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if req.GetRequest() != nil {
+		t.Errorf("the caller's request was changed to %v", req)
+	}
+
+	first := rec.sent[0].(*city.GetCityRequest).GetRequest()
+	second := rec.sent[1].(*city.GetCityRequest).GetRequest()
+	if first.GetRequestId() == "" || first.GetRequestId() != second.GetRequestId() {
+		t.Errorf("stream messages got request IDs %q and %q, want one shared ID", first.GetRequestId(), second.GetRequestId())
+	}
+	if first.GetDownstream() != "gateway" {
+		t.Errorf("downstream = %q, want gateway", first.GetDownstream())
+	}
+}
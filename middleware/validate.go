@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+)
+
+// validator is implemented by every generated message. ValidateAll reports
+// every broken rule rather than only the first.
+type validator interface {
+	ValidateAll() error
+}
+
+// validate checks m against the rules declared on its proto fields.
+// Messages without generated validation are let through.
+func validate(m any) error {
+	v, ok := m.(validator)
+	if !ok {
+		return nil
+	}
+
+	return rpcerrors.FromValidation(v.ValidateAll())
+}
+
+// ValidationInterceptors create interceptors rejecting messages that break
+// the rules declared on their proto fields with an InvalidArgument status
+// listing the offending fields. The client interceptors check outgoing
+// messages before they are sent, so invalid requests never reach the
+// network, and the server interceptors check incoming ones before they
+// reach the handler.
+func ValidationInterceptors() (ClientInterceptor, ServerInterceptor) {
+	client := ClientInterceptor{
+		Unary: func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			if err := validate(req); err != nil {
+				return rpcerrors.FromError(err)
+			}
+
+			return invoker(ctx, method, req, reply, cc, opts...)
+		},
+		Stream: func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			stream, err := streamer(ctx, desc, cc, method, opts...)
+			if err != nil {
+				return nil, err
+			}
+
+			return &validatingClientStream{ClientStream: stream}, nil
+		},
+	}
+
+	server := ServerInterceptor{
+		Unary: func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := validate(req); err != nil {
+				return nil, err
+			}
+
+			return handler(ctx, req)
+		},
+		Stream: func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, &validatingServerStream{ServerStream: ss})
+		},
+	}
+
+	return client, server
+}
+
+type validatingClientStream struct {
+	grpc.ClientStream
+}
+
+func (s *validatingClientStream) SendMsg(m any) error {
+	if err := validate(m); err != nil {
+		return rpcerrors.FromError(err)
+	}
+
+	return s.ClientStream.SendMsg(m)
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return validate(m)
+}
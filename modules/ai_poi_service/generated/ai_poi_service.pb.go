@@ -27,7 +27,7 @@ type GetServiceInfoRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	IncludeEndpoints   bool                   `protobuf:"varint,1,opt,name=include_endpoints,json=includeEndpoints,proto3" json:"include_endpoints,omitempty"`
 	IncludeVersionInfo bool                   `protobuf:"varint,2,opt,name=include_version_info,json=includeVersionInfo,proto3" json:"include_version_info,omitempty"`
-	Request            *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request            *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -76,7 +76,7 @@ func (x *GetServiceInfoRequest) GetIncludeVersionInfo() bool {
 	return false
 }
 
-func (x *GetServiceInfoRequest) GetRequest() *BaseRequest {
+func (x *GetServiceInfoRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -88,7 +88,7 @@ type GetServiceInfoResponse struct {
 	ServiceInfo   *ServiceInfo            `protobuf:"bytes,1,opt,name=service_info,json=serviceInfo,proto3" json:"service_info,omitempty"`
 	Endpoints     []*ServiceEndpoint      `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
 	Versions      []*generated.ApiVersion `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
-	Response      *BaseResponse           `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -144,7 +144,7 @@ func (x *GetServiceInfoResponse) GetVersions() []*generated.ApiVersion {
 	return nil
 }
 
-func (x *GetServiceInfoResponse) GetResponse() *BaseResponse {
+func (x *GetServiceInfoResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -342,7 +342,7 @@ type GetFeatureFlagsRequest struct {
 	ClientVersion  string                 `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
 	Platform       string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"` // "web", "ios", "android"
 	UserAttributes map[string]string      `protobuf:"bytes,4,rep,name=user_attributes,json=userAttributes,proto3" json:"user_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Request        *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request        *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -405,7 +405,7 @@ func (x *GetFeatureFlagsRequest) GetUserAttributes() map[string]string {
 	return nil
 }
 
-func (x *GetFeatureFlagsRequest) GetRequest() *BaseRequest {
+func (x *GetFeatureFlagsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -416,7 +416,7 @@ type GetFeatureFlagsResponse struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Flags         []*generated.FeatureFlag `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
 	Experiments   map[string]string        `protobuf:"bytes,2,rep,name=experiments,proto3" json:"experiments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // A/B test assignments
-	Response      *BaseResponse            `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Response      *generated.BaseResponse  `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -465,7 +465,7 @@ func (x *GetFeatureFlagsResponse) GetExperiments() map[string]string {
 	return nil
 }
 
-func (x *GetFeatureFlagsResponse) GetResponse() *BaseResponse {
+func (x *GetFeatureFlagsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -549,6 +549,10 @@ func (x *ServiceDependencies) GetFileStorage() *generated.ComponentHealth {
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in ai_poi_service.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -601,6 +605,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in ai_poi_service.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -665,16 +673,16 @@ var File_ai_poi_service_proto protoreflect.FileDescriptor
 
 const file_ai_poi_service_proto_rawDesc = "" +
 	"\n" +
-	"\x14ai_poi_service.proto\x12\tai_poi.v1\x1a\fcommon.proto\"\xa8\x01\n" +
+	"\x14ai_poi_service.proto\x12\tai_poi.v1\x1a\fcommon.proto\"\xaf\x01\n" +
 	"\x15GetServiceInfoRequest\x12+\n" +
 	"\x11include_endpoints\x18\x01 \x01(\bR\x10includeEndpoints\x120\n" +
-	"\x14include_version_info\x18\x02 \x01(\bR\x12includeVersionInfo\x120\n" +
-	"\arequest\x18d \x01(\v2\x16.ai_poi.v1.BaseRequestR\arequest\"\xfc\x01\n" +
+	"\x14include_version_info\x18\x02 \x01(\bR\x12includeVersionInfo\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x83\x02\n" +
 	"\x16GetServiceInfoResponse\x129\n" +
 	"\fservice_info\x18\x01 \x01(\v2\x16.ai_poi.v1.ServiceInfoR\vserviceInfo\x128\n" +
 	"\tendpoints\x18\x02 \x03(\v2\x1a.ai_poi.v1.ServiceEndpointR\tendpoints\x128\n" +
-	"\bversions\x18\x03 \x03(\v2\x1c.ai_poi.common.v1.ApiVersionR\bversions\x123\n" +
-	"\bresponse\x18d \x01(\v2\x17.ai_poi.v1.BaseResponseR\bresponse\"\xd0\x02\n" +
+	"\bversions\x18\x03 \x03(\v2\x1c.ai_poi.common.v1.ApiVersionR\bversions\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xd0\x02\n" +
 	"\vServiceInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12 \n" +
@@ -696,20 +704,20 @@ const file_ai_poi_service_proto_rawDesc = "" +
 	"\rrequires_auth\x18\x05 \x01(\bR\frequiresAuth\x121\n" +
 	"\x14required_permissions\x18\x06 \x03(\tR\x13requiredPermissions\x12>\n" +
 	"\n" +
-	"rate_limit\x18\a \x01(\v2\x1f.ai_poi.common.v1.RateLimitInfoR\trateLimit\"\xc9\x02\n" +
+	"rate_limit\x18\a \x01(\v2\x1f.ai_poi.common.v1.RateLimitInfoR\trateLimit\"\xd0\x02\n" +
 	"\x16GetFeatureFlagsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
 	"\x0eclient_version\x18\x02 \x01(\tR\rclientVersion\x12\x1a\n" +
 	"\bplatform\x18\x03 \x01(\tR\bplatform\x12^\n" +
-	"\x0fuser_attributes\x18\x04 \x03(\v25.ai_poi.v1.GetFeatureFlagsRequest.UserAttributesEntryR\x0euserAttributes\x120\n" +
-	"\arequest\x18d \x01(\v2\x16.ai_poi.v1.BaseRequestR\arequest\x1aA\n" +
+	"\x0fuser_attributes\x18\x04 \x03(\v25.ai_poi.v1.GetFeatureFlagsRequest.UserAttributesEntryR\x0euserAttributes\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\x1aA\n" +
 	"\x13UserAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9a\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa1\x02\n" +
 	"\x17GetFeatureFlagsResponse\x123\n" +
 	"\x05flags\x18\x01 \x03(\v2\x1d.ai_poi.common.v1.FeatureFlagR\x05flags\x12U\n" +
-	"\vexperiments\x18\x02 \x03(\v23.ai_poi.v1.GetFeatureFlagsResponse.ExperimentsEntryR\vexperiments\x123\n" +
-	"\bresponse\x18d \x01(\v2\x17.ai_poi.v1.BaseResponseR\bresponse\x1a>\n" +
+	"\vexperiments\x18\x02 \x03(\v23.ai_poi.v1.GetFeatureFlagsResponse.ExperimentsEntryR\vexperiments\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\x1a>\n" +
 	"\x10ExperimentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe8\x02\n" +
@@ -720,18 +728,18 @@ const file_ai_poi_service_proto_rawDesc = "" +
 	"\n" +
 	"ai_service\x18\x03 \x01(\v2!.ai_poi.common.v1.ComponentHealthR\taiService\x12F\n" +
 	"\rexternal_apis\x18\x04 \x01(\v2!.ai_poi.common.v1.ComponentHealthR\fexternalApis\x12D\n" +
-	"\ffile_storage\x18\x05 \x01(\v2!.ai_poi.common.v1.ComponentHealthR\vfileStorage\"N\n" +
+	"\ffile_storage\x18\x05 \x01(\v2!.ai_poi.common.v1.ComponentHealthR\vfileStorage\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status2\x9b\x02\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x012\x9b\x02\n" +
 	"\fAiPoiService\x12Z\n" +
 	"\vHealthCheck\x12$.ai_poi.common.v1.HealthCheckRequest\x1a%.ai_poi.common.v1.HealthCheckResponse\x12U\n" +
 	"\x0eGetServiceInfo\x12 .ai_poi.v1.GetServiceInfoRequest\x1a!.ai_poi.v1.GetServiceInfoResponse\x12X\n" +
@@ -763,35 +771,37 @@ var file_ai_poi_service_proto_goTypes = []any{
 	nil,                                   // 9: ai_poi.v1.ServiceInfo.ConfigurationEntry
 	nil,                                   // 10: ai_poi.v1.GetFeatureFlagsRequest.UserAttributesEntry
 	nil,                                   // 11: ai_poi.v1.GetFeatureFlagsResponse.ExperimentsEntry
-	(*generated.ApiVersion)(nil),          // 12: ai_poi.common.v1.ApiVersion
-	(*generated.RateLimitInfo)(nil),       // 13: ai_poi.common.v1.RateLimitInfo
-	(*generated.FeatureFlag)(nil),         // 14: ai_poi.common.v1.FeatureFlag
-	(*generated.ComponentHealth)(nil),     // 15: ai_poi.common.v1.ComponentHealth
-	(*generated.HealthCheckRequest)(nil),  // 16: ai_poi.common.v1.HealthCheckRequest
-	(*generated.HealthCheckResponse)(nil), // 17: ai_poi.common.v1.HealthCheckResponse
+	(*generated.BaseRequest)(nil),         // 12: ai_poi.common.v1.BaseRequest
+	(*generated.ApiVersion)(nil),          // 13: ai_poi.common.v1.ApiVersion
+	(*generated.BaseResponse)(nil),        // 14: ai_poi.common.v1.BaseResponse
+	(*generated.RateLimitInfo)(nil),       // 15: ai_poi.common.v1.RateLimitInfo
+	(*generated.FeatureFlag)(nil),         // 16: ai_poi.common.v1.FeatureFlag
+	(*generated.ComponentHealth)(nil),     // 17: ai_poi.common.v1.ComponentHealth
+	(*generated.HealthCheckRequest)(nil),  // 18: ai_poi.common.v1.HealthCheckRequest
+	(*generated.HealthCheckResponse)(nil), // 19: ai_poi.common.v1.HealthCheckResponse
 }
 var file_ai_poi_service_proto_depIdxs = []int32{
-	7,  // 0: ai_poi.v1.GetServiceInfoRequest.request:type_name -> ai_poi.v1.BaseRequest
+	12, // 0: ai_poi.v1.GetServiceInfoRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	2,  // 1: ai_poi.v1.GetServiceInfoResponse.service_info:type_name -> ai_poi.v1.ServiceInfo
 	3,  // 2: ai_poi.v1.GetServiceInfoResponse.endpoints:type_name -> ai_poi.v1.ServiceEndpoint
-	12, // 3: ai_poi.v1.GetServiceInfoResponse.versions:type_name -> ai_poi.common.v1.ApiVersion
-	8,  // 4: ai_poi.v1.GetServiceInfoResponse.response:type_name -> ai_poi.v1.BaseResponse
+	13, // 3: ai_poi.v1.GetServiceInfoResponse.versions:type_name -> ai_poi.common.v1.ApiVersion
+	14, // 4: ai_poi.v1.GetServiceInfoResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	9,  // 5: ai_poi.v1.ServiceInfo.configuration:type_name -> ai_poi.v1.ServiceInfo.ConfigurationEntry
-	13, // 6: ai_poi.v1.ServiceEndpoint.rate_limit:type_name -> ai_poi.common.v1.RateLimitInfo
+	15, // 6: ai_poi.v1.ServiceEndpoint.rate_limit:type_name -> ai_poi.common.v1.RateLimitInfo
 	10, // 7: ai_poi.v1.GetFeatureFlagsRequest.user_attributes:type_name -> ai_poi.v1.GetFeatureFlagsRequest.UserAttributesEntry
-	7,  // 8: ai_poi.v1.GetFeatureFlagsRequest.request:type_name -> ai_poi.v1.BaseRequest
-	14, // 9: ai_poi.v1.GetFeatureFlagsResponse.flags:type_name -> ai_poi.common.v1.FeatureFlag
+	12, // 8: ai_poi.v1.GetFeatureFlagsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	16, // 9: ai_poi.v1.GetFeatureFlagsResponse.flags:type_name -> ai_poi.common.v1.FeatureFlag
 	11, // 10: ai_poi.v1.GetFeatureFlagsResponse.experiments:type_name -> ai_poi.v1.GetFeatureFlagsResponse.ExperimentsEntry
-	8,  // 11: ai_poi.v1.GetFeatureFlagsResponse.response:type_name -> ai_poi.v1.BaseResponse
-	15, // 12: ai_poi.v1.ServiceDependencies.database:type_name -> ai_poi.common.v1.ComponentHealth
-	15, // 13: ai_poi.v1.ServiceDependencies.redis_cache:type_name -> ai_poi.common.v1.ComponentHealth
-	15, // 14: ai_poi.v1.ServiceDependencies.ai_service:type_name -> ai_poi.common.v1.ComponentHealth
-	15, // 15: ai_poi.v1.ServiceDependencies.external_apis:type_name -> ai_poi.common.v1.ComponentHealth
-	15, // 16: ai_poi.v1.ServiceDependencies.file_storage:type_name -> ai_poi.common.v1.ComponentHealth
-	16, // 17: ai_poi.v1.AiPoiService.HealthCheck:input_type -> ai_poi.common.v1.HealthCheckRequest
+	14, // 11: ai_poi.v1.GetFeatureFlagsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	17, // 12: ai_poi.v1.ServiceDependencies.database:type_name -> ai_poi.common.v1.ComponentHealth
+	17, // 13: ai_poi.v1.ServiceDependencies.redis_cache:type_name -> ai_poi.common.v1.ComponentHealth
+	17, // 14: ai_poi.v1.ServiceDependencies.ai_service:type_name -> ai_poi.common.v1.ComponentHealth
+	17, // 15: ai_poi.v1.ServiceDependencies.external_apis:type_name -> ai_poi.common.v1.ComponentHealth
+	17, // 16: ai_poi.v1.ServiceDependencies.file_storage:type_name -> ai_poi.common.v1.ComponentHealth
+	18, // 17: ai_poi.v1.AiPoiService.HealthCheck:input_type -> ai_poi.common.v1.HealthCheckRequest
 	0,  // 18: ai_poi.v1.AiPoiService.GetServiceInfo:input_type -> ai_poi.v1.GetServiceInfoRequest
 	4,  // 19: ai_poi.v1.AiPoiService.GetFeatureFlags:input_type -> ai_poi.v1.GetFeatureFlagsRequest
-	17, // 20: ai_poi.v1.AiPoiService.HealthCheck:output_type -> ai_poi.common.v1.HealthCheckResponse
+	19, // 20: ai_poi.v1.AiPoiService.HealthCheck:output_type -> ai_poi.common.v1.HealthCheckResponse
 	1,  // 21: ai_poi.v1.AiPoiService.GetServiceInfo:output_type -> ai_poi.v1.GetServiceInfoResponse
 	5,  // 22: ai_poi.v1.AiPoiService.GetFeatureFlags:output_type -> ai_poi.v1.GetFeatureFlagsResponse
 	20, // [20:23] is the sub-list for method output_type
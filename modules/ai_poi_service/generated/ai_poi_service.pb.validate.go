@@ -0,0 +1,1412 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: ai_poi_service.proto
+
+package generated
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetServiceInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetServiceInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetServiceInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetServiceInfoRequestMultiError, or nil if none found.
+func (m *GetServiceInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetServiceInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeEndpoints
+
+	// no validation rules for IncludeVersionInfo
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetServiceInfoRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetServiceInfoRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetServiceInfoRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetServiceInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetServiceInfoRequestMultiError is an error wrapping multiple validation
+// errors returned by GetServiceInfoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetServiceInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetServiceInfoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetServiceInfoRequestMultiError) AllErrors() []error { return m }
+
+// GetServiceInfoRequestValidationError is the validation error returned by
+// GetServiceInfoRequest.Validate if the designated constraints aren't met.
+type GetServiceInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetServiceInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetServiceInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetServiceInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetServiceInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetServiceInfoRequestValidationError) ErrorName() string {
+	return "GetServiceInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetServiceInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetServiceInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetServiceInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetServiceInfoRequestValidationError{}
+
+// Validate checks the field values on GetServiceInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetServiceInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetServiceInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetServiceInfoResponseMultiError, or nil if none found.
+func (m *GetServiceInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetServiceInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetServiceInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetServiceInfoResponseValidationError{
+					field:  "ServiceInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetServiceInfoResponseValidationError{
+					field:  "ServiceInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetServiceInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetServiceInfoResponseValidationError{
+				field:  "ServiceInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEndpoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetServiceInfoResponseValidationError{
+						field:  fmt.Sprintf("Endpoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetServiceInfoResponseValidationError{
+						field:  fmt.Sprintf("Endpoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetServiceInfoResponseValidationError{
+					field:  fmt.Sprintf("Endpoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetServiceInfoResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetServiceInfoResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetServiceInfoResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetServiceInfoResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetServiceInfoResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetServiceInfoResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetServiceInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetServiceInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by GetServiceInfoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetServiceInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetServiceInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetServiceInfoResponseMultiError) AllErrors() []error { return m }
+
+// GetServiceInfoResponseValidationError is the validation error returned by
+// GetServiceInfoResponse.Validate if the designated constraints aren't met.
+type GetServiceInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetServiceInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetServiceInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetServiceInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetServiceInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetServiceInfoResponseValidationError) ErrorName() string {
+	return "GetServiceInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetServiceInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetServiceInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetServiceInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetServiceInfoResponseValidationError{}
+
+// Validate checks the field values on ServiceInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ServiceInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ServiceInfoMultiError, or
+// nil if none found.
+func (m *ServiceInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Version
+
+	// no validation rules for Description
+
+	// no validation rules for BuildTime
+
+	// no validation rules for GitCommit
+
+	// no validation rules for Environment
+
+	// no validation rules for Configuration
+
+	if len(errors) > 0 {
+		return ServiceInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceInfoMultiError is an error wrapping multiple validation errors
+// returned by ServiceInfo.ValidateAll() if the designated constraints aren't met.
+type ServiceInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceInfoMultiError) AllErrors() []error { return m }
+
+// ServiceInfoValidationError is the validation error returned by
+// ServiceInfo.Validate if the designated constraints aren't met.
+type ServiceInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceInfoValidationError) ErrorName() string { return "ServiceInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceInfoValidationError{}
+
+// Validate checks the field values on ServiceEndpoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ServiceEndpoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceEndpoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServiceEndpointMultiError, or nil if none found.
+func (m *ServiceEndpoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceEndpoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Path
+
+	// no validation rules for Method
+
+	// no validation rules for Description
+
+	// no validation rules for RequiresAuth
+
+	if all {
+		switch v := interface{}(m.GetRateLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceEndpointValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceEndpointValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceEndpointValidationError{
+				field:  "RateLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ServiceEndpointMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceEndpointMultiError is an error wrapping multiple validation errors
+// returned by ServiceEndpoint.ValidateAll() if the designated constraints
+// aren't met.
+type ServiceEndpointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceEndpointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceEndpointMultiError) AllErrors() []error { return m }
+
+// ServiceEndpointValidationError is the validation error returned by
+// ServiceEndpoint.Validate if the designated constraints aren't met.
+type ServiceEndpointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceEndpointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceEndpointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceEndpointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceEndpointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceEndpointValidationError) ErrorName() string { return "ServiceEndpointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceEndpointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceEndpoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceEndpointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceEndpointValidationError{}
+
+// Validate checks the field values on GetFeatureFlagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFeatureFlagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFeatureFlagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFeatureFlagsRequestMultiError, or nil if none found.
+func (m *GetFeatureFlagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFeatureFlagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ClientVersion
+
+	// no validation rules for Platform
+
+	// no validation rules for UserAttributes
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFeatureFlagsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFeatureFlagsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFeatureFlagsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFeatureFlagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFeatureFlagsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFeatureFlagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFeatureFlagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFeatureFlagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFeatureFlagsRequestMultiError) AllErrors() []error { return m }
+
+// GetFeatureFlagsRequestValidationError is the validation error returned by
+// GetFeatureFlagsRequest.Validate if the designated constraints aren't met.
+type GetFeatureFlagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFeatureFlagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFeatureFlagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFeatureFlagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFeatureFlagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFeatureFlagsRequestValidationError) ErrorName() string {
+	return "GetFeatureFlagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFeatureFlagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFeatureFlagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFeatureFlagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFeatureFlagsRequestValidationError{}
+
+// Validate checks the field values on GetFeatureFlagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFeatureFlagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFeatureFlagsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFeatureFlagsResponseMultiError, or nil if none found.
+func (m *GetFeatureFlagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFeatureFlagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFlags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFeatureFlagsResponseValidationError{
+						field:  fmt.Sprintf("Flags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFeatureFlagsResponseValidationError{
+						field:  fmt.Sprintf("Flags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFeatureFlagsResponseValidationError{
+					field:  fmt.Sprintf("Flags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Experiments
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFeatureFlagsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFeatureFlagsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFeatureFlagsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFeatureFlagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFeatureFlagsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFeatureFlagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFeatureFlagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFeatureFlagsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFeatureFlagsResponseMultiError) AllErrors() []error { return m }
+
+// GetFeatureFlagsResponseValidationError is the validation error returned by
+// GetFeatureFlagsResponse.Validate if the designated constraints aren't met.
+type GetFeatureFlagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFeatureFlagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFeatureFlagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFeatureFlagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFeatureFlagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFeatureFlagsResponseValidationError) ErrorName() string {
+	return "GetFeatureFlagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFeatureFlagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFeatureFlagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFeatureFlagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFeatureFlagsResponseValidationError{}
+
+// Validate checks the field values on ServiceDependencies with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ServiceDependencies) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceDependencies with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServiceDependenciesMultiError, or nil if none found.
+func (m *ServiceDependencies) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceDependencies) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDatabase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceDependenciesValidationError{
+					field:  "Database",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceDependenciesValidationError{
+					field:  "Database",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDatabase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceDependenciesValidationError{
+				field:  "Database",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRedisCache()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceDependenciesValidationError{
+					field:  "RedisCache",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceDependenciesValidationError{
+					field:  "RedisCache",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedisCache()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceDependenciesValidationError{
+				field:  "RedisCache",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAiService()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceDependenciesValidationError{
+					field:  "AiService",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceDependenciesValidationError{
+					field:  "AiService",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAiService()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceDependenciesValidationError{
+				field:  "AiService",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExternalApis()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceDependenciesValidationError{
+					field:  "ExternalApis",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceDependenciesValidationError{
+					field:  "ExternalApis",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExternalApis()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceDependenciesValidationError{
+				field:  "ExternalApis",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFileStorage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceDependenciesValidationError{
+					field:  "FileStorage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceDependenciesValidationError{
+					field:  "FileStorage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFileStorage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceDependenciesValidationError{
+				field:  "FileStorage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ServiceDependenciesMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceDependenciesMultiError is an error wrapping multiple validation
+// errors returned by ServiceDependencies.ValidateAll() if the designated
+// constraints aren't met.
+type ServiceDependenciesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceDependenciesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceDependenciesMultiError) AllErrors() []error { return m }
+
+// ServiceDependenciesValidationError is the validation error returned by
+// ServiceDependencies.Validate if the designated constraints aren't met.
+type ServiceDependenciesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceDependenciesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceDependenciesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceDependenciesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceDependenciesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceDependenciesValidationError) ErrorName() string {
+	return "ServiceDependenciesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServiceDependenciesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceDependencies.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceDependenciesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceDependenciesValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
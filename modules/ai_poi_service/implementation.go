@@ -8,13 +8,14 @@ import (
 
 	"github.com/FACorreiaa/loci-proto/core"
 	c "github.com/FACorreiaa/loci-proto/modules/ai_poi_service/generated"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
 	"github.com/FACorreiaa/loci-proto/utils"
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.AiPoiServiceClient
 }
 
 var (
@@ -22,7 +23,7 @@ var (
 	_ core.Broker          = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -30,34 +31,54 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewAiPoiServiceClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.AiPoiServiceClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewAiPoiServiceClient(conn), nil
+}
+
 // AiPoiService method implementations
-func (b *Broker) HealthCheck(ctx context.Context, in *c.HealthCheckRequest, opts ...grpc.CallOption) (*c.HealthCheckResponse, error) {
-	return b.client.HealthCheck(ctx, in, opts...)
+func (b *Broker) HealthCheck(ctx context.Context, in *common.HealthCheckRequest, opts ...grpc.CallOption) (*common.HealthCheckResponse, error) {
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.HealthCheck(ctx, in, opts...)
 }
 
 func (b *Broker) GetServiceInfo(ctx context.Context, in *c.GetServiceInfoRequest, opts ...grpc.CallOption) (*c.GetServiceInfoResponse, error) {
-	return b.client.GetServiceInfo(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetServiceInfo(ctx, in, opts...)
 }
 
 func (b *Broker) GetFeatureFlags(ctx context.Context, in *c.GetFeatureFlagsRequest, opts ...grpc.CallOption) (*c.GetFeatureFlagsResponse, error) {
-	return b.client.GetFeatureFlags(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetFeatureFlags(ctx, in, opts...)
 }
\ No newline at end of file
@@ -0,0 +1,405 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	c "github.com/FACorreiaa/loci-proto/modules/auth/generated"
+)
+
+// DefaultRefreshMargin is how long before expiry a RefreshingTokenSource
+// swaps its access token for a new one.
+const DefaultRefreshMargin = 30 * time.Second
+
+// Token is an access token issued by AuthService along with what is needed
+// to refresh it.
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	Expiry       time.Time
+}
+
+// TokenFromLogin extracts the token issued with a LoginResponse.
+func TokenFromLogin(resp *c.LoginResponse) *Token {
+	return newToken(resp.GetAccessToken(), resp.GetRefreshToken(), resp.GetExpiresIn())
+}
+
+// TokenFromResponse extracts the token issued with a TokenResponse.
+func TokenFromResponse(resp *c.TokenResponse) *Token {
+	return newToken(resp.GetAccessToken(), resp.GetRefreshToken(), resp.GetExpiresIn())
+}
+
+func newToken(accessToken, refreshToken string, expiresIn int64) *Token {
+	t := &Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+	if expiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	}
+
+	return t
+}
+
+// expiresWithin reports whether t expires in less than d. Tokens without an
+// expiry never do.
+func (t *Token) expiresWithin(d time.Duration) bool {
+	return !t.Expiry.IsZero() && time.Until(t.Expiry) < d
+}
+
+// TokenSource hands out the access token to attach to outgoing calls.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// RefreshingTokenSource keeps an access token fresh by calling
+// AuthService.RefreshToken shortly before it expires. Concurrent callers
+// share a single refresh.
+type RefreshingTokenSource struct {
+	client c.AuthServiceClient
+	margin time.Duration
+
+	refresh singleflight.Group
+
+	mu    sync.Mutex
+	token *Token
+	stale bool
+}
+
+// NewRefreshingTokenSource creates a token source that starts from token and
+// refreshes it through client. A margin of zero uses DefaultRefreshMargin.
+func NewRefreshingTokenSource(client c.AuthServiceClient, token *Token, margin time.Duration) *RefreshingTokenSource {
+	if margin <= 0 {
+		margin = DefaultRefreshMargin
+	}
+
+	return &RefreshingTokenSource{
+		client: client,
+		margin: margin,
+		token:  token,
+	}
+}
+
+// Token returns the current access token, refreshing it first when it is
+// about to expire or was rejected by the server. The lock is not held while
+// the refresh call runs, so the client may itself carry Credentials backed
+// by s.
+func (s *RefreshingTokenSource) Token(ctx context.Context) (*Token, error) {
+	if token, ok := s.current(); ok {
+		return token, nil
+	}
+
+	token, err, _ := s.refresh.Do("", func() (any, error) {
+		return s.refreshToken(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return token.(*Token), nil
+}
+
+// current returns the token, and whether it can be used without a refresh.
+func (s *RefreshingTokenSource) current() (*Token, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.token, s.token != nil && !s.stale && !s.token.expiresWithin(s.margin)
+}
+
+func (s *RefreshingTokenSource) refreshToken(ctx context.Context) (*Token, error) {
+	// A refresh that completed since the caller looked may have made this
+	// one unnecessary.
+	current, ok := s.current()
+	if ok {
+		return current, nil
+	}
+	if current == nil {
+		return nil, errors.New("no token to refresh")
+	}
+
+	resp, err := s.client.RefreshToken(ctx, &c.RefreshTokenRequest{RefreshToken: current.RefreshToken})
+	if err != nil {
+		return nil, err
+	}
+
+	token := TokenFromResponse(resp)
+	if token.RefreshToken == "" {
+		// Servers that do not rotate refresh tokens keep the old one valid.
+		token.RefreshToken = current.RefreshToken
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+	s.stale = false
+
+	return token, nil
+}
+
+// Invalidate marks the current access token as rejected, so the next call
+// to Token refreshes it regardless of its expiry.
+func (s *RefreshingTokenSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stale = true
+}
+
+// Credentials attaches the bearer token from Source to every call. Pass it
+// to a Broker with utils.WithCredentials, which also installs the
+// interceptors that retry a call once when the server answers
+// Unauthenticated.
+type Credentials struct {
+	Source TokenSource
+
+	// AllowInsecure lets the token travel over plaintext connections. Only
+	// use it for local development.
+	AllowInsecure bool
+}
+
+var _ credentials.PerRPCCredentials = (*Credentials)(nil)
+
+// anonymousMethods are the AuthService calls that obtain tokens rather than
+// use one. They carry no token and are not retried, which also keeps a
+// refresh through a client that carries Credentials from waiting on itself.
+var anonymousMethods = map[string]bool{
+	c.AuthService_Register_FullMethodName:       true,
+	c.AuthService_Login_FullMethodName:          true,
+	c.AuthService_RefreshToken_FullMethodName:   true,
+	c.AuthService_GoogleLogin_FullMethodName:    true,
+	c.AuthService_GoogleCallback_FullMethodName: true,
+}
+
+// NewCredentials creates per-call credentials backed by source.
+func NewCredentials(source TokenSource) *Credentials {
+	return &Credentials{Source: source}
+}
+
+func (cr *Credentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	if info, ok := credentials.RequestInfoFromContext(ctx); ok && anonymousMethods[info.Method] {
+		return nil, nil
+	}
+
+	token, err := cr.Source.Token(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to obtain access token: %v", err)
+	}
+
+	return map[string]string{"authorization": "Bearer " + token.AccessToken}, nil
+}
+
+func (cr *Credentials) RequireTransportSecurity() bool {
+	return !cr.AllowInsecure
+}
+
+// invalidate drops the current token when the source supports it, and
+// reports whether a retry could use a different one.
+func (cr *Credentials) invalidate() bool {
+	source, ok := cr.Source.(interface{ Invalidate() })
+	if ok {
+		source.Invalidate()
+	}
+
+	return ok
+}
+
+// UnaryClientInterceptor retries a call once with a refreshed token when the
+// server rejects it as Unauthenticated.
+func (cr *Credentials) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if anonymousMethods[method] || status.Code(err) != codes.Unauthenticated || !cr.invalidate() {
+			return err
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor retries a stream once with a refreshed token when
+// the server rejects it as Unauthenticated, either when it is opened or on
+// the first receive. In the latter case the stream is reopened and the
+// messages sent so far are sent again, so streams that fail after a message
+// was received, or after more than MaxReplayedMessages were sent, are not
+// retried.
+func (cr *Credentials) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if anonymousMethods[method] {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if status.Code(err) == codes.Unauthenticated && cr.invalidate() {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &retryingStream{
+			ClientStream: stream,
+			reopen: func() (grpc.ClientStream, error) {
+				return streamer(ctx, desc, cc, method, opts...)
+			},
+			invalidate: cr.invalidate,
+			replay:     true,
+		}, nil
+	}
+}
+
+// MaxReplayedMessages is how many messages a stream keeps to send again
+// when it is retried. Streams that send more before their first receive are
+// not retried.
+const MaxReplayedMessages = 16
+
+// retryingStream keeps what was sent on a stream until its first message is
+// received, so that it can reopen the stream with a refreshed token and
+// send it again. The lock is never held across a call on the underlying
+// stream, so sending and receiving from two goroutines stays possible.
+type retryingStream struct {
+	grpc.ClientStream
+
+	reopen     func() (grpc.ClientStream, error)
+	invalidate func() bool
+
+	mu     sync.Mutex
+	sent   []any
+	closed bool
+	// replay is whether the stream may still be retried.
+	replay bool
+	// retrying is closed once a retry has swapped the stream, and nil
+	// outside of one. Sends wait for it, so they go to the new stream
+	// after the replayed messages.
+	retrying chan struct{}
+}
+
+// stream returns the current stream, once any retry is done with it.
+func (s *retryingStream) stream() grpc.ClientStream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.waitRetry()
+
+	return s.ClientStream
+}
+
+// waitRetry waits for a retry in progress to swap the stream. s.mu must be
+// held, and is released while waiting.
+func (s *retryingStream) waitRetry() {
+	for s.retrying != nil {
+		done := s.retrying
+		s.mu.Unlock()
+		<-done
+		s.mu.Lock()
+	}
+}
+
+func (s *retryingStream) SendMsg(m any) error {
+	s.mu.Lock()
+	s.waitRetry()
+	if s.replay {
+		if len(s.sent) < MaxReplayedMessages {
+			if msg, ok := m.(proto.Message); ok {
+				m = proto.Clone(msg)
+			}
+			s.sent = append(s.sent, m)
+		} else {
+			s.replay, s.sent = false, nil
+		}
+	}
+	stream := s.ClientStream
+	s.mu.Unlock()
+
+	return stream.SendMsg(m)
+}
+
+func (s *retryingStream) CloseSend() error {
+	s.mu.Lock()
+	s.waitRetry()
+	s.closed = true
+	stream := s.ClientStream
+	s.mu.Unlock()
+
+	return stream.CloseSend()
+}
+
+func (s *retryingStream) Context() context.Context {
+	return s.stream().Context()
+}
+
+func (s *retryingStream) Header() (metadata.MD, error) {
+	return s.stream().Header()
+}
+
+func (s *retryingStream) Trailer() metadata.MD {
+	return s.stream().Trailer()
+}
+
+func (s *retryingStream) RecvMsg(m any) error {
+	err := s.stream().RecvMsg(m)
+
+	s.mu.Lock()
+	if err == nil || !s.replay || status.Code(err) != codes.Unauthenticated {
+		s.replay, s.sent = false, nil
+		s.mu.Unlock()
+		return err
+	}
+	sent, closed := s.sent, s.closed
+	s.replay, s.sent = false, nil
+	done := make(chan struct{})
+	s.retrying = done
+	s.mu.Unlock()
+
+	stream, err := s.retry(err, sent, closed)
+
+	s.mu.Lock()
+	if err == nil {
+		s.ClientStream = stream
+	}
+	s.retrying = nil
+	s.mu.Unlock()
+	close(done)
+
+	if err != nil {
+		return err
+	}
+
+	return stream.RecvMsg(m)
+}
+
+// retry reopens the stream with a refreshed token and sends sent again. It
+// returns cause when the token cannot be refreshed.
+func (s *retryingStream) retry(cause error, sent []any, closed bool) (grpc.ClientStream, error) {
+	if !s.invalidate() {
+		return nil, cause
+	}
+
+	stream, err := s.reopen()
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range sent {
+		if err := stream.SendMsg(msg); err != nil {
+			return nil, err
+		}
+	}
+	if closed {
+		if err := stream.CloseSend(); err != nil {
+			return nil, err
+		}
+	}
+
+	return stream, nil
+}
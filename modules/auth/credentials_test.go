@@ -0,0 +1,354 @@
+package auth
+
+import (
+	"context"
+	"io"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	c "github.com/FACorreiaa/loci-proto/modules/auth/generated"
+)
+
+// refresher is an AuthServiceClient whose RefreshToken hands out numbered
+// tokens, once release is closed.
+type refresher struct {
+	c.AuthServiceClient
+
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (r *refresher) RefreshToken(ctx context.Context, in *c.RefreshTokenRequest, _ ...grpc.CallOption) (*c.TokenResponse, error) {
+	n := r.calls.Add(1)
+	select {
+	case <-r.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return &c.TokenResponse{AccessToken: "access-" + string(rune('0'+n)), ExpiresIn: 3600}, nil
+}
+
+func newRefresher() *refresher {
+	r := &refresher{release: make(chan struct{})}
+	close(r.release)
+
+	return r
+}
+
+func TestRefreshingTokenSourceSingleflight(t *testing.T) {
+	client := &refresher{release: make(chan struct{})}
+	expired := &Token{AccessToken: "old", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Minute)}
+	source := NewRefreshingTokenSource(client, expired, 0)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	tokens := make([]*Token, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := source.Token(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			tokens[i] = token
+		}()
+	}
+
+	// Let every caller reach the refresh before it completes.
+	for client.calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(client.release)
+	wg.Wait()
+
+	if n := client.calls.Load(); n != 1 {
+		t.Errorf("RefreshToken called %d times, want 1", n)
+	}
+	for _, token := range tokens {
+		if token == nil || token.AccessToken != "access-1" || token.RefreshToken != "refresh" {
+			t.Errorf("Token() = %+v, want the refreshed token keeping the refresh token", token)
+		}
+	}
+
+	// A fresh token is handed out without a refresh, until it is rejected.
+	if token, _ := source.Token(context.Background()); token.AccessToken != "access-1" || client.calls.Load() != 1 {
+		t.Errorf("Token() = %+v after %d refreshes, want the current token", token, client.calls.Load())
+	}
+	source.Invalidate()
+	if token, _ := source.Token(context.Background()); token.AccessToken != "access-2" {
+		t.Errorf("Token() after Invalidate = %+v, want a refreshed token", token)
+	}
+}
+
+func TestRefreshingTokenSourceWithoutToken(t *testing.T) {
+	source := NewRefreshingTokenSource(newRefresher(), nil, 0)
+	if _, err := source.Token(context.Background()); err == nil {
+		t.Error("Token() without a token to refresh succeeded")
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		errs      []error
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{name: "success", method: c.AuthService_ValidateSession_FullMethodName, errs: []error{nil}, wantCalls: 1},
+		{name: "retried once", method: c.AuthService_ValidateSession_FullMethodName, errs: []error{status.Error(codes.Unauthenticated, "expired"), nil}, wantCalls: 2},
+		{
+			name:      "rejected twice",
+			method:    c.AuthService_ValidateSession_FullMethodName,
+			errs:      []error{status.Error(codes.Unauthenticated, "expired"), status.Error(codes.Unauthenticated, "still expired")},
+			wantCalls: 2,
+			wantCode:  codes.Unauthenticated,
+		},
+		{name: "other error", method: c.AuthService_ValidateSession_FullMethodName, errs: []error{status.Error(codes.PermissionDenied, "no")}, wantCalls: 1, wantCode: codes.PermissionDenied},
+		{name: "anonymous method", method: c.AuthService_Login_FullMethodName, errs: []error{status.Error(codes.Unauthenticated, "bad password")}, wantCalls: 1, wantCode: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newRefresher()
+			source := NewRefreshingTokenSource(client, &Token{AccessToken: "old", RefreshToken: "refresh"}, 0)
+			creds := NewCredentials(source)
+
+			calls := 0
+			invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+				calls++
+				return tt.errs[calls-1]
+			}
+
+			err := creds.UnaryClientInterceptor()(context.Background(), tt.method, nil, nil, nil, invoker)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("error = %v, want code %v", err, tt.wantCode)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("invoked %d times, want %d", calls, tt.wantCalls)
+			}
+
+			// Only a retry invalidates the token.
+			token, _ := source.Token(context.Background())
+			if refreshed := token.AccessToken != "old"; refreshed != (tt.wantCalls == 2) {
+				t.Errorf("token is %q after %d calls", token.AccessToken, calls)
+			}
+		})
+	}
+}
+
+// fakeStream is a client stream recording what is sent on it. RecvMsg
+// returns recvErr, once unblock is closed, if it is set.
+type fakeStream struct {
+	ctx     context.Context
+	recvErr error
+	unblock chan struct{}
+
+	mu     sync.Mutex
+	sent   []string
+	closed bool
+}
+
+func (f *fakeStream) Header() (metadata.MD, error) { return nil, nil }
+func (f *fakeStream) Trailer() metadata.MD         { return nil }
+func (f *fakeStream) Context() context.Context     { return f.ctx }
+
+func (f *fakeStream) CloseSend() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.closed = true
+
+	return nil
+}
+
+func (f *fakeStream) SendMsg(m any) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sent = append(f.sent, m.(*c.RefreshTokenRequest).GetRefreshToken())
+
+	return nil
+}
+
+func (f *fakeStream) RecvMsg(m any) error {
+	if f.unblock != nil {
+		<-f.unblock
+	}
+	if f.recvErr != nil {
+		return f.recvErr
+	}
+	proto.Merge(m.(proto.Message), &c.TokenResponse{AccessToken: "received"})
+
+	return nil
+}
+
+func (f *fakeStream) messages() ([]string, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string(nil), f.sent...), f.closed
+}
+
+// openStream opens a stream through the interceptor of creds, onto streams
+// in turn.
+func openStream(t *testing.T, creds *Credentials, streams ...*fakeStream) (grpc.ClientStream, *int) {
+	t.Helper()
+
+	opened := 0
+	streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+		if opened == len(streams) {
+			t.Fatal("stream opened too many times")
+		}
+		opened++
+		return streams[opened-1], nil
+	}
+
+	stream, err := creds.StreamClientInterceptor()(context.Background(), &grpc.StreamDesc{ClientStreams: true, ServerStreams: true}, nil, c.AuthService_ValidateSession_FullMethodName, streamer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return stream, &opened
+}
+
+func send(t *testing.T, stream grpc.ClientStream, msgs ...string) {
+	t.Helper()
+
+	for _, msg := range msgs {
+		if err := stream.SendMsg(&c.RefreshTokenRequest{RefreshToken: msg}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestStreamClientInterceptorReplay(t *testing.T) {
+	rejected := &fakeStream{ctx: context.Background(), recvErr: status.Error(codes.Unauthenticated, "expired")}
+	accepted := &fakeStream{ctx: context.Background()}
+	creds := NewCredentials(NewRefreshingTokenSource(newRefresher(), &Token{AccessToken: "old", RefreshToken: "refresh"}, 0))
+
+	stream, opened := openStream(t, creds, rejected, accepted)
+	send(t, stream, "a", "b")
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+
+	reply := new(c.TokenResponse)
+	if err := stream.RecvMsg(reply); err != nil {
+		t.Fatalf("RecvMsg() = %v, want the retried stream to succeed", err)
+	}
+	if reply.GetAccessToken() != "received" || *opened != 2 {
+		t.Errorf("RecvMsg() = %v after opening %d streams", reply, *opened)
+	}
+	if sent, closed := accepted.messages(); len(sent) != 2 || sent[0] != "a" || sent[1] != "b" || !closed {
+		t.Errorf("retried stream got %v, closed %t, want [a b] and closed", sent, closed)
+	}
+
+	// Later messages go to the new stream, and are no longer kept.
+	send(t, stream, "c")
+	if sent, _ := accepted.messages(); len(sent) != 3 {
+		t.Errorf("retried stream got %v, want the message sent after the retry", sent)
+	}
+}
+
+func TestStreamClientInterceptorNoReplay(t *testing.T) {
+	unauthenticated := status.Error(codes.Unauthenticated, "expired")
+	newCreds := func() *Credentials {
+		return NewCredentials(NewRefreshingTokenSource(newRefresher(), &Token{AccessToken: "old", RefreshToken: "refresh"}, 0))
+	}
+
+	t.Run("after a message was received", func(t *testing.T) {
+		first := &fakeStream{ctx: context.Background()}
+		stream, opened := openStream(t, newCreds(), first)
+		if err := stream.RecvMsg(new(c.TokenResponse)); err != nil {
+			t.Fatal(err)
+		}
+		first.recvErr = unauthenticated
+		if err := stream.RecvMsg(new(c.TokenResponse)); status.Code(err) != codes.Unauthenticated || *opened != 1 {
+			t.Errorf("RecvMsg() = %v after opening %d streams, want the error without a retry", err, *opened)
+		}
+	})
+
+	t.Run("past the replay limit", func(t *testing.T) {
+		stream, opened := openStream(t, newCreds(), &fakeStream{ctx: context.Background(), recvErr: unauthenticated})
+		for range MaxReplayedMessages + 1 {
+			send(t, stream, "m")
+		}
+		if err := stream.RecvMsg(new(c.TokenResponse)); status.Code(err) != codes.Unauthenticated || *opened != 1 {
+			t.Errorf("RecvMsg() = %v after opening %d streams, want the error without a retry", err, *opened)
+		}
+	})
+
+	t.Run("retried once only", func(t *testing.T) {
+		stream, opened := openStream(t, newCreds(),
+			&fakeStream{ctx: context.Background(), recvErr: unauthenticated},
+			&fakeStream{ctx: context.Background(), recvErr: unauthenticated})
+		if err := stream.RecvMsg(new(c.TokenResponse)); status.Code(err) != codes.Unauthenticated || *opened != 2 {
+			t.Errorf("RecvMsg() = %v after opening %d streams, want the second error", err, *opened)
+		}
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		stream, opened := openStream(t, newCreds(), &fakeStream{ctx: context.Background(), recvErr: io.EOF})
+		if err := stream.RecvMsg(new(c.TokenResponse)); err != io.EOF || *opened != 1 {
+			t.Errorf("RecvMsg() = %v after opening %d streams, want io.EOF", err, *opened)
+		}
+	})
+}
+
+func TestStreamClientInterceptorConcurrentSend(t *testing.T) {
+	rejected := &fakeStream{ctx: context.Background(), recvErr: status.Error(codes.Unauthenticated, "expired")}
+	blocked := &fakeStream{ctx: context.Background(), unblock: make(chan struct{})}
+	stream, _ := openStream(t, NewCredentials(NewRefreshingTokenSource(newRefresher(), &Token{AccessToken: "old", RefreshToken: "refresh"}, 0)), rejected, blocked)
+	send(t, stream, "a")
+
+	received := make(chan error, 1)
+	go func() {
+		received <- stream.RecvMsg(new(c.TokenResponse))
+	}()
+
+	// Wait for the retry to replay onto the new stream, whose RecvMsg then
+	// blocks until the server replies.
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(time.Millisecond) {
+		if sent, _ := blocked.messages(); len(sent) == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the stream was not retried")
+		}
+	}
+
+	// Sending must not wait for the server's reply.
+	sent := make(chan struct{})
+	go func() {
+		if err := stream.SendMsg(&c.RefreshTokenRequest{RefreshToken: "b"}); err != nil {
+			t.Error(err)
+		}
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SendMsg blocked on a pending RecvMsg")
+	}
+
+	close(blocked.unblock)
+	if err := <-received; err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := blocked.messages(); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("sent on the retried stream = %v, want [a b]", got)
+	}
+}
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -114,7 +115,7 @@ type RegisterRequest struct {
 	Email           string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Password        string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	ConfirmPassword string                 `protobuf:"bytes,4,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
-	Request         *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request         *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -177,7 +178,7 @@ func (x *RegisterRequest) GetConfirmPassword() string {
 	return ""
 }
 
-func (x *RegisterRequest) GetRequest() *BaseRequest {
+func (x *RegisterRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -185,11 +186,11 @@ func (x *RegisterRequest) GetRequest() *BaseRequest {
 }
 
 type RegisterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	User          *UserAuth              `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	User          *UserAuth               `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -245,7 +246,7 @@ func (x *RegisterResponse) GetUser() *UserAuth {
 	return nil
 }
 
-func (x *RegisterResponse) GetResponse() *BaseResponse {
+func (x *RegisterResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -257,7 +258,7 @@ type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -306,7 +307,7 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
-func (x *LoginRequest) GetRequest() *BaseRequest {
+func (x *LoginRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -314,14 +315,14 @@ func (x *LoginRequest) GetRequest() *BaseRequest {
 }
 
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	AccessToken   string                 `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresIn     int64                  `protobuf:"varint,5,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
-	User          *UserAuth              `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	AccessToken   string                  `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                  `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn     int64                   `protobuf:"varint,5,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	User          *UserAuth               `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -398,7 +399,7 @@ func (x *LoginResponse) GetUser() *UserAuth {
 	return nil
 }
 
-func (x *LoginResponse) GetResponse() *BaseResponse {
+func (x *LoginResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -409,7 +410,7 @@ func (x *LoginResponse) GetResponse() *BaseResponse {
 type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -451,7 +452,7 @@ func (x *RefreshTokenRequest) GetRefreshToken() string {
 	return ""
 }
 
-func (x *RefreshTokenRequest) GetRequest() *BaseRequest {
+func (x *RefreshTokenRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -459,11 +460,11 @@ func (x *RefreshTokenRequest) GetRequest() *BaseRequest {
 }
 
 type TokenResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	AccessToken   string                  `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                  `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn     int64                   `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -519,7 +520,7 @@ func (x *TokenResponse) GetExpiresIn() int64 {
 	return 0
 }
 
-func (x *TokenResponse) GetResponse() *BaseResponse {
+func (x *TokenResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -530,7 +531,7 @@ func (x *TokenResponse) GetResponse() *BaseResponse {
 type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -572,7 +573,7 @@ func (x *LogoutRequest) GetUserId() string {
 	return ""
 }
 
-func (x *LogoutRequest) GetRequest() *BaseRequest {
+func (x *LogoutRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -580,10 +581,10 @@ func (x *LogoutRequest) GetRequest() *BaseRequest {
 }
 
 type LogoutResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -632,7 +633,7 @@ func (x *LogoutResponse) GetMessage() string {
 	return ""
 }
 
-func (x *LogoutResponse) GetResponse() *BaseResponse {
+func (x *LogoutResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -643,7 +644,7 @@ func (x *LogoutResponse) GetResponse() *BaseResponse {
 type ValidateSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -685,7 +686,7 @@ func (x *ValidateSessionRequest) GetAccessToken() string {
 	return ""
 }
 
-func (x *ValidateSessionRequest) GetRequest() *BaseRequest {
+func (x *ValidateSessionRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -693,13 +694,13 @@ func (x *ValidateSessionRequest) GetRequest() *BaseRequest {
 }
 
 type ValidateSessionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Valid         bool                    `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	UserId        string                  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                  `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                  `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiresAt     int64                   `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -769,7 +770,7 @@ func (x *ValidateSessionResponse) GetExpiresAt() int64 {
 	return 0
 }
 
-func (x *ValidateSessionResponse) GetResponse() *BaseResponse {
+func (x *ValidateSessionResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -782,7 +783,7 @@ type UpdatePasswordRequest struct {
 	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	CurrentPassword string                 `protobuf:"bytes,2,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
 	NewPassword     string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
-	Request         *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request         *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -838,7 +839,7 @@ func (x *UpdatePasswordRequest) GetNewPassword() string {
 	return ""
 }
 
-func (x *UpdatePasswordRequest) GetRequest() *BaseRequest {
+func (x *UpdatePasswordRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -846,10 +847,10 @@ func (x *UpdatePasswordRequest) GetRequest() *BaseRequest {
 }
 
 type UpdatePasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -898,7 +899,7 @@ func (x *UpdatePasswordResponse) GetMessage() string {
 	return ""
 }
 
-func (x *UpdatePasswordResponse) GetResponse() *BaseResponse {
+func (x *UpdatePasswordResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -909,7 +910,7 @@ func (x *UpdatePasswordResponse) GetResponse() *BaseResponse {
 type GoogleLoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RedirectUri   string                 `protobuf:"bytes,1,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -951,7 +952,7 @@ func (x *GoogleLoginRequest) GetRedirectUri() string {
 	return ""
 }
 
-func (x *GoogleLoginRequest) GetRequest() *BaseRequest {
+func (x *GoogleLoginRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -959,9 +960,9 @@ func (x *GoogleLoginRequest) GetRequest() *BaseRequest {
 }
 
 type GoogleLoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuthUrl       string                 `protobuf:"bytes,1,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	AuthUrl       string                  `protobuf:"bytes,1,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1003,7 +1004,7 @@ func (x *GoogleLoginResponse) GetAuthUrl() string {
 	return ""
 }
 
-func (x *GoogleLoginResponse) GetResponse() *BaseResponse {
+func (x *GoogleLoginResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1014,7 +1015,7 @@ type GoogleCallbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1063,7 +1064,7 @@ func (x *GoogleCallbackRequest) GetState() string {
 	return ""
 }
 
-func (x *GoogleCallbackRequest) GetRequest() *BaseRequest {
+func (x *GoogleCallbackRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1155,6 +1156,10 @@ func (x *Claims) GetIss() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in auth.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -1207,6 +1212,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in auth.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -1272,7 +1281,7 @@ var File_auth_proto protoreflect.FileDescriptor
 const file_auth_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"auth.proto\x12\x0eai_poi.auth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x01\n" +
+	"auth.proto\x12\x0eai_poi.auth.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x01\n" +
 	"\bUserAuth\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
@@ -1281,22 +1290,22 @@ const file_auth_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc1\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc3\x01\n" +
 	"\x0fRegisterRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12)\n" +
-	"\x10confirm_password\x18\x04 \x01(\tR\x0fconfirmPassword\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.auth.v1.BaseRequestR\arequest\"\xae\x01\n" +
+	"\x10confirm_password\x18\x04 \x01(\tR\x0fconfirmPassword\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xb0\x01\n" +
 	"\x10RegisterResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
-	"\x04user\x18\x03 \x01(\v2\x18.ai_poi.auth.v1.UserAuthR\x04user\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.auth.v1.BaseResponseR\bresponse\"w\n" +
+	"\x04user\x18\x03 \x01(\v2\x18.ai_poi.auth.v1.UserAuthR\x04user\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"y\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.auth.v1.BaseRequestR\arequest\"\x92\x02\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x94\x02\n" +
 	"\rLoginResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
@@ -1304,72 +1313,72 @@ const file_auth_proto_rawDesc = "" +
 	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12\x1d\n" +
 	"\n" +
 	"expires_in\x18\x05 \x01(\x03R\texpiresIn\x12,\n" +
-	"\x04user\x18\x06 \x01(\v2\x18.ai_poi.auth.v1.UserAuthR\x04user\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.auth.v1.BaseResponseR\bresponse\"q\n" +
+	"\x04user\x18\x06 \x01(\v2\x18.ai_poi.auth.v1.UserAuthR\x04user\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"s\n" +
 	"\x13RefreshTokenRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.auth.v1.BaseRequestR\arequest\"\xb0\x01\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xb2\x01\n" +
 	"\rTokenResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
 	"\n" +
-	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.auth.v1.BaseResponseR\bresponse\"_\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"a\n" +
 	"\rLogoutRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.auth.v1.BaseRequestR\arequest\"~\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x80\x01\n" +
 	"\x0eLogoutResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.auth.v1.BaseResponseR\bresponse\"r\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"t\n" +
 	"\x16ValidateSessionRequest\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.auth.v1.BaseRequestR\arequest\"\xcb\x01\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xcd\x01\n" +
 	"\x17ValidateSessionResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
 	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1d\n" +
 	"\n" +
-	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.auth.v1.BaseResponseR\bresponse\"\xb5\x01\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb7\x01\n" +
 	"\x15UpdatePasswordRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12)\n" +
 	"\x10current_password\x18\x02 \x01(\tR\x0fcurrentPassword\x12!\n" +
-	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.auth.v1.BaseRequestR\arequest\"\x86\x01\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x88\x01\n" +
 	"\x16UpdatePasswordResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.auth.v1.BaseResponseR\bresponse\"n\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"p\n" +
 	"\x12GoogleLoginRequest\x12!\n" +
-	"\fredirect_uri\x18\x01 \x01(\tR\vredirectUri\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.auth.v1.BaseRequestR\arequest\"j\n" +
+	"\fredirect_uri\x18\x01 \x01(\tR\vredirectUri\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"l\n" +
 	"\x13GoogleLoginResponse\x12\x19\n" +
-	"\bauth_url\x18\x01 \x01(\tR\aauthUrl\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.auth.v1.BaseResponseR\bresponse\"x\n" +
+	"\bauth_url\x18\x01 \x01(\tR\aauthUrl\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"z\n" +
 	"\x15GoogleCallbackRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
-	"\x05state\x18\x02 \x01(\tR\x05state\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.auth.v1.BaseRequestR\arequest\"\x81\x01\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x81\x01\n" +
 	"\x06Claims\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
 	"\x04role\x18\x03 \x01(\tR\x04role\x12\x10\n" +
 	"\x03exp\x18\x04 \x01(\x03R\x03exp\x12\x10\n" +
 	"\x03iat\x18\x05 \x01(\x03R\x03iat\x12\x10\n" +
-	"\x03iss\x18\x06 \x01(\tR\x03iss\"N\n" +
+	"\x03iss\x18\x06 \x01(\tR\x03iss\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status2\xb4\x05\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x012\xb4\x05\n" +
 	"\vAuthService\x12M\n" +
 	"\bRegister\x12\x1f.ai_poi.auth.v1.RegisterRequest\x1a .ai_poi.auth.v1.RegisterResponse\x12D\n" +
 	"\x05Login\x12\x1c.ai_poi.auth.v1.LoginRequest\x1a\x1d.ai_poi.auth.v1.LoginResponse\x12R\n" +
@@ -1414,27 +1423,29 @@ var file_auth_proto_goTypes = []any{
 	(*BaseRequest)(nil),             // 17: ai_poi.auth.v1.BaseRequest
 	(*BaseResponse)(nil),            // 18: ai_poi.auth.v1.BaseResponse
 	(*timestamppb.Timestamp)(nil),   // 19: google.protobuf.Timestamp
+	(*generated.BaseRequest)(nil),   // 20: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),  // 21: ai_poi.common.v1.BaseResponse
 }
 var file_auth_proto_depIdxs = []int32{
 	19, // 0: ai_poi.auth.v1.UserAuth.created_at:type_name -> google.protobuf.Timestamp
 	19, // 1: ai_poi.auth.v1.UserAuth.updated_at:type_name -> google.protobuf.Timestamp
-	17, // 2: ai_poi.auth.v1.RegisterRequest.request:type_name -> ai_poi.auth.v1.BaseRequest
+	20, // 2: ai_poi.auth.v1.RegisterRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	0,  // 3: ai_poi.auth.v1.RegisterResponse.user:type_name -> ai_poi.auth.v1.UserAuth
-	18, // 4: ai_poi.auth.v1.RegisterResponse.response:type_name -> ai_poi.auth.v1.BaseResponse
-	17, // 5: ai_poi.auth.v1.LoginRequest.request:type_name -> ai_poi.auth.v1.BaseRequest
+	21, // 4: ai_poi.auth.v1.RegisterResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	20, // 5: ai_poi.auth.v1.LoginRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	0,  // 6: ai_poi.auth.v1.LoginResponse.user:type_name -> ai_poi.auth.v1.UserAuth
-	18, // 7: ai_poi.auth.v1.LoginResponse.response:type_name -> ai_poi.auth.v1.BaseResponse
-	17, // 8: ai_poi.auth.v1.RefreshTokenRequest.request:type_name -> ai_poi.auth.v1.BaseRequest
-	18, // 9: ai_poi.auth.v1.TokenResponse.response:type_name -> ai_poi.auth.v1.BaseResponse
-	17, // 10: ai_poi.auth.v1.LogoutRequest.request:type_name -> ai_poi.auth.v1.BaseRequest
-	18, // 11: ai_poi.auth.v1.LogoutResponse.response:type_name -> ai_poi.auth.v1.BaseResponse
-	17, // 12: ai_poi.auth.v1.ValidateSessionRequest.request:type_name -> ai_poi.auth.v1.BaseRequest
-	18, // 13: ai_poi.auth.v1.ValidateSessionResponse.response:type_name -> ai_poi.auth.v1.BaseResponse
-	17, // 14: ai_poi.auth.v1.UpdatePasswordRequest.request:type_name -> ai_poi.auth.v1.BaseRequest
-	18, // 15: ai_poi.auth.v1.UpdatePasswordResponse.response:type_name -> ai_poi.auth.v1.BaseResponse
-	17, // 16: ai_poi.auth.v1.GoogleLoginRequest.request:type_name -> ai_poi.auth.v1.BaseRequest
-	18, // 17: ai_poi.auth.v1.GoogleLoginResponse.response:type_name -> ai_poi.auth.v1.BaseResponse
-	17, // 18: ai_poi.auth.v1.GoogleCallbackRequest.request:type_name -> ai_poi.auth.v1.BaseRequest
+	21, // 7: ai_poi.auth.v1.LoginResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	20, // 8: ai_poi.auth.v1.RefreshTokenRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	21, // 9: ai_poi.auth.v1.TokenResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	20, // 10: ai_poi.auth.v1.LogoutRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	21, // 11: ai_poi.auth.v1.LogoutResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	20, // 12: ai_poi.auth.v1.ValidateSessionRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	21, // 13: ai_poi.auth.v1.ValidateSessionResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	20, // 14: ai_poi.auth.v1.UpdatePasswordRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	21, // 15: ai_poi.auth.v1.UpdatePasswordResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	20, // 16: ai_poi.auth.v1.GoogleLoginRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	21, // 17: ai_poi.auth.v1.GoogleLoginResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	20, // 18: ai_poi.auth.v1.GoogleCallbackRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	1,  // 19: ai_poi.auth.v1.AuthService.Register:input_type -> ai_poi.auth.v1.RegisterRequest
 	3,  // 20: ai_poi.auth.v1.AuthService.Login:input_type -> ai_poi.auth.v1.LoginRequest
 	5,  // 21: ai_poi.auth.v1.AuthService.RefreshToken:input_type -> ai_poi.auth.v1.RefreshTokenRequest
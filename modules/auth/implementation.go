@@ -12,9 +12,9 @@ import (
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.AuthServiceClient
 }
 
 var (
@@ -22,7 +22,7 @@ var (
 	_ core.Broker         = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -30,54 +30,99 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewAuthServiceClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.AuthServiceClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewAuthServiceClient(conn), nil
+}
+
 // AuthService method implementations
 func (b *Broker) Register(ctx context.Context, in *c.RegisterRequest, opts ...grpc.CallOption) (*c.RegisterResponse, error) {
-	return b.client.Register(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Register(ctx, in, opts...)
 }
 
 func (b *Broker) Login(ctx context.Context, in *c.LoginRequest, opts ...grpc.CallOption) (*c.LoginResponse, error) {
-	return b.client.Login(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Login(ctx, in, opts...)
 }
 
 func (b *Broker) RefreshToken(ctx context.Context, in *c.RefreshTokenRequest, opts ...grpc.CallOption) (*c.TokenResponse, error) {
-	return b.client.RefreshToken(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.RefreshToken(ctx, in, opts...)
 }
 
 func (b *Broker) Logout(ctx context.Context, in *c.LogoutRequest, opts ...grpc.CallOption) (*c.LogoutResponse, error) {
-	return b.client.Logout(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Logout(ctx, in, opts...)
 }
 
 func (b *Broker) ValidateSession(ctx context.Context, in *c.ValidateSessionRequest, opts ...grpc.CallOption) (*c.ValidateSessionResponse, error) {
-	return b.client.ValidateSession(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ValidateSession(ctx, in, opts...)
 }
 
 func (b *Broker) UpdatePassword(ctx context.Context, in *c.UpdatePasswordRequest, opts ...grpc.CallOption) (*c.UpdatePasswordResponse, error) {
-	return b.client.UpdatePassword(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.UpdatePassword(ctx, in, opts...)
 }
 
 func (b *Broker) GoogleLogin(ctx context.Context, in *c.GoogleLoginRequest, opts ...grpc.CallOption) (*c.GoogleLoginResponse, error) {
-	return b.client.GoogleLogin(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GoogleLogin(ctx, in, opts...)
 }
 
 func (b *Broker) GoogleCallback(ctx context.Context, in *c.GoogleCallbackRequest, opts ...grpc.CallOption) (*c.LoginResponse, error) {
-	return b.client.GoogleCallback(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GoogleCallback(ctx, in, opts...)
 }
\ No newline at end of file
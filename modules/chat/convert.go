@@ -0,0 +1,91 @@
+package chat
+
+import (
+	"maps"
+
+	c "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	helpers "github.com/FACorreiaa/loci-proto/modules/common"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// POIToCommon converts a POIDetailedInfo to the canonical POI. The
+// conversion is lossless: POIFromCommon turns the result back into a message
+// equal to p.
+func POIToCommon(p *c.POIDetailedInfo) *common.POI {
+	if p == nil {
+		return nil
+	}
+
+	return &common.POI{
+		Id:          p.GetId(),
+		Name:        p.GetName(),
+		Location:    helpers.NewPOILocation(p.GetLatitude(), p.GetLongitude()),
+		Category:    p.GetCategory(),
+		Description: p.GetDescription(),
+		Rating:      helpers.NewPOIRating(p.GetRating(), p.GetReviewCount()),
+		PriceRange:  helpers.PriceRangeFromLabel(p.GetPriceRange()),
+		PriceLabel:  p.GetPriceRange(),
+		Address:     helpers.NewPOIAddress(p.GetAddress(), "", ""),
+		Contact:     helpers.NewPOIContact(p.GetPhone(), "", p.GetWebsite()),
+		Media:       helpers.MediaFromURLs(p.GetPhotos()),
+		Metadata:    maps.Clone(p.GetMetadata()),
+	}
+}
+
+// POIFromCommon converts a canonical POI to a POIDetailedInfo, dropping the
+// fields it has no room for.
+func POIFromCommon(p *common.POI) *c.POIDetailedInfo {
+	if p == nil {
+		return nil
+	}
+
+	return &c.POIDetailedInfo{
+		Id:          p.GetId(),
+		Name:        p.GetName(),
+		Latitude:    p.GetLocation().GetLatitude(),
+		Longitude:   p.GetLocation().GetLongitude(),
+		Category:    p.GetCategory(),
+		Description: p.GetDescription(),
+		Rating:      p.GetRating().GetAverage(),
+		ReviewCount: p.GetRating().GetCount(),
+		PriceRange:  helpers.PriceLabel(p),
+		Address:     helpers.FormatAddress(p.GetAddress()),
+		Phone:       p.GetContact().GetPhone(),
+		Website:     p.GetContact().GetWebsite(),
+		Photos:      helpers.PhotoURLs(p.GetMedia()),
+		Metadata:    maps.Clone(p.GetMetadata()),
+	}
+}
+
+// POIReferenceToCommon converts the POI an itinerary item refers to. The
+// conversion is lossless: POIReferenceFromCommon turns the result back into
+// a message equal to ref.
+func POIReferenceToCommon(ref *c.POIReference) *common.POI {
+	if ref == nil {
+		return nil
+	}
+
+	return &common.POI{
+		Id:          ref.GetId(),
+		Name:        ref.GetName(),
+		Category:    ref.GetCategory(),
+		Location:    helpers.NewPOILocation(ref.GetLatitude(), ref.GetLongitude()),
+		Description: ref.GetDescription(),
+	}
+}
+
+// POIReferenceFromCommon converts a canonical POI to a reference.
+func POIReferenceFromCommon(p *common.POI) *c.POIReference {
+	if p == nil {
+		return nil
+	}
+
+	return &c.POIReference{
+		Id:          p.GetId(),
+		Name:        p.GetName(),
+		Category:    p.GetCategory(),
+		Latitude:    p.GetLocation().GetLatitude(),
+		Longitude:   p.GetLocation().GetLongitude(),
+		Description: p.GetDescription(),
+	}
+}
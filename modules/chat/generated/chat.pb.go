@@ -7,6 +7,7 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -1077,14 +1078,19 @@ func (x *CulturalInfo) GetFestivals() []string {
 
 // Estimated costs
 type EstimatedCosts struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	BudgetLow     float64                `protobuf:"fixed64,2,opt,name=budget_low,json=budgetLow,proto3" json:"budget_low,omitempty"`
-	BudgetMedium  float64                `protobuf:"fixed64,3,opt,name=budget_medium,json=budgetMedium,proto3" json:"budget_medium,omitempty"`
-	BudgetHigh    float64                `protobuf:"fixed64,4,opt,name=budget_high,json=budgetHigh,proto3" json:"budget_high,omitempty"`
-	Breakdown     []*CostBreakdown       `protobuf:"bytes,5,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Currency     string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	BudgetLow    float64                `protobuf:"fixed64,2,opt,name=budget_low,json=budgetLow,proto3" json:"budget_low,omitempty"`
+	BudgetMedium float64                `protobuf:"fixed64,3,opt,name=budget_medium,json=budgetMedium,proto3" json:"budget_medium,omitempty"`
+	BudgetHigh   float64                `protobuf:"fixed64,4,opt,name=budget_high,json=budgetHigh,proto3" json:"budget_high,omitempty"`
+	Breakdown    []*CostBreakdown       `protobuf:"bytes,5,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+	// The budgets as Money. Servers set both these and the plain budgets
+	// above, which are in currency.
+	BudgetLowAmount    *generated.Money `protobuf:"bytes,6,opt,name=budget_low_amount,json=budgetLowAmount,proto3" json:"budget_low_amount,omitempty"`
+	BudgetMediumAmount *generated.Money `protobuf:"bytes,7,opt,name=budget_medium_amount,json=budgetMediumAmount,proto3" json:"budget_medium_amount,omitempty"`
+	BudgetHighAmount   *generated.Money `protobuf:"bytes,8,opt,name=budget_high_amount,json=budgetHighAmount,proto3" json:"budget_high_amount,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *EstimatedCosts) Reset() {
@@ -1152,12 +1158,34 @@ func (x *EstimatedCosts) GetBreakdown() []*CostBreakdown {
 	return nil
 }
 
+func (x *EstimatedCosts) GetBudgetLowAmount() *generated.Money {
+	if x != nil {
+		return x.BudgetLowAmount
+	}
+	return nil
+}
+
+func (x *EstimatedCosts) GetBudgetMediumAmount() *generated.Money {
+	if x != nil {
+		return x.BudgetMediumAmount
+	}
+	return nil
+}
+
+func (x *EstimatedCosts) GetBudgetHighAmount() *generated.Money {
+	if x != nil {
+		return x.BudgetHighAmount
+	}
+	return nil
+}
+
 // Cost breakdown
 type CostBreakdown struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"` // In EstimatedCosts.currency
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Cost          *generated.Money       `protobuf:"bytes,4,opt,name=cost,proto3" json:"cost,omitempty"` // amount as Money
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1213,6 +1241,13 @@ func (x *CostBreakdown) GetDescription() string {
 	return ""
 }
 
+func (x *CostBreakdown) GetCost() *generated.Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
 // Request messages
 type StartChatRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -1221,7 +1256,7 @@ type StartChatRequest struct {
 	InitialMessage string                 `protobuf:"bytes,3,opt,name=initial_message,json=initialMessage,proto3" json:"initial_message,omitempty"`
 	ContextType    ChatContextType        `protobuf:"varint,4,opt,name=context_type,json=contextType,proto3,enum=ai_poi.chat.v1.ChatContextType" json:"context_type,omitempty"`
 	Metadata       map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Request        *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request        *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1291,7 +1326,7 @@ func (x *StartChatRequest) GetMetadata() map[string]string {
 	return nil
 }
 
-func (x *StartChatRequest) GetRequest() *BaseRequest {
+func (x *StartChatRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1304,7 +1339,7 @@ type ContinueChatRequest struct {
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	ContextType   ChatContextType        `protobuf:"varint,4,opt,name=context_type,json=contextType,proto3,enum=ai_poi.chat.v1.ChatContextType" json:"context_type,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1367,7 +1402,7 @@ func (x *ContinueChatRequest) GetContextType() ChatContextType {
 	return ChatContextType_CHAT_CONTEXT_TYPE_UNSPECIFIED
 }
 
-func (x *ContinueChatRequest) GetRequest() *BaseRequest {
+func (x *ContinueChatRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1379,7 +1414,7 @@ type FreeChatRequest struct {
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // For session continuity without auth
 	ContextType   ChatContextType        `protobuf:"varint,3,opt,name=context_type,json=contextType,proto3,enum=ai_poi.chat.v1.ChatContextType" json:"context_type,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1435,7 +1470,7 @@ func (x *FreeChatRequest) GetContextType() ChatContextType {
 	return ChatContextType_CHAT_CONTEXT_TYPE_UNSPECIFIED
 }
 
-func (x *FreeChatRequest) GetRequest() *BaseRequest {
+func (x *FreeChatRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1448,7 +1483,7 @@ type GetChatSessionsRequest struct {
 	ProfileId     string                 `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1511,7 +1546,7 @@ func (x *GetChatSessionsRequest) GetOffset() int32 {
 	return 0
 }
 
-func (x *GetChatSessionsRequest) GetRequest() *BaseRequest {
+func (x *GetChatSessionsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1519,10 +1554,10 @@ func (x *GetChatSessionsRequest) GetRequest() *BaseRequest {
 }
 
 type GetChatSessionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sessions      []*ChatSession         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Sessions      []*ChatSession          `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1571,7 +1606,7 @@ func (x *GetChatSessionsResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *GetChatSessionsResponse) GetResponse() *BaseResponse {
+func (x *GetChatSessionsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1686,7 +1721,7 @@ type SaveItineraryRequest struct {
 	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	ItineraryData *ItineraryResponse     `protobuf:"bytes,5,opt,name=itinerary_data,json=itineraryData,proto3" json:"itinerary_data,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1756,7 +1791,7 @@ func (x *SaveItineraryRequest) GetItineraryData() *ItineraryResponse {
 	return nil
 }
 
-func (x *SaveItineraryRequest) GetRequest() *BaseRequest {
+func (x *SaveItineraryRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1764,11 +1799,11 @@ func (x *SaveItineraryRequest) GetRequest() *BaseRequest {
 }
 
 type SaveItineraryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItineraryId   string                 `protobuf:"bytes,1,opt,name=itinerary_id,json=itineraryId,proto3" json:"itinerary_id,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ItineraryId   string                  `protobuf:"bytes,1,opt,name=itinerary_id,json=itineraryId,proto3" json:"itinerary_id,omitempty"`
+	Success       bool                    `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1824,7 +1859,7 @@ func (x *SaveItineraryResponse) GetMessage() string {
 	return ""
 }
 
-func (x *SaveItineraryResponse) GetResponse() *BaseResponse {
+func (x *SaveItineraryResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1836,7 +1871,7 @@ type GetSavedItinerariesRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1892,7 +1927,7 @@ func (x *GetSavedItinerariesRequest) GetOffset() int32 {
 	return 0
 }
 
-func (x *GetSavedItinerariesRequest) GetRequest() *BaseRequest {
+func (x *GetSavedItinerariesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1900,10 +1935,10 @@ func (x *GetSavedItinerariesRequest) GetRequest() *BaseRequest {
 }
 
 type GetSavedItinerariesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Itineraries   []*UserSavedItinerary  `protobuf:"bytes,1,rep,name=itineraries,proto3" json:"itineraries,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Itineraries   []*UserSavedItinerary   `protobuf:"bytes,1,rep,name=itineraries,proto3" json:"itineraries,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1952,7 +1987,7 @@ func (x *GetSavedItinerariesResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *GetSavedItinerariesResponse) GetResponse() *BaseResponse {
+func (x *GetSavedItinerariesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2063,7 +2098,7 @@ type RemoveItineraryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ItineraryId   string                 `protobuf:"bytes,2,opt,name=itinerary_id,json=itineraryId,proto3" json:"itinerary_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2112,7 +2147,7 @@ func (x *RemoveItineraryRequest) GetItineraryId() string {
 	return ""
 }
 
-func (x *RemoveItineraryRequest) GetRequest() *BaseRequest {
+func (x *RemoveItineraryRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2120,10 +2155,10 @@ func (x *RemoveItineraryRequest) GetRequest() *BaseRequest {
 }
 
 type RemoveItineraryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2172,7 +2207,7 @@ func (x *RemoveItineraryResponse) GetMessage() string {
 	return ""
 }
 
-func (x *RemoveItineraryResponse) GetResponse() *BaseResponse {
+func (x *RemoveItineraryResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2185,7 +2220,7 @@ type GetPOIDetailsRequest struct {
 	PoiId          string                 `protobuf:"bytes,1,opt,name=poi_id,json=poiId,proto3" json:"poi_id,omitempty"`
 	IncludeReviews bool                   `protobuf:"varint,2,opt,name=include_reviews,json=includeReviews,proto3" json:"include_reviews,omitempty"`
 	IncludePhotos  bool                   `protobuf:"varint,3,opt,name=include_photos,json=includePhotos,proto3" json:"include_photos,omitempty"`
-	Request        *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request        *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -2241,7 +2276,7 @@ func (x *GetPOIDetailsRequest) GetIncludePhotos() bool {
 	return false
 }
 
-func (x *GetPOIDetailsRequest) GetRequest() *BaseRequest {
+func (x *GetPOIDetailsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2249,9 +2284,9 @@ func (x *GetPOIDetailsRequest) GetRequest() *BaseRequest {
 }
 
 type GetPOIDetailsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Poi           *POIDetailedInfo       `protobuf:"bytes,1,opt,name=poi,proto3" json:"poi,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Poi           *POIDetailedInfo        `protobuf:"bytes,1,opt,name=poi,proto3" json:"poi,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2293,7 +2328,7 @@ func (x *GetPOIDetailsResponse) GetPoi() *POIDetailedInfo {
 	return nil
 }
 
-func (x *GetPOIDetailsResponse) GetResponse() *BaseResponse {
+func (x *GetPOIDetailsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2449,6 +2484,10 @@ func (x *POIDetailedInfo) GetMetadata() map[string]string {
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in chat.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -2501,6 +2540,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in chat.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -2566,7 +2609,7 @@ var File_chat_proto protoreflect.FileDescriptor
 const file_chat_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"chat.proto\x12\x0eai_poi.chat.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\x04\n" +
+	"chat.proto\x12\x0eai_poi.chat.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\x04\n" +
 	"\tChatEvent\x12\x1d\n" +
 	"\n" +
 	"event_type\x18\x01 \x01(\tR\teventType\x12\x12\n" +
@@ -2655,7 +2698,7 @@ const file_chat_proto_rawDesc = "" +
 	"\blanguage\x18\x01 \x01(\tR\blanguage\x12\x1a\n" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x18\n" +
 	"\acustoms\x18\x03 \x03(\tR\acustoms\x12\x1c\n" +
-	"\tfestivals\x18\x04 \x03(\tR\tfestivals\"\xce\x01\n" +
+	"\tfestivals\x18\x04 \x03(\tR\tfestivals\"\xa5\x03\n" +
 	"\x0eEstimatedCosts\x12\x1a\n" +
 	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1d\n" +
 	"\n" +
@@ -2663,46 +2706,50 @@ const file_chat_proto_rawDesc = "" +
 	"\rbudget_medium\x18\x03 \x01(\x01R\fbudgetMedium\x12\x1f\n" +
 	"\vbudget_high\x18\x04 \x01(\x01R\n" +
 	"budgetHigh\x12;\n" +
-	"\tbreakdown\x18\x05 \x03(\v2\x1d.ai_poi.chat.v1.CostBreakdownR\tbreakdown\"e\n" +
+	"\tbreakdown\x18\x05 \x03(\v2\x1d.ai_poi.chat.v1.CostBreakdownR\tbreakdown\x12C\n" +
+	"\x11budget_low_amount\x18\x06 \x01(\v2\x17.ai_poi.common.v1.MoneyR\x0fbudgetLowAmount\x12I\n" +
+	"\x14budget_medium_amount\x18\a \x01(\v2\x17.ai_poi.common.v1.MoneyR\x12budgetMediumAmount\x12E\n" +
+	"\x12budget_high_amount\x18\b \x01(\v2\x17.ai_poi.common.v1.MoneyR\x10budgetHighAmount\"\x92\x01\n" +
 	"\rCostBreakdown\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xf7\x02\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12+\n" +
+	"\x04cost\x18\x04 \x01(\v2\x17.ai_poi.common.v1.MoneyR\x04cost\"\xf9\x02\n" +
 	"\x10StartChatRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"profile_id\x18\x02 \x01(\tR\tprofileId\x12'\n" +
 	"\x0finitial_message\x18\x03 \x01(\tR\x0einitialMessage\x12B\n" +
 	"\fcontext_type\x18\x04 \x01(\x0e2\x1f.ai_poi.chat.v1.ChatContextTypeR\vcontextType\x12J\n" +
-	"\bmetadata\x18\x05 \x03(\v2..ai_poi.chat.v1.StartChatRequest.MetadataEntryR\bmetadata\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.chat.v1.BaseRequestR\arequest\x1a;\n" +
+	"\bmetadata\x18\x05 \x03(\v2..ai_poi.chat.v1.StartChatRequest.MetadataEntryR\bmetadata\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe2\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe4\x01\n" +
 	"\x13ContinueChatRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12B\n" +
-	"\fcontext_type\x18\x04 \x01(\x0e2\x1f.ai_poi.chat.v1.ChatContextTypeR\vcontextType\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.chat.v1.BaseRequestR\arequest\"\xcb\x01\n" +
+	"\fcontext_type\x18\x04 \x01(\x0e2\x1f.ai_poi.chat.v1.ChatContextTypeR\vcontextType\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xcd\x01\n" +
 	"\x0fFreeChatRequest\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12#\n" +
 	"\rsession_token\x18\x02 \x01(\tR\fsessionToken\x12B\n" +
-	"\fcontext_type\x18\x03 \x01(\x0e2\x1f.ai_poi.chat.v1.ChatContextTypeR\vcontextType\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.chat.v1.BaseRequestR\arequest\"\xb5\x01\n" +
+	"\fcontext_type\x18\x03 \x01(\x0e2\x1f.ai_poi.chat.v1.ChatContextTypeR\vcontextType\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xb7\x01\n" +
 	"\x16GetChatSessionsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"profile_id\x18\x02 \x01(\tR\tprofileId\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.chat.v1.BaseRequestR\arequest\"\xad\x01\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xaf\x01\n" +
 	"\x17GetChatSessionsResponse\x127\n" +
 	"\bsessions\x18\x01 \x03(\v2\x1b.ai_poi.chat.v1.ChatSessionR\bsessions\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.chat.v1.BaseResponseR\bresponse\"\xca\x02\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xca\x02\n" +
 	"\vChatSession\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
@@ -2714,30 +2761,30 @@ const file_chat_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
 	"\rmessage_count\x18\a \x01(\x05R\fmessageCount\x12B\n" +
-	"\fcontext_type\x18\b \x01(\x0e2\x1f.ai_poi.chat.v1.ChatContextTypeR\vcontextType\"\x87\x02\n" +
+	"\fcontext_type\x18\b \x01(\x0e2\x1f.ai_poi.chat.v1.ChatContextTypeR\vcontextType\"\x89\x02\n" +
 	"\x14SaveItineraryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12H\n" +
-	"\x0eitinerary_data\x18\x05 \x01(\v2!.ai_poi.chat.v1.ItineraryResponseR\ritineraryData\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.chat.v1.BaseRequestR\arequest\"\xa8\x01\n" +
+	"\x0eitinerary_data\x18\x05 \x01(\v2!.ai_poi.chat.v1.ItineraryResponseR\ritineraryData\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xaa\x01\n" +
 	"\x15SaveItineraryResponse\x12!\n" +
 	"\fitinerary_id\x18\x01 \x01(\tR\vitineraryId\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.chat.v1.BaseResponseR\bresponse\"\x9a\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x9c\x01\n" +
 	"\x1aGetSavedItinerariesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.chat.v1.BaseRequestR\arequest\"\xbe\x01\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc0\x01\n" +
 	"\x1bGetSavedItinerariesResponse\x12D\n" +
 	"\vitineraries\x18\x01 \x03(\v2\".ai_poi.chat.v1.UserSavedItineraryR\vitineraries\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.chat.v1.BaseResponseR\bresponse\"\xb1\x02\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb1\x02\n" +
 	"\x12UserSavedItinerary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
@@ -2749,23 +2796,23 @@ const file_chat_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8b\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8d\x01\n" +
 	"\x16RemoveItineraryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
-	"\fitinerary_id\x18\x02 \x01(\tR\vitineraryId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.chat.v1.BaseRequestR\arequest\"\x87\x01\n" +
+	"\fitinerary_id\x18\x02 \x01(\tR\vitineraryId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x89\x01\n" +
 	"\x17RemoveItineraryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.chat.v1.BaseResponseR\bresponse\"\xb4\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb6\x01\n" +
 	"\x14GetPOIDetailsRequest\x12\x15\n" +
 	"\x06poi_id\x18\x01 \x01(\tR\x05poiId\x12'\n" +
 	"\x0finclude_reviews\x18\x02 \x01(\bR\x0eincludeReviews\x12%\n" +
-	"\x0einclude_photos\x18\x03 \x01(\bR\rincludePhotos\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.chat.v1.BaseRequestR\arequest\"\x84\x01\n" +
+	"\x0einclude_photos\x18\x03 \x01(\bR\rincludePhotos\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x86\x01\n" +
 	"\x15GetPOIDetailsResponse\x121\n" +
-	"\x03poi\x18\x01 \x01(\v2\x1f.ai_poi.chat.v1.POIDetailedInfoR\x03poi\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.chat.v1.BaseResponseR\bresponse\"\xf3\x03\n" +
+	"\x03poi\x18\x01 \x01(\v2\x1f.ai_poi.chat.v1.POIDetailedInfoR\x03poi\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xf3\x03\n" +
 	"\x0fPOIDetailedInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -2785,18 +2832,18 @@ const file_chat_proto_rawDesc = "" +
 	"\bmetadata\x18\x0e \x03(\v2-.ai_poi.chat.v1.POIDetailedInfo.MetadataEntryR\bmetadata\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status*\x81\x02\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x01*\x81\x02\n" +
 	"\x0fChatContextType\x12!\n" +
 	"\x1dCHAT_CONTEXT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19CHAT_CONTEXT_TYPE_GENERAL\x10\x01\x12&\n" +
@@ -2866,6 +2913,9 @@ var file_chat_proto_goTypes = []any{
 	nil,                                 // 34: ai_poi.chat.v1.StartChatRequest.MetadataEntry
 	nil,                                 // 35: ai_poi.chat.v1.POIDetailedInfo.MetadataEntry
 	(*timestamppb.Timestamp)(nil),       // 36: google.protobuf.Timestamp
+	(*generated.Money)(nil),             // 37: ai_poi.common.v1.Money
+	(*generated.BaseRequest)(nil),       // 38: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),      // 39: ai_poi.common.v1.BaseResponse
 }
 var file_chat_proto_depIdxs = []int32{
 	36, // 0: ai_poi.chat.v1.ChatEvent.timestamp:type_name -> google.protobuf.Timestamp
@@ -2888,54 +2938,58 @@ var file_chat_proto_depIdxs = []int32{
 	9,  // 17: ai_poi.chat.v1.ItineraryDay.activities:type_name -> ai_poi.chat.v1.ItineraryActivity
 	10, // 18: ai_poi.chat.v1.ItineraryActivity.poi_reference:type_name -> ai_poi.chat.v1.POIReference
 	14, // 19: ai_poi.chat.v1.EstimatedCosts.breakdown:type_name -> ai_poi.chat.v1.CostBreakdown
-	0,  // 20: ai_poi.chat.v1.StartChatRequest.context_type:type_name -> ai_poi.chat.v1.ChatContextType
-	34, // 21: ai_poi.chat.v1.StartChatRequest.metadata:type_name -> ai_poi.chat.v1.StartChatRequest.MetadataEntry
-	31, // 22: ai_poi.chat.v1.StartChatRequest.request:type_name -> ai_poi.chat.v1.BaseRequest
-	0,  // 23: ai_poi.chat.v1.ContinueChatRequest.context_type:type_name -> ai_poi.chat.v1.ChatContextType
-	31, // 24: ai_poi.chat.v1.ContinueChatRequest.request:type_name -> ai_poi.chat.v1.BaseRequest
-	0,  // 25: ai_poi.chat.v1.FreeChatRequest.context_type:type_name -> ai_poi.chat.v1.ChatContextType
-	31, // 26: ai_poi.chat.v1.FreeChatRequest.request:type_name -> ai_poi.chat.v1.BaseRequest
-	31, // 27: ai_poi.chat.v1.GetChatSessionsRequest.request:type_name -> ai_poi.chat.v1.BaseRequest
-	20, // 28: ai_poi.chat.v1.GetChatSessionsResponse.sessions:type_name -> ai_poi.chat.v1.ChatSession
-	32, // 29: ai_poi.chat.v1.GetChatSessionsResponse.response:type_name -> ai_poi.chat.v1.BaseResponse
-	36, // 30: ai_poi.chat.v1.ChatSession.created_at:type_name -> google.protobuf.Timestamp
-	36, // 31: ai_poi.chat.v1.ChatSession.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 32: ai_poi.chat.v1.ChatSession.context_type:type_name -> ai_poi.chat.v1.ChatContextType
-	7,  // 33: ai_poi.chat.v1.SaveItineraryRequest.itinerary_data:type_name -> ai_poi.chat.v1.ItineraryResponse
-	31, // 34: ai_poi.chat.v1.SaveItineraryRequest.request:type_name -> ai_poi.chat.v1.BaseRequest
-	32, // 35: ai_poi.chat.v1.SaveItineraryResponse.response:type_name -> ai_poi.chat.v1.BaseResponse
-	31, // 36: ai_poi.chat.v1.GetSavedItinerariesRequest.request:type_name -> ai_poi.chat.v1.BaseRequest
-	25, // 37: ai_poi.chat.v1.GetSavedItinerariesResponse.itineraries:type_name -> ai_poi.chat.v1.UserSavedItinerary
-	32, // 38: ai_poi.chat.v1.GetSavedItinerariesResponse.response:type_name -> ai_poi.chat.v1.BaseResponse
-	36, // 39: ai_poi.chat.v1.UserSavedItinerary.created_at:type_name -> google.protobuf.Timestamp
-	36, // 40: ai_poi.chat.v1.UserSavedItinerary.updated_at:type_name -> google.protobuf.Timestamp
-	31, // 41: ai_poi.chat.v1.RemoveItineraryRequest.request:type_name -> ai_poi.chat.v1.BaseRequest
-	32, // 42: ai_poi.chat.v1.RemoveItineraryResponse.response:type_name -> ai_poi.chat.v1.BaseResponse
-	31, // 43: ai_poi.chat.v1.GetPOIDetailsRequest.request:type_name -> ai_poi.chat.v1.BaseRequest
-	30, // 44: ai_poi.chat.v1.GetPOIDetailsResponse.poi:type_name -> ai_poi.chat.v1.POIDetailedInfo
-	32, // 45: ai_poi.chat.v1.GetPOIDetailsResponse.response:type_name -> ai_poi.chat.v1.BaseResponse
-	35, // 46: ai_poi.chat.v1.POIDetailedInfo.metadata:type_name -> ai_poi.chat.v1.POIDetailedInfo.MetadataEntry
-	15, // 47: ai_poi.chat.v1.ChatService.StartChatStream:input_type -> ai_poi.chat.v1.StartChatRequest
-	16, // 48: ai_poi.chat.v1.ChatService.ContinueChatStream:input_type -> ai_poi.chat.v1.ContinueChatRequest
-	17, // 49: ai_poi.chat.v1.ChatService.FreeChatStream:input_type -> ai_poi.chat.v1.FreeChatRequest
-	18, // 50: ai_poi.chat.v1.ChatService.GetChatSessions:input_type -> ai_poi.chat.v1.GetChatSessionsRequest
-	21, // 51: ai_poi.chat.v1.ChatService.SaveItinerary:input_type -> ai_poi.chat.v1.SaveItineraryRequest
-	23, // 52: ai_poi.chat.v1.ChatService.GetSavedItineraries:input_type -> ai_poi.chat.v1.GetSavedItinerariesRequest
-	26, // 53: ai_poi.chat.v1.ChatService.RemoveItinerary:input_type -> ai_poi.chat.v1.RemoveItineraryRequest
-	28, // 54: ai_poi.chat.v1.ChatService.GetPOIDetails:input_type -> ai_poi.chat.v1.GetPOIDetailsRequest
-	1,  // 55: ai_poi.chat.v1.ChatService.StartChatStream:output_type -> ai_poi.chat.v1.ChatEvent
-	1,  // 56: ai_poi.chat.v1.ChatService.ContinueChatStream:output_type -> ai_poi.chat.v1.ChatEvent
-	1,  // 57: ai_poi.chat.v1.ChatService.FreeChatStream:output_type -> ai_poi.chat.v1.ChatEvent
-	19, // 58: ai_poi.chat.v1.ChatService.GetChatSessions:output_type -> ai_poi.chat.v1.GetChatSessionsResponse
-	22, // 59: ai_poi.chat.v1.ChatService.SaveItinerary:output_type -> ai_poi.chat.v1.SaveItineraryResponse
-	24, // 60: ai_poi.chat.v1.ChatService.GetSavedItineraries:output_type -> ai_poi.chat.v1.GetSavedItinerariesResponse
-	27, // 61: ai_poi.chat.v1.ChatService.RemoveItinerary:output_type -> ai_poi.chat.v1.RemoveItineraryResponse
-	29, // 62: ai_poi.chat.v1.ChatService.GetPOIDetails:output_type -> ai_poi.chat.v1.GetPOIDetailsResponse
-	55, // [55:63] is the sub-list for method output_type
-	47, // [47:55] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
+	37, // 20: ai_poi.chat.v1.EstimatedCosts.budget_low_amount:type_name -> ai_poi.common.v1.Money
+	37, // 21: ai_poi.chat.v1.EstimatedCosts.budget_medium_amount:type_name -> ai_poi.common.v1.Money
+	37, // 22: ai_poi.chat.v1.EstimatedCosts.budget_high_amount:type_name -> ai_poi.common.v1.Money
+	37, // 23: ai_poi.chat.v1.CostBreakdown.cost:type_name -> ai_poi.common.v1.Money
+	0,  // 24: ai_poi.chat.v1.StartChatRequest.context_type:type_name -> ai_poi.chat.v1.ChatContextType
+	34, // 25: ai_poi.chat.v1.StartChatRequest.metadata:type_name -> ai_poi.chat.v1.StartChatRequest.MetadataEntry
+	38, // 26: ai_poi.chat.v1.StartChatRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 27: ai_poi.chat.v1.ContinueChatRequest.context_type:type_name -> ai_poi.chat.v1.ChatContextType
+	38, // 28: ai_poi.chat.v1.ContinueChatRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 29: ai_poi.chat.v1.FreeChatRequest.context_type:type_name -> ai_poi.chat.v1.ChatContextType
+	38, // 30: ai_poi.chat.v1.FreeChatRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	38, // 31: ai_poi.chat.v1.GetChatSessionsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	20, // 32: ai_poi.chat.v1.GetChatSessionsResponse.sessions:type_name -> ai_poi.chat.v1.ChatSession
+	39, // 33: ai_poi.chat.v1.GetChatSessionsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	36, // 34: ai_poi.chat.v1.ChatSession.created_at:type_name -> google.protobuf.Timestamp
+	36, // 35: ai_poi.chat.v1.ChatSession.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 36: ai_poi.chat.v1.ChatSession.context_type:type_name -> ai_poi.chat.v1.ChatContextType
+	7,  // 37: ai_poi.chat.v1.SaveItineraryRequest.itinerary_data:type_name -> ai_poi.chat.v1.ItineraryResponse
+	38, // 38: ai_poi.chat.v1.SaveItineraryRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	39, // 39: ai_poi.chat.v1.SaveItineraryResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	38, // 40: ai_poi.chat.v1.GetSavedItinerariesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	25, // 41: ai_poi.chat.v1.GetSavedItinerariesResponse.itineraries:type_name -> ai_poi.chat.v1.UserSavedItinerary
+	39, // 42: ai_poi.chat.v1.GetSavedItinerariesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	36, // 43: ai_poi.chat.v1.UserSavedItinerary.created_at:type_name -> google.protobuf.Timestamp
+	36, // 44: ai_poi.chat.v1.UserSavedItinerary.updated_at:type_name -> google.protobuf.Timestamp
+	38, // 45: ai_poi.chat.v1.RemoveItineraryRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	39, // 46: ai_poi.chat.v1.RemoveItineraryResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	38, // 47: ai_poi.chat.v1.GetPOIDetailsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	30, // 48: ai_poi.chat.v1.GetPOIDetailsResponse.poi:type_name -> ai_poi.chat.v1.POIDetailedInfo
+	39, // 49: ai_poi.chat.v1.GetPOIDetailsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	35, // 50: ai_poi.chat.v1.POIDetailedInfo.metadata:type_name -> ai_poi.chat.v1.POIDetailedInfo.MetadataEntry
+	15, // 51: ai_poi.chat.v1.ChatService.StartChatStream:input_type -> ai_poi.chat.v1.StartChatRequest
+	16, // 52: ai_poi.chat.v1.ChatService.ContinueChatStream:input_type -> ai_poi.chat.v1.ContinueChatRequest
+	17, // 53: ai_poi.chat.v1.ChatService.FreeChatStream:input_type -> ai_poi.chat.v1.FreeChatRequest
+	18, // 54: ai_poi.chat.v1.ChatService.GetChatSessions:input_type -> ai_poi.chat.v1.GetChatSessionsRequest
+	21, // 55: ai_poi.chat.v1.ChatService.SaveItinerary:input_type -> ai_poi.chat.v1.SaveItineraryRequest
+	23, // 56: ai_poi.chat.v1.ChatService.GetSavedItineraries:input_type -> ai_poi.chat.v1.GetSavedItinerariesRequest
+	26, // 57: ai_poi.chat.v1.ChatService.RemoveItinerary:input_type -> ai_poi.chat.v1.RemoveItineraryRequest
+	28, // 58: ai_poi.chat.v1.ChatService.GetPOIDetails:input_type -> ai_poi.chat.v1.GetPOIDetailsRequest
+	1,  // 59: ai_poi.chat.v1.ChatService.StartChatStream:output_type -> ai_poi.chat.v1.ChatEvent
+	1,  // 60: ai_poi.chat.v1.ChatService.ContinueChatStream:output_type -> ai_poi.chat.v1.ChatEvent
+	1,  // 61: ai_poi.chat.v1.ChatService.FreeChatStream:output_type -> ai_poi.chat.v1.ChatEvent
+	19, // 62: ai_poi.chat.v1.ChatService.GetChatSessions:output_type -> ai_poi.chat.v1.GetChatSessionsResponse
+	22, // 63: ai_poi.chat.v1.ChatService.SaveItinerary:output_type -> ai_poi.chat.v1.SaveItineraryResponse
+	24, // 64: ai_poi.chat.v1.ChatService.GetSavedItineraries:output_type -> ai_poi.chat.v1.GetSavedItinerariesResponse
+	27, // 65: ai_poi.chat.v1.ChatService.RemoveItinerary:output_type -> ai_poi.chat.v1.RemoveItineraryResponse
+	29, // 66: ai_poi.chat.v1.ChatService.GetPOIDetails:output_type -> ai_poi.chat.v1.GetPOIDetailsResponse
+	59, // [59:67] is the sub-list for method output_type
+	51, // [51:59] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_chat_proto_init() }
@@ -0,0 +1,4839 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: chat.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ChatEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatEventMultiError, or nil
+// if none found.
+func (m *ChatEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventType
+
+	// no validation rules for Data
+
+	// no validation rules for SessionId
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatEventValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatEventValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatEventValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Payload.(type) {
+	case *ChatEvent_Message:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMessage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_Thinking:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetThinking()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Thinking",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Thinking",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetThinking()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "Thinking",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_Error:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_Complete:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetComplete()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Complete",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "Complete",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetComplete()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "Complete",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_CityResponse:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCityResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "CityResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "CityResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCityResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "CityResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatEvent_ItineraryResponse:
+		if v == nil {
+			err := ChatEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetItineraryResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "ItineraryResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatEventValidationError{
+						field:  "ItineraryResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetItineraryResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatEventValidationError{
+					field:  "ItineraryResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ChatEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatEventMultiError is an error wrapping multiple validation errors returned
+// by ChatEvent.ValidateAll() if the designated constraints aren't met.
+type ChatEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatEventMultiError) AllErrors() []error { return m }
+
+// ChatEventValidationError is the validation error returned by
+// ChatEvent.Validate if the designated constraints aren't met.
+type ChatEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatEventValidationError) ErrorName() string { return "ChatEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatEventValidationError{}
+
+// Validate checks the field values on ChatMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatMessageMultiError, or
+// nil if none found.
+func (m *ChatMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SessionId
+
+	// no validation rules for Content
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatMessageValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ContextType
+
+	if len(errors) > 0 {
+		return ChatMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatMessageMultiError is an error wrapping multiple validation errors
+// returned by ChatMessage.ValidateAll() if the designated constraints aren't met.
+type ChatMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatMessageMultiError) AllErrors() []error { return m }
+
+// ChatMessageValidationError is the validation error returned by
+// ChatMessage.Validate if the designated constraints aren't met.
+type ChatMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatMessageValidationError) ErrorName() string { return "ChatMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatMessageValidationError{}
+
+// Validate checks the field values on ThinkingEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ThinkingEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ThinkingEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ThinkingEventMultiError, or
+// nil if none found.
+func (m *ThinkingEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ThinkingEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for Progress
+
+	if len(errors) > 0 {
+		return ThinkingEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ThinkingEventMultiError is an error wrapping multiple validation errors
+// returned by ThinkingEvent.ValidateAll() if the designated constraints
+// aren't met.
+type ThinkingEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ThinkingEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ThinkingEventMultiError) AllErrors() []error { return m }
+
+// ThinkingEventValidationError is the validation error returned by
+// ThinkingEvent.Validate if the designated constraints aren't met.
+type ThinkingEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ThinkingEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ThinkingEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ThinkingEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ThinkingEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ThinkingEventValidationError) ErrorName() string { return "ThinkingEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ThinkingEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sThinkingEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ThinkingEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ThinkingEventValidationError{}
+
+// Validate checks the field values on ErrorEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErrorEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorEventMultiError, or
+// nil if none found.
+func (m *ErrorEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErrorEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Details
+
+	if len(errors) > 0 {
+		return ErrorEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErrorEventMultiError is an error wrapping multiple validation errors
+// returned by ErrorEvent.ValidateAll() if the designated constraints aren't met.
+type ErrorEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErrorEventMultiError) AllErrors() []error { return m }
+
+// ErrorEventValidationError is the validation error returned by
+// ErrorEvent.Validate if the designated constraints aren't met.
+type ErrorEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErrorEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErrorEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErrorEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErrorEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErrorEventValidationError) ErrorName() string { return "ErrorEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErrorEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErrorEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErrorEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorEventValidationError{}
+
+// Validate checks the field values on CompleteEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CompleteEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CompleteEventMultiError, or
+// nil if none found.
+func (m *CompleteEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	// no validation rules for TotalMessages
+
+	if all {
+		switch v := interface{}(m.GetCompletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompleteEventValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompleteEventValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompleteEventValidationError{
+				field:  "CompletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CompleteEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteEventMultiError is an error wrapping multiple validation errors
+// returned by CompleteEvent.ValidateAll() if the designated constraints
+// aren't met.
+type CompleteEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteEventMultiError) AllErrors() []error { return m }
+
+// CompleteEventValidationError is the validation error returned by
+// CompleteEvent.Validate if the designated constraints aren't met.
+type CompleteEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteEventValidationError) ErrorName() string { return "CompleteEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CompleteEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteEventValidationError{}
+
+// Validate checks the field values on CityResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CityResponseMultiError, or
+// nil if none found.
+func (m *CityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Country
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetPois() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CityResponseValidationError{
+						field:  fmt.Sprintf("Pois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CityResponseValidationError{
+						field:  fmt.Sprintf("Pois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CityResponseValidationError{
+					field:  fmt.Sprintf("Pois[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetWeather()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityResponseValidationError{
+					field:  "Weather",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityResponseValidationError{
+					field:  "Weather",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWeather()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityResponseValidationError{
+				field:  "Weather",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCulturalInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityResponseValidationError{
+					field:  "CulturalInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityResponseValidationError{
+					field:  "CulturalInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCulturalInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityResponseValidationError{
+				field:  "CulturalInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityResponseMultiError is an error wrapping multiple validation errors
+// returned by CityResponse.ValidateAll() if the designated constraints aren't met.
+type CityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityResponseMultiError) AllErrors() []error { return m }
+
+// CityResponseValidationError is the validation error returned by
+// CityResponse.Validate if the designated constraints aren't met.
+type CityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityResponseValidationError) ErrorName() string { return "CityResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityResponseValidationError{}
+
+// Validate checks the field values on ItineraryResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ItineraryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ItineraryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ItineraryResponseMultiError, or nil if none found.
+func (m *ItineraryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ItineraryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for DurationDays
+
+	for idx, item := range m.GetDays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ItineraryResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ItineraryResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ItineraryResponseValidationError{
+					field:  fmt.Sprintf("Days[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFeaturedPois() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ItineraryResponseValidationError{
+						field:  fmt.Sprintf("FeaturedPois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ItineraryResponseValidationError{
+						field:  fmt.Sprintf("FeaturedPois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ItineraryResponseValidationError{
+					field:  fmt.Sprintf("FeaturedPois[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetEstimatedCosts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ItineraryResponseValidationError{
+					field:  "EstimatedCosts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ItineraryResponseValidationError{
+					field:  "EstimatedCosts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEstimatedCosts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ItineraryResponseValidationError{
+				field:  "EstimatedCosts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ItineraryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ItineraryResponseMultiError is an error wrapping multiple validation errors
+// returned by ItineraryResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ItineraryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ItineraryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ItineraryResponseMultiError) AllErrors() []error { return m }
+
+// ItineraryResponseValidationError is the validation error returned by
+// ItineraryResponse.Validate if the designated constraints aren't met.
+type ItineraryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItineraryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItineraryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItineraryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItineraryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItineraryResponseValidationError) ErrorName() string {
+	return "ItineraryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ItineraryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItineraryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItineraryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItineraryResponseValidationError{}
+
+// Validate checks the field values on ItineraryDay with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ItineraryDay) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ItineraryDay with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ItineraryDayMultiError, or
+// nil if none found.
+func (m *ItineraryDay) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ItineraryDay) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DayNumber
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetActivities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ItineraryDayValidationError{
+						field:  fmt.Sprintf("Activities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ItineraryDayValidationError{
+						field:  fmt.Sprintf("Activities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ItineraryDayValidationError{
+					field:  fmt.Sprintf("Activities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ItineraryDayMultiError(errors)
+	}
+
+	return nil
+}
+
+// ItineraryDayMultiError is an error wrapping multiple validation errors
+// returned by ItineraryDay.ValidateAll() if the designated constraints aren't met.
+type ItineraryDayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ItineraryDayMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ItineraryDayMultiError) AllErrors() []error { return m }
+
+// ItineraryDayValidationError is the validation error returned by
+// ItineraryDay.Validate if the designated constraints aren't met.
+type ItineraryDayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItineraryDayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItineraryDayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItineraryDayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItineraryDayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItineraryDayValidationError) ErrorName() string { return "ItineraryDayValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ItineraryDayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItineraryDay.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItineraryDayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItineraryDayValidationError{}
+
+// Validate checks the field values on ItineraryActivity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ItineraryActivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ItineraryActivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ItineraryActivityMultiError, or nil if none found.
+func (m *ItineraryActivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ItineraryActivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TimeSlot
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for Location
+
+	// no validation rules for DurationMinutes
+
+	// no validation rules for Category
+
+	if all {
+		switch v := interface{}(m.GetPoiReference()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ItineraryActivityValidationError{
+					field:  "PoiReference",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ItineraryActivityValidationError{
+					field:  "PoiReference",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoiReference()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ItineraryActivityValidationError{
+				field:  "PoiReference",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ItineraryActivityMultiError(errors)
+	}
+
+	return nil
+}
+
+// ItineraryActivityMultiError is an error wrapping multiple validation errors
+// returned by ItineraryActivity.ValidateAll() if the designated constraints
+// aren't met.
+type ItineraryActivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ItineraryActivityMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ItineraryActivityMultiError) AllErrors() []error { return m }
+
+// ItineraryActivityValidationError is the validation error returned by
+// ItineraryActivity.Validate if the designated constraints aren't met.
+type ItineraryActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItineraryActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItineraryActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItineraryActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItineraryActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItineraryActivityValidationError) ErrorName() string {
+	return "ItineraryActivityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ItineraryActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItineraryActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItineraryActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItineraryActivityValidationError{}
+
+// Validate checks the field values on POIReference with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *POIReference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on POIReference with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in POIReferenceMultiError, or
+// nil if none found.
+func (m *POIReference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *POIReference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Category
+
+	// no validation rules for Latitude
+
+	// no validation rules for Longitude
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return POIReferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// POIReferenceMultiError is an error wrapping multiple validation errors
+// returned by POIReference.ValidateAll() if the designated constraints aren't met.
+type POIReferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m POIReferenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m POIReferenceMultiError) AllErrors() []error { return m }
+
+// POIReferenceValidationError is the validation error returned by
+// POIReference.Validate if the designated constraints aren't met.
+type POIReferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e POIReferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e POIReferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e POIReferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e POIReferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e POIReferenceValidationError) ErrorName() string { return "POIReferenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e POIReferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPOIReference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = POIReferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = POIReferenceValidationError{}
+
+// Validate checks the field values on WeatherInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WeatherInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WeatherInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WeatherInfoMultiError, or
+// nil if none found.
+func (m *WeatherInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WeatherInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CurrentCondition
+
+	// no validation rules for TemperatureCelsius
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return WeatherInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// WeatherInfoMultiError is an error wrapping multiple validation errors
+// returned by WeatherInfo.ValidateAll() if the designated constraints aren't met.
+type WeatherInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WeatherInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WeatherInfoMultiError) AllErrors() []error { return m }
+
+// WeatherInfoValidationError is the validation error returned by
+// WeatherInfo.Validate if the designated constraints aren't met.
+type WeatherInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WeatherInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WeatherInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WeatherInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WeatherInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WeatherInfoValidationError) ErrorName() string { return "WeatherInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WeatherInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWeatherInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WeatherInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WeatherInfoValidationError{}
+
+// Validate checks the field values on CulturalInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CulturalInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CulturalInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CulturalInfoMultiError, or
+// nil if none found.
+func (m *CulturalInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CulturalInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Language
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return CulturalInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// CulturalInfoMultiError is an error wrapping multiple validation errors
+// returned by CulturalInfo.ValidateAll() if the designated constraints aren't met.
+type CulturalInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CulturalInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CulturalInfoMultiError) AllErrors() []error { return m }
+
+// CulturalInfoValidationError is the validation error returned by
+// CulturalInfo.Validate if the designated constraints aren't met.
+type CulturalInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CulturalInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CulturalInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CulturalInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CulturalInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CulturalInfoValidationError) ErrorName() string { return "CulturalInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CulturalInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCulturalInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CulturalInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CulturalInfoValidationError{}
+
+// Validate checks the field values on EstimatedCosts with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EstimatedCosts) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EstimatedCosts with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EstimatedCostsMultiError,
+// or nil if none found.
+func (m *EstimatedCosts) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EstimatedCosts) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Currency
+
+	// no validation rules for BudgetLow
+
+	// no validation rules for BudgetMedium
+
+	// no validation rules for BudgetHigh
+
+	for idx, item := range m.GetBreakdown() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EstimatedCostsValidationError{
+						field:  fmt.Sprintf("Breakdown[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EstimatedCostsValidationError{
+						field:  fmt.Sprintf("Breakdown[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EstimatedCostsValidationError{
+					field:  fmt.Sprintf("Breakdown[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetBudgetLowAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EstimatedCostsValidationError{
+					field:  "BudgetLowAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EstimatedCostsValidationError{
+					field:  "BudgetLowAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBudgetLowAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EstimatedCostsValidationError{
+				field:  "BudgetLowAmount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBudgetMediumAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EstimatedCostsValidationError{
+					field:  "BudgetMediumAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EstimatedCostsValidationError{
+					field:  "BudgetMediumAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBudgetMediumAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EstimatedCostsValidationError{
+				field:  "BudgetMediumAmount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBudgetHighAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EstimatedCostsValidationError{
+					field:  "BudgetHighAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EstimatedCostsValidationError{
+					field:  "BudgetHighAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBudgetHighAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EstimatedCostsValidationError{
+				field:  "BudgetHighAmount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EstimatedCostsMultiError(errors)
+	}
+
+	return nil
+}
+
+// EstimatedCostsMultiError is an error wrapping multiple validation errors
+// returned by EstimatedCosts.ValidateAll() if the designated constraints
+// aren't met.
+type EstimatedCostsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EstimatedCostsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EstimatedCostsMultiError) AllErrors() []error { return m }
+
+// EstimatedCostsValidationError is the validation error returned by
+// EstimatedCosts.Validate if the designated constraints aren't met.
+type EstimatedCostsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EstimatedCostsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EstimatedCostsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EstimatedCostsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EstimatedCostsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EstimatedCostsValidationError) ErrorName() string { return "EstimatedCostsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EstimatedCostsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEstimatedCosts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EstimatedCostsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EstimatedCostsValidationError{}
+
+// Validate checks the field values on CostBreakdown with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CostBreakdown) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CostBreakdown with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CostBreakdownMultiError, or
+// nil if none found.
+func (m *CostBreakdown) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CostBreakdown) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Category
+
+	// no validation rules for Amount
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CostBreakdownValidationError{
+					field:  "Cost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CostBreakdownValidationError{
+					field:  "Cost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CostBreakdownValidationError{
+				field:  "Cost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CostBreakdownMultiError(errors)
+	}
+
+	return nil
+}
+
+// CostBreakdownMultiError is an error wrapping multiple validation errors
+// returned by CostBreakdown.ValidateAll() if the designated constraints
+// aren't met.
+type CostBreakdownMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CostBreakdownMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CostBreakdownMultiError) AllErrors() []error { return m }
+
+// CostBreakdownValidationError is the validation error returned by
+// CostBreakdown.Validate if the designated constraints aren't met.
+type CostBreakdownValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CostBreakdownValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CostBreakdownValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CostBreakdownValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CostBreakdownValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CostBreakdownValidationError) ErrorName() string { return "CostBreakdownValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CostBreakdownValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCostBreakdown.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CostBreakdownValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CostBreakdownValidationError{}
+
+// Validate checks the field values on StartChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StartChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartChatRequestMultiError, or nil if none found.
+func (m *StartChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ProfileId
+
+	// no validation rules for InitialMessage
+
+	// no validation rules for ContextType
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartChatRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartChatRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartChatRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartChatRequestMultiError is an error wrapping multiple validation errors
+// returned by StartChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StartChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartChatRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartChatRequestMultiError) AllErrors() []error { return m }
+
+// StartChatRequestValidationError is the validation error returned by
+// StartChatRequest.Validate if the designated constraints aren't met.
+type StartChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartChatRequestValidationError) ErrorName() string { return "StartChatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StartChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartChatRequestValidationError{}
+
+// Validate checks the field values on ContinueChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ContinueChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContinueChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ContinueChatRequestMultiError, or nil if none found.
+func (m *ContinueChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContinueChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	// no validation rules for UserId
+
+	// no validation rules for Message
+
+	// no validation rules for ContextType
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ContinueChatRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ContinueChatRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ContinueChatRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ContinueChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContinueChatRequestMultiError is an error wrapping multiple validation
+// errors returned by ContinueChatRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ContinueChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContinueChatRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContinueChatRequestMultiError) AllErrors() []error { return m }
+
+// ContinueChatRequestValidationError is the validation error returned by
+// ContinueChatRequest.Validate if the designated constraints aren't met.
+type ContinueChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContinueChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContinueChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContinueChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContinueChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContinueChatRequestValidationError) ErrorName() string {
+	return "ContinueChatRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ContinueChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContinueChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContinueChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContinueChatRequestValidationError{}
+
+// Validate checks the field values on FreeChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FreeChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FreeChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FreeChatRequestMultiError, or nil if none found.
+func (m *FreeChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FreeChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for SessionToken
+
+	// no validation rules for ContextType
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreeChatRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreeChatRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreeChatRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FreeChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FreeChatRequestMultiError is an error wrapping multiple validation errors
+// returned by FreeChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FreeChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FreeChatRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FreeChatRequestMultiError) AllErrors() []error { return m }
+
+// FreeChatRequestValidationError is the validation error returned by
+// FreeChatRequest.Validate if the designated constraints aren't met.
+type FreeChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreeChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreeChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreeChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreeChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreeChatRequestValidationError) ErrorName() string { return "FreeChatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FreeChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreeChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreeChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreeChatRequestValidationError{}
+
+// Validate checks the field values on GetChatSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChatSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChatSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChatSessionsRequestMultiError, or nil if none found.
+func (m *GetChatSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChatSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ProfileId
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetChatSessionsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetChatSessionsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetChatSessionsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetChatSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChatSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetChatSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetChatSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChatSessionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChatSessionsRequestMultiError) AllErrors() []error { return m }
+
+// GetChatSessionsRequestValidationError is the validation error returned by
+// GetChatSessionsRequest.Validate if the designated constraints aren't met.
+type GetChatSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChatSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChatSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChatSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChatSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChatSessionsRequestValidationError) ErrorName() string {
+	return "GetChatSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChatSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChatSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChatSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChatSessionsRequestValidationError{}
+
+// Validate checks the field values on GetChatSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChatSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChatSessionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChatSessionsResponseMultiError, or nil if none found.
+func (m *GetChatSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChatSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetChatSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetChatSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetChatSessionsResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetChatSessionsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetChatSessionsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetChatSessionsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetChatSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChatSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetChatSessionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetChatSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChatSessionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChatSessionsResponseMultiError) AllErrors() []error { return m }
+
+// GetChatSessionsResponseValidationError is the validation error returned by
+// GetChatSessionsResponse.Validate if the designated constraints aren't met.
+type GetChatSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChatSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChatSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChatSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChatSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChatSessionsResponseValidationError) ErrorName() string {
+	return "GetChatSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChatSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChatSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChatSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChatSessionsResponseValidationError{}
+
+// Validate checks the field values on ChatSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatSessionMultiError, or
+// nil if none found.
+func (m *ChatSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for ProfileId
+
+	// no validation rules for Title
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatSessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatSessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatSessionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatSessionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatSessionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatSessionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MessageCount
+
+	// no validation rules for ContextType
+
+	if len(errors) > 0 {
+		return ChatSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatSessionMultiError is an error wrapping multiple validation errors
+// returned by ChatSession.ValidateAll() if the designated constraints aren't met.
+type ChatSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatSessionMultiError) AllErrors() []error { return m }
+
+// ChatSessionValidationError is the validation error returned by
+// ChatSession.Validate if the designated constraints aren't met.
+type ChatSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatSessionValidationError) ErrorName() string { return "ChatSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatSessionValidationError{}
+
+// Validate checks the field values on SaveItineraryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SaveItineraryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveItineraryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveItineraryRequestMultiError, or nil if none found.
+func (m *SaveItineraryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveItineraryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for SessionId
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetItineraryData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SaveItineraryRequestValidationError{
+					field:  "ItineraryData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SaveItineraryRequestValidationError{
+					field:  "ItineraryData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItineraryData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SaveItineraryRequestValidationError{
+				field:  "ItineraryData",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SaveItineraryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SaveItineraryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SaveItineraryRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SaveItineraryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveItineraryRequestMultiError is an error wrapping multiple validation
+// errors returned by SaveItineraryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SaveItineraryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveItineraryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveItineraryRequestMultiError) AllErrors() []error { return m }
+
+// SaveItineraryRequestValidationError is the validation error returned by
+// SaveItineraryRequest.Validate if the designated constraints aren't met.
+type SaveItineraryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveItineraryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveItineraryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveItineraryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveItineraryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveItineraryRequestValidationError) ErrorName() string {
+	return "SaveItineraryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveItineraryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveItineraryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveItineraryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveItineraryRequestValidationError{}
+
+// Validate checks the field values on SaveItineraryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SaveItineraryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveItineraryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveItineraryResponseMultiError, or nil if none found.
+func (m *SaveItineraryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveItineraryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ItineraryId
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SaveItineraryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SaveItineraryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SaveItineraryResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SaveItineraryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveItineraryResponseMultiError is an error wrapping multiple validation
+// errors returned by SaveItineraryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SaveItineraryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveItineraryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveItineraryResponseMultiError) AllErrors() []error { return m }
+
+// SaveItineraryResponseValidationError is the validation error returned by
+// SaveItineraryResponse.Validate if the designated constraints aren't met.
+type SaveItineraryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveItineraryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveItineraryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveItineraryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveItineraryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveItineraryResponseValidationError) ErrorName() string {
+	return "SaveItineraryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveItineraryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveItineraryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveItineraryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveItineraryResponseValidationError{}
+
+// Validate checks the field values on GetSavedItinerariesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSavedItinerariesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSavedItinerariesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSavedItinerariesRequestMultiError, or nil if none found.
+func (m *GetSavedItinerariesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSavedItinerariesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSavedItinerariesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSavedItinerariesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSavedItinerariesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSavedItinerariesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSavedItinerariesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSavedItinerariesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetSavedItinerariesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSavedItinerariesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSavedItinerariesRequestMultiError) AllErrors() []error { return m }
+
+// GetSavedItinerariesRequestValidationError is the validation error returned
+// by GetSavedItinerariesRequest.Validate if the designated constraints aren't met.
+type GetSavedItinerariesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSavedItinerariesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSavedItinerariesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSavedItinerariesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSavedItinerariesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSavedItinerariesRequestValidationError) ErrorName() string {
+	return "GetSavedItinerariesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSavedItinerariesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSavedItinerariesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSavedItinerariesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSavedItinerariesRequestValidationError{}
+
+// Validate checks the field values on GetSavedItinerariesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSavedItinerariesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSavedItinerariesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSavedItinerariesResponseMultiError, or nil if none found.
+func (m *GetSavedItinerariesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSavedItinerariesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItineraries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSavedItinerariesResponseValidationError{
+						field:  fmt.Sprintf("Itineraries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSavedItinerariesResponseValidationError{
+						field:  fmt.Sprintf("Itineraries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSavedItinerariesResponseValidationError{
+					field:  fmt.Sprintf("Itineraries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSavedItinerariesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSavedItinerariesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSavedItinerariesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSavedItinerariesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSavedItinerariesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSavedItinerariesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetSavedItinerariesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSavedItinerariesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSavedItinerariesResponseMultiError) AllErrors() []error { return m }
+
+// GetSavedItinerariesResponseValidationError is the validation error returned
+// by GetSavedItinerariesResponse.Validate if the designated constraints
+// aren't met.
+type GetSavedItinerariesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSavedItinerariesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSavedItinerariesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSavedItinerariesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSavedItinerariesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSavedItinerariesResponseValidationError) ErrorName() string {
+	return "GetSavedItinerariesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSavedItinerariesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSavedItinerariesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSavedItinerariesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSavedItinerariesResponseValidationError{}
+
+// Validate checks the field values on UserSavedItinerary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserSavedItinerary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserSavedItinerary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserSavedItineraryMultiError, or nil if none found.
+func (m *UserSavedItinerary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserSavedItinerary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for SessionId
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for ItineraryData
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserSavedItineraryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserSavedItineraryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserSavedItineraryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserSavedItineraryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserSavedItineraryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserSavedItineraryValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserSavedItineraryMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserSavedItineraryMultiError is an error wrapping multiple validation errors
+// returned by UserSavedItinerary.ValidateAll() if the designated constraints
+// aren't met.
+type UserSavedItineraryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserSavedItineraryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserSavedItineraryMultiError) AllErrors() []error { return m }
+
+// UserSavedItineraryValidationError is the validation error returned by
+// UserSavedItinerary.Validate if the designated constraints aren't met.
+type UserSavedItineraryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserSavedItineraryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserSavedItineraryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserSavedItineraryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserSavedItineraryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserSavedItineraryValidationError) ErrorName() string {
+	return "UserSavedItineraryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserSavedItineraryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserSavedItinerary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserSavedItineraryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserSavedItineraryValidationError{}
+
+// Validate checks the field values on RemoveItineraryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveItineraryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveItineraryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveItineraryRequestMultiError, or nil if none found.
+func (m *RemoveItineraryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveItineraryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ItineraryId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveItineraryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveItineraryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveItineraryRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveItineraryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveItineraryRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveItineraryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveItineraryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveItineraryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveItineraryRequestMultiError) AllErrors() []error { return m }
+
+// RemoveItineraryRequestValidationError is the validation error returned by
+// RemoveItineraryRequest.Validate if the designated constraints aren't met.
+type RemoveItineraryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveItineraryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveItineraryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveItineraryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveItineraryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveItineraryRequestValidationError) ErrorName() string {
+	return "RemoveItineraryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveItineraryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveItineraryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveItineraryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveItineraryRequestValidationError{}
+
+// Validate checks the field values on RemoveItineraryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveItineraryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveItineraryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveItineraryResponseMultiError, or nil if none found.
+func (m *RemoveItineraryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveItineraryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveItineraryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveItineraryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveItineraryResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveItineraryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveItineraryResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveItineraryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveItineraryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveItineraryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveItineraryResponseMultiError) AllErrors() []error { return m }
+
+// RemoveItineraryResponseValidationError is the validation error returned by
+// RemoveItineraryResponse.Validate if the designated constraints aren't met.
+type RemoveItineraryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveItineraryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveItineraryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveItineraryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveItineraryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveItineraryResponseValidationError) ErrorName() string {
+	return "RemoveItineraryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveItineraryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveItineraryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveItineraryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveItineraryResponseValidationError{}
+
+// Validate checks the field values on GetPOIDetailsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPOIDetailsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPOIDetailsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPOIDetailsRequestMultiError, or nil if none found.
+func (m *GetPOIDetailsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPOIDetailsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PoiId
+
+	// no validation rules for IncludeReviews
+
+	// no validation rules for IncludePhotos
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIDetailsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIDetailsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIDetailsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPOIDetailsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPOIDetailsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPOIDetailsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPOIDetailsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPOIDetailsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPOIDetailsRequestMultiError) AllErrors() []error { return m }
+
+// GetPOIDetailsRequestValidationError is the validation error returned by
+// GetPOIDetailsRequest.Validate if the designated constraints aren't met.
+type GetPOIDetailsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPOIDetailsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPOIDetailsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPOIDetailsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPOIDetailsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPOIDetailsRequestValidationError) ErrorName() string {
+	return "GetPOIDetailsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPOIDetailsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPOIDetailsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPOIDetailsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPOIDetailsRequestValidationError{}
+
+// Validate checks the field values on GetPOIDetailsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPOIDetailsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPOIDetailsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPOIDetailsResponseMultiError, or nil if none found.
+func (m *GetPOIDetailsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPOIDetailsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPoi()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIDetailsResponseValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIDetailsResponseValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIDetailsResponseValidationError{
+				field:  "Poi",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIDetailsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIDetailsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIDetailsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPOIDetailsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPOIDetailsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPOIDetailsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPOIDetailsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPOIDetailsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPOIDetailsResponseMultiError) AllErrors() []error { return m }
+
+// GetPOIDetailsResponseValidationError is the validation error returned by
+// GetPOIDetailsResponse.Validate if the designated constraints aren't met.
+type GetPOIDetailsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPOIDetailsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPOIDetailsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPOIDetailsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPOIDetailsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPOIDetailsResponseValidationError) ErrorName() string {
+	return "GetPOIDetailsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPOIDetailsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPOIDetailsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPOIDetailsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPOIDetailsResponseValidationError{}
+
+// Validate checks the field values on POIDetailedInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *POIDetailedInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on POIDetailedInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// POIDetailedInfoMultiError, or nil if none found.
+func (m *POIDetailedInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *POIDetailedInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Latitude
+
+	// no validation rules for Longitude
+
+	// no validation rules for Category
+
+	// no validation rules for Description
+
+	// no validation rules for Rating
+
+	// no validation rules for ReviewCount
+
+	// no validation rules for PriceRange
+
+	// no validation rules for Address
+
+	// no validation rules for Phone
+
+	// no validation rules for Website
+
+	// no validation rules for Metadata
+
+	if len(errors) > 0 {
+		return POIDetailedInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// POIDetailedInfoMultiError is an error wrapping multiple validation errors
+// returned by POIDetailedInfo.ValidateAll() if the designated constraints
+// aren't met.
+type POIDetailedInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m POIDetailedInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m POIDetailedInfoMultiError) AllErrors() []error { return m }
+
+// POIDetailedInfoValidationError is the validation error returned by
+// POIDetailedInfo.Validate if the designated constraints aren't met.
+type POIDetailedInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e POIDetailedInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e POIDetailedInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e POIDetailedInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e POIDetailedInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e POIDetailedInfoValidationError) ErrorName() string { return "POIDetailedInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e POIDetailedInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPOIDetailedInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = POIDetailedInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = POIDetailedInfoValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
@@ -12,9 +12,9 @@ import (
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.ChatServiceClient
 }
 
 var (
@@ -22,7 +22,7 @@ var (
 	_ core.Broker         = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -30,57 +30,102 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewChatServiceClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.ChatServiceClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewChatServiceClient(conn), nil
+}
+
 // ChatService method implementations
 
 // Streaming methods
 func (b *Broker) StartChatStream(ctx context.Context, in *c.StartChatRequest, opts ...grpc.CallOption) (c.ChatService_StartChatStreamClient, error) {
-	return b.client.StartChatStream(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.StartChatStream(ctx, in, opts...)
 }
 
 func (b *Broker) ContinueChatStream(ctx context.Context, in *c.ContinueChatRequest, opts ...grpc.CallOption) (c.ChatService_ContinueChatStreamClient, error) {
-	return b.client.ContinueChatStream(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ContinueChatStream(ctx, in, opts...)
 }
 
 func (b *Broker) FreeChatStream(ctx context.Context, in *c.FreeChatRequest, opts ...grpc.CallOption) (c.ChatService_FreeChatStreamClient, error) {
-	return b.client.FreeChatStream(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.FreeChatStream(ctx, in, opts...)
 }
 
 // Non-streaming methods
 func (b *Broker) GetChatSessions(ctx context.Context, in *c.GetChatSessionsRequest, opts ...grpc.CallOption) (*c.GetChatSessionsResponse, error) {
-	return b.client.GetChatSessions(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetChatSessions(ctx, in, opts...)
 }
 
 func (b *Broker) SaveItinerary(ctx context.Context, in *c.SaveItineraryRequest, opts ...grpc.CallOption) (*c.SaveItineraryResponse, error) {
-	return b.client.SaveItinerary(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SaveItinerary(ctx, in, opts...)
 }
 
 func (b *Broker) GetSavedItineraries(ctx context.Context, in *c.GetSavedItinerariesRequest, opts ...grpc.CallOption) (*c.GetSavedItinerariesResponse, error) {
-	return b.client.GetSavedItineraries(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetSavedItineraries(ctx, in, opts...)
 }
 
 func (b *Broker) RemoveItinerary(ctx context.Context, in *c.RemoveItineraryRequest, opts ...grpc.CallOption) (*c.RemoveItineraryResponse, error) {
-	return b.client.RemoveItinerary(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.RemoveItinerary(ctx, in, opts...)
 }
 
 func (b *Broker) GetPOIDetails(ctx context.Context, in *c.GetPOIDetailsRequest, opts ...grpc.CallOption) (*c.GetPOIDetailsResponse, error) {
-	return b.client.GetPOIDetails(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetPOIDetails(ctx, in, opts...)
 }
\ No newline at end of file
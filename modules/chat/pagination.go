@@ -0,0 +1,28 @@
+package chat
+
+import (
+	"context"
+	"iter"
+
+	"google.golang.org/protobuf/proto"
+
+	c "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	"github.com/FACorreiaa/loci-proto/pagination"
+)
+
+// AllChatSessions iterates over every chat session matching in, fetching
+// them a page at a time. The limit and offset of in are ignored in favour
+// of opts.
+func (b *Broker) AllChatSessions(ctx context.Context, in *c.GetChatSessionsRequest, opts ...pagination.Option) iter.Seq2[*c.ChatSession, error] {
+	return pagination.New(func(ctx context.Context, req pagination.Request) (pagination.Page[*c.ChatSession], error) {
+		page := proto.CloneOf(in)
+		page.Limit, page.Offset = req.Limit, req.Offset
+
+		resp, err := b.GetChatSessions(ctx, page)
+		if err != nil {
+			return pagination.Page[*c.ChatSession]{}, err
+		}
+
+		return pagination.PageFromTotal(resp.GetSessions(), resp.GetTotalCount(), req), nil
+	}, opts...).All(ctx)
+}
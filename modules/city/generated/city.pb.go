@@ -7,6 +7,8 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -44,8 +46,11 @@ type City struct {
 	Metadata        *CityMetadata          `protobuf:"bytes,17,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Translations of name and description, which hold the default language
+	LocalizedName        *generated.MultilingualText `protobuf:"bytes,20,opt,name=localized_name,json=localizedName,proto3" json:"localized_name,omitempty"`
+	LocalizedDescription *generated.MultilingualText `protobuf:"bytes,21,opt,name=localized_description,json=localizedDescription,proto3" json:"localized_description,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *City) Reset() {
@@ -211,6 +216,20 @@ func (x *City) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *City) GetLocalizedName() *generated.MultilingualText {
+	if x != nil {
+		return x.LocalizedName
+	}
+	return nil
+}
+
+func (x *City) GetLocalizedDescription() *generated.MultilingualText {
+	if x != nil {
+		return x.LocalizedDescription
+	}
+	return nil
+}
+
 // City metadata
 type CityMetadata struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -599,7 +618,7 @@ type GetCitiesRequest struct {
 	IncludeStatistics bool                   `protobuf:"varint,3,opt,name=include_statistics,json=includeStatistics,proto3" json:"include_statistics,omitempty"`
 	CountryCode       string                 `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`  // Filter by country
 	PopularOnly       bool                   `protobuf:"varint,5,opt,name=popular_only,json=popularOnly,proto3" json:"popular_only,omitempty"` // Only popular destinations
-	Request           *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request           *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -669,7 +688,7 @@ func (x *GetCitiesRequest) GetPopularOnly() bool {
 	return false
 }
 
-func (x *GetCitiesRequest) GetRequest() *BaseRequest {
+func (x *GetCitiesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -677,10 +696,10 @@ func (x *GetCitiesRequest) GetRequest() *BaseRequest {
 }
 
 type GetCitiesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cities        []*City                `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Cities        []*City                 `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -729,7 +748,7 @@ func (x *GetCitiesResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *GetCitiesResponse) GetResponse() *BaseResponse {
+func (x *GetCitiesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -741,7 +760,7 @@ type GetCityRequest struct {
 	CityId            string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
 	IncludeStatistics bool                   `protobuf:"varint,2,opt,name=include_statistics,json=includeStatistics,proto3" json:"include_statistics,omitempty"`
 	IncludeWeather    bool                   `protobuf:"varint,3,opt,name=include_weather,json=includeWeather,proto3" json:"include_weather,omitempty"`
-	Request           *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request           *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -797,7 +816,7 @@ func (x *GetCityRequest) GetIncludeWeather() bool {
 	return false
 }
 
-func (x *GetCityRequest) GetRequest() *BaseRequest {
+func (x *GetCityRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -805,11 +824,11 @@ func (x *GetCityRequest) GetRequest() *BaseRequest {
 }
 
 type GetCityResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	City          *City                  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
-	Statistics    *CityStatistics        `protobuf:"bytes,2,opt,name=statistics,proto3" json:"statistics,omitempty"`
-	Weather       *WeatherInfo           `protobuf:"bytes,3,opt,name=weather,proto3" json:"weather,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	City          *City                   `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Statistics    *CityStatistics         `protobuf:"bytes,2,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Weather       *WeatherInfo            `protobuf:"bytes,3,opt,name=weather,proto3" json:"weather,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -865,7 +884,7 @@ func (x *GetCityResponse) GetWeather() *WeatherInfo {
 	return nil
 }
 
-func (x *GetCityResponse) GetResponse() *BaseResponse {
+func (x *GetCityResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -879,7 +898,7 @@ type SearchCitiesRequest struct {
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	FuzzySearch   bool                   `protobuf:"varint,5,opt,name=fuzzy_search,json=fuzzySearch,proto3" json:"fuzzy_search,omitempty"` // Enable fuzzy matching
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -949,7 +968,7 @@ func (x *SearchCitiesRequest) GetFuzzySearch() bool {
 	return false
 }
 
-func (x *SearchCitiesRequest) GetRequest() *BaseRequest {
+func (x *SearchCitiesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -957,11 +976,11 @@ func (x *SearchCitiesRequest) GetRequest() *BaseRequest {
 }
 
 type SearchCitiesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*CitySearchResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Metadata      *SearchMetadata        `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*CitySearchResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Metadata      *SearchMetadata         `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1017,7 +1036,7 @@ func (x *SearchCitiesResponse) GetMetadata() *SearchMetadata {
 	return nil
 }
 
-func (x *SearchCitiesResponse) GetResponse() *BaseResponse {
+func (x *SearchCitiesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1148,7 +1167,7 @@ type GetCityStatisticsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CityId        string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
 	IncludeTrends bool                   `protobuf:"varint,2,opt,name=include_trends,json=includeTrends,proto3" json:"include_trends,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1197,7 +1216,7 @@ func (x *GetCityStatisticsRequest) GetIncludeTrends() bool {
 	return false
 }
 
-func (x *GetCityStatisticsRequest) GetRequest() *BaseRequest {
+func (x *GetCityStatisticsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1205,10 +1224,10 @@ func (x *GetCityStatisticsRequest) GetRequest() *BaseRequest {
 }
 
 type GetCityStatisticsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statistics    *CityStatistics        `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
-	Trends        []*TrendData           `protobuf:"bytes,2,rep,name=trends,proto3" json:"trends,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Statistics    *CityStatistics         `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Trends        []*TrendData            `protobuf:"bytes,2,rep,name=trends,proto3" json:"trends,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1257,7 +1276,7 @@ func (x *GetCityStatisticsResponse) GetTrends() []*TrendData {
 	return nil
 }
 
-func (x *GetCityStatisticsResponse) GetResponse() *BaseResponse {
+func (x *GetCityStatisticsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1376,6 +1395,10 @@ func (x *DataPoint) GetValue() float64 {
 	return 0
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in city.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -1428,6 +1451,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in city.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -1493,12 +1520,13 @@ var File_city_proto protoreflect.FileDescriptor
 const file_city_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"city.proto\x12\x0eai_poi.city.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa0\x05\n" +
+	"city.proto\x12\x0eai_poi.city.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xda\x06\n" +
 	"\x04City\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
-	"\acountry\x18\x03 \x01(\tR\acountry\x12!\n" +
-	"\fcountry_code\x18\x04 \x01(\tR\vcountryCode\x12%\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x127\n" +
+	"\fcountry_code\x18\x04 \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{2}$\xd0\x01\x01R\vcountryCode\x12%\n" +
 	"\x0estate_province\x18\x05 \x01(\tR\rstateProvince\x12\x1a\n" +
 	"\blatitude\x18\x06 \x01(\x01R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\a \x01(\x01R\tlongitude\x12\x1a\n" +
@@ -1520,7 +1548,9 @@ const file_city_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa9\x03\n" +
+	"updated_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12I\n" +
+	"\x0elocalized_name\x18\x14 \x01(\v2\".ai_poi.common.v1.MultilingualTextR\rlocalizedName\x12W\n" +
+	"\x15localized_description\x18\x15 \x01(\v2\".ai_poi.common.v1.MultilingualTextR\x14localizedDescription\"\xa9\x03\n" +
 	"\fCityMetadata\x12\x1b\n" +
 	"\timage_url\x18\x01 \x01(\tR\bimageUrl\x12#\n" +
 	"\rimage_gallery\x18\x02 \x03(\tR\fimageGallery\x12)\n" +
@@ -1558,44 +1588,46 @@ const file_city_proto_rawDesc = "" +
 	"\bhumidity\x18\x04 \x01(\x05R\bhumidity\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12!\n" +
 	"\fforecast_url\x18\x06 \x01(\tR\vforecastUrl\x12=\n" +
-	"\flast_updated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\"\xec\x01\n" +
+	"\flast_updated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\"\x84\x02\n" +
 	"\x10GetCitiesRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12-\n" +
-	"\x12include_statistics\x18\x03 \x01(\bR\x11includeStatistics\x12!\n" +
-	"\fcountry_code\x18\x04 \x01(\tR\vcountryCode\x12!\n" +
-	"\fpopular_only\x18\x05 \x01(\bR\vpopularOnly\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.city.v1.BaseRequestR\arequest\"\x9c\x01\n" +
+	"\x12include_statistics\x18\x03 \x01(\bR\x11includeStatistics\x127\n" +
+	"\fcountry_code\x18\x04 \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{2}$\xd0\x01\x01R\vcountryCode\x12!\n" +
+	"\fpopular_only\x18\x05 \x01(\bR\vpopularOnly\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x9e\x01\n" +
 	"\x11GetCitiesResponse\x12,\n" +
 	"\x06cities\x18\x01 \x03(\v2\x14.ai_poi.city.v1.CityR\x06cities\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.city.v1.BaseResponseR\bresponse\"\xb8\x01\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xba\x01\n" +
 	"\x0eGetCityRequest\x12\x17\n" +
 	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12-\n" +
 	"\x12include_statistics\x18\x02 \x01(\bR\x11includeStatistics\x12'\n" +
-	"\x0finclude_weather\x18\x03 \x01(\bR\x0eincludeWeather\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.city.v1.BaseRequestR\arequest\"\xec\x01\n" +
+	"\x0finclude_weather\x18\x03 \x01(\bR\x0eincludeWeather\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xee\x01\n" +
 	"\x0fGetCityResponse\x12(\n" +
 	"\x04city\x18\x01 \x01(\v2\x14.ai_poi.city.v1.CityR\x04city\x12>\n" +
 	"\n" +
 	"statistics\x18\x02 \x01(\v2\x1e.ai_poi.city.v1.CityStatisticsR\n" +
 	"statistics\x125\n" +
-	"\aweather\x18\x03 \x01(\v2\x1b.ai_poi.city.v1.WeatherInfoR\aweather\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.city.v1.BaseResponseR\bresponse\"\xd6\x01\n" +
+	"\aweather\x18\x03 \x01(\v2\x1b.ai_poi.city.v1.WeatherInfoR\aweather\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xee\x01\n" +
 	"\x13SearchCitiesRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\x12!\n" +
-	"\fcountry_code\x18\x02 \x01(\tR\vcountryCode\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x127\n" +
+	"\fcountry_code\x18\x02 \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{2}$\xd0\x01\x01R\vcountryCode\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12!\n" +
-	"\ffuzzy_search\x18\x05 \x01(\bR\vfuzzySearch\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.city.v1.BaseRequestR\arequest\"\xe9\x01\n" +
+	"\ffuzzy_search\x18\x05 \x01(\bR\vfuzzySearch\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xeb\x01\n" +
 	"\x14SearchCitiesResponse\x12:\n" +
 	"\aresults\x18\x01 \x03(\v2 .ai_poi.city.v1.CitySearchResultR\aresults\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12:\n" +
-	"\bmetadata\x18\x03 \x01(\v2\x1e.ai_poi.city.v1.SearchMetadataR\bmetadata\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.city.v1.BaseResponseR\bresponse\"\x88\x01\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1e.ai_poi.city.v1.SearchMetadataR\bmetadata\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x88\x01\n" +
 	"\x10CitySearchResult\x12(\n" +
 	"\x04city\x18\x01 \x01(\v2\x14.ai_poi.city.v1.CityR\x04city\x12'\n" +
 	"\x0frelevance_score\x18\x02 \x01(\x01R\x0erelevanceScore\x12!\n" +
@@ -1603,17 +1635,17 @@ const file_city_proto_rawDesc = "" +
 	"\x0eSearchMetadata\x12\"\n" +
 	"\rquery_time_ms\x18\x01 \x01(\x01R\vqueryTimeMs\x12#\n" +
 	"\rsearch_method\x18\x02 \x01(\tR\fsearchMethod\x12.\n" +
-	"\x13fuzzy_matching_used\x18\x03 \x01(\bR\x11fuzzyMatchingUsed\"\x91\x01\n" +
+	"\x13fuzzy_matching_used\x18\x03 \x01(\bR\x11fuzzyMatchingUsed\"\x93\x01\n" +
 	"\x18GetCityStatisticsRequest\x12\x17\n" +
 	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12%\n" +
-	"\x0einclude_trends\x18\x02 \x01(\bR\rincludeTrends\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.city.v1.BaseRequestR\arequest\"\xc8\x01\n" +
+	"\x0einclude_trends\x18\x02 \x01(\bR\rincludeTrends\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xca\x01\n" +
 	"\x19GetCityStatisticsResponse\x12>\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2\x1e.ai_poi.city.v1.CityStatisticsR\n" +
 	"statistics\x121\n" +
-	"\x06trends\x18\x02 \x03(\v2\x19.ai_poi.city.v1.TrendDataR\x06trends\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.city.v1.BaseResponseR\bresponse\"w\n" +
+	"\x06trends\x18\x02 \x03(\v2\x19.ai_poi.city.v1.TrendDataR\x06trends\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"w\n" +
 	"\tTrendData\x12\x16\n" +
 	"\x06metric\x18\x01 \x01(\tR\x06metric\x12:\n" +
 	"\vdata_points\x18\x02 \x03(\v2\x19.ai_poi.city.v1.DataPointR\n" +
@@ -1621,18 +1653,18 @@ const file_city_proto_rawDesc = "" +
 	"\x06period\x18\x03 \x01(\tR\x06period\"[\n" +
 	"\tDataPoint\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value\"N\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status2\xf0\x02\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x012\xf0\x02\n" +
 	"\vCityService\x12P\n" +
 	"\tGetCities\x12 .ai_poi.city.v1.GetCitiesRequest\x1a!.ai_poi.city.v1.GetCitiesResponse\x12J\n" +
 	"\aGetCity\x12\x1e.ai_poi.city.v1.GetCityRequest\x1a\x1f.ai_poi.city.v1.GetCityResponse\x12Y\n" +
@@ -1653,66 +1685,71 @@ func file_city_proto_rawDescGZIP() []byte {
 
 var file_city_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_city_proto_goTypes = []any{
-	(*City)(nil),                      // 0: ai_poi.city.v1.City
-	(*CityMetadata)(nil),              // 1: ai_poi.city.v1.CityMetadata
-	(*CityStatistics)(nil),            // 2: ai_poi.city.v1.CityStatistics
-	(*CategoryCount)(nil),             // 3: ai_poi.city.v1.CategoryCount
-	(*WeatherInfo)(nil),               // 4: ai_poi.city.v1.WeatherInfo
-	(*GetCitiesRequest)(nil),          // 5: ai_poi.city.v1.GetCitiesRequest
-	(*GetCitiesResponse)(nil),         // 6: ai_poi.city.v1.GetCitiesResponse
-	(*GetCityRequest)(nil),            // 7: ai_poi.city.v1.GetCityRequest
-	(*GetCityResponse)(nil),           // 8: ai_poi.city.v1.GetCityResponse
-	(*SearchCitiesRequest)(nil),       // 9: ai_poi.city.v1.SearchCitiesRequest
-	(*SearchCitiesResponse)(nil),      // 10: ai_poi.city.v1.SearchCitiesResponse
-	(*CitySearchResult)(nil),          // 11: ai_poi.city.v1.CitySearchResult
-	(*SearchMetadata)(nil),            // 12: ai_poi.city.v1.SearchMetadata
-	(*GetCityStatisticsRequest)(nil),  // 13: ai_poi.city.v1.GetCityStatisticsRequest
-	(*GetCityStatisticsResponse)(nil), // 14: ai_poi.city.v1.GetCityStatisticsResponse
-	(*TrendData)(nil),                 // 15: ai_poi.city.v1.TrendData
-	(*DataPoint)(nil),                 // 16: ai_poi.city.v1.DataPoint
-	(*BaseRequest)(nil),               // 17: ai_poi.city.v1.BaseRequest
-	(*BaseResponse)(nil),              // 18: ai_poi.city.v1.BaseResponse
-	(*timestamppb.Timestamp)(nil),     // 19: google.protobuf.Timestamp
+	(*City)(nil),                       // 0: ai_poi.city.v1.City
+	(*CityMetadata)(nil),               // 1: ai_poi.city.v1.CityMetadata
+	(*CityStatistics)(nil),             // 2: ai_poi.city.v1.CityStatistics
+	(*CategoryCount)(nil),              // 3: ai_poi.city.v1.CategoryCount
+	(*WeatherInfo)(nil),                // 4: ai_poi.city.v1.WeatherInfo
+	(*GetCitiesRequest)(nil),           // 5: ai_poi.city.v1.GetCitiesRequest
+	(*GetCitiesResponse)(nil),          // 6: ai_poi.city.v1.GetCitiesResponse
+	(*GetCityRequest)(nil),             // 7: ai_poi.city.v1.GetCityRequest
+	(*GetCityResponse)(nil),            // 8: ai_poi.city.v1.GetCityResponse
+	(*SearchCitiesRequest)(nil),        // 9: ai_poi.city.v1.SearchCitiesRequest
+	(*SearchCitiesResponse)(nil),       // 10: ai_poi.city.v1.SearchCitiesResponse
+	(*CitySearchResult)(nil),           // 11: ai_poi.city.v1.CitySearchResult
+	(*SearchMetadata)(nil),             // 12: ai_poi.city.v1.SearchMetadata
+	(*GetCityStatisticsRequest)(nil),   // 13: ai_poi.city.v1.GetCityStatisticsRequest
+	(*GetCityStatisticsResponse)(nil),  // 14: ai_poi.city.v1.GetCityStatisticsResponse
+	(*TrendData)(nil),                  // 15: ai_poi.city.v1.TrendData
+	(*DataPoint)(nil),                  // 16: ai_poi.city.v1.DataPoint
+	(*BaseRequest)(nil),                // 17: ai_poi.city.v1.BaseRequest
+	(*BaseResponse)(nil),               // 18: ai_poi.city.v1.BaseResponse
+	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
+	(*generated.MultilingualText)(nil), // 20: ai_poi.common.v1.MultilingualText
+	(*generated.BaseRequest)(nil),      // 21: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),     // 22: ai_poi.common.v1.BaseResponse
 }
 var file_city_proto_depIdxs = []int32{
 	1,  // 0: ai_poi.city.v1.City.metadata:type_name -> ai_poi.city.v1.CityMetadata
 	19, // 1: ai_poi.city.v1.City.created_at:type_name -> google.protobuf.Timestamp
 	19, // 2: ai_poi.city.v1.City.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 3: ai_poi.city.v1.CityStatistics.poi_by_category:type_name -> ai_poi.city.v1.CategoryCount
-	19, // 4: ai_poi.city.v1.CityStatistics.last_updated:type_name -> google.protobuf.Timestamp
-	19, // 5: ai_poi.city.v1.WeatherInfo.last_updated:type_name -> google.protobuf.Timestamp
-	17, // 6: ai_poi.city.v1.GetCitiesRequest.request:type_name -> ai_poi.city.v1.BaseRequest
-	0,  // 7: ai_poi.city.v1.GetCitiesResponse.cities:type_name -> ai_poi.city.v1.City
-	18, // 8: ai_poi.city.v1.GetCitiesResponse.response:type_name -> ai_poi.city.v1.BaseResponse
-	17, // 9: ai_poi.city.v1.GetCityRequest.request:type_name -> ai_poi.city.v1.BaseRequest
-	0,  // 10: ai_poi.city.v1.GetCityResponse.city:type_name -> ai_poi.city.v1.City
-	2,  // 11: ai_poi.city.v1.GetCityResponse.statistics:type_name -> ai_poi.city.v1.CityStatistics
-	4,  // 12: ai_poi.city.v1.GetCityResponse.weather:type_name -> ai_poi.city.v1.WeatherInfo
-	18, // 13: ai_poi.city.v1.GetCityResponse.response:type_name -> ai_poi.city.v1.BaseResponse
-	17, // 14: ai_poi.city.v1.SearchCitiesRequest.request:type_name -> ai_poi.city.v1.BaseRequest
-	11, // 15: ai_poi.city.v1.SearchCitiesResponse.results:type_name -> ai_poi.city.v1.CitySearchResult
-	12, // 16: ai_poi.city.v1.SearchCitiesResponse.metadata:type_name -> ai_poi.city.v1.SearchMetadata
-	18, // 17: ai_poi.city.v1.SearchCitiesResponse.response:type_name -> ai_poi.city.v1.BaseResponse
-	0,  // 18: ai_poi.city.v1.CitySearchResult.city:type_name -> ai_poi.city.v1.City
-	17, // 19: ai_poi.city.v1.GetCityStatisticsRequest.request:type_name -> ai_poi.city.v1.BaseRequest
-	2,  // 20: ai_poi.city.v1.GetCityStatisticsResponse.statistics:type_name -> ai_poi.city.v1.CityStatistics
-	15, // 21: ai_poi.city.v1.GetCityStatisticsResponse.trends:type_name -> ai_poi.city.v1.TrendData
-	18, // 22: ai_poi.city.v1.GetCityStatisticsResponse.response:type_name -> ai_poi.city.v1.BaseResponse
-	16, // 23: ai_poi.city.v1.TrendData.data_points:type_name -> ai_poi.city.v1.DataPoint
-	19, // 24: ai_poi.city.v1.DataPoint.timestamp:type_name -> google.protobuf.Timestamp
-	5,  // 25: ai_poi.city.v1.CityService.GetCities:input_type -> ai_poi.city.v1.GetCitiesRequest
-	7,  // 26: ai_poi.city.v1.CityService.GetCity:input_type -> ai_poi.city.v1.GetCityRequest
-	9,  // 27: ai_poi.city.v1.CityService.SearchCities:input_type -> ai_poi.city.v1.SearchCitiesRequest
-	13, // 28: ai_poi.city.v1.CityService.GetCityStatistics:input_type -> ai_poi.city.v1.GetCityStatisticsRequest
-	6,  // 29: ai_poi.city.v1.CityService.GetCities:output_type -> ai_poi.city.v1.GetCitiesResponse
-	8,  // 30: ai_poi.city.v1.CityService.GetCity:output_type -> ai_poi.city.v1.GetCityResponse
-	10, // 31: ai_poi.city.v1.CityService.SearchCities:output_type -> ai_poi.city.v1.SearchCitiesResponse
-	14, // 32: ai_poi.city.v1.CityService.GetCityStatistics:output_type -> ai_poi.city.v1.GetCityStatisticsResponse
-	29, // [29:33] is the sub-list for method output_type
-	25, // [25:29] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	20, // 3: ai_poi.city.v1.City.localized_name:type_name -> ai_poi.common.v1.MultilingualText
+	20, // 4: ai_poi.city.v1.City.localized_description:type_name -> ai_poi.common.v1.MultilingualText
+	3,  // 5: ai_poi.city.v1.CityStatistics.poi_by_category:type_name -> ai_poi.city.v1.CategoryCount
+	19, // 6: ai_poi.city.v1.CityStatistics.last_updated:type_name -> google.protobuf.Timestamp
+	19, // 7: ai_poi.city.v1.WeatherInfo.last_updated:type_name -> google.protobuf.Timestamp
+	21, // 8: ai_poi.city.v1.GetCitiesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 9: ai_poi.city.v1.GetCitiesResponse.cities:type_name -> ai_poi.city.v1.City
+	22, // 10: ai_poi.city.v1.GetCitiesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	21, // 11: ai_poi.city.v1.GetCityRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 12: ai_poi.city.v1.GetCityResponse.city:type_name -> ai_poi.city.v1.City
+	2,  // 13: ai_poi.city.v1.GetCityResponse.statistics:type_name -> ai_poi.city.v1.CityStatistics
+	4,  // 14: ai_poi.city.v1.GetCityResponse.weather:type_name -> ai_poi.city.v1.WeatherInfo
+	22, // 15: ai_poi.city.v1.GetCityResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	21, // 16: ai_poi.city.v1.SearchCitiesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	11, // 17: ai_poi.city.v1.SearchCitiesResponse.results:type_name -> ai_poi.city.v1.CitySearchResult
+	12, // 18: ai_poi.city.v1.SearchCitiesResponse.metadata:type_name -> ai_poi.city.v1.SearchMetadata
+	22, // 19: ai_poi.city.v1.SearchCitiesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	0,  // 20: ai_poi.city.v1.CitySearchResult.city:type_name -> ai_poi.city.v1.City
+	21, // 21: ai_poi.city.v1.GetCityStatisticsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	2,  // 22: ai_poi.city.v1.GetCityStatisticsResponse.statistics:type_name -> ai_poi.city.v1.CityStatistics
+	15, // 23: ai_poi.city.v1.GetCityStatisticsResponse.trends:type_name -> ai_poi.city.v1.TrendData
+	22, // 24: ai_poi.city.v1.GetCityStatisticsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	16, // 25: ai_poi.city.v1.TrendData.data_points:type_name -> ai_poi.city.v1.DataPoint
+	19, // 26: ai_poi.city.v1.DataPoint.timestamp:type_name -> google.protobuf.Timestamp
+	5,  // 27: ai_poi.city.v1.CityService.GetCities:input_type -> ai_poi.city.v1.GetCitiesRequest
+	7,  // 28: ai_poi.city.v1.CityService.GetCity:input_type -> ai_poi.city.v1.GetCityRequest
+	9,  // 29: ai_poi.city.v1.CityService.SearchCities:input_type -> ai_poi.city.v1.SearchCitiesRequest
+	13, // 30: ai_poi.city.v1.CityService.GetCityStatistics:input_type -> ai_poi.city.v1.GetCityStatisticsRequest
+	6,  // 31: ai_poi.city.v1.CityService.GetCities:output_type -> ai_poi.city.v1.GetCitiesResponse
+	8,  // 32: ai_poi.city.v1.CityService.GetCity:output_type -> ai_poi.city.v1.GetCityResponse
+	10, // 33: ai_poi.city.v1.CityService.SearchCities:output_type -> ai_poi.city.v1.SearchCitiesResponse
+	14, // 34: ai_poi.city.v1.CityService.GetCityStatistics:output_type -> ai_poi.city.v1.GetCityStatisticsResponse
+	31, // [31:35] is the sub-list for method output_type
+	27, // [27:31] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_city_proto_init() }
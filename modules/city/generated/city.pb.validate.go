@@ -0,0 +1,2925 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: city.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on City with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *City) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on City with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CityMultiError, or nil if none found.
+func (m *City) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *City) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Country
+
+	if m.GetCountryCode() != "" {
+
+		if !_City_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := CityValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^[A-Z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for StateProvince
+
+	// no validation rules for Latitude
+
+	// no validation rules for Longitude
+
+	// no validation rules for Timezone
+
+	// no validation rules for Population
+
+	// no validation rules for Currency
+
+	// no validation rules for Description
+
+	// no validation rules for Climate
+
+	// no validation rules for BestTimeToVisit
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalizedName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityValidationError{
+					field:  "LocalizedName",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityValidationError{
+					field:  "LocalizedName",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalizedName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityValidationError{
+				field:  "LocalizedName",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalizedDescription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityValidationError{
+					field:  "LocalizedDescription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityValidationError{
+					field:  "LocalizedDescription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalizedDescription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityValidationError{
+				field:  "LocalizedDescription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CityMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityMultiError is an error wrapping multiple validation errors returned by
+// City.ValidateAll() if the designated constraints aren't met.
+type CityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityMultiError) AllErrors() []error { return m }
+
+// CityValidationError is the validation error returned by City.Validate if the
+// designated constraints aren't met.
+type CityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityValidationError) ErrorName() string { return "CityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityValidationError{}
+
+var _City_CountryCode_Pattern = regexp.MustCompile("^[A-Z]{2}$")
+
+// Validate checks the field values on CityMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CityMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CityMetadataMultiError, or
+// nil if none found.
+func (m *CityMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageUrl
+
+	// no validation rules for OfficialWebsite
+
+	// no validation rules for TourismWebsite
+
+	// no validation rules for IsCapital
+
+	// no validation rules for IsPopularDestination
+
+	// no validation rules for SafetyRating
+
+	// no validation rules for CostOfLivingIndex
+
+	// no validation rules for WalkabilityScore
+
+	if len(errors) > 0 {
+		return CityMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityMetadataMultiError is an error wrapping multiple validation errors
+// returned by CityMetadata.ValidateAll() if the designated constraints aren't met.
+type CityMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityMetadataMultiError) AllErrors() []error { return m }
+
+// CityMetadataValidationError is the validation error returned by
+// CityMetadata.Validate if the designated constraints aren't met.
+type CityMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityMetadataValidationError) ErrorName() string { return "CityMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CityMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityMetadataValidationError{}
+
+// Validate checks the field values on CityStatistics with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CityStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityStatistics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CityStatisticsMultiError,
+// or nil if none found.
+func (m *CityStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	// no validation rules for TotalPois
+
+	// no validation rules for TotalRestaurants
+
+	// no validation rules for TotalHotels
+
+	// no validation rules for TotalAttractions
+
+	// no validation rules for UserVisits
+
+	// no validation rules for SavedItineraries
+
+	// no validation rules for AverageRating
+
+	for idx, item := range m.GetPoiByCategory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CityStatisticsValidationError{
+						field:  fmt.Sprintf("PoiByCategory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CityStatisticsValidationError{
+						field:  fmt.Sprintf("PoiByCategory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CityStatisticsValidationError{
+					field:  fmt.Sprintf("PoiByCategory[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUpdated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityStatisticsValidationError{
+					field:  "LastUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityStatisticsValidationError{
+					field:  "LastUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUpdated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityStatisticsValidationError{
+				field:  "LastUpdated",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CityStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityStatisticsMultiError is an error wrapping multiple validation errors
+// returned by CityStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type CityStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityStatisticsMultiError) AllErrors() []error { return m }
+
+// CityStatisticsValidationError is the validation error returned by
+// CityStatistics.Validate if the designated constraints aren't met.
+type CityStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityStatisticsValidationError) ErrorName() string { return "CityStatisticsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CityStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityStatisticsValidationError{}
+
+// Validate checks the field values on CategoryCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryCountMultiError, or
+// nil if none found.
+func (m *CategoryCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Category
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return CategoryCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryCountMultiError is an error wrapping multiple validation errors
+// returned by CategoryCount.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryCountMultiError) AllErrors() []error { return m }
+
+// CategoryCountValidationError is the validation error returned by
+// CategoryCount.Validate if the designated constraints aren't met.
+type CategoryCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryCountValidationError) ErrorName() string { return "CategoryCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryCountValidationError{}
+
+// Validate checks the field values on WeatherInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WeatherInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WeatherInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WeatherInfoMultiError, or
+// nil if none found.
+func (m *WeatherInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WeatherInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	// no validation rules for CurrentCondition
+
+	// no validation rules for TemperatureCelsius
+
+	// no validation rules for Humidity
+
+	// no validation rules for Description
+
+	// no validation rules for ForecastUrl
+
+	if all {
+		switch v := interface{}(m.GetLastUpdated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WeatherInfoValidationError{
+					field:  "LastUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WeatherInfoValidationError{
+					field:  "LastUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUpdated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WeatherInfoValidationError{
+				field:  "LastUpdated",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WeatherInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// WeatherInfoMultiError is an error wrapping multiple validation errors
+// returned by WeatherInfo.ValidateAll() if the designated constraints aren't met.
+type WeatherInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WeatherInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WeatherInfoMultiError) AllErrors() []error { return m }
+
+// WeatherInfoValidationError is the validation error returned by
+// WeatherInfo.Validate if the designated constraints aren't met.
+type WeatherInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WeatherInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WeatherInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WeatherInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WeatherInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WeatherInfoValidationError) ErrorName() string { return "WeatherInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WeatherInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWeatherInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WeatherInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WeatherInfoValidationError{}
+
+// Validate checks the field values on GetCitiesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCitiesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCitiesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCitiesRequestMultiError, or nil if none found.
+func (m *GetCitiesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCitiesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for IncludeStatistics
+
+	if m.GetCountryCode() != "" {
+
+		if !_GetCitiesRequest_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := GetCitiesRequestValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^[A-Z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PopularOnly
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCitiesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCitiesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCitiesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCitiesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCitiesRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCitiesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCitiesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCitiesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCitiesRequestMultiError) AllErrors() []error { return m }
+
+// GetCitiesRequestValidationError is the validation error returned by
+// GetCitiesRequest.Validate if the designated constraints aren't met.
+type GetCitiesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCitiesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCitiesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCitiesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCitiesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCitiesRequestValidationError) ErrorName() string { return "GetCitiesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCitiesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCitiesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCitiesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCitiesRequestValidationError{}
+
+var _GetCitiesRequest_CountryCode_Pattern = regexp.MustCompile("^[A-Z]{2}$")
+
+// Validate checks the field values on GetCitiesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCitiesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCitiesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCitiesResponseMultiError, or nil if none found.
+func (m *GetCitiesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCitiesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCitiesResponseValidationError{
+						field:  fmt.Sprintf("Cities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCitiesResponseValidationError{
+						field:  fmt.Sprintf("Cities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCitiesResponseValidationError{
+					field:  fmt.Sprintf("Cities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCitiesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCitiesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCitiesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCitiesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCitiesResponseMultiError is an error wrapping multiple validation errors
+// returned by GetCitiesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetCitiesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCitiesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCitiesResponseMultiError) AllErrors() []error { return m }
+
+// GetCitiesResponseValidationError is the validation error returned by
+// GetCitiesResponse.Validate if the designated constraints aren't met.
+type GetCitiesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCitiesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCitiesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCitiesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCitiesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCitiesResponseValidationError) ErrorName() string {
+	return "GetCitiesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCitiesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCitiesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCitiesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCitiesResponseValidationError{}
+
+// Validate checks the field values on GetCityRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetCityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCityRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetCityRequestMultiError,
+// or nil if none found.
+func (m *GetCityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	// no validation rules for IncludeStatistics
+
+	// no validation rules for IncludeWeather
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCityRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCityRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCityRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCityRequestMultiError) AllErrors() []error { return m }
+
+// GetCityRequestValidationError is the validation error returned by
+// GetCityRequest.Validate if the designated constraints aren't met.
+type GetCityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCityRequestValidationError) ErrorName() string { return "GetCityRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCityRequestValidationError{}
+
+// Validate checks the field values on GetCityResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCityResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCityResponseMultiError, or nil if none found.
+func (m *GetCityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityResponseValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityResponseValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityResponseValidationError{
+				field:  "City",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityResponseValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWeather()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityResponseValidationError{
+					field:  "Weather",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityResponseValidationError{
+					field:  "Weather",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWeather()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityResponseValidationError{
+				field:  "Weather",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCityResponseMultiError is an error wrapping multiple validation errors
+// returned by GetCityResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetCityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCityResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCityResponseMultiError) AllErrors() []error { return m }
+
+// GetCityResponseValidationError is the validation error returned by
+// GetCityResponse.Validate if the designated constraints aren't met.
+type GetCityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCityResponseValidationError) ErrorName() string { return "GetCityResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCityResponseValidationError{}
+
+// Validate checks the field values on SearchCitiesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchCitiesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchCitiesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchCitiesRequestMultiError, or nil if none found.
+func (m *SearchCitiesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchCitiesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	if m.GetCountryCode() != "" {
+
+		if !_SearchCitiesRequest_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := SearchCitiesRequestValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^[A-Z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for FuzzySearch
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchCitiesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchCitiesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchCitiesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchCitiesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchCitiesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchCitiesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchCitiesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchCitiesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchCitiesRequestMultiError) AllErrors() []error { return m }
+
+// SearchCitiesRequestValidationError is the validation error returned by
+// SearchCitiesRequest.Validate if the designated constraints aren't met.
+type SearchCitiesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchCitiesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchCitiesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchCitiesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchCitiesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchCitiesRequestValidationError) ErrorName() string {
+	return "SearchCitiesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchCitiesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchCitiesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchCitiesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchCitiesRequestValidationError{}
+
+var _SearchCitiesRequest_CountryCode_Pattern = regexp.MustCompile("^[A-Z]{2}$")
+
+// Validate checks the field values on SearchCitiesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchCitiesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchCitiesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchCitiesResponseMultiError, or nil if none found.
+func (m *SearchCitiesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchCitiesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchCitiesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchCitiesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchCitiesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchCitiesResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchCitiesResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchCitiesResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchCitiesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchCitiesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchCitiesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchCitiesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchCitiesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchCitiesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchCitiesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchCitiesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchCitiesResponseMultiError) AllErrors() []error { return m }
+
+// SearchCitiesResponseValidationError is the validation error returned by
+// SearchCitiesResponse.Validate if the designated constraints aren't met.
+type SearchCitiesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchCitiesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchCitiesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchCitiesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchCitiesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchCitiesResponseValidationError) ErrorName() string {
+	return "SearchCitiesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchCitiesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchCitiesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchCitiesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchCitiesResponseValidationError{}
+
+// Validate checks the field values on CitySearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CitySearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CitySearchResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CitySearchResultMultiError, or nil if none found.
+func (m *CitySearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CitySearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CitySearchResultValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CitySearchResultValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CitySearchResultValidationError{
+				field:  "City",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RelevanceScore
+
+	// no validation rules for MatchReason
+
+	if len(errors) > 0 {
+		return CitySearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// CitySearchResultMultiError is an error wrapping multiple validation errors
+// returned by CitySearchResult.ValidateAll() if the designated constraints
+// aren't met.
+type CitySearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CitySearchResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CitySearchResultMultiError) AllErrors() []error { return m }
+
+// CitySearchResultValidationError is the validation error returned by
+// CitySearchResult.Validate if the designated constraints aren't met.
+type CitySearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CitySearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CitySearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CitySearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CitySearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CitySearchResultValidationError) ErrorName() string { return "CitySearchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CitySearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCitySearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CitySearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CitySearchResultValidationError{}
+
+// Validate checks the field values on SearchMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchMetadataMultiError,
+// or nil if none found.
+func (m *SearchMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QueryTimeMs
+
+	// no validation rules for SearchMethod
+
+	// no validation rules for FuzzyMatchingUsed
+
+	if len(errors) > 0 {
+		return SearchMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMetadataMultiError is an error wrapping multiple validation errors
+// returned by SearchMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type SearchMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMetadataMultiError) AllErrors() []error { return m }
+
+// SearchMetadataValidationError is the validation error returned by
+// SearchMetadata.Validate if the designated constraints aren't met.
+type SearchMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMetadataValidationError) ErrorName() string { return "SearchMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMetadataValidationError{}
+
+// Validate checks the field values on GetCityStatisticsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCityStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCityStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCityStatisticsRequestMultiError, or nil if none found.
+func (m *GetCityStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCityStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	// no validation rules for IncludeTrends
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityStatisticsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCityStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCityStatisticsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCityStatisticsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCityStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCityStatisticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCityStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// GetCityStatisticsRequestValidationError is the validation error returned by
+// GetCityStatisticsRequest.Validate if the designated constraints aren't met.
+type GetCityStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCityStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCityStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCityStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCityStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCityStatisticsRequestValidationError) ErrorName() string {
+	return "GetCityStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCityStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCityStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCityStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCityStatisticsRequestValidationError{}
+
+// Validate checks the field values on GetCityStatisticsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCityStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCityStatisticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCityStatisticsResponseMultiError, or nil if none found.
+func (m *GetCityStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCityStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityStatisticsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityStatisticsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityStatisticsResponseValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTrends() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCityStatisticsResponseValidationError{
+						field:  fmt.Sprintf("Trends[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCityStatisticsResponseValidationError{
+						field:  fmt.Sprintf("Trends[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCityStatisticsResponseValidationError{
+					field:  fmt.Sprintf("Trends[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityStatisticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityStatisticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityStatisticsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCityStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCityStatisticsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCityStatisticsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetCityStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCityStatisticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCityStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// GetCityStatisticsResponseValidationError is the validation error returned by
+// GetCityStatisticsResponse.Validate if the designated constraints aren't met.
+type GetCityStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCityStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCityStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCityStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCityStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCityStatisticsResponseValidationError) ErrorName() string {
+	return "GetCityStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCityStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCityStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCityStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCityStatisticsResponseValidationError{}
+
+// Validate checks the field values on TrendData with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrendData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrendData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrendDataMultiError, or nil
+// if none found.
+func (m *TrendData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrendData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Metric
+
+	for idx, item := range m.GetDataPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TrendDataValidationError{
+						field:  fmt.Sprintf("DataPoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TrendDataValidationError{
+						field:  fmt.Sprintf("DataPoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrendDataValidationError{
+					field:  fmt.Sprintf("DataPoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Period
+
+	if len(errors) > 0 {
+		return TrendDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrendDataMultiError is an error wrapping multiple validation errors returned
+// by TrendData.ValidateAll() if the designated constraints aren't met.
+type TrendDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrendDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrendDataMultiError) AllErrors() []error { return m }
+
+// TrendDataValidationError is the validation error returned by
+// TrendData.Validate if the designated constraints aren't met.
+type TrendDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrendDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrendDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrendDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrendDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrendDataValidationError) ErrorName() string { return "TrendDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrendDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrendData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrendDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrendDataValidationError{}
+
+// Validate checks the field values on DataPoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DataPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DataPointMultiError, or nil
+// if none found.
+func (m *DataPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataPointValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataPointValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataPointValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return DataPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataPointMultiError is an error wrapping multiple validation errors returned
+// by DataPoint.ValidateAll() if the designated constraints aren't met.
+type DataPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataPointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataPointMultiError) AllErrors() []error { return m }
+
+// DataPointValidationError is the validation error returned by
+// DataPoint.Validate if the designated constraints aren't met.
+type DataPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataPointValidationError) ErrorName() string { return "DataPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataPointValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
@@ -12,9 +12,9 @@ import (
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.CityServiceClient
 }
 
 var (
@@ -22,7 +22,7 @@ var (
 	_ core.Broker         = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -30,38 +30,63 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewCityServiceClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.CityServiceClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewCityServiceClient(conn), nil
+}
+
 // CityService method implementations
 func (b *Broker) GetCities(ctx context.Context, in *c.GetCitiesRequest, opts ...grpc.CallOption) (*c.GetCitiesResponse, error) {
-	return b.client.GetCities(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetCities(ctx, in, opts...)
 }
 
 func (b *Broker) GetCity(ctx context.Context, in *c.GetCityRequest, opts ...grpc.CallOption) (*c.GetCityResponse, error) {
-	return b.client.GetCity(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetCity(ctx, in, opts...)
 }
 
 func (b *Broker) SearchCities(ctx context.Context, in *c.SearchCitiesRequest, opts ...grpc.CallOption) (*c.SearchCitiesResponse, error) {
-	return b.client.SearchCities(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SearchCities(ctx, in, opts...)
 }
 
 func (b *Broker) GetCityStatistics(ctx context.Context, in *c.GetCityStatisticsRequest, opts ...grpc.CallOption) (*c.GetCityStatisticsResponse, error) {
-	return b.client.GetCityStatistics(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetCityStatistics(ctx, in, opts...)
 }
\ No newline at end of file
@@ -7,6 +7,7 @@
 package generated
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -408,6 +409,7 @@ type PaginationRequest struct {
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Number of items per page (default: 20, max: 100)
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                     // Alternative to page-based pagination
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                       // Alternative to page_size
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                      // Opaque cursor from PaginationResponse.next_cursor, takes precedence over page and offset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -470,6 +472,13 @@ func (x *PaginationRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *PaginationRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 // Pagination response metadata
 type PaginationResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -1173,6 +1182,69 @@ func (x *SpecialHours) GetIsClosed() bool {
 	return false
 }
 
+// An amount of money in a currency, with the same shape as google.type.Money.
+// units and nanos must have the same sign, e.g. -1.75 is units -1 and nanos
+// -750000000.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // ISO 4217
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	Nanos         int32                  `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_common_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
 // Rating information
 type Rating struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1185,7 +1257,7 @@ type Rating struct {
 
 func (x *Rating) Reset() {
 	*x = Rating{}
-	mi := &file_common_proto_msgTypes[14]
+	mi := &file_common_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1197,7 +1269,7 @@ func (x *Rating) String() string {
 func (*Rating) ProtoMessage() {}
 
 func (x *Rating) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[14]
+	mi := &file_common_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,7 +1282,7 @@ func (x *Rating) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rating.ProtoReflect.Descriptor instead.
 func (*Rating) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{14}
+	return file_common_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Rating) GetAverage() float64 {
@@ -1247,7 +1319,7 @@ type RatingBreakdown struct {
 
 func (x *RatingBreakdown) Reset() {
 	*x = RatingBreakdown{}
-	mi := &file_common_proto_msgTypes[15]
+	mi := &file_common_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1259,7 +1331,7 @@ func (x *RatingBreakdown) String() string {
 func (*RatingBreakdown) ProtoMessage() {}
 
 func (x *RatingBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[15]
+	mi := &file_common_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,7 +1344,7 @@ func (x *RatingBreakdown) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RatingBreakdown.ProtoReflect.Descriptor instead.
 func (*RatingBreakdown) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{15}
+	return file_common_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RatingBreakdown) GetFiveStar() int32 {
@@ -1322,7 +1394,7 @@ type Media struct {
 
 func (x *Media) Reset() {
 	*x = Media{}
-	mi := &file_common_proto_msgTypes[16]
+	mi := &file_common_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1334,7 +1406,7 @@ func (x *Media) String() string {
 func (*Media) ProtoMessage() {}
 
 func (x *Media) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[16]
+	mi := &file_common_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1419,7 @@ func (x *Media) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Media.ProtoReflect.Descriptor instead.
 func (*Media) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{16}
+	return file_common_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Media) GetPhotos() []*Photo {
@@ -1388,7 +1460,7 @@ type Photo struct {
 
 func (x *Photo) Reset() {
 	*x = Photo{}
-	mi := &file_common_proto_msgTypes[17]
+	mi := &file_common_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1400,7 +1472,7 @@ func (x *Photo) String() string {
 func (*Photo) ProtoMessage() {}
 
 func (x *Photo) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[17]
+	mi := &file_common_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,7 +1485,7 @@ func (x *Photo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Photo.ProtoReflect.Descriptor instead.
 func (*Photo) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{17}
+	return file_common_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Photo) GetId() string {
@@ -1494,7 +1566,7 @@ type Video struct {
 
 func (x *Video) Reset() {
 	*x = Video{}
-	mi := &file_common_proto_msgTypes[18]
+	mi := &file_common_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1506,7 +1578,7 @@ func (x *Video) String() string {
 func (*Video) ProtoMessage() {}
 
 func (x *Video) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[18]
+	mi := &file_common_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,7 +1591,7 @@ func (x *Video) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Video.ProtoReflect.Descriptor instead.
 func (*Video) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{18}
+	return file_common_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Video) GetId() string {
@@ -1584,7 +1656,7 @@ type VirtualTour struct {
 
 func (x *VirtualTour) Reset() {
 	*x = VirtualTour{}
-	mi := &file_common_proto_msgTypes[19]
+	mi := &file_common_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1596,7 +1668,7 @@ func (x *VirtualTour) String() string {
 func (*VirtualTour) ProtoMessage() {}
 
 func (x *VirtualTour) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[19]
+	mi := &file_common_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1609,7 +1681,7 @@ func (x *VirtualTour) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VirtualTour.ProtoReflect.Descriptor instead.
 func (*VirtualTour) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{19}
+	return file_common_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *VirtualTour) GetId() string {
@@ -1647,6 +1719,371 @@ func (x *VirtualTour) GetPreviewImageUrl() string {
 	return ""
 }
 
+// Canonical point of interest. poi.v1, chat.v1 and list.v1 each declare a
+// POIDetailedInfo of their own; their Go packages convert those to and from
+// this message without losing data.
+type POI struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location         *Coordinates           `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Category         string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Subcategory      string                 `protobuf:"bytes,5,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
+	Description      string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Rating           *Rating                `protobuf:"bytes,7,opt,name=rating,proto3" json:"rating,omitempty"`
+	PriceRange       PriceRange             `protobuf:"varint,8,opt,name=price_range,json=priceRange,proto3,enum=ai_poi.common.v1.PriceRange" json:"price_range,omitempty"`
+	PriceLabel       string                 `protobuf:"bytes,9,opt,name=price_label,json=priceLabel,proto3" json:"price_label,omitempty"` // As sent by upstream, e.g. "€€"
+	PriceLevel       string                 `protobuf:"bytes,10,opt,name=price_level,json=priceLevel,proto3" json:"price_level,omitempty"`
+	Address          *Address               `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	Contact          *ContactInfo           `protobuf:"bytes,12,opt,name=contact,proto3" json:"contact,omitempty"`
+	PhoneNumber      string                 `protobuf:"bytes,13,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"` // Second number some upstreams send next to contact.phone
+	OpeningHours     *OpeningHours          `protobuf:"bytes,14,opt,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	OpeningHoursText []string               `protobuf:"bytes,15,rep,name=opening_hours_text,json=openingHoursText,proto3" json:"opening_hours_text,omitempty"` // Unparsed, e.g. "Monday: 09:00-17:00"
+	Media            *Media                 `protobuf:"bytes,16,opt,name=media,proto3" json:"media,omitempty"`
+	Amenities        []string               `protobuf:"bytes,17,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	Distance         string                 `protobuf:"bytes,18,opt,name=distance,proto3" json:"distance,omitempty"` // Distance from the search point, as sent by upstream
+	CityId           string                 `protobuf:"bytes,19,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	IsVerified       bool                   `protobuf:"varint,20,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	Tags             []*POITag              `protobuf:"bytes,21,rep,name=tags,proto3" json:"tags,omitempty"`
+	Source           string                 `protobuf:"bytes,22,opt,name=source,proto3" json:"source,omitempty"`
+	Metadata         map[string]string      `protobuf:"bytes,23,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DistanceMeters   float64                `protobuf:"fixed64,26,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"` // Distance from the search point, for sorting
+	// Translations of name and description, which hold the default language
+	LocalizedName        *MultilingualText `protobuf:"bytes,27,opt,name=localized_name,json=localizedName,proto3" json:"localized_name,omitempty"`
+	LocalizedDescription *MultilingualText `protobuf:"bytes,28,opt,name=localized_description,json=localizedDescription,proto3" json:"localized_description,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *POI) Reset() {
+	*x = POI{}
+	mi := &file_common_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *POI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*POI) ProtoMessage() {}
+
+func (x *POI) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use POI.ProtoReflect.Descriptor instead.
+func (*POI) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *POI) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *POI) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *POI) GetLocation() *Coordinates {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *POI) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *POI) GetSubcategory() string {
+	if x != nil {
+		return x.Subcategory
+	}
+	return ""
+}
+
+func (x *POI) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *POI) GetRating() *Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *POI) GetPriceRange() PriceRange {
+	if x != nil {
+		return x.PriceRange
+	}
+	return PriceRange_PRICE_RANGE_UNSPECIFIED
+}
+
+func (x *POI) GetPriceLabel() string {
+	if x != nil {
+		return x.PriceLabel
+	}
+	return ""
+}
+
+func (x *POI) GetPriceLevel() string {
+	if x != nil {
+		return x.PriceLevel
+	}
+	return ""
+}
+
+func (x *POI) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *POI) GetContact() *ContactInfo {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *POI) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *POI) GetOpeningHours() *OpeningHours {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+func (x *POI) GetOpeningHoursText() []string {
+	if x != nil {
+		return x.OpeningHoursText
+	}
+	return nil
+}
+
+func (x *POI) GetMedia() *Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *POI) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *POI) GetDistance() string {
+	if x != nil {
+		return x.Distance
+	}
+	return ""
+}
+
+func (x *POI) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *POI) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *POI) GetTags() []*POITag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *POI) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *POI) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *POI) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *POI) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *POI) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *POI) GetLocalizedName() *MultilingualText {
+	if x != nil {
+		return x.LocalizedName
+	}
+	return nil
+}
+
+func (x *POI) GetLocalizedDescription() *MultilingualText {
+	if x != nil {
+		return x.LocalizedDescription
+	}
+	return nil
+}
+
+// Tag attached to a POI, wire compatible with poi.v1.Tags
+type POITag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TagType       string                 `protobuf:"bytes,4,opt,name=tag_type,json=tagType,proto3" json:"tag_type,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *POITag) Reset() {
+	*x = POITag{}
+	mi := &file_common_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *POITag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*POITag) ProtoMessage() {}
+
+func (x *POITag) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use POITag.ProtoReflect.Descriptor instead.
+func (*POITag) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *POITag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *POITag) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *POITag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *POITag) GetTagType() string {
+	if x != nil {
+		return x.TagType
+	}
+	return ""
+}
+
+func (x *POITag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *POITag) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *POITag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *POITag) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 // Sort options
 type SortOptions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1658,7 +2095,7 @@ type SortOptions struct {
 
 func (x *SortOptions) Reset() {
 	*x = SortOptions{}
-	mi := &file_common_proto_msgTypes[20]
+	mi := &file_common_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1670,7 +2107,7 @@ func (x *SortOptions) String() string {
 func (*SortOptions) ProtoMessage() {}
 
 func (x *SortOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[20]
+	mi := &file_common_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1683,7 +2120,7 @@ func (x *SortOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SortOptions.ProtoReflect.Descriptor instead.
 func (*SortOptions) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{20}
+	return file_common_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SortOptions) GetField() string {
@@ -1716,7 +2153,7 @@ type FilterOptions struct {
 
 func (x *FilterOptions) Reset() {
 	*x = FilterOptions{}
-	mi := &file_common_proto_msgTypes[21]
+	mi := &file_common_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1728,7 +2165,7 @@ func (x *FilterOptions) String() string {
 func (*FilterOptions) ProtoMessage() {}
 
 func (x *FilterOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[21]
+	mi := &file_common_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,7 +2178,7 @@ func (x *FilterOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterOptions.ProtoReflect.Descriptor instead.
 func (*FilterOptions) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{21}
+	return file_common_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *FilterOptions) GetCategories() []string {
@@ -1796,7 +2233,7 @@ func (x *FilterOptions) GetCustomFilters() map[string]string {
 // Localization
 type LocalizedString struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LanguageCode  string                 `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"` // ISO 639-1
+	LanguageCode  string                 `protobuf:"bytes,1,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"` // BCP 47, e.g. "pt" or "pt-BR"
 	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1804,7 +2241,7 @@ type LocalizedString struct {
 
 func (x *LocalizedString) Reset() {
 	*x = LocalizedString{}
-	mi := &file_common_proto_msgTypes[22]
+	mi := &file_common_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1816,7 +2253,7 @@ func (x *LocalizedString) String() string {
 func (*LocalizedString) ProtoMessage() {}
 
 func (x *LocalizedString) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[22]
+	mi := &file_common_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1829,7 +2266,7 @@ func (x *LocalizedString) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalizedString.ProtoReflect.Descriptor instead.
 func (*LocalizedString) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{22}
+	return file_common_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *LocalizedString) GetLanguageCode() string {
@@ -1856,7 +2293,7 @@ type MultilingualText struct {
 
 func (x *MultilingualText) Reset() {
 	*x = MultilingualText{}
-	mi := &file_common_proto_msgTypes[23]
+	mi := &file_common_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1868,7 +2305,7 @@ func (x *MultilingualText) String() string {
 func (*MultilingualText) ProtoMessage() {}
 
 func (x *MultilingualText) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[23]
+	mi := &file_common_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1881,7 +2318,7 @@ func (x *MultilingualText) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultilingualText.ProtoReflect.Descriptor instead.
 func (*MultilingualText) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{23}
+	return file_common_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MultilingualText) GetTranslations() []*LocalizedString {
@@ -1912,7 +2349,7 @@ type AuditInfo struct {
 
 func (x *AuditInfo) Reset() {
 	*x = AuditInfo{}
-	mi := &file_common_proto_msgTypes[24]
+	mi := &file_common_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1924,7 +2361,7 @@ func (x *AuditInfo) String() string {
 func (*AuditInfo) ProtoMessage() {}
 
 func (x *AuditInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[24]
+	mi := &file_common_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1937,7 +2374,7 @@ func (x *AuditInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditInfo.ProtoReflect.Descriptor instead.
 func (*AuditInfo) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{24}
+	return file_common_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AuditInfo) GetCreatedAt() *timestamppb.Timestamp {
@@ -1986,7 +2423,7 @@ type HealthCheckRequest struct {
 
 func (x *HealthCheckRequest) Reset() {
 	*x = HealthCheckRequest{}
-	mi := &file_common_proto_msgTypes[25]
+	mi := &file_common_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1998,7 +2435,7 @@ func (x *HealthCheckRequest) String() string {
 func (*HealthCheckRequest) ProtoMessage() {}
 
 func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[25]
+	mi := &file_common_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2011,7 +2448,7 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{25}
+	return file_common_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *HealthCheckRequest) GetService() string {
@@ -2042,7 +2479,7 @@ type HealthCheckResponse struct {
 
 func (x *HealthCheckResponse) Reset() {
 	*x = HealthCheckResponse{}
-	mi := &file_common_proto_msgTypes[26]
+	mi := &file_common_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2054,7 +2491,7 @@ func (x *HealthCheckResponse) String() string {
 func (*HealthCheckResponse) ProtoMessage() {}
 
 func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[26]
+	mi := &file_common_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2067,7 +2504,7 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{26}
+	return file_common_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *HealthCheckResponse) GetStatus() string {
@@ -2116,7 +2553,7 @@ type ComponentHealth struct {
 
 func (x *ComponentHealth) Reset() {
 	*x = ComponentHealth{}
-	mi := &file_common_proto_msgTypes[27]
+	mi := &file_common_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2128,7 +2565,7 @@ func (x *ComponentHealth) String() string {
 func (*ComponentHealth) ProtoMessage() {}
 
 func (x *ComponentHealth) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[27]
+	mi := &file_common_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2141,7 +2578,7 @@ func (x *ComponentHealth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComponentHealth.ProtoReflect.Descriptor instead.
 func (*ComponentHealth) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{27}
+	return file_common_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ComponentHealth) GetStatus() string {
@@ -2179,7 +2616,7 @@ type FeatureFlag struct {
 
 func (x *FeatureFlag) Reset() {
 	*x = FeatureFlag{}
-	mi := &file_common_proto_msgTypes[28]
+	mi := &file_common_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2191,7 +2628,7 @@ func (x *FeatureFlag) String() string {
 func (*FeatureFlag) ProtoMessage() {}
 
 func (x *FeatureFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[28]
+	mi := &file_common_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2204,7 +2641,7 @@ func (x *FeatureFlag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeatureFlag.ProtoReflect.Descriptor instead.
 func (*FeatureFlag) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{28}
+	return file_common_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *FeatureFlag) GetName() string {
@@ -2255,7 +2692,7 @@ type ApiVersion struct {
 
 func (x *ApiVersion) Reset() {
 	*x = ApiVersion{}
-	mi := &file_common_proto_msgTypes[29]
+	mi := &file_common_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2267,7 +2704,7 @@ func (x *ApiVersion) String() string {
 func (*ApiVersion) ProtoMessage() {}
 
 func (x *ApiVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[29]
+	mi := &file_common_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2280,7 +2717,7 @@ func (x *ApiVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiVersion.ProtoReflect.Descriptor instead.
 func (*ApiVersion) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{29}
+	return file_common_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ApiVersion) GetVersion() string {
@@ -2324,7 +2761,7 @@ type RateLimitInfo struct {
 
 func (x *RateLimitInfo) Reset() {
 	*x = RateLimitInfo{}
-	mi := &file_common_proto_msgTypes[30]
+	mi := &file_common_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2336,7 +2773,7 @@ func (x *RateLimitInfo) String() string {
 func (*RateLimitInfo) ProtoMessage() {}
 
 func (x *RateLimitInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[30]
+	mi := &file_common_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2349,7 +2786,7 @@ func (x *RateLimitInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateLimitInfo.ProtoReflect.Descriptor instead.
 func (*RateLimitInfo) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{30}
+	return file_common_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *RateLimitInfo) GetRequestsRemaining() int32 {
@@ -2390,7 +2827,7 @@ type BaseRequest struct {
 
 func (x *BaseRequest) Reset() {
 	*x = BaseRequest{}
-	mi := &file_common_proto_msgTypes[31]
+	mi := &file_common_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2402,7 +2839,7 @@ func (x *BaseRequest) String() string {
 func (*BaseRequest) ProtoMessage() {}
 
 func (x *BaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[31]
+	mi := &file_common_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2415,7 +2852,7 @@ func (x *BaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseRequest.ProtoReflect.Descriptor instead.
 func (*BaseRequest) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{31}
+	return file_common_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *BaseRequest) GetDownstream() string {
@@ -2443,7 +2880,7 @@ type BaseResponse struct {
 
 func (x *BaseResponse) Reset() {
 	*x = BaseResponse{}
-	mi := &file_common_proto_msgTypes[32]
+	mi := &file_common_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2455,7 +2892,7 @@ func (x *BaseResponse) String() string {
 func (*BaseResponse) ProtoMessage() {}
 
 func (x *BaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[32]
+	mi := &file_common_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2468,7 +2905,7 @@ func (x *BaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseResponse.ProtoReflect.Descriptor instead.
 func (*BaseResponse) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{32}
+	return file_common_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *BaseResponse) GetUpstream() string {
@@ -2496,7 +2933,7 @@ var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
 	"\n" +
-	"\fcommon.proto\x12\x10ai_poi.common.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x01\n" +
+	"\fcommon.proto\x12\x10ai_poi.common.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xf7\x01\n" +
 	"\bResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
@@ -2518,12 +2955,13 @@ const file_common_proto_rawDesc = "" +
 	"FieldError\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\tR\x04code\"r\n" +
-	"\x11PaginationRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xb0\x02\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"\xb8\x01\n" +
+	"\x11PaginationRequest\x12\x1d\n" +
+	"\x04page\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04(\x01@\x01R\x04page\x12(\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\v\xfaB\b\x1a\x06\x18d(\x01@\x01R\bpageSize\x12\x1f\n" +
+	"\x06offset\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06offset\x12!\n" +
+	"\x05limit\x18\x04 \x01(\x05B\v\xfaB\b\x1a\x06\x18d(\x01@\x01R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\"\xb0\x02\n" +
 	"\x12PaginationResponse\x12!\n" +
 	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
@@ -2535,23 +2973,24 @@ const file_common_proto_rawDesc = "" +
 	"\x11has_previous_page\x18\x06 \x01(\bR\x0fhasPreviousPage\x12\x1f\n" +
 	"\vnext_cursor\x18\a \x01(\tR\n" +
 	"nextCursor\x12'\n" +
-	"\x0fprevious_cursor\x18\b \x01(\tR\x0epreviousCursor\"\x7f\n" +
-	"\vCoordinates\x12\x1a\n" +
-	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1a\n" +
-	"\baltitude\x18\x03 \x01(\x01R\baltitude\x12\x1a\n" +
-	"\baccuracy\x18\x04 \x01(\x01R\baccuracy\"\x85\x01\n" +
+	"\x0fprevious_cursor\x18\b \x01(\tR\x0epreviousCursor\"\xc1\x01\n" +
+	"\vCoordinates\x123\n" +
+	"\blatitude\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x125\n" +
+	"\tlongitude\x18\x02 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x12\x1a\n" +
+	"\baltitude\x18\x03 \x01(\x01R\baltitude\x12*\n" +
+	"\baccuracy\x18\x04 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\baccuracy\"\x85\x01\n" +
 	"\tGeoBounds\x12;\n" +
 	"\tsouthwest\x18\x01 \x01(\v2\x1d.ai_poi.common.v1.CoordinatesR\tsouthwest\x12;\n" +
-	"\tnortheast\x18\x02 \x01(\v2\x1d.ai_poi.common.v1.CoordinatesR\tnortheast\"\xd6\x01\n" +
+	"\tnortheast\x18\x02 \x01(\v2\x1d.ai_poi.common.v1.CoordinatesR\tnortheast\"\xec\x01\n" +
 	"\aAddress\x12\x16\n" +
 	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
 	"\x04city\x18\x02 \x01(\tR\x04city\x12\x14\n" +
 	"\x05state\x18\x03 \x01(\tR\x05state\x12\x1f\n" +
 	"\vpostal_code\x18\x04 \x01(\tR\n" +
 	"postalCode\x12\x18\n" +
-	"\acountry\x18\x05 \x01(\tR\acountry\x12!\n" +
-	"\fcountry_code\x18\x06 \x01(\tR\vcountryCode\x12+\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x127\n" +
+	"\fcountry_code\x18\x06 \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{2}$\xd0\x01\x01R\vcountryCode\x12+\n" +
 	"\x11formatted_address\x18\a \x01(\tR\x10formattedAddress\"\x95\x01\n" +
 	"\vContactInfo\x12\x14\n" +
 	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x14\n" +
@@ -2572,20 +3011,25 @@ const file_common_proto_rawDesc = "" +
 	"\x03day\x18\x01 \x01(\x0e2\x1b.ai_poi.common.v1.DayOfWeekR\x03day\x129\n" +
 	"\n" +
 	"time_slots\x18\x02 \x03(\v2\x1a.ai_poi.common.v1.TimeSlotR\ttimeSlots\x12\x1b\n" +
-	"\tis_closed\x18\x03 \x01(\bR\bisClosed\"F\n" +
-	"\bTimeSlot\x12\x1b\n" +
-	"\topen_time\x18\x01 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\tis_closed\x18\x03 \x01(\bR\bisClosed\"\xa6\x01\n" +
+	"\bTimeSlot\x12K\n" +
+	"\topen_time\x18\x01 \x01(\tB.\xfaB+r)2'^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$R\bopenTime\x12M\n" +
 	"\n" +
-	"close_time\x18\x02 \x01(\tR\tcloseTime\"\xb8\x01\n" +
+	"close_time\x18\x02 \x01(\tB.\xfaB+r)2'^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$R\tcloseTime\"\xb8\x01\n" +
 	"\fSpecialHours\x12.\n" +
 	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x129\n" +
 	"\n" +
 	"time_slots\x18\x03 \x03(\v2\x1a.ai_poi.common.v1.TimeSlotR\ttimeSlots\x12\x1b\n" +
-	"\tis_closed\x18\x04 \x01(\bR\bisClosed\"y\n" +
-	"\x06Rating\x12\x18\n" +
-	"\aaverage\x18\x01 \x01(\x01R\aaverage\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\x12?\n" +
+	"\tis_closed\x18\x04 \x01(\bR\bisClosed\"\x83\x01\n" +
+	"\x05Money\x126\n" +
+	"\rcurrency_code\x18\x01 \x01(\tB\x11\xfaB\x0er\f2\n" +
+	"^[A-Z]{3}$R\fcurrencyCode\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12,\n" +
+	"\x05nanos\x18\x03 \x01(\x05B\x16\xfaB\x13\x1a\x11\x18\xff\x93\xeb\xdc\x03(\x81씣\xfc\xff\xff\xff\xff\x01R\x05nanos\"\x9b\x01\n" +
+	"\x06Rating\x121\n" +
+	"\aaverage\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\x00\x00R\aaverage\x12\x1d\n" +
+	"\x05count\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05count\x12?\n" +
 	"\tbreakdown\x18\x03 \x01(\v2!.ai_poi.common.v1.RatingBreakdownR\tbreakdown\"\xa0\x01\n" +
 	"\x0fRatingBreakdown\x12\x1b\n" +
 	"\tfive_star\x18\x01 \x01(\x05R\bfiveStar\x12\x1b\n" +
@@ -2621,30 +3065,81 @@ const file_common_proto_rawDesc = "" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
 	"\bprovider\x18\x04 \x01(\tR\bprovider\x12*\n" +
-	"\x11preview_image_url\x18\x05 \x01(\tR\x0fpreviewImageUrl\"b\n" +
+	"\x11preview_image_url\x18\x05 \x01(\tR\x0fpreviewImageUrl\"\xb5\n" +
+	"\n" +
+	"\x03POI\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\blocation\x18\x03 \x01(\v2\x1d.ai_poi.common.v1.CoordinatesR\blocation\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12 \n" +
+	"\vsubcategory\x18\x05 \x01(\tR\vsubcategory\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x120\n" +
+	"\x06rating\x18\a \x01(\v2\x18.ai_poi.common.v1.RatingR\x06rating\x12=\n" +
+	"\vprice_range\x18\b \x01(\x0e2\x1c.ai_poi.common.v1.PriceRangeR\n" +
+	"priceRange\x12\x1f\n" +
+	"\vprice_label\x18\t \x01(\tR\n" +
+	"priceLabel\x12\x1f\n" +
+	"\vprice_level\x18\n" +
+	" \x01(\tR\n" +
+	"priceLevel\x123\n" +
+	"\aaddress\x18\v \x01(\v2\x19.ai_poi.common.v1.AddressR\aaddress\x127\n" +
+	"\acontact\x18\f \x01(\v2\x1d.ai_poi.common.v1.ContactInfoR\acontact\x12!\n" +
+	"\fphone_number\x18\r \x01(\tR\vphoneNumber\x12C\n" +
+	"\ropening_hours\x18\x0e \x01(\v2\x1e.ai_poi.common.v1.OpeningHoursR\fopeningHours\x12,\n" +
+	"\x12opening_hours_text\x18\x0f \x03(\tR\x10openingHoursText\x12-\n" +
+	"\x05media\x18\x10 \x01(\v2\x17.ai_poi.common.v1.MediaR\x05media\x12\x1c\n" +
+	"\tamenities\x18\x11 \x03(\tR\tamenities\x12\x1a\n" +
+	"\bdistance\x18\x12 \x01(\tR\bdistance\x12\x17\n" +
+	"\acity_id\x18\x13 \x01(\tR\x06cityId\x12\x1f\n" +
+	"\vis_verified\x18\x14 \x01(\bR\n" +
+	"isVerified\x12,\n" +
+	"\x04tags\x18\x15 \x03(\v2\x18.ai_poi.common.v1.POITagR\x04tags\x12\x16\n" +
+	"\x06source\x18\x16 \x01(\tR\x06source\x12?\n" +
+	"\bmetadata\x18\x17 \x03(\v2#.ai_poi.common.v1.POI.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x127\n" +
+	"\x0fdistance_meters\x18\x1a \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x0edistanceMeters\x12I\n" +
+	"\x0elocalized_name\x18\x1b \x01(\v2\".ai_poi.common.v1.MultilingualTextR\rlocalizedName\x12W\n" +
+	"\x15localized_description\x18\x1c \x01(\v2\".ai_poi.common.v1.MultilingualTextR\x14localizedDescription\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x90\x02\n" +
+	"\x06POITag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\btag_type\x18\x04 \x01(\tR\atagType\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"b\n" +
 	"\vSortOptions\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12=\n" +
-	"\tdirection\x18\x02 \x01(\x0e2\x1f.ai_poi.common.v1.SortDirectionR\tdirection\"\x95\x03\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1f.ai_poi.common.v1.SortDirectionR\tdirection\"\xbe\x03\n" +
 	"\rFilterOptions\x12\x1e\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\tR\n" +
 	"categories\x12?\n" +
-	"\fprice_ranges\x18\x02 \x03(\x0e2\x1c.ai_poi.common.v1.PriceRangeR\vpriceRanges\x12\x1d\n" +
+	"\fprice_ranges\x18\x02 \x03(\x0e2\x1c.ai_poi.common.v1.PriceRangeR\vpriceRanges\x126\n" +
 	"\n" +
-	"min_rating\x18\x03 \x01(\x01R\tminRating\x12.\n" +
-	"\x13max_distance_meters\x18\x04 \x01(\x01R\x11maxDistanceMeters\x12\x19\n" +
+	"min_rating\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\x00\x00R\tminRating\x12>\n" +
+	"\x13max_distance_meters\x18\x04 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x11maxDistanceMeters\x12\x19\n" +
 	"\bopen_now\x18\x05 \x01(\bR\aopenNow\x12\x1c\n" +
 	"\tamenities\x18\x06 \x03(\tR\tamenities\x12Y\n" +
 	"\x0ecustom_filters\x18\a \x03(\v22.ai_poi.common.v1.FilterOptions.CustomFiltersEntryR\rcustomFilters\x1a@\n" +
 	"\x12CustomFiltersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
-	"\x0fLocalizedString\x12#\n" +
-	"\rlanguage_code\x18\x01 \x01(\tR\flanguageCode\x12\x12\n" +
-	"\x04text\x18\x02 \x01(\tR\x04text\"\x84\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"s\n" +
+	"\x0fLocalizedString\x12L\n" +
+	"\rlanguage_code\x18\x01 \x01(\tB'\xfaB$r\"2 ^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$R\flanguageCode\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\xb0\x01\n" +
 	"\x10MultilingualText\x12E\n" +
-	"\ftranslations\x18\x01 \x03(\v2!.ai_poi.common.v1.LocalizedStringR\ftranslations\x12)\n" +
-	"\x10default_language\x18\x02 \x01(\tR\x0fdefaultLanguage\"\xd9\x01\n" +
+	"\ftranslations\x18\x01 \x03(\v2!.ai_poi.common.v1.LocalizedStringR\ftranslations\x12U\n" +
+	"\x10default_language\x18\x02 \x01(\tB*\xfaB'r%2 ^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\x0fdefaultLanguage\"\xd9\x01\n" +
 	"\tAuditInfo\x129\n" +
 	"\n" +
 	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
@@ -2748,7 +3243,7 @@ func file_common_proto_rawDescGZIP() []byte {
 }
 
 var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_common_proto_goTypes = []any{
 	(DayOfWeek)(0),                // 0: ai_poi.common.v1.DayOfWeek
 	(PriceRange)(0),               // 1: ai_poi.common.v1.PriceRange
@@ -2767,38 +3262,42 @@ var file_common_proto_goTypes = []any{
 	(*DaySchedule)(nil),           // 14: ai_poi.common.v1.DaySchedule
 	(*TimeSlot)(nil),              // 15: ai_poi.common.v1.TimeSlot
 	(*SpecialHours)(nil),          // 16: ai_poi.common.v1.SpecialHours
-	(*Rating)(nil),                // 17: ai_poi.common.v1.Rating
-	(*RatingBreakdown)(nil),       // 18: ai_poi.common.v1.RatingBreakdown
-	(*Media)(nil),                 // 19: ai_poi.common.v1.Media
-	(*Photo)(nil),                 // 20: ai_poi.common.v1.Photo
-	(*Video)(nil),                 // 21: ai_poi.common.v1.Video
-	(*VirtualTour)(nil),           // 22: ai_poi.common.v1.VirtualTour
-	(*SortOptions)(nil),           // 23: ai_poi.common.v1.SortOptions
-	(*FilterOptions)(nil),         // 24: ai_poi.common.v1.FilterOptions
-	(*LocalizedString)(nil),       // 25: ai_poi.common.v1.LocalizedString
-	(*MultilingualText)(nil),      // 26: ai_poi.common.v1.MultilingualText
-	(*AuditInfo)(nil),             // 27: ai_poi.common.v1.AuditInfo
-	(*HealthCheckRequest)(nil),    // 28: ai_poi.common.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),   // 29: ai_poi.common.v1.HealthCheckResponse
-	(*ComponentHealth)(nil),       // 30: ai_poi.common.v1.ComponentHealth
-	(*FeatureFlag)(nil),           // 31: ai_poi.common.v1.FeatureFlag
-	(*ApiVersion)(nil),            // 32: ai_poi.common.v1.ApiVersion
-	(*RateLimitInfo)(nil),         // 33: ai_poi.common.v1.RateLimitInfo
-	(*BaseRequest)(nil),           // 34: ai_poi.common.v1.BaseRequest
-	(*BaseResponse)(nil),          // 35: ai_poi.common.v1.BaseResponse
-	nil,                           // 36: ai_poi.common.v1.Response.MetadataEntry
-	nil,                           // 37: ai_poi.common.v1.ErrorDetails.DetailsEntry
-	nil,                           // 38: ai_poi.common.v1.FilterOptions.CustomFiltersEntry
-	nil,                           // 39: ai_poi.common.v1.HealthCheckResponse.ComponentsEntry
-	nil,                           // 40: ai_poi.common.v1.ComponentHealth.DetailsEntry
-	nil,                           // 41: ai_poi.common.v1.FeatureFlag.ParametersEntry
-	(*timestamppb.Timestamp)(nil), // 42: google.protobuf.Timestamp
+	(*Money)(nil),                 // 17: ai_poi.common.v1.Money
+	(*Rating)(nil),                // 18: ai_poi.common.v1.Rating
+	(*RatingBreakdown)(nil),       // 19: ai_poi.common.v1.RatingBreakdown
+	(*Media)(nil),                 // 20: ai_poi.common.v1.Media
+	(*Photo)(nil),                 // 21: ai_poi.common.v1.Photo
+	(*Video)(nil),                 // 22: ai_poi.common.v1.Video
+	(*VirtualTour)(nil),           // 23: ai_poi.common.v1.VirtualTour
+	(*POI)(nil),                   // 24: ai_poi.common.v1.POI
+	(*POITag)(nil),                // 25: ai_poi.common.v1.POITag
+	(*SortOptions)(nil),           // 26: ai_poi.common.v1.SortOptions
+	(*FilterOptions)(nil),         // 27: ai_poi.common.v1.FilterOptions
+	(*LocalizedString)(nil),       // 28: ai_poi.common.v1.LocalizedString
+	(*MultilingualText)(nil),      // 29: ai_poi.common.v1.MultilingualText
+	(*AuditInfo)(nil),             // 30: ai_poi.common.v1.AuditInfo
+	(*HealthCheckRequest)(nil),    // 31: ai_poi.common.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),   // 32: ai_poi.common.v1.HealthCheckResponse
+	(*ComponentHealth)(nil),       // 33: ai_poi.common.v1.ComponentHealth
+	(*FeatureFlag)(nil),           // 34: ai_poi.common.v1.FeatureFlag
+	(*ApiVersion)(nil),            // 35: ai_poi.common.v1.ApiVersion
+	(*RateLimitInfo)(nil),         // 36: ai_poi.common.v1.RateLimitInfo
+	(*BaseRequest)(nil),           // 37: ai_poi.common.v1.BaseRequest
+	(*BaseResponse)(nil),          // 38: ai_poi.common.v1.BaseResponse
+	nil,                           // 39: ai_poi.common.v1.Response.MetadataEntry
+	nil,                           // 40: ai_poi.common.v1.ErrorDetails.DetailsEntry
+	nil,                           // 41: ai_poi.common.v1.POI.MetadataEntry
+	nil,                           // 42: ai_poi.common.v1.FilterOptions.CustomFiltersEntry
+	nil,                           // 43: ai_poi.common.v1.HealthCheckResponse.ComponentsEntry
+	nil,                           // 44: ai_poi.common.v1.ComponentHealth.DetailsEntry
+	nil,                           // 45: ai_poi.common.v1.FeatureFlag.ParametersEntry
+	(*timestamppb.Timestamp)(nil), // 46: google.protobuf.Timestamp
 }
 var file_common_proto_depIdxs = []int32{
 	4,  // 0: ai_poi.common.v1.Response.error:type_name -> ai_poi.common.v1.ErrorDetails
-	36, // 1: ai_poi.common.v1.Response.metadata:type_name -> ai_poi.common.v1.Response.MetadataEntry
+	39, // 1: ai_poi.common.v1.Response.metadata:type_name -> ai_poi.common.v1.Response.MetadataEntry
 	5,  // 2: ai_poi.common.v1.ErrorDetails.field_errors:type_name -> ai_poi.common.v1.FieldError
-	37, // 3: ai_poi.common.v1.ErrorDetails.details:type_name -> ai_poi.common.v1.ErrorDetails.DetailsEntry
+	40, // 3: ai_poi.common.v1.ErrorDetails.details:type_name -> ai_poi.common.v1.ErrorDetails.DetailsEntry
 	8,  // 4: ai_poi.common.v1.GeoBounds.southwest:type_name -> ai_poi.common.v1.Coordinates
 	8,  // 5: ai_poi.common.v1.GeoBounds.northeast:type_name -> ai_poi.common.v1.Coordinates
 	12, // 6: ai_poi.common.v1.ContactInfo.social_media:type_name -> ai_poi.common.v1.SocialMedia
@@ -2806,33 +3305,48 @@ var file_common_proto_depIdxs = []int32{
 	16, // 8: ai_poi.common.v1.OpeningHours.special_hours:type_name -> ai_poi.common.v1.SpecialHours
 	0,  // 9: ai_poi.common.v1.DaySchedule.day:type_name -> ai_poi.common.v1.DayOfWeek
 	15, // 10: ai_poi.common.v1.DaySchedule.time_slots:type_name -> ai_poi.common.v1.TimeSlot
-	42, // 11: ai_poi.common.v1.SpecialHours.date:type_name -> google.protobuf.Timestamp
+	46, // 11: ai_poi.common.v1.SpecialHours.date:type_name -> google.protobuf.Timestamp
 	15, // 12: ai_poi.common.v1.SpecialHours.time_slots:type_name -> ai_poi.common.v1.TimeSlot
-	18, // 13: ai_poi.common.v1.Rating.breakdown:type_name -> ai_poi.common.v1.RatingBreakdown
-	20, // 14: ai_poi.common.v1.Media.photos:type_name -> ai_poi.common.v1.Photo
-	21, // 15: ai_poi.common.v1.Media.videos:type_name -> ai_poi.common.v1.Video
-	22, // 16: ai_poi.common.v1.Media.virtual_tours:type_name -> ai_poi.common.v1.VirtualTour
-	42, // 17: ai_poi.common.v1.Photo.taken_at:type_name -> google.protobuf.Timestamp
-	2,  // 18: ai_poi.common.v1.SortOptions.direction:type_name -> ai_poi.common.v1.SortDirection
-	1,  // 19: ai_poi.common.v1.FilterOptions.price_ranges:type_name -> ai_poi.common.v1.PriceRange
-	38, // 20: ai_poi.common.v1.FilterOptions.custom_filters:type_name -> ai_poi.common.v1.FilterOptions.CustomFiltersEntry
-	25, // 21: ai_poi.common.v1.MultilingualText.translations:type_name -> ai_poi.common.v1.LocalizedString
-	42, // 22: ai_poi.common.v1.AuditInfo.created_at:type_name -> google.protobuf.Timestamp
-	42, // 23: ai_poi.common.v1.AuditInfo.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 24: ai_poi.common.v1.HealthCheckRequest.request:type_name -> ai_poi.common.v1.BaseRequest
-	42, // 25: ai_poi.common.v1.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
-	39, // 26: ai_poi.common.v1.HealthCheckResponse.components:type_name -> ai_poi.common.v1.HealthCheckResponse.ComponentsEntry
-	35, // 27: ai_poi.common.v1.HealthCheckResponse.response:type_name -> ai_poi.common.v1.BaseResponse
-	40, // 28: ai_poi.common.v1.ComponentHealth.details:type_name -> ai_poi.common.v1.ComponentHealth.DetailsEntry
-	41, // 29: ai_poi.common.v1.FeatureFlag.parameters:type_name -> ai_poi.common.v1.FeatureFlag.ParametersEntry
-	42, // 30: ai_poi.common.v1.ApiVersion.sunset_date:type_name -> google.protobuf.Timestamp
-	42, // 31: ai_poi.common.v1.RateLimitInfo.reset_time:type_name -> google.protobuf.Timestamp
-	30, // 32: ai_poi.common.v1.HealthCheckResponse.ComponentsEntry.value:type_name -> ai_poi.common.v1.ComponentHealth
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	19, // 13: ai_poi.common.v1.Rating.breakdown:type_name -> ai_poi.common.v1.RatingBreakdown
+	21, // 14: ai_poi.common.v1.Media.photos:type_name -> ai_poi.common.v1.Photo
+	22, // 15: ai_poi.common.v1.Media.videos:type_name -> ai_poi.common.v1.Video
+	23, // 16: ai_poi.common.v1.Media.virtual_tours:type_name -> ai_poi.common.v1.VirtualTour
+	46, // 17: ai_poi.common.v1.Photo.taken_at:type_name -> google.protobuf.Timestamp
+	8,  // 18: ai_poi.common.v1.POI.location:type_name -> ai_poi.common.v1.Coordinates
+	18, // 19: ai_poi.common.v1.POI.rating:type_name -> ai_poi.common.v1.Rating
+	1,  // 20: ai_poi.common.v1.POI.price_range:type_name -> ai_poi.common.v1.PriceRange
+	10, // 21: ai_poi.common.v1.POI.address:type_name -> ai_poi.common.v1.Address
+	11, // 22: ai_poi.common.v1.POI.contact:type_name -> ai_poi.common.v1.ContactInfo
+	13, // 23: ai_poi.common.v1.POI.opening_hours:type_name -> ai_poi.common.v1.OpeningHours
+	20, // 24: ai_poi.common.v1.POI.media:type_name -> ai_poi.common.v1.Media
+	25, // 25: ai_poi.common.v1.POI.tags:type_name -> ai_poi.common.v1.POITag
+	41, // 26: ai_poi.common.v1.POI.metadata:type_name -> ai_poi.common.v1.POI.MetadataEntry
+	46, // 27: ai_poi.common.v1.POI.created_at:type_name -> google.protobuf.Timestamp
+	46, // 28: ai_poi.common.v1.POI.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 29: ai_poi.common.v1.POI.localized_name:type_name -> ai_poi.common.v1.MultilingualText
+	29, // 30: ai_poi.common.v1.POI.localized_description:type_name -> ai_poi.common.v1.MultilingualText
+	46, // 31: ai_poi.common.v1.POITag.created_at:type_name -> google.protobuf.Timestamp
+	46, // 32: ai_poi.common.v1.POITag.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 33: ai_poi.common.v1.SortOptions.direction:type_name -> ai_poi.common.v1.SortDirection
+	1,  // 34: ai_poi.common.v1.FilterOptions.price_ranges:type_name -> ai_poi.common.v1.PriceRange
+	42, // 35: ai_poi.common.v1.FilterOptions.custom_filters:type_name -> ai_poi.common.v1.FilterOptions.CustomFiltersEntry
+	28, // 36: ai_poi.common.v1.MultilingualText.translations:type_name -> ai_poi.common.v1.LocalizedString
+	46, // 37: ai_poi.common.v1.AuditInfo.created_at:type_name -> google.protobuf.Timestamp
+	46, // 38: ai_poi.common.v1.AuditInfo.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 39: ai_poi.common.v1.HealthCheckRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	46, // 40: ai_poi.common.v1.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	43, // 41: ai_poi.common.v1.HealthCheckResponse.components:type_name -> ai_poi.common.v1.HealthCheckResponse.ComponentsEntry
+	38, // 42: ai_poi.common.v1.HealthCheckResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	44, // 43: ai_poi.common.v1.ComponentHealth.details:type_name -> ai_poi.common.v1.ComponentHealth.DetailsEntry
+	45, // 44: ai_poi.common.v1.FeatureFlag.parameters:type_name -> ai_poi.common.v1.FeatureFlag.ParametersEntry
+	46, // 45: ai_poi.common.v1.ApiVersion.sunset_date:type_name -> google.protobuf.Timestamp
+	46, // 46: ai_poi.common.v1.RateLimitInfo.reset_time:type_name -> google.protobuf.Timestamp
+	33, // 47: ai_poi.common.v1.HealthCheckResponse.ComponentsEntry.value:type_name -> ai_poi.common.v1.ComponentHealth
+	48, // [48:48] is the sub-list for method output_type
+	48, // [48:48] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -2846,7 +3360,7 @@ func file_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   39,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,5224 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: common.proto
+
+package generated
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Response with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Response with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResponseMultiError, or nil
+// if none found.
+func (m *Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResponseValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResponseValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResponseValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Metadata
+
+	if len(errors) > 0 {
+		return ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResponseMultiError is an error wrapping multiple validation errors returned
+// by Response.ValidateAll() if the designated constraints aren't met.
+type ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResponseMultiError) AllErrors() []error { return m }
+
+// ResponseValidationError is the validation error returned by
+// Response.Validate if the designated constraints aren't met.
+type ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResponseValidationError) ErrorName() string { return "ResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResponseValidationError{}
+
+// Validate checks the field values on ErrorDetails with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorDetails) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErrorDetails with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorDetailsMultiError, or
+// nil if none found.
+func (m *ErrorDetails) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErrorDetails) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetFieldErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ErrorDetailsValidationError{
+						field:  fmt.Sprintf("FieldErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ErrorDetailsValidationError{
+						field:  fmt.Sprintf("FieldErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ErrorDetailsValidationError{
+					field:  fmt.Sprintf("FieldErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TraceId
+
+	// no validation rules for Details
+
+	if len(errors) > 0 {
+		return ErrorDetailsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErrorDetailsMultiError is an error wrapping multiple validation errors
+// returned by ErrorDetails.ValidateAll() if the designated constraints aren't met.
+type ErrorDetailsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorDetailsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErrorDetailsMultiError) AllErrors() []error { return m }
+
+// ErrorDetailsValidationError is the validation error returned by
+// ErrorDetails.Validate if the designated constraints aren't met.
+type ErrorDetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErrorDetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErrorDetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErrorDetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErrorDetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErrorDetailsValidationError) ErrorName() string { return "ErrorDetailsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErrorDetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErrorDetails.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErrorDetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorDetailsValidationError{}
+
+// Validate checks the field values on FieldError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FieldError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldErrorMultiError, or
+// nil if none found.
+func (m *FieldError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FieldError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Message
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return FieldErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// FieldErrorMultiError is an error wrapping multiple validation errors
+// returned by FieldError.ValidateAll() if the designated constraints aren't met.
+type FieldErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FieldErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FieldErrorMultiError) AllErrors() []error { return m }
+
+// FieldErrorValidationError is the validation error returned by
+// FieldError.Validate if the designated constraints aren't met.
+type FieldErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldErrorValidationError) ErrorName() string { return "FieldErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldErrorValidationError{}
+
+// Validate checks the field values on PaginationRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PaginationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaginationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PaginationRequestMultiError, or nil if none found.
+func (m *PaginationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaginationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() != 0 {
+
+		if m.GetPage() < 1 {
+			err := PaginationRequestValidationError{
+				field:  "Page",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := PaginationRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOffset() < 0 {
+		err := PaginationRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() != 0 {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := PaginationRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return PaginationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaginationRequestMultiError is an error wrapping multiple validation errors
+// returned by PaginationRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PaginationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaginationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaginationRequestMultiError) AllErrors() []error { return m }
+
+// PaginationRequestValidationError is the validation error returned by
+// PaginationRequest.Validate if the designated constraints aren't met.
+type PaginationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaginationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaginationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaginationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaginationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaginationRequestValidationError) ErrorName() string {
+	return "PaginationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PaginationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaginationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaginationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaginationRequestValidationError{}
+
+// Validate checks the field values on PaginationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PaginationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaginationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PaginationResponseMultiError, or nil if none found.
+func (m *PaginationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaginationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CurrentPage
+
+	// no validation rules for PageSize
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	// no validation rules for HasNextPage
+
+	// no validation rules for HasPreviousPage
+
+	// no validation rules for NextCursor
+
+	// no validation rules for PreviousCursor
+
+	if len(errors) > 0 {
+		return PaginationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaginationResponseMultiError is an error wrapping multiple validation errors
+// returned by PaginationResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PaginationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaginationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaginationResponseMultiError) AllErrors() []error { return m }
+
+// PaginationResponseValidationError is the validation error returned by
+// PaginationResponse.Validate if the designated constraints aren't met.
+type PaginationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaginationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaginationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaginationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaginationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaginationResponseValidationError) ErrorName() string {
+	return "PaginationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PaginationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaginationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaginationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaginationResponseValidationError{}
+
+// Validate checks the field values on Coordinates with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Coordinates) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Coordinates with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CoordinatesMultiError, or
+// nil if none found.
+func (m *Coordinates) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Coordinates) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		err := CoordinatesValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		err := CoordinatesValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Altitude
+
+	if m.GetAccuracy() < 0 {
+		err := CoordinatesValidationError{
+			field:  "Accuracy",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CoordinatesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CoordinatesMultiError is an error wrapping multiple validation errors
+// returned by Coordinates.ValidateAll() if the designated constraints aren't met.
+type CoordinatesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CoordinatesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CoordinatesMultiError) AllErrors() []error { return m }
+
+// CoordinatesValidationError is the validation error returned by
+// Coordinates.Validate if the designated constraints aren't met.
+type CoordinatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CoordinatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CoordinatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CoordinatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CoordinatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CoordinatesValidationError) ErrorName() string { return "CoordinatesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CoordinatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCoordinates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CoordinatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CoordinatesValidationError{}
+
+// Validate checks the field values on GeoBounds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GeoBounds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GeoBounds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GeoBoundsMultiError, or nil
+// if none found.
+func (m *GeoBounds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GeoBounds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSouthwest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GeoBoundsValidationError{
+					field:  "Southwest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GeoBoundsValidationError{
+					field:  "Southwest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSouthwest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GeoBoundsValidationError{
+				field:  "Southwest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNortheast()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GeoBoundsValidationError{
+					field:  "Northeast",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GeoBoundsValidationError{
+					field:  "Northeast",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNortheast()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GeoBoundsValidationError{
+				field:  "Northeast",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GeoBoundsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GeoBoundsMultiError is an error wrapping multiple validation errors returned
+// by GeoBounds.ValidateAll() if the designated constraints aren't met.
+type GeoBoundsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GeoBoundsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GeoBoundsMultiError) AllErrors() []error { return m }
+
+// GeoBoundsValidationError is the validation error returned by
+// GeoBounds.Validate if the designated constraints aren't met.
+type GeoBoundsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GeoBoundsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GeoBoundsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GeoBoundsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GeoBoundsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GeoBoundsValidationError) ErrorName() string { return "GeoBoundsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GeoBoundsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGeoBounds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GeoBoundsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GeoBoundsValidationError{}
+
+// Validate checks the field values on Address with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Address) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Address with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AddressMultiError, or nil if none found.
+func (m *Address) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Address) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Street
+
+	// no validation rules for City
+
+	// no validation rules for State
+
+	// no validation rules for PostalCode
+
+	// no validation rules for Country
+
+	if m.GetCountryCode() != "" {
+
+		if !_Address_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := AddressValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^[A-Z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for FormattedAddress
+
+	if len(errors) > 0 {
+		return AddressMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressMultiError is an error wrapping multiple validation errors returned
+// by Address.ValidateAll() if the designated constraints aren't met.
+type AddressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressMultiError) AllErrors() []error { return m }
+
+// AddressValidationError is the validation error returned by Address.Validate
+// if the designated constraints aren't met.
+type AddressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressValidationError) ErrorName() string { return "AddressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressValidationError{}
+
+var _Address_CountryCode_Pattern = regexp.MustCompile("^[A-Z]{2}$")
+
+// Validate checks the field values on ContactInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ContactInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContactInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContactInfoMultiError, or
+// nil if none found.
+func (m *ContactInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContactInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Phone
+
+	// no validation rules for Email
+
+	// no validation rules for Website
+
+	for idx, item := range m.GetSocialMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ContactInfoValidationError{
+						field:  fmt.Sprintf("SocialMedia[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ContactInfoValidationError{
+						field:  fmt.Sprintf("SocialMedia[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ContactInfoValidationError{
+					field:  fmt.Sprintf("SocialMedia[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ContactInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContactInfoMultiError is an error wrapping multiple validation errors
+// returned by ContactInfo.ValidateAll() if the designated constraints aren't met.
+type ContactInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContactInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContactInfoMultiError) AllErrors() []error { return m }
+
+// ContactInfoValidationError is the validation error returned by
+// ContactInfo.Validate if the designated constraints aren't met.
+type ContactInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContactInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContactInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContactInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContactInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContactInfoValidationError) ErrorName() string { return "ContactInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContactInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContactInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContactInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContactInfoValidationError{}
+
+// Validate checks the field values on SocialMedia with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SocialMedia) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SocialMedia with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SocialMediaMultiError, or
+// nil if none found.
+func (m *SocialMedia) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SocialMedia) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Platform
+
+	// no validation rules for Url
+
+	// no validation rules for Handle
+
+	if len(errors) > 0 {
+		return SocialMediaMultiError(errors)
+	}
+
+	return nil
+}
+
+// SocialMediaMultiError is an error wrapping multiple validation errors
+// returned by SocialMedia.ValidateAll() if the designated constraints aren't met.
+type SocialMediaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SocialMediaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SocialMediaMultiError) AllErrors() []error { return m }
+
+// SocialMediaValidationError is the validation error returned by
+// SocialMedia.Validate if the designated constraints aren't met.
+type SocialMediaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SocialMediaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SocialMediaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SocialMediaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SocialMediaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SocialMediaValidationError) ErrorName() string { return "SocialMediaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SocialMediaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSocialMedia.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SocialMediaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SocialMediaValidationError{}
+
+// Validate checks the field values on OpeningHours with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OpeningHours) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpeningHours with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OpeningHoursMultiError, or
+// nil if none found.
+func (m *OpeningHours) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpeningHours) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchedule() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpeningHoursValidationError{
+						field:  fmt.Sprintf("Schedule[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpeningHoursValidationError{
+						field:  fmt.Sprintf("Schedule[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpeningHoursValidationError{
+					field:  fmt.Sprintf("Schedule[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Timezone
+
+	for idx, item := range m.GetSpecialHours() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpeningHoursValidationError{
+						field:  fmt.Sprintf("SpecialHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpeningHoursValidationError{
+						field:  fmt.Sprintf("SpecialHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpeningHoursValidationError{
+					field:  fmt.Sprintf("SpecialHours[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Is_24_7
+
+	// no validation rules for IsClosed
+
+	if len(errors) > 0 {
+		return OpeningHoursMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpeningHoursMultiError is an error wrapping multiple validation errors
+// returned by OpeningHours.ValidateAll() if the designated constraints aren't met.
+type OpeningHoursMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpeningHoursMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpeningHoursMultiError) AllErrors() []error { return m }
+
+// OpeningHoursValidationError is the validation error returned by
+// OpeningHours.Validate if the designated constraints aren't met.
+type OpeningHoursValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpeningHoursValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpeningHoursValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpeningHoursValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpeningHoursValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpeningHoursValidationError) ErrorName() string { return "OpeningHoursValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpeningHoursValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpeningHours.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpeningHoursValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpeningHoursValidationError{}
+
+// Validate checks the field values on DaySchedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DaySchedule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DaySchedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DayScheduleMultiError, or
+// nil if none found.
+func (m *DaySchedule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DaySchedule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Day
+
+	for idx, item := range m.GetTimeSlots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DayScheduleValidationError{
+						field:  fmt.Sprintf("TimeSlots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DayScheduleValidationError{
+						field:  fmt.Sprintf("TimeSlots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DayScheduleValidationError{
+					field:  fmt.Sprintf("TimeSlots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsClosed
+
+	if len(errors) > 0 {
+		return DayScheduleMultiError(errors)
+	}
+
+	return nil
+}
+
+// DayScheduleMultiError is an error wrapping multiple validation errors
+// returned by DaySchedule.ValidateAll() if the designated constraints aren't met.
+type DayScheduleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DayScheduleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DayScheduleMultiError) AllErrors() []error { return m }
+
+// DayScheduleValidationError is the validation error returned by
+// DaySchedule.Validate if the designated constraints aren't met.
+type DayScheduleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DayScheduleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DayScheduleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DayScheduleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DayScheduleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DayScheduleValidationError) ErrorName() string { return "DayScheduleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DayScheduleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDaySchedule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DayScheduleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DayScheduleValidationError{}
+
+// Validate checks the field values on TimeSlot with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimeSlot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSlot with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimeSlotMultiError, or nil
+// if none found.
+func (m *TimeSlot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSlot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_TimeSlot_OpenTime_Pattern.MatchString(m.GetOpenTime()) {
+		err := TimeSlotValidationError{
+			field:  "OpenTime",
+			reason: "value does not match regex pattern \"^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TimeSlot_CloseTime_Pattern.MatchString(m.GetCloseTime()) {
+		err := TimeSlotValidationError{
+			field:  "CloseTime",
+			reason: "value does not match regex pattern \"^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TimeSlotMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSlotMultiError is an error wrapping multiple validation errors returned
+// by TimeSlot.ValidateAll() if the designated constraints aren't met.
+type TimeSlotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSlotMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSlotMultiError) AllErrors() []error { return m }
+
+// TimeSlotValidationError is the validation error returned by
+// TimeSlot.Validate if the designated constraints aren't met.
+type TimeSlotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSlotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSlotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSlotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSlotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSlotValidationError) ErrorName() string { return "TimeSlotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeSlotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSlot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSlotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSlotValidationError{}
+
+var _TimeSlot_OpenTime_Pattern = regexp.MustCompile("^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$")
+
+var _TimeSlot_CloseTime_Pattern = regexp.MustCompile("^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$")
+
+// Validate checks the field values on SpecialHours with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SpecialHours) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpecialHours with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SpecialHoursMultiError, or
+// nil if none found.
+func (m *SpecialHours) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpecialHours) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SpecialHoursValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SpecialHoursValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SpecialHoursValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetTimeSlots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SpecialHoursValidationError{
+						field:  fmt.Sprintf("TimeSlots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SpecialHoursValidationError{
+						field:  fmt.Sprintf("TimeSlots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SpecialHoursValidationError{
+					field:  fmt.Sprintf("TimeSlots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsClosed
+
+	if len(errors) > 0 {
+		return SpecialHoursMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpecialHoursMultiError is an error wrapping multiple validation errors
+// returned by SpecialHours.ValidateAll() if the designated constraints aren't met.
+type SpecialHoursMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpecialHoursMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpecialHoursMultiError) AllErrors() []error { return m }
+
+// SpecialHoursValidationError is the validation error returned by
+// SpecialHours.Validate if the designated constraints aren't met.
+type SpecialHoursValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpecialHoursValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpecialHoursValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpecialHoursValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpecialHoursValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpecialHoursValidationError) ErrorName() string { return "SpecialHoursValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SpecialHoursValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpecialHours.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpecialHoursValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpecialHoursValidationError{}
+
+// Validate checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Money) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MoneyMultiError, or nil if none found.
+func (m *Money) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Money) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_Money_CurrencyCode_Pattern.MatchString(m.GetCurrencyCode()) {
+		err := MoneyValidationError{
+			field:  "CurrencyCode",
+			reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Units
+
+	if val := m.GetNanos(); val < -999999999 || val > 999999999 {
+		err := MoneyValidationError{
+			field:  "Nanos",
+			reason: "value must be inside range [-999999999, 999999999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoneyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMultiError is an error wrapping multiple validation errors returned by
+// Money.ValidateAll() if the designated constraints aren't met.
+type MoneyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMultiError) AllErrors() []error { return m }
+
+// MoneyValidationError is the validation error returned by Money.Validate if
+// the designated constraints aren't met.
+type MoneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyValidationError) ErrorName() string { return "MoneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyValidationError{}
+
+var _Money_CurrencyCode_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
+// Validate checks the field values on Rating with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Rating) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Rating with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RatingMultiError, or nil if none found.
+func (m *Rating) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Rating) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetAverage(); val < 0 || val > 5 {
+		err := RatingValidationError{
+			field:  "Average",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() < 0 {
+		err := RatingValidationError{
+			field:  "Count",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBreakdown()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RatingValidationError{
+					field:  "Breakdown",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RatingValidationError{
+					field:  "Breakdown",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBreakdown()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RatingValidationError{
+				field:  "Breakdown",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RatingMultiError(errors)
+	}
+
+	return nil
+}
+
+// RatingMultiError is an error wrapping multiple validation errors returned by
+// Rating.ValidateAll() if the designated constraints aren't met.
+type RatingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RatingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RatingMultiError) AllErrors() []error { return m }
+
+// RatingValidationError is the validation error returned by Rating.Validate if
+// the designated constraints aren't met.
+type RatingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RatingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RatingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RatingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RatingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RatingValidationError) ErrorName() string { return "RatingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RatingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRating.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RatingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RatingValidationError{}
+
+// Validate checks the field values on RatingBreakdown with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RatingBreakdown) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RatingBreakdown with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RatingBreakdownMultiError, or nil if none found.
+func (m *RatingBreakdown) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RatingBreakdown) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FiveStar
+
+	// no validation rules for FourStar
+
+	// no validation rules for ThreeStar
+
+	// no validation rules for TwoStar
+
+	// no validation rules for OneStar
+
+	if len(errors) > 0 {
+		return RatingBreakdownMultiError(errors)
+	}
+
+	return nil
+}
+
+// RatingBreakdownMultiError is an error wrapping multiple validation errors
+// returned by RatingBreakdown.ValidateAll() if the designated constraints
+// aren't met.
+type RatingBreakdownMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RatingBreakdownMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RatingBreakdownMultiError) AllErrors() []error { return m }
+
+// RatingBreakdownValidationError is the validation error returned by
+// RatingBreakdown.Validate if the designated constraints aren't met.
+type RatingBreakdownValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RatingBreakdownValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RatingBreakdownValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RatingBreakdownValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RatingBreakdownValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RatingBreakdownValidationError) ErrorName() string { return "RatingBreakdownValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RatingBreakdownValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRatingBreakdown.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RatingBreakdownValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RatingBreakdownValidationError{}
+
+// Validate checks the field values on Media with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Media) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Media with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MediaMultiError, or nil if none found.
+func (m *Media) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Media) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPhotos() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MediaValidationError{
+						field:  fmt.Sprintf("Photos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MediaValidationError{
+						field:  fmt.Sprintf("Photos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MediaValidationError{
+					field:  fmt.Sprintf("Photos[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetVideos() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MediaValidationError{
+						field:  fmt.Sprintf("Videos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MediaValidationError{
+						field:  fmt.Sprintf("Videos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MediaValidationError{
+					field:  fmt.Sprintf("Videos[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetVirtualTours() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MediaValidationError{
+						field:  fmt.Sprintf("VirtualTours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MediaValidationError{
+						field:  fmt.Sprintf("VirtualTours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MediaValidationError{
+					field:  fmt.Sprintf("VirtualTours[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MediaMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaMultiError is an error wrapping multiple validation errors returned by
+// Media.ValidateAll() if the designated constraints aren't met.
+type MediaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaMultiError) AllErrors() []error { return m }
+
+// MediaValidationError is the validation error returned by Media.Validate if
+// the designated constraints aren't met.
+type MediaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaValidationError) ErrorName() string { return "MediaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMedia.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaValidationError{}
+
+// Validate checks the field values on Photo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Photo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Photo with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PhotoMultiError, or nil if none found.
+func (m *Photo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Photo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for ThumbnailUrl
+
+	// no validation rules for Caption
+
+	// no validation rules for AltText
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for Photographer
+
+	if all {
+		switch v := interface{}(m.GetTakenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PhotoValidationError{
+					field:  "TakenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PhotoValidationError{
+					field:  "TakenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTakenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PhotoValidationError{
+				field:  "TakenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PhotoMultiError(errors)
+	}
+
+	return nil
+}
+
+// PhotoMultiError is an error wrapping multiple validation errors returned by
+// Photo.ValidateAll() if the designated constraints aren't met.
+type PhotoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PhotoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PhotoMultiError) AllErrors() []error { return m }
+
+// PhotoValidationError is the validation error returned by Photo.Validate if
+// the designated constraints aren't met.
+type PhotoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PhotoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PhotoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PhotoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PhotoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PhotoValidationError) ErrorName() string { return "PhotoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PhotoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPhoto.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PhotoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PhotoValidationError{}
+
+// Validate checks the field values on Video with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Video) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Video with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in VideoMultiError, or nil if none found.
+func (m *Video) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Video) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for ThumbnailUrl
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for DurationSeconds
+
+	// no validation rules for Provider
+
+	if len(errors) > 0 {
+		return VideoMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoMultiError is an error wrapping multiple validation errors returned by
+// Video.ValidateAll() if the designated constraints aren't met.
+type VideoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoMultiError) AllErrors() []error { return m }
+
+// VideoValidationError is the validation error returned by Video.Validate if
+// the designated constraints aren't met.
+type VideoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoValidationError) ErrorName() string { return "VideoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoValidationError{}
+
+// Validate checks the field values on VirtualTour with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VirtualTour) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VirtualTour with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VirtualTourMultiError, or
+// nil if none found.
+func (m *VirtualTour) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VirtualTour) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for Title
+
+	// no validation rules for Provider
+
+	// no validation rules for PreviewImageUrl
+
+	if len(errors) > 0 {
+		return VirtualTourMultiError(errors)
+	}
+
+	return nil
+}
+
+// VirtualTourMultiError is an error wrapping multiple validation errors
+// returned by VirtualTour.ValidateAll() if the designated constraints aren't met.
+type VirtualTourMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VirtualTourMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VirtualTourMultiError) AllErrors() []error { return m }
+
+// VirtualTourValidationError is the validation error returned by
+// VirtualTour.Validate if the designated constraints aren't met.
+type VirtualTourValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VirtualTourValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VirtualTourValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VirtualTourValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VirtualTourValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VirtualTourValidationError) ErrorName() string { return "VirtualTourValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VirtualTourValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVirtualTour.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VirtualTourValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VirtualTourValidationError{}
+
+// Validate checks the field values on POI with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *POI) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on POI with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in POIMultiError, or nil if none found.
+func (m *POI) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *POI) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Category
+
+	// no validation rules for Subcategory
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetRating()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "Rating",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "Rating",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRating()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIValidationError{
+				field:  "Rating",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PriceRange
+
+	// no validation rules for PriceLabel
+
+	// no validation rules for PriceLevel
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetContact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "Contact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "Contact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIValidationError{
+				field:  "Contact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PhoneNumber
+
+	if all {
+		switch v := interface{}(m.GetOpeningHours()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "OpeningHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "OpeningHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpeningHours()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIValidationError{
+				field:  "OpeningHours",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMedia()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "Media",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "Media",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMedia()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIValidationError{
+				field:  "Media",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Distance
+
+	// no validation rules for CityId
+
+	// no validation rules for IsVerified
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, POIValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, POIValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return POIValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Source
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetDistanceMeters() < 0 {
+		err := POIValidationError{
+			field:  "DistanceMeters",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalizedName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "LocalizedName",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "LocalizedName",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalizedName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIValidationError{
+				field:  "LocalizedName",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalizedDescription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "LocalizedDescription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIValidationError{
+					field:  "LocalizedDescription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalizedDescription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIValidationError{
+				field:  "LocalizedDescription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return POIMultiError(errors)
+	}
+
+	return nil
+}
+
+// POIMultiError is an error wrapping multiple validation errors returned by
+// POI.ValidateAll() if the designated constraints aren't met.
+type POIMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m POIMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m POIMultiError) AllErrors() []error { return m }
+
+// POIValidationError is the validation error returned by POI.Validate if the
+// designated constraints aren't met.
+type POIValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e POIValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e POIValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e POIValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e POIValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e POIValidationError) ErrorName() string { return "POIValidationError" }
+
+// Error satisfies the builtin error interface
+func (e POIValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPOI.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = POIValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = POIValidationError{}
+
+// Validate checks the field values on POITag with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *POITag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on POITag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in POITagMultiError, or nil if none found.
+func (m *POITag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *POITag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for TagType
+
+	// no validation rules for Description
+
+	// no validation rules for Source
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POITagValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POITagValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POITagValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POITagValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POITagValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POITagValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return POITagMultiError(errors)
+	}
+
+	return nil
+}
+
+// POITagMultiError is an error wrapping multiple validation errors returned by
+// POITag.ValidateAll() if the designated constraints aren't met.
+type POITagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m POITagMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m POITagMultiError) AllErrors() []error { return m }
+
+// POITagValidationError is the validation error returned by POITag.Validate if
+// the designated constraints aren't met.
+type POITagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e POITagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e POITagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e POITagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e POITagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e POITagValidationError) ErrorName() string { return "POITagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e POITagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPOITag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = POITagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = POITagValidationError{}
+
+// Validate checks the field values on SortOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SortOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SortOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SortOptionsMultiError, or
+// nil if none found.
+func (m *SortOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SortOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Direction
+
+	if len(errors) > 0 {
+		return SortOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SortOptionsMultiError is an error wrapping multiple validation errors
+// returned by SortOptions.ValidateAll() if the designated constraints aren't met.
+type SortOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SortOptionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SortOptionsMultiError) AllErrors() []error { return m }
+
+// SortOptionsValidationError is the validation error returned by
+// SortOptions.Validate if the designated constraints aren't met.
+type SortOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SortOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SortOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SortOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SortOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SortOptionsValidationError) ErrorName() string { return "SortOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SortOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSortOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SortOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SortOptionsValidationError{}
+
+// Validate checks the field values on FilterOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FilterOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FilterOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FilterOptionsMultiError, or
+// nil if none found.
+func (m *FilterOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FilterOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetMinRating(); val < 0 || val > 5 {
+		err := FilterOptionsValidationError{
+			field:  "MinRating",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxDistanceMeters() < 0 {
+		err := FilterOptionsValidationError{
+			field:  "MaxDistanceMeters",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpenNow
+
+	// no validation rules for CustomFilters
+
+	if len(errors) > 0 {
+		return FilterOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// FilterOptionsMultiError is an error wrapping multiple validation errors
+// returned by FilterOptions.ValidateAll() if the designated constraints
+// aren't met.
+type FilterOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FilterOptionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FilterOptionsMultiError) AllErrors() []error { return m }
+
+// FilterOptionsValidationError is the validation error returned by
+// FilterOptions.Validate if the designated constraints aren't met.
+type FilterOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FilterOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FilterOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FilterOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FilterOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FilterOptionsValidationError) ErrorName() string { return "FilterOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FilterOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFilterOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FilterOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FilterOptionsValidationError{}
+
+// Validate checks the field values on LocalizedString with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LocalizedString) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocalizedString with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LocalizedStringMultiError, or nil if none found.
+func (m *LocalizedString) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocalizedString) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_LocalizedString_LanguageCode_Pattern.MatchString(m.GetLanguageCode()) {
+		err := LocalizedStringValidationError{
+			field:  "LanguageCode",
+			reason: "value does not match regex pattern \"^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return LocalizedStringMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocalizedStringMultiError is an error wrapping multiple validation errors
+// returned by LocalizedString.ValidateAll() if the designated constraints
+// aren't met.
+type LocalizedStringMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocalizedStringMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocalizedStringMultiError) AllErrors() []error { return m }
+
+// LocalizedStringValidationError is the validation error returned by
+// LocalizedString.Validate if the designated constraints aren't met.
+type LocalizedStringValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocalizedStringValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocalizedStringValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocalizedStringValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocalizedStringValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocalizedStringValidationError) ErrorName() string { return "LocalizedStringValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocalizedStringValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocalizedString.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocalizedStringValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocalizedStringValidationError{}
+
+var _LocalizedString_LanguageCode_Pattern = regexp.MustCompile("^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on MultilingualText with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MultilingualText) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MultilingualText with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MultilingualTextMultiError, or nil if none found.
+func (m *MultilingualText) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MultilingualText) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTranslations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MultilingualTextValidationError{
+						field:  fmt.Sprintf("Translations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MultilingualTextValidationError{
+						field:  fmt.Sprintf("Translations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultilingualTextValidationError{
+					field:  fmt.Sprintf("Translations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetDefaultLanguage() != "" {
+
+		if !_MultilingualText_DefaultLanguage_Pattern.MatchString(m.GetDefaultLanguage()) {
+			err := MultilingualTextValidationError{
+				field:  "DefaultLanguage",
+				reason: "value does not match regex pattern \"^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MultilingualTextMultiError(errors)
+	}
+
+	return nil
+}
+
+// MultilingualTextMultiError is an error wrapping multiple validation errors
+// returned by MultilingualText.ValidateAll() if the designated constraints
+// aren't met.
+type MultilingualTextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MultilingualTextMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MultilingualTextMultiError) AllErrors() []error { return m }
+
+// MultilingualTextValidationError is the validation error returned by
+// MultilingualText.Validate if the designated constraints aren't met.
+type MultilingualTextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultilingualTextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultilingualTextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultilingualTextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultilingualTextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultilingualTextValidationError) ErrorName() string { return "MultilingualTextValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MultilingualTextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultilingualText.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultilingualTextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultilingualTextValidationError{}
+
+var _MultilingualText_DefaultLanguage_Pattern = regexp.MustCompile("^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on AuditInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditInfoMultiError, or nil
+// if none found.
+func (m *AuditInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditInfoValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditInfoValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditInfoValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditInfoValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for UpdatedBy
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return AuditInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditInfoMultiError is an error wrapping multiple validation errors returned
+// by AuditInfo.ValidateAll() if the designated constraints aren't met.
+type AuditInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditInfoMultiError) AllErrors() []error { return m }
+
+// AuditInfoValidationError is the validation error returned by
+// AuditInfo.Validate if the designated constraints aren't met.
+type AuditInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditInfoValidationError) ErrorName() string { return "AuditInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditInfoValidationError{}
+
+// Validate checks the field values on HealthCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HealthCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HealthCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HealthCheckRequestMultiError, or nil if none found.
+func (m *HealthCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HealthCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Service
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HealthCheckRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HealthCheckRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HealthCheckRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return HealthCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HealthCheckRequestMultiError is an error wrapping multiple validation errors
+// returned by HealthCheckRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HealthCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HealthCheckRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HealthCheckRequestMultiError) AllErrors() []error { return m }
+
+// HealthCheckRequestValidationError is the validation error returned by
+// HealthCheckRequest.Validate if the designated constraints aren't met.
+type HealthCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthCheckRequestValidationError) ErrorName() string {
+	return "HealthCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HealthCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthCheckRequestValidationError{}
+
+// Validate checks the field values on HealthCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HealthCheckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HealthCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HealthCheckResponseMultiError, or nil if none found.
+func (m *HealthCheckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HealthCheckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HealthCheckResponseValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HealthCheckResponseValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HealthCheckResponseValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetComponents()))
+		i := 0
+		for key := range m.GetComponents() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetComponents()[key]
+			_ = val
+
+			// no validation rules for Components[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, HealthCheckResponseValidationError{
+							field:  fmt.Sprintf("Components[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, HealthCheckResponseValidationError{
+							field:  fmt.Sprintf("Components[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return HealthCheckResponseValidationError{
+						field:  fmt.Sprintf("Components[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HealthCheckResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HealthCheckResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HealthCheckResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return HealthCheckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// HealthCheckResponseMultiError is an error wrapping multiple validation
+// errors returned by HealthCheckResponse.ValidateAll() if the designated
+// constraints aren't met.
+type HealthCheckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HealthCheckResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HealthCheckResponseMultiError) AllErrors() []error { return m }
+
+// HealthCheckResponseValidationError is the validation error returned by
+// HealthCheckResponse.Validate if the designated constraints aren't met.
+type HealthCheckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthCheckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthCheckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthCheckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthCheckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthCheckResponseValidationError) ErrorName() string {
+	return "HealthCheckResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HealthCheckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthCheckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthCheckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthCheckResponseValidationError{}
+
+// Validate checks the field values on ComponentHealth with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ComponentHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComponentHealth with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ComponentHealthMultiError, or nil if none found.
+func (m *ComponentHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComponentHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	// no validation rules for Details
+
+	if len(errors) > 0 {
+		return ComponentHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComponentHealthMultiError is an error wrapping multiple validation errors
+// returned by ComponentHealth.ValidateAll() if the designated constraints
+// aren't met.
+type ComponentHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComponentHealthMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComponentHealthMultiError) AllErrors() []error { return m }
+
+// ComponentHealthValidationError is the validation error returned by
+// ComponentHealth.Validate if the designated constraints aren't met.
+type ComponentHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComponentHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComponentHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComponentHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComponentHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComponentHealthValidationError) ErrorName() string { return "ComponentHealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ComponentHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComponentHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComponentHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComponentHealthValidationError{}
+
+// Validate checks the field values on FeatureFlag with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeatureFlag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeatureFlag with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FeatureFlagMultiError, or
+// nil if none found.
+func (m *FeatureFlag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeatureFlag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Enabled
+
+	// no validation rules for Description
+
+	// no validation rules for Parameters
+
+	if len(errors) > 0 {
+		return FeatureFlagMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeatureFlagMultiError is an error wrapping multiple validation errors
+// returned by FeatureFlag.ValidateAll() if the designated constraints aren't met.
+type FeatureFlagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeatureFlagMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeatureFlagMultiError) AllErrors() []error { return m }
+
+// FeatureFlagValidationError is the validation error returned by
+// FeatureFlag.Validate if the designated constraints aren't met.
+type FeatureFlagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeatureFlagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeatureFlagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeatureFlagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeatureFlagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeatureFlagValidationError) ErrorName() string { return "FeatureFlagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeatureFlagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeatureFlag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeatureFlagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeatureFlagValidationError{}
+
+// Validate checks the field values on ApiVersion with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ApiVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ApiVersionMultiError, or
+// nil if none found.
+func (m *ApiVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Deprecated
+
+	if all {
+		switch v := interface{}(m.GetSunsetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiVersionValidationError{
+					field:  "SunsetDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiVersionValidationError{
+					field:  "SunsetDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSunsetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiVersionValidationError{
+				field:  "SunsetDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MigrationGuideUrl
+
+	if len(errors) > 0 {
+		return ApiVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiVersionMultiError is an error wrapping multiple validation errors
+// returned by ApiVersion.ValidateAll() if the designated constraints aren't met.
+type ApiVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiVersionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiVersionMultiError) AllErrors() []error { return m }
+
+// ApiVersionValidationError is the validation error returned by
+// ApiVersion.Validate if the designated constraints aren't met.
+type ApiVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiVersionValidationError) ErrorName() string { return "ApiVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApiVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiVersionValidationError{}
+
+// Validate checks the field values on RateLimitInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RateLimitInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RateLimitInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RateLimitInfoMultiError, or
+// nil if none found.
+func (m *RateLimitInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RateLimitInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequestsRemaining
+
+	// no validation rules for RequestsLimit
+
+	if all {
+		switch v := interface{}(m.GetResetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RateLimitInfoValidationError{
+					field:  "ResetTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RateLimitInfoValidationError{
+					field:  "ResetTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RateLimitInfoValidationError{
+				field:  "ResetTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RetryAfterSeconds
+
+	if len(errors) > 0 {
+		return RateLimitInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// RateLimitInfoMultiError is an error wrapping multiple validation errors
+// returned by RateLimitInfo.ValidateAll() if the designated constraints
+// aren't met.
+type RateLimitInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RateLimitInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RateLimitInfoMultiError) AllErrors() []error { return m }
+
+// RateLimitInfoValidationError is the validation error returned by
+// RateLimitInfo.Validate if the designated constraints aren't met.
+type RateLimitInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitInfoValidationError) ErrorName() string { return "RateLimitInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RateLimitInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimitInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitInfoValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
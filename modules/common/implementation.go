@@ -3,6 +3,7 @@ package common
 import (
 	"time"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	c "github.com/FACorreiaa/loci-proto/modules/common/generated"
@@ -313,6 +314,68 @@ func NewBaseResponse(upstream, requestId string) *c.BaseResponse {
 	}
 }
 
+// LegacyBaseRequest is implemented by the deprecated BaseRequest each
+// service used to declare for itself
+type LegacyBaseRequest interface {
+	GetDownstream() string
+	GetRequestId() string
+}
+
+// LegacyBaseResponse is implemented by the deprecated BaseResponse each
+// service used to declare for itself
+type LegacyBaseResponse interface {
+	GetUpstream() string
+	GetRequestId() string
+	GetStatus() string
+}
+
+// BaseRequestFrom converts a deprecated per-service BaseRequest
+func BaseRequestFrom(legacy LegacyBaseRequest) *c.BaseRequest {
+	if legacy == nil {
+		return nil
+	}
+
+	return NewBaseRequest(legacy.GetDownstream(), legacy.GetRequestId())
+}
+
+// BaseResponseFrom converts a deprecated per-service BaseResponse
+func BaseResponseFrom(legacy LegacyBaseResponse) *c.BaseResponse {
+	if legacy == nil {
+		return nil
+	}
+
+	return &c.BaseResponse{
+		Upstream:  legacy.GetUpstream(),
+		RequestId: legacy.GetRequestId(),
+		Status:    legacy.GetStatus(),
+	}
+}
+
+// ConvertWire converts between messages that share a wire format, such as a
+// common BaseRequest and a deprecated per-service one:
+//
+//	legacy, err := common.ConvertWire[poi.BaseRequest](req.GetRequest())
+func ConvertWire[T any, PT interface {
+	*T
+	proto.Message
+}](src proto.Message) (PT, error) {
+	dst := PT(new(T))
+	if src == nil {
+		return dst, nil
+	}
+
+	b, err := proto.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := proto.Unmarshal(b, dst); err != nil {
+		return nil, err
+	}
+
+	return dst, nil
+}
+
 // Validation helpers
 
 // ValidateCoordinates checks if coordinates are within valid ranges
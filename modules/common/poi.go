@@ -0,0 +1,154 @@
+package common
+
+import (
+	"strings"
+
+	c "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// Helpers shared by the converters between the per-service POIDetailedInfo
+// messages and the canonical POI
+
+// PriceRangeFromLabel parses a price label such as "Free", "€€" or "$$$"
+func PriceRangeFromLabel(label string) c.PriceRange {
+	label = strings.TrimSpace(label)
+	if strings.EqualFold(label, "free") {
+		return c.PriceRange_PRICE_RANGE_FREE
+	}
+
+	symbols := 0
+	for _, r := range label {
+		switch r {
+		case '€', '$', '£', '¥':
+			symbols++
+		default:
+			return c.PriceRange_PRICE_RANGE_UNSPECIFIED
+		}
+	}
+
+	switch symbols {
+	case 0:
+		return c.PriceRange_PRICE_RANGE_UNSPECIFIED
+	case 1:
+		return c.PriceRange_PRICE_RANGE_BUDGET
+	case 2:
+		return c.PriceRange_PRICE_RANGE_MODERATE
+	case 3:
+		return c.PriceRange_PRICE_RANGE_EXPENSIVE
+	default:
+		return c.PriceRange_PRICE_RANGE_LUXURY
+	}
+}
+
+// PriceLabel returns the label of a POI's price range, preferring the one
+// sent by upstream
+func PriceLabel(poi *c.POI) string {
+	if label := poi.GetPriceLabel(); label != "" {
+		return label
+	}
+
+	switch poi.GetPriceRange() {
+	case c.PriceRange_PRICE_RANGE_FREE:
+		return "Free"
+	case c.PriceRange_PRICE_RANGE_BUDGET:
+		return "€"
+	case c.PriceRange_PRICE_RANGE_MODERATE:
+		return "€€"
+	case c.PriceRange_PRICE_RANGE_EXPENSIVE:
+		return "€€€"
+	case c.PriceRange_PRICE_RANGE_LUXURY:
+		return "€€€€"
+	default:
+		return ""
+	}
+}
+
+// NewPOILocation creates the location of a POI, or nil when the legacy
+// message carried none
+func NewPOILocation(lat, lng float64) *c.Coordinates {
+	if lat == 0 && lng == 0 {
+		return nil
+	}
+
+	return NewCoordinates(lat, lng)
+}
+
+// NewPOIRating creates the rating of a POI, or nil when the legacy message
+// carried none
+func NewPOIRating(average float64, count int32) *c.Rating {
+	if average == 0 && count == 0 {
+		return nil
+	}
+
+	return NewRating(average, count)
+}
+
+// NewPOIAddress creates the address of a POI from the plain strings the
+// legacy messages carry
+func NewPOIAddress(formatted, city, country string) *c.Address {
+	if formatted == "" && city == "" && country == "" {
+		return nil
+	}
+
+	return &c.Address{
+		FormattedAddress: formatted,
+		City:             city,
+		Country:          country,
+	}
+}
+
+// FormatAddress returns the one-line form of an address. Addresses that
+// upstream did not format are joined from their parts, as long as the street
+// is known
+func FormatAddress(address *c.Address) string {
+	if address.GetFormattedAddress() != "" || address.GetStreet() == "" {
+		return address.GetFormattedAddress()
+	}
+
+	var parts []string
+	for _, part := range []string{address.GetStreet(), address.GetPostalCode(), address.GetCity(), address.GetState(), address.GetCountry()} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// NewPOIContact creates the contact information of a POI, or nil when the
+// legacy message carried none
+func NewPOIContact(phone, email, website string) *c.ContactInfo {
+	if phone == "" && email == "" && website == "" {
+		return nil
+	}
+
+	return NewContactInfo(phone, email, website)
+}
+
+// MediaFromURLs creates the media of a POI from plain photo URLs
+func MediaFromURLs(urls []string) *c.Media {
+	if len(urls) == 0 {
+		return nil
+	}
+
+	media := &c.Media{Photos: make([]*c.Photo, 0, len(urls))}
+	for _, url := range urls {
+		media.Photos = append(media.Photos, &c.Photo{Url: url})
+	}
+
+	return media
+}
+
+// PhotoURLs returns the URLs of the photos of a POI
+func PhotoURLs(media *c.Media) []string {
+	if len(media.GetPhotos()) == 0 {
+		return nil
+	}
+
+	urls := make([]string, 0, len(media.GetPhotos()))
+	for _, photo := range media.GetPhotos() {
+		urls = append(urls, photo.GetUrl())
+	}
+
+	return urls
+}
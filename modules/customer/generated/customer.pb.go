@@ -14,6 +14,7 @@
 package generated
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -31,8 +32,8 @@ const (
 type GetCustomerReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Be explicit with what you want to get, don't just"ID"
-	PublicId      string       `protobuf:"bytes,1,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
-	Request       *BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	PublicId      string                 `protobuf:"bytes,1,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,7 +75,7 @@ func (x *GetCustomerReq) GetPublicId() string {
 	return ""
 }
 
-func (x *GetCustomerReq) GetRequest() *BaseRequest {
+func (x *GetCustomerReq) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -86,8 +87,8 @@ type GetCustomerRes struct {
 	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Optional: populate if success is false
 	// The customer object
-	Customer      *XCustomer    `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
-	Response      *BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Customer      *XCustomer              `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -143,7 +144,7 @@ func (x *GetCustomerRes) GetCustomer() *XCustomer {
 	return nil
 }
 
-func (x *GetCustomerRes) GetResponse() *BaseResponse {
+func (x *GetCustomerRes) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -153,8 +154,8 @@ func (x *GetCustomerRes) GetResponse() *BaseResponse {
 type CreateCustomerReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The customer object
-	Customer      *XCustomer   `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
-	Request       *BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Customer      *XCustomer             `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -196,7 +197,7 @@ func (x *CreateCustomerReq) GetCustomer() *XCustomer {
 	return nil
 }
 
-func (x *CreateCustomerReq) GetRequest() *BaseRequest {
+func (x *CreateCustomerReq) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -208,8 +209,8 @@ type CreateCustomerRes struct {
 	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	// The customer object
-	Customer      *XCustomer    `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
-	Response      *BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Customer      *XCustomer              `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -265,7 +266,7 @@ func (x *CreateCustomerRes) GetCustomer() *XCustomer {
 	return nil
 }
 
-func (x *CreateCustomerRes) GetResponse() *BaseResponse {
+func (x *CreateCustomerRes) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -278,8 +279,8 @@ type UpdateCustomerReq struct {
 	// This saves us from having to return the entire customer object and saves
 	// on implementation complexity due to not all customer fields being
 	// returned (so we can't just dump the entire object into the DB).
-	Updates       []*XDiff     `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
-	Request       *BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Updates       []*XDiff               `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -328,7 +329,7 @@ func (x *UpdateCustomerReq) GetUpdates() []*XDiff {
 	return nil
 }
 
-func (x *UpdateCustomerReq) GetRequest() *BaseRequest {
+func (x *UpdateCustomerReq) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -340,8 +341,8 @@ type UpdateCustomerRes struct {
 	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	// The customer object
-	Customer      *XCustomer    `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
-	Response      *BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Customer      *XCustomer              `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -397,7 +398,7 @@ func (x *UpdateCustomerRes) GetCustomer() *XCustomer {
 	return nil
 }
 
-func (x *UpdateCustomerRes) GetResponse() *BaseResponse {
+func (x *UpdateCustomerRes) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -408,7 +409,7 @@ type DeleteCustomerReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
 	HardDelete    bool                   `protobuf:"varint,2,opt,name=hard_delete,json=hardDelete,proto3" json:"hard_delete,omitempty"` // soft delete by default
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -457,7 +458,7 @@ func (x *DeleteCustomerReq) GetHardDelete() bool {
 	return false
 }
 
-func (x *DeleteCustomerReq) GetRequest() *BaseRequest {
+func (x *DeleteCustomerReq) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -468,8 +469,8 @@ func (x *DeleteCustomerReq) GetRequest() *BaseRequest {
 // as the google.protobuf.Empty type is not available in all languages that we
 // support.
 type NilRes struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -504,7 +505,7 @@ func (*NilRes) Descriptor() ([]byte, []int) {
 	return file_customer_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *NilRes) GetResponse() *BaseResponse {
+func (x *NilRes) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -753,6 +754,10 @@ func (x *XDiff) GetNewValue() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in customer.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -805,6 +810,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in customer.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -869,41 +878,41 @@ var File_customer_proto protoreflect.FileDescriptor
 
 const file_customer_proto_rawDesc = "" +
 	"\n" +
-	"\x0ecustomer.proto\x12\x17highlyregarded.customer\"m\n" +
+	"\x0ecustomer.proto\x12\x17highlyregarded.customer\x1a\fcommon.proto\"f\n" +
 	"\x0eGetCustomerReq\x12\x1b\n" +
-	"\tpublic_id\x18\x01 \x01(\tR\bpublicId\x12>\n" +
-	"\arequest\x18d \x01(\v2$.highlyregarded.customer.BaseRequestR\arequest\"\xc7\x01\n" +
+	"\tpublic_id\x18\x01 \x01(\tR\bpublicId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc0\x01\n" +
 	"\x0eGetCustomerRes\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12>\n" +
-	"\bcustomer\x18\x03 \x01(\v2\".highlyregarded.customer.XCustomerR\bcustomer\x12A\n" +
-	"\bresponse\x18d \x01(\v2%.highlyregarded.customer.BaseResponseR\bresponse\"\x93\x01\n" +
+	"\bcustomer\x18\x03 \x01(\v2\".highlyregarded.customer.XCustomerR\bcustomer\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8c\x01\n" +
 	"\x11CreateCustomerReq\x12>\n" +
-	"\bcustomer\x18\x01 \x01(\v2\".highlyregarded.customer.XCustomerR\bcustomer\x12>\n" +
-	"\arequest\x18d \x01(\v2$.highlyregarded.customer.BaseRequestR\arequest\"\xca\x01\n" +
+	"\bcustomer\x18\x01 \x01(\v2\".highlyregarded.customer.XCustomerR\bcustomer\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc3\x01\n" +
 	"\x11CreateCustomerRes\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12>\n" +
-	"\bcustomer\x18\x03 \x01(\v2\".highlyregarded.customer.XCustomerR\bcustomer\x12A\n" +
-	"\bresponse\x18d \x01(\v2%.highlyregarded.customer.BaseResponseR\bresponse\"\xae\x01\n" +
+	"\bcustomer\x18\x03 \x01(\v2\".highlyregarded.customer.XCustomerR\bcustomer\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xa7\x01\n" +
 	"\x11UpdateCustomerReq\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x128\n" +
-	"\aupdates\x18\x02 \x03(\v2\x1e.highlyregarded.customer.XDiffR\aupdates\x12>\n" +
-	"\arequest\x18d \x01(\v2$.highlyregarded.customer.BaseRequestR\arequest\"\xca\x01\n" +
+	"\aupdates\x18\x02 \x03(\v2\x1e.highlyregarded.customer.XDiffR\aupdates\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc3\x01\n" +
 	"\x11UpdateCustomerRes\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12>\n" +
-	"\bcustomer\x18\x03 \x01(\v2\".highlyregarded.customer.XCustomerR\bcustomer\x12A\n" +
-	"\bresponse\x18d \x01(\v2%.highlyregarded.customer.BaseResponseR\bresponse\"\x95\x01\n" +
+	"\bcustomer\x18\x03 \x01(\v2\".highlyregarded.customer.XCustomerR\bcustomer\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8e\x01\n" +
 	"\x11DeleteCustomerReq\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12\x1f\n" +
 	"\vhard_delete\x18\x02 \x01(\bR\n" +
-	"hardDelete\x12>\n" +
-	"\arequest\x18d \x01(\v2$.highlyregarded.customer.BaseRequestR\arequest\"K\n" +
-	"\x06NilRes\x12A\n" +
-	"\bresponse\x18d \x01(\v2%.highlyregarded.customer.BaseResponseR\bresponse\"\xa7\x01\n" +
+	"hardDelete\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"D\n" +
+	"\x06NilRes\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xa7\x01\n" +
 	"\tXCustomer\x12\x1b\n" +
 	"\tpublic_id\x18\x01 \x01(\tR\bpublicId\x12\x1d\n" +
 	"\n" +
@@ -923,23 +932,23 @@ const file_customer_proto_rawDesc = "" +
 	"\x05XDiff\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
 	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
-	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"N\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status2\x9e\x03\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x012\x9e\x03\n" +
 	"\bCustomer\x12_\n" +
 	"\vGetCustomer\x12'.highlyregarded.customer.GetCustomerReq\x1a'.highlyregarded.customer.GetCustomerRes\x12h\n" +
 	"\x0eCreateCustomer\x12*.highlyregarded.customer.CreateCustomerReq\x1a*.highlyregarded.customer.CreateCustomerRes\x12h\n" +
 	"\x0eUpdateCustomer\x12*.highlyregarded.customer.UpdateCustomerReq\x1a*.highlyregarded.customer.UpdateCustomerRes\x12]\n" +
-	"\x0eDeleteCustomer\x12*.highlyregarded.customer.DeleteCustomerReq\x1a\x1f.highlyregarded.customer.NilResb\x06proto3"
+	"\x0eDeleteCustomer\x12*.highlyregarded.customer.DeleteCustomerReq\x1a\x1f.highlyregarded.customer.NilResB=Z;github.com/FACorreiaa/loci-proto/modules/customer/generatedb\x06proto3"
 
 var (
 	file_customer_proto_rawDescOnce sync.Once
@@ -955,34 +964,36 @@ func file_customer_proto_rawDescGZIP() []byte {
 
 var file_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_customer_proto_goTypes = []any{
-	(*GetCustomerReq)(nil),    // 0: highlyregarded.customer.GetCustomerReq
-	(*GetCustomerRes)(nil),    // 1: highlyregarded.customer.GetCustomerRes
-	(*CreateCustomerReq)(nil), // 2: highlyregarded.customer.CreateCustomerReq
-	(*CreateCustomerRes)(nil), // 3: highlyregarded.customer.CreateCustomerRes
-	(*UpdateCustomerReq)(nil), // 4: highlyregarded.customer.UpdateCustomerReq
-	(*UpdateCustomerRes)(nil), // 5: highlyregarded.customer.UpdateCustomerRes
-	(*DeleteCustomerReq)(nil), // 6: highlyregarded.customer.DeleteCustomerReq
-	(*NilRes)(nil),            // 7: highlyregarded.customer.NilRes
-	(*XCustomer)(nil),         // 8: highlyregarded.customer.XCustomer
-	(*XName)(nil),             // 9: highlyregarded.customer.XName
-	(*XDiff)(nil),             // 10: highlyregarded.customer.XDiff
-	(*BaseRequest)(nil),       // 11: highlyregarded.customer.BaseRequest
-	(*BaseResponse)(nil),      // 12: highlyregarded.customer.BaseResponse
+	(*GetCustomerReq)(nil),         // 0: highlyregarded.customer.GetCustomerReq
+	(*GetCustomerRes)(nil),         // 1: highlyregarded.customer.GetCustomerRes
+	(*CreateCustomerReq)(nil),      // 2: highlyregarded.customer.CreateCustomerReq
+	(*CreateCustomerRes)(nil),      // 3: highlyregarded.customer.CreateCustomerRes
+	(*UpdateCustomerReq)(nil),      // 4: highlyregarded.customer.UpdateCustomerReq
+	(*UpdateCustomerRes)(nil),      // 5: highlyregarded.customer.UpdateCustomerRes
+	(*DeleteCustomerReq)(nil),      // 6: highlyregarded.customer.DeleteCustomerReq
+	(*NilRes)(nil),                 // 7: highlyregarded.customer.NilRes
+	(*XCustomer)(nil),              // 8: highlyregarded.customer.XCustomer
+	(*XName)(nil),                  // 9: highlyregarded.customer.XName
+	(*XDiff)(nil),                  // 10: highlyregarded.customer.XDiff
+	(*BaseRequest)(nil),            // 11: highlyregarded.customer.BaseRequest
+	(*BaseResponse)(nil),           // 12: highlyregarded.customer.BaseResponse
+	(*generated.BaseRequest)(nil),  // 13: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil), // 14: ai_poi.common.v1.BaseResponse
 }
 var file_customer_proto_depIdxs = []int32{
-	11, // 0: highlyregarded.customer.GetCustomerReq.request:type_name -> highlyregarded.customer.BaseRequest
+	13, // 0: highlyregarded.customer.GetCustomerReq.request:type_name -> ai_poi.common.v1.BaseRequest
 	8,  // 1: highlyregarded.customer.GetCustomerRes.customer:type_name -> highlyregarded.customer.XCustomer
-	12, // 2: highlyregarded.customer.GetCustomerRes.response:type_name -> highlyregarded.customer.BaseResponse
+	14, // 2: highlyregarded.customer.GetCustomerRes.response:type_name -> ai_poi.common.v1.BaseResponse
 	8,  // 3: highlyregarded.customer.CreateCustomerReq.customer:type_name -> highlyregarded.customer.XCustomer
-	11, // 4: highlyregarded.customer.CreateCustomerReq.request:type_name -> highlyregarded.customer.BaseRequest
+	13, // 4: highlyregarded.customer.CreateCustomerReq.request:type_name -> ai_poi.common.v1.BaseRequest
 	8,  // 5: highlyregarded.customer.CreateCustomerRes.customer:type_name -> highlyregarded.customer.XCustomer
-	12, // 6: highlyregarded.customer.CreateCustomerRes.response:type_name -> highlyregarded.customer.BaseResponse
+	14, // 6: highlyregarded.customer.CreateCustomerRes.response:type_name -> ai_poi.common.v1.BaseResponse
 	10, // 7: highlyregarded.customer.UpdateCustomerReq.updates:type_name -> highlyregarded.customer.XDiff
-	11, // 8: highlyregarded.customer.UpdateCustomerReq.request:type_name -> highlyregarded.customer.BaseRequest
+	13, // 8: highlyregarded.customer.UpdateCustomerReq.request:type_name -> ai_poi.common.v1.BaseRequest
 	8,  // 9: highlyregarded.customer.UpdateCustomerRes.customer:type_name -> highlyregarded.customer.XCustomer
-	12, // 10: highlyregarded.customer.UpdateCustomerRes.response:type_name -> highlyregarded.customer.BaseResponse
-	11, // 11: highlyregarded.customer.DeleteCustomerReq.request:type_name -> highlyregarded.customer.BaseRequest
-	12, // 12: highlyregarded.customer.NilRes.response:type_name -> highlyregarded.customer.BaseResponse
+	14, // 10: highlyregarded.customer.UpdateCustomerRes.response:type_name -> ai_poi.common.v1.BaseResponse
+	13, // 11: highlyregarded.customer.DeleteCustomerReq.request:type_name -> ai_poi.common.v1.BaseRequest
+	14, // 12: highlyregarded.customer.NilRes.response:type_name -> ai_poi.common.v1.BaseResponse
 	9,  // 13: highlyregarded.customer.XCustomer.name:type_name -> highlyregarded.customer.XName
 	0,  // 14: highlyregarded.customer.Customer.GetCustomer:input_type -> highlyregarded.customer.GetCustomerReq
 	2,  // 15: highlyregarded.customer.Customer.CreateCustomer:input_type -> highlyregarded.customer.CreateCustomerReq
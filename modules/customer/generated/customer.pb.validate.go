@@ -0,0 +1,1808 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: customer.proto
+
+package generated
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetCustomerReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetCustomerReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCustomerReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetCustomerReqMultiError,
+// or nil if none found.
+func (m *GetCustomerReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCustomerReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PublicId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCustomerReqValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCustomerReqValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCustomerReqValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCustomerReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCustomerReqMultiError is an error wrapping multiple validation errors
+// returned by GetCustomerReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetCustomerReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCustomerReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCustomerReqMultiError) AllErrors() []error { return m }
+
+// GetCustomerReqValidationError is the validation error returned by
+// GetCustomerReq.Validate if the designated constraints aren't met.
+type GetCustomerReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCustomerReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCustomerReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCustomerReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCustomerReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCustomerReqValidationError) ErrorName() string { return "GetCustomerReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCustomerReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCustomerReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCustomerReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCustomerReqValidationError{}
+
+// Validate checks the field values on GetCustomerRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetCustomerRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCustomerRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetCustomerResMultiError,
+// or nil if none found.
+func (m *GetCustomerRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCustomerRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetCustomer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCustomerResValidationError{
+					field:  "Customer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCustomerResValidationError{
+					field:  "Customer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCustomer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCustomerResValidationError{
+				field:  "Customer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCustomerResValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCustomerResValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCustomerResValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCustomerResMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCustomerResMultiError is an error wrapping multiple validation errors
+// returned by GetCustomerRes.ValidateAll() if the designated constraints
+// aren't met.
+type GetCustomerResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCustomerResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCustomerResMultiError) AllErrors() []error { return m }
+
+// GetCustomerResValidationError is the validation error returned by
+// GetCustomerRes.Validate if the designated constraints aren't met.
+type GetCustomerResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCustomerResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCustomerResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCustomerResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCustomerResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCustomerResValidationError) ErrorName() string { return "GetCustomerResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCustomerResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCustomerRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCustomerResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCustomerResValidationError{}
+
+// Validate checks the field values on CreateCustomerReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateCustomerReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCustomerReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCustomerReqMultiError, or nil if none found.
+func (m *CreateCustomerReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCustomerReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCustomer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCustomerReqValidationError{
+					field:  "Customer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCustomerReqValidationError{
+					field:  "Customer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCustomer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCustomerReqValidationError{
+				field:  "Customer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCustomerReqValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCustomerReqValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCustomerReqValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCustomerReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCustomerReqMultiError is an error wrapping multiple validation errors
+// returned by CreateCustomerReq.ValidateAll() if the designated constraints
+// aren't met.
+type CreateCustomerReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCustomerReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCustomerReqMultiError) AllErrors() []error { return m }
+
+// CreateCustomerReqValidationError is the validation error returned by
+// CreateCustomerReq.Validate if the designated constraints aren't met.
+type CreateCustomerReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCustomerReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCustomerReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCustomerReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCustomerReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCustomerReqValidationError) ErrorName() string {
+	return "CreateCustomerReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCustomerReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCustomerReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCustomerReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCustomerReqValidationError{}
+
+// Validate checks the field values on CreateCustomerRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateCustomerRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCustomerRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCustomerResMultiError, or nil if none found.
+func (m *CreateCustomerRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCustomerRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetCustomer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCustomerResValidationError{
+					field:  "Customer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCustomerResValidationError{
+					field:  "Customer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCustomer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCustomerResValidationError{
+				field:  "Customer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCustomerResValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCustomerResValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCustomerResValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCustomerResMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCustomerResMultiError is an error wrapping multiple validation errors
+// returned by CreateCustomerRes.ValidateAll() if the designated constraints
+// aren't met.
+type CreateCustomerResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCustomerResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCustomerResMultiError) AllErrors() []error { return m }
+
+// CreateCustomerResValidationError is the validation error returned by
+// CreateCustomerRes.Validate if the designated constraints aren't met.
+type CreateCustomerResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCustomerResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCustomerResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCustomerResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCustomerResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCustomerResValidationError) ErrorName() string {
+	return "CreateCustomerResValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCustomerResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCustomerRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCustomerResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCustomerResValidationError{}
+
+// Validate checks the field values on UpdateCustomerReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCustomerReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCustomerReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCustomerReqMultiError, or nil if none found.
+func (m *UpdateCustomerReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCustomerReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CustomerId
+
+	for idx, item := range m.GetUpdates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateCustomerReqValidationError{
+						field:  fmt.Sprintf("Updates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateCustomerReqValidationError{
+						field:  fmt.Sprintf("Updates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateCustomerReqValidationError{
+					field:  fmt.Sprintf("Updates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCustomerReqValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCustomerReqValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCustomerReqValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCustomerReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCustomerReqMultiError is an error wrapping multiple validation errors
+// returned by UpdateCustomerReq.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateCustomerReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCustomerReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCustomerReqMultiError) AllErrors() []error { return m }
+
+// UpdateCustomerReqValidationError is the validation error returned by
+// UpdateCustomerReq.Validate if the designated constraints aren't met.
+type UpdateCustomerReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCustomerReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCustomerReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCustomerReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCustomerReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCustomerReqValidationError) ErrorName() string {
+	return "UpdateCustomerReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCustomerReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCustomerReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCustomerReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCustomerReqValidationError{}
+
+// Validate checks the field values on UpdateCustomerRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCustomerRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCustomerRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCustomerResMultiError, or nil if none found.
+func (m *UpdateCustomerRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCustomerRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetCustomer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCustomerResValidationError{
+					field:  "Customer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCustomerResValidationError{
+					field:  "Customer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCustomer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCustomerResValidationError{
+				field:  "Customer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCustomerResValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCustomerResValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCustomerResValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCustomerResMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCustomerResMultiError is an error wrapping multiple validation errors
+// returned by UpdateCustomerRes.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateCustomerResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCustomerResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCustomerResMultiError) AllErrors() []error { return m }
+
+// UpdateCustomerResValidationError is the validation error returned by
+// UpdateCustomerRes.Validate if the designated constraints aren't met.
+type UpdateCustomerResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCustomerResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCustomerResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCustomerResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCustomerResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCustomerResValidationError) ErrorName() string {
+	return "UpdateCustomerResValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCustomerResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCustomerRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCustomerResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCustomerResValidationError{}
+
+// Validate checks the field values on DeleteCustomerReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCustomerReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCustomerReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCustomerReqMultiError, or nil if none found.
+func (m *DeleteCustomerReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCustomerReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CustomerId
+
+	// no validation rules for HardDelete
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteCustomerReqValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteCustomerReqValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteCustomerReqValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteCustomerReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCustomerReqMultiError is an error wrapping multiple validation errors
+// returned by DeleteCustomerReq.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteCustomerReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCustomerReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCustomerReqMultiError) AllErrors() []error { return m }
+
+// DeleteCustomerReqValidationError is the validation error returned by
+// DeleteCustomerReq.Validate if the designated constraints aren't met.
+type DeleteCustomerReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCustomerReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCustomerReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCustomerReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCustomerReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCustomerReqValidationError) ErrorName() string {
+	return "DeleteCustomerReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCustomerReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCustomerReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCustomerReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCustomerReqValidationError{}
+
+// Validate checks the field values on NilRes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NilRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NilRes with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in NilResMultiError, or nil if none found.
+func (m *NilRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NilRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NilResValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NilResValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NilResValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NilResMultiError(errors)
+	}
+
+	return nil
+}
+
+// NilResMultiError is an error wrapping multiple validation errors returned by
+// NilRes.ValidateAll() if the designated constraints aren't met.
+type NilResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NilResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NilResMultiError) AllErrors() []error { return m }
+
+// NilResValidationError is the validation error returned by NilRes.Validate if
+// the designated constraints aren't met.
+type NilResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NilResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NilResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NilResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NilResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NilResValidationError) ErrorName() string { return "NilResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NilResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNilRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NilResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NilResValidationError{}
+
+// Validate checks the field values on XCustomer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *XCustomer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on XCustomer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in XCustomerMultiError, or nil
+// if none found.
+func (m *XCustomer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *XCustomer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PublicId
+
+	// no validation rules for PrivateId
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, XCustomerValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, XCustomerValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return XCustomerValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Email
+
+	// no validation rules for Phone
+
+	if len(errors) > 0 {
+		return XCustomerMultiError(errors)
+	}
+
+	return nil
+}
+
+// XCustomerMultiError is an error wrapping multiple validation errors returned
+// by XCustomer.ValidateAll() if the designated constraints aren't met.
+type XCustomerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m XCustomerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m XCustomerMultiError) AllErrors() []error { return m }
+
+// XCustomerValidationError is the validation error returned by
+// XCustomer.Validate if the designated constraints aren't met.
+type XCustomerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e XCustomerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e XCustomerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e XCustomerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e XCustomerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e XCustomerValidationError) ErrorName() string { return "XCustomerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e XCustomerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sXCustomer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = XCustomerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = XCustomerValidationError{}
+
+// Validate checks the field values on XName with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *XName) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on XName with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in XNameMultiError, or nil if none found.
+func (m *XName) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *XName) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for First
+
+	// no validation rules for Middle
+
+	// no validation rules for Last
+
+	// no validation rules for Suffix
+
+	// no validation rules for Nickname
+
+	// no validation rules for Full
+
+	// no validation rules for Friendly
+
+	if len(errors) > 0 {
+		return XNameMultiError(errors)
+	}
+
+	return nil
+}
+
+// XNameMultiError is an error wrapping multiple validation errors returned by
+// XName.ValidateAll() if the designated constraints aren't met.
+type XNameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m XNameMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m XNameMultiError) AllErrors() []error { return m }
+
+// XNameValidationError is the validation error returned by XName.Validate if
+// the designated constraints aren't met.
+type XNameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e XNameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e XNameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e XNameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e XNameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e XNameValidationError) ErrorName() string { return "XNameValidationError" }
+
+// Error satisfies the builtin error interface
+func (e XNameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sXName.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = XNameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = XNameValidationError{}
+
+// Validate checks the field values on XDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *XDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on XDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in XDiffMultiError, or nil if none found.
+func (m *XDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *XDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for OldValue
+
+	// no validation rules for NewValue
+
+	if len(errors) > 0 {
+		return XDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// XDiffMultiError is an error wrapping multiple validation errors returned by
+// XDiff.ValidateAll() if the designated constraints aren't met.
+type XDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m XDiffMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m XDiffMultiError) AllErrors() []error { return m }
+
+// XDiffValidationError is the validation error returned by XDiff.Validate if
+// the designated constraints aren't met.
+type XDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e XDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e XDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e XDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e XDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e XDiffValidationError) ErrorName() string { return "XDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e XDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sXDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = XDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = XDiffValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
@@ -12,9 +12,9 @@ import (
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.CustomerClient
 }
 
 var (
@@ -22,7 +22,7 @@ var (
 	_ core.Broker      = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -30,37 +30,62 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewCustomerClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.CustomerClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewCustomerClient(conn), nil
+}
+
 func (b *Broker) GetCustomer(ctx context.Context, in *c.GetCustomerReq, opts ...grpc.CallOption) (*c.GetCustomerRes, error) {
-	return b.client.GetCustomer(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetCustomer(ctx, in, opts...)
 }
 
 func (b *Broker) CreateCustomer(ctx context.Context, in *c.CreateCustomerReq, opts ...grpc.CallOption) (*c.CreateCustomerRes, error) {
-	return b.client.CreateCustomer(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CreateCustomer(ctx, in, opts...)
 }
 
 func (b *Broker) UpdateCustomer(ctx context.Context, in *c.UpdateCustomerReq, opts ...grpc.CallOption) (*c.UpdateCustomerRes, error) {
-	return b.client.UpdateCustomer(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.UpdateCustomer(ctx, in, opts...)
 }
 
 func (b *Broker) DeleteCustomer(ctx context.Context, in *c.DeleteCustomerReq, opts ...grpc.CallOption) (*c.NilRes, error) {
-	return b.client.DeleteCustomer(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DeleteCustomer(ctx, in, opts...)
 }
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -24,16 +25,19 @@ const (
 
 // Interest entity
 type Interest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
-	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Active      bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Source      string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Translations of name and description, which hold the default language
+	LocalizedName        *generated.MultilingualText `protobuf:"bytes,8,opt,name=localized_name,json=localizedName,proto3" json:"localized_name,omitempty"`
+	LocalizedDescription *generated.MultilingualText `protobuf:"bytes,9,opt,name=localized_description,json=localizedDescription,proto3" json:"localized_description,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Interest) Reset() {
@@ -115,6 +119,20 @@ func (x *Interest) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Interest) GetLocalizedName() *generated.MultilingualText {
+	if x != nil {
+		return x.LocalizedName
+	}
+	return nil
+}
+
+func (x *Interest) GetLocalizedDescription() *generated.MultilingualText {
+	if x != nil {
+		return x.LocalizedDescription
+	}
+	return nil
+}
+
 // Interest creation parameters
 type CreateInterestParams struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -240,7 +258,7 @@ func (x *UpdateInterestParams) GetActive() bool {
 // Request/Response messages
 type GetAllInterestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -275,7 +293,7 @@ func (*GetAllInterestsRequest) Descriptor() ([]byte, []int) {
 	return file_interests_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetAllInterestsRequest) GetRequest() *BaseRequest {
+func (x *GetAllInterestsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -283,9 +301,9 @@ func (x *GetAllInterestsRequest) GetRequest() *BaseRequest {
 }
 
 type GetAllInterestsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Interests     []*Interest            `protobuf:"bytes,1,rep,name=interests,proto3" json:"interests,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Interests     []*Interest             `protobuf:"bytes,1,rep,name=interests,proto3" json:"interests,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -327,7 +345,7 @@ func (x *GetAllInterestsResponse) GetInterests() []*Interest {
 	return nil
 }
 
-func (x *GetAllInterestsResponse) GetResponse() *BaseResponse {
+func (x *GetAllInterestsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -338,7 +356,7 @@ type CreateInterestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Interest      *CreateInterestParams  `protobuf:"bytes,2,opt,name=interest,proto3" json:"interest,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -387,7 +405,7 @@ func (x *CreateInterestRequest) GetInterest() *CreateInterestParams {
 	return nil
 }
 
-func (x *CreateInterestRequest) GetRequest() *BaseRequest {
+func (x *CreateInterestRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -395,11 +413,11 @@ func (x *CreateInterestRequest) GetRequest() *BaseRequest {
 }
 
 type CreateInterestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Interest      *Interest              `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Interest      *Interest               `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -455,7 +473,7 @@ func (x *CreateInterestResponse) GetInterest() *Interest {
 	return nil
 }
 
-func (x *CreateInterestResponse) GetResponse() *BaseResponse {
+func (x *CreateInterestResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -467,7 +485,7 @@ type UpdateInterestRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	InterestId    string                 `protobuf:"bytes,2,opt,name=interest_id,json=interestId,proto3" json:"interest_id,omitempty"`
 	Interest      *UpdateInterestParams  `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -523,7 +541,7 @@ func (x *UpdateInterestRequest) GetInterest() *UpdateInterestParams {
 	return nil
 }
 
-func (x *UpdateInterestRequest) GetRequest() *BaseRequest {
+func (x *UpdateInterestRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -531,11 +549,11 @@ func (x *UpdateInterestRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateInterestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Interest      *Interest              `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Interest      *Interest               `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -591,7 +609,7 @@ func (x *UpdateInterestResponse) GetInterest() *Interest {
 	return nil
 }
 
-func (x *UpdateInterestResponse) GetResponse() *BaseResponse {
+func (x *UpdateInterestResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -602,7 +620,7 @@ type RemoveInterestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	InterestId    string                 `protobuf:"bytes,2,opt,name=interest_id,json=interestId,proto3" json:"interest_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -651,7 +669,7 @@ func (x *RemoveInterestRequest) GetInterestId() string {
 	return ""
 }
 
-func (x *RemoveInterestRequest) GetRequest() *BaseRequest {
+func (x *RemoveInterestRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -659,10 +677,10 @@ func (x *RemoveInterestRequest) GetRequest() *BaseRequest {
 }
 
 type RemoveInterestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -711,13 +729,17 @@ func (x *RemoveInterestResponse) GetMessage() string {
 	return ""
 }
 
-func (x *RemoveInterestResponse) GetResponse() *BaseResponse {
+func (x *RemoveInterestResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in interests.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -770,6 +792,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in interests.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -834,7 +860,7 @@ var File_interests_proto protoreflect.FileDescriptor
 
 const file_interests_proto_rawDesc = "" +
 	"\n" +
-	"\x0finterests.proto\x12\x13ai_poi.interests.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x01\n" +
+	"\x0finterests.proto\x12\x13ai_poi.interests.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x03\n" +
 	"\bInterest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -844,7 +870,9 @@ const file_interests_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"d\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12I\n" +
+	"\x0elocalized_name\x18\b \x01(\v2\".ai_poi.common.v1.MultilingualTextR\rlocalizedName\x12W\n" +
+	"\x15localized_description\x18\t \x01(\v2\".ai_poi.common.v1.MultilingualTextR\x14localizedDescription\"d\n" +
 	"\x14CreateInterestParams\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
@@ -852,52 +880,52 @@ const file_interests_proto_rawDesc = "" +
 	"\x14UpdateInterestParams\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06active\x18\x03 \x01(\bR\x06active\"T\n" +
-	"\x16GetAllInterestsRequest\x12:\n" +
-	"\arequest\x18d \x01(\v2 .ai_poi.interests.v1.BaseRequestR\arequest\"\x95\x01\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active\"Q\n" +
+	"\x16GetAllInterestsRequest\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x92\x01\n" +
 	"\x17GetAllInterestsResponse\x12;\n" +
-	"\tinterests\x18\x01 \x03(\v2\x1d.ai_poi.interests.v1.InterestR\tinterests\x12=\n" +
-	"\bresponse\x18d \x01(\v2!.ai_poi.interests.v1.BaseResponseR\bresponse\"\xb3\x01\n" +
+	"\tinterests\x18\x01 \x03(\v2\x1d.ai_poi.interests.v1.InterestR\tinterests\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb0\x01\n" +
 	"\x15CreateInterestRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12E\n" +
-	"\binterest\x18\x02 \x01(\v2).ai_poi.interests.v1.CreateInterestParamsR\binterest\x12:\n" +
-	"\arequest\x18d \x01(\v2 .ai_poi.interests.v1.BaseRequestR\arequest\"\xc6\x01\n" +
+	"\binterest\x18\x02 \x01(\v2).ai_poi.interests.v1.CreateInterestParamsR\binterest\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc3\x01\n" +
 	"\x16CreateInterestResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
-	"\binterest\x18\x03 \x01(\v2\x1d.ai_poi.interests.v1.InterestR\binterest\x12=\n" +
-	"\bresponse\x18d \x01(\v2!.ai_poi.interests.v1.BaseResponseR\bresponse\"\xd4\x01\n" +
+	"\binterest\x18\x03 \x01(\v2\x1d.ai_poi.interests.v1.InterestR\binterest\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xd1\x01\n" +
 	"\x15UpdateInterestRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\vinterest_id\x18\x02 \x01(\tR\n" +
 	"interestId\x12E\n" +
-	"\binterest\x18\x03 \x01(\v2).ai_poi.interests.v1.UpdateInterestParamsR\binterest\x12:\n" +
-	"\arequest\x18d \x01(\v2 .ai_poi.interests.v1.BaseRequestR\arequest\"\xc6\x01\n" +
+	"\binterest\x18\x03 \x01(\v2).ai_poi.interests.v1.UpdateInterestParamsR\binterest\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc3\x01\n" +
 	"\x16UpdateInterestResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
-	"\binterest\x18\x03 \x01(\v2\x1d.ai_poi.interests.v1.InterestR\binterest\x12=\n" +
-	"\bresponse\x18d \x01(\v2!.ai_poi.interests.v1.BaseResponseR\bresponse\"\x8d\x01\n" +
+	"\binterest\x18\x03 \x01(\v2\x1d.ai_poi.interests.v1.InterestR\binterest\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8a\x01\n" +
 	"\x15RemoveInterestRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\vinterest_id\x18\x02 \x01(\tR\n" +
-	"interestId\x12:\n" +
-	"\arequest\x18d \x01(\v2 .ai_poi.interests.v1.BaseRequestR\arequest\"\x8b\x01\n" +
+	"interestId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x88\x01\n" +
 	"\x16RemoveInterestResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12=\n" +
-	"\bresponse\x18d \x01(\v2!.ai_poi.interests.v1.BaseResponseR\bresponse\"N\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status2\xc1\x03\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x012\xc1\x03\n" +
 	"\x10InterestsService\x12l\n" +
 	"\x0fGetAllInterests\x12+.ai_poi.interests.v1.GetAllInterestsRequest\x1a,.ai_poi.interests.v1.GetAllInterestsResponse\x12i\n" +
 	"\x0eCreateInterest\x12*.ai_poi.interests.v1.CreateInterestRequest\x1a+.ai_poi.interests.v1.CreateInterestResponse\x12i\n" +
@@ -918,50 +946,55 @@ func file_interests_proto_rawDescGZIP() []byte {
 
 var file_interests_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_interests_proto_goTypes = []any{
-	(*Interest)(nil),                // 0: ai_poi.interests.v1.Interest
-	(*CreateInterestParams)(nil),    // 1: ai_poi.interests.v1.CreateInterestParams
-	(*UpdateInterestParams)(nil),    // 2: ai_poi.interests.v1.UpdateInterestParams
-	(*GetAllInterestsRequest)(nil),  // 3: ai_poi.interests.v1.GetAllInterestsRequest
-	(*GetAllInterestsResponse)(nil), // 4: ai_poi.interests.v1.GetAllInterestsResponse
-	(*CreateInterestRequest)(nil),   // 5: ai_poi.interests.v1.CreateInterestRequest
-	(*CreateInterestResponse)(nil),  // 6: ai_poi.interests.v1.CreateInterestResponse
-	(*UpdateInterestRequest)(nil),   // 7: ai_poi.interests.v1.UpdateInterestRequest
-	(*UpdateInterestResponse)(nil),  // 8: ai_poi.interests.v1.UpdateInterestResponse
-	(*RemoveInterestRequest)(nil),   // 9: ai_poi.interests.v1.RemoveInterestRequest
-	(*RemoveInterestResponse)(nil),  // 10: ai_poi.interests.v1.RemoveInterestResponse
-	(*BaseRequest)(nil),             // 11: ai_poi.interests.v1.BaseRequest
-	(*BaseResponse)(nil),            // 12: ai_poi.interests.v1.BaseResponse
-	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
+	(*Interest)(nil),                   // 0: ai_poi.interests.v1.Interest
+	(*CreateInterestParams)(nil),       // 1: ai_poi.interests.v1.CreateInterestParams
+	(*UpdateInterestParams)(nil),       // 2: ai_poi.interests.v1.UpdateInterestParams
+	(*GetAllInterestsRequest)(nil),     // 3: ai_poi.interests.v1.GetAllInterestsRequest
+	(*GetAllInterestsResponse)(nil),    // 4: ai_poi.interests.v1.GetAllInterestsResponse
+	(*CreateInterestRequest)(nil),      // 5: ai_poi.interests.v1.CreateInterestRequest
+	(*CreateInterestResponse)(nil),     // 6: ai_poi.interests.v1.CreateInterestResponse
+	(*UpdateInterestRequest)(nil),      // 7: ai_poi.interests.v1.UpdateInterestRequest
+	(*UpdateInterestResponse)(nil),     // 8: ai_poi.interests.v1.UpdateInterestResponse
+	(*RemoveInterestRequest)(nil),      // 9: ai_poi.interests.v1.RemoveInterestRequest
+	(*RemoveInterestResponse)(nil),     // 10: ai_poi.interests.v1.RemoveInterestResponse
+	(*BaseRequest)(nil),                // 11: ai_poi.interests.v1.BaseRequest
+	(*BaseResponse)(nil),               // 12: ai_poi.interests.v1.BaseResponse
+	(*timestamppb.Timestamp)(nil),      // 13: google.protobuf.Timestamp
+	(*generated.MultilingualText)(nil), // 14: ai_poi.common.v1.MultilingualText
+	(*generated.BaseRequest)(nil),      // 15: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),     // 16: ai_poi.common.v1.BaseResponse
 }
 var file_interests_proto_depIdxs = []int32{
 	13, // 0: ai_poi.interests.v1.Interest.created_at:type_name -> google.protobuf.Timestamp
 	13, // 1: ai_poi.interests.v1.Interest.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 2: ai_poi.interests.v1.GetAllInterestsRequest.request:type_name -> ai_poi.interests.v1.BaseRequest
-	0,  // 3: ai_poi.interests.v1.GetAllInterestsResponse.interests:type_name -> ai_poi.interests.v1.Interest
-	12, // 4: ai_poi.interests.v1.GetAllInterestsResponse.response:type_name -> ai_poi.interests.v1.BaseResponse
-	1,  // 5: ai_poi.interests.v1.CreateInterestRequest.interest:type_name -> ai_poi.interests.v1.CreateInterestParams
-	11, // 6: ai_poi.interests.v1.CreateInterestRequest.request:type_name -> ai_poi.interests.v1.BaseRequest
-	0,  // 7: ai_poi.interests.v1.CreateInterestResponse.interest:type_name -> ai_poi.interests.v1.Interest
-	12, // 8: ai_poi.interests.v1.CreateInterestResponse.response:type_name -> ai_poi.interests.v1.BaseResponse
-	2,  // 9: ai_poi.interests.v1.UpdateInterestRequest.interest:type_name -> ai_poi.interests.v1.UpdateInterestParams
-	11, // 10: ai_poi.interests.v1.UpdateInterestRequest.request:type_name -> ai_poi.interests.v1.BaseRequest
-	0,  // 11: ai_poi.interests.v1.UpdateInterestResponse.interest:type_name -> ai_poi.interests.v1.Interest
-	12, // 12: ai_poi.interests.v1.UpdateInterestResponse.response:type_name -> ai_poi.interests.v1.BaseResponse
-	11, // 13: ai_poi.interests.v1.RemoveInterestRequest.request:type_name -> ai_poi.interests.v1.BaseRequest
-	12, // 14: ai_poi.interests.v1.RemoveInterestResponse.response:type_name -> ai_poi.interests.v1.BaseResponse
-	3,  // 15: ai_poi.interests.v1.InterestsService.GetAllInterests:input_type -> ai_poi.interests.v1.GetAllInterestsRequest
-	5,  // 16: ai_poi.interests.v1.InterestsService.CreateInterest:input_type -> ai_poi.interests.v1.CreateInterestRequest
-	7,  // 17: ai_poi.interests.v1.InterestsService.UpdateInterest:input_type -> ai_poi.interests.v1.UpdateInterestRequest
-	9,  // 18: ai_poi.interests.v1.InterestsService.RemoveInterest:input_type -> ai_poi.interests.v1.RemoveInterestRequest
-	4,  // 19: ai_poi.interests.v1.InterestsService.GetAllInterests:output_type -> ai_poi.interests.v1.GetAllInterestsResponse
-	6,  // 20: ai_poi.interests.v1.InterestsService.CreateInterest:output_type -> ai_poi.interests.v1.CreateInterestResponse
-	8,  // 21: ai_poi.interests.v1.InterestsService.UpdateInterest:output_type -> ai_poi.interests.v1.UpdateInterestResponse
-	10, // 22: ai_poi.interests.v1.InterestsService.RemoveInterest:output_type -> ai_poi.interests.v1.RemoveInterestResponse
-	19, // [19:23] is the sub-list for method output_type
-	15, // [15:19] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	14, // 2: ai_poi.interests.v1.Interest.localized_name:type_name -> ai_poi.common.v1.MultilingualText
+	14, // 3: ai_poi.interests.v1.Interest.localized_description:type_name -> ai_poi.common.v1.MultilingualText
+	15, // 4: ai_poi.interests.v1.GetAllInterestsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 5: ai_poi.interests.v1.GetAllInterestsResponse.interests:type_name -> ai_poi.interests.v1.Interest
+	16, // 6: ai_poi.interests.v1.GetAllInterestsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	1,  // 7: ai_poi.interests.v1.CreateInterestRequest.interest:type_name -> ai_poi.interests.v1.CreateInterestParams
+	15, // 8: ai_poi.interests.v1.CreateInterestRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 9: ai_poi.interests.v1.CreateInterestResponse.interest:type_name -> ai_poi.interests.v1.Interest
+	16, // 10: ai_poi.interests.v1.CreateInterestResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	2,  // 11: ai_poi.interests.v1.UpdateInterestRequest.interest:type_name -> ai_poi.interests.v1.UpdateInterestParams
+	15, // 12: ai_poi.interests.v1.UpdateInterestRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 13: ai_poi.interests.v1.UpdateInterestResponse.interest:type_name -> ai_poi.interests.v1.Interest
+	16, // 14: ai_poi.interests.v1.UpdateInterestResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	15, // 15: ai_poi.interests.v1.RemoveInterestRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	16, // 16: ai_poi.interests.v1.RemoveInterestResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	3,  // 17: ai_poi.interests.v1.InterestsService.GetAllInterests:input_type -> ai_poi.interests.v1.GetAllInterestsRequest
+	5,  // 18: ai_poi.interests.v1.InterestsService.CreateInterest:input_type -> ai_poi.interests.v1.CreateInterestRequest
+	7,  // 19: ai_poi.interests.v1.InterestsService.UpdateInterest:input_type -> ai_poi.interests.v1.UpdateInterestRequest
+	9,  // 20: ai_poi.interests.v1.InterestsService.RemoveInterest:input_type -> ai_poi.interests.v1.RemoveInterestRequest
+	4,  // 21: ai_poi.interests.v1.InterestsService.GetAllInterests:output_type -> ai_poi.interests.v1.GetAllInterestsResponse
+	6,  // 22: ai_poi.interests.v1.InterestsService.CreateInterest:output_type -> ai_poi.interests.v1.CreateInterestResponse
+	8,  // 23: ai_poi.interests.v1.InterestsService.UpdateInterest:output_type -> ai_poi.interests.v1.UpdateInterestResponse
+	10, // 24: ai_poi.interests.v1.InterestsService.RemoveInterest:output_type -> ai_poi.interests.v1.RemoveInterestResponse
+	21, // [21:25] is the sub-list for method output_type
+	17, // [17:21] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_interests_proto_init() }
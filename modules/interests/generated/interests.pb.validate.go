@@ -0,0 +1,1905 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: interests.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Interest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Interest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Interest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InterestMultiError, or nil
+// if none found.
+func (m *Interest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Interest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Active
+
+	// no validation rules for Source
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InterestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InterestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InterestValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InterestValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InterestValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InterestValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalizedName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InterestValidationError{
+					field:  "LocalizedName",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InterestValidationError{
+					field:  "LocalizedName",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalizedName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InterestValidationError{
+				field:  "LocalizedName",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalizedDescription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InterestValidationError{
+					field:  "LocalizedDescription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InterestValidationError{
+					field:  "LocalizedDescription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalizedDescription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InterestValidationError{
+				field:  "LocalizedDescription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InterestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InterestMultiError is an error wrapping multiple validation errors returned
+// by Interest.ValidateAll() if the designated constraints aren't met.
+type InterestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InterestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InterestMultiError) AllErrors() []error { return m }
+
+// InterestValidationError is the validation error returned by
+// Interest.Validate if the designated constraints aren't met.
+type InterestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InterestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InterestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InterestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InterestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InterestValidationError) ErrorName() string { return "InterestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InterestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInterest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InterestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InterestValidationError{}
+
+// Validate checks the field values on CreateInterestParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateInterestParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInterestParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInterestParamsMultiError, or nil if none found.
+func (m *CreateInterestParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInterestParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Active
+
+	if len(errors) > 0 {
+		return CreateInterestParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInterestParamsMultiError is an error wrapping multiple validation
+// errors returned by CreateInterestParams.ValidateAll() if the designated
+// constraints aren't met.
+type CreateInterestParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInterestParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInterestParamsMultiError) AllErrors() []error { return m }
+
+// CreateInterestParamsValidationError is the validation error returned by
+// CreateInterestParams.Validate if the designated constraints aren't met.
+type CreateInterestParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInterestParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInterestParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInterestParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInterestParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInterestParamsValidationError) ErrorName() string {
+	return "CreateInterestParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateInterestParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInterestParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInterestParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInterestParamsValidationError{}
+
+// Validate checks the field values on UpdateInterestParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateInterestParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateInterestParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateInterestParamsMultiError, or nil if none found.
+func (m *UpdateInterestParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateInterestParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Active
+
+	if len(errors) > 0 {
+		return UpdateInterestParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateInterestParamsMultiError is an error wrapping multiple validation
+// errors returned by UpdateInterestParams.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateInterestParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateInterestParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateInterestParamsMultiError) AllErrors() []error { return m }
+
+// UpdateInterestParamsValidationError is the validation error returned by
+// UpdateInterestParams.Validate if the designated constraints aren't met.
+type UpdateInterestParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateInterestParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateInterestParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateInterestParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateInterestParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateInterestParamsValidationError) ErrorName() string {
+	return "UpdateInterestParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateInterestParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateInterestParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateInterestParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateInterestParamsValidationError{}
+
+// Validate checks the field values on GetAllInterestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllInterestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllInterestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllInterestsRequestMultiError, or nil if none found.
+func (m *GetAllInterestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllInterestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAllInterestsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAllInterestsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAllInterestsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAllInterestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllInterestsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllInterestsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllInterestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllInterestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllInterestsRequestMultiError) AllErrors() []error { return m }
+
+// GetAllInterestsRequestValidationError is the validation error returned by
+// GetAllInterestsRequest.Validate if the designated constraints aren't met.
+type GetAllInterestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllInterestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllInterestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllInterestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllInterestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllInterestsRequestValidationError) ErrorName() string {
+	return "GetAllInterestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllInterestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllInterestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllInterestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllInterestsRequestValidationError{}
+
+// Validate checks the field values on GetAllInterestsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllInterestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllInterestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllInterestsResponseMultiError, or nil if none found.
+func (m *GetAllInterestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllInterestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInterests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllInterestsResponseValidationError{
+						field:  fmt.Sprintf("Interests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllInterestsResponseValidationError{
+						field:  fmt.Sprintf("Interests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllInterestsResponseValidationError{
+					field:  fmt.Sprintf("Interests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAllInterestsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAllInterestsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAllInterestsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAllInterestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllInterestsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAllInterestsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllInterestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllInterestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllInterestsResponseMultiError) AllErrors() []error { return m }
+
+// GetAllInterestsResponseValidationError is the validation error returned by
+// GetAllInterestsResponse.Validate if the designated constraints aren't met.
+type GetAllInterestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllInterestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllInterestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllInterestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllInterestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllInterestsResponseValidationError) ErrorName() string {
+	return "GetAllInterestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllInterestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllInterestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllInterestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllInterestsResponseValidationError{}
+
+// Validate checks the field values on CreateInterestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateInterestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInterestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInterestRequestMultiError, or nil if none found.
+func (m *CreateInterestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInterestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetInterest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInterestRequestValidationError{
+					field:  "Interest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInterestRequestValidationError{
+					field:  "Interest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInterest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInterestRequestValidationError{
+				field:  "Interest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInterestRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInterestRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInterestRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateInterestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInterestRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateInterestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateInterestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInterestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInterestRequestMultiError) AllErrors() []error { return m }
+
+// CreateInterestRequestValidationError is the validation error returned by
+// CreateInterestRequest.Validate if the designated constraints aren't met.
+type CreateInterestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInterestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInterestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInterestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInterestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInterestRequestValidationError) ErrorName() string {
+	return "CreateInterestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateInterestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInterestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInterestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInterestRequestValidationError{}
+
+// Validate checks the field values on CreateInterestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateInterestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInterestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInterestResponseMultiError, or nil if none found.
+func (m *CreateInterestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInterestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetInterest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInterestResponseValidationError{
+					field:  "Interest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInterestResponseValidationError{
+					field:  "Interest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInterest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInterestResponseValidationError{
+				field:  "Interest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInterestResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInterestResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInterestResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateInterestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInterestResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateInterestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateInterestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInterestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInterestResponseMultiError) AllErrors() []error { return m }
+
+// CreateInterestResponseValidationError is the validation error returned by
+// CreateInterestResponse.Validate if the designated constraints aren't met.
+type CreateInterestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInterestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInterestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInterestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInterestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInterestResponseValidationError) ErrorName() string {
+	return "CreateInterestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateInterestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInterestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInterestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInterestResponseValidationError{}
+
+// Validate checks the field values on UpdateInterestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateInterestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateInterestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateInterestRequestMultiError, or nil if none found.
+func (m *UpdateInterestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateInterestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for InterestId
+
+	if all {
+		switch v := interface{}(m.GetInterest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateInterestRequestValidationError{
+					field:  "Interest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateInterestRequestValidationError{
+					field:  "Interest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInterest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateInterestRequestValidationError{
+				field:  "Interest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateInterestRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateInterestRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateInterestRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateInterestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateInterestRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateInterestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateInterestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateInterestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateInterestRequestMultiError) AllErrors() []error { return m }
+
+// UpdateInterestRequestValidationError is the validation error returned by
+// UpdateInterestRequest.Validate if the designated constraints aren't met.
+type UpdateInterestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateInterestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateInterestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateInterestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateInterestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateInterestRequestValidationError) ErrorName() string {
+	return "UpdateInterestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateInterestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateInterestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateInterestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateInterestRequestValidationError{}
+
+// Validate checks the field values on UpdateInterestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateInterestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateInterestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateInterestResponseMultiError, or nil if none found.
+func (m *UpdateInterestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateInterestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetInterest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateInterestResponseValidationError{
+					field:  "Interest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateInterestResponseValidationError{
+					field:  "Interest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInterest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateInterestResponseValidationError{
+				field:  "Interest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateInterestResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateInterestResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateInterestResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateInterestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateInterestResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateInterestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateInterestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateInterestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateInterestResponseMultiError) AllErrors() []error { return m }
+
+// UpdateInterestResponseValidationError is the validation error returned by
+// UpdateInterestResponse.Validate if the designated constraints aren't met.
+type UpdateInterestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateInterestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateInterestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateInterestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateInterestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateInterestResponseValidationError) ErrorName() string {
+	return "UpdateInterestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateInterestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateInterestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateInterestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateInterestResponseValidationError{}
+
+// Validate checks the field values on RemoveInterestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveInterestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveInterestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveInterestRequestMultiError, or nil if none found.
+func (m *RemoveInterestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveInterestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for InterestId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveInterestRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveInterestRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveInterestRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveInterestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveInterestRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveInterestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveInterestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveInterestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveInterestRequestMultiError) AllErrors() []error { return m }
+
+// RemoveInterestRequestValidationError is the validation error returned by
+// RemoveInterestRequest.Validate if the designated constraints aren't met.
+type RemoveInterestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveInterestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveInterestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveInterestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveInterestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveInterestRequestValidationError) ErrorName() string {
+	return "RemoveInterestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveInterestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveInterestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveInterestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveInterestRequestValidationError{}
+
+// Validate checks the field values on RemoveInterestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveInterestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveInterestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveInterestResponseMultiError, or nil if none found.
+func (m *RemoveInterestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveInterestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveInterestResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveInterestResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveInterestResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveInterestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveInterestResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveInterestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveInterestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveInterestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveInterestResponseMultiError) AllErrors() []error { return m }
+
+// RemoveInterestResponseValidationError is the validation error returned by
+// RemoveInterestResponse.Validate if the designated constraints aren't met.
+type RemoveInterestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveInterestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveInterestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveInterestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveInterestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveInterestResponseValidationError) ErrorName() string {
+	return "RemoveInterestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveInterestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveInterestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveInterestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveInterestResponseValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
@@ -12,9 +12,9 @@ import (
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.InterestsServiceClient
 }
 
 var (
@@ -22,7 +22,7 @@ var (
 	_ core.Broker              = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -30,37 +30,62 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewInterestsServiceClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.InterestsServiceClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewInterestsServiceClient(conn), nil
+}
+
 func (b *Broker) GetAllInterests(ctx context.Context, in *c.GetAllInterestsRequest, opts ...grpc.CallOption) (*c.GetAllInterestsResponse, error) {
-	return b.client.GetAllInterests(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetAllInterests(ctx, in, opts...)
 }
 
 func (b *Broker) CreateInterest(ctx context.Context, in *c.CreateInterestRequest, opts ...grpc.CallOption) (*c.CreateInterestResponse, error) {
-	return b.client.CreateInterest(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CreateInterest(ctx, in, opts...)
 }
 
 func (b *Broker) UpdateInterest(ctx context.Context, in *c.UpdateInterestRequest, opts ...grpc.CallOption) (*c.UpdateInterestResponse, error) {
-	return b.client.UpdateInterest(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.UpdateInterest(ctx, in, opts...)
 }
 
 func (b *Broker) RemoveInterest(ctx context.Context, in *c.RemoveInterestRequest, opts ...grpc.CallOption) (*c.RemoveInterestResponse, error) {
-	return b.client.RemoveInterest(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.RemoveInterest(ctx, in, opts...)
 }
@@ -0,0 +1,54 @@
+package list
+
+import (
+	helpers "github.com/FACorreiaa/loci-proto/modules/common"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	c "github.com/FACorreiaa/loci-proto/modules/list/generated"
+)
+
+// POIToCommon converts a POIDetailedInfo to the canonical POI. The
+// conversion is lossless: POIFromCommon turns the result back into a message
+// equal to p.
+func POIToCommon(p *c.POIDetailedInfo) *common.POI {
+	if p == nil {
+		return nil
+	}
+
+	return &common.POI{
+		Id:          p.GetId(),
+		Name:        p.GetName(),
+		Location:    helpers.NewPOILocation(p.GetLatitude(), p.GetLongitude()),
+		Category:    p.GetCategory(),
+		Description: p.GetDescription(),
+		Rating:      helpers.NewPOIRating(p.GetRating(), p.GetReviewCount()),
+		PriceRange:  helpers.PriceRangeFromLabel(p.GetPriceRange()),
+		PriceLabel:  p.GetPriceRange(),
+		Address:     helpers.NewPOIAddress(p.GetAddress(), "", ""),
+		Contact:     helpers.NewPOIContact(p.GetPhone(), "", p.GetWebsite()),
+		Media:       helpers.MediaFromURLs(p.GetPhotos()),
+	}
+}
+
+// POIFromCommon converts a canonical POI to a POIDetailedInfo, dropping the
+// fields it has no room for.
+func POIFromCommon(p *common.POI) *c.POIDetailedInfo {
+	if p == nil {
+		return nil
+	}
+
+	return &c.POIDetailedInfo{
+		Id:          p.GetId(),
+		Name:        p.GetName(),
+		Latitude:    p.GetLocation().GetLatitude(),
+		Longitude:   p.GetLocation().GetLongitude(),
+		Category:    p.GetCategory(),
+		Description: p.GetDescription(),
+		Rating:      p.GetRating().GetAverage(),
+		ReviewCount: p.GetRating().GetCount(),
+		PriceRange:  helpers.PriceLabel(p),
+		Address:     helpers.FormatAddress(p.GetAddress()),
+		Phone:       p.GetContact().GetPhone(),
+		Website:     p.GetContact().GetWebsite(),
+		Photos:      helpers.PhotoURLs(p.GetMedia()),
+	}
+}
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -964,7 +965,7 @@ type CreateListRequest struct {
 	CityId        string                 `protobuf:"bytes,4,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
 	IsItinerary   bool                   `protobuf:"varint,5,opt,name=is_itinerary,json=isItinerary,proto3" json:"is_itinerary,omitempty"`
 	IsPublic      bool                   `protobuf:"varint,6,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1041,7 +1042,7 @@ func (x *CreateListRequest) GetIsPublic() bool {
 	return false
 }
 
-func (x *CreateListRequest) GetRequest() *BaseRequest {
+func (x *CreateListRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1049,11 +1050,11 @@ func (x *CreateListRequest) GetRequest() *BaseRequest {
 }
 
 type CreateListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	List          *List                  `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	List          *List                   `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1109,7 +1110,7 @@ func (x *CreateListResponse) GetList() *List {
 	return nil
 }
 
-func (x *CreateListResponse) GetResponse() *BaseResponse {
+func (x *CreateListResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1122,7 +1123,7 @@ type GetListsRequest struct {
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	IncludeItems  bool                   `protobuf:"varint,4,opt,name=include_items,json=includeItems,proto3" json:"include_items,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1185,7 +1186,7 @@ func (x *GetListsRequest) GetIncludeItems() bool {
 	return false
 }
 
-func (x *GetListsRequest) GetRequest() *BaseRequest {
+func (x *GetListsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1193,10 +1194,10 @@ func (x *GetListsRequest) GetRequest() *BaseRequest {
 }
 
 type GetListsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lists         []*ListWithItems       `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Lists         []*ListWithItems        `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1245,7 +1246,7 @@ func (x *GetListsResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *GetListsResponse) GetResponse() *BaseResponse {
+func (x *GetListsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1257,7 +1258,7 @@ type GetListRequest struct {
 	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ListId               string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
 	IncludeDetailedItems bool                   `protobuf:"varint,3,opt,name=include_detailed_items,json=includeDetailedItems,proto3" json:"include_detailed_items,omitempty"`
-	Request              *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request              *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -1313,7 +1314,7 @@ func (x *GetListRequest) GetIncludeDetailedItems() bool {
 	return false
 }
 
-func (x *GetListRequest) GetRequest() *BaseRequest {
+func (x *GetListRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1321,9 +1322,9 @@ func (x *GetListRequest) GetRequest() *BaseRequest {
 }
 
 type GetListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          *ListWithDetailedItems `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	List          *ListWithDetailedItems  `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1365,7 +1366,7 @@ func (x *GetListResponse) GetList() *ListWithDetailedItems {
 	return nil
 }
 
-func (x *GetListResponse) GetResponse() *BaseResponse {
+func (x *GetListResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1381,7 +1382,7 @@ type UpdateListRequest struct {
 	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
 	IsPublic      bool                   `protobuf:"varint,6,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
 	CityId        string                 `protobuf:"bytes,7,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1465,7 +1466,7 @@ func (x *UpdateListRequest) GetCityId() string {
 	return ""
 }
 
-func (x *UpdateListRequest) GetRequest() *BaseRequest {
+func (x *UpdateListRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1473,11 +1474,11 @@ func (x *UpdateListRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	List          *List                  `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	List          *List                   `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1533,7 +1534,7 @@ func (x *UpdateListResponse) GetList() *List {
 	return nil
 }
 
-func (x *UpdateListResponse) GetResponse() *BaseResponse {
+func (x *UpdateListResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1544,7 +1545,7 @@ type DeleteListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1593,7 +1594,7 @@ func (x *DeleteListRequest) GetListId() string {
 	return ""
 }
 
-func (x *DeleteListRequest) GetRequest() *BaseRequest {
+func (x *DeleteListRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1601,10 +1602,10 @@ func (x *DeleteListRequest) GetRequest() *BaseRequest {
 }
 
 type DeleteListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1653,7 +1654,7 @@ func (x *DeleteListResponse) GetMessage() string {
 	return ""
 }
 
-func (x *DeleteListResponse) GetResponse() *BaseResponse {
+func (x *DeleteListResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1668,7 +1669,7 @@ type CreateItineraryRequest struct {
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	IsPublic      bool                   `protobuf:"varint,5,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1738,7 +1739,7 @@ func (x *CreateItineraryRequest) GetIsPublic() bool {
 	return false
 }
 
-func (x *CreateItineraryRequest) GetRequest() *BaseRequest {
+func (x *CreateItineraryRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1746,11 +1747,11 @@ func (x *CreateItineraryRequest) GetRequest() *BaseRequest {
 }
 
 type CreateItineraryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Itinerary     *List                  `protobuf:"bytes,3,opt,name=itinerary,proto3" json:"itinerary,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Itinerary     *List                   `protobuf:"bytes,3,opt,name=itinerary,proto3" json:"itinerary,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1806,7 +1807,7 @@ func (x *CreateItineraryResponse) GetItinerary() *List {
 	return nil
 }
 
-func (x *CreateItineraryResponse) GetResponse() *BaseResponse {
+func (x *CreateItineraryResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1827,7 +1828,7 @@ type AddListItemRequest struct {
 	DurationMinutes        int32                  `protobuf:"varint,9,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
 	SourceLlmInteractionId string                 `protobuf:"bytes,10,opt,name=source_llm_interaction_id,json=sourceLlmInteractionId,proto3" json:"source_llm_interaction_id,omitempty"`
 	ItemAiDescription      string                 `protobuf:"bytes,11,opt,name=item_ai_description,json=itemAiDescription,proto3" json:"item_ai_description,omitempty"`
-	Request                *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request                *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -1939,7 +1940,7 @@ func (x *AddListItemRequest) GetItemAiDescription() string {
 	return ""
 }
 
-func (x *AddListItemRequest) GetRequest() *BaseRequest {
+func (x *AddListItemRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1947,11 +1948,11 @@ func (x *AddListItemRequest) GetRequest() *BaseRequest {
 }
 
 type AddListItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item          *ListItem              `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item          *ListItem               `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2007,7 +2008,7 @@ func (x *AddListItemResponse) GetItem() *ListItem {
 	return nil
 }
 
-func (x *AddListItemResponse) GetResponse() *BaseResponse {
+func (x *AddListItemResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2027,7 +2028,7 @@ type UpdateListItemRequest struct {
 	DurationMinutes        int32                  `protobuf:"varint,9,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
 	SourceLlmInteractionId string                 `protobuf:"bytes,10,opt,name=source_llm_interaction_id,json=sourceLlmInteractionId,proto3" json:"source_llm_interaction_id,omitempty"`
 	ItemAiDescription      string                 `protobuf:"bytes,11,opt,name=item_ai_description,json=itemAiDescription,proto3" json:"item_ai_description,omitempty"`
-	Request                *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request                *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -2139,7 +2140,7 @@ func (x *UpdateListItemRequest) GetItemAiDescription() string {
 	return ""
 }
 
-func (x *UpdateListItemRequest) GetRequest() *BaseRequest {
+func (x *UpdateListItemRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2147,11 +2148,11 @@ func (x *UpdateListItemRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateListItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item          *ListItem              `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item          *ListItem               `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2207,7 +2208,7 @@ func (x *UpdateListItemResponse) GetItem() *ListItem {
 	return nil
 }
 
-func (x *UpdateListItemResponse) GetResponse() *BaseResponse {
+func (x *UpdateListItemResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2220,7 +2221,7 @@ type RemoveListItemRequest struct {
 	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
 	ItemId        string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 	ContentType   ContentType            `protobuf:"varint,4,opt,name=content_type,json=contentType,proto3,enum=ai_poi.list.v1.ContentType" json:"content_type,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2283,7 +2284,7 @@ func (x *RemoveListItemRequest) GetContentType() ContentType {
 	return ContentType_CONTENT_TYPE_UNSPECIFIED
 }
 
-func (x *RemoveListItemRequest) GetRequest() *BaseRequest {
+func (x *RemoveListItemRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2291,10 +2292,10 @@ func (x *RemoveListItemRequest) GetRequest() *BaseRequest {
 }
 
 type RemoveListItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2343,7 +2344,7 @@ func (x *RemoveListItemResponse) GetMessage() string {
 	return ""
 }
 
-func (x *RemoveListItemResponse) GetResponse() *BaseResponse {
+func (x *RemoveListItemResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2355,7 +2356,7 @@ type GetListItemsRequest struct {
 	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ListId                string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
 	IncludeContentDetails bool                   `protobuf:"varint,3,opt,name=include_content_details,json=includeContentDetails,proto3" json:"include_content_details,omitempty"`
-	Request               *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request               *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -2411,7 +2412,7 @@ func (x *GetListItemsRequest) GetIncludeContentDetails() bool {
 	return false
 }
 
-func (x *GetListItemsRequest) GetRequest() *BaseRequest {
+func (x *GetListItemsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2419,10 +2420,10 @@ func (x *GetListItemsRequest) GetRequest() *BaseRequest {
 }
 
 type GetListItemsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*ListItemWithContent `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*ListItemWithContent  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2471,7 +2472,7 @@ func (x *GetListItemsResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *GetListItemsResponse) GetResponse() *BaseResponse {
+func (x *GetListItemsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2483,7 +2484,7 @@ type GetListRestaurantsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2532,7 +2533,7 @@ func (x *GetListRestaurantsRequest) GetListId() string {
 	return ""
 }
 
-func (x *GetListRestaurantsRequest) GetRequest() *BaseRequest {
+func (x *GetListRestaurantsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2542,7 +2543,7 @@ func (x *GetListRestaurantsRequest) GetRequest() *BaseRequest {
 type GetListRestaurantsResponse struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Restaurants   []*RestaurantDetailedInfo `protobuf:"bytes,1,rep,name=restaurants,proto3" json:"restaurants,omitempty"`
-	Response      *BaseResponse             `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Response      *generated.BaseResponse   `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2584,7 +2585,7 @@ func (x *GetListRestaurantsResponse) GetRestaurants() []*RestaurantDetailedInfo
 	return nil
 }
 
-func (x *GetListRestaurantsResponse) GetResponse() *BaseResponse {
+func (x *GetListRestaurantsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2595,7 +2596,7 @@ type GetListHotelsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2644,7 +2645,7 @@ func (x *GetListHotelsRequest) GetListId() string {
 	return ""
 }
 
-func (x *GetListHotelsRequest) GetRequest() *BaseRequest {
+func (x *GetListHotelsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2652,9 +2653,9 @@ func (x *GetListHotelsRequest) GetRequest() *BaseRequest {
 }
 
 type GetListHotelsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hotels        []*HotelDetailedInfo   `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Hotels        []*HotelDetailedInfo    `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2696,7 +2697,7 @@ func (x *GetListHotelsResponse) GetHotels() []*HotelDetailedInfo {
 	return nil
 }
 
-func (x *GetListHotelsResponse) GetResponse() *BaseResponse {
+func (x *GetListHotelsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2707,7 +2708,7 @@ type GetListItinerariesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2756,7 +2757,7 @@ func (x *GetListItinerariesRequest) GetListId() string {
 	return ""
 }
 
-func (x *GetListItinerariesRequest) GetRequest() *BaseRequest {
+func (x *GetListItinerariesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2764,9 +2765,9 @@ func (x *GetListItinerariesRequest) GetRequest() *BaseRequest {
 }
 
 type GetListItinerariesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Itineraries   []*UserSavedItinerary  `protobuf:"bytes,1,rep,name=itineraries,proto3" json:"itineraries,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Itineraries   []*UserSavedItinerary   `protobuf:"bytes,1,rep,name=itineraries,proto3" json:"itineraries,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2808,7 +2809,7 @@ func (x *GetListItinerariesResponse) GetItineraries() []*UserSavedItinerary {
 	return nil
 }
 
-func (x *GetListItinerariesResponse) GetResponse() *BaseResponse {
+func (x *GetListItinerariesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2820,7 +2821,7 @@ type SavePublicListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2869,7 +2870,7 @@ func (x *SavePublicListRequest) GetListId() string {
 	return ""
 }
 
-func (x *SavePublicListRequest) GetRequest() *BaseRequest {
+func (x *SavePublicListRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2877,10 +2878,10 @@ func (x *SavePublicListRequest) GetRequest() *BaseRequest {
 }
 
 type SavePublicListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2929,7 +2930,7 @@ func (x *SavePublicListResponse) GetMessage() string {
 	return ""
 }
 
-func (x *SavePublicListResponse) GetResponse() *BaseResponse {
+func (x *SavePublicListResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2940,7 +2941,7 @@ type UnsaveListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2989,7 +2990,7 @@ func (x *UnsaveListRequest) GetListId() string {
 	return ""
 }
 
-func (x *UnsaveListRequest) GetRequest() *BaseRequest {
+func (x *UnsaveListRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2997,10 +2998,10 @@ func (x *UnsaveListRequest) GetRequest() *BaseRequest {
 }
 
 type UnsaveListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3049,7 +3050,7 @@ func (x *UnsaveListResponse) GetMessage() string {
 	return ""
 }
 
-func (x *UnsaveListResponse) GetResponse() *BaseResponse {
+func (x *UnsaveListResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -3061,7 +3062,7 @@ type GetSavedListsRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3117,7 +3118,7 @@ func (x *GetSavedListsRequest) GetOffset() int32 {
 	return 0
 }
 
-func (x *GetSavedListsRequest) GetRequest() *BaseRequest {
+func (x *GetSavedListsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -3125,10 +3126,10 @@ func (x *GetSavedListsRequest) GetRequest() *BaseRequest {
 }
 
 type GetSavedListsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lists         []*ListWithItems       `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Lists         []*ListWithItems        `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3177,7 +3178,7 @@ func (x *GetSavedListsResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *GetSavedListsResponse) GetResponse() *BaseResponse {
+func (x *GetSavedListsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -3192,7 +3193,7 @@ type SearchPublicListsRequest struct {
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 	SortBy        string                 `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // "popularity", "recent", "name"
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3269,7 +3270,7 @@ func (x *SearchPublicListsRequest) GetSortBy() string {
 	return ""
 }
 
-func (x *SearchPublicListsRequest) GetRequest() *BaseRequest {
+func (x *SearchPublicListsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -3277,11 +3278,11 @@ func (x *SearchPublicListsRequest) GetRequest() *BaseRequest {
 }
 
 type SearchPublicListsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lists         []*ListWithItems       `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Metadata      *SearchMetadata        `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Lists         []*ListWithItems        `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Metadata      *SearchMetadata         `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3337,7 +3338,7 @@ func (x *SearchPublicListsResponse) GetMetadata() *SearchMetadata {
 	return nil
 }
 
-func (x *SearchPublicListsResponse) GetResponse() *BaseResponse {
+func (x *SearchPublicListsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -3404,6 +3405,10 @@ func (x *SearchMetadata) GetFiltersApplied() map[string]string {
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in list.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -3456,6 +3461,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in list.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -3521,7 +3530,7 @@ var File_list_proto protoreflect.FileDescriptor
 const file_list_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"list.proto\x12\x0eai_poi.list.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x03\n" +
+	"list.proto\x12\x0eai_poi.list.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x03\n" +
 	"\x04List\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
@@ -3618,39 +3627,39 @@ const file_list_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf2\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf4\x01\n" +
 	"\x11CreateListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x17\n" +
 	"\acity_id\x18\x04 \x01(\tR\x06cityId\x12!\n" +
 	"\fis_itinerary\x18\x05 \x01(\bR\visItinerary\x12\x1b\n" +
-	"\tis_public\x18\x06 \x01(\bR\bisPublic\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\xac\x01\n" +
+	"\tis_public\x18\x06 \x01(\bR\bisPublic\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xae\x01\n" +
 	"\x12CreateListResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
-	"\x04list\x18\x03 \x01(\v2\x14.ai_poi.list.v1.ListR\x04list\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\xb4\x01\n" +
+	"\x04list\x18\x03 \x01(\v2\x14.ai_poi.list.v1.ListR\x04list\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb6\x01\n" +
 	"\x0fGetListsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12#\n" +
-	"\rinclude_items\x18\x04 \x01(\bR\fincludeItems\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\xa2\x01\n" +
+	"\rinclude_items\x18\x04 \x01(\bR\fincludeItems\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xa4\x01\n" +
 	"\x10GetListsResponse\x123\n" +
 	"\x05lists\x18\x01 \x03(\v2\x1d.ai_poi.list.v1.ListWithItemsR\x05lists\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\xaf\x01\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb1\x01\n" +
 	"\x0eGetListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\alist_id\x18\x02 \x01(\tR\x06listId\x124\n" +
-	"\x16include_detailed_items\x18\x03 \x01(\bR\x14includeDetailedItems\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\x86\x01\n" +
+	"\x16include_detailed_items\x18\x03 \x01(\bR\x14includeDetailedItems\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x88\x01\n" +
 	"\x0fGetListResponse\x129\n" +
-	"\x04list\x18\x01 \x01(\v2%.ai_poi.list.v1.ListWithDetailedItemsR\x04list\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\x85\x02\n" +
+	"\x04list\x18\x01 \x01(\v2%.ai_poi.list.v1.ListWithDetailedItemsR\x04list\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x87\x02\n" +
 	"\x11UpdateListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\alist_id\x18\x02 \x01(\tR\x06listId\x12\x12\n" +
@@ -3658,33 +3667,33 @@ const file_list_proto_rawDesc = "" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
 	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x1b\n" +
 	"\tis_public\x18\x06 \x01(\bR\bisPublic\x12\x17\n" +
-	"\acity_id\x18\a \x01(\tR\x06cityId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\xac\x01\n" +
+	"\acity_id\x18\a \x01(\tR\x06cityId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xae\x01\n" +
 	"\x12UpdateListResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
-	"\x04list\x18\x03 \x01(\v2\x14.ai_poi.list.v1.ListR\x04list\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"|\n" +
+	"\x04list\x18\x03 \x01(\v2\x14.ai_poi.list.v1.ListR\x04list\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"~\n" +
 	"\x11DeleteListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\alist_id\x18\x02 \x01(\tR\x06listId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\x82\x01\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x84\x01\n" +
 	"\x12DeleteListResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\xe1\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xe3\x01\n" +
 	"\x16CreateItineraryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
 	"\x0eparent_list_id\x18\x02 \x01(\tR\fparentListId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
-	"\tis_public\x18\x05 \x01(\bR\bisPublic\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\xbb\x01\n" +
+	"\tis_public\x18\x05 \x01(\bR\bisPublic\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xbd\x01\n" +
 	"\x17CreateItineraryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
-	"\titinerary\x18\x03 \x01(\v2\x14.ai_poi.list.v1.ListR\titinerary\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\xf6\x03\n" +
+	"\titinerary\x18\x03 \x01(\v2\x14.ai_poi.list.v1.ListR\titinerary\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xf8\x03\n" +
 	"\x12AddListItemRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\alist_id\x18\x02 \x01(\tR\x06listId\x12\x17\n" +
@@ -3698,13 +3707,13 @@ const file_list_proto_rawDesc = "" +
 	"\x10duration_minutes\x18\t \x01(\x05R\x0fdurationMinutes\x129\n" +
 	"\x19source_llm_interaction_id\x18\n" +
 	" \x01(\tR\x16sourceLlmInteractionId\x12.\n" +
-	"\x13item_ai_description\x18\v \x01(\tR\x11itemAiDescription\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\xb1\x01\n" +
+	"\x13item_ai_description\x18\v \x01(\tR\x11itemAiDescription\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xb3\x01\n" +
 	"\x13AddListItemResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
-	"\x04item\x18\x03 \x01(\v2\x18.ai_poi.list.v1.ListItemR\x04item\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\xf9\x03\n" +
+	"\x04item\x18\x03 \x01(\v2\x18.ai_poi.list.v1.ListItemR\x04item\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xfb\x03\n" +
 	"\x15UpdateListItemRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\alist_id\x18\x02 \x01(\tR\x06listId\x12\x17\n" +
@@ -3718,80 +3727,80 @@ const file_list_proto_rawDesc = "" +
 	"\x10duration_minutes\x18\t \x01(\x05R\x0fdurationMinutes\x129\n" +
 	"\x19source_llm_interaction_id\x18\n" +
 	" \x01(\tR\x16sourceLlmInteractionId\x12.\n" +
-	"\x13item_ai_description\x18\v \x01(\tR\x11itemAiDescription\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\xb4\x01\n" +
+	"\x13item_ai_description\x18\v \x01(\tR\x11itemAiDescription\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xb6\x01\n" +
 	"\x16UpdateListItemResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
-	"\x04item\x18\x03 \x01(\v2\x18.ai_poi.list.v1.ListItemR\x04item\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\xd9\x01\n" +
+	"\x04item\x18\x03 \x01(\v2\x18.ai_poi.list.v1.ListItemR\x04item\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xdb\x01\n" +
 	"\x15RemoveListItemRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\alist_id\x18\x02 \x01(\tR\x06listId\x12\x17\n" +
 	"\aitem_id\x18\x03 \x01(\tR\x06itemId\x12>\n" +
-	"\fcontent_type\x18\x04 \x01(\x0e2\x1b.ai_poi.list.v1.ContentTypeR\vcontentType\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\x86\x01\n" +
+	"\fcontent_type\x18\x04 \x01(\x0e2\x1b.ai_poi.list.v1.ContentTypeR\vcontentType\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x88\x01\n" +
 	"\x16RemoveListItemResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\xb6\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb8\x01\n" +
 	"\x13GetListItemsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\alist_id\x18\x02 \x01(\tR\x06listId\x126\n" +
-	"\x17include_content_details\x18\x03 \x01(\bR\x15includeContentDetails\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\xac\x01\n" +
+	"\x17include_content_details\x18\x03 \x01(\bR\x15includeContentDetails\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xae\x01\n" +
 	"\x14GetListItemsResponse\x129\n" +
 	"\x05items\x18\x01 \x03(\v2#.ai_poi.list.v1.ListItemWithContentR\x05items\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\x84\x01\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x86\x01\n" +
 	"\x19GetListRestaurantsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\alist_id\x18\x02 \x01(\tR\x06listId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\xa0\x01\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xa2\x01\n" +
 	"\x1aGetListRestaurantsResponse\x12H\n" +
-	"\vrestaurants\x18\x01 \x03(\v2&.ai_poi.list.v1.RestaurantDetailedInfoR\vrestaurants\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\x7f\n" +
+	"\vrestaurants\x18\x01 \x03(\v2&.ai_poi.list.v1.RestaurantDetailedInfoR\vrestaurants\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x81\x01\n" +
 	"\x14GetListHotelsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\alist_id\x18\x02 \x01(\tR\x06listId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\x8c\x01\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x8e\x01\n" +
 	"\x15GetListHotelsResponse\x129\n" +
-	"\x06hotels\x18\x01 \x03(\v2!.ai_poi.list.v1.HotelDetailedInfoR\x06hotels\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\x84\x01\n" +
+	"\x06hotels\x18\x01 \x03(\v2!.ai_poi.list.v1.HotelDetailedInfoR\x06hotels\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x86\x01\n" +
 	"\x19GetListItinerariesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\alist_id\x18\x02 \x01(\tR\x06listId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\x9c\x01\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x9e\x01\n" +
 	"\x1aGetListItinerariesResponse\x12D\n" +
-	"\vitineraries\x18\x01 \x03(\v2\".ai_poi.list.v1.UserSavedItineraryR\vitineraries\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\x80\x01\n" +
+	"\vitineraries\x18\x01 \x03(\v2\".ai_poi.list.v1.UserSavedItineraryR\vitineraries\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x82\x01\n" +
 	"\x15SavePublicListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\alist_id\x18\x02 \x01(\tR\x06listId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\x86\x01\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x88\x01\n" +
 	"\x16SavePublicListResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"|\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"~\n" +
 	"\x11UnsaveListRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\alist_id\x18\x02 \x01(\tR\x06listId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\x82\x01\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x84\x01\n" +
 	"\x12UnsaveListResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\x94\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x96\x01\n" +
 	"\x14GetSavedListsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\xa7\x01\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xa9\x01\n" +
 	"\x15GetSavedListsResponse\x123\n" +
 	"\x05lists\x18\x01 \x03(\v2\x1d.ai_poi.list.v1.ListWithItemsR\x05lists\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\xe7\x01\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xe9\x01\n" +
 	"\x18SearchPublicListsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x17\n" +
 	"\acity_id\x18\x02 \x01(\tR\x06cityId\x12\x1e\n" +
@@ -3800,32 +3809,32 @@ const file_list_proto_rawDesc = "" +
 	"categories\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\x17\n" +
-	"\asort_by\x18\x06 \x01(\tR\x06sortBy\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.list.v1.BaseRequestR\arequest\"\xe7\x01\n" +
+	"\asort_by\x18\x06 \x01(\tR\x06sortBy\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xe9\x01\n" +
 	"\x19SearchPublicListsResponse\x123\n" +
 	"\x05lists\x18\x01 \x03(\v2\x1d.ai_poi.list.v1.ListWithItemsR\x05lists\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12:\n" +
-	"\bmetadata\x18\x03 \x01(\v2\x1e.ai_poi.list.v1.SearchMetadataR\bmetadata\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.list.v1.BaseResponseR\bresponse\"\xf9\x01\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1e.ai_poi.list.v1.SearchMetadataR\bmetadata\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xf9\x01\n" +
 	"\x0eSearchMetadata\x12\"\n" +
 	"\rquery_time_ms\x18\x01 \x01(\x01R\vqueryTimeMs\x12#\n" +
 	"\rsearch_method\x18\x02 \x01(\tR\fsearchMethod\x12[\n" +
 	"\x0ffilters_applied\x18\x03 \x03(\v22.ai_poi.list.v1.SearchMetadata.FiltersAppliedEntryR\x0efiltersApplied\x1aA\n" +
 	"\x13FiltersAppliedEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status*\x92\x01\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x01*\x92\x01\n" +
 	"\vContentType\x12\x1c\n" +
 	"\x18CONTENT_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10CONTENT_TYPE_POI\x10\x01\x12\x1b\n" +
@@ -3919,6 +3928,8 @@ var file_list_proto_goTypes = []any{
 	(*BaseResponse)(nil),               // 46: ai_poi.list.v1.BaseResponse
 	nil,                                // 47: ai_poi.list.v1.SearchMetadata.FiltersAppliedEntry
 	(*timestamppb.Timestamp)(nil),      // 48: google.protobuf.Timestamp
+	(*generated.BaseRequest)(nil),      // 49: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),     // 50: ai_poi.common.v1.BaseResponse
 }
 var file_list_proto_depIdxs = []int32{
 	48, // 0: ai_poi.list.v1.List.created_at:type_name -> google.protobuf.Timestamp
@@ -3940,59 +3951,59 @@ var file_list_proto_depIdxs = []int32{
 	6,  // 16: ai_poi.list.v1.HotelDetailedInfo.poi:type_name -> ai_poi.list.v1.POIDetailedInfo
 	48, // 17: ai_poi.list.v1.UserSavedItinerary.created_at:type_name -> google.protobuf.Timestamp
 	48, // 18: ai_poi.list.v1.UserSavedItinerary.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 19: ai_poi.list.v1.CreateListRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	49, // 19: ai_poi.list.v1.CreateListRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	1,  // 20: ai_poi.list.v1.CreateListResponse.list:type_name -> ai_poi.list.v1.List
-	46, // 21: ai_poi.list.v1.CreateListResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 22: ai_poi.list.v1.GetListsRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	50, // 21: ai_poi.list.v1.CreateListResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 22: ai_poi.list.v1.GetListsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	3,  // 23: ai_poi.list.v1.GetListsResponse.lists:type_name -> ai_poi.list.v1.ListWithItems
-	46, // 24: ai_poi.list.v1.GetListsResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 25: ai_poi.list.v1.GetListRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	50, // 24: ai_poi.list.v1.GetListsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 25: ai_poi.list.v1.GetListRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	5,  // 26: ai_poi.list.v1.GetListResponse.list:type_name -> ai_poi.list.v1.ListWithDetailedItems
-	46, // 27: ai_poi.list.v1.GetListResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 28: ai_poi.list.v1.UpdateListRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	50, // 27: ai_poi.list.v1.GetListResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 28: ai_poi.list.v1.UpdateListRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	1,  // 29: ai_poi.list.v1.UpdateListResponse.list:type_name -> ai_poi.list.v1.List
-	46, // 30: ai_poi.list.v1.UpdateListResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 31: ai_poi.list.v1.DeleteListRequest.request:type_name -> ai_poi.list.v1.BaseRequest
-	46, // 32: ai_poi.list.v1.DeleteListResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 33: ai_poi.list.v1.CreateItineraryRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	50, // 30: ai_poi.list.v1.UpdateListResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 31: ai_poi.list.v1.DeleteListRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	50, // 32: ai_poi.list.v1.DeleteListResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 33: ai_poi.list.v1.CreateItineraryRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	1,  // 34: ai_poi.list.v1.CreateItineraryResponse.itinerary:type_name -> ai_poi.list.v1.List
-	46, // 35: ai_poi.list.v1.CreateItineraryResponse.response:type_name -> ai_poi.list.v1.BaseResponse
+	50, // 35: ai_poi.list.v1.CreateItineraryResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	0,  // 36: ai_poi.list.v1.AddListItemRequest.content_type:type_name -> ai_poi.list.v1.ContentType
 	48, // 37: ai_poi.list.v1.AddListItemRequest.time_slot:type_name -> google.protobuf.Timestamp
-	45, // 38: ai_poi.list.v1.AddListItemRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	49, // 38: ai_poi.list.v1.AddListItemRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	2,  // 39: ai_poi.list.v1.AddListItemResponse.item:type_name -> ai_poi.list.v1.ListItem
-	46, // 40: ai_poi.list.v1.AddListItemResponse.response:type_name -> ai_poi.list.v1.BaseResponse
+	50, // 40: ai_poi.list.v1.AddListItemResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	0,  // 41: ai_poi.list.v1.UpdateListItemRequest.content_type:type_name -> ai_poi.list.v1.ContentType
 	48, // 42: ai_poi.list.v1.UpdateListItemRequest.time_slot:type_name -> google.protobuf.Timestamp
-	45, // 43: ai_poi.list.v1.UpdateListItemRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	49, // 43: ai_poi.list.v1.UpdateListItemRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	2,  // 44: ai_poi.list.v1.UpdateListItemResponse.item:type_name -> ai_poi.list.v1.ListItem
-	46, // 45: ai_poi.list.v1.UpdateListItemResponse.response:type_name -> ai_poi.list.v1.BaseResponse
+	50, // 45: ai_poi.list.v1.UpdateListItemResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	0,  // 46: ai_poi.list.v1.RemoveListItemRequest.content_type:type_name -> ai_poi.list.v1.ContentType
-	45, // 47: ai_poi.list.v1.RemoveListItemRequest.request:type_name -> ai_poi.list.v1.BaseRequest
-	46, // 48: ai_poi.list.v1.RemoveListItemResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 49: ai_poi.list.v1.GetListItemsRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	49, // 47: ai_poi.list.v1.RemoveListItemRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	50, // 48: ai_poi.list.v1.RemoveListItemResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 49: ai_poi.list.v1.GetListItemsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	4,  // 50: ai_poi.list.v1.GetListItemsResponse.items:type_name -> ai_poi.list.v1.ListItemWithContent
-	46, // 51: ai_poi.list.v1.GetListItemsResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 52: ai_poi.list.v1.GetListRestaurantsRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	50, // 51: ai_poi.list.v1.GetListItemsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 52: ai_poi.list.v1.GetListRestaurantsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	7,  // 53: ai_poi.list.v1.GetListRestaurantsResponse.restaurants:type_name -> ai_poi.list.v1.RestaurantDetailedInfo
-	46, // 54: ai_poi.list.v1.GetListRestaurantsResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 55: ai_poi.list.v1.GetListHotelsRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	50, // 54: ai_poi.list.v1.GetListRestaurantsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 55: ai_poi.list.v1.GetListHotelsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	8,  // 56: ai_poi.list.v1.GetListHotelsResponse.hotels:type_name -> ai_poi.list.v1.HotelDetailedInfo
-	46, // 57: ai_poi.list.v1.GetListHotelsResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 58: ai_poi.list.v1.GetListItinerariesRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	50, // 57: ai_poi.list.v1.GetListHotelsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 58: ai_poi.list.v1.GetListItinerariesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	9,  // 59: ai_poi.list.v1.GetListItinerariesResponse.itineraries:type_name -> ai_poi.list.v1.UserSavedItinerary
-	46, // 60: ai_poi.list.v1.GetListItinerariesResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 61: ai_poi.list.v1.SavePublicListRequest.request:type_name -> ai_poi.list.v1.BaseRequest
-	46, // 62: ai_poi.list.v1.SavePublicListResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 63: ai_poi.list.v1.UnsaveListRequest.request:type_name -> ai_poi.list.v1.BaseRequest
-	46, // 64: ai_poi.list.v1.UnsaveListResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 65: ai_poi.list.v1.GetSavedListsRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	50, // 60: ai_poi.list.v1.GetListItinerariesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 61: ai_poi.list.v1.SavePublicListRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	50, // 62: ai_poi.list.v1.SavePublicListResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 63: ai_poi.list.v1.UnsaveListRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	50, // 64: ai_poi.list.v1.UnsaveListResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 65: ai_poi.list.v1.GetSavedListsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	3,  // 66: ai_poi.list.v1.GetSavedListsResponse.lists:type_name -> ai_poi.list.v1.ListWithItems
-	46, // 67: ai_poi.list.v1.GetSavedListsResponse.response:type_name -> ai_poi.list.v1.BaseResponse
-	45, // 68: ai_poi.list.v1.SearchPublicListsRequest.request:type_name -> ai_poi.list.v1.BaseRequest
+	50, // 67: ai_poi.list.v1.GetSavedListsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 68: ai_poi.list.v1.SearchPublicListsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	3,  // 69: ai_poi.list.v1.SearchPublicListsResponse.lists:type_name -> ai_poi.list.v1.ListWithItems
 	44, // 70: ai_poi.list.v1.SearchPublicListsResponse.metadata:type_name -> ai_poi.list.v1.SearchMetadata
-	46, // 71: ai_poi.list.v1.SearchPublicListsResponse.response:type_name -> ai_poi.list.v1.BaseResponse
+	50, // 71: ai_poi.list.v1.SearchPublicListsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	47, // 72: ai_poi.list.v1.SearchMetadata.filters_applied:type_name -> ai_poi.list.v1.SearchMetadata.FiltersAppliedEntry
 	10, // 73: ai_poi.list.v1.ListService.CreateList:input_type -> ai_poi.list.v1.CreateListRequest
 	12, // 74: ai_poi.list.v1.ListService.GetLists:input_type -> ai_poi.list.v1.GetListsRequest
@@ -0,0 +1,7014 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: list.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on List with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on List with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ListMultiError, or nil if none found.
+func (m *List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for ImageUrl
+
+	// no validation rules for IsPublic
+
+	// no validation rules for IsItinerary
+
+	// no validation rules for ParentListId
+
+	// no validation rules for CityId
+
+	// no validation rules for ViewCount
+
+	// no validation rules for SaveCount
+
+	// no validation rules for ItemCount
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMultiError is an error wrapping multiple validation errors returned by
+// List.ValidateAll() if the designated constraints aren't met.
+type ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMultiError) AllErrors() []error { return m }
+
+// ListValidationError is the validation error returned by List.Validate if the
+// designated constraints aren't met.
+type ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListValidationError) ErrorName() string { return "ListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListValidationError{}
+
+// Validate checks the field values on ListItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListItemMultiError, or nil
+// if none found.
+func (m *ListItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ListId
+
+	// no validation rules for ItemId
+
+	// no validation rules for PoiId
+
+	// no validation rules for ContentType
+
+	// no validation rules for Position
+
+	// no validation rules for Notes
+
+	// no validation rules for DayNumber
+
+	if all {
+		switch v := interface{}(m.GetTimeSlot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListItemValidationError{
+					field:  "TimeSlot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListItemValidationError{
+					field:  "TimeSlot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeSlot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListItemValidationError{
+				field:  "TimeSlot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Duration
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListItemValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListItemValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListItemValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListItemValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListItemValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListItemValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SourceLlmInteractionId
+
+	// no validation rules for ItemAiDescription
+
+	if len(errors) > 0 {
+		return ListItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListItemMultiError is an error wrapping multiple validation errors returned
+// by ListItem.ValidateAll() if the designated constraints aren't met.
+type ListItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListItemMultiError) AllErrors() []error { return m }
+
+// ListItemValidationError is the validation error returned by
+// ListItem.Validate if the designated constraints aren't met.
+type ListItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListItemValidationError) ErrorName() string { return "ListItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListItemValidationError{}
+
+// Validate checks the field values on ListWithItems with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListWithItems) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWithItems with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListWithItemsMultiError, or
+// nil if none found.
+func (m *ListWithItems) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWithItems) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListWithItemsValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListWithItemsValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListWithItemsValidationError{
+				field:  "List",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWithItemsValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWithItemsValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWithItemsValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWithItemsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWithItemsMultiError is an error wrapping multiple validation errors
+// returned by ListWithItems.ValidateAll() if the designated constraints
+// aren't met.
+type ListWithItemsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWithItemsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWithItemsMultiError) AllErrors() []error { return m }
+
+// ListWithItemsValidationError is the validation error returned by
+// ListWithItems.Validate if the designated constraints aren't met.
+type ListWithItemsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWithItemsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWithItemsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWithItemsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWithItemsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWithItemsValidationError) ErrorName() string { return "ListWithItemsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListWithItemsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWithItems.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWithItemsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWithItemsValidationError{}
+
+// Validate checks the field values on ListItemWithContent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListItemWithContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListItemWithContent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListItemWithContentMultiError, or nil if none found.
+func (m *ListItemWithContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListItemWithContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetListItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListItemWithContentValidationError{
+					field:  "ListItem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListItemWithContentValidationError{
+					field:  "ListItem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetListItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListItemWithContentValidationError{
+				field:  "ListItem",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPoi()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListItemWithContentValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListItemWithContentValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListItemWithContentValidationError{
+				field:  "Poi",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRestaurant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListItemWithContentValidationError{
+					field:  "Restaurant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListItemWithContentValidationError{
+					field:  "Restaurant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRestaurant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListItemWithContentValidationError{
+				field:  "Restaurant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHotel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListItemWithContentValidationError{
+					field:  "Hotel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListItemWithContentValidationError{
+					field:  "Hotel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHotel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListItemWithContentValidationError{
+				field:  "Hotel",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetItinerary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListItemWithContentValidationError{
+					field:  "Itinerary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListItemWithContentValidationError{
+					field:  "Itinerary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItinerary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListItemWithContentValidationError{
+				field:  "Itinerary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListItemWithContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListItemWithContentMultiError is an error wrapping multiple validation
+// errors returned by ListItemWithContent.ValidateAll() if the designated
+// constraints aren't met.
+type ListItemWithContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListItemWithContentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListItemWithContentMultiError) AllErrors() []error { return m }
+
+// ListItemWithContentValidationError is the validation error returned by
+// ListItemWithContent.Validate if the designated constraints aren't met.
+type ListItemWithContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListItemWithContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListItemWithContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListItemWithContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListItemWithContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListItemWithContentValidationError) ErrorName() string {
+	return "ListItemWithContentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListItemWithContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListItemWithContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListItemWithContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListItemWithContentValidationError{}
+
+// Validate checks the field values on ListWithDetailedItems with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWithDetailedItems) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWithDetailedItems with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWithDetailedItemsMultiError, or nil if none found.
+func (m *ListWithDetailedItems) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWithDetailedItems) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListWithDetailedItemsValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListWithDetailedItemsValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListWithDetailedItemsValidationError{
+				field:  "List",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWithDetailedItemsValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWithDetailedItemsValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWithDetailedItemsValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWithDetailedItemsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWithDetailedItemsMultiError is an error wrapping multiple validation
+// errors returned by ListWithDetailedItems.ValidateAll() if the designated
+// constraints aren't met.
+type ListWithDetailedItemsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWithDetailedItemsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWithDetailedItemsMultiError) AllErrors() []error { return m }
+
+// ListWithDetailedItemsValidationError is the validation error returned by
+// ListWithDetailedItems.Validate if the designated constraints aren't met.
+type ListWithDetailedItemsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWithDetailedItemsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWithDetailedItemsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWithDetailedItemsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWithDetailedItemsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWithDetailedItemsValidationError) ErrorName() string {
+	return "ListWithDetailedItemsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWithDetailedItemsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWithDetailedItems.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWithDetailedItemsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWithDetailedItemsValidationError{}
+
+// Validate checks the field values on POIDetailedInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *POIDetailedInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on POIDetailedInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// POIDetailedInfoMultiError, or nil if none found.
+func (m *POIDetailedInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *POIDetailedInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Latitude
+
+	// no validation rules for Longitude
+
+	// no validation rules for Category
+
+	// no validation rules for Description
+
+	// no validation rules for Rating
+
+	// no validation rules for ReviewCount
+
+	// no validation rules for PriceRange
+
+	// no validation rules for Address
+
+	// no validation rules for Phone
+
+	// no validation rules for Website
+
+	if len(errors) > 0 {
+		return POIDetailedInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// POIDetailedInfoMultiError is an error wrapping multiple validation errors
+// returned by POIDetailedInfo.ValidateAll() if the designated constraints
+// aren't met.
+type POIDetailedInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m POIDetailedInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m POIDetailedInfoMultiError) AllErrors() []error { return m }
+
+// POIDetailedInfoValidationError is the validation error returned by
+// POIDetailedInfo.Validate if the designated constraints aren't met.
+type POIDetailedInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e POIDetailedInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e POIDetailedInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e POIDetailedInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e POIDetailedInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e POIDetailedInfoValidationError) ErrorName() string { return "POIDetailedInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e POIDetailedInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPOIDetailedInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = POIDetailedInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = POIDetailedInfoValidationError{}
+
+// Validate checks the field values on RestaurantDetailedInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestaurantDetailedInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestaurantDetailedInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestaurantDetailedInfoMultiError, or nil if none found.
+func (m *RestaurantDetailedInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestaurantDetailedInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPoi()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestaurantDetailedInfoValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestaurantDetailedInfoValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestaurantDetailedInfoValidationError{
+				field:  "Poi",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CuisineType
+
+	// no validation rules for DressCode
+
+	// no validation rules for ReservationsRequired
+
+	if len(errors) > 0 {
+		return RestaurantDetailedInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestaurantDetailedInfoMultiError is an error wrapping multiple validation
+// errors returned by RestaurantDetailedInfo.ValidateAll() if the designated
+// constraints aren't met.
+type RestaurantDetailedInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestaurantDetailedInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestaurantDetailedInfoMultiError) AllErrors() []error { return m }
+
+// RestaurantDetailedInfoValidationError is the validation error returned by
+// RestaurantDetailedInfo.Validate if the designated constraints aren't met.
+type RestaurantDetailedInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestaurantDetailedInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestaurantDetailedInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestaurantDetailedInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestaurantDetailedInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestaurantDetailedInfoValidationError) ErrorName() string {
+	return "RestaurantDetailedInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestaurantDetailedInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestaurantDetailedInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestaurantDetailedInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestaurantDetailedInfoValidationError{}
+
+// Validate checks the field values on HotelDetailedInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HotelDetailedInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HotelDetailedInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HotelDetailedInfoMultiError, or nil if none found.
+func (m *HotelDetailedInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HotelDetailedInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPoi()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HotelDetailedInfoValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HotelDetailedInfoValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HotelDetailedInfoValidationError{
+				field:  "Poi",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for StarRating
+
+	// no validation rules for CheckInTime
+
+	// no validation rules for CheckOutTime
+
+	if len(errors) > 0 {
+		return HotelDetailedInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// HotelDetailedInfoMultiError is an error wrapping multiple validation errors
+// returned by HotelDetailedInfo.ValidateAll() if the designated constraints
+// aren't met.
+type HotelDetailedInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HotelDetailedInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HotelDetailedInfoMultiError) AllErrors() []error { return m }
+
+// HotelDetailedInfoValidationError is the validation error returned by
+// HotelDetailedInfo.Validate if the designated constraints aren't met.
+type HotelDetailedInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HotelDetailedInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HotelDetailedInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HotelDetailedInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HotelDetailedInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HotelDetailedInfoValidationError) ErrorName() string {
+	return "HotelDetailedInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HotelDetailedInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHotelDetailedInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HotelDetailedInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HotelDetailedInfoValidationError{}
+
+// Validate checks the field values on UserSavedItinerary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserSavedItinerary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserSavedItinerary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserSavedItineraryMultiError, or nil if none found.
+func (m *UserSavedItinerary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserSavedItinerary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for SessionId
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for ItineraryData
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserSavedItineraryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserSavedItineraryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserSavedItineraryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserSavedItineraryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserSavedItineraryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserSavedItineraryValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserSavedItineraryMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserSavedItineraryMultiError is an error wrapping multiple validation errors
+// returned by UserSavedItinerary.ValidateAll() if the designated constraints
+// aren't met.
+type UserSavedItineraryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserSavedItineraryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserSavedItineraryMultiError) AllErrors() []error { return m }
+
+// UserSavedItineraryValidationError is the validation error returned by
+// UserSavedItinerary.Validate if the designated constraints aren't met.
+type UserSavedItineraryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserSavedItineraryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserSavedItineraryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserSavedItineraryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserSavedItineraryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserSavedItineraryValidationError) ErrorName() string {
+	return "UserSavedItineraryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserSavedItineraryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserSavedItinerary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserSavedItineraryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserSavedItineraryValidationError{}
+
+// Validate checks the field values on CreateListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateListRequestMultiError, or nil if none found.
+func (m *CreateListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for CityId
+
+	// no validation rules for IsItinerary
+
+	// no validation rules for IsPublic
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateListRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateListRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateListRequestMultiError) AllErrors() []error { return m }
+
+// CreateListRequestValidationError is the validation error returned by
+// CreateListRequest.Validate if the designated constraints aren't met.
+type CreateListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateListRequestValidationError) ErrorName() string {
+	return "CreateListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateListRequestValidationError{}
+
+// Validate checks the field values on CreateListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateListResponseMultiError, or nil if none found.
+func (m *CreateListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateListResponseValidationError{
+				field:  "List",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateListResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateListResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateListResponseMultiError) AllErrors() []error { return m }
+
+// CreateListResponseValidationError is the validation error returned by
+// CreateListResponse.Validate if the designated constraints aren't met.
+type CreateListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateListResponseValidationError) ErrorName() string {
+	return "CreateListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateListResponseValidationError{}
+
+// Validate checks the field values on GetListsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetListsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListsRequestMultiError, or nil if none found.
+func (m *GetListsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for IncludeItems
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetListsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetListsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListsRequestMultiError) AllErrors() []error { return m }
+
+// GetListsRequestValidationError is the validation error returned by
+// GetListsRequest.Validate if the designated constraints aren't met.
+type GetListsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListsRequestValidationError) ErrorName() string { return "GetListsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetListsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListsRequestValidationError{}
+
+// Validate checks the field values on GetListsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetListsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListsResponseMultiError, or nil if none found.
+func (m *GetListsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLists() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListsResponseValidationError{
+						field:  fmt.Sprintf("Lists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListsResponseValidationError{
+						field:  fmt.Sprintf("Lists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListsResponseValidationError{
+					field:  fmt.Sprintf("Lists[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetListsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetListsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListsResponseMultiError) AllErrors() []error { return m }
+
+// GetListsResponseValidationError is the validation error returned by
+// GetListsResponse.Validate if the designated constraints aren't met.
+type GetListsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListsResponseValidationError) ErrorName() string { return "GetListsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetListsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListsResponseValidationError{}
+
+// Validate checks the field values on GetListRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetListRequestMultiError,
+// or nil if none found.
+func (m *GetListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	// no validation rules for IncludeDetailedItems
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListRequestMultiError is an error wrapping multiple validation errors
+// returned by GetListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListRequestMultiError) AllErrors() []error { return m }
+
+// GetListRequestValidationError is the validation error returned by
+// GetListRequest.Validate if the designated constraints aren't met.
+type GetListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListRequestValidationError) ErrorName() string { return "GetListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListRequestValidationError{}
+
+// Validate checks the field values on GetListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListResponseMultiError, or nil if none found.
+func (m *GetListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListResponseValidationError{
+				field:  "List",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListResponseMultiError is an error wrapping multiple validation errors
+// returned by GetListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListResponseMultiError) AllErrors() []error { return m }
+
+// GetListResponseValidationError is the validation error returned by
+// GetListResponse.Validate if the designated constraints aren't met.
+type GetListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListResponseValidationError) ErrorName() string { return "GetListResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListResponseValidationError{}
+
+// Validate checks the field values on UpdateListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateListRequestMultiError, or nil if none found.
+func (m *UpdateListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for ImageUrl
+
+	// no validation rules for IsPublic
+
+	// no validation rules for CityId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateListRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateListRequestMultiError) AllErrors() []error { return m }
+
+// UpdateListRequestValidationError is the validation error returned by
+// UpdateListRequest.Validate if the designated constraints aren't met.
+type UpdateListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateListRequestValidationError) ErrorName() string {
+	return "UpdateListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateListRequestValidationError{}
+
+// Validate checks the field values on UpdateListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateListResponseMultiError, or nil if none found.
+func (m *UpdateListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListResponseValidationError{
+				field:  "List",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateListResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateListResponseMultiError) AllErrors() []error { return m }
+
+// UpdateListResponseValidationError is the validation error returned by
+// UpdateListResponse.Validate if the designated constraints aren't met.
+type UpdateListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateListResponseValidationError) ErrorName() string {
+	return "UpdateListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateListResponseValidationError{}
+
+// Validate checks the field values on DeleteListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteListRequestMultiError, or nil if none found.
+func (m *DeleteListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteListRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteListRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteListRequestMultiError) AllErrors() []error { return m }
+
+// DeleteListRequestValidationError is the validation error returned by
+// DeleteListRequest.Validate if the designated constraints aren't met.
+type DeleteListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteListRequestValidationError) ErrorName() string {
+	return "DeleteListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteListRequestValidationError{}
+
+// Validate checks the field values on DeleteListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteListResponseMultiError, or nil if none found.
+func (m *DeleteListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteListResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteListResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteListResponseMultiError) AllErrors() []error { return m }
+
+// DeleteListResponseValidationError is the validation error returned by
+// DeleteListResponse.Validate if the designated constraints aren't met.
+type DeleteListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteListResponseValidationError) ErrorName() string {
+	return "DeleteListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteListResponseValidationError{}
+
+// Validate checks the field values on CreateItineraryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateItineraryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateItineraryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateItineraryRequestMultiError, or nil if none found.
+func (m *CreateItineraryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateItineraryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ParentListId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for IsPublic
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateItineraryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateItineraryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateItineraryRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateItineraryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateItineraryRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateItineraryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateItineraryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateItineraryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateItineraryRequestMultiError) AllErrors() []error { return m }
+
+// CreateItineraryRequestValidationError is the validation error returned by
+// CreateItineraryRequest.Validate if the designated constraints aren't met.
+type CreateItineraryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateItineraryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateItineraryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateItineraryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateItineraryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateItineraryRequestValidationError) ErrorName() string {
+	return "CreateItineraryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateItineraryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateItineraryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateItineraryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateItineraryRequestValidationError{}
+
+// Validate checks the field values on CreateItineraryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateItineraryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateItineraryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateItineraryResponseMultiError, or nil if none found.
+func (m *CreateItineraryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateItineraryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetItinerary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateItineraryResponseValidationError{
+					field:  "Itinerary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateItineraryResponseValidationError{
+					field:  "Itinerary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItinerary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateItineraryResponseValidationError{
+				field:  "Itinerary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateItineraryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateItineraryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateItineraryResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateItineraryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateItineraryResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateItineraryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateItineraryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateItineraryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateItineraryResponseMultiError) AllErrors() []error { return m }
+
+// CreateItineraryResponseValidationError is the validation error returned by
+// CreateItineraryResponse.Validate if the designated constraints aren't met.
+type CreateItineraryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateItineraryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateItineraryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateItineraryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateItineraryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateItineraryResponseValidationError) ErrorName() string {
+	return "CreateItineraryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateItineraryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateItineraryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateItineraryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateItineraryResponseValidationError{}
+
+// Validate checks the field values on AddListItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddListItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddListItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddListItemRequestMultiError, or nil if none found.
+func (m *AddListItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddListItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	// no validation rules for ItemId
+
+	// no validation rules for ContentType
+
+	// no validation rules for Position
+
+	// no validation rules for Notes
+
+	// no validation rules for DayNumber
+
+	if all {
+		switch v := interface{}(m.GetTimeSlot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddListItemRequestValidationError{
+					field:  "TimeSlot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddListItemRequestValidationError{
+					field:  "TimeSlot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeSlot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddListItemRequestValidationError{
+				field:  "TimeSlot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DurationMinutes
+
+	// no validation rules for SourceLlmInteractionId
+
+	// no validation rules for ItemAiDescription
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddListItemRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddListItemRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddListItemRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddListItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddListItemRequestMultiError is an error wrapping multiple validation errors
+// returned by AddListItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddListItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddListItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddListItemRequestMultiError) AllErrors() []error { return m }
+
+// AddListItemRequestValidationError is the validation error returned by
+// AddListItemRequest.Validate if the designated constraints aren't met.
+type AddListItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddListItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddListItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddListItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddListItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddListItemRequestValidationError) ErrorName() string {
+	return "AddListItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddListItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddListItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddListItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddListItemRequestValidationError{}
+
+// Validate checks the field values on AddListItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddListItemResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddListItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddListItemResponseMultiError, or nil if none found.
+func (m *AddListItemResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddListItemResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddListItemResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddListItemResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddListItemResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddListItemResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddListItemResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddListItemResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddListItemResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddListItemResponseMultiError is an error wrapping multiple validation
+// errors returned by AddListItemResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddListItemResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddListItemResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddListItemResponseMultiError) AllErrors() []error { return m }
+
+// AddListItemResponseValidationError is the validation error returned by
+// AddListItemResponse.Validate if the designated constraints aren't met.
+type AddListItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddListItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddListItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddListItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddListItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddListItemResponseValidationError) ErrorName() string {
+	return "AddListItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddListItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddListItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddListItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddListItemResponseValidationError{}
+
+// Validate checks the field values on UpdateListItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateListItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateListItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateListItemRequestMultiError, or nil if none found.
+func (m *UpdateListItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateListItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	// no validation rules for ItemId
+
+	// no validation rules for ContentType
+
+	// no validation rules for Position
+
+	// no validation rules for Notes
+
+	// no validation rules for DayNumber
+
+	if all {
+		switch v := interface{}(m.GetTimeSlot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListItemRequestValidationError{
+					field:  "TimeSlot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListItemRequestValidationError{
+					field:  "TimeSlot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeSlot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListItemRequestValidationError{
+				field:  "TimeSlot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DurationMinutes
+
+	// no validation rules for SourceLlmInteractionId
+
+	// no validation rules for ItemAiDescription
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListItemRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListItemRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListItemRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateListItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateListItemRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateListItemRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateListItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateListItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateListItemRequestMultiError) AllErrors() []error { return m }
+
+// UpdateListItemRequestValidationError is the validation error returned by
+// UpdateListItemRequest.Validate if the designated constraints aren't met.
+type UpdateListItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateListItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateListItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateListItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateListItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateListItemRequestValidationError) ErrorName() string {
+	return "UpdateListItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateListItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateListItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateListItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateListItemRequestValidationError{}
+
+// Validate checks the field values on UpdateListItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateListItemResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateListItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateListItemResponseMultiError, or nil if none found.
+func (m *UpdateListItemResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateListItemResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListItemResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListItemResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListItemResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListItemResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListItemResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListItemResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateListItemResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateListItemResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateListItemResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateListItemResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateListItemResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateListItemResponseMultiError) AllErrors() []error { return m }
+
+// UpdateListItemResponseValidationError is the validation error returned by
+// UpdateListItemResponse.Validate if the designated constraints aren't met.
+type UpdateListItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateListItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateListItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateListItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateListItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateListItemResponseValidationError) ErrorName() string {
+	return "UpdateListItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateListItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateListItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateListItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateListItemResponseValidationError{}
+
+// Validate checks the field values on RemoveListItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveListItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveListItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveListItemRequestMultiError, or nil if none found.
+func (m *RemoveListItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveListItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	// no validation rules for ItemId
+
+	// no validation rules for ContentType
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveListItemRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveListItemRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveListItemRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveListItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveListItemRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveListItemRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveListItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveListItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveListItemRequestMultiError) AllErrors() []error { return m }
+
+// RemoveListItemRequestValidationError is the validation error returned by
+// RemoveListItemRequest.Validate if the designated constraints aren't met.
+type RemoveListItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveListItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveListItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveListItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveListItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveListItemRequestValidationError) ErrorName() string {
+	return "RemoveListItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveListItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveListItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveListItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveListItemRequestValidationError{}
+
+// Validate checks the field values on RemoveListItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveListItemResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveListItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveListItemResponseMultiError, or nil if none found.
+func (m *RemoveListItemResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveListItemResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveListItemResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveListItemResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveListItemResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveListItemResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveListItemResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveListItemResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveListItemResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveListItemResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveListItemResponseMultiError) AllErrors() []error { return m }
+
+// RemoveListItemResponseValidationError is the validation error returned by
+// RemoveListItemResponse.Validate if the designated constraints aren't met.
+type RemoveListItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveListItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveListItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveListItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveListItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveListItemResponseValidationError) ErrorName() string {
+	return "RemoveListItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveListItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveListItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveListItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveListItemResponseValidationError{}
+
+// Validate checks the field values on GetListItemsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListItemsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListItemsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListItemsRequestMultiError, or nil if none found.
+func (m *GetListItemsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListItemsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	// no validation rules for IncludeContentDetails
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListItemsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListItemsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListItemsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListItemsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListItemsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetListItemsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetListItemsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListItemsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListItemsRequestMultiError) AllErrors() []error { return m }
+
+// GetListItemsRequestValidationError is the validation error returned by
+// GetListItemsRequest.Validate if the designated constraints aren't met.
+type GetListItemsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListItemsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListItemsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListItemsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListItemsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListItemsRequestValidationError) ErrorName() string {
+	return "GetListItemsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListItemsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListItemsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListItemsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListItemsRequestValidationError{}
+
+// Validate checks the field values on GetListItemsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListItemsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListItemsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListItemsResponseMultiError, or nil if none found.
+func (m *GetListItemsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListItemsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListItemsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListItemsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListItemsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListItemsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListItemsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListItemsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetListItemsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListItemsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListItemsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListItemsResponseMultiError) AllErrors() []error { return m }
+
+// GetListItemsResponseValidationError is the validation error returned by
+// GetListItemsResponse.Validate if the designated constraints aren't met.
+type GetListItemsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListItemsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListItemsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListItemsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListItemsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListItemsResponseValidationError) ErrorName() string {
+	return "GetListItemsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListItemsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListItemsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListItemsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListItemsResponseValidationError{}
+
+// Validate checks the field values on GetListRestaurantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListRestaurantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListRestaurantsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListRestaurantsRequestMultiError, or nil if none found.
+func (m *GetListRestaurantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListRestaurantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListRestaurantsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListRestaurantsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListRestaurantsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListRestaurantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListRestaurantsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetListRestaurantsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetListRestaurantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListRestaurantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListRestaurantsRequestMultiError) AllErrors() []error { return m }
+
+// GetListRestaurantsRequestValidationError is the validation error returned by
+// GetListRestaurantsRequest.Validate if the designated constraints aren't met.
+type GetListRestaurantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListRestaurantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListRestaurantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListRestaurantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListRestaurantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListRestaurantsRequestValidationError) ErrorName() string {
+	return "GetListRestaurantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListRestaurantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListRestaurantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListRestaurantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListRestaurantsRequestValidationError{}
+
+// Validate checks the field values on GetListRestaurantsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListRestaurantsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListRestaurantsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListRestaurantsResponseMultiError, or nil if none found.
+func (m *GetListRestaurantsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListRestaurantsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRestaurants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListRestaurantsResponseValidationError{
+						field:  fmt.Sprintf("Restaurants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListRestaurantsResponseValidationError{
+						field:  fmt.Sprintf("Restaurants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListRestaurantsResponseValidationError{
+					field:  fmt.Sprintf("Restaurants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListRestaurantsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListRestaurantsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListRestaurantsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListRestaurantsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListRestaurantsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetListRestaurantsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetListRestaurantsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListRestaurantsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListRestaurantsResponseMultiError) AllErrors() []error { return m }
+
+// GetListRestaurantsResponseValidationError is the validation error returned
+// by GetListRestaurantsResponse.Validate if the designated constraints aren't met.
+type GetListRestaurantsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListRestaurantsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListRestaurantsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListRestaurantsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListRestaurantsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListRestaurantsResponseValidationError) ErrorName() string {
+	return "GetListRestaurantsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListRestaurantsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListRestaurantsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListRestaurantsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListRestaurantsResponseValidationError{}
+
+// Validate checks the field values on GetListHotelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListHotelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListHotelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListHotelsRequestMultiError, or nil if none found.
+func (m *GetListHotelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListHotelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListHotelsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListHotelsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListHotelsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListHotelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListHotelsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetListHotelsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetListHotelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListHotelsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListHotelsRequestMultiError) AllErrors() []error { return m }
+
+// GetListHotelsRequestValidationError is the validation error returned by
+// GetListHotelsRequest.Validate if the designated constraints aren't met.
+type GetListHotelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListHotelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListHotelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListHotelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListHotelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListHotelsRequestValidationError) ErrorName() string {
+	return "GetListHotelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListHotelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListHotelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListHotelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListHotelsRequestValidationError{}
+
+// Validate checks the field values on GetListHotelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListHotelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListHotelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListHotelsResponseMultiError, or nil if none found.
+func (m *GetListHotelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListHotelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHotels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListHotelsResponseValidationError{
+						field:  fmt.Sprintf("Hotels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListHotelsResponseValidationError{
+						field:  fmt.Sprintf("Hotels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListHotelsResponseValidationError{
+					field:  fmt.Sprintf("Hotels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListHotelsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListHotelsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListHotelsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListHotelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListHotelsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetListHotelsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListHotelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListHotelsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListHotelsResponseMultiError) AllErrors() []error { return m }
+
+// GetListHotelsResponseValidationError is the validation error returned by
+// GetListHotelsResponse.Validate if the designated constraints aren't met.
+type GetListHotelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListHotelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListHotelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListHotelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListHotelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListHotelsResponseValidationError) ErrorName() string {
+	return "GetListHotelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListHotelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListHotelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListHotelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListHotelsResponseValidationError{}
+
+// Validate checks the field values on GetListItinerariesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListItinerariesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListItinerariesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListItinerariesRequestMultiError, or nil if none found.
+func (m *GetListItinerariesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListItinerariesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListItinerariesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListItinerariesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListItinerariesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListItinerariesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListItinerariesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetListItinerariesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetListItinerariesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListItinerariesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListItinerariesRequestMultiError) AllErrors() []error { return m }
+
+// GetListItinerariesRequestValidationError is the validation error returned by
+// GetListItinerariesRequest.Validate if the designated constraints aren't met.
+type GetListItinerariesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListItinerariesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListItinerariesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListItinerariesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListItinerariesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListItinerariesRequestValidationError) ErrorName() string {
+	return "GetListItinerariesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListItinerariesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListItinerariesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListItinerariesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListItinerariesRequestValidationError{}
+
+// Validate checks the field values on GetListItinerariesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListItinerariesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListItinerariesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListItinerariesResponseMultiError, or nil if none found.
+func (m *GetListItinerariesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListItinerariesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItineraries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListItinerariesResponseValidationError{
+						field:  fmt.Sprintf("Itineraries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListItinerariesResponseValidationError{
+						field:  fmt.Sprintf("Itineraries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListItinerariesResponseValidationError{
+					field:  fmt.Sprintf("Itineraries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListItinerariesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListItinerariesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListItinerariesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListItinerariesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListItinerariesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetListItinerariesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetListItinerariesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListItinerariesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListItinerariesResponseMultiError) AllErrors() []error { return m }
+
+// GetListItinerariesResponseValidationError is the validation error returned
+// by GetListItinerariesResponse.Validate if the designated constraints aren't met.
+type GetListItinerariesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListItinerariesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListItinerariesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListItinerariesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListItinerariesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListItinerariesResponseValidationError) ErrorName() string {
+	return "GetListItinerariesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListItinerariesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListItinerariesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListItinerariesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListItinerariesResponseValidationError{}
+
+// Validate checks the field values on SavePublicListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SavePublicListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavePublicListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SavePublicListRequestMultiError, or nil if none found.
+func (m *SavePublicListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavePublicListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavePublicListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavePublicListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavePublicListRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SavePublicListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavePublicListRequestMultiError is an error wrapping multiple validation
+// errors returned by SavePublicListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SavePublicListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavePublicListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavePublicListRequestMultiError) AllErrors() []error { return m }
+
+// SavePublicListRequestValidationError is the validation error returned by
+// SavePublicListRequest.Validate if the designated constraints aren't met.
+type SavePublicListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavePublicListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavePublicListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavePublicListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavePublicListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavePublicListRequestValidationError) ErrorName() string {
+	return "SavePublicListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SavePublicListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavePublicListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavePublicListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavePublicListRequestValidationError{}
+
+// Validate checks the field values on SavePublicListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SavePublicListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavePublicListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SavePublicListResponseMultiError, or nil if none found.
+func (m *SavePublicListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavePublicListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavePublicListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavePublicListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavePublicListResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SavePublicListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavePublicListResponseMultiError is an error wrapping multiple validation
+// errors returned by SavePublicListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SavePublicListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavePublicListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavePublicListResponseMultiError) AllErrors() []error { return m }
+
+// SavePublicListResponseValidationError is the validation error returned by
+// SavePublicListResponse.Validate if the designated constraints aren't met.
+type SavePublicListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavePublicListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavePublicListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavePublicListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavePublicListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavePublicListResponseValidationError) ErrorName() string {
+	return "SavePublicListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SavePublicListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavePublicListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavePublicListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavePublicListResponseValidationError{}
+
+// Validate checks the field values on UnsaveListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnsaveListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsaveListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsaveListRequestMultiError, or nil if none found.
+func (m *UnsaveListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsaveListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ListId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnsaveListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnsaveListRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnsaveListRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UnsaveListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsaveListRequestMultiError is an error wrapping multiple validation errors
+// returned by UnsaveListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnsaveListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsaveListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsaveListRequestMultiError) AllErrors() []error { return m }
+
+// UnsaveListRequestValidationError is the validation error returned by
+// UnsaveListRequest.Validate if the designated constraints aren't met.
+type UnsaveListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsaveListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsaveListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsaveListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsaveListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsaveListRequestValidationError) ErrorName() string {
+	return "UnsaveListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsaveListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsaveListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsaveListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsaveListRequestValidationError{}
+
+// Validate checks the field values on UnsaveListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsaveListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsaveListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsaveListResponseMultiError, or nil if none found.
+func (m *UnsaveListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsaveListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnsaveListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnsaveListResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnsaveListResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UnsaveListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsaveListResponseMultiError is an error wrapping multiple validation errors
+// returned by UnsaveListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UnsaveListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsaveListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsaveListResponseMultiError) AllErrors() []error { return m }
+
+// UnsaveListResponseValidationError is the validation error returned by
+// UnsaveListResponse.Validate if the designated constraints aren't met.
+type UnsaveListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsaveListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsaveListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsaveListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsaveListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsaveListResponseValidationError) ErrorName() string {
+	return "UnsaveListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsaveListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsaveListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsaveListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsaveListResponseValidationError{}
+
+// Validate checks the field values on GetSavedListsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSavedListsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSavedListsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSavedListsRequestMultiError, or nil if none found.
+func (m *GetSavedListsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSavedListsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSavedListsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSavedListsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSavedListsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSavedListsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSavedListsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSavedListsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSavedListsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSavedListsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSavedListsRequestMultiError) AllErrors() []error { return m }
+
+// GetSavedListsRequestValidationError is the validation error returned by
+// GetSavedListsRequest.Validate if the designated constraints aren't met.
+type GetSavedListsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSavedListsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSavedListsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSavedListsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSavedListsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSavedListsRequestValidationError) ErrorName() string {
+	return "GetSavedListsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSavedListsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSavedListsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSavedListsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSavedListsRequestValidationError{}
+
+// Validate checks the field values on GetSavedListsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSavedListsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSavedListsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSavedListsResponseMultiError, or nil if none found.
+func (m *GetSavedListsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSavedListsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLists() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSavedListsResponseValidationError{
+						field:  fmt.Sprintf("Lists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSavedListsResponseValidationError{
+						field:  fmt.Sprintf("Lists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSavedListsResponseValidationError{
+					field:  fmt.Sprintf("Lists[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSavedListsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSavedListsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSavedListsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSavedListsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSavedListsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSavedListsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSavedListsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSavedListsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSavedListsResponseMultiError) AllErrors() []error { return m }
+
+// GetSavedListsResponseValidationError is the validation error returned by
+// GetSavedListsResponse.Validate if the designated constraints aren't met.
+type GetSavedListsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSavedListsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSavedListsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSavedListsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSavedListsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSavedListsResponseValidationError) ErrorName() string {
+	return "GetSavedListsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSavedListsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSavedListsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSavedListsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSavedListsResponseValidationError{}
+
+// Validate checks the field values on SearchPublicListsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPublicListsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPublicListsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPublicListsRequestMultiError, or nil if none found.
+func (m *SearchPublicListsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPublicListsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for CityId
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for SortBy
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPublicListsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPublicListsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPublicListsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchPublicListsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPublicListsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchPublicListsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchPublicListsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPublicListsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPublicListsRequestMultiError) AllErrors() []error { return m }
+
+// SearchPublicListsRequestValidationError is the validation error returned by
+// SearchPublicListsRequest.Validate if the designated constraints aren't met.
+type SearchPublicListsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPublicListsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPublicListsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPublicListsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPublicListsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPublicListsRequestValidationError) ErrorName() string {
+	return "SearchPublicListsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPublicListsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPublicListsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPublicListsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPublicListsRequestValidationError{}
+
+// Validate checks the field values on SearchPublicListsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPublicListsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPublicListsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPublicListsResponseMultiError, or nil if none found.
+func (m *SearchPublicListsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPublicListsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLists() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchPublicListsResponseValidationError{
+						field:  fmt.Sprintf("Lists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchPublicListsResponseValidationError{
+						field:  fmt.Sprintf("Lists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchPublicListsResponseValidationError{
+					field:  fmt.Sprintf("Lists[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPublicListsResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPublicListsResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPublicListsResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPublicListsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPublicListsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPublicListsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchPublicListsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPublicListsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchPublicListsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SearchPublicListsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPublicListsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPublicListsResponseMultiError) AllErrors() []error { return m }
+
+// SearchPublicListsResponseValidationError is the validation error returned by
+// SearchPublicListsResponse.Validate if the designated constraints aren't met.
+type SearchPublicListsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPublicListsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPublicListsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPublicListsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPublicListsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPublicListsResponseValidationError) ErrorName() string {
+	return "SearchPublicListsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPublicListsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPublicListsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPublicListsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPublicListsResponseValidationError{}
+
+// Validate checks the field values on SearchMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchMetadataMultiError,
+// or nil if none found.
+func (m *SearchMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QueryTimeMs
+
+	// no validation rules for SearchMethod
+
+	// no validation rules for FiltersApplied
+
+	if len(errors) > 0 {
+		return SearchMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMetadataMultiError is an error wrapping multiple validation errors
+// returned by SearchMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type SearchMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMetadataMultiError) AllErrors() []error { return m }
+
+// SearchMetadataValidationError is the validation error returned by
+// SearchMetadata.Validate if the designated constraints aren't met.
+type SearchMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMetadataValidationError) ErrorName() string { return "SearchMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMetadataValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
@@ -0,0 +1,44 @@
+package list
+
+import (
+	"context"
+	"iter"
+
+	"google.golang.org/protobuf/proto"
+
+	c "github.com/FACorreiaa/loci-proto/modules/list/generated"
+	"github.com/FACorreiaa/loci-proto/pagination"
+)
+
+// AllLists iterates over every list of the user of in, fetching them a page
+// at a time. The limit and offset of in are ignored in favour of opts.
+func (b *Broker) AllLists(ctx context.Context, in *c.GetListsRequest, opts ...pagination.Option) iter.Seq2[*c.ListWithItems, error] {
+	return pagination.New(func(ctx context.Context, req pagination.Request) (pagination.Page[*c.ListWithItems], error) {
+		page := proto.CloneOf(in)
+		page.Limit, page.Offset = req.Limit, req.Offset
+
+		resp, err := b.GetLists(ctx, page)
+		if err != nil {
+			return pagination.Page[*c.ListWithItems]{}, err
+		}
+
+		return pagination.PageFromTotal(resp.GetLists(), resp.GetTotalCount(), req), nil
+	}, opts...).All(ctx)
+}
+
+// AllPublicLists iterates over every public list matching in, fetching them
+// a page at a time. The limit and offset of in are ignored in favour of
+// opts.
+func (b *Broker) AllPublicLists(ctx context.Context, in *c.SearchPublicListsRequest, opts ...pagination.Option) iter.Seq2[*c.ListWithItems, error] {
+	return pagination.New(func(ctx context.Context, req pagination.Request) (pagination.Page[*c.ListWithItems], error) {
+		page := proto.CloneOf(in)
+		page.Limit, page.Offset = req.Limit, req.Offset
+
+		resp, err := b.SearchPublicLists(ctx, page)
+		if err != nil {
+			return pagination.Page[*c.ListWithItems]{}, err
+		}
+
+		return pagination.PageFromTotal(resp.GetLists(), resp.GetTotalCount(), req), nil
+	}, opts...).All(ctx)
+}
@@ -0,0 +1,123 @@
+package poi
+
+import (
+	"maps"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+
+	helpers "github.com/FACorreiaa/loci-proto/modules/common"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	c "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+)
+
+// POIToCommon converts a POIDetailedInfo to the canonical POI. The
+// conversion is lossless: POIFromCommon turns the result back into a message
+// equal to p.
+func POIToCommon(p *c.POIDetailedInfo) *common.POI {
+	if p == nil {
+		return nil
+	}
+
+	out := &common.POI{
+		Id:                   p.GetId(),
+		Name:                 p.GetName(),
+		Location:             helpers.NewPOILocation(p.GetLatitude(), p.GetLongitude()),
+		Category:             p.GetCategory(),
+		Subcategory:          p.GetSubcategory(),
+		Description:          p.GetDescription(),
+		Rating:               helpers.NewPOIRating(p.GetRating(), p.GetReviewCount()),
+		PriceRange:           helpers.PriceRangeFromLabel(p.GetPriceRange()),
+		PriceLabel:           p.GetPriceRange(),
+		PriceLevel:           p.GetPriceLevel(),
+		Address:              helpers.NewPOIAddress(p.GetAddress(), p.GetCityName(), p.GetCountry()),
+		Contact:              helpers.NewPOIContact(p.GetPhone(), p.GetEmail(), p.GetWebsite()),
+		PhoneNumber:          p.GetPhoneNumber(),
+		OpeningHoursText:     slices.Clone(p.GetOpeningHours()),
+		Media:                helpers.MediaFromURLs(p.GetPhotos()),
+		Amenities:            slices.Clone(p.GetAmenities()),
+		Distance:             p.GetDistance(),
+		DistanceMeters:       p.GetDistanceMeters(),
+		LocalizedName:        proto.CloneOf(p.GetLocalizedName()),
+		LocalizedDescription: proto.CloneOf(p.GetLocalizedDescription()),
+		CityId:               p.GetCityId(),
+		IsVerified:           p.GetIsVerified(),
+		Source:               p.GetSource(),
+		Metadata:             maps.Clone(p.GetMetadata()),
+		CreatedAt:            proto.CloneOf(p.GetCreatedAt()),
+		UpdatedAt:            proto.CloneOf(p.GetUpdatedAt()),
+	}
+
+	for _, tag := range p.GetTags() {
+		out.Tags = append(out.Tags, &common.POITag{
+			Id:          tag.GetId(),
+			UserId:      tag.GetUserId(),
+			Name:        tag.GetName(),
+			TagType:     tag.GetTagType(),
+			Description: tag.GetDescription(),
+			Source:      tag.GetSource(),
+			CreatedAt:   proto.CloneOf(tag.GetCreatedAt()),
+			UpdatedAt:   proto.CloneOf(tag.GetUpdatedAt()),
+		})
+	}
+
+	return out
+}
+
+// POIFromCommon converts a canonical POI to a POIDetailedInfo. Structured
+// opening hours and media other than photo URLs have no counterpart and are
+// dropped.
+func POIFromCommon(p *common.POI) *c.POIDetailedInfo {
+	if p == nil {
+		return nil
+	}
+
+	out := &c.POIDetailedInfo{
+		Id:                   p.GetId(),
+		Name:                 p.GetName(),
+		Latitude:             p.GetLocation().GetLatitude(),
+		Longitude:            p.GetLocation().GetLongitude(),
+		Category:             p.GetCategory(),
+		Subcategory:          p.GetSubcategory(),
+		Description:          p.GetDescription(),
+		Rating:               p.GetRating().GetAverage(),
+		ReviewCount:          p.GetRating().GetCount(),
+		PriceRange:           helpers.PriceLabel(p),
+		Address:              helpers.FormatAddress(p.GetAddress()),
+		Phone:                p.GetContact().GetPhone(),
+		Email:                p.GetContact().GetEmail(),
+		Website:              p.GetContact().GetWebsite(),
+		OpeningHours:         slices.Clone(p.GetOpeningHoursText()),
+		Photos:               helpers.PhotoURLs(p.GetMedia()),
+		Amenities:            slices.Clone(p.GetAmenities()),
+		Distance:             p.GetDistance(),
+		CityId:               p.GetCityId(),
+		CityName:             p.GetAddress().GetCity(),
+		Country:              p.GetAddress().GetCountry(),
+		IsVerified:           p.GetIsVerified(),
+		Metadata:             maps.Clone(p.GetMetadata()),
+		CreatedAt:            proto.CloneOf(p.GetCreatedAt()),
+		UpdatedAt:            proto.CloneOf(p.GetUpdatedAt()),
+		PhoneNumber:          p.GetPhoneNumber(),
+		PriceLevel:           p.GetPriceLevel(),
+		Source:               p.GetSource(),
+		DistanceMeters:       p.GetDistanceMeters(),
+		LocalizedName:        proto.CloneOf(p.GetLocalizedName()),
+		LocalizedDescription: proto.CloneOf(p.GetLocalizedDescription()),
+	}
+
+	for _, tag := range p.GetTags() {
+		out.Tags = append(out.Tags, &c.Tags{
+			Id:          tag.GetId(),
+			UserId:      tag.GetUserId(),
+			Name:        tag.GetName(),
+			TagType:     tag.GetTagType(),
+			Description: tag.GetDescription(),
+			Source:      tag.GetSource(),
+			CreatedAt:   proto.CloneOf(tag.GetCreatedAt()),
+			UpdatedAt:   proto.CloneOf(tag.GetUpdatedAt()),
+		})
+	}
+
+	return out
+}
@@ -7,6 +7,8 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -24,38 +26,42 @@ const (
 
 // Core POI entity
 type POIDetailedInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
-	Subcategory   string                 `protobuf:"bytes,6,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	Rating        float64                `protobuf:"fixed64,8,opt,name=rating,proto3" json:"rating,omitempty"`
-	ReviewCount   int32                  `protobuf:"varint,9,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
-	PriceRange    string                 `protobuf:"bytes,10,opt,name=price_range,json=priceRange,proto3" json:"price_range,omitempty"` // Free, €, €€, €€€
-	Address       string                 `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
-	Phone         string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,13,opt,name=email,proto3" json:"email,omitempty"`
-	Website       string                 `protobuf:"bytes,14,opt,name=website,proto3" json:"website,omitempty"`
-	OpeningHours  []string               `protobuf:"bytes,15,rep,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
-	Photos        []string               `protobuf:"bytes,16,rep,name=photos,proto3" json:"photos,omitempty"`
-	Amenities     []string               `protobuf:"bytes,17,rep,name=amenities,proto3" json:"amenities,omitempty"`
-	Distance      string                 `protobuf:"bytes,18,opt,name=distance,proto3" json:"distance,omitempty"` // Distance from search point
-	CityId        string                 `protobuf:"bytes,19,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
-	CityName      string                 `protobuf:"bytes,20,opt,name=city_name,json=cityName,proto3" json:"city_name,omitempty"`
-	Country       string                 `protobuf:"bytes,21,opt,name=country,proto3" json:"country,omitempty"`
-	IsVerified    bool                   `protobuf:"varint,22,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,23,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Tags          []*Tags                `protobuf:"bytes,26,rep,name=tags,proto3" json:"tags,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,27,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	PriceLevel    string                 `protobuf:"bytes,28,opt,name=price_level,json=priceLevel,proto3" json:"price_level,omitempty"`
-	Source        string                 `protobuf:"bytes,29,opt,name=source,proto3" json:"source,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude       float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude      float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Category       string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Subcategory    string                 `protobuf:"bytes,6,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
+	Description    string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Rating         float64                `protobuf:"fixed64,8,opt,name=rating,proto3" json:"rating,omitempty"`
+	ReviewCount    int32                  `protobuf:"varint,9,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	PriceRange     string                 `protobuf:"bytes,10,opt,name=price_range,json=priceRange,proto3" json:"price_range,omitempty"` // Free, €, €€, €€€
+	Address        string                 `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	Phone          string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email          string                 `protobuf:"bytes,13,opt,name=email,proto3" json:"email,omitempty"`
+	Website        string                 `protobuf:"bytes,14,opt,name=website,proto3" json:"website,omitempty"`
+	OpeningHours   []string               `protobuf:"bytes,15,rep,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	Photos         []string               `protobuf:"bytes,16,rep,name=photos,proto3" json:"photos,omitempty"`
+	Amenities      []string               `protobuf:"bytes,17,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	Distance       string                 `protobuf:"bytes,18,opt,name=distance,proto3" json:"distance,omitempty"` // Distance from search point
+	CityId         string                 `protobuf:"bytes,19,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	CityName       string                 `protobuf:"bytes,20,opt,name=city_name,json=cityName,proto3" json:"city_name,omitempty"`
+	Country        string                 `protobuf:"bytes,21,opt,name=country,proto3" json:"country,omitempty"`
+	IsVerified     bool                   `protobuf:"varint,22,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	Metadata       map[string]string      `protobuf:"bytes,23,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Tags           []*Tags                `protobuf:"bytes,26,rep,name=tags,proto3" json:"tags,omitempty"`
+	PhoneNumber    string                 `protobuf:"bytes,27,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	PriceLevel     string                 `protobuf:"bytes,28,opt,name=price_level,json=priceLevel,proto3" json:"price_level,omitempty"`
+	Source         string                 `protobuf:"bytes,29,opt,name=source,proto3" json:"source,omitempty"`
+	DistanceMeters float64                `protobuf:"fixed64,30,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"` // Distance from search point, set alongside distance
+	// Translations of name and description, which hold the default language
+	LocalizedName        *generated.MultilingualText `protobuf:"bytes,31,opt,name=localized_name,json=localizedName,proto3" json:"localized_name,omitempty"`
+	LocalizedDescription *generated.MultilingualText `protobuf:"bytes,32,opt,name=localized_description,json=localizedDescription,proto3" json:"localized_description,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *POIDetailedInfo) Reset() {
@@ -291,6 +297,27 @@ func (x *POIDetailedInfo) GetSource() string {
 	return ""
 }
 
+func (x *POIDetailedInfo) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *POIDetailedInfo) GetLocalizedName() *generated.MultilingualText {
+	if x != nil {
+		return x.LocalizedName
+	}
+	return nil
+}
+
+func (x *POIDetailedInfo) GetLocalizedDescription() *generated.MultilingualText {
+	if x != nil {
+		return x.LocalizedDescription
+	}
+	return nil
+}
+
 // Restaurant-specific information
 type RestaurantDetailedInfo struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -685,7 +712,7 @@ type GetPOIsByCityRequest struct {
 	CityId        string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -741,7 +768,7 @@ func (x *GetPOIsByCityRequest) GetOffset() int32 {
 	return 0
 }
 
-func (x *GetPOIsByCityRequest) GetRequest() *BaseRequest {
+func (x *GetPOIsByCityRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -749,10 +776,10 @@ func (x *GetPOIsByCityRequest) GetRequest() *BaseRequest {
 }
 
 type GetPOIsByCityResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pois          []*POIDetailedInfo     `protobuf:"bytes,1,rep,name=pois,proto3" json:"pois,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Pois          []*POIDetailedInfo      `protobuf:"bytes,1,rep,name=pois,proto3" json:"pois,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -801,7 +828,7 @@ func (x *GetPOIsByCityResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *GetPOIsByCityResponse) GetResponse() *BaseResponse {
+func (x *GetPOIsByCityResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -811,7 +838,7 @@ func (x *GetPOIsByCityResponse) GetResponse() *BaseResponse {
 type SearchPOIsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filter        *POIFilter             `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -853,7 +880,7 @@ func (x *SearchPOIsRequest) GetFilter() *POIFilter {
 	return nil
 }
 
-func (x *SearchPOIsRequest) GetRequest() *BaseRequest {
+func (x *SearchPOIsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -861,11 +888,11 @@ func (x *SearchPOIsRequest) GetRequest() *BaseRequest {
 }
 
 type SearchPOIsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pois          []*POIDetailedInfo     `protobuf:"bytes,1,rep,name=pois,proto3" json:"pois,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Metadata      *SearchMetadata        `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Pois          []*POIDetailedInfo      `protobuf:"bytes,1,rep,name=pois,proto3" json:"pois,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Metadata      *SearchMetadata         `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -921,7 +948,7 @@ func (x *SearchPOIsResponse) GetMetadata() *SearchMetadata {
 	return nil
 }
 
-func (x *SearchPOIsResponse) GetResponse() *BaseResponse {
+func (x *SearchPOIsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -934,7 +961,7 @@ type SearchPOIsSemanticRequest struct {
 	Location          *GeoPoint              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
 	Limit             int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	SemanticThreshold float64                `protobuf:"fixed64,4,opt,name=semantic_threshold,json=semanticThreshold,proto3" json:"semantic_threshold,omitempty"`
-	Request           *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request           *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -997,7 +1024,7 @@ func (x *SearchPOIsSemanticRequest) GetSemanticThreshold() float64 {
 	return 0
 }
 
-func (x *SearchPOIsSemanticRequest) GetRequest() *BaseRequest {
+func (x *SearchPOIsSemanticRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1010,7 +1037,7 @@ type SearchPOIsSemanticByCityRequest struct {
 	CityId            string                 `protobuf:"bytes,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
 	Limit             int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	SemanticThreshold float64                `protobuf:"fixed64,4,opt,name=semantic_threshold,json=semanticThreshold,proto3" json:"semantic_threshold,omitempty"`
-	Request           *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request           *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -1073,7 +1100,7 @@ func (x *SearchPOIsSemanticByCityRequest) GetSemanticThreshold() float64 {
 	return 0
 }
 
-func (x *SearchPOIsSemanticByCityRequest) GetRequest() *BaseRequest {
+func (x *SearchPOIsSemanticByCityRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1081,11 +1108,11 @@ func (x *SearchPOIsSemanticByCityRequest) GetRequest() *BaseRequest {
 }
 
 type SearchPOIsSemanticResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pois          []*POISemanticMatch    `protobuf:"bytes,1,rep,name=pois,proto3" json:"pois,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Metadata      *SearchMetadata        `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Pois          []*POISemanticMatch     `protobuf:"bytes,1,rep,name=pois,proto3" json:"pois,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Metadata      *SearchMetadata         `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1141,7 +1168,7 @@ func (x *SearchPOIsSemanticResponse) GetMetadata() *SearchMetadata {
 	return nil
 }
 
-func (x *SearchPOIsSemanticResponse) GetResponse() *BaseResponse {
+func (x *SearchPOIsSemanticResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1213,7 +1240,7 @@ type SearchPOIsHybridRequest struct {
 	Filter         *POIFilter             `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	SemanticQuery  string                 `protobuf:"bytes,2,opt,name=semantic_query,json=semanticQuery,proto3" json:"semantic_query,omitempty"`
 	SemanticWeight float64                `protobuf:"fixed64,3,opt,name=semantic_weight,json=semanticWeight,proto3" json:"semantic_weight,omitempty"` // 0.0-1.0, weight between spatial and semantic
-	Request        *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request        *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1269,7 +1296,7 @@ func (x *SearchPOIsHybridRequest) GetSemanticWeight() float64 {
 	return 0
 }
 
-func (x *SearchPOIsHybridRequest) GetRequest() *BaseRequest {
+func (x *SearchPOIsHybridRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1277,11 +1304,11 @@ func (x *SearchPOIsHybridRequest) GetRequest() *BaseRequest {
 }
 
 type SearchPOIsHybridResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pois          []*POIHybridMatch      `protobuf:"bytes,1,rep,name=pois,proto3" json:"pois,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Metadata      *SearchMetadata        `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Pois          []*POIHybridMatch       `protobuf:"bytes,1,rep,name=pois,proto3" json:"pois,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Metadata      *SearchMetadata         `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1337,7 +1364,7 @@ func (x *SearchPOIsHybridResponse) GetMetadata() *SearchMetadata {
 	return nil
 }
 
-func (x *SearchPOIsHybridResponse) GetResponse() *BaseResponse {
+func (x *SearchPOIsHybridResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1480,7 +1507,7 @@ type GetNearbyRecommendationsRequest struct {
 	UserId              string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For personalized recommendations
 	PreferredCategories []string               `protobuf:"bytes,4,rep,name=preferred_categories,json=preferredCategories,proto3" json:"preferred_categories,omitempty"`
 	Limit               int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	Request             *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request             *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -1550,7 +1577,7 @@ func (x *GetNearbyRecommendationsRequest) GetLimit() int32 {
 	return 0
 }
 
-func (x *GetNearbyRecommendationsRequest) GetRequest() *BaseRequest {
+func (x *GetNearbyRecommendationsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1561,7 +1588,7 @@ type GetNearbyRecommendationsResponse struct {
 	state           protoimpl.MessageState  `protogen:"open.v1"`
 	Recommendations []*POIRecommendation    `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
 	Metadata        *RecommendationMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Response        *BaseResponse           `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Response        *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1610,7 +1637,7 @@ func (x *GetNearbyRecommendationsResponse) GetMetadata() *RecommendationMetadata
 	return nil
 }
 
-func (x *GetNearbyRecommendationsResponse) GetResponse() *BaseResponse {
+func (x *GetNearbyRecommendationsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1746,7 +1773,7 @@ type DiscoverRestaurantsRequest struct {
 	PriceRanges   []string               `protobuf:"bytes,4,rep,name=price_ranges,json=priceRanges,proto3" json:"price_ranges,omitempty"`
 	MinRating     float64                `protobuf:"fixed64,5,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
 	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1823,7 +1850,7 @@ func (x *DiscoverRestaurantsRequest) GetLimit() int32 {
 	return 0
 }
 
-func (x *DiscoverRestaurantsRequest) GetRequest() *BaseRequest {
+func (x *DiscoverRestaurantsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1834,7 +1861,7 @@ type DiscoverRestaurantsResponse struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Restaurants   []*RestaurantDetailedInfo `protobuf:"bytes,1,rep,name=restaurants,proto3" json:"restaurants,omitempty"`
 	TotalCount    int32                     `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse             `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Response      *generated.BaseResponse   `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1883,7 +1910,7 @@ func (x *DiscoverRestaurantsResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *DiscoverRestaurantsResponse) GetResponse() *BaseResponse {
+func (x *DiscoverRestaurantsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1897,7 +1924,7 @@ type DiscoverActivitiesRequest struct {
 	ActivityTypes []string               `protobuf:"bytes,3,rep,name=activity_types,json=activityTypes,proto3" json:"activity_types,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	Duration      string                 `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1967,7 +1994,7 @@ func (x *DiscoverActivitiesRequest) GetDuration() string {
 	return ""
 }
 
-func (x *DiscoverActivitiesRequest) GetRequest() *BaseRequest {
+func (x *DiscoverActivitiesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1975,10 +2002,10 @@ func (x *DiscoverActivitiesRequest) GetRequest() *BaseRequest {
 }
 
 type DiscoverActivitiesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Activities    []*POIDetailedInfo     `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Activities    []*POIDetailedInfo      `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2027,7 +2054,7 @@ func (x *DiscoverActivitiesResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *DiscoverActivitiesResponse) GetResponse() *BaseResponse {
+func (x *DiscoverActivitiesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2042,7 +2069,7 @@ type DiscoverHotelsRequest struct {
 	StarRatings   []int32                `protobuf:"varint,4,rep,packed,name=star_ratings,json=starRatings,proto3" json:"star_ratings,omitempty"`
 	PriceRanges   []string               `protobuf:"bytes,5,rep,name=price_ranges,json=priceRanges,proto3" json:"price_ranges,omitempty"`
 	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2119,7 +2146,7 @@ func (x *DiscoverHotelsRequest) GetLimit() int32 {
 	return 0
 }
 
-func (x *DiscoverHotelsRequest) GetRequest() *BaseRequest {
+func (x *DiscoverHotelsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2127,10 +2154,10 @@ func (x *DiscoverHotelsRequest) GetRequest() *BaseRequest {
 }
 
 type DiscoverHotelsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hotels        []*HotelDetailedInfo   `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Hotels        []*HotelDetailedInfo    `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2179,7 +2206,7 @@ func (x *DiscoverHotelsResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *DiscoverHotelsResponse) GetResponse() *BaseResponse {
+func (x *DiscoverHotelsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2192,7 +2219,7 @@ type DiscoverAttractionsRequest struct {
 	RadiusMeters    float64                `protobuf:"fixed64,2,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
 	AttractionTypes []string               `protobuf:"bytes,3,rep,name=attraction_types,json=attractionTypes,proto3" json:"attraction_types,omitempty"`
 	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Request         *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request         *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -2255,7 +2282,7 @@ func (x *DiscoverAttractionsRequest) GetLimit() int32 {
 	return 0
 }
 
-func (x *DiscoverAttractionsRequest) GetRequest() *BaseRequest {
+func (x *DiscoverAttractionsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2263,10 +2290,10 @@ func (x *DiscoverAttractionsRequest) GetRequest() *BaseRequest {
 }
 
 type DiscoverAttractionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attractions   []*POIDetailedInfo     `protobuf:"bytes,1,rep,name=attractions,proto3" json:"attractions,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Attractions   []*POIDetailedInfo      `protobuf:"bytes,1,rep,name=attractions,proto3" json:"attractions,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2315,7 +2342,7 @@ func (x *DiscoverAttractionsResponse) GetTotalCount() int32 {
 	return 0
 }
 
-func (x *DiscoverAttractionsResponse) GetResponse() *BaseResponse {
+func (x *DiscoverAttractionsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2329,7 +2356,7 @@ type AddToFavoritesRequest struct {
 	PoiId         string                 `protobuf:"bytes,2,opt,name=poi_id,json=poiId,proto3" json:"poi_id,omitempty"`
 	IsLlmPoi      bool                   `protobuf:"varint,3,opt,name=is_llm_poi,json=isLlmPoi,proto3" json:"is_llm_poi,omitempty"`
 	PoiData       *POIDetailedInfo       `protobuf:"bytes,4,opt,name=poi_data,json=poiData,proto3" json:"poi_data,omitempty"` // For LLM-generated POIs
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2392,7 +2419,7 @@ func (x *AddToFavoritesRequest) GetPoiData() *POIDetailedInfo {
 	return nil
 }
 
-func (x *AddToFavoritesRequest) GetRequest() *BaseRequest {
+func (x *AddToFavoritesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2400,12 +2427,12 @@ func (x *AddToFavoritesRequest) GetRequest() *BaseRequest {
 }
 
 type AddToFavoritesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	PoiId         string                 `protobuf:"bytes,3,opt,name=poi_id,json=poiId,proto3" json:"poi_id,omitempty"` // Actual POI ID after processing
-	FavoriteId    string                 `protobuf:"bytes,4,opt,name=favorite_id,json=favoriteId,proto3" json:"favorite_id,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PoiId         string                  `protobuf:"bytes,3,opt,name=poi_id,json=poiId,proto3" json:"poi_id,omitempty"` // Actual POI ID after processing
+	FavoriteId    string                  `protobuf:"bytes,4,opt,name=favorite_id,json=favoriteId,proto3" json:"favorite_id,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2468,7 +2495,7 @@ func (x *AddToFavoritesResponse) GetFavoriteId() string {
 	return ""
 }
 
-func (x *AddToFavoritesResponse) GetResponse() *BaseResponse {
+func (x *AddToFavoritesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2481,7 +2508,7 @@ type RemoveFromFavoritesRequest struct {
 	PoiId         string                 `protobuf:"bytes,2,opt,name=poi_id,json=poiId,proto3" json:"poi_id,omitempty"`
 	IsLlmPoi      bool                   `protobuf:"varint,3,opt,name=is_llm_poi,json=isLlmPoi,proto3" json:"is_llm_poi,omitempty"`
 	PoiData       *POIDetailedInfo       `protobuf:"bytes,4,opt,name=poi_data,json=poiData,proto3" json:"poi_data,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2544,7 +2571,7 @@ func (x *RemoveFromFavoritesRequest) GetPoiData() *POIDetailedInfo {
 	return nil
 }
 
-func (x *RemoveFromFavoritesRequest) GetRequest() *BaseRequest {
+func (x *RemoveFromFavoritesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2552,11 +2579,11 @@ func (x *RemoveFromFavoritesRequest) GetRequest() *BaseRequest {
 }
 
 type RemoveFromFavoritesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	PoiId         string                 `protobuf:"bytes,3,opt,name=poi_id,json=poiId,proto3" json:"poi_id,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PoiId         string                  `protobuf:"bytes,3,opt,name=poi_id,json=poiId,proto3" json:"poi_id,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2612,7 +2639,7 @@ func (x *RemoveFromFavoritesResponse) GetPoiId() string {
 	return ""
 }
 
-func (x *RemoveFromFavoritesResponse) GetResponse() *BaseResponse {
+func (x *RemoveFromFavoritesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2626,7 +2653,7 @@ type GetFavoritesRequest struct {
 	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2696,7 +2723,7 @@ func (x *GetFavoritesRequest) GetOffset() int32 {
 	return 0
 }
 
-func (x *GetFavoritesRequest) GetRequest() *BaseRequest {
+func (x *GetFavoritesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2704,14 +2731,14 @@ func (x *GetFavoritesRequest) GetRequest() *BaseRequest {
 }
 
 type GetFavoritesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Favorites     []*POIDetailedInfo     `protobuf:"bytes,1,rep,name=favorites,proto3" json:"favorites,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	CurrentPage   int32                  `protobuf:"varint,3,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Favorites     []*POIDetailedInfo      `protobuf:"bytes,1,rep,name=favorites,proto3" json:"favorites,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	CurrentPage   int32                   `protobuf:"varint,3,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	TotalPages    int32                   `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Limit         int32                   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                   `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2788,7 +2815,7 @@ func (x *GetFavoritesResponse) GetOffset() int32 {
 	return 0
 }
 
-func (x *GetFavoritesResponse) GetResponse() *BaseResponse {
+func (x *GetFavoritesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2803,7 +2830,7 @@ type GetItinerariesRequest struct {
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2873,7 +2900,7 @@ func (x *GetItinerariesRequest) GetPageSize() int32 {
 	return 0
 }
 
-func (x *GetItinerariesRequest) GetRequest() *BaseRequest {
+func (x *GetItinerariesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2881,12 +2908,12 @@ func (x *GetItinerariesRequest) GetRequest() *BaseRequest {
 }
 
 type GetItinerariesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Itineraries   []*UserItinerary       `protobuf:"bytes,1,rep,name=itineraries,proto3" json:"itineraries,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Itineraries   []*UserItinerary        `protobuf:"bytes,1,rep,name=itineraries,proto3" json:"itineraries,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                   `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2949,7 +2976,7 @@ func (x *GetItinerariesResponse) GetPageSize() int32 {
 	return 0
 }
 
-func (x *GetItinerariesResponse) GetResponse() *BaseResponse {
+func (x *GetItinerariesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2960,7 +2987,7 @@ type GetItineraryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ItineraryId   string                 `protobuf:"bytes,2,opt,name=itinerary_id,json=itineraryId,proto3" json:"itinerary_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3009,7 +3036,7 @@ func (x *GetItineraryRequest) GetItineraryId() string {
 	return ""
 }
 
-func (x *GetItineraryRequest) GetRequest() *BaseRequest {
+func (x *GetItineraryRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -3017,9 +3044,9 @@ func (x *GetItineraryRequest) GetRequest() *BaseRequest {
 }
 
 type GetItineraryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Itinerary     *UserItinerary         `protobuf:"bytes,1,opt,name=itinerary,proto3" json:"itinerary,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Itinerary     *UserItinerary          `protobuf:"bytes,1,opt,name=itinerary,proto3" json:"itinerary,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3061,7 +3088,7 @@ func (x *GetItineraryResponse) GetItinerary() *UserItinerary {
 	return nil
 }
 
-func (x *GetItineraryResponse) GetResponse() *BaseResponse {
+func (x *GetItineraryResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -3075,7 +3102,7 @@ type UpdateItineraryRequest struct {
 	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	MarkdownContent string                 `protobuf:"bytes,5,opt,name=markdown_content,json=markdownContent,proto3" json:"markdown_content,omitempty"`
-	Request         *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request         *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -3145,7 +3172,7 @@ func (x *UpdateItineraryRequest) GetMarkdownContent() string {
 	return ""
 }
 
-func (x *UpdateItineraryRequest) GetRequest() *BaseRequest {
+func (x *UpdateItineraryRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -3153,11 +3180,11 @@ func (x *UpdateItineraryRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateItineraryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Itinerary     *UserItinerary         `protobuf:"bytes,3,opt,name=itinerary,proto3" json:"itinerary,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Itinerary     *UserItinerary          `protobuf:"bytes,3,opt,name=itinerary,proto3" json:"itinerary,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3213,7 +3240,7 @@ func (x *UpdateItineraryResponse) GetItinerary() *UserItinerary {
 	return nil
 }
 
-func (x *UpdateItineraryResponse) GetResponse() *BaseResponse {
+func (x *UpdateItineraryResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -3457,7 +3484,7 @@ type GenerateEmbeddingsRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	BatchSize       int32                  `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 	ForceRegenerate bool                   `protobuf:"varint,2,opt,name=force_regenerate,json=forceRegenerate,proto3" json:"force_regenerate,omitempty"`
-	Request         *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request         *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -3506,7 +3533,7 @@ func (x *GenerateEmbeddingsRequest) GetForceRegenerate() bool {
 	return false
 }
 
-func (x *GenerateEmbeddingsRequest) GetRequest() *BaseRequest {
+func (x *GenerateEmbeddingsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -3514,14 +3541,14 @@ func (x *GenerateEmbeddingsRequest) GetRequest() *BaseRequest {
 }
 
 type GenerateEmbeddingsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ProcessedCount int32                  `protobuf:"varint,1,opt,name=processed_count,json=processedCount,proto3" json:"processed_count,omitempty"`
-	UpdatedCount   int32                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
-	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Errors         []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
-	Message        string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	Success        bool                   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
-	Response       *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	ProcessedCount int32                   `protobuf:"varint,1,opt,name=processed_count,json=processedCount,proto3" json:"processed_count,omitempty"`
+	UpdatedCount   int32                   `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	Status         string                  `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Errors         []string                `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	Message        string                  `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Success        bool                    `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	Response       *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -3598,13 +3625,17 @@ func (x *GenerateEmbeddingsResponse) GetSuccess() bool {
 	return false
 }
 
-func (x *GenerateEmbeddingsResponse) GetResponse() *BaseResponse {
+func (x *GenerateEmbeddingsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in poi.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -3657,6 +3688,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in poi.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -3721,7 +3756,7 @@ var File_poi_proto protoreflect.FileDescriptor
 
 const file_poi_proto_rawDesc = "" +
 	"\n" +
-	"\tpoi.proto\x12\rai_poi.poi.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf5\a\n" +
+	"\tpoi.proto\x12\rai_poi.poi.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xd2\t\n" +
 	"\x0fPOIDetailedInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -3757,7 +3792,10 @@ const file_poi_proto_rawDesc = "" +
 	"\fphone_number\x18\x1b \x01(\tR\vphoneNumber\x12\x1f\n" +
 	"\vprice_level\x18\x1c \x01(\tR\n" +
 	"priceLevel\x12\x16\n" +
-	"\x06source\x18\x1d \x01(\tR\x06source\x1a;\n" +
+	"\x06source\x18\x1d \x01(\tR\x06source\x127\n" +
+	"\x0fdistance_meters\x18\x1e \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x0edistanceMeters\x12I\n" +
+	"\x0elocalized_name\x18\x1f \x01(\v2\".ai_poi.common.v1.MultilingualTextR\rlocalizedName\x12W\n" +
+	"\x15localized_description\x18  \x01(\v2\".ai_poi.common.v1.MultilingualTextR\x14localizedDescription\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc0\x02\n" +
@@ -3781,80 +3819,80 @@ const file_poi_proto_rawDesc = "" +
 	"\x0echeck_out_time\x18\x06 \x01(\tR\fcheckOutTime\x12!\n" +
 	"\fpet_friendly\x18\a \x01(\bR\vpetFriendly\x12+\n" +
 	"\x11parking_available\x18\b \x01(\bR\x10parkingAvailable\x12#\n" +
-	"\rproperty_type\x18\t \x01(\tR\fpropertyType\"Z\n" +
-	"\bGeoPoint\x12\x1a\n" +
-	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x01R\x05limit\"\xdc\x02\n" +
+	"\rproperty_type\x18\t \x01(\tR\fpropertyType\"\x8c\x01\n" +
+	"\bGeoPoint\x123\n" +
+	"\blatitude\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x125\n" +
+	"\tlongitude\x18\x02 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x01R\x05limit\"\x85\x03\n" +
 	"\tPOIFilter\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x123\n" +
-	"\blocation\x18\x02 \x01(\v2\x17.ai_poi.poi.v1.GeoPointR\blocation\x12#\n" +
-	"\rradius_meters\x18\x03 \x01(\x01R\fradiusMeters\x12\x1e\n" +
+	"\blocation\x18\x02 \x01(\v2\x17.ai_poi.poi.v1.GeoPointR\blocation\x123\n" +
+	"\rradius_meters\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\fradiusMeters\x12\x1e\n" +
 	"\n" +
 	"categories\x18\x04 \x03(\tR\n" +
 	"categories\x12!\n" +
-	"\fprice_ranges\x18\x05 \x03(\tR\vpriceRanges\x12\x1d\n" +
+	"\fprice_ranges\x18\x05 \x03(\tR\vpriceRanges\x126\n" +
 	"\n" +
-	"min_rating\x18\x06 \x01(\x01R\tminRating\x12\x17\n" +
+	"min_rating\x18\x06 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\x00\x00R\tminRating\x12\x17\n" +
 	"\asort_by\x18\a \x01(\tR\x06sortBy\x12\x1d\n" +
 	"\n" +
 	"sort_order\x18\b \x01(\tR\tsortOrder\x12\x14\n" +
 	"\x05limit\x18\t \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\n" +
 	" \x01(\x05R\x06offset\x12\x17\n" +
-	"\acity_id\x18\v \x01(\tR\x06cityId\"\x93\x01\n" +
+	"\acity_id\x18\v \x01(\tR\x06cityId\"\x96\x01\n" +
 	"\x14GetPOIsByCityRequest\x12\x17\n" +
 	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xa5\x01\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xa8\x01\n" +
 	"\x15GetPOIsByCityResponse\x122\n" +
 	"\x04pois\x18\x01 \x03(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\x04pois\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"{\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"~\n" +
 	"\x11SearchPOIsRequest\x120\n" +
-	"\x06filter\x18\x01 \x01(\v2\x18.ai_poi.poi.v1.POIFilterR\x06filter\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xdd\x01\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.ai_poi.poi.v1.POIFilterR\x06filter\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xe0\x01\n" +
 	"\x12SearchPOIsResponse\x122\n" +
 	"\x04pois\x18\x01 \x03(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\x04pois\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x129\n" +
-	"\bmetadata\x18\x03 \x01(\v2\x1d.ai_poi.poi.v1.SearchMetadataR\bmetadata\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xe1\x01\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1d.ai_poi.poi.v1.SearchMetadataR\bmetadata\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xe4\x01\n" +
 	"\x19SearchPOIsSemanticRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x123\n" +
 	"\blocation\x18\x02 \x01(\v2\x17.ai_poi.poi.v1.GeoPointR\blocation\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12-\n" +
-	"\x12semantic_threshold\x18\x04 \x01(\x01R\x11semanticThreshold\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xcb\x01\n" +
+	"\x12semantic_threshold\x18\x04 \x01(\x01R\x11semanticThreshold\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xce\x01\n" +
 	"\x1fSearchPOIsSemanticByCityRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x17\n" +
 	"\acity_id\x18\x02 \x01(\tR\x06cityId\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12-\n" +
-	"\x12semantic_threshold\x18\x04 \x01(\x01R\x11semanticThreshold\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xe6\x01\n" +
+	"\x12semantic_threshold\x18\x04 \x01(\x01R\x11semanticThreshold\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xe9\x01\n" +
 	"\x1aSearchPOIsSemanticResponse\x123\n" +
 	"\x04pois\x18\x01 \x03(\v2\x1f.ai_poi.poi.v1.POISemanticMatchR\x04pois\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x129\n" +
-	"\bmetadata\x18\x03 \x01(\v2\x1d.ai_poi.poi.v1.SearchMetadataR\bmetadata\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\x92\x01\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1d.ai_poi.poi.v1.SearchMetadataR\bmetadata\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x92\x01\n" +
 	"\x10POISemanticMatch\x120\n" +
 	"\x03poi\x18\x01 \x01(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\x03poi\x12)\n" +
 	"\x10similarity_score\x18\x02 \x01(\x01R\x0fsimilarityScore\x12!\n" +
-	"\fmatch_reason\x18\x03 \x01(\tR\vmatchReason\"\xd1\x01\n" +
+	"\fmatch_reason\x18\x03 \x01(\tR\vmatchReason\"\xed\x01\n" +
 	"\x17SearchPOIsHybridRequest\x120\n" +
 	"\x06filter\x18\x01 \x01(\v2\x18.ai_poi.poi.v1.POIFilterR\x06filter\x12%\n" +
-	"\x0esemantic_query\x18\x02 \x01(\tR\rsemanticQuery\x12'\n" +
-	"\x0fsemantic_weight\x18\x03 \x01(\x01R\x0esemanticWeight\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xe2\x01\n" +
+	"\x0esemantic_query\x18\x02 \x01(\tR\rsemanticQuery\x12@\n" +
+	"\x0fsemantic_weight\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x0esemanticWeight\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xe5\x01\n" +
 	"\x18SearchPOIsHybridResponse\x121\n" +
 	"\x04pois\x18\x01 \x03(\v2\x1d.ai_poi.poi.v1.POIHybridMatchR\x04pois\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x129\n" +
-	"\bmetadata\x18\x03 \x01(\v2\x1d.ai_poi.poi.v1.SearchMetadataR\bmetadata\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xb5\x01\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1d.ai_poi.poi.v1.SearchMetadataR\bmetadata\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb5\x01\n" +
 	"\x0ePOIHybridMatch\x120\n" +
 	"\x03poi\x18\x01 \x01(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\x03poi\x12#\n" +
 	"\rspatial_score\x18\x02 \x01(\x01R\fspatialScore\x12%\n" +
@@ -3867,18 +3905,18 @@ const file_poi_proto_rawDesc = "" +
 	"debug_info\x18\x03 \x03(\v2,.ai_poi.poi.v1.SearchMetadata.DebugInfoEntryR\tdebugInfo\x1a<\n" +
 	"\x0eDebugInfoEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x93\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x96\x02\n" +
 	"\x1fGetNearbyRecommendationsRequest\x123\n" +
 	"\blocation\x18\x01 \x01(\v2\x17.ai_poi.poi.v1.GeoPointR\blocation\x12#\n" +
 	"\rradius_meters\x18\x02 \x01(\x01R\fradiusMeters\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x121\n" +
 	"\x14preferred_categories\x18\x04 \x03(\tR\x13preferredCategories\x12\x14\n" +
-	"\x05limit\x18\x05 \x01(\x05R\x05limit\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xea\x01\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xed\x01\n" +
 	" GetNearbyRecommendationsResponse\x12J\n" +
 	"\x0frecommendations\x18\x01 \x03(\v2 .ai_poi.poi.v1.POIRecommendationR\x0frecommendations\x12A\n" +
-	"\bmetadata\x18\x02 \x01(\v2%.ai_poi.poi.v1.RecommendationMetadataR\bmetadata\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xc1\x01\n" +
+	"\bmetadata\x18\x02 \x01(\v2%.ai_poi.poi.v1.RecommendationMetadataR\bmetadata\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xc1\x01\n" +
 	"\x11POIRecommendation\x120\n" +
 	"\x03poi\x18\x01 \x01(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\x03poi\x121\n" +
 	"\x14recommendation_score\x18\x02 \x01(\x01R\x13recommendationScore\x123\n" +
@@ -3886,7 +3924,7 @@ const file_poi_proto_rawDesc = "" +
 	"\x04tags\x18\x04 \x03(\tR\x04tags\"v\n" +
 	"\x16RecommendationMetadata\x123\n" +
 	"\x15personalization_level\x18\x01 \x01(\tR\x14personalizationLevel\x12'\n" +
-	"\x0fapplied_filters\x18\x02 \x03(\tR\x0eappliedFilters\"\xa9\x02\n" +
+	"\x0fapplied_filters\x18\x02 \x03(\tR\x0eappliedFilters\"\xac\x02\n" +
 	"\x1aDiscoverRestaurantsRequest\x123\n" +
 	"\blocation\x18\x01 \x01(\v2\x17.ai_poi.poi.v1.GeoPointR\blocation\x12#\n" +
 	"\rradius_meters\x18\x02 \x01(\x01R\fradiusMeters\x12#\n" +
@@ -3894,84 +3932,84 @@ const file_poi_proto_rawDesc = "" +
 	"\fprice_ranges\x18\x04 \x03(\tR\vpriceRanges\x12\x1d\n" +
 	"\n" +
 	"min_rating\x18\x05 \x01(\x01R\tminRating\x12\x14\n" +
-	"\x05limit\x18\x06 \x01(\x05R\x05limit\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xc0\x01\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc3\x01\n" +
 	"\x1bDiscoverRestaurantsResponse\x12G\n" +
 	"\vrestaurants\x18\x01 \x03(\v2%.ai_poi.poi.v1.RestaurantDetailedInfoR\vrestaurants\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\x84\x02\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x87\x02\n" +
 	"\x19DiscoverActivitiesRequest\x123\n" +
 	"\blocation\x18\x01 \x01(\v2\x17.ai_poi.poi.v1.GeoPointR\blocation\x12#\n" +
 	"\rradius_meters\x18\x02 \x01(\x01R\fradiusMeters\x12%\n" +
 	"\x0eactivity_types\x18\x03 \x03(\tR\ractivityTypes\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1a\n" +
-	"\bduration\x18\x05 \x01(\tR\bduration\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xb6\x01\n" +
+	"\bduration\x18\x05 \x01(\tR\bduration\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xb9\x01\n" +
 	"\x1aDiscoverActivitiesResponse\x12>\n" +
 	"\n" +
 	"activities\x18\x01 \x03(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\n" +
 	"activities\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xaa\x02\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xad\x02\n" +
 	"\x15DiscoverHotelsRequest\x123\n" +
 	"\blocation\x18\x01 \x01(\v2\x17.ai_poi.poi.v1.GeoPointR\blocation\x12#\n" +
 	"\rradius_meters\x18\x02 \x01(\x01R\fradiusMeters\x12%\n" +
 	"\x0eproperty_types\x18\x03 \x03(\tR\rpropertyTypes\x12!\n" +
 	"\fstar_ratings\x18\x04 \x03(\x05R\vstarRatings\x12!\n" +
 	"\fprice_ranges\x18\x05 \x03(\tR\vpriceRanges\x12\x14\n" +
-	"\x05limit\x18\x06 \x01(\x05R\x05limit\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xac\x01\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xaf\x01\n" +
 	"\x16DiscoverHotelsResponse\x128\n" +
 	"\x06hotels\x18\x01 \x03(\v2 .ai_poi.poi.v1.HotelDetailedInfoR\x06hotels\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xed\x01\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xf0\x01\n" +
 	"\x1aDiscoverAttractionsRequest\x123\n" +
 	"\blocation\x18\x01 \x01(\v2\x17.ai_poi.poi.v1.GeoPointR\blocation\x12#\n" +
 	"\rradius_meters\x18\x02 \x01(\x01R\fradiusMeters\x12)\n" +
 	"\x10attraction_types\x18\x03 \x03(\tR\x0fattractionTypes\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xb9\x01\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xbc\x01\n" +
 	"\x1bDiscoverAttractionsResponse\x12@\n" +
 	"\vattractions\x18\x01 \x03(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\vattractions\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xd6\x01\n" +
+	"totalCount\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xd9\x01\n" +
 	"\x15AddToFavoritesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
 	"\x06poi_id\x18\x02 \x01(\tR\x05poiId\x12\x1c\n" +
 	"\n" +
 	"is_llm_poi\x18\x03 \x01(\bR\bisLlmPoi\x129\n" +
-	"\bpoi_data\x18\x04 \x01(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\apoiData\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xbd\x01\n" +
+	"\bpoi_data\x18\x04 \x01(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\apoiData\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc0\x01\n" +
 	"\x16AddToFavoritesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x15\n" +
 	"\x06poi_id\x18\x03 \x01(\tR\x05poiId\x12\x1f\n" +
 	"\vfavorite_id\x18\x04 \x01(\tR\n" +
-	"favoriteId\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xdb\x01\n" +
+	"favoriteId\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xde\x01\n" +
 	"\x1aRemoveFromFavoritesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
 	"\x06poi_id\x18\x02 \x01(\tR\x05poiId\x12\x1c\n" +
 	"\n" +
 	"is_llm_poi\x18\x03 \x01(\bR\bisLlmPoi\x129\n" +
-	"\bpoi_data\x18\x04 \x01(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\apoiData\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xa1\x01\n" +
+	"\bpoi_data\x18\x04 \x01(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\apoiData\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xa4\x01\n" +
 	"\x1bRemoveFromFavoritesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x15\n" +
-	"\x06poi_id\x18\x03 \x01(\tR\x05poiId\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xc3\x01\n" +
+	"\x06poi_id\x18\x03 \x01(\tR\x05poiId\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xc6\x01\n" +
 	"\x13GetFavoritesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offset\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xa0\x02\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xa3\x02\n" +
 	"\x14GetFavoritesResponse\x12<\n" +
 	"\tfavorites\x18\x01 \x03(\v2\x1e.ai_poi.poi.v1.POIDetailedInfoR\tfavorites\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
@@ -3980,41 +4018,41 @@ const file_poi_proto_rawDesc = "" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
 	"totalPages\x12\x14\n" +
 	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x06 \x01(\x05R\x06offset\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xc5\x01\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xc8\x01\n" +
 	"\x15GetItinerariesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xe3\x01\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xe6\x01\n" +
 	"\x16GetItinerariesResponse\x12>\n" +
 	"\vitineraries\x18\x01 \x03(\v2\x1c.ai_poi.poi.v1.UserItineraryR\vitineraries\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\x87\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8a\x01\n" +
 	"\x13GetItineraryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
-	"\fitinerary_id\x18\x02 \x01(\tR\vitineraryId\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\x8b\x01\n" +
+	"\fitinerary_id\x18\x02 \x01(\tR\vitineraryId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x8e\x01\n" +
 	"\x14GetItineraryResponse\x12:\n" +
-	"\titinerary\x18\x01 \x01(\v2\x1c.ai_poi.poi.v1.UserItineraryR\titinerary\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xed\x01\n" +
+	"\titinerary\x18\x01 \x01(\v2\x1c.ai_poi.poi.v1.UserItineraryR\titinerary\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xf0\x01\n" +
 	"\x16UpdateItineraryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\fitinerary_id\x18\x02 \x01(\tR\vitineraryId\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12)\n" +
-	"\x10markdown_content\x18\x05 \x01(\tR\x0fmarkdownContent\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\xc2\x01\n" +
+	"\x10markdown_content\x18\x05 \x01(\tR\x0fmarkdownContent\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc5\x01\n" +
 	"\x17UpdateItineraryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
-	"\titinerary\x18\x03 \x01(\v2\x1c.ai_poi.poi.v1.UserItineraryR\titinerary\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"\xe5\x03\n" +
+	"\titinerary\x18\x03 \x01(\v2\x1c.ai_poi.poi.v1.UserItineraryR\titinerary\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xe5\x03\n" +
 	"\rUserItinerary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
@@ -4042,31 +4080,31 @@ const file_poi_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9b\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9e\x01\n" +
 	"\x19GenerateEmbeddingsRequest\x12\x1d\n" +
 	"\n" +
 	"batch_size\x18\x01 \x01(\x05R\tbatchSize\x12)\n" +
-	"\x10force_regenerate\x18\x02 \x01(\bR\x0fforceRegenerate\x124\n" +
-	"\arequest\x18d \x01(\v2\x1a.ai_poi.poi.v1.BaseRequestR\arequest\"\x87\x02\n" +
+	"\x10force_regenerate\x18\x02 \x01(\bR\x0fforceRegenerate\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x8a\x02\n" +
 	"\x1aGenerateEmbeddingsResponse\x12'\n" +
 	"\x0fprocessed_count\x18\x01 \x01(\x05R\x0eprocessedCount\x12#\n" +
 	"\rupdated_count\x18\x02 \x01(\x05R\fupdatedCount\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
 	"\x06errors\x18\x04 \x03(\tR\x06errors\x12\x18\n" +
 	"\amessage\x18\x05 \x01(\tR\amessage\x12\x18\n" +
-	"\asuccess\x18\x06 \x01(\bR\asuccess\x127\n" +
-	"\bresponse\x18d \x01(\v2\x1b.ai_poi.poi.v1.BaseResponseR\bresponse\"N\n" +
+	"\asuccess\x18\x06 \x01(\bR\asuccess\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status2\xd0\r\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x012\xd0\r\n" +
 	"\n" +
 	"POIService\x12Z\n" +
 	"\rGetPOIsByCity\x12#.ai_poi.poi.v1.GetPOIsByCityRequest\x1a$.ai_poi.poi.v1.GetPOIsByCityResponse\x12Q\n" +
@@ -4152,123 +4190,128 @@ var file_poi_proto_goTypes = []any{
 	nil,                                      // 47: ai_poi.poi.v1.POIDetailedInfo.MetadataEntry
 	nil,                                      // 48: ai_poi.poi.v1.SearchMetadata.DebugInfoEntry
 	(*timestamppb.Timestamp)(nil),            // 49: google.protobuf.Timestamp
+	(*generated.MultilingualText)(nil),       // 50: ai_poi.common.v1.MultilingualText
+	(*generated.BaseRequest)(nil),            // 51: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),           // 52: ai_poi.common.v1.BaseResponse
 }
 var file_poi_proto_depIdxs = []int32{
 	47, // 0: ai_poi.poi.v1.POIDetailedInfo.metadata:type_name -> ai_poi.poi.v1.POIDetailedInfo.MetadataEntry
 	49, // 1: ai_poi.poi.v1.POIDetailedInfo.created_at:type_name -> google.protobuf.Timestamp
 	49, // 2: ai_poi.poi.v1.POIDetailedInfo.updated_at:type_name -> google.protobuf.Timestamp
 	42, // 3: ai_poi.poi.v1.POIDetailedInfo.tags:type_name -> ai_poi.poi.v1.Tags
-	0,  // 4: ai_poi.poi.v1.RestaurantDetailedInfo.poi:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	0,  // 5: ai_poi.poi.v1.HotelDetailedInfo.poi:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	3,  // 6: ai_poi.poi.v1.POIFilter.location:type_name -> ai_poi.poi.v1.GeoPoint
-	45, // 7: ai_poi.poi.v1.GetPOIsByCityRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	0,  // 8: ai_poi.poi.v1.GetPOIsByCityResponse.pois:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	46, // 9: ai_poi.poi.v1.GetPOIsByCityResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	4,  // 10: ai_poi.poi.v1.SearchPOIsRequest.filter:type_name -> ai_poi.poi.v1.POIFilter
-	45, // 11: ai_poi.poi.v1.SearchPOIsRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	0,  // 12: ai_poi.poi.v1.SearchPOIsResponse.pois:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	16, // 13: ai_poi.poi.v1.SearchPOIsResponse.metadata:type_name -> ai_poi.poi.v1.SearchMetadata
-	46, // 14: ai_poi.poi.v1.SearchPOIsResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	3,  // 15: ai_poi.poi.v1.SearchPOIsSemanticRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
-	45, // 16: ai_poi.poi.v1.SearchPOIsSemanticRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	45, // 17: ai_poi.poi.v1.SearchPOIsSemanticByCityRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	12, // 18: ai_poi.poi.v1.SearchPOIsSemanticResponse.pois:type_name -> ai_poi.poi.v1.POISemanticMatch
-	16, // 19: ai_poi.poi.v1.SearchPOIsSemanticResponse.metadata:type_name -> ai_poi.poi.v1.SearchMetadata
-	46, // 20: ai_poi.poi.v1.SearchPOIsSemanticResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	0,  // 21: ai_poi.poi.v1.POISemanticMatch.poi:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	4,  // 22: ai_poi.poi.v1.SearchPOIsHybridRequest.filter:type_name -> ai_poi.poi.v1.POIFilter
-	45, // 23: ai_poi.poi.v1.SearchPOIsHybridRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	15, // 24: ai_poi.poi.v1.SearchPOIsHybridResponse.pois:type_name -> ai_poi.poi.v1.POIHybridMatch
-	16, // 25: ai_poi.poi.v1.SearchPOIsHybridResponse.metadata:type_name -> ai_poi.poi.v1.SearchMetadata
-	46, // 26: ai_poi.poi.v1.SearchPOIsHybridResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	0,  // 27: ai_poi.poi.v1.POIHybridMatch.poi:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	48, // 28: ai_poi.poi.v1.SearchMetadata.debug_info:type_name -> ai_poi.poi.v1.SearchMetadata.DebugInfoEntry
-	3,  // 29: ai_poi.poi.v1.GetNearbyRecommendationsRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
-	45, // 30: ai_poi.poi.v1.GetNearbyRecommendationsRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	19, // 31: ai_poi.poi.v1.GetNearbyRecommendationsResponse.recommendations:type_name -> ai_poi.poi.v1.POIRecommendation
-	20, // 32: ai_poi.poi.v1.GetNearbyRecommendationsResponse.metadata:type_name -> ai_poi.poi.v1.RecommendationMetadata
-	46, // 33: ai_poi.poi.v1.GetNearbyRecommendationsResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	0,  // 34: ai_poi.poi.v1.POIRecommendation.poi:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	3,  // 35: ai_poi.poi.v1.DiscoverRestaurantsRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
-	45, // 36: ai_poi.poi.v1.DiscoverRestaurantsRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	1,  // 37: ai_poi.poi.v1.DiscoverRestaurantsResponse.restaurants:type_name -> ai_poi.poi.v1.RestaurantDetailedInfo
-	46, // 38: ai_poi.poi.v1.DiscoverRestaurantsResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	3,  // 39: ai_poi.poi.v1.DiscoverActivitiesRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
-	45, // 40: ai_poi.poi.v1.DiscoverActivitiesRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	0,  // 41: ai_poi.poi.v1.DiscoverActivitiesResponse.activities:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	46, // 42: ai_poi.poi.v1.DiscoverActivitiesResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	3,  // 43: ai_poi.poi.v1.DiscoverHotelsRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
-	45, // 44: ai_poi.poi.v1.DiscoverHotelsRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	2,  // 45: ai_poi.poi.v1.DiscoverHotelsResponse.hotels:type_name -> ai_poi.poi.v1.HotelDetailedInfo
-	46, // 46: ai_poi.poi.v1.DiscoverHotelsResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	3,  // 47: ai_poi.poi.v1.DiscoverAttractionsRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
-	45, // 48: ai_poi.poi.v1.DiscoverAttractionsRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	0,  // 49: ai_poi.poi.v1.DiscoverAttractionsResponse.attractions:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	46, // 50: ai_poi.poi.v1.DiscoverAttractionsResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	0,  // 51: ai_poi.poi.v1.AddToFavoritesRequest.poi_data:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	45, // 52: ai_poi.poi.v1.AddToFavoritesRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	46, // 53: ai_poi.poi.v1.AddToFavoritesResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	0,  // 54: ai_poi.poi.v1.RemoveFromFavoritesRequest.poi_data:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	45, // 55: ai_poi.poi.v1.RemoveFromFavoritesRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	46, // 56: ai_poi.poi.v1.RemoveFromFavoritesResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	45, // 57: ai_poi.poi.v1.GetFavoritesRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	0,  // 58: ai_poi.poi.v1.GetFavoritesResponse.favorites:type_name -> ai_poi.poi.v1.POIDetailedInfo
-	46, // 59: ai_poi.poi.v1.GetFavoritesResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	45, // 60: ai_poi.poi.v1.GetItinerariesRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	41, // 61: ai_poi.poi.v1.GetItinerariesResponse.itineraries:type_name -> ai_poi.poi.v1.UserItinerary
-	46, // 62: ai_poi.poi.v1.GetItinerariesResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	45, // 63: ai_poi.poi.v1.GetItineraryRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	41, // 64: ai_poi.poi.v1.GetItineraryResponse.itinerary:type_name -> ai_poi.poi.v1.UserItinerary
-	46, // 65: ai_poi.poi.v1.GetItineraryResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	45, // 66: ai_poi.poi.v1.UpdateItineraryRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	41, // 67: ai_poi.poi.v1.UpdateItineraryResponse.itinerary:type_name -> ai_poi.poi.v1.UserItinerary
-	46, // 68: ai_poi.poi.v1.UpdateItineraryResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	49, // 69: ai_poi.poi.v1.UserItinerary.created_at:type_name -> google.protobuf.Timestamp
-	49, // 70: ai_poi.poi.v1.UserItinerary.updated_at:type_name -> google.protobuf.Timestamp
-	42, // 71: ai_poi.poi.v1.UserItinerary.tags:type_name -> ai_poi.poi.v1.Tags
-	49, // 72: ai_poi.poi.v1.Tags.created_at:type_name -> google.protobuf.Timestamp
-	49, // 73: ai_poi.poi.v1.Tags.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 74: ai_poi.poi.v1.GenerateEmbeddingsRequest.request:type_name -> ai_poi.poi.v1.BaseRequest
-	46, // 75: ai_poi.poi.v1.GenerateEmbeddingsResponse.response:type_name -> ai_poi.poi.v1.BaseResponse
-	5,  // 76: ai_poi.poi.v1.POIService.GetPOIsByCity:input_type -> ai_poi.poi.v1.GetPOIsByCityRequest
-	7,  // 77: ai_poi.poi.v1.POIService.SearchPOIs:input_type -> ai_poi.poi.v1.SearchPOIsRequest
-	9,  // 78: ai_poi.poi.v1.POIService.SearchPOIsSemantic:input_type -> ai_poi.poi.v1.SearchPOIsSemanticRequest
-	10, // 79: ai_poi.poi.v1.POIService.SearchPOIsSemanticByCity:input_type -> ai_poi.poi.v1.SearchPOIsSemanticByCityRequest
-	13, // 80: ai_poi.poi.v1.POIService.SearchPOIsHybrid:input_type -> ai_poi.poi.v1.SearchPOIsHybridRequest
-	17, // 81: ai_poi.poi.v1.POIService.GetNearbyRecommendations:input_type -> ai_poi.poi.v1.GetNearbyRecommendationsRequest
-	21, // 82: ai_poi.poi.v1.POIService.DiscoverRestaurants:input_type -> ai_poi.poi.v1.DiscoverRestaurantsRequest
-	23, // 83: ai_poi.poi.v1.POIService.DiscoverActivities:input_type -> ai_poi.poi.v1.DiscoverActivitiesRequest
-	25, // 84: ai_poi.poi.v1.POIService.DiscoverHotels:input_type -> ai_poi.poi.v1.DiscoverHotelsRequest
-	27, // 85: ai_poi.poi.v1.POIService.DiscoverAttractions:input_type -> ai_poi.poi.v1.DiscoverAttractionsRequest
-	29, // 86: ai_poi.poi.v1.POIService.AddToFavorites:input_type -> ai_poi.poi.v1.AddToFavoritesRequest
-	31, // 87: ai_poi.poi.v1.POIService.RemoveFromFavorites:input_type -> ai_poi.poi.v1.RemoveFromFavoritesRequest
-	33, // 88: ai_poi.poi.v1.POIService.GetFavorites:input_type -> ai_poi.poi.v1.GetFavoritesRequest
-	35, // 89: ai_poi.poi.v1.POIService.GetItineraries:input_type -> ai_poi.poi.v1.GetItinerariesRequest
-	37, // 90: ai_poi.poi.v1.POIService.GetItinerary:input_type -> ai_poi.poi.v1.GetItineraryRequest
-	39, // 91: ai_poi.poi.v1.POIService.UpdateItinerary:input_type -> ai_poi.poi.v1.UpdateItineraryRequest
-	43, // 92: ai_poi.poi.v1.POIService.GenerateEmbeddings:input_type -> ai_poi.poi.v1.GenerateEmbeddingsRequest
-	6,  // 93: ai_poi.poi.v1.POIService.GetPOIsByCity:output_type -> ai_poi.poi.v1.GetPOIsByCityResponse
-	8,  // 94: ai_poi.poi.v1.POIService.SearchPOIs:output_type -> ai_poi.poi.v1.SearchPOIsResponse
-	11, // 95: ai_poi.poi.v1.POIService.SearchPOIsSemantic:output_type -> ai_poi.poi.v1.SearchPOIsSemanticResponse
-	11, // 96: ai_poi.poi.v1.POIService.SearchPOIsSemanticByCity:output_type -> ai_poi.poi.v1.SearchPOIsSemanticResponse
-	14, // 97: ai_poi.poi.v1.POIService.SearchPOIsHybrid:output_type -> ai_poi.poi.v1.SearchPOIsHybridResponse
-	18, // 98: ai_poi.poi.v1.POIService.GetNearbyRecommendations:output_type -> ai_poi.poi.v1.GetNearbyRecommendationsResponse
-	22, // 99: ai_poi.poi.v1.POIService.DiscoverRestaurants:output_type -> ai_poi.poi.v1.DiscoverRestaurantsResponse
-	24, // 100: ai_poi.poi.v1.POIService.DiscoverActivities:output_type -> ai_poi.poi.v1.DiscoverActivitiesResponse
-	26, // 101: ai_poi.poi.v1.POIService.DiscoverHotels:output_type -> ai_poi.poi.v1.DiscoverHotelsResponse
-	28, // 102: ai_poi.poi.v1.POIService.DiscoverAttractions:output_type -> ai_poi.poi.v1.DiscoverAttractionsResponse
-	30, // 103: ai_poi.poi.v1.POIService.AddToFavorites:output_type -> ai_poi.poi.v1.AddToFavoritesResponse
-	32, // 104: ai_poi.poi.v1.POIService.RemoveFromFavorites:output_type -> ai_poi.poi.v1.RemoveFromFavoritesResponse
-	34, // 105: ai_poi.poi.v1.POIService.GetFavorites:output_type -> ai_poi.poi.v1.GetFavoritesResponse
-	36, // 106: ai_poi.poi.v1.POIService.GetItineraries:output_type -> ai_poi.poi.v1.GetItinerariesResponse
-	38, // 107: ai_poi.poi.v1.POIService.GetItinerary:output_type -> ai_poi.poi.v1.GetItineraryResponse
-	40, // 108: ai_poi.poi.v1.POIService.UpdateItinerary:output_type -> ai_poi.poi.v1.UpdateItineraryResponse
-	44, // 109: ai_poi.poi.v1.POIService.GenerateEmbeddings:output_type -> ai_poi.poi.v1.GenerateEmbeddingsResponse
-	93, // [93:110] is the sub-list for method output_type
-	76, // [76:93] is the sub-list for method input_type
-	76, // [76:76] is the sub-list for extension type_name
-	76, // [76:76] is the sub-list for extension extendee
-	0,  // [0:76] is the sub-list for field type_name
+	50, // 4: ai_poi.poi.v1.POIDetailedInfo.localized_name:type_name -> ai_poi.common.v1.MultilingualText
+	50, // 5: ai_poi.poi.v1.POIDetailedInfo.localized_description:type_name -> ai_poi.common.v1.MultilingualText
+	0,  // 6: ai_poi.poi.v1.RestaurantDetailedInfo.poi:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	0,  // 7: ai_poi.poi.v1.HotelDetailedInfo.poi:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	3,  // 8: ai_poi.poi.v1.POIFilter.location:type_name -> ai_poi.poi.v1.GeoPoint
+	51, // 9: ai_poi.poi.v1.GetPOIsByCityRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 10: ai_poi.poi.v1.GetPOIsByCityResponse.pois:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	52, // 11: ai_poi.poi.v1.GetPOIsByCityResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	4,  // 12: ai_poi.poi.v1.SearchPOIsRequest.filter:type_name -> ai_poi.poi.v1.POIFilter
+	51, // 13: ai_poi.poi.v1.SearchPOIsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 14: ai_poi.poi.v1.SearchPOIsResponse.pois:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	16, // 15: ai_poi.poi.v1.SearchPOIsResponse.metadata:type_name -> ai_poi.poi.v1.SearchMetadata
+	52, // 16: ai_poi.poi.v1.SearchPOIsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	3,  // 17: ai_poi.poi.v1.SearchPOIsSemanticRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
+	51, // 18: ai_poi.poi.v1.SearchPOIsSemanticRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	51, // 19: ai_poi.poi.v1.SearchPOIsSemanticByCityRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	12, // 20: ai_poi.poi.v1.SearchPOIsSemanticResponse.pois:type_name -> ai_poi.poi.v1.POISemanticMatch
+	16, // 21: ai_poi.poi.v1.SearchPOIsSemanticResponse.metadata:type_name -> ai_poi.poi.v1.SearchMetadata
+	52, // 22: ai_poi.poi.v1.SearchPOIsSemanticResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	0,  // 23: ai_poi.poi.v1.POISemanticMatch.poi:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	4,  // 24: ai_poi.poi.v1.SearchPOIsHybridRequest.filter:type_name -> ai_poi.poi.v1.POIFilter
+	51, // 25: ai_poi.poi.v1.SearchPOIsHybridRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	15, // 26: ai_poi.poi.v1.SearchPOIsHybridResponse.pois:type_name -> ai_poi.poi.v1.POIHybridMatch
+	16, // 27: ai_poi.poi.v1.SearchPOIsHybridResponse.metadata:type_name -> ai_poi.poi.v1.SearchMetadata
+	52, // 28: ai_poi.poi.v1.SearchPOIsHybridResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	0,  // 29: ai_poi.poi.v1.POIHybridMatch.poi:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	48, // 30: ai_poi.poi.v1.SearchMetadata.debug_info:type_name -> ai_poi.poi.v1.SearchMetadata.DebugInfoEntry
+	3,  // 31: ai_poi.poi.v1.GetNearbyRecommendationsRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
+	51, // 32: ai_poi.poi.v1.GetNearbyRecommendationsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	19, // 33: ai_poi.poi.v1.GetNearbyRecommendationsResponse.recommendations:type_name -> ai_poi.poi.v1.POIRecommendation
+	20, // 34: ai_poi.poi.v1.GetNearbyRecommendationsResponse.metadata:type_name -> ai_poi.poi.v1.RecommendationMetadata
+	52, // 35: ai_poi.poi.v1.GetNearbyRecommendationsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	0,  // 36: ai_poi.poi.v1.POIRecommendation.poi:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	3,  // 37: ai_poi.poi.v1.DiscoverRestaurantsRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
+	51, // 38: ai_poi.poi.v1.DiscoverRestaurantsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	1,  // 39: ai_poi.poi.v1.DiscoverRestaurantsResponse.restaurants:type_name -> ai_poi.poi.v1.RestaurantDetailedInfo
+	52, // 40: ai_poi.poi.v1.DiscoverRestaurantsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	3,  // 41: ai_poi.poi.v1.DiscoverActivitiesRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
+	51, // 42: ai_poi.poi.v1.DiscoverActivitiesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 43: ai_poi.poi.v1.DiscoverActivitiesResponse.activities:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	52, // 44: ai_poi.poi.v1.DiscoverActivitiesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	3,  // 45: ai_poi.poi.v1.DiscoverHotelsRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
+	51, // 46: ai_poi.poi.v1.DiscoverHotelsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	2,  // 47: ai_poi.poi.v1.DiscoverHotelsResponse.hotels:type_name -> ai_poi.poi.v1.HotelDetailedInfo
+	52, // 48: ai_poi.poi.v1.DiscoverHotelsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	3,  // 49: ai_poi.poi.v1.DiscoverAttractionsRequest.location:type_name -> ai_poi.poi.v1.GeoPoint
+	51, // 50: ai_poi.poi.v1.DiscoverAttractionsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 51: ai_poi.poi.v1.DiscoverAttractionsResponse.attractions:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	52, // 52: ai_poi.poi.v1.DiscoverAttractionsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	0,  // 53: ai_poi.poi.v1.AddToFavoritesRequest.poi_data:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	51, // 54: ai_poi.poi.v1.AddToFavoritesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	52, // 55: ai_poi.poi.v1.AddToFavoritesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	0,  // 56: ai_poi.poi.v1.RemoveFromFavoritesRequest.poi_data:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	51, // 57: ai_poi.poi.v1.RemoveFromFavoritesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	52, // 58: ai_poi.poi.v1.RemoveFromFavoritesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	51, // 59: ai_poi.poi.v1.GetFavoritesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 60: ai_poi.poi.v1.GetFavoritesResponse.favorites:type_name -> ai_poi.poi.v1.POIDetailedInfo
+	52, // 61: ai_poi.poi.v1.GetFavoritesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	51, // 62: ai_poi.poi.v1.GetItinerariesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	41, // 63: ai_poi.poi.v1.GetItinerariesResponse.itineraries:type_name -> ai_poi.poi.v1.UserItinerary
+	52, // 64: ai_poi.poi.v1.GetItinerariesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	51, // 65: ai_poi.poi.v1.GetItineraryRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	41, // 66: ai_poi.poi.v1.GetItineraryResponse.itinerary:type_name -> ai_poi.poi.v1.UserItinerary
+	52, // 67: ai_poi.poi.v1.GetItineraryResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	51, // 68: ai_poi.poi.v1.UpdateItineraryRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	41, // 69: ai_poi.poi.v1.UpdateItineraryResponse.itinerary:type_name -> ai_poi.poi.v1.UserItinerary
+	52, // 70: ai_poi.poi.v1.UpdateItineraryResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 71: ai_poi.poi.v1.UserItinerary.created_at:type_name -> google.protobuf.Timestamp
+	49, // 72: ai_poi.poi.v1.UserItinerary.updated_at:type_name -> google.protobuf.Timestamp
+	42, // 73: ai_poi.poi.v1.UserItinerary.tags:type_name -> ai_poi.poi.v1.Tags
+	49, // 74: ai_poi.poi.v1.Tags.created_at:type_name -> google.protobuf.Timestamp
+	49, // 75: ai_poi.poi.v1.Tags.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 76: ai_poi.poi.v1.GenerateEmbeddingsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	52, // 77: ai_poi.poi.v1.GenerateEmbeddingsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	5,  // 78: ai_poi.poi.v1.POIService.GetPOIsByCity:input_type -> ai_poi.poi.v1.GetPOIsByCityRequest
+	7,  // 79: ai_poi.poi.v1.POIService.SearchPOIs:input_type -> ai_poi.poi.v1.SearchPOIsRequest
+	9,  // 80: ai_poi.poi.v1.POIService.SearchPOIsSemantic:input_type -> ai_poi.poi.v1.SearchPOIsSemanticRequest
+	10, // 81: ai_poi.poi.v1.POIService.SearchPOIsSemanticByCity:input_type -> ai_poi.poi.v1.SearchPOIsSemanticByCityRequest
+	13, // 82: ai_poi.poi.v1.POIService.SearchPOIsHybrid:input_type -> ai_poi.poi.v1.SearchPOIsHybridRequest
+	17, // 83: ai_poi.poi.v1.POIService.GetNearbyRecommendations:input_type -> ai_poi.poi.v1.GetNearbyRecommendationsRequest
+	21, // 84: ai_poi.poi.v1.POIService.DiscoverRestaurants:input_type -> ai_poi.poi.v1.DiscoverRestaurantsRequest
+	23, // 85: ai_poi.poi.v1.POIService.DiscoverActivities:input_type -> ai_poi.poi.v1.DiscoverActivitiesRequest
+	25, // 86: ai_poi.poi.v1.POIService.DiscoverHotels:input_type -> ai_poi.poi.v1.DiscoverHotelsRequest
+	27, // 87: ai_poi.poi.v1.POIService.DiscoverAttractions:input_type -> ai_poi.poi.v1.DiscoverAttractionsRequest
+	29, // 88: ai_poi.poi.v1.POIService.AddToFavorites:input_type -> ai_poi.poi.v1.AddToFavoritesRequest
+	31, // 89: ai_poi.poi.v1.POIService.RemoveFromFavorites:input_type -> ai_poi.poi.v1.RemoveFromFavoritesRequest
+	33, // 90: ai_poi.poi.v1.POIService.GetFavorites:input_type -> ai_poi.poi.v1.GetFavoritesRequest
+	35, // 91: ai_poi.poi.v1.POIService.GetItineraries:input_type -> ai_poi.poi.v1.GetItinerariesRequest
+	37, // 92: ai_poi.poi.v1.POIService.GetItinerary:input_type -> ai_poi.poi.v1.GetItineraryRequest
+	39, // 93: ai_poi.poi.v1.POIService.UpdateItinerary:input_type -> ai_poi.poi.v1.UpdateItineraryRequest
+	43, // 94: ai_poi.poi.v1.POIService.GenerateEmbeddings:input_type -> ai_poi.poi.v1.GenerateEmbeddingsRequest
+	6,  // 95: ai_poi.poi.v1.POIService.GetPOIsByCity:output_type -> ai_poi.poi.v1.GetPOIsByCityResponse
+	8,  // 96: ai_poi.poi.v1.POIService.SearchPOIs:output_type -> ai_poi.poi.v1.SearchPOIsResponse
+	11, // 97: ai_poi.poi.v1.POIService.SearchPOIsSemantic:output_type -> ai_poi.poi.v1.SearchPOIsSemanticResponse
+	11, // 98: ai_poi.poi.v1.POIService.SearchPOIsSemanticByCity:output_type -> ai_poi.poi.v1.SearchPOIsSemanticResponse
+	14, // 99: ai_poi.poi.v1.POIService.SearchPOIsHybrid:output_type -> ai_poi.poi.v1.SearchPOIsHybridResponse
+	18, // 100: ai_poi.poi.v1.POIService.GetNearbyRecommendations:output_type -> ai_poi.poi.v1.GetNearbyRecommendationsResponse
+	22, // 101: ai_poi.poi.v1.POIService.DiscoverRestaurants:output_type -> ai_poi.poi.v1.DiscoverRestaurantsResponse
+	24, // 102: ai_poi.poi.v1.POIService.DiscoverActivities:output_type -> ai_poi.poi.v1.DiscoverActivitiesResponse
+	26, // 103: ai_poi.poi.v1.POIService.DiscoverHotels:output_type -> ai_poi.poi.v1.DiscoverHotelsResponse
+	28, // 104: ai_poi.poi.v1.POIService.DiscoverAttractions:output_type -> ai_poi.poi.v1.DiscoverAttractionsResponse
+	30, // 105: ai_poi.poi.v1.POIService.AddToFavorites:output_type -> ai_poi.poi.v1.AddToFavoritesResponse
+	32, // 106: ai_poi.poi.v1.POIService.RemoveFromFavorites:output_type -> ai_poi.poi.v1.RemoveFromFavoritesResponse
+	34, // 107: ai_poi.poi.v1.POIService.GetFavorites:output_type -> ai_poi.poi.v1.GetFavoritesResponse
+	36, // 108: ai_poi.poi.v1.POIService.GetItineraries:output_type -> ai_poi.poi.v1.GetItinerariesResponse
+	38, // 109: ai_poi.poi.v1.POIService.GetItinerary:output_type -> ai_poi.poi.v1.GetItineraryResponse
+	40, // 110: ai_poi.poi.v1.POIService.UpdateItinerary:output_type -> ai_poi.poi.v1.UpdateItineraryResponse
+	44, // 111: ai_poi.poi.v1.POIService.GenerateEmbeddings:output_type -> ai_poi.poi.v1.GenerateEmbeddingsResponse
+	95, // [95:112] is the sub-list for method output_type
+	78, // [78:95] is the sub-list for method input_type
+	78, // [78:78] is the sub-list for extension type_name
+	78, // [78:78] is the sub-list for extension extendee
+	0,  // [0:78] is the sub-list for field type_name
 }
 
 func init() { file_poi_proto_init() }
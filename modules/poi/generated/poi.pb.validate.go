@@ -0,0 +1,7447 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: poi.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on POIDetailedInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *POIDetailedInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on POIDetailedInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// POIDetailedInfoMultiError, or nil if none found.
+func (m *POIDetailedInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *POIDetailedInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Latitude
+
+	// no validation rules for Longitude
+
+	// no validation rules for Category
+
+	// no validation rules for Subcategory
+
+	// no validation rules for Description
+
+	// no validation rules for Rating
+
+	// no validation rules for ReviewCount
+
+	// no validation rules for PriceRange
+
+	// no validation rules for Address
+
+	// no validation rules for Phone
+
+	// no validation rules for Email
+
+	// no validation rules for Website
+
+	// no validation rules for Distance
+
+	// no validation rules for CityId
+
+	// no validation rules for CityName
+
+	// no validation rules for Country
+
+	// no validation rules for IsVerified
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIDetailedInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIDetailedInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIDetailedInfoValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIDetailedInfoValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIDetailedInfoValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIDetailedInfoValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, POIDetailedInfoValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, POIDetailedInfoValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return POIDetailedInfoValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PhoneNumber
+
+	// no validation rules for PriceLevel
+
+	// no validation rules for Source
+
+	if m.GetDistanceMeters() < 0 {
+		err := POIDetailedInfoValidationError{
+			field:  "DistanceMeters",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalizedName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIDetailedInfoValidationError{
+					field:  "LocalizedName",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIDetailedInfoValidationError{
+					field:  "LocalizedName",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalizedName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIDetailedInfoValidationError{
+				field:  "LocalizedName",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalizedDescription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIDetailedInfoValidationError{
+					field:  "LocalizedDescription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIDetailedInfoValidationError{
+					field:  "LocalizedDescription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalizedDescription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIDetailedInfoValidationError{
+				field:  "LocalizedDescription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return POIDetailedInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// POIDetailedInfoMultiError is an error wrapping multiple validation errors
+// returned by POIDetailedInfo.ValidateAll() if the designated constraints
+// aren't met.
+type POIDetailedInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m POIDetailedInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m POIDetailedInfoMultiError) AllErrors() []error { return m }
+
+// POIDetailedInfoValidationError is the validation error returned by
+// POIDetailedInfo.Validate if the designated constraints aren't met.
+type POIDetailedInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e POIDetailedInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e POIDetailedInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e POIDetailedInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e POIDetailedInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e POIDetailedInfoValidationError) ErrorName() string { return "POIDetailedInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e POIDetailedInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPOIDetailedInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = POIDetailedInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = POIDetailedInfoValidationError{}
+
+// Validate checks the field values on RestaurantDetailedInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestaurantDetailedInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestaurantDetailedInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestaurantDetailedInfoMultiError, or nil if none found.
+func (m *RestaurantDetailedInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestaurantDetailedInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPoi()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestaurantDetailedInfoValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestaurantDetailedInfoValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestaurantDetailedInfoValidationError{
+				field:  "Poi",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CuisineType
+
+	// no validation rules for DressCode
+
+	// no validation rules for ReservationsRequired
+
+	// no validation rules for AverageMealDuration
+
+	if len(errors) > 0 {
+		return RestaurantDetailedInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestaurantDetailedInfoMultiError is an error wrapping multiple validation
+// errors returned by RestaurantDetailedInfo.ValidateAll() if the designated
+// constraints aren't met.
+type RestaurantDetailedInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestaurantDetailedInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestaurantDetailedInfoMultiError) AllErrors() []error { return m }
+
+// RestaurantDetailedInfoValidationError is the validation error returned by
+// RestaurantDetailedInfo.Validate if the designated constraints aren't met.
+type RestaurantDetailedInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestaurantDetailedInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestaurantDetailedInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestaurantDetailedInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestaurantDetailedInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestaurantDetailedInfoValidationError) ErrorName() string {
+	return "RestaurantDetailedInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestaurantDetailedInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestaurantDetailedInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestaurantDetailedInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestaurantDetailedInfoValidationError{}
+
+// Validate checks the field values on HotelDetailedInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HotelDetailedInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HotelDetailedInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HotelDetailedInfoMultiError, or nil if none found.
+func (m *HotelDetailedInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HotelDetailedInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPoi()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HotelDetailedInfoValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HotelDetailedInfoValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HotelDetailedInfoValidationError{
+				field:  "Poi",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for StarRating
+
+	// no validation rules for CheckInTime
+
+	// no validation rules for CheckOutTime
+
+	// no validation rules for PetFriendly
+
+	// no validation rules for ParkingAvailable
+
+	// no validation rules for PropertyType
+
+	if len(errors) > 0 {
+		return HotelDetailedInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// HotelDetailedInfoMultiError is an error wrapping multiple validation errors
+// returned by HotelDetailedInfo.ValidateAll() if the designated constraints
+// aren't met.
+type HotelDetailedInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HotelDetailedInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HotelDetailedInfoMultiError) AllErrors() []error { return m }
+
+// HotelDetailedInfoValidationError is the validation error returned by
+// HotelDetailedInfo.Validate if the designated constraints aren't met.
+type HotelDetailedInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HotelDetailedInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HotelDetailedInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HotelDetailedInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HotelDetailedInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HotelDetailedInfoValidationError) ErrorName() string {
+	return "HotelDetailedInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HotelDetailedInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHotelDetailedInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HotelDetailedInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HotelDetailedInfoValidationError{}
+
+// Validate checks the field values on GeoPoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GeoPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GeoPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GeoPointMultiError, or nil
+// if none found.
+func (m *GeoPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GeoPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		err := GeoPointValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		err := GeoPointValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GeoPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// GeoPointMultiError is an error wrapping multiple validation errors returned
+// by GeoPoint.ValidateAll() if the designated constraints aren't met.
+type GeoPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GeoPointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GeoPointMultiError) AllErrors() []error { return m }
+
+// GeoPointValidationError is the validation error returned by
+// GeoPoint.Validate if the designated constraints aren't met.
+type GeoPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GeoPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GeoPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GeoPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GeoPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GeoPointValidationError) ErrorName() string { return "GeoPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GeoPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGeoPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GeoPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GeoPointValidationError{}
+
+// Validate checks the field values on POIFilter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *POIFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on POIFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in POIFilterMultiError, or nil
+// if none found.
+func (m *POIFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *POIFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIFilterValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIFilterValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIFilterValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetRadiusMeters() < 0 {
+		err := POIFilterValidationError{
+			field:  "RadiusMeters",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMinRating(); val < 0 || val > 5 {
+		err := POIFilterValidationError{
+			field:  "MinRating",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortOrder
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for CityId
+
+	if len(errors) > 0 {
+		return POIFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// POIFilterMultiError is an error wrapping multiple validation errors returned
+// by POIFilter.ValidateAll() if the designated constraints aren't met.
+type POIFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m POIFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m POIFilterMultiError) AllErrors() []error { return m }
+
+// POIFilterValidationError is the validation error returned by
+// POIFilter.Validate if the designated constraints aren't met.
+type POIFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e POIFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e POIFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e POIFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e POIFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e POIFilterValidationError) ErrorName() string { return "POIFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e POIFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPOIFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = POIFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = POIFilterValidationError{}
+
+// Validate checks the field values on GetPOIsByCityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPOIsByCityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPOIsByCityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPOIsByCityRequestMultiError, or nil if none found.
+func (m *GetPOIsByCityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPOIsByCityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIsByCityRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIsByCityRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIsByCityRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPOIsByCityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPOIsByCityRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPOIsByCityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPOIsByCityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPOIsByCityRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPOIsByCityRequestMultiError) AllErrors() []error { return m }
+
+// GetPOIsByCityRequestValidationError is the validation error returned by
+// GetPOIsByCityRequest.Validate if the designated constraints aren't met.
+type GetPOIsByCityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPOIsByCityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPOIsByCityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPOIsByCityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPOIsByCityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPOIsByCityRequestValidationError) ErrorName() string {
+	return "GetPOIsByCityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPOIsByCityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPOIsByCityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPOIsByCityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPOIsByCityRequestValidationError{}
+
+// Validate checks the field values on GetPOIsByCityResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPOIsByCityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPOIsByCityResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPOIsByCityResponseMultiError, or nil if none found.
+func (m *GetPOIsByCityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPOIsByCityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPois() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPOIsByCityResponseValidationError{
+						field:  fmt.Sprintf("Pois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPOIsByCityResponseValidationError{
+						field:  fmt.Sprintf("Pois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPOIsByCityResponseValidationError{
+					field:  fmt.Sprintf("Pois[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIsByCityResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIsByCityResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIsByCityResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPOIsByCityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPOIsByCityResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPOIsByCityResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPOIsByCityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPOIsByCityResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPOIsByCityResponseMultiError) AllErrors() []error { return m }
+
+// GetPOIsByCityResponseValidationError is the validation error returned by
+// GetPOIsByCityResponse.Validate if the designated constraints aren't met.
+type GetPOIsByCityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPOIsByCityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPOIsByCityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPOIsByCityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPOIsByCityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPOIsByCityResponseValidationError) ErrorName() string {
+	return "GetPOIsByCityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPOIsByCityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPOIsByCityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPOIsByCityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPOIsByCityResponseValidationError{}
+
+// Validate checks the field values on SearchPOIsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchPOIsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPOIsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPOIsRequestMultiError, or nil if none found.
+func (m *SearchPOIsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPOIsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchPOIsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPOIsRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchPOIsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchPOIsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPOIsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPOIsRequestMultiError) AllErrors() []error { return m }
+
+// SearchPOIsRequestValidationError is the validation error returned by
+// SearchPOIsRequest.Validate if the designated constraints aren't met.
+type SearchPOIsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPOIsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPOIsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPOIsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPOIsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPOIsRequestValidationError) ErrorName() string {
+	return "SearchPOIsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPOIsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPOIsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPOIsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPOIsRequestValidationError{}
+
+// Validate checks the field values on SearchPOIsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPOIsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPOIsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPOIsResponseMultiError, or nil if none found.
+func (m *SearchPOIsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPOIsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPois() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchPOIsResponseValidationError{
+						field:  fmt.Sprintf("Pois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchPOIsResponseValidationError{
+						field:  fmt.Sprintf("Pois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchPOIsResponseValidationError{
+					field:  fmt.Sprintf("Pois[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchPOIsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPOIsResponseMultiError is an error wrapping multiple validation errors
+// returned by SearchPOIsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SearchPOIsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPOIsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPOIsResponseMultiError) AllErrors() []error { return m }
+
+// SearchPOIsResponseValidationError is the validation error returned by
+// SearchPOIsResponse.Validate if the designated constraints aren't met.
+type SearchPOIsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPOIsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPOIsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPOIsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPOIsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPOIsResponseValidationError) ErrorName() string {
+	return "SearchPOIsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPOIsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPOIsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPOIsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPOIsResponseValidationError{}
+
+// Validate checks the field values on SearchPOIsSemanticRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPOIsSemanticRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPOIsSemanticRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPOIsSemanticRequestMultiError, or nil if none found.
+func (m *SearchPOIsSemanticRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPOIsSemanticRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsSemanticRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsSemanticRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsSemanticRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for SemanticThreshold
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsSemanticRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsSemanticRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsSemanticRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchPOIsSemanticRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPOIsSemanticRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchPOIsSemanticRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SearchPOIsSemanticRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPOIsSemanticRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPOIsSemanticRequestMultiError) AllErrors() []error { return m }
+
+// SearchPOIsSemanticRequestValidationError is the validation error returned by
+// SearchPOIsSemanticRequest.Validate if the designated constraints aren't met.
+type SearchPOIsSemanticRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPOIsSemanticRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPOIsSemanticRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPOIsSemanticRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPOIsSemanticRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPOIsSemanticRequestValidationError) ErrorName() string {
+	return "SearchPOIsSemanticRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPOIsSemanticRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPOIsSemanticRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPOIsSemanticRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPOIsSemanticRequestValidationError{}
+
+// Validate checks the field values on SearchPOIsSemanticByCityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPOIsSemanticByCityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPOIsSemanticByCityRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SearchPOIsSemanticByCityRequestMultiError, or nil if none found.
+func (m *SearchPOIsSemanticByCityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPOIsSemanticByCityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for CityId
+
+	// no validation rules for Limit
+
+	// no validation rules for SemanticThreshold
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsSemanticByCityRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsSemanticByCityRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsSemanticByCityRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchPOIsSemanticByCityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPOIsSemanticByCityRequestMultiError is an error wrapping multiple
+// validation errors returned by SearchPOIsSemanticByCityRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SearchPOIsSemanticByCityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPOIsSemanticByCityRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPOIsSemanticByCityRequestMultiError) AllErrors() []error { return m }
+
+// SearchPOIsSemanticByCityRequestValidationError is the validation error
+// returned by SearchPOIsSemanticByCityRequest.Validate if the designated
+// constraints aren't met.
+type SearchPOIsSemanticByCityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPOIsSemanticByCityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPOIsSemanticByCityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPOIsSemanticByCityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPOIsSemanticByCityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPOIsSemanticByCityRequestValidationError) ErrorName() string {
+	return "SearchPOIsSemanticByCityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPOIsSemanticByCityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPOIsSemanticByCityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPOIsSemanticByCityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPOIsSemanticByCityRequestValidationError{}
+
+// Validate checks the field values on SearchPOIsSemanticResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPOIsSemanticResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPOIsSemanticResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPOIsSemanticResponseMultiError, or nil if none found.
+func (m *SearchPOIsSemanticResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPOIsSemanticResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPois() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchPOIsSemanticResponseValidationError{
+						field:  fmt.Sprintf("Pois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchPOIsSemanticResponseValidationError{
+						field:  fmt.Sprintf("Pois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchPOIsSemanticResponseValidationError{
+					field:  fmt.Sprintf("Pois[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsSemanticResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsSemanticResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsSemanticResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsSemanticResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsSemanticResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsSemanticResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchPOIsSemanticResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPOIsSemanticResponseMultiError is an error wrapping multiple
+// validation errors returned by SearchPOIsSemanticResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SearchPOIsSemanticResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPOIsSemanticResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPOIsSemanticResponseMultiError) AllErrors() []error { return m }
+
+// SearchPOIsSemanticResponseValidationError is the validation error returned
+// by SearchPOIsSemanticResponse.Validate if the designated constraints aren't met.
+type SearchPOIsSemanticResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPOIsSemanticResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPOIsSemanticResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPOIsSemanticResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPOIsSemanticResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPOIsSemanticResponseValidationError) ErrorName() string {
+	return "SearchPOIsSemanticResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPOIsSemanticResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPOIsSemanticResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPOIsSemanticResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPOIsSemanticResponseValidationError{}
+
+// Validate checks the field values on POISemanticMatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *POISemanticMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on POISemanticMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// POISemanticMatchMultiError, or nil if none found.
+func (m *POISemanticMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *POISemanticMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPoi()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POISemanticMatchValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POISemanticMatchValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POISemanticMatchValidationError{
+				field:  "Poi",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SimilarityScore
+
+	// no validation rules for MatchReason
+
+	if len(errors) > 0 {
+		return POISemanticMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// POISemanticMatchMultiError is an error wrapping multiple validation errors
+// returned by POISemanticMatch.ValidateAll() if the designated constraints
+// aren't met.
+type POISemanticMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m POISemanticMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m POISemanticMatchMultiError) AllErrors() []error { return m }
+
+// POISemanticMatchValidationError is the validation error returned by
+// POISemanticMatch.Validate if the designated constraints aren't met.
+type POISemanticMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e POISemanticMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e POISemanticMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e POISemanticMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e POISemanticMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e POISemanticMatchValidationError) ErrorName() string { return "POISemanticMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e POISemanticMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPOISemanticMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = POISemanticMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = POISemanticMatchValidationError{}
+
+// Validate checks the field values on SearchPOIsHybridRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPOIsHybridRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPOIsHybridRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPOIsHybridRequestMultiError, or nil if none found.
+func (m *SearchPOIsHybridRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPOIsHybridRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsHybridRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsHybridRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsHybridRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SemanticQuery
+
+	if val := m.GetSemanticWeight(); val < 0 || val > 1 {
+		err := SearchPOIsHybridRequestValidationError{
+			field:  "SemanticWeight",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsHybridRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsHybridRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsHybridRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchPOIsHybridRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPOIsHybridRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchPOIsHybridRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchPOIsHybridRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPOIsHybridRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPOIsHybridRequestMultiError) AllErrors() []error { return m }
+
+// SearchPOIsHybridRequestValidationError is the validation error returned by
+// SearchPOIsHybridRequest.Validate if the designated constraints aren't met.
+type SearchPOIsHybridRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPOIsHybridRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPOIsHybridRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPOIsHybridRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPOIsHybridRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPOIsHybridRequestValidationError) ErrorName() string {
+	return "SearchPOIsHybridRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPOIsHybridRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPOIsHybridRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPOIsHybridRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPOIsHybridRequestValidationError{}
+
+// Validate checks the field values on SearchPOIsHybridResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPOIsHybridResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPOIsHybridResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPOIsHybridResponseMultiError, or nil if none found.
+func (m *SearchPOIsHybridResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPOIsHybridResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPois() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchPOIsHybridResponseValidationError{
+						field:  fmt.Sprintf("Pois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchPOIsHybridResponseValidationError{
+						field:  fmt.Sprintf("Pois[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchPOIsHybridResponseValidationError{
+					field:  fmt.Sprintf("Pois[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsHybridResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsHybridResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsHybridResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPOIsHybridResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPOIsHybridResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPOIsHybridResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchPOIsHybridResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPOIsHybridResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchPOIsHybridResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchPOIsHybridResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPOIsHybridResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPOIsHybridResponseMultiError) AllErrors() []error { return m }
+
+// SearchPOIsHybridResponseValidationError is the validation error returned by
+// SearchPOIsHybridResponse.Validate if the designated constraints aren't met.
+type SearchPOIsHybridResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPOIsHybridResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPOIsHybridResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPOIsHybridResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPOIsHybridResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPOIsHybridResponseValidationError) ErrorName() string {
+	return "SearchPOIsHybridResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPOIsHybridResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPOIsHybridResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPOIsHybridResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPOIsHybridResponseValidationError{}
+
+// Validate checks the field values on POIHybridMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *POIHybridMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on POIHybridMatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in POIHybridMatchMultiError,
+// or nil if none found.
+func (m *POIHybridMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *POIHybridMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPoi()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIHybridMatchValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIHybridMatchValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIHybridMatchValidationError{
+				field:  "Poi",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SpatialScore
+
+	// no validation rules for SemanticScore
+
+	// no validation rules for CombinedScore
+
+	if len(errors) > 0 {
+		return POIHybridMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// POIHybridMatchMultiError is an error wrapping multiple validation errors
+// returned by POIHybridMatch.ValidateAll() if the designated constraints
+// aren't met.
+type POIHybridMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m POIHybridMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m POIHybridMatchMultiError) AllErrors() []error { return m }
+
+// POIHybridMatchValidationError is the validation error returned by
+// POIHybridMatch.Validate if the designated constraints aren't met.
+type POIHybridMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e POIHybridMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e POIHybridMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e POIHybridMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e POIHybridMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e POIHybridMatchValidationError) ErrorName() string { return "POIHybridMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e POIHybridMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPOIHybridMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = POIHybridMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = POIHybridMatchValidationError{}
+
+// Validate checks the field values on SearchMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchMetadataMultiError,
+// or nil if none found.
+func (m *SearchMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QueryTimeMs
+
+	// no validation rules for SearchMethod
+
+	// no validation rules for DebugInfo
+
+	if len(errors) > 0 {
+		return SearchMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMetadataMultiError is an error wrapping multiple validation errors
+// returned by SearchMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type SearchMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMetadataMultiError) AllErrors() []error { return m }
+
+// SearchMetadataValidationError is the validation error returned by
+// SearchMetadata.Validate if the designated constraints aren't met.
+type SearchMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMetadataValidationError) ErrorName() string { return "SearchMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMetadataValidationError{}
+
+// Validate checks the field values on GetNearbyRecommendationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNearbyRecommendationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNearbyRecommendationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetNearbyRecommendationsRequestMultiError, or nil if none found.
+func (m *GetNearbyRecommendationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNearbyRecommendationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNearbyRecommendationsRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNearbyRecommendationsRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNearbyRecommendationsRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RadiusMeters
+
+	// no validation rules for UserId
+
+	// no validation rules for Limit
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNearbyRecommendationsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNearbyRecommendationsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNearbyRecommendationsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetNearbyRecommendationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNearbyRecommendationsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetNearbyRecommendationsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetNearbyRecommendationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNearbyRecommendationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNearbyRecommendationsRequestMultiError) AllErrors() []error { return m }
+
+// GetNearbyRecommendationsRequestValidationError is the validation error
+// returned by GetNearbyRecommendationsRequest.Validate if the designated
+// constraints aren't met.
+type GetNearbyRecommendationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNearbyRecommendationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNearbyRecommendationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNearbyRecommendationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNearbyRecommendationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNearbyRecommendationsRequestValidationError) ErrorName() string {
+	return "GetNearbyRecommendationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNearbyRecommendationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNearbyRecommendationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNearbyRecommendationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNearbyRecommendationsRequestValidationError{}
+
+// Validate checks the field values on GetNearbyRecommendationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetNearbyRecommendationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNearbyRecommendationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetNearbyRecommendationsResponseMultiError, or nil if none found.
+func (m *GetNearbyRecommendationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNearbyRecommendationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecommendations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetNearbyRecommendationsResponseValidationError{
+						field:  fmt.Sprintf("Recommendations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetNearbyRecommendationsResponseValidationError{
+						field:  fmt.Sprintf("Recommendations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetNearbyRecommendationsResponseValidationError{
+					field:  fmt.Sprintf("Recommendations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNearbyRecommendationsResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNearbyRecommendationsResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNearbyRecommendationsResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNearbyRecommendationsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNearbyRecommendationsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNearbyRecommendationsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetNearbyRecommendationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNearbyRecommendationsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetNearbyRecommendationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetNearbyRecommendationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNearbyRecommendationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNearbyRecommendationsResponseMultiError) AllErrors() []error { return m }
+
+// GetNearbyRecommendationsResponseValidationError is the validation error
+// returned by GetNearbyRecommendationsResponse.Validate if the designated
+// constraints aren't met.
+type GetNearbyRecommendationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNearbyRecommendationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNearbyRecommendationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNearbyRecommendationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNearbyRecommendationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNearbyRecommendationsResponseValidationError) ErrorName() string {
+	return "GetNearbyRecommendationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNearbyRecommendationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNearbyRecommendationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNearbyRecommendationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNearbyRecommendationsResponseValidationError{}
+
+// Validate checks the field values on POIRecommendation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *POIRecommendation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on POIRecommendation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// POIRecommendationMultiError, or nil if none found.
+func (m *POIRecommendation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *POIRecommendation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPoi()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, POIRecommendationValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, POIRecommendationValidationError{
+					field:  "Poi",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return POIRecommendationValidationError{
+				field:  "Poi",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RecommendationScore
+
+	// no validation rules for RecommendationReason
+
+	if len(errors) > 0 {
+		return POIRecommendationMultiError(errors)
+	}
+
+	return nil
+}
+
+// POIRecommendationMultiError is an error wrapping multiple validation errors
+// returned by POIRecommendation.ValidateAll() if the designated constraints
+// aren't met.
+type POIRecommendationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m POIRecommendationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m POIRecommendationMultiError) AllErrors() []error { return m }
+
+// POIRecommendationValidationError is the validation error returned by
+// POIRecommendation.Validate if the designated constraints aren't met.
+type POIRecommendationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e POIRecommendationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e POIRecommendationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e POIRecommendationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e POIRecommendationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e POIRecommendationValidationError) ErrorName() string {
+	return "POIRecommendationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e POIRecommendationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPOIRecommendation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = POIRecommendationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = POIRecommendationValidationError{}
+
+// Validate checks the field values on RecommendationMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendationMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendationMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendationMetadataMultiError, or nil if none found.
+func (m *RecommendationMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendationMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PersonalizationLevel
+
+	if len(errors) > 0 {
+		return RecommendationMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendationMetadataMultiError is an error wrapping multiple validation
+// errors returned by RecommendationMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendationMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendationMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendationMetadataMultiError) AllErrors() []error { return m }
+
+// RecommendationMetadataValidationError is the validation error returned by
+// RecommendationMetadata.Validate if the designated constraints aren't met.
+type RecommendationMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendationMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendationMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendationMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendationMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendationMetadataValidationError) ErrorName() string {
+	return "RecommendationMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendationMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendationMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendationMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendationMetadataValidationError{}
+
+// Validate checks the field values on DiscoverRestaurantsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscoverRestaurantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscoverRestaurantsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscoverRestaurantsRequestMultiError, or nil if none found.
+func (m *DiscoverRestaurantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscoverRestaurantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverRestaurantsRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverRestaurantsRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverRestaurantsRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RadiusMeters
+
+	// no validation rules for MinRating
+
+	// no validation rules for Limit
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverRestaurantsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverRestaurantsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverRestaurantsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiscoverRestaurantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscoverRestaurantsRequestMultiError is an error wrapping multiple
+// validation errors returned by DiscoverRestaurantsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DiscoverRestaurantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscoverRestaurantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscoverRestaurantsRequestMultiError) AllErrors() []error { return m }
+
+// DiscoverRestaurantsRequestValidationError is the validation error returned
+// by DiscoverRestaurantsRequest.Validate if the designated constraints aren't met.
+type DiscoverRestaurantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscoverRestaurantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscoverRestaurantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscoverRestaurantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscoverRestaurantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscoverRestaurantsRequestValidationError) ErrorName() string {
+	return "DiscoverRestaurantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscoverRestaurantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscoverRestaurantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscoverRestaurantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscoverRestaurantsRequestValidationError{}
+
+// Validate checks the field values on DiscoverRestaurantsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscoverRestaurantsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscoverRestaurantsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscoverRestaurantsResponseMultiError, or nil if none found.
+func (m *DiscoverRestaurantsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscoverRestaurantsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRestaurants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscoverRestaurantsResponseValidationError{
+						field:  fmt.Sprintf("Restaurants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscoverRestaurantsResponseValidationError{
+						field:  fmt.Sprintf("Restaurants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscoverRestaurantsResponseValidationError{
+					field:  fmt.Sprintf("Restaurants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverRestaurantsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverRestaurantsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverRestaurantsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiscoverRestaurantsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscoverRestaurantsResponseMultiError is an error wrapping multiple
+// validation errors returned by DiscoverRestaurantsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DiscoverRestaurantsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscoverRestaurantsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscoverRestaurantsResponseMultiError) AllErrors() []error { return m }
+
+// DiscoverRestaurantsResponseValidationError is the validation error returned
+// by DiscoverRestaurantsResponse.Validate if the designated constraints
+// aren't met.
+type DiscoverRestaurantsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscoverRestaurantsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscoverRestaurantsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscoverRestaurantsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscoverRestaurantsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscoverRestaurantsResponseValidationError) ErrorName() string {
+	return "DiscoverRestaurantsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscoverRestaurantsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscoverRestaurantsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscoverRestaurantsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscoverRestaurantsResponseValidationError{}
+
+// Validate checks the field values on DiscoverActivitiesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscoverActivitiesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscoverActivitiesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscoverActivitiesRequestMultiError, or nil if none found.
+func (m *DiscoverActivitiesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscoverActivitiesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverActivitiesRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverActivitiesRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverActivitiesRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RadiusMeters
+
+	// no validation rules for Limit
+
+	// no validation rules for Duration
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverActivitiesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverActivitiesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverActivitiesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiscoverActivitiesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscoverActivitiesRequestMultiError is an error wrapping multiple validation
+// errors returned by DiscoverActivitiesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DiscoverActivitiesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscoverActivitiesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscoverActivitiesRequestMultiError) AllErrors() []error { return m }
+
+// DiscoverActivitiesRequestValidationError is the validation error returned by
+// DiscoverActivitiesRequest.Validate if the designated constraints aren't met.
+type DiscoverActivitiesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscoverActivitiesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscoverActivitiesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscoverActivitiesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscoverActivitiesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscoverActivitiesRequestValidationError) ErrorName() string {
+	return "DiscoverActivitiesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscoverActivitiesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscoverActivitiesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscoverActivitiesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscoverActivitiesRequestValidationError{}
+
+// Validate checks the field values on DiscoverActivitiesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscoverActivitiesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscoverActivitiesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscoverActivitiesResponseMultiError, or nil if none found.
+func (m *DiscoverActivitiesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscoverActivitiesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetActivities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscoverActivitiesResponseValidationError{
+						field:  fmt.Sprintf("Activities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscoverActivitiesResponseValidationError{
+						field:  fmt.Sprintf("Activities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscoverActivitiesResponseValidationError{
+					field:  fmt.Sprintf("Activities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverActivitiesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverActivitiesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverActivitiesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiscoverActivitiesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscoverActivitiesResponseMultiError is an error wrapping multiple
+// validation errors returned by DiscoverActivitiesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DiscoverActivitiesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscoverActivitiesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscoverActivitiesResponseMultiError) AllErrors() []error { return m }
+
+// DiscoverActivitiesResponseValidationError is the validation error returned
+// by DiscoverActivitiesResponse.Validate if the designated constraints aren't met.
+type DiscoverActivitiesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscoverActivitiesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscoverActivitiesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscoverActivitiesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscoverActivitiesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscoverActivitiesResponseValidationError) ErrorName() string {
+	return "DiscoverActivitiesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscoverActivitiesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscoverActivitiesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscoverActivitiesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscoverActivitiesResponseValidationError{}
+
+// Validate checks the field values on DiscoverHotelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscoverHotelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscoverHotelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscoverHotelsRequestMultiError, or nil if none found.
+func (m *DiscoverHotelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscoverHotelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverHotelsRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverHotelsRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverHotelsRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RadiusMeters
+
+	// no validation rules for Limit
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverHotelsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverHotelsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverHotelsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiscoverHotelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscoverHotelsRequestMultiError is an error wrapping multiple validation
+// errors returned by DiscoverHotelsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DiscoverHotelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscoverHotelsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscoverHotelsRequestMultiError) AllErrors() []error { return m }
+
+// DiscoverHotelsRequestValidationError is the validation error returned by
+// DiscoverHotelsRequest.Validate if the designated constraints aren't met.
+type DiscoverHotelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscoverHotelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscoverHotelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscoverHotelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscoverHotelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscoverHotelsRequestValidationError) ErrorName() string {
+	return "DiscoverHotelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscoverHotelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscoverHotelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscoverHotelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscoverHotelsRequestValidationError{}
+
+// Validate checks the field values on DiscoverHotelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscoverHotelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscoverHotelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscoverHotelsResponseMultiError, or nil if none found.
+func (m *DiscoverHotelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscoverHotelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHotels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscoverHotelsResponseValidationError{
+						field:  fmt.Sprintf("Hotels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscoverHotelsResponseValidationError{
+						field:  fmt.Sprintf("Hotels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscoverHotelsResponseValidationError{
+					field:  fmt.Sprintf("Hotels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverHotelsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverHotelsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverHotelsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiscoverHotelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscoverHotelsResponseMultiError is an error wrapping multiple validation
+// errors returned by DiscoverHotelsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DiscoverHotelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscoverHotelsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscoverHotelsResponseMultiError) AllErrors() []error { return m }
+
+// DiscoverHotelsResponseValidationError is the validation error returned by
+// DiscoverHotelsResponse.Validate if the designated constraints aren't met.
+type DiscoverHotelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscoverHotelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscoverHotelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscoverHotelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscoverHotelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscoverHotelsResponseValidationError) ErrorName() string {
+	return "DiscoverHotelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscoverHotelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscoverHotelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscoverHotelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscoverHotelsResponseValidationError{}
+
+// Validate checks the field values on DiscoverAttractionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscoverAttractionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscoverAttractionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscoverAttractionsRequestMultiError, or nil if none found.
+func (m *DiscoverAttractionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscoverAttractionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverAttractionsRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverAttractionsRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverAttractionsRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RadiusMeters
+
+	// no validation rules for Limit
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverAttractionsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverAttractionsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverAttractionsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiscoverAttractionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscoverAttractionsRequestMultiError is an error wrapping multiple
+// validation errors returned by DiscoverAttractionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DiscoverAttractionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscoverAttractionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscoverAttractionsRequestMultiError) AllErrors() []error { return m }
+
+// DiscoverAttractionsRequestValidationError is the validation error returned
+// by DiscoverAttractionsRequest.Validate if the designated constraints aren't met.
+type DiscoverAttractionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscoverAttractionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscoverAttractionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscoverAttractionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscoverAttractionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscoverAttractionsRequestValidationError) ErrorName() string {
+	return "DiscoverAttractionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscoverAttractionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscoverAttractionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscoverAttractionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscoverAttractionsRequestValidationError{}
+
+// Validate checks the field values on DiscoverAttractionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscoverAttractionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscoverAttractionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscoverAttractionsResponseMultiError, or nil if none found.
+func (m *DiscoverAttractionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscoverAttractionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAttractions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscoverAttractionsResponseValidationError{
+						field:  fmt.Sprintf("Attractions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscoverAttractionsResponseValidationError{
+						field:  fmt.Sprintf("Attractions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscoverAttractionsResponseValidationError{
+					field:  fmt.Sprintf("Attractions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverAttractionsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverAttractionsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverAttractionsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiscoverAttractionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscoverAttractionsResponseMultiError is an error wrapping multiple
+// validation errors returned by DiscoverAttractionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DiscoverAttractionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscoverAttractionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscoverAttractionsResponseMultiError) AllErrors() []error { return m }
+
+// DiscoverAttractionsResponseValidationError is the validation error returned
+// by DiscoverAttractionsResponse.Validate if the designated constraints
+// aren't met.
+type DiscoverAttractionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscoverAttractionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscoverAttractionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscoverAttractionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscoverAttractionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscoverAttractionsResponseValidationError) ErrorName() string {
+	return "DiscoverAttractionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscoverAttractionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscoverAttractionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscoverAttractionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscoverAttractionsResponseValidationError{}
+
+// Validate checks the field values on AddToFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddToFavoritesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddToFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddToFavoritesRequestMultiError, or nil if none found.
+func (m *AddToFavoritesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddToFavoritesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for PoiId
+
+	// no validation rules for IsLlmPoi
+
+	if all {
+		switch v := interface{}(m.GetPoiData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddToFavoritesRequestValidationError{
+					field:  "PoiData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddToFavoritesRequestValidationError{
+					field:  "PoiData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoiData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddToFavoritesRequestValidationError{
+				field:  "PoiData",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddToFavoritesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddToFavoritesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddToFavoritesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddToFavoritesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddToFavoritesRequestMultiError is an error wrapping multiple validation
+// errors returned by AddToFavoritesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddToFavoritesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddToFavoritesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddToFavoritesRequestMultiError) AllErrors() []error { return m }
+
+// AddToFavoritesRequestValidationError is the validation error returned by
+// AddToFavoritesRequest.Validate if the designated constraints aren't met.
+type AddToFavoritesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddToFavoritesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddToFavoritesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddToFavoritesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddToFavoritesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddToFavoritesRequestValidationError) ErrorName() string {
+	return "AddToFavoritesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddToFavoritesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddToFavoritesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddToFavoritesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddToFavoritesRequestValidationError{}
+
+// Validate checks the field values on AddToFavoritesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddToFavoritesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddToFavoritesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddToFavoritesResponseMultiError, or nil if none found.
+func (m *AddToFavoritesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddToFavoritesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	// no validation rules for PoiId
+
+	// no validation rules for FavoriteId
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddToFavoritesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddToFavoritesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddToFavoritesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddToFavoritesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddToFavoritesResponseMultiError is an error wrapping multiple validation
+// errors returned by AddToFavoritesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddToFavoritesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddToFavoritesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddToFavoritesResponseMultiError) AllErrors() []error { return m }
+
+// AddToFavoritesResponseValidationError is the validation error returned by
+// AddToFavoritesResponse.Validate if the designated constraints aren't met.
+type AddToFavoritesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddToFavoritesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddToFavoritesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddToFavoritesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddToFavoritesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddToFavoritesResponseValidationError) ErrorName() string {
+	return "AddToFavoritesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddToFavoritesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddToFavoritesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddToFavoritesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddToFavoritesResponseValidationError{}
+
+// Validate checks the field values on RemoveFromFavoritesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveFromFavoritesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveFromFavoritesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveFromFavoritesRequestMultiError, or nil if none found.
+func (m *RemoveFromFavoritesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveFromFavoritesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for PoiId
+
+	// no validation rules for IsLlmPoi
+
+	if all {
+		switch v := interface{}(m.GetPoiData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveFromFavoritesRequestValidationError{
+					field:  "PoiData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveFromFavoritesRequestValidationError{
+					field:  "PoiData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoiData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveFromFavoritesRequestValidationError{
+				field:  "PoiData",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveFromFavoritesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveFromFavoritesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveFromFavoritesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveFromFavoritesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveFromFavoritesRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveFromFavoritesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveFromFavoritesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveFromFavoritesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveFromFavoritesRequestMultiError) AllErrors() []error { return m }
+
+// RemoveFromFavoritesRequestValidationError is the validation error returned
+// by RemoveFromFavoritesRequest.Validate if the designated constraints aren't met.
+type RemoveFromFavoritesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveFromFavoritesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveFromFavoritesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveFromFavoritesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveFromFavoritesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveFromFavoritesRequestValidationError) ErrorName() string {
+	return "RemoveFromFavoritesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveFromFavoritesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveFromFavoritesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveFromFavoritesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveFromFavoritesRequestValidationError{}
+
+// Validate checks the field values on RemoveFromFavoritesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveFromFavoritesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveFromFavoritesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveFromFavoritesResponseMultiError, or nil if none found.
+func (m *RemoveFromFavoritesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveFromFavoritesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	// no validation rules for PoiId
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveFromFavoritesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveFromFavoritesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveFromFavoritesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveFromFavoritesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveFromFavoritesResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveFromFavoritesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveFromFavoritesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveFromFavoritesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveFromFavoritesResponseMultiError) AllErrors() []error { return m }
+
+// RemoveFromFavoritesResponseValidationError is the validation error returned
+// by RemoveFromFavoritesResponse.Validate if the designated constraints
+// aren't met.
+type RemoveFromFavoritesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveFromFavoritesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveFromFavoritesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveFromFavoritesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveFromFavoritesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveFromFavoritesResponseValidationError) ErrorName() string {
+	return "RemoveFromFavoritesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveFromFavoritesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveFromFavoritesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveFromFavoritesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveFromFavoritesResponseValidationError{}
+
+// Validate checks the field values on GetFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFavoritesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFavoritesRequestMultiError, or nil if none found.
+func (m *GetFavoritesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFavoritesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFavoritesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFavoritesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFavoritesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFavoritesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFavoritesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFavoritesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFavoritesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFavoritesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFavoritesRequestMultiError) AllErrors() []error { return m }
+
+// GetFavoritesRequestValidationError is the validation error returned by
+// GetFavoritesRequest.Validate if the designated constraints aren't met.
+type GetFavoritesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFavoritesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFavoritesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFavoritesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFavoritesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFavoritesRequestValidationError) ErrorName() string {
+	return "GetFavoritesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFavoritesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFavoritesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFavoritesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFavoritesRequestValidationError{}
+
+// Validate checks the field values on GetFavoritesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFavoritesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFavoritesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFavoritesResponseMultiError, or nil if none found.
+func (m *GetFavoritesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFavoritesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFavorites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Favorites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFavoritesResponseValidationError{
+						field:  fmt.Sprintf("Favorites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFavoritesResponseValidationError{
+					field:  fmt.Sprintf("Favorites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for CurrentPage
+
+	// no validation rules for TotalPages
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFavoritesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFavoritesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFavoritesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFavoritesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFavoritesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFavoritesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFavoritesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFavoritesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFavoritesResponseMultiError) AllErrors() []error { return m }
+
+// GetFavoritesResponseValidationError is the validation error returned by
+// GetFavoritesResponse.Validate if the designated constraints aren't met.
+type GetFavoritesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFavoritesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFavoritesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFavoritesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFavoritesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFavoritesResponseValidationError) ErrorName() string {
+	return "GetFavoritesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFavoritesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFavoritesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFavoritesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFavoritesResponseValidationError{}
+
+// Validate checks the field values on GetItinerariesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetItinerariesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetItinerariesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetItinerariesRequestMultiError, or nil if none found.
+func (m *GetItinerariesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetItinerariesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetItinerariesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetItinerariesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetItinerariesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetItinerariesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetItinerariesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetItinerariesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetItinerariesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetItinerariesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetItinerariesRequestMultiError) AllErrors() []error { return m }
+
+// GetItinerariesRequestValidationError is the validation error returned by
+// GetItinerariesRequest.Validate if the designated constraints aren't met.
+type GetItinerariesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetItinerariesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetItinerariesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetItinerariesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetItinerariesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetItinerariesRequestValidationError) ErrorName() string {
+	return "GetItinerariesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetItinerariesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetItinerariesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetItinerariesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetItinerariesRequestValidationError{}
+
+// Validate checks the field values on GetItinerariesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetItinerariesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetItinerariesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetItinerariesResponseMultiError, or nil if none found.
+func (m *GetItinerariesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetItinerariesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItineraries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetItinerariesResponseValidationError{
+						field:  fmt.Sprintf("Itineraries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetItinerariesResponseValidationError{
+						field:  fmt.Sprintf("Itineraries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetItinerariesResponseValidationError{
+					field:  fmt.Sprintf("Itineraries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetItinerariesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetItinerariesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetItinerariesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetItinerariesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetItinerariesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetItinerariesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetItinerariesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetItinerariesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetItinerariesResponseMultiError) AllErrors() []error { return m }
+
+// GetItinerariesResponseValidationError is the validation error returned by
+// GetItinerariesResponse.Validate if the designated constraints aren't met.
+type GetItinerariesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetItinerariesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetItinerariesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetItinerariesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetItinerariesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetItinerariesResponseValidationError) ErrorName() string {
+	return "GetItinerariesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetItinerariesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetItinerariesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetItinerariesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetItinerariesResponseValidationError{}
+
+// Validate checks the field values on GetItineraryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetItineraryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetItineraryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetItineraryRequestMultiError, or nil if none found.
+func (m *GetItineraryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetItineraryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ItineraryId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetItineraryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetItineraryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetItineraryRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetItineraryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetItineraryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetItineraryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetItineraryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetItineraryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetItineraryRequestMultiError) AllErrors() []error { return m }
+
+// GetItineraryRequestValidationError is the validation error returned by
+// GetItineraryRequest.Validate if the designated constraints aren't met.
+type GetItineraryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetItineraryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetItineraryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetItineraryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetItineraryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetItineraryRequestValidationError) ErrorName() string {
+	return "GetItineraryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetItineraryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetItineraryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetItineraryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetItineraryRequestValidationError{}
+
+// Validate checks the field values on GetItineraryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetItineraryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetItineraryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetItineraryResponseMultiError, or nil if none found.
+func (m *GetItineraryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetItineraryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItinerary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetItineraryResponseValidationError{
+					field:  "Itinerary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetItineraryResponseValidationError{
+					field:  "Itinerary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItinerary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetItineraryResponseValidationError{
+				field:  "Itinerary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetItineraryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetItineraryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetItineraryResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetItineraryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetItineraryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetItineraryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetItineraryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetItineraryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetItineraryResponseMultiError) AllErrors() []error { return m }
+
+// GetItineraryResponseValidationError is the validation error returned by
+// GetItineraryResponse.Validate if the designated constraints aren't met.
+type GetItineraryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetItineraryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetItineraryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetItineraryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetItineraryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetItineraryResponseValidationError) ErrorName() string {
+	return "GetItineraryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetItineraryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetItineraryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetItineraryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetItineraryResponseValidationError{}
+
+// Validate checks the field values on UpdateItineraryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateItineraryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateItineraryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateItineraryRequestMultiError, or nil if none found.
+func (m *UpdateItineraryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateItineraryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ItineraryId
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for MarkdownContent
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateItineraryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateItineraryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateItineraryRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateItineraryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateItineraryRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateItineraryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateItineraryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateItineraryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateItineraryRequestMultiError) AllErrors() []error { return m }
+
+// UpdateItineraryRequestValidationError is the validation error returned by
+// UpdateItineraryRequest.Validate if the designated constraints aren't met.
+type UpdateItineraryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateItineraryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateItineraryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateItineraryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateItineraryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateItineraryRequestValidationError) ErrorName() string {
+	return "UpdateItineraryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateItineraryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateItineraryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateItineraryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateItineraryRequestValidationError{}
+
+// Validate checks the field values on UpdateItineraryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateItineraryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateItineraryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateItineraryResponseMultiError, or nil if none found.
+func (m *UpdateItineraryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateItineraryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetItinerary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateItineraryResponseValidationError{
+					field:  "Itinerary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateItineraryResponseValidationError{
+					field:  "Itinerary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItinerary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateItineraryResponseValidationError{
+				field:  "Itinerary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateItineraryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateItineraryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateItineraryResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateItineraryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateItineraryResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateItineraryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateItineraryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateItineraryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateItineraryResponseMultiError) AllErrors() []error { return m }
+
+// UpdateItineraryResponseValidationError is the validation error returned by
+// UpdateItineraryResponse.Validate if the designated constraints aren't met.
+type UpdateItineraryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateItineraryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateItineraryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateItineraryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateItineraryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateItineraryResponseValidationError) ErrorName() string {
+	return "UpdateItineraryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateItineraryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateItineraryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateItineraryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateItineraryResponseValidationError{}
+
+// Validate checks the field values on UserItinerary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserItinerary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserItinerary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserItineraryMultiError, or
+// nil if none found.
+func (m *UserItinerary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserItinerary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for SessionId
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for MarkdownContent
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserItineraryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserItineraryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserItineraryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserItineraryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserItineraryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserItineraryValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserItineraryValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserItineraryValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserItineraryValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for EstimatedRepeatedDays
+
+	// no validation rules for EstimatedCostLevel_11
+
+	// no validation rules for IsPublic
+
+	if len(errors) > 0 {
+		return UserItineraryMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserItineraryMultiError is an error wrapping multiple validation errors
+// returned by UserItinerary.ValidateAll() if the designated constraints
+// aren't met.
+type UserItineraryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserItineraryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserItineraryMultiError) AllErrors() []error { return m }
+
+// UserItineraryValidationError is the validation error returned by
+// UserItinerary.Validate if the designated constraints aren't met.
+type UserItineraryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserItineraryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserItineraryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserItineraryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserItineraryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserItineraryValidationError) ErrorName() string { return "UserItineraryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserItineraryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserItinerary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserItineraryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserItineraryValidationError{}
+
+// Validate checks the field values on Tags with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tags) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tags with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagsMultiError, or nil if none found.
+func (m *Tags) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tags) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for TagType
+
+	// no validation rules for Description
+
+	// no validation rules for Source
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagsValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagsValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagsValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagsValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagsValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagsValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TagsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagsMultiError is an error wrapping multiple validation errors returned by
+// Tags.ValidateAll() if the designated constraints aren't met.
+type TagsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagsMultiError) AllErrors() []error { return m }
+
+// TagsValidationError is the validation error returned by Tags.Validate if the
+// designated constraints aren't met.
+type TagsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagsValidationError) ErrorName() string { return "TagsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTags.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagsValidationError{}
+
+// Validate checks the field values on GenerateEmbeddingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateEmbeddingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateEmbeddingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateEmbeddingsRequestMultiError, or nil if none found.
+func (m *GenerateEmbeddingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateEmbeddingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchSize
+
+	// no validation rules for ForceRegenerate
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateEmbeddingsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateEmbeddingsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateEmbeddingsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateEmbeddingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateEmbeddingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateEmbeddingsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateEmbeddingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateEmbeddingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateEmbeddingsRequestMultiError) AllErrors() []error { return m }
+
+// GenerateEmbeddingsRequestValidationError is the validation error returned by
+// GenerateEmbeddingsRequest.Validate if the designated constraints aren't met.
+type GenerateEmbeddingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateEmbeddingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateEmbeddingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateEmbeddingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateEmbeddingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateEmbeddingsRequestValidationError) ErrorName() string {
+	return "GenerateEmbeddingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateEmbeddingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateEmbeddingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateEmbeddingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateEmbeddingsRequestValidationError{}
+
+// Validate checks the field values on GenerateEmbeddingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateEmbeddingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateEmbeddingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateEmbeddingsResponseMultiError, or nil if none found.
+func (m *GenerateEmbeddingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateEmbeddingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProcessedCount
+
+	// no validation rules for UpdatedCount
+
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	// no validation rules for Success
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateEmbeddingsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateEmbeddingsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateEmbeddingsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateEmbeddingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateEmbeddingsResponseMultiError is an error wrapping multiple
+// validation errors returned by GenerateEmbeddingsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GenerateEmbeddingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateEmbeddingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateEmbeddingsResponseMultiError) AllErrors() []error { return m }
+
+// GenerateEmbeddingsResponseValidationError is the validation error returned
+// by GenerateEmbeddingsResponse.Validate if the designated constraints aren't met.
+type GenerateEmbeddingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateEmbeddingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateEmbeddingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateEmbeddingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateEmbeddingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateEmbeddingsResponseValidationError) ErrorName() string {
+	return "GenerateEmbeddingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateEmbeddingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateEmbeddingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateEmbeddingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateEmbeddingsResponseValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
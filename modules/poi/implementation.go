@@ -12,9 +12,9 @@ import (
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.POIServiceClient
 }
 
 var (
@@ -22,7 +22,7 @@ var (
 	_ core.Broker        = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -30,90 +30,180 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewPOIServiceClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.POIServiceClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewPOIServiceClient(conn), nil
+}
+
 // POIService method implementations
 func (b *Broker) GetPOIsByCity(ctx context.Context, in *c.GetPOIsByCityRequest, opts ...grpc.CallOption) (*c.GetPOIsByCityResponse, error) {
-	return b.client.GetPOIsByCity(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetPOIsByCity(ctx, in, opts...)
 }
 
 func (b *Broker) SearchPOIs(ctx context.Context, in *c.SearchPOIsRequest, opts ...grpc.CallOption) (*c.SearchPOIsResponse, error) {
-	return b.client.SearchPOIs(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SearchPOIs(ctx, in, opts...)
 }
 
 func (b *Broker) SearchPOIsSemantic(ctx context.Context, in *c.SearchPOIsSemanticRequest, opts ...grpc.CallOption) (*c.SearchPOIsSemanticResponse, error) {
-	return b.client.SearchPOIsSemantic(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SearchPOIsSemantic(ctx, in, opts...)
 }
 
 func (b *Broker) SearchPOIsSemanticByCity(ctx context.Context, in *c.SearchPOIsSemanticByCityRequest, opts ...grpc.CallOption) (*c.SearchPOIsSemanticResponse, error) {
-	return b.client.SearchPOIsSemanticByCity(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SearchPOIsSemanticByCity(ctx, in, opts...)
 }
 
 func (b *Broker) SearchPOIsHybrid(ctx context.Context, in *c.SearchPOIsHybridRequest, opts ...grpc.CallOption) (*c.SearchPOIsHybridResponse, error) {
-	return b.client.SearchPOIsHybrid(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SearchPOIsHybrid(ctx, in, opts...)
 }
 
 func (b *Broker) GetNearbyRecommendations(ctx context.Context, in *c.GetNearbyRecommendationsRequest, opts ...grpc.CallOption) (*c.GetNearbyRecommendationsResponse, error) {
-	return b.client.GetNearbyRecommendations(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetNearbyRecommendations(ctx, in, opts...)
 }
 
 func (b *Broker) DiscoverRestaurants(ctx context.Context, in *c.DiscoverRestaurantsRequest, opts ...grpc.CallOption) (*c.DiscoverRestaurantsResponse, error) {
-	return b.client.DiscoverRestaurants(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DiscoverRestaurants(ctx, in, opts...)
 }
 
 func (b *Broker) DiscoverActivities(ctx context.Context, in *c.DiscoverActivitiesRequest, opts ...grpc.CallOption) (*c.DiscoverActivitiesResponse, error) {
-	return b.client.DiscoverActivities(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DiscoverActivities(ctx, in, opts...)
 }
 
 func (b *Broker) DiscoverHotels(ctx context.Context, in *c.DiscoverHotelsRequest, opts ...grpc.CallOption) (*c.DiscoverHotelsResponse, error) {
-	return b.client.DiscoverHotels(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DiscoverHotels(ctx, in, opts...)
 }
 
 func (b *Broker) DiscoverAttractions(ctx context.Context, in *c.DiscoverAttractionsRequest, opts ...grpc.CallOption) (*c.DiscoverAttractionsResponse, error) {
-	return b.client.DiscoverAttractions(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DiscoverAttractions(ctx, in, opts...)
 }
 
 func (b *Broker) AddToFavorites(ctx context.Context, in *c.AddToFavoritesRequest, opts ...grpc.CallOption) (*c.AddToFavoritesResponse, error) {
-	return b.client.AddToFavorites(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.AddToFavorites(ctx, in, opts...)
 }
 
 func (b *Broker) RemoveFromFavorites(ctx context.Context, in *c.RemoveFromFavoritesRequest, opts ...grpc.CallOption) (*c.RemoveFromFavoritesResponse, error) {
-	return b.client.RemoveFromFavorites(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.RemoveFromFavorites(ctx, in, opts...)
 }
 
 func (b *Broker) GetFavorites(ctx context.Context, in *c.GetFavoritesRequest, opts ...grpc.CallOption) (*c.GetFavoritesResponse, error) {
-	return b.client.GetFavorites(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetFavorites(ctx, in, opts...)
 }
 
 func (b *Broker) GetItineraries(ctx context.Context, in *c.GetItinerariesRequest, opts ...grpc.CallOption) (*c.GetItinerariesResponse, error) {
-	return b.client.GetItineraries(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetItineraries(ctx, in, opts...)
 }
 
 func (b *Broker) GetItinerary(ctx context.Context, in *c.GetItineraryRequest, opts ...grpc.CallOption) (*c.GetItineraryResponse, error) {
-	return b.client.GetItinerary(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetItinerary(ctx, in, opts...)
 }
 
 func (b *Broker) UpdateItinerary(ctx context.Context, in *c.UpdateItineraryRequest, opts ...grpc.CallOption) (*c.UpdateItineraryResponse, error) {
-	return b.client.UpdateItinerary(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.UpdateItinerary(ctx, in, opts...)
 }
 
 func (b *Broker) GenerateEmbeddings(ctx context.Context, in *c.GenerateEmbeddingsRequest, opts ...grpc.CallOption) (*c.GenerateEmbeddingsResponse, error) {
-	return b.client.GenerateEmbeddings(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GenerateEmbeddings(ctx, in, opts...)
 }
\ No newline at end of file
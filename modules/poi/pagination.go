@@ -0,0 +1,30 @@
+package poi
+
+import (
+	"context"
+	"iter"
+
+	"google.golang.org/protobuf/proto"
+
+	c "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+	"github.com/FACorreiaa/loci-proto/pagination"
+)
+
+// AllFavorites iterates over every favourite POI of the user of in, fetching
+// them a page at a time. The paging fields of in are ignored in favour of
+// opts.
+func (b *Broker) AllFavorites(ctx context.Context, in *c.GetFavoritesRequest, opts ...pagination.Option) iter.Seq2[*c.POIDetailedInfo, error] {
+	return pagination.New(func(ctx context.Context, req pagination.Request) (pagination.Page[*c.POIDetailedInfo], error) {
+		p := req.Pagination()
+		page := proto.CloneOf(in)
+		page.Page, page.PageSize = p.GetPage(), p.GetPageSize()
+		page.Limit, page.Offset = p.GetLimit(), p.GetOffset()
+
+		resp, err := b.GetFavorites(ctx, page)
+		if err != nil {
+			return pagination.Page[*c.POIDetailedInfo]{}, err
+		}
+
+		return pagination.PageFromTotal(resp.GetFavorites(), resp.GetTotalCount(), req), nil
+	}, opts...).All(ctx)
+}
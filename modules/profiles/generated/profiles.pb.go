@@ -7,6 +7,8 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -190,6 +192,7 @@ type RangeFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Min           float64                `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
 	Max           float64                `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217, for price ranges
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -238,6 +241,13 @@ func (x *RangeFilter) GetMax() float64 {
 	return 0
 }
 
+func (x *RangeFilter) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 // Accommodation preferences
 type AccommodationPreferences struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
@@ -1527,7 +1537,7 @@ func (x *UpdateSearchProfileParams) GetItineraryPreferences() *ItineraryPreferen
 type GetSearchProfilesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1569,7 +1579,7 @@ func (x *GetSearchProfilesRequest) GetUserId() string {
 	return ""
 }
 
-func (x *GetSearchProfilesRequest) GetRequest() *BaseRequest {
+func (x *GetSearchProfilesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1580,7 +1590,7 @@ type GetSearchProfilesResponse struct {
 	state            protoimpl.MessageState   `protogen:"open.v1"`
 	Profiles         []*UserPreferenceProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
 	DefaultProfileId string                   `protobuf:"bytes,2,opt,name=default_profile_id,json=defaultProfileId,proto3" json:"default_profile_id,omitempty"`
-	Response         *BaseResponse            `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Response         *generated.BaseResponse  `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1629,7 +1639,7 @@ func (x *GetSearchProfilesResponse) GetDefaultProfileId() string {
 	return ""
 }
 
-func (x *GetSearchProfilesResponse) GetResponse() *BaseResponse {
+func (x *GetSearchProfilesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1640,7 +1650,7 @@ type GetSearchProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ProfileId     string                 `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1689,7 +1699,7 @@ func (x *GetSearchProfileRequest) GetProfileId() string {
 	return ""
 }
 
-func (x *GetSearchProfileRequest) GetRequest() *BaseRequest {
+func (x *GetSearchProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1697,9 +1707,9 @@ func (x *GetSearchProfileRequest) GetRequest() *BaseRequest {
 }
 
 type GetSearchProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *UserPreferenceProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Profile       *UserPreferenceProfile  `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1741,7 +1751,7 @@ func (x *GetSearchProfileResponse) GetProfile() *UserPreferenceProfile {
 	return nil
 }
 
-func (x *GetSearchProfileResponse) GetResponse() *BaseResponse {
+func (x *GetSearchProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1751,7 +1761,7 @@ func (x *GetSearchProfileResponse) GetResponse() *BaseResponse {
 type GetDefaultSearchProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1793,7 +1803,7 @@ func (x *GetDefaultSearchProfileRequest) GetUserId() string {
 	return ""
 }
 
-func (x *GetDefaultSearchProfileRequest) GetRequest() *BaseRequest {
+func (x *GetDefaultSearchProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1801,9 +1811,9 @@ func (x *GetDefaultSearchProfileRequest) GetRequest() *BaseRequest {
 }
 
 type GetDefaultSearchProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *UserPreferenceProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Profile       *UserPreferenceProfile  `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1845,7 +1855,7 @@ func (x *GetDefaultSearchProfileResponse) GetProfile() *UserPreferenceProfile {
 	return nil
 }
 
-func (x *GetDefaultSearchProfileResponse) GetResponse() *BaseResponse {
+func (x *GetDefaultSearchProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1856,7 +1866,7 @@ type CreateSearchProfileRequest struct {
 	state         protoimpl.MessageState             `protogen:"open.v1"`
 	UserId        string                             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Profile       *CreateUserPreferenceProfileParams `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
-	Request       *BaseRequest                       `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest             `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1905,7 +1915,7 @@ func (x *CreateSearchProfileRequest) GetProfile() *CreateUserPreferenceProfilePa
 	return nil
 }
 
-func (x *CreateSearchProfileRequest) GetRequest() *BaseRequest {
+func (x *CreateSearchProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1913,11 +1923,11 @@ func (x *CreateSearchProfileRequest) GetRequest() *BaseRequest {
 }
 
 type CreateSearchProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Profile       *UserPreferenceProfile `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Profile       *UserPreferenceProfile  `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1973,7 +1983,7 @@ func (x *CreateSearchProfileResponse) GetProfile() *UserPreferenceProfile {
 	return nil
 }
 
-func (x *CreateSearchProfileResponse) GetResponse() *BaseResponse {
+func (x *CreateSearchProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1986,7 +1996,7 @@ type UpdateSearchProfileRequest struct {
 	ProfileId     string                     `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
 	Profile       *UpdateSearchProfileParams `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
 	UpdateFields  []string                   `protobuf:"bytes,4,rep,name=update_fields,json=updateFields,proto3" json:"update_fields,omitempty"`
-	Request       *BaseRequest               `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest     `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2049,7 +2059,7 @@ func (x *UpdateSearchProfileRequest) GetUpdateFields() []string {
 	return nil
 }
 
-func (x *UpdateSearchProfileRequest) GetRequest() *BaseRequest {
+func (x *UpdateSearchProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2057,11 +2067,11 @@ func (x *UpdateSearchProfileRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateSearchProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Profile       *UserPreferenceProfile `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Profile       *UserPreferenceProfile  `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2117,7 +2127,7 @@ func (x *UpdateSearchProfileResponse) GetProfile() *UserPreferenceProfile {
 	return nil
 }
 
-func (x *UpdateSearchProfileResponse) GetResponse() *BaseResponse {
+func (x *UpdateSearchProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2128,7 +2138,7 @@ type DeleteSearchProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ProfileId     string                 `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2177,7 +2187,7 @@ func (x *DeleteSearchProfileRequest) GetProfileId() string {
 	return ""
 }
 
-func (x *DeleteSearchProfileRequest) GetRequest() *BaseRequest {
+func (x *DeleteSearchProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2185,10 +2195,10 @@ func (x *DeleteSearchProfileRequest) GetRequest() *BaseRequest {
 }
 
 type DeleteSearchProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2237,7 +2247,7 @@ func (x *DeleteSearchProfileResponse) GetMessage() string {
 	return ""
 }
 
-func (x *DeleteSearchProfileResponse) GetResponse() *BaseResponse {
+func (x *DeleteSearchProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2248,7 +2258,7 @@ type SetDefaultSearchProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ProfileId     string                 `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2297,7 +2307,7 @@ func (x *SetDefaultSearchProfileRequest) GetProfileId() string {
 	return ""
 }
 
-func (x *SetDefaultSearchProfileRequest) GetRequest() *BaseRequest {
+func (x *SetDefaultSearchProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2305,10 +2315,10 @@ func (x *SetDefaultSearchProfileRequest) GetRequest() *BaseRequest {
 }
 
 type SetDefaultSearchProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2357,13 +2367,17 @@ func (x *SetDefaultSearchProfileResponse) GetMessage() string {
 	return ""
 }
 
-func (x *SetDefaultSearchProfileResponse) GetResponse() *BaseResponse {
+func (x *SetDefaultSearchProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in profiles.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -2416,6 +2430,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in profiles.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -2480,10 +2498,12 @@ var File_profiles_proto protoreflect.FileDescriptor
 
 const file_profiles_proto_rawDesc = "" +
 	"\n" +
-	"\x0eprofiles.proto\x12\x12ai_poi.profiles.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"1\n" +
+	"\x0eprofiles.proto\x12\x12ai_poi.profiles.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"c\n" +
 	"\vRangeFilter\x12\x10\n" +
 	"\x03min\x18\x01 \x01(\x01R\x03min\x12\x10\n" +
-	"\x03max\x18\x02 \x01(\x01R\x03max\"\xea\x04\n" +
+	"\x03max\x18\x02 \x01(\x01R\x03max\x120\n" +
+	"\bcurrency\x18\x03 \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{3}$\xd0\x01\x01R\bcurrency\"\xea\x04\n" +
 	"\x18AccommodationPreferences\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
 	"\x1auser_preference_profile_id\x18\x02 \x01(\tR\x17userPreferenceProfileId\x12-\n" +
@@ -2634,78 +2654,78 @@ const file_profiles_proto_rawDesc = "" +
 	"\x19accommodation_preferences\x18\x0f \x01(\v2,.ai_poi.profiles.v1.AccommodationPreferencesR\x18accommodationPreferences\x12T\n" +
 	"\x12dining_preferences\x18\x10 \x01(\v2%.ai_poi.profiles.v1.DiningPreferencesR\x11diningPreferences\x12Z\n" +
 	"\x14activity_preferences\x18\x11 \x01(\v2'.ai_poi.profiles.v1.ActivityPreferencesR\x13activityPreferences\x12]\n" +
-	"\x15itinerary_preferences\x18\x12 \x01(\v2(.ai_poi.profiles.v1.ItineraryPreferencesR\x14itineraryPreferences\"n\n" +
+	"\x15itinerary_preferences\x18\x12 \x01(\v2(.ai_poi.profiles.v1.ItineraryPreferencesR\x14itineraryPreferences\"l\n" +
 	"\x18GetSearchProfilesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
-	"\arequest\x18d \x01(\v2\x1f.ai_poi.profiles.v1.BaseRequestR\arequest\"\xce\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xcc\x01\n" +
 	"\x19GetSearchProfilesResponse\x12E\n" +
 	"\bprofiles\x18\x01 \x03(\v2).ai_poi.profiles.v1.UserPreferenceProfileR\bprofiles\x12,\n" +
-	"\x12default_profile_id\x18\x02 \x01(\tR\x10defaultProfileId\x12<\n" +
-	"\bresponse\x18d \x01(\v2 .ai_poi.profiles.v1.BaseResponseR\bresponse\"\x8c\x01\n" +
+	"\x12default_profile_id\x18\x02 \x01(\tR\x10defaultProfileId\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8a\x01\n" +
 	"\x17GetSearchProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
-	"profile_id\x18\x02 \x01(\tR\tprofileId\x129\n" +
-	"\arequest\x18d \x01(\v2\x1f.ai_poi.profiles.v1.BaseRequestR\arequest\"\x9d\x01\n" +
+	"profile_id\x18\x02 \x01(\tR\tprofileId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x9b\x01\n" +
 	"\x18GetSearchProfileResponse\x12C\n" +
-	"\aprofile\x18\x01 \x01(\v2).ai_poi.profiles.v1.UserPreferenceProfileR\aprofile\x12<\n" +
-	"\bresponse\x18d \x01(\v2 .ai_poi.profiles.v1.BaseResponseR\bresponse\"t\n" +
+	"\aprofile\x18\x01 \x01(\v2).ai_poi.profiles.v1.UserPreferenceProfileR\aprofile\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"r\n" +
 	"\x1eGetDefaultSearchProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
-	"\arequest\x18d \x01(\v2\x1f.ai_poi.profiles.v1.BaseRequestR\arequest\"\xa4\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xa2\x01\n" +
 	"\x1fGetDefaultSearchProfileResponse\x12C\n" +
-	"\aprofile\x18\x01 \x01(\v2).ai_poi.profiles.v1.UserPreferenceProfileR\aprofile\x12<\n" +
-	"\bresponse\x18d \x01(\v2 .ai_poi.profiles.v1.BaseResponseR\bresponse\"\xc1\x01\n" +
+	"\aprofile\x18\x01 \x01(\v2).ai_poi.profiles.v1.UserPreferenceProfileR\aprofile\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xbf\x01\n" +
 	"\x1aCreateSearchProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12O\n" +
-	"\aprofile\x18\x02 \x01(\v25.ai_poi.profiles.v1.CreateUserPreferenceProfileParamsR\aprofile\x129\n" +
-	"\arequest\x18d \x01(\v2\x1f.ai_poi.profiles.v1.BaseRequestR\arequest\"\xd4\x01\n" +
+	"\aprofile\x18\x02 \x01(\v25.ai_poi.profiles.v1.CreateUserPreferenceProfileParamsR\aprofile\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xd2\x01\n" +
 	"\x1bCreateSearchProfileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12C\n" +
-	"\aprofile\x18\x03 \x01(\v2).ai_poi.profiles.v1.UserPreferenceProfileR\aprofile\x12<\n" +
-	"\bresponse\x18d \x01(\v2 .ai_poi.profiles.v1.BaseResponseR\bresponse\"\xfd\x01\n" +
+	"\aprofile\x18\x03 \x01(\v2).ai_poi.profiles.v1.UserPreferenceProfileR\aprofile\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xfb\x01\n" +
 	"\x1aUpdateSearchProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"profile_id\x18\x02 \x01(\tR\tprofileId\x12G\n" +
 	"\aprofile\x18\x03 \x01(\v2-.ai_poi.profiles.v1.UpdateSearchProfileParamsR\aprofile\x12#\n" +
-	"\rupdate_fields\x18\x04 \x03(\tR\fupdateFields\x129\n" +
-	"\arequest\x18d \x01(\v2\x1f.ai_poi.profiles.v1.BaseRequestR\arequest\"\xd4\x01\n" +
+	"\rupdate_fields\x18\x04 \x03(\tR\fupdateFields\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xd2\x01\n" +
 	"\x1bUpdateSearchProfileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12C\n" +
-	"\aprofile\x18\x03 \x01(\v2).ai_poi.profiles.v1.UserPreferenceProfileR\aprofile\x12<\n" +
-	"\bresponse\x18d \x01(\v2 .ai_poi.profiles.v1.BaseResponseR\bresponse\"\x8f\x01\n" +
+	"\aprofile\x18\x03 \x01(\v2).ai_poi.profiles.v1.UserPreferenceProfileR\aprofile\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8d\x01\n" +
 	"\x1aDeleteSearchProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
-	"profile_id\x18\x02 \x01(\tR\tprofileId\x129\n" +
-	"\arequest\x18d \x01(\v2\x1f.ai_poi.profiles.v1.BaseRequestR\arequest\"\x8f\x01\n" +
+	"profile_id\x18\x02 \x01(\tR\tprofileId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x8d\x01\n" +
 	"\x1bDeleteSearchProfileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
-	"\bresponse\x18d \x01(\v2 .ai_poi.profiles.v1.BaseResponseR\bresponse\"\x93\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x91\x01\n" +
 	"\x1eSetDefaultSearchProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
-	"profile_id\x18\x02 \x01(\tR\tprofileId\x129\n" +
-	"\arequest\x18d \x01(\v2\x1f.ai_poi.profiles.v1.BaseRequestR\arequest\"\x93\x01\n" +
+	"profile_id\x18\x02 \x01(\tR\tprofileId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x91\x01\n" +
 	"\x1fSetDefaultSearchProfileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
-	"\bresponse\x18d \x01(\v2 .ai_poi.profiles.v1.BaseResponseR\bresponse\"N\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status*y\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x01*y\n" +
 	"\rDayPreference\x12\x1e\n" +
 	"\x1aDAY_PREFERENCE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12DAY_PREFERENCE_ANY\x10\x01\x12\x16\n" +
@@ -2778,6 +2798,8 @@ var file_profiles_proto_goTypes = []any{
 	(*BaseRequest)(nil),                       // 27: ai_poi.profiles.v1.BaseRequest
 	(*BaseResponse)(nil),                      // 28: ai_poi.profiles.v1.BaseResponse
 	(*timestamppb.Timestamp)(nil),             // 29: google.protobuf.Timestamp
+	(*generated.BaseRequest)(nil),             // 30: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),            // 31: ai_poi.common.v1.BaseResponse
 }
 var file_profiles_proto_depIdxs = []int32{
 	3,  // 0: ai_poi.profiles.v1.AccommodationPreferences.star_rating:type_name -> ai_poi.profiles.v1.RangeFilter
@@ -2816,27 +2838,27 @@ var file_profiles_proto_depIdxs = []int32{
 	5,  // 33: ai_poi.profiles.v1.UpdateSearchProfileParams.dining_preferences:type_name -> ai_poi.profiles.v1.DiningPreferences
 	6,  // 34: ai_poi.profiles.v1.UpdateSearchProfileParams.activity_preferences:type_name -> ai_poi.profiles.v1.ActivityPreferences
 	7,  // 35: ai_poi.profiles.v1.UpdateSearchProfileParams.itinerary_preferences:type_name -> ai_poi.profiles.v1.ItineraryPreferences
-	27, // 36: ai_poi.profiles.v1.GetSearchProfilesRequest.request:type_name -> ai_poi.profiles.v1.BaseRequest
+	30, // 36: ai_poi.profiles.v1.GetSearchProfilesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	10, // 37: ai_poi.profiles.v1.GetSearchProfilesResponse.profiles:type_name -> ai_poi.profiles.v1.UserPreferenceProfile
-	28, // 38: ai_poi.profiles.v1.GetSearchProfilesResponse.response:type_name -> ai_poi.profiles.v1.BaseResponse
-	27, // 39: ai_poi.profiles.v1.GetSearchProfileRequest.request:type_name -> ai_poi.profiles.v1.BaseRequest
+	31, // 38: ai_poi.profiles.v1.GetSearchProfilesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	30, // 39: ai_poi.profiles.v1.GetSearchProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	10, // 40: ai_poi.profiles.v1.GetSearchProfileResponse.profile:type_name -> ai_poi.profiles.v1.UserPreferenceProfile
-	28, // 41: ai_poi.profiles.v1.GetSearchProfileResponse.response:type_name -> ai_poi.profiles.v1.BaseResponse
-	27, // 42: ai_poi.profiles.v1.GetDefaultSearchProfileRequest.request:type_name -> ai_poi.profiles.v1.BaseRequest
+	31, // 41: ai_poi.profiles.v1.GetSearchProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	30, // 42: ai_poi.profiles.v1.GetDefaultSearchProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	10, // 43: ai_poi.profiles.v1.GetDefaultSearchProfileResponse.profile:type_name -> ai_poi.profiles.v1.UserPreferenceProfile
-	28, // 44: ai_poi.profiles.v1.GetDefaultSearchProfileResponse.response:type_name -> ai_poi.profiles.v1.BaseResponse
+	31, // 44: ai_poi.profiles.v1.GetDefaultSearchProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	11, // 45: ai_poi.profiles.v1.CreateSearchProfileRequest.profile:type_name -> ai_poi.profiles.v1.CreateUserPreferenceProfileParams
-	27, // 46: ai_poi.profiles.v1.CreateSearchProfileRequest.request:type_name -> ai_poi.profiles.v1.BaseRequest
+	30, // 46: ai_poi.profiles.v1.CreateSearchProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	10, // 47: ai_poi.profiles.v1.CreateSearchProfileResponse.profile:type_name -> ai_poi.profiles.v1.UserPreferenceProfile
-	28, // 48: ai_poi.profiles.v1.CreateSearchProfileResponse.response:type_name -> ai_poi.profiles.v1.BaseResponse
+	31, // 48: ai_poi.profiles.v1.CreateSearchProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	12, // 49: ai_poi.profiles.v1.UpdateSearchProfileRequest.profile:type_name -> ai_poi.profiles.v1.UpdateSearchProfileParams
-	27, // 50: ai_poi.profiles.v1.UpdateSearchProfileRequest.request:type_name -> ai_poi.profiles.v1.BaseRequest
+	30, // 50: ai_poi.profiles.v1.UpdateSearchProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	10, // 51: ai_poi.profiles.v1.UpdateSearchProfileResponse.profile:type_name -> ai_poi.profiles.v1.UserPreferenceProfile
-	28, // 52: ai_poi.profiles.v1.UpdateSearchProfileResponse.response:type_name -> ai_poi.profiles.v1.BaseResponse
-	27, // 53: ai_poi.profiles.v1.DeleteSearchProfileRequest.request:type_name -> ai_poi.profiles.v1.BaseRequest
-	28, // 54: ai_poi.profiles.v1.DeleteSearchProfileResponse.response:type_name -> ai_poi.profiles.v1.BaseResponse
-	27, // 55: ai_poi.profiles.v1.SetDefaultSearchProfileRequest.request:type_name -> ai_poi.profiles.v1.BaseRequest
-	28, // 56: ai_poi.profiles.v1.SetDefaultSearchProfileResponse.response:type_name -> ai_poi.profiles.v1.BaseResponse
+	31, // 52: ai_poi.profiles.v1.UpdateSearchProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	30, // 53: ai_poi.profiles.v1.DeleteSearchProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	31, // 54: ai_poi.profiles.v1.DeleteSearchProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	30, // 55: ai_poi.profiles.v1.SetDefaultSearchProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	31, // 56: ai_poi.profiles.v1.SetDefaultSearchProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	13, // 57: ai_poi.profiles.v1.ProfilesService.GetSearchProfiles:input_type -> ai_poi.profiles.v1.GetSearchProfilesRequest
 	15, // 58: ai_poi.profiles.v1.ProfilesService.GetSearchProfile:input_type -> ai_poi.profiles.v1.GetSearchProfileRequest
 	17, // 59: ai_poi.profiles.v1.ProfilesService.GetDefaultSearchProfile:input_type -> ai_poi.profiles.v1.GetDefaultSearchProfileRequest
@@ -0,0 +1,4302 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: profiles.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RangeFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RangeFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RangeFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RangeFilterMultiError, or
+// nil if none found.
+func (m *RangeFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RangeFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if m.GetCurrency() != "" {
+
+		if !_RangeFilter_Currency_Pattern.MatchString(m.GetCurrency()) {
+			err := RangeFilterValidationError{
+				field:  "Currency",
+				reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RangeFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// RangeFilterMultiError is an error wrapping multiple validation errors
+// returned by RangeFilter.ValidateAll() if the designated constraints aren't met.
+type RangeFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RangeFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RangeFilterMultiError) AllErrors() []error { return m }
+
+// RangeFilterValidationError is the validation error returned by
+// RangeFilter.Validate if the designated constraints aren't met.
+type RangeFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RangeFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RangeFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RangeFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RangeFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RangeFilterValidationError) ErrorName() string { return "RangeFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RangeFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRangeFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RangeFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RangeFilterValidationError{}
+
+var _RangeFilter_Currency_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
+// Validate checks the field values on AccommodationPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccommodationPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccommodationPreferences with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccommodationPreferencesMultiError, or nil if none found.
+func (m *AccommodationPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccommodationPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserPreferenceProfileId
+
+	if all {
+		switch v := interface{}(m.GetStarRating()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccommodationPreferencesValidationError{
+					field:  "StarRating",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccommodationPreferencesValidationError{
+					field:  "StarRating",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStarRating()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccommodationPreferencesValidationError{
+				field:  "StarRating",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriceRangePerNight()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccommodationPreferencesValidationError{
+					field:  "PriceRangePerNight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccommodationPreferencesValidationError{
+					field:  "PriceRangePerNight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceRangePerNight()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccommodationPreferencesValidationError{
+				field:  "PriceRangePerNight",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ChainPreference
+
+	// no validation rules for BookingFlexibility
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccommodationPreferencesValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccommodationPreferencesValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccommodationPreferencesValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccommodationPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccommodationPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccommodationPreferencesValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AccommodationPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccommodationPreferencesMultiError is an error wrapping multiple validation
+// errors returned by AccommodationPreferences.ValidateAll() if the designated
+// constraints aren't met.
+type AccommodationPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccommodationPreferencesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccommodationPreferencesMultiError) AllErrors() []error { return m }
+
+// AccommodationPreferencesValidationError is the validation error returned by
+// AccommodationPreferences.Validate if the designated constraints aren't met.
+type AccommodationPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccommodationPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccommodationPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccommodationPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccommodationPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccommodationPreferencesValidationError) ErrorName() string {
+	return "AccommodationPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccommodationPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccommodationPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccommodationPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccommodationPreferencesValidationError{}
+
+// Validate checks the field values on DiningPreferences with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DiningPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiningPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiningPreferencesMultiError, or nil if none found.
+func (m *DiningPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiningPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserPreferenceProfileId
+
+	if all {
+		switch v := interface{}(m.GetPriceRangePerPerson()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiningPreferencesValidationError{
+					field:  "PriceRangePerPerson",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiningPreferencesValidationError{
+					field:  "PriceRangePerPerson",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceRangePerPerson()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiningPreferencesValidationError{
+				field:  "PriceRangePerPerson",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MichelinRated
+
+	// no validation rules for LocalRecommendations
+
+	// no validation rules for ChainVsLocal
+
+	// no validation rules for OrganicPreference
+
+	// no validation rules for OutdoorSeatingPreferred
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiningPreferencesValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiningPreferencesValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiningPreferencesValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiningPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiningPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiningPreferencesValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiningPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiningPreferencesMultiError is an error wrapping multiple validation errors
+// returned by DiningPreferences.ValidateAll() if the designated constraints
+// aren't met.
+type DiningPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiningPreferencesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiningPreferencesMultiError) AllErrors() []error { return m }
+
+// DiningPreferencesValidationError is the validation error returned by
+// DiningPreferences.Validate if the designated constraints aren't met.
+type DiningPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiningPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiningPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiningPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiningPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiningPreferencesValidationError) ErrorName() string {
+	return "DiningPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiningPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiningPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiningPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiningPreferencesValidationError{}
+
+// Validate checks the field values on ActivityPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ActivityPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivityPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActivityPreferencesMultiError, or nil if none found.
+func (m *ActivityPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivityPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserPreferenceProfileId
+
+	// no validation rules for PhysicalActivityLevel
+
+	// no validation rules for IndoorOutdoorPreference
+
+	// no validation rules for CulturalImmersionLevel
+
+	// no validation rules for MustSeeVsHiddenGems
+
+	// no validation rules for EducationalPreference
+
+	// no validation rules for PhotographyOpportunities
+
+	// no validation rules for AvoidCrowds
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActivityPreferencesValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActivityPreferencesValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActivityPreferencesValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActivityPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActivityPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActivityPreferencesValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ActivityPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivityPreferencesMultiError is an error wrapping multiple validation
+// errors returned by ActivityPreferences.ValidateAll() if the designated
+// constraints aren't met.
+type ActivityPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivityPreferencesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivityPreferencesMultiError) AllErrors() []error { return m }
+
+// ActivityPreferencesValidationError is the validation error returned by
+// ActivityPreferences.Validate if the designated constraints aren't met.
+type ActivityPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivityPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivityPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivityPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivityPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivityPreferencesValidationError) ErrorName() string {
+	return "ActivityPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActivityPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivityPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivityPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivityPreferencesValidationError{}
+
+// Validate checks the field values on ItineraryPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ItineraryPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ItineraryPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ItineraryPreferencesMultiError, or nil if none found.
+func (m *ItineraryPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ItineraryPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserPreferenceProfileId
+
+	// no validation rules for PlanningStyle
+
+	// no validation rules for PreferredPace
+
+	// no validation rules for TimeFlexibility
+
+	// no validation rules for MorningVsEvening
+
+	// no validation rules for WeekendVsWeekday
+
+	// no validation rules for AvoidPeakSeason
+
+	// no validation rules for AdventureVsRelaxation
+
+	// no validation rules for SpontaneousVsPlanned
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ItineraryPreferencesValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ItineraryPreferencesValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ItineraryPreferencesValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ItineraryPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ItineraryPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ItineraryPreferencesValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ItineraryPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// ItineraryPreferencesMultiError is an error wrapping multiple validation
+// errors returned by ItineraryPreferences.ValidateAll() if the designated
+// constraints aren't met.
+type ItineraryPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ItineraryPreferencesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ItineraryPreferencesMultiError) AllErrors() []error { return m }
+
+// ItineraryPreferencesValidationError is the validation error returned by
+// ItineraryPreferences.Validate if the designated constraints aren't met.
+type ItineraryPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItineraryPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItineraryPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItineraryPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItineraryPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItineraryPreferencesValidationError) ErrorName() string {
+	return "ItineraryPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ItineraryPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItineraryPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItineraryPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItineraryPreferencesValidationError{}
+
+// Validate checks the field values on InterestReference with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InterestReference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InterestReference with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InterestReferenceMultiError, or nil if none found.
+func (m *InterestReference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InterestReference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return InterestReferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// InterestReferenceMultiError is an error wrapping multiple validation errors
+// returned by InterestReference.ValidateAll() if the designated constraints
+// aren't met.
+type InterestReferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InterestReferenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InterestReferenceMultiError) AllErrors() []error { return m }
+
+// InterestReferenceValidationError is the validation error returned by
+// InterestReference.Validate if the designated constraints aren't met.
+type InterestReferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InterestReferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InterestReferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InterestReferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InterestReferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InterestReferenceValidationError) ErrorName() string {
+	return "InterestReferenceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InterestReferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInterestReference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InterestReferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InterestReferenceValidationError{}
+
+// Validate checks the field values on TagReference with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TagReference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TagReference with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagReferenceMultiError, or
+// nil if none found.
+func (m *TagReference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TagReference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for TagType
+
+	if len(errors) > 0 {
+		return TagReferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagReferenceMultiError is an error wrapping multiple validation errors
+// returned by TagReference.ValidateAll() if the designated constraints aren't met.
+type TagReferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagReferenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagReferenceMultiError) AllErrors() []error { return m }
+
+// TagReferenceValidationError is the validation error returned by
+// TagReference.Validate if the designated constraints aren't met.
+type TagReferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagReferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagReferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagReferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagReferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagReferenceValidationError) ErrorName() string { return "TagReferenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagReferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTagReference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagReferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagReferenceValidationError{}
+
+// Validate checks the field values on UserPreferenceProfile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserPreferenceProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPreferenceProfile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPreferenceProfileMultiError, or nil if none found.
+func (m *UserPreferenceProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPreferenceProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for ProfileName
+
+	// no validation rules for IsDefault
+
+	// no validation rules for SearchRadiusKm
+
+	// no validation rules for PreferredTime
+
+	// no validation rules for BudgetLevel
+
+	// no validation rules for PreferredPace
+
+	// no validation rules for PreferAccessiblePois
+
+	// no validation rules for PreferOutdoorSeating
+
+	// no validation rules for PreferDogFriendly
+
+	// no validation rules for PreferredTransport
+
+	for idx, item := range m.GetInterests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserPreferenceProfileValidationError{
+						field:  fmt.Sprintf("Interests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserPreferenceProfileValidationError{
+						field:  fmt.Sprintf("Interests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserPreferenceProfileValidationError{
+					field:  fmt.Sprintf("Interests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserPreferenceProfileValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserPreferenceProfileValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserPreferenceProfileValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UserLatitude
+
+	// no validation rules for UserLongitude
+
+	if all {
+		switch v := interface{}(m.GetAccommodationPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "AccommodationPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "AccommodationPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccommodationPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPreferenceProfileValidationError{
+				field:  "AccommodationPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDiningPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "DiningPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "DiningPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiningPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPreferenceProfileValidationError{
+				field:  "DiningPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetActivityPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "ActivityPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "ActivityPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActivityPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPreferenceProfileValidationError{
+				field:  "ActivityPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetItineraryPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "ItineraryPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "ItineraryPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItineraryPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPreferenceProfileValidationError{
+				field:  "ItineraryPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPreferenceProfileValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPreferenceProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPreferenceProfileValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserPreferenceProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPreferenceProfileMultiError is an error wrapping multiple validation
+// errors returned by UserPreferenceProfile.ValidateAll() if the designated
+// constraints aren't met.
+type UserPreferenceProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPreferenceProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPreferenceProfileMultiError) AllErrors() []error { return m }
+
+// UserPreferenceProfileValidationError is the validation error returned by
+// UserPreferenceProfile.Validate if the designated constraints aren't met.
+type UserPreferenceProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPreferenceProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPreferenceProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPreferenceProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPreferenceProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPreferenceProfileValidationError) ErrorName() string {
+	return "UserPreferenceProfileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserPreferenceProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPreferenceProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPreferenceProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPreferenceProfileValidationError{}
+
+// Validate checks the field values on CreateUserPreferenceProfileParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateUserPreferenceProfileParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserPreferenceProfileParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateUserPreferenceProfileParamsMultiError, or nil if none found.
+func (m *CreateUserPreferenceProfileParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserPreferenceProfileParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProfileName
+
+	// no validation rules for IsDefault
+
+	// no validation rules for SearchRadiusKm
+
+	// no validation rules for PreferredTime
+
+	// no validation rules for BudgetLevel
+
+	// no validation rules for PreferredPace
+
+	// no validation rules for PreferAccessiblePois
+
+	// no validation rules for PreferOutdoorSeating
+
+	// no validation rules for PreferDogFriendly
+
+	// no validation rules for PreferredTransport
+
+	if all {
+		switch v := interface{}(m.GetAccommodationPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUserPreferenceProfileParamsValidationError{
+					field:  "AccommodationPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUserPreferenceProfileParamsValidationError{
+					field:  "AccommodationPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccommodationPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUserPreferenceProfileParamsValidationError{
+				field:  "AccommodationPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDiningPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUserPreferenceProfileParamsValidationError{
+					field:  "DiningPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUserPreferenceProfileParamsValidationError{
+					field:  "DiningPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiningPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUserPreferenceProfileParamsValidationError{
+				field:  "DiningPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetActivityPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUserPreferenceProfileParamsValidationError{
+					field:  "ActivityPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUserPreferenceProfileParamsValidationError{
+					field:  "ActivityPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActivityPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUserPreferenceProfileParamsValidationError{
+				field:  "ActivityPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetItineraryPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUserPreferenceProfileParamsValidationError{
+					field:  "ItineraryPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUserPreferenceProfileParamsValidationError{
+					field:  "ItineraryPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItineraryPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUserPreferenceProfileParamsValidationError{
+				field:  "ItineraryPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateUserPreferenceProfileParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUserPreferenceProfileParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateUserPreferenceProfileParams.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUserPreferenceProfileParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserPreferenceProfileParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserPreferenceProfileParamsMultiError) AllErrors() []error { return m }
+
+// CreateUserPreferenceProfileParamsValidationError is the validation error
+// returned by CreateUserPreferenceProfileParams.Validate if the designated
+// constraints aren't met.
+type CreateUserPreferenceProfileParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUserPreferenceProfileParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUserPreferenceProfileParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUserPreferenceProfileParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUserPreferenceProfileParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUserPreferenceProfileParamsValidationError) ErrorName() string {
+	return "CreateUserPreferenceProfileParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUserPreferenceProfileParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUserPreferenceProfileParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUserPreferenceProfileParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUserPreferenceProfileParamsValidationError{}
+
+// Validate checks the field values on UpdateSearchProfileParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSearchProfileParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSearchProfileParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSearchProfileParamsMultiError, or nil if none found.
+func (m *UpdateSearchProfileParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSearchProfileParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProfileName
+
+	// no validation rules for IsDefault
+
+	// no validation rules for SearchRadiusKm
+
+	// no validation rules for PreferredTime
+
+	// no validation rules for BudgetLevel
+
+	// no validation rules for PreferredPace
+
+	// no validation rules for PreferAccessiblePois
+
+	// no validation rules for PreferOutdoorSeating
+
+	// no validation rules for PreferDogFriendly
+
+	// no validation rules for PreferredTransport
+
+	if all {
+		switch v := interface{}(m.GetAccommodationPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSearchProfileParamsValidationError{
+					field:  "AccommodationPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSearchProfileParamsValidationError{
+					field:  "AccommodationPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccommodationPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSearchProfileParamsValidationError{
+				field:  "AccommodationPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDiningPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSearchProfileParamsValidationError{
+					field:  "DiningPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSearchProfileParamsValidationError{
+					field:  "DiningPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiningPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSearchProfileParamsValidationError{
+				field:  "DiningPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetActivityPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSearchProfileParamsValidationError{
+					field:  "ActivityPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSearchProfileParamsValidationError{
+					field:  "ActivityPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActivityPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSearchProfileParamsValidationError{
+				field:  "ActivityPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetItineraryPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSearchProfileParamsValidationError{
+					field:  "ItineraryPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSearchProfileParamsValidationError{
+					field:  "ItineraryPreferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItineraryPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSearchProfileParamsValidationError{
+				field:  "ItineraryPreferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSearchProfileParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSearchProfileParamsMultiError is an error wrapping multiple validation
+// errors returned by UpdateSearchProfileParams.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateSearchProfileParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSearchProfileParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSearchProfileParamsMultiError) AllErrors() []error { return m }
+
+// UpdateSearchProfileParamsValidationError is the validation error returned by
+// UpdateSearchProfileParams.Validate if the designated constraints aren't met.
+type UpdateSearchProfileParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSearchProfileParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSearchProfileParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSearchProfileParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSearchProfileParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSearchProfileParamsValidationError) ErrorName() string {
+	return "UpdateSearchProfileParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSearchProfileParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSearchProfileParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSearchProfileParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSearchProfileParamsValidationError{}
+
+// Validate checks the field values on GetSearchProfilesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSearchProfilesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSearchProfilesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSearchProfilesRequestMultiError, or nil if none found.
+func (m *GetSearchProfilesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSearchProfilesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSearchProfilesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSearchProfilesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSearchProfilesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSearchProfilesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSearchProfilesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSearchProfilesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSearchProfilesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSearchProfilesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSearchProfilesRequestMultiError) AllErrors() []error { return m }
+
+// GetSearchProfilesRequestValidationError is the validation error returned by
+// GetSearchProfilesRequest.Validate if the designated constraints aren't met.
+type GetSearchProfilesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSearchProfilesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSearchProfilesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSearchProfilesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSearchProfilesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSearchProfilesRequestValidationError) ErrorName() string {
+	return "GetSearchProfilesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSearchProfilesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSearchProfilesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSearchProfilesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSearchProfilesRequestValidationError{}
+
+// Validate checks the field values on GetSearchProfilesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSearchProfilesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSearchProfilesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSearchProfilesResponseMultiError, or nil if none found.
+func (m *GetSearchProfilesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSearchProfilesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProfiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSearchProfilesResponseValidationError{
+						field:  fmt.Sprintf("Profiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSearchProfilesResponseValidationError{
+						field:  fmt.Sprintf("Profiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSearchProfilesResponseValidationError{
+					field:  fmt.Sprintf("Profiles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DefaultProfileId
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSearchProfilesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSearchProfilesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSearchProfilesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSearchProfilesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSearchProfilesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSearchProfilesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetSearchProfilesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSearchProfilesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSearchProfilesResponseMultiError) AllErrors() []error { return m }
+
+// GetSearchProfilesResponseValidationError is the validation error returned by
+// GetSearchProfilesResponse.Validate if the designated constraints aren't met.
+type GetSearchProfilesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSearchProfilesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSearchProfilesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSearchProfilesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSearchProfilesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSearchProfilesResponseValidationError) ErrorName() string {
+	return "GetSearchProfilesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSearchProfilesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSearchProfilesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSearchProfilesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSearchProfilesResponseValidationError{}
+
+// Validate checks the field values on GetSearchProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSearchProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSearchProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSearchProfileRequestMultiError, or nil if none found.
+func (m *GetSearchProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSearchProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ProfileId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSearchProfileRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSearchProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSearchProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSearchProfileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSearchProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSearchProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSearchProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetSearchProfileRequestValidationError is the validation error returned by
+// GetSearchProfileRequest.Validate if the designated constraints aren't met.
+type GetSearchProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSearchProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSearchProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSearchProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSearchProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSearchProfileRequestValidationError) ErrorName() string {
+	return "GetSearchProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSearchProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSearchProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSearchProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSearchProfileRequestValidationError{}
+
+// Validate checks the field values on GetSearchProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSearchProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSearchProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSearchProfileResponseMultiError, or nil if none found.
+func (m *GetSearchProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSearchProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSearchProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSearchProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSearchProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSearchProfileResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSearchProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSearchProfileResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSearchProfileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSearchProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSearchProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSearchProfileResponseMultiError) AllErrors() []error { return m }
+
+// GetSearchProfileResponseValidationError is the validation error returned by
+// GetSearchProfileResponse.Validate if the designated constraints aren't met.
+type GetSearchProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSearchProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSearchProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSearchProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSearchProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSearchProfileResponseValidationError) ErrorName() string {
+	return "GetSearchProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSearchProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSearchProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSearchProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSearchProfileResponseValidationError{}
+
+// Validate checks the field values on GetDefaultSearchProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDefaultSearchProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDefaultSearchProfileRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDefaultSearchProfileRequestMultiError, or nil if none found.
+func (m *GetDefaultSearchProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDefaultSearchProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDefaultSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDefaultSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDefaultSearchProfileRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDefaultSearchProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDefaultSearchProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDefaultSearchProfileRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetDefaultSearchProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDefaultSearchProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDefaultSearchProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetDefaultSearchProfileRequestValidationError is the validation error
+// returned by GetDefaultSearchProfileRequest.Validate if the designated
+// constraints aren't met.
+type GetDefaultSearchProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDefaultSearchProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDefaultSearchProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDefaultSearchProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDefaultSearchProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDefaultSearchProfileRequestValidationError) ErrorName() string {
+	return "GetDefaultSearchProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDefaultSearchProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDefaultSearchProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDefaultSearchProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDefaultSearchProfileRequestValidationError{}
+
+// Validate checks the field values on GetDefaultSearchProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDefaultSearchProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDefaultSearchProfileResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDefaultSearchProfileResponseMultiError, or nil if none found.
+func (m *GetDefaultSearchProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDefaultSearchProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDefaultSearchProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDefaultSearchProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDefaultSearchProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDefaultSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDefaultSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDefaultSearchProfileResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDefaultSearchProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDefaultSearchProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDefaultSearchProfileResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetDefaultSearchProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDefaultSearchProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDefaultSearchProfileResponseMultiError) AllErrors() []error { return m }
+
+// GetDefaultSearchProfileResponseValidationError is the validation error
+// returned by GetDefaultSearchProfileResponse.Validate if the designated
+// constraints aren't met.
+type GetDefaultSearchProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDefaultSearchProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDefaultSearchProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDefaultSearchProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDefaultSearchProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDefaultSearchProfileResponseValidationError) ErrorName() string {
+	return "GetDefaultSearchProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDefaultSearchProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDefaultSearchProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDefaultSearchProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDefaultSearchProfileResponseValidationError{}
+
+// Validate checks the field values on CreateSearchProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSearchProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSearchProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSearchProfileRequestMultiError, or nil if none found.
+func (m *CreateSearchProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSearchProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSearchProfileRequestValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSearchProfileRequestValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSearchProfileRequestValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSearchProfileRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSearchProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSearchProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateSearchProfileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateSearchProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSearchProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSearchProfileRequestMultiError) AllErrors() []error { return m }
+
+// CreateSearchProfileRequestValidationError is the validation error returned
+// by CreateSearchProfileRequest.Validate if the designated constraints aren't met.
+type CreateSearchProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSearchProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSearchProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSearchProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSearchProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSearchProfileRequestValidationError) ErrorName() string {
+	return "CreateSearchProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSearchProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSearchProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSearchProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSearchProfileRequestValidationError{}
+
+// Validate checks the field values on CreateSearchProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSearchProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSearchProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSearchProfileResponseMultiError, or nil if none found.
+func (m *CreateSearchProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSearchProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSearchProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSearchProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSearchProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSearchProfileResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSearchProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSearchProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateSearchProfileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateSearchProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSearchProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSearchProfileResponseMultiError) AllErrors() []error { return m }
+
+// CreateSearchProfileResponseValidationError is the validation error returned
+// by CreateSearchProfileResponse.Validate if the designated constraints
+// aren't met.
+type CreateSearchProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSearchProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSearchProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSearchProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSearchProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSearchProfileResponseValidationError) ErrorName() string {
+	return "CreateSearchProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSearchProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSearchProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSearchProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSearchProfileResponseValidationError{}
+
+// Validate checks the field values on UpdateSearchProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSearchProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSearchProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSearchProfileRequestMultiError, or nil if none found.
+func (m *UpdateSearchProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSearchProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ProfileId
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSearchProfileRequestValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSearchProfileRequestValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSearchProfileRequestValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSearchProfileRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSearchProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSearchProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateSearchProfileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateSearchProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSearchProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSearchProfileRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSearchProfileRequestValidationError is the validation error returned
+// by UpdateSearchProfileRequest.Validate if the designated constraints aren't met.
+type UpdateSearchProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSearchProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSearchProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSearchProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSearchProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSearchProfileRequestValidationError) ErrorName() string {
+	return "UpdateSearchProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSearchProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSearchProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSearchProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSearchProfileRequestValidationError{}
+
+// Validate checks the field values on UpdateSearchProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSearchProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSearchProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSearchProfileResponseMultiError, or nil if none found.
+func (m *UpdateSearchProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSearchProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSearchProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSearchProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSearchProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSearchProfileResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSearchProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSearchProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateSearchProfileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateSearchProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSearchProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSearchProfileResponseMultiError) AllErrors() []error { return m }
+
+// UpdateSearchProfileResponseValidationError is the validation error returned
+// by UpdateSearchProfileResponse.Validate if the designated constraints
+// aren't met.
+type UpdateSearchProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSearchProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSearchProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSearchProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSearchProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSearchProfileResponseValidationError) ErrorName() string {
+	return "UpdateSearchProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSearchProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSearchProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSearchProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSearchProfileResponseValidationError{}
+
+// Validate checks the field values on DeleteSearchProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSearchProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSearchProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSearchProfileRequestMultiError, or nil if none found.
+func (m *DeleteSearchProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSearchProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ProfileId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteSearchProfileRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteSearchProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSearchProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteSearchProfileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteSearchProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSearchProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSearchProfileRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSearchProfileRequestValidationError is the validation error returned
+// by DeleteSearchProfileRequest.Validate if the designated constraints aren't met.
+type DeleteSearchProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSearchProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSearchProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSearchProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSearchProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSearchProfileRequestValidationError) ErrorName() string {
+	return "DeleteSearchProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSearchProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSearchProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSearchProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSearchProfileRequestValidationError{}
+
+// Validate checks the field values on DeleteSearchProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSearchProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSearchProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSearchProfileResponseMultiError, or nil if none found.
+func (m *DeleteSearchProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSearchProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteSearchProfileResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteSearchProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSearchProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteSearchProfileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteSearchProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSearchProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSearchProfileResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSearchProfileResponseValidationError is the validation error returned
+// by DeleteSearchProfileResponse.Validate if the designated constraints
+// aren't met.
+type DeleteSearchProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSearchProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSearchProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSearchProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSearchProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSearchProfileResponseValidationError) ErrorName() string {
+	return "DeleteSearchProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSearchProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSearchProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSearchProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSearchProfileResponseValidationError{}
+
+// Validate checks the field values on SetDefaultSearchProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetDefaultSearchProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetDefaultSearchProfileRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetDefaultSearchProfileRequestMultiError, or nil if none found.
+func (m *SetDefaultSearchProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetDefaultSearchProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ProfileId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetDefaultSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetDefaultSearchProfileRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetDefaultSearchProfileRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetDefaultSearchProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetDefaultSearchProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by SetDefaultSearchProfileRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetDefaultSearchProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetDefaultSearchProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetDefaultSearchProfileRequestMultiError) AllErrors() []error { return m }
+
+// SetDefaultSearchProfileRequestValidationError is the validation error
+// returned by SetDefaultSearchProfileRequest.Validate if the designated
+// constraints aren't met.
+type SetDefaultSearchProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetDefaultSearchProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetDefaultSearchProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetDefaultSearchProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetDefaultSearchProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetDefaultSearchProfileRequestValidationError) ErrorName() string {
+	return "SetDefaultSearchProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetDefaultSearchProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetDefaultSearchProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetDefaultSearchProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetDefaultSearchProfileRequestValidationError{}
+
+// Validate checks the field values on SetDefaultSearchProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetDefaultSearchProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetDefaultSearchProfileResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetDefaultSearchProfileResponseMultiError, or nil if none found.
+func (m *SetDefaultSearchProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetDefaultSearchProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetDefaultSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetDefaultSearchProfileResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetDefaultSearchProfileResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetDefaultSearchProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetDefaultSearchProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by SetDefaultSearchProfileResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetDefaultSearchProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetDefaultSearchProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetDefaultSearchProfileResponseMultiError) AllErrors() []error { return m }
+
+// SetDefaultSearchProfileResponseValidationError is the validation error
+// returned by SetDefaultSearchProfileResponse.Validate if the designated
+// constraints aren't met.
+type SetDefaultSearchProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetDefaultSearchProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetDefaultSearchProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetDefaultSearchProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetDefaultSearchProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetDefaultSearchProfileResponseValidationError) ErrorName() string {
+	return "SetDefaultSearchProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetDefaultSearchProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetDefaultSearchProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetDefaultSearchProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetDefaultSearchProfileResponseValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
@@ -12,9 +12,9 @@ import (
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.ProfilesServiceClient
 }
 
 var (
@@ -22,7 +22,7 @@ var (
 	_ core.Broker             = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -30,49 +30,89 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewProfilesServiceClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.ProfilesServiceClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewProfilesServiceClient(conn), nil
+}
+
 func (b *Broker) GetSearchProfiles(ctx context.Context, in *c.GetSearchProfilesRequest, opts ...grpc.CallOption) (*c.GetSearchProfilesResponse, error) {
-	return b.client.GetSearchProfiles(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetSearchProfiles(ctx, in, opts...)
 }
 
 func (b *Broker) GetSearchProfile(ctx context.Context, in *c.GetSearchProfileRequest, opts ...grpc.CallOption) (*c.GetSearchProfileResponse, error) {
-	return b.client.GetSearchProfile(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetSearchProfile(ctx, in, opts...)
 }
 
 func (b *Broker) GetDefaultSearchProfile(ctx context.Context, in *c.GetDefaultSearchProfileRequest, opts ...grpc.CallOption) (*c.GetDefaultSearchProfileResponse, error) {
-	return b.client.GetDefaultSearchProfile(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetDefaultSearchProfile(ctx, in, opts...)
 }
 
 func (b *Broker) CreateSearchProfile(ctx context.Context, in *c.CreateSearchProfileRequest, opts ...grpc.CallOption) (*c.CreateSearchProfileResponse, error) {
-	return b.client.CreateSearchProfile(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CreateSearchProfile(ctx, in, opts...)
 }
 
 func (b *Broker) UpdateSearchProfile(ctx context.Context, in *c.UpdateSearchProfileRequest, opts ...grpc.CallOption) (*c.UpdateSearchProfileResponse, error) {
-	return b.client.UpdateSearchProfile(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.UpdateSearchProfile(ctx, in, opts...)
 }
 
 func (b *Broker) DeleteSearchProfile(ctx context.Context, in *c.DeleteSearchProfileRequest, opts ...grpc.CallOption) (*c.DeleteSearchProfileResponse, error) {
-	return b.client.DeleteSearchProfile(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DeleteSearchProfile(ctx, in, opts...)
 }
 
 func (b *Broker) SetDefaultSearchProfile(ctx context.Context, in *c.SetDefaultSearchProfileRequest, opts ...grpc.CallOption) (*c.SetDefaultSearchProfileResponse, error) {
-	return b.client.SetDefaultSearchProfile(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SetDefaultSearchProfile(ctx, in, opts...)
 }
@@ -7,6 +7,8 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -867,7 +869,7 @@ type GetRecentInteractionsRequest struct {
 	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	Filter        *InteractionFilter     `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 	GroupByCity   bool                   `protobuf:"varint,5,opt,name=group_by_city,json=groupByCity,proto3" json:"group_by_city,omitempty"` // Group results by city
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -937,7 +939,7 @@ func (x *GetRecentInteractionsRequest) GetGroupByCity() bool {
 	return false
 }
 
-func (x *GetRecentInteractionsRequest) GetRequest() *BaseRequest {
+func (x *GetRecentInteractionsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -950,7 +952,7 @@ type GetRecentInteractionsResponse struct {
 	TotalCount    int32                     `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	CitySummaries []*CityInteractionSummary `protobuf:"bytes,3,rep,name=city_summaries,json=citySummaries,proto3" json:"city_summaries,omitempty"` // When group_by_city is true
 	Analytics     *InteractionAnalytics     `protobuf:"bytes,4,opt,name=analytics,proto3" json:"analytics,omitempty"`
-	Response      *BaseResponse             `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Response      *generated.BaseResponse   `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1013,7 +1015,7 @@ func (x *GetRecentInteractionsResponse) GetAnalytics() *InteractionAnalytics {
 	return nil
 }
 
-func (x *GetRecentInteractionsResponse) GetResponse() *BaseResponse {
+func (x *GetRecentInteractionsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1197,7 +1199,7 @@ type GetCityInteractionsRequest struct {
 	IncludeDetails bool                   `protobuf:"varint,3,opt,name=include_details,json=includeDetails,proto3" json:"include_details,omitempty"`
 	StartDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Request        *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request        *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1267,7 +1269,7 @@ func (x *GetCityInteractionsRequest) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *GetCityInteractionsRequest) GetRequest() *BaseRequest {
+func (x *GetCityInteractionsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1275,11 +1277,11 @@ func (x *GetCityInteractionsRequest) GetRequest() *BaseRequest {
 }
 
 type GetCityInteractionsResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	CityInteractions     *CityInteractions      `protobuf:"bytes,1,opt,name=city_interactions,json=cityInteractions,proto3" json:"city_interactions,omitempty"`
-	DetailedInteractions []*RecentInteraction   `protobuf:"bytes,2,rep,name=detailed_interactions,json=detailedInteractions,proto3" json:"detailed_interactions,omitempty"` // When include_details is true
-	Insights             *CityInsights          `protobuf:"bytes,3,opt,name=insights,proto3" json:"insights,omitempty"`
-	Response             *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state                protoimpl.MessageState  `protogen:"open.v1"`
+	CityInteractions     *CityInteractions       `protobuf:"bytes,1,opt,name=city_interactions,json=cityInteractions,proto3" json:"city_interactions,omitempty"`
+	DetailedInteractions []*RecentInteraction    `protobuf:"bytes,2,rep,name=detailed_interactions,json=detailedInteractions,proto3" json:"detailed_interactions,omitempty"` // When include_details is true
+	Insights             *CityInsights           `protobuf:"bytes,3,opt,name=insights,proto3" json:"insights,omitempty"`
+	Response             *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -1335,7 +1337,7 @@ func (x *GetCityInteractionsResponse) GetInsights() *CityInsights {
 	return nil
 }
 
-func (x *GetCityInteractionsResponse) GetResponse() *BaseResponse {
+func (x *GetCityInteractionsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1429,7 +1431,7 @@ type RecordInteractionRequest struct {
 	CityId          string                 `protobuf:"bytes,6,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
 	Context         *InteractionContext    `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
 	Metadata        map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Request         *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request         *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1520,7 +1522,7 @@ func (x *RecordInteractionRequest) GetMetadata() map[string]string {
 	return nil
 }
 
-func (x *RecordInteractionRequest) GetRequest() *BaseRequest {
+func (x *RecordInteractionRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1528,11 +1530,11 @@ func (x *RecordInteractionRequest) GetRequest() *BaseRequest {
 }
 
 type RecordInteractionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	InteractionId string                 `protobuf:"bytes,2,opt,name=interaction_id,json=interactionId,proto3" json:"interaction_id,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	InteractionId string                  `protobuf:"bytes,2,opt,name=interaction_id,json=interactionId,proto3" json:"interaction_id,omitempty"`
+	Message       string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1588,7 +1590,7 @@ func (x *RecordInteractionResponse) GetMessage() string {
 	return ""
 }
 
-func (x *RecordInteractionResponse) GetResponse() *BaseResponse {
+func (x *RecordInteractionResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1604,7 +1606,7 @@ type GetInteractionHistoryRequest struct {
 	SortBy           string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          // "date", "frequency", "relevance"
 	SortOrder        string                 `protobuf:"bytes,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // "asc", "desc"
 	IncludeAnalytics bool                   `protobuf:"varint,7,opt,name=include_analytics,json=includeAnalytics,proto3" json:"include_analytics,omitempty"`
-	Request          *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request          *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1688,7 +1690,7 @@ func (x *GetInteractionHistoryRequest) GetIncludeAnalytics() bool {
 	return false
 }
 
-func (x *GetInteractionHistoryRequest) GetRequest() *BaseRequest {
+func (x *GetInteractionHistoryRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1696,12 +1698,12 @@ func (x *GetInteractionHistoryRequest) GetRequest() *BaseRequest {
 }
 
 type GetInteractionHistoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Interactions  []*RecentInteraction   `protobuf:"bytes,1,rep,name=interactions,proto3" json:"interactions,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Analytics     *InteractionAnalytics  `protobuf:"bytes,3,opt,name=analytics,proto3" json:"analytics,omitempty"`
-	Trends        []*TrendData           `protobuf:"bytes,4,rep,name=trends,proto3" json:"trends,omitempty"` // Historical trends
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Interactions  []*RecentInteraction    `protobuf:"bytes,1,rep,name=interactions,proto3" json:"interactions,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Analytics     *InteractionAnalytics   `protobuf:"bytes,3,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	Trends        []*TrendData            `protobuf:"bytes,4,rep,name=trends,proto3" json:"trends,omitempty"` // Historical trends
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1764,7 +1766,7 @@ func (x *GetInteractionHistoryResponse) GetTrends() []*TrendData {
 	return nil
 }
 
-func (x *GetInteractionHistoryResponse) GetResponse() *BaseResponse {
+func (x *GetInteractionHistoryResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1891,7 +1893,7 @@ type GetFrequentPlacesRequest struct {
 	MinFrequencyScore float64                `protobuf:"fixed64,3,opt,name=min_frequency_score,json=minFrequencyScore,proto3" json:"min_frequency_score,omitempty"` // Minimum frequency score to include
 	TimeRange         string                 `protobuf:"bytes,4,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`                             // "30d", "90d", "1y", "all"
 	PlaceTypes        []string               `protobuf:"bytes,5,rep,name=place_types,json=placeTypes,proto3" json:"place_types,omitempty"`                          // Filter by place types
-	Request           *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request           *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -1961,7 +1963,7 @@ func (x *GetFrequentPlacesRequest) GetPlaceTypes() []string {
 	return nil
 }
 
-func (x *GetFrequentPlacesRequest) GetRequest() *BaseRequest {
+func (x *GetFrequentPlacesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1969,10 +1971,10 @@ func (x *GetFrequentPlacesRequest) GetRequest() *BaseRequest {
 }
 
 type GetFrequentPlacesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Places        []*FrequentPlace       `protobuf:"bytes,1,rep,name=places,proto3" json:"places,omitempty"`
-	Insights      *FrequentPlaceInsights `protobuf:"bytes,2,opt,name=insights,proto3" json:"insights,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Places        []*FrequentPlace        `protobuf:"bytes,1,rep,name=places,proto3" json:"places,omitempty"`
+	Insights      *FrequentPlaceInsights  `protobuf:"bytes,2,opt,name=insights,proto3" json:"insights,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2021,13 +2023,17 @@ func (x *GetFrequentPlacesResponse) GetInsights() *FrequentPlaceInsights {
 	return nil
 }
 
-func (x *GetFrequentPlacesResponse) GetResponse() *BaseResponse {
+func (x *GetFrequentPlacesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in recents.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -2080,6 +2086,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in recents.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -2221,7 +2231,7 @@ var File_recents_proto protoreflect.FileDescriptor
 
 const file_recents_proto_rawDesc = "" +
 	"\n" +
-	"\rrecents.proto\x12\x11ai_poi.recents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe5\x04\n" +
+	"\rrecents.proto\x12\x11ai_poi.recents.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xe5\x04\n" +
 	"\x11RecentInteraction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12M\n" +
@@ -2263,12 +2273,13 @@ const file_recents_proto_rawDesc = "" +
 	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x12\n" +
 	"\x04city\x18\x03 \x01(\tR\x04city\x12\x18\n" +
 	"\acountry\x18\x04 \x01(\tR\acountry\x12\x1a\n" +
-	"\baccuracy\x18\x05 \x01(\x01R\baccuracy\"\xbd\x04\n" +
+	"\baccuracy\x18\x05 \x01(\x01R\baccuracy\"\xd3\x04\n" +
 	"\x10CityInteractions\x12\x17\n" +
 	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12\x1b\n" +
 	"\tcity_name\x18\x02 \x01(\tR\bcityName\x12\x18\n" +
-	"\acountry\x18\x03 \x01(\tR\acountry\x12!\n" +
-	"\fcountry_code\x18\x04 \x01(\tR\vcountryCode\x12-\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x127\n" +
+	"\fcountry_code\x18\x04 \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{2}$\xd0\x01\x01R\vcountryCode\x12-\n" +
 	"\x12total_interactions\x18\x05 \x01(\x05R\x11totalInteractions\x12\x1a\n" +
 	"\bsearches\x18\x06 \x01(\x05R\bsearches\x12\x1c\n" +
 	"\tfavorites\x18\a \x01(\x05R\tfavorites\x12/\n" +
@@ -2316,21 +2327,21 @@ const file_recents_proto_rawDesc = "" +
 	"\fsearch_query\x18\x06 \x01(\tR\vsearchQuery\x12\x1e\n" +
 	"\n" +
 	"categories\x18\a \x03(\tR\n" +
-	"categories\"\x81\x02\n" +
+	"categories\"\x8b\x02\n" +
 	"\x1cGetRecentInteractionsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12<\n" +
 	"\x06filter\x18\x04 \x01(\v2$.ai_poi.recents.v1.InteractionFilterR\x06filter\x12\"\n" +
-	"\rgroup_by_city\x18\x05 \x01(\bR\vgroupByCity\x128\n" +
-	"\arequest\x18d \x01(\v2\x1e.ai_poi.recents.v1.BaseRequestR\arequest\"\xe0\x02\n" +
+	"\rgroup_by_city\x18\x05 \x01(\bR\vgroupByCity\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xdf\x02\n" +
 	"\x1dGetRecentInteractionsResponse\x12H\n" +
 	"\finteractions\x18\x01 \x03(\v2$.ai_poi.recents.v1.RecentInteractionR\finteractions\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12P\n" +
 	"\x0ecity_summaries\x18\x03 \x03(\v2).ai_poi.recents.v1.CityInteractionSummaryR\rcitySummaries\x12E\n" +
-	"\tanalytics\x18\x04 \x01(\v2'.ai_poi.recents.v1.InteractionAnalyticsR\tanalytics\x12;\n" +
-	"\bresponse\x18d \x01(\v2\x1f.ai_poi.recents.v1.BaseResponseR\bresponse\"\xb7\x02\n" +
+	"\tanalytics\x18\x04 \x01(\v2'.ai_poi.recents.v1.InteractionAnalyticsR\tanalytics\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb7\x02\n" +
 	"\x16CityInteractionSummary\x12\x17\n" +
 	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12\x1b\n" +
 	"\tcity_name\x18\x02 \x01(\tR\bcityName\x12\x18\n" +
@@ -2344,26 +2355,26 @@ const file_recents_proto_rawDesc = "" +
 	"\x15unique_cities_visited\x18\x03 \x01(\x05R\x13uniqueCitiesVisited\x12%\n" +
 	"\x0etop_categories\x18\x04 \x03(\tR\rtopCategories\x124\n" +
 	"\x17most_active_time_of_day\x18\x05 \x01(\tR\x13mostActiveTimeOfDay\x12?\n" +
-	"\x1caverage_interactions_per_day\x18\x06 \x01(\x01R\x19averageInteractionsPerDay\"\xa7\x02\n" +
+	"\x1caverage_interactions_per_day\x18\x06 \x01(\x01R\x19averageInteractionsPerDay\"\xa6\x02\n" +
 	"\x1aGetCityInteractionsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tcity_name\x18\x02 \x01(\tR\bcityName\x12'\n" +
 	"\x0finclude_details\x18\x03 \x01(\bR\x0eincludeDetails\x129\n" +
 	"\n" +
 	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x128\n" +
-	"\arequest\x18d \x01(\v2\x1e.ai_poi.recents.v1.BaseRequestR\arequest\"\xc4\x02\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc3\x02\n" +
 	"\x1bGetCityInteractionsResponse\x12P\n" +
 	"\x11city_interactions\x18\x01 \x01(\v2#.ai_poi.recents.v1.CityInteractionsR\x10cityInteractions\x12Y\n" +
 	"\x15detailed_interactions\x18\x02 \x03(\v2$.ai_poi.recents.v1.RecentInteractionR\x14detailedInteractions\x12;\n" +
-	"\binsights\x18\x03 \x01(\v2\x1f.ai_poi.recents.v1.CityInsightsR\binsights\x12;\n" +
-	"\bresponse\x18d \x01(\v2\x1f.ai_poi.recents.v1.BaseResponseR\bresponse\"\xed\x01\n" +
+	"\binsights\x18\x03 \x01(\v2\x1f.ai_poi.recents.v1.CityInsightsR\binsights\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x86\x02\n" +
 	"\fCityInsights\x12+\n" +
 	"\x11discovery_pattern\x18\x01 \x01(\tR\x10discoveryPattern\x121\n" +
 	"\x14suggested_categories\x18\x02 \x03(\tR\x13suggestedCategories\x12)\n" +
-	"\x10unexplored_areas\x18\x03 \x03(\tR\x0funexploredAreas\x12)\n" +
-	"\x10engagement_score\x18\x04 \x01(\x01R\x0fengagementScore\x12'\n" +
-	"\x0fvisit_frequency\x18\x05 \x01(\tR\x0evisitFrequency\"\x89\x04\n" +
+	"\x10unexplored_areas\x18\x03 \x03(\tR\x0funexploredAreas\x12B\n" +
+	"\x10engagement_score\x18\x04 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\x0fengagementScore\x12'\n" +
+	"\x0fvisit_frequency\x18\x05 \x01(\tR\x0evisitFrequency\"\x88\x04\n" +
 	"\x18RecordInteractionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12M\n" +
 	"\x10interaction_type\x18\x02 \x01(\x0e2\".ai_poi.recents.v1.InteractionTypeR\x0finteractionType\x12\x1b\n" +
@@ -2374,16 +2385,16 @@ const file_recents_proto_rawDesc = "" +
 	"entityName\x12\x17\n" +
 	"\acity_id\x18\x06 \x01(\tR\x06cityId\x12?\n" +
 	"\acontext\x18\a \x01(\v2%.ai_poi.recents.v1.InteractionContextR\acontext\x12U\n" +
-	"\bmetadata\x18\b \x03(\v29.ai_poi.recents.v1.RecordInteractionRequest.MetadataEntryR\bmetadata\x128\n" +
-	"\arequest\x18d \x01(\v2\x1e.ai_poi.recents.v1.BaseRequestR\arequest\x1a;\n" +
+	"\bmetadata\x18\b \x03(\v29.ai_poi.recents.v1.RecordInteractionRequest.MetadataEntryR\bmetadata\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb3\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb2\x01\n" +
 	"\x19RecordInteractionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
 	"\x0einteraction_id\x18\x02 \x01(\tR\rinteractionId\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\x12;\n" +
-	"\bresponse\x18d \x01(\v2\x1f.ai_poi.recents.v1.BaseResponseR\bresponse\"\xc2\x02\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xc1\x02\n" +
 	"\x1cGetInteractionHistoryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12<\n" +
 	"\x06filter\x18\x02 \x01(\v2$.ai_poi.recents.v1.InteractionFilterR\x06filter\x12\x14\n" +
@@ -2392,22 +2403,22 @@ const file_recents_proto_rawDesc = "" +
 	"\asort_by\x18\x05 \x01(\tR\x06sortBy\x12\x1d\n" +
 	"\n" +
 	"sort_order\x18\x06 \x01(\tR\tsortOrder\x12+\n" +
-	"\x11include_analytics\x18\a \x01(\bR\x10includeAnalytics\x128\n" +
-	"\arequest\x18d \x01(\v2\x1e.ai_poi.recents.v1.BaseRequestR\arequest\"\xc4\x02\n" +
+	"\x11include_analytics\x18\a \x01(\bR\x10includeAnalytics\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc3\x02\n" +
 	"\x1dGetInteractionHistoryResponse\x12H\n" +
 	"\finteractions\x18\x01 \x03(\v2$.ai_poi.recents.v1.RecentInteractionR\finteractions\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12E\n" +
 	"\tanalytics\x18\x03 \x01(\v2'.ai_poi.recents.v1.InteractionAnalyticsR\tanalytics\x124\n" +
-	"\x06trends\x18\x04 \x03(\v2\x1c.ai_poi.recents.v1.TrendDataR\x06trends\x12;\n" +
-	"\bresponse\x18d \x01(\v2\x1f.ai_poi.recents.v1.BaseResponseR\bresponse\"\xb8\x01\n" +
+	"\x06trends\x18\x04 \x03(\v2\x1c.ai_poi.recents.v1.TrendDataR\x06trends\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb8\x01\n" +
 	"\tTrendData\x12.\n" +
 	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12+\n" +
 	"\x11interaction_count\x18\x02 \x01(\x05R\x10interactionCount\x12N\n" +
 	"\x0etype_breakdown\x18\x03 \x03(\v2'.ai_poi.recents.v1.InteractionTypeCountR\rtypeBreakdown\"d\n" +
 	"\x14InteractionTypeCount\x126\n" +
 	"\x04type\x18\x01 \x01(\x0e2\".ai_poi.recents.v1.InteractionTypeR\x04type\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\"\xf3\x01\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xf2\x01\n" +
 	"\x18GetFrequentPlacesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12.\n" +
@@ -2415,27 +2426,27 @@ const file_recents_proto_rawDesc = "" +
 	"\n" +
 	"time_range\x18\x04 \x01(\tR\ttimeRange\x12\x1f\n" +
 	"\vplace_types\x18\x05 \x03(\tR\n" +
-	"placeTypes\x128\n" +
-	"\arequest\x18d \x01(\v2\x1e.ai_poi.recents.v1.BaseRequestR\arequest\"\xd8\x01\n" +
+	"placeTypes\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xd7\x01\n" +
 	"\x19GetFrequentPlacesResponse\x128\n" +
 	"\x06places\x18\x01 \x03(\v2 .ai_poi.recents.v1.FrequentPlaceR\x06places\x12D\n" +
-	"\binsights\x18\x02 \x01(\v2(.ai_poi.recents.v1.FrequentPlaceInsightsR\binsights\x12;\n" +
-	"\bresponse\x18d \x01(\v2\x1f.ai_poi.recents.v1.BaseResponseR\bresponse\"N\n" +
+	"\binsights\x18\x02 \x01(\v2(.ai_poi.recents.v1.FrequentPlaceInsightsR\binsights\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status\"\x8a\x02\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x01\"\xa3\x02\n" +
 	"\x15FrequentPlaceInsights\x12%\n" +
 	"\x0etravel_pattern\x18\x01 \x01(\tR\rtravelPattern\x12/\n" +
-	"\x13favorite_categories\x18\x02 \x03(\tR\x12favoriteCategories\x12>\n" +
-	"\x1bexploration_diversity_score\x18\x03 \x01(\x01R\x19explorationDiversityScore\x12#\n" +
+	"\x13favorite_categories\x18\x02 \x03(\tR\x12favoriteCategories\x12W\n" +
+	"\x1bexploration_diversity_score\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\x19explorationDiversityScore\x12#\n" +
 	"\rloyalty_level\x18\x04 \x01(\tR\floyaltyLevel\x124\n" +
 	"\x16recommended_new_places\x18\x05 \x03(\tR\x14recommendedNewPlaces*\xfe\x02\n" +
 	"\x0fInteractionType\x12 \n" +
@@ -2503,6 +2514,8 @@ var file_recents_proto_goTypes = []any{
 	nil,                                   // 27: ai_poi.recents.v1.InteractionContext.CustomPropertiesEntry
 	nil,                                   // 28: ai_poi.recents.v1.RecordInteractionRequest.MetadataEntry
 	(*timestamppb.Timestamp)(nil),         // 29: google.protobuf.Timestamp
+	(*generated.BaseRequest)(nil),         // 30: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),        // 31: ai_poi.common.v1.BaseResponse
 }
 var file_recents_proto_depIdxs = []int32{
 	0,  // 0: ai_poi.recents.v1.RecentInteraction.interaction_type:type_name -> ai_poi.recents.v1.InteractionType
@@ -2521,38 +2534,38 @@ var file_recents_proto_depIdxs = []int32{
 	29, // 13: ai_poi.recents.v1.InteractionFilter.start_date:type_name -> google.protobuf.Timestamp
 	29, // 14: ai_poi.recents.v1.InteractionFilter.end_date:type_name -> google.protobuf.Timestamp
 	7,  // 15: ai_poi.recents.v1.GetRecentInteractionsRequest.filter:type_name -> ai_poi.recents.v1.InteractionFilter
-	23, // 16: ai_poi.recents.v1.GetRecentInteractionsRequest.request:type_name -> ai_poi.recents.v1.BaseRequest
+	30, // 16: ai_poi.recents.v1.GetRecentInteractionsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	1,  // 17: ai_poi.recents.v1.GetRecentInteractionsResponse.interactions:type_name -> ai_poi.recents.v1.RecentInteraction
 	10, // 18: ai_poi.recents.v1.GetRecentInteractionsResponse.city_summaries:type_name -> ai_poi.recents.v1.CityInteractionSummary
 	11, // 19: ai_poi.recents.v1.GetRecentInteractionsResponse.analytics:type_name -> ai_poi.recents.v1.InteractionAnalytics
-	24, // 20: ai_poi.recents.v1.GetRecentInteractionsResponse.response:type_name -> ai_poi.recents.v1.BaseResponse
+	31, // 20: ai_poi.recents.v1.GetRecentInteractionsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	29, // 21: ai_poi.recents.v1.CityInteractionSummary.latest_interaction:type_name -> google.protobuf.Timestamp
 	1,  // 22: ai_poi.recents.v1.CityInteractionSummary.recent_interactions:type_name -> ai_poi.recents.v1.RecentInteraction
 	29, // 23: ai_poi.recents.v1.GetCityInteractionsRequest.start_date:type_name -> google.protobuf.Timestamp
 	29, // 24: ai_poi.recents.v1.GetCityInteractionsRequest.end_date:type_name -> google.protobuf.Timestamp
-	23, // 25: ai_poi.recents.v1.GetCityInteractionsRequest.request:type_name -> ai_poi.recents.v1.BaseRequest
+	30, // 25: ai_poi.recents.v1.GetCityInteractionsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	4,  // 26: ai_poi.recents.v1.GetCityInteractionsResponse.city_interactions:type_name -> ai_poi.recents.v1.CityInteractions
 	1,  // 27: ai_poi.recents.v1.GetCityInteractionsResponse.detailed_interactions:type_name -> ai_poi.recents.v1.RecentInteraction
 	14, // 28: ai_poi.recents.v1.GetCityInteractionsResponse.insights:type_name -> ai_poi.recents.v1.CityInsights
-	24, // 29: ai_poi.recents.v1.GetCityInteractionsResponse.response:type_name -> ai_poi.recents.v1.BaseResponse
+	31, // 29: ai_poi.recents.v1.GetCityInteractionsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	0,  // 30: ai_poi.recents.v1.RecordInteractionRequest.interaction_type:type_name -> ai_poi.recents.v1.InteractionType
 	2,  // 31: ai_poi.recents.v1.RecordInteractionRequest.context:type_name -> ai_poi.recents.v1.InteractionContext
 	28, // 32: ai_poi.recents.v1.RecordInteractionRequest.metadata:type_name -> ai_poi.recents.v1.RecordInteractionRequest.MetadataEntry
-	23, // 33: ai_poi.recents.v1.RecordInteractionRequest.request:type_name -> ai_poi.recents.v1.BaseRequest
-	24, // 34: ai_poi.recents.v1.RecordInteractionResponse.response:type_name -> ai_poi.recents.v1.BaseResponse
+	30, // 33: ai_poi.recents.v1.RecordInteractionRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	31, // 34: ai_poi.recents.v1.RecordInteractionResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	7,  // 35: ai_poi.recents.v1.GetInteractionHistoryRequest.filter:type_name -> ai_poi.recents.v1.InteractionFilter
-	23, // 36: ai_poi.recents.v1.GetInteractionHistoryRequest.request:type_name -> ai_poi.recents.v1.BaseRequest
+	30, // 36: ai_poi.recents.v1.GetInteractionHistoryRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	1,  // 37: ai_poi.recents.v1.GetInteractionHistoryResponse.interactions:type_name -> ai_poi.recents.v1.RecentInteraction
 	11, // 38: ai_poi.recents.v1.GetInteractionHistoryResponse.analytics:type_name -> ai_poi.recents.v1.InteractionAnalytics
 	19, // 39: ai_poi.recents.v1.GetInteractionHistoryResponse.trends:type_name -> ai_poi.recents.v1.TrendData
-	24, // 40: ai_poi.recents.v1.GetInteractionHistoryResponse.response:type_name -> ai_poi.recents.v1.BaseResponse
+	31, // 40: ai_poi.recents.v1.GetInteractionHistoryResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	29, // 41: ai_poi.recents.v1.TrendData.date:type_name -> google.protobuf.Timestamp
 	20, // 42: ai_poi.recents.v1.TrendData.type_breakdown:type_name -> ai_poi.recents.v1.InteractionTypeCount
 	0,  // 43: ai_poi.recents.v1.InteractionTypeCount.type:type_name -> ai_poi.recents.v1.InteractionType
-	23, // 44: ai_poi.recents.v1.GetFrequentPlacesRequest.request:type_name -> ai_poi.recents.v1.BaseRequest
+	30, // 44: ai_poi.recents.v1.GetFrequentPlacesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	6,  // 45: ai_poi.recents.v1.GetFrequentPlacesResponse.places:type_name -> ai_poi.recents.v1.FrequentPlace
 	25, // 46: ai_poi.recents.v1.GetFrequentPlacesResponse.insights:type_name -> ai_poi.recents.v1.FrequentPlaceInsights
-	24, // 47: ai_poi.recents.v1.GetFrequentPlacesResponse.response:type_name -> ai_poi.recents.v1.BaseResponse
+	31, // 47: ai_poi.recents.v1.GetFrequentPlacesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	8,  // 48: ai_poi.recents.v1.RecentsService.GetRecentInteractions:input_type -> ai_poi.recents.v1.GetRecentInteractionsRequest
 	12, // 49: ai_poi.recents.v1.RecentsService.GetCityInteractions:input_type -> ai_poi.recents.v1.GetCityInteractionsRequest
 	15, // 50: ai_poi.recents.v1.RecentsService.RecordInteraction:input_type -> ai_poi.recents.v1.RecordInteractionRequest
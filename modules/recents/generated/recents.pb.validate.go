@@ -0,0 +1,4008 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: recents.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RecentInteraction with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RecentInteraction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecentInteraction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecentInteractionMultiError, or nil if none found.
+func (m *RecentInteraction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecentInteraction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for InteractionType
+
+	// no validation rules for EntityId
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityName
+
+	// no validation rules for Description
+
+	// no validation rules for CityId
+
+	// no validation rules for CityName
+
+	// no validation rules for Country
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecentInteractionValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecentInteractionValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecentInteractionValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecentInteractionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecentInteractionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecentInteractionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecentInteractionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecentInteractionMultiError is an error wrapping multiple validation errors
+// returned by RecentInteraction.ValidateAll() if the designated constraints
+// aren't met.
+type RecentInteractionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecentInteractionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecentInteractionMultiError) AllErrors() []error { return m }
+
+// RecentInteractionValidationError is the validation error returned by
+// RecentInteraction.Validate if the designated constraints aren't met.
+type RecentInteractionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecentInteractionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecentInteractionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecentInteractionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecentInteractionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecentInteractionValidationError) ErrorName() string {
+	return "RecentInteractionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecentInteractionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecentInteraction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecentInteractionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecentInteractionValidationError{}
+
+// Validate checks the field values on InteractionContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InteractionContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InteractionContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InteractionContextMultiError, or nil if none found.
+func (m *InteractionContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InteractionContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourcePage
+
+	// no validation rules for UserAgent
+
+	// no validation rules for DeviceType
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InteractionContextValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InteractionContextValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InteractionContextValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SessionId
+
+	// no validation rules for Referrer
+
+	// no validation rules for CustomProperties
+
+	if len(errors) > 0 {
+		return InteractionContextMultiError(errors)
+	}
+
+	return nil
+}
+
+// InteractionContextMultiError is an error wrapping multiple validation errors
+// returned by InteractionContext.ValidateAll() if the designated constraints
+// aren't met.
+type InteractionContextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InteractionContextMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InteractionContextMultiError) AllErrors() []error { return m }
+
+// InteractionContextValidationError is the validation error returned by
+// InteractionContext.Validate if the designated constraints aren't met.
+type InteractionContextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InteractionContextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InteractionContextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InteractionContextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InteractionContextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InteractionContextValidationError) ErrorName() string {
+	return "InteractionContextValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InteractionContextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInteractionContext.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InteractionContextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InteractionContextValidationError{}
+
+// Validate checks the field values on GeoLocation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GeoLocation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GeoLocation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GeoLocationMultiError, or
+// nil if none found.
+func (m *GeoLocation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GeoLocation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Latitude
+
+	// no validation rules for Longitude
+
+	// no validation rules for City
+
+	// no validation rules for Country
+
+	// no validation rules for Accuracy
+
+	if len(errors) > 0 {
+		return GeoLocationMultiError(errors)
+	}
+
+	return nil
+}
+
+// GeoLocationMultiError is an error wrapping multiple validation errors
+// returned by GeoLocation.ValidateAll() if the designated constraints aren't met.
+type GeoLocationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GeoLocationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GeoLocationMultiError) AllErrors() []error { return m }
+
+// GeoLocationValidationError is the validation error returned by
+// GeoLocation.Validate if the designated constraints aren't met.
+type GeoLocationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GeoLocationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GeoLocationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GeoLocationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GeoLocationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GeoLocationValidationError) ErrorName() string { return "GeoLocationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GeoLocationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGeoLocation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GeoLocationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GeoLocationValidationError{}
+
+// Validate checks the field values on CityInteractions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CityInteractions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityInteractions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CityInteractionsMultiError, or nil if none found.
+func (m *CityInteractions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityInteractions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	// no validation rules for CityName
+
+	// no validation rules for Country
+
+	if m.GetCountryCode() != "" {
+
+		if !_CityInteractions_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := CityInteractionsValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^[A-Z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for TotalInteractions
+
+	// no validation rules for Searches
+
+	// no validation rules for Favorites
+
+	// no validation rules for ItinerariesCreated
+
+	// no validation rules for PoisViewed
+
+	if all {
+		switch v := interface{}(m.GetFirstInteraction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityInteractionsValidationError{
+					field:  "FirstInteraction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityInteractionsValidationError{
+					field:  "FirstInteraction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirstInteraction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityInteractionsValidationError{
+				field:  "FirstInteraction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastInteraction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityInteractionsValidationError{
+					field:  "LastInteraction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityInteractionsValidationError{
+					field:  "LastInteraction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastInteraction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityInteractionsValidationError{
+				field:  "LastInteraction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityInteractionsValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityInteractionsValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityInteractionsValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CityInteractionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityInteractionsMultiError is an error wrapping multiple validation errors
+// returned by CityInteractions.ValidateAll() if the designated constraints
+// aren't met.
+type CityInteractionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityInteractionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityInteractionsMultiError) AllErrors() []error { return m }
+
+// CityInteractionsValidationError is the validation error returned by
+// CityInteractions.Validate if the designated constraints aren't met.
+type CityInteractionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityInteractionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityInteractionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityInteractionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityInteractionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityInteractionsValidationError) ErrorName() string { return "CityInteractionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CityInteractionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityInteractions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityInteractionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityInteractionsValidationError{}
+
+var _CityInteractions_CountryCode_Pattern = regexp.MustCompile("^[A-Z]{2}$")
+
+// Validate checks the field values on CityPreferences with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CityPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CityPreferencesMultiError, or nil if none found.
+func (m *CityPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PreferredPriceRange
+
+	// no validation rules for PreferredRadiusMeters
+
+	// no validation rules for PreferredTimeOfDay
+
+	if len(errors) > 0 {
+		return CityPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityPreferencesMultiError is an error wrapping multiple validation errors
+// returned by CityPreferences.ValidateAll() if the designated constraints
+// aren't met.
+type CityPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityPreferencesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityPreferencesMultiError) AllErrors() []error { return m }
+
+// CityPreferencesValidationError is the validation error returned by
+// CityPreferences.Validate if the designated constraints aren't met.
+type CityPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityPreferencesValidationError) ErrorName() string { return "CityPreferencesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CityPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityPreferencesValidationError{}
+
+// Validate checks the field values on FrequentPlace with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FrequentPlace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FrequentPlace with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FrequentPlaceMultiError, or
+// nil if none found.
+func (m *FrequentPlace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FrequentPlace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlaceId
+
+	// no validation rules for PlaceName
+
+	// no validation rules for PlaceType
+
+	// no validation rules for Category
+
+	// no validation rules for Latitude
+
+	// no validation rules for Longitude
+
+	// no validation rules for CityName
+
+	// no validation rules for VisitCount
+
+	// no validation rules for InteractionCount
+
+	if all {
+		switch v := interface{}(m.GetFirstVisit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FrequentPlaceValidationError{
+					field:  "FirstVisit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FrequentPlaceValidationError{
+					field:  "FirstVisit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirstVisit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FrequentPlaceValidationError{
+				field:  "FirstVisit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastVisit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FrequentPlaceValidationError{
+					field:  "LastVisit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FrequentPlaceValidationError{
+					field:  "LastVisit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastVisit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FrequentPlaceValidationError{
+				field:  "LastVisit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for VisitFrequencyScore
+
+	if len(errors) > 0 {
+		return FrequentPlaceMultiError(errors)
+	}
+
+	return nil
+}
+
+// FrequentPlaceMultiError is an error wrapping multiple validation errors
+// returned by FrequentPlace.ValidateAll() if the designated constraints
+// aren't met.
+type FrequentPlaceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FrequentPlaceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FrequentPlaceMultiError) AllErrors() []error { return m }
+
+// FrequentPlaceValidationError is the validation error returned by
+// FrequentPlace.Validate if the designated constraints aren't met.
+type FrequentPlaceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FrequentPlaceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FrequentPlaceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FrequentPlaceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FrequentPlaceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FrequentPlaceValidationError) ErrorName() string { return "FrequentPlaceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FrequentPlaceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFrequentPlace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FrequentPlaceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FrequentPlaceValidationError{}
+
+// Validate checks the field values on InteractionFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InteractionFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InteractionFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InteractionFilterMultiError, or nil if none found.
+func (m *InteractionFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InteractionFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InteractionFilterValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InteractionFilterValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InteractionFilterValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InteractionFilterValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InteractionFilterValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InteractionFilterValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SearchQuery
+
+	if len(errors) > 0 {
+		return InteractionFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// InteractionFilterMultiError is an error wrapping multiple validation errors
+// returned by InteractionFilter.ValidateAll() if the designated constraints
+// aren't met.
+type InteractionFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InteractionFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InteractionFilterMultiError) AllErrors() []error { return m }
+
+// InteractionFilterValidationError is the validation error returned by
+// InteractionFilter.Validate if the designated constraints aren't met.
+type InteractionFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InteractionFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InteractionFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InteractionFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InteractionFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InteractionFilterValidationError) ErrorName() string {
+	return "InteractionFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InteractionFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInteractionFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InteractionFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InteractionFilterValidationError{}
+
+// Validate checks the field values on GetRecentInteractionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRecentInteractionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRecentInteractionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRecentInteractionsRequestMultiError, or nil if none found.
+func (m *GetRecentInteractionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRecentInteractionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := GetRecentInteractionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Offset
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRecentInteractionsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRecentInteractionsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRecentInteractionsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GroupByCity
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRecentInteractionsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRecentInteractionsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRecentInteractionsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRecentInteractionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRecentInteractionsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetRecentInteractionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetRecentInteractionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRecentInteractionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRecentInteractionsRequestMultiError) AllErrors() []error { return m }
+
+// GetRecentInteractionsRequestValidationError is the validation error returned
+// by GetRecentInteractionsRequest.Validate if the designated constraints
+// aren't met.
+type GetRecentInteractionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRecentInteractionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRecentInteractionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRecentInteractionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRecentInteractionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRecentInteractionsRequestValidationError) ErrorName() string {
+	return "GetRecentInteractionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRecentInteractionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRecentInteractionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRecentInteractionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRecentInteractionsRequestValidationError{}
+
+// Validate checks the field values on GetRecentInteractionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRecentInteractionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRecentInteractionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetRecentInteractionsResponseMultiError, or nil if none found.
+func (m *GetRecentInteractionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRecentInteractionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInteractions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRecentInteractionsResponseValidationError{
+						field:  fmt.Sprintf("Interactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRecentInteractionsResponseValidationError{
+						field:  fmt.Sprintf("Interactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRecentInteractionsResponseValidationError{
+					field:  fmt.Sprintf("Interactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetCitySummaries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRecentInteractionsResponseValidationError{
+						field:  fmt.Sprintf("CitySummaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRecentInteractionsResponseValidationError{
+						field:  fmt.Sprintf("CitySummaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRecentInteractionsResponseValidationError{
+					field:  fmt.Sprintf("CitySummaries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnalytics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRecentInteractionsResponseValidationError{
+					field:  "Analytics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRecentInteractionsResponseValidationError{
+					field:  "Analytics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnalytics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRecentInteractionsResponseValidationError{
+				field:  "Analytics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRecentInteractionsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRecentInteractionsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRecentInteractionsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRecentInteractionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRecentInteractionsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetRecentInteractionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetRecentInteractionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRecentInteractionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRecentInteractionsResponseMultiError) AllErrors() []error { return m }
+
+// GetRecentInteractionsResponseValidationError is the validation error
+// returned by GetRecentInteractionsResponse.Validate if the designated
+// constraints aren't met.
+type GetRecentInteractionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRecentInteractionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRecentInteractionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRecentInteractionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRecentInteractionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRecentInteractionsResponseValidationError) ErrorName() string {
+	return "GetRecentInteractionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRecentInteractionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRecentInteractionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRecentInteractionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRecentInteractionsResponseValidationError{}
+
+// Validate checks the field values on CityInteractionSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CityInteractionSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityInteractionSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CityInteractionSummaryMultiError, or nil if none found.
+func (m *CityInteractionSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityInteractionSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	// no validation rules for CityName
+
+	// no validation rules for Country
+
+	// no validation rules for InteractionCount
+
+	if all {
+		switch v := interface{}(m.GetLatestInteraction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityInteractionSummaryValidationError{
+					field:  "LatestInteraction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityInteractionSummaryValidationError{
+					field:  "LatestInteraction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLatestInteraction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityInteractionSummaryValidationError{
+				field:  "LatestInteraction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRecentInteractions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CityInteractionSummaryValidationError{
+						field:  fmt.Sprintf("RecentInteractions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CityInteractionSummaryValidationError{
+						field:  fmt.Sprintf("RecentInteractions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CityInteractionSummaryValidationError{
+					field:  fmt.Sprintf("RecentInteractions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CityInteractionSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityInteractionSummaryMultiError is an error wrapping multiple validation
+// errors returned by CityInteractionSummary.ValidateAll() if the designated
+// constraints aren't met.
+type CityInteractionSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityInteractionSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityInteractionSummaryMultiError) AllErrors() []error { return m }
+
+// CityInteractionSummaryValidationError is the validation error returned by
+// CityInteractionSummary.Validate if the designated constraints aren't met.
+type CityInteractionSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityInteractionSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityInteractionSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityInteractionSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityInteractionSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityInteractionSummaryValidationError) ErrorName() string {
+	return "CityInteractionSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CityInteractionSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityInteractionSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityInteractionSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityInteractionSummaryValidationError{}
+
+// Validate checks the field values on InteractionAnalytics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InteractionAnalytics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InteractionAnalytics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InteractionAnalyticsMultiError, or nil if none found.
+func (m *InteractionAnalytics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InteractionAnalytics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalInteractionsToday
+
+	// no validation rules for TotalInteractionsThisWeek
+
+	// no validation rules for UniqueCitiesVisited
+
+	// no validation rules for MostActiveTimeOfDay
+
+	// no validation rules for AverageInteractionsPerDay
+
+	if len(errors) > 0 {
+		return InteractionAnalyticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// InteractionAnalyticsMultiError is an error wrapping multiple validation
+// errors returned by InteractionAnalytics.ValidateAll() if the designated
+// constraints aren't met.
+type InteractionAnalyticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InteractionAnalyticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InteractionAnalyticsMultiError) AllErrors() []error { return m }
+
+// InteractionAnalyticsValidationError is the validation error returned by
+// InteractionAnalytics.Validate if the designated constraints aren't met.
+type InteractionAnalyticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InteractionAnalyticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InteractionAnalyticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InteractionAnalyticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InteractionAnalyticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InteractionAnalyticsValidationError) ErrorName() string {
+	return "InteractionAnalyticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InteractionAnalyticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInteractionAnalytics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InteractionAnalyticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InteractionAnalyticsValidationError{}
+
+// Validate checks the field values on GetCityInteractionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCityInteractionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCityInteractionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCityInteractionsRequestMultiError, or nil if none found.
+func (m *GetCityInteractionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCityInteractionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for CityName
+
+	// no validation rules for IncludeDetails
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityInteractionsRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityInteractionsRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityInteractionsRequestValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityInteractionsRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityInteractionsRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityInteractionsRequestValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityInteractionsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityInteractionsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityInteractionsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCityInteractionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCityInteractionsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCityInteractionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetCityInteractionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCityInteractionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCityInteractionsRequestMultiError) AllErrors() []error { return m }
+
+// GetCityInteractionsRequestValidationError is the validation error returned
+// by GetCityInteractionsRequest.Validate if the designated constraints aren't met.
+type GetCityInteractionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCityInteractionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCityInteractionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCityInteractionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCityInteractionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCityInteractionsRequestValidationError) ErrorName() string {
+	return "GetCityInteractionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCityInteractionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCityInteractionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCityInteractionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCityInteractionsRequestValidationError{}
+
+// Validate checks the field values on GetCityInteractionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCityInteractionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCityInteractionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCityInteractionsResponseMultiError, or nil if none found.
+func (m *GetCityInteractionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCityInteractionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCityInteractions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityInteractionsResponseValidationError{
+					field:  "CityInteractions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityInteractionsResponseValidationError{
+					field:  "CityInteractions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCityInteractions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityInteractionsResponseValidationError{
+				field:  "CityInteractions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDetailedInteractions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCityInteractionsResponseValidationError{
+						field:  fmt.Sprintf("DetailedInteractions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCityInteractionsResponseValidationError{
+						field:  fmt.Sprintf("DetailedInteractions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCityInteractionsResponseValidationError{
+					field:  fmt.Sprintf("DetailedInteractions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetInsights()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityInteractionsResponseValidationError{
+					field:  "Insights",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityInteractionsResponseValidationError{
+					field:  "Insights",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInsights()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityInteractionsResponseValidationError{
+				field:  "Insights",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCityInteractionsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCityInteractionsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCityInteractionsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCityInteractionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCityInteractionsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCityInteractionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCityInteractionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCityInteractionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCityInteractionsResponseMultiError) AllErrors() []error { return m }
+
+// GetCityInteractionsResponseValidationError is the validation error returned
+// by GetCityInteractionsResponse.Validate if the designated constraints
+// aren't met.
+type GetCityInteractionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCityInteractionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCityInteractionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCityInteractionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCityInteractionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCityInteractionsResponseValidationError) ErrorName() string {
+	return "GetCityInteractionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCityInteractionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCityInteractionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCityInteractionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCityInteractionsResponseValidationError{}
+
+// Validate checks the field values on CityInsights with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CityInsights) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityInsights with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CityInsightsMultiError, or
+// nil if none found.
+func (m *CityInsights) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityInsights) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DiscoveryPattern
+
+	if val := m.GetEngagementScore(); val < 0 || val > 100 {
+		err := CityInsightsValidationError{
+			field:  "EngagementScore",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VisitFrequency
+
+	if len(errors) > 0 {
+		return CityInsightsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityInsightsMultiError is an error wrapping multiple validation errors
+// returned by CityInsights.ValidateAll() if the designated constraints aren't met.
+type CityInsightsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityInsightsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityInsightsMultiError) AllErrors() []error { return m }
+
+// CityInsightsValidationError is the validation error returned by
+// CityInsights.Validate if the designated constraints aren't met.
+type CityInsightsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityInsightsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityInsightsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityInsightsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityInsightsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityInsightsValidationError) ErrorName() string { return "CityInsightsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CityInsightsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityInsights.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityInsightsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityInsightsValidationError{}
+
+// Validate checks the field values on RecordInteractionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordInteractionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordInteractionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordInteractionRequestMultiError, or nil if none found.
+func (m *RecordInteractionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordInteractionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for InteractionType
+
+	// no validation rules for EntityId
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityName
+
+	// no validation rules for CityId
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecordInteractionRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecordInteractionRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordInteractionRequestValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecordInteractionRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecordInteractionRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordInteractionRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecordInteractionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordInteractionRequestMultiError is an error wrapping multiple validation
+// errors returned by RecordInteractionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecordInteractionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordInteractionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordInteractionRequestMultiError) AllErrors() []error { return m }
+
+// RecordInteractionRequestValidationError is the validation error returned by
+// RecordInteractionRequest.Validate if the designated constraints aren't met.
+type RecordInteractionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordInteractionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordInteractionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordInteractionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordInteractionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordInteractionRequestValidationError) ErrorName() string {
+	return "RecordInteractionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordInteractionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordInteractionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordInteractionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordInteractionRequestValidationError{}
+
+// Validate checks the field values on RecordInteractionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordInteractionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordInteractionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordInteractionResponseMultiError, or nil if none found.
+func (m *RecordInteractionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordInteractionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for InteractionId
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecordInteractionResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecordInteractionResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordInteractionResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecordInteractionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordInteractionResponseMultiError is an error wrapping multiple validation
+// errors returned by RecordInteractionResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RecordInteractionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordInteractionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordInteractionResponseMultiError) AllErrors() []error { return m }
+
+// RecordInteractionResponseValidationError is the validation error returned by
+// RecordInteractionResponse.Validate if the designated constraints aren't met.
+type RecordInteractionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordInteractionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordInteractionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordInteractionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordInteractionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordInteractionResponseValidationError) ErrorName() string {
+	return "RecordInteractionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordInteractionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordInteractionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordInteractionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordInteractionResponseValidationError{}
+
+// Validate checks the field values on GetInteractionHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInteractionHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInteractionHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInteractionHistoryRequestMultiError, or nil if none found.
+func (m *GetInteractionHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInteractionHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInteractionHistoryRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetInteractionHistoryRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInteractionHistoryRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortOrder
+
+	// no validation rules for IncludeAnalytics
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInteractionHistoryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetInteractionHistoryRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInteractionHistoryRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetInteractionHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInteractionHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetInteractionHistoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetInteractionHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInteractionHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInteractionHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetInteractionHistoryRequestValidationError is the validation error returned
+// by GetInteractionHistoryRequest.Validate if the designated constraints
+// aren't met.
+type GetInteractionHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInteractionHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInteractionHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInteractionHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInteractionHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInteractionHistoryRequestValidationError) ErrorName() string {
+	return "GetInteractionHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInteractionHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInteractionHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInteractionHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInteractionHistoryRequestValidationError{}
+
+// Validate checks the field values on GetInteractionHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInteractionHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInteractionHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetInteractionHistoryResponseMultiError, or nil if none found.
+func (m *GetInteractionHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInteractionHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInteractions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetInteractionHistoryResponseValidationError{
+						field:  fmt.Sprintf("Interactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetInteractionHistoryResponseValidationError{
+						field:  fmt.Sprintf("Interactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetInteractionHistoryResponseValidationError{
+					field:  fmt.Sprintf("Interactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if all {
+		switch v := interface{}(m.GetAnalytics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInteractionHistoryResponseValidationError{
+					field:  "Analytics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetInteractionHistoryResponseValidationError{
+					field:  "Analytics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnalytics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInteractionHistoryResponseValidationError{
+				field:  "Analytics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTrends() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetInteractionHistoryResponseValidationError{
+						field:  fmt.Sprintf("Trends[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetInteractionHistoryResponseValidationError{
+						field:  fmt.Sprintf("Trends[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetInteractionHistoryResponseValidationError{
+					field:  fmt.Sprintf("Trends[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInteractionHistoryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetInteractionHistoryResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInteractionHistoryResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetInteractionHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInteractionHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetInteractionHistoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetInteractionHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInteractionHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInteractionHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetInteractionHistoryResponseValidationError is the validation error
+// returned by GetInteractionHistoryResponse.Validate if the designated
+// constraints aren't met.
+type GetInteractionHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInteractionHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInteractionHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInteractionHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInteractionHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInteractionHistoryResponseValidationError) ErrorName() string {
+	return "GetInteractionHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInteractionHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInteractionHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInteractionHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInteractionHistoryResponseValidationError{}
+
+// Validate checks the field values on TrendData with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrendData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrendData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrendDataMultiError, or nil
+// if none found.
+func (m *TrendData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrendData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrendDataValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrendDataValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrendDataValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InteractionCount
+
+	for idx, item := range m.GetTypeBreakdown() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TrendDataValidationError{
+						field:  fmt.Sprintf("TypeBreakdown[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TrendDataValidationError{
+						field:  fmt.Sprintf("TypeBreakdown[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrendDataValidationError{
+					field:  fmt.Sprintf("TypeBreakdown[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TrendDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrendDataMultiError is an error wrapping multiple validation errors returned
+// by TrendData.ValidateAll() if the designated constraints aren't met.
+type TrendDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrendDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrendDataMultiError) AllErrors() []error { return m }
+
+// TrendDataValidationError is the validation error returned by
+// TrendData.Validate if the designated constraints aren't met.
+type TrendDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrendDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrendDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrendDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrendDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrendDataValidationError) ErrorName() string { return "TrendDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrendDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrendData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrendDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrendDataValidationError{}
+
+// Validate checks the field values on InteractionTypeCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InteractionTypeCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InteractionTypeCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InteractionTypeCountMultiError, or nil if none found.
+func (m *InteractionTypeCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InteractionTypeCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return InteractionTypeCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// InteractionTypeCountMultiError is an error wrapping multiple validation
+// errors returned by InteractionTypeCount.ValidateAll() if the designated
+// constraints aren't met.
+type InteractionTypeCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InteractionTypeCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InteractionTypeCountMultiError) AllErrors() []error { return m }
+
+// InteractionTypeCountValidationError is the validation error returned by
+// InteractionTypeCount.Validate if the designated constraints aren't met.
+type InteractionTypeCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InteractionTypeCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InteractionTypeCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InteractionTypeCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InteractionTypeCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InteractionTypeCountValidationError) ErrorName() string {
+	return "InteractionTypeCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InteractionTypeCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInteractionTypeCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InteractionTypeCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InteractionTypeCountValidationError{}
+
+// Validate checks the field values on GetFrequentPlacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFrequentPlacesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFrequentPlacesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFrequentPlacesRequestMultiError, or nil if none found.
+func (m *GetFrequentPlacesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFrequentPlacesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Limit
+
+	// no validation rules for MinFrequencyScore
+
+	// no validation rules for TimeRange
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFrequentPlacesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFrequentPlacesRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFrequentPlacesRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFrequentPlacesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFrequentPlacesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFrequentPlacesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFrequentPlacesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFrequentPlacesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFrequentPlacesRequestMultiError) AllErrors() []error { return m }
+
+// GetFrequentPlacesRequestValidationError is the validation error returned by
+// GetFrequentPlacesRequest.Validate if the designated constraints aren't met.
+type GetFrequentPlacesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFrequentPlacesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFrequentPlacesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFrequentPlacesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFrequentPlacesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFrequentPlacesRequestValidationError) ErrorName() string {
+	return "GetFrequentPlacesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFrequentPlacesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFrequentPlacesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFrequentPlacesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFrequentPlacesRequestValidationError{}
+
+// Validate checks the field values on GetFrequentPlacesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFrequentPlacesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFrequentPlacesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFrequentPlacesResponseMultiError, or nil if none found.
+func (m *GetFrequentPlacesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFrequentPlacesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPlaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFrequentPlacesResponseValidationError{
+						field:  fmt.Sprintf("Places[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFrequentPlacesResponseValidationError{
+						field:  fmt.Sprintf("Places[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFrequentPlacesResponseValidationError{
+					field:  fmt.Sprintf("Places[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetInsights()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFrequentPlacesResponseValidationError{
+					field:  "Insights",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFrequentPlacesResponseValidationError{
+					field:  "Insights",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInsights()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFrequentPlacesResponseValidationError{
+				field:  "Insights",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFrequentPlacesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFrequentPlacesResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFrequentPlacesResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFrequentPlacesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFrequentPlacesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFrequentPlacesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetFrequentPlacesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFrequentPlacesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFrequentPlacesResponseMultiError) AllErrors() []error { return m }
+
+// GetFrequentPlacesResponseValidationError is the validation error returned by
+// GetFrequentPlacesResponse.Validate if the designated constraints aren't met.
+type GetFrequentPlacesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFrequentPlacesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFrequentPlacesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFrequentPlacesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFrequentPlacesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFrequentPlacesResponseValidationError) ErrorName() string {
+	return "GetFrequentPlacesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFrequentPlacesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFrequentPlacesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFrequentPlacesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFrequentPlacesResponseValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
+
+// Validate checks the field values on FrequentPlaceInsights with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FrequentPlaceInsights) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FrequentPlaceInsights with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FrequentPlaceInsightsMultiError, or nil if none found.
+func (m *FrequentPlaceInsights) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FrequentPlaceInsights) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TravelPattern
+
+	if val := m.GetExplorationDiversityScore(); val < 0 || val > 100 {
+		err := FrequentPlaceInsightsValidationError{
+			field:  "ExplorationDiversityScore",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for LoyaltyLevel
+
+	if len(errors) > 0 {
+		return FrequentPlaceInsightsMultiError(errors)
+	}
+
+	return nil
+}
+
+// FrequentPlaceInsightsMultiError is an error wrapping multiple validation
+// errors returned by FrequentPlaceInsights.ValidateAll() if the designated
+// constraints aren't met.
+type FrequentPlaceInsightsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FrequentPlaceInsightsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FrequentPlaceInsightsMultiError) AllErrors() []error { return m }
+
+// FrequentPlaceInsightsValidationError is the validation error returned by
+// FrequentPlaceInsights.Validate if the designated constraints aren't met.
+type FrequentPlaceInsightsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FrequentPlaceInsightsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FrequentPlaceInsightsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FrequentPlaceInsightsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FrequentPlaceInsightsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FrequentPlaceInsightsValidationError) ErrorName() string {
+	return "FrequentPlaceInsightsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FrequentPlaceInsightsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFrequentPlaceInsights.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FrequentPlaceInsightsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FrequentPlaceInsightsValidationError{}
@@ -12,9 +12,9 @@ import (
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.RecentsServiceClient
 }
 
 var (
@@ -22,7 +22,7 @@ var (
 	_ core.Broker            = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -30,42 +30,72 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewRecentsServiceClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.RecentsServiceClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewRecentsServiceClient(conn), nil
+}
+
 // RecentsService method implementations
 func (b *Broker) GetRecentInteractions(ctx context.Context, in *c.GetRecentInteractionsRequest, opts ...grpc.CallOption) (*c.GetRecentInteractionsResponse, error) {
-	return b.client.GetRecentInteractions(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetRecentInteractions(ctx, in, opts...)
 }
 
 func (b *Broker) GetCityInteractions(ctx context.Context, in *c.GetCityInteractionsRequest, opts ...grpc.CallOption) (*c.GetCityInteractionsResponse, error) {
-	return b.client.GetCityInteractions(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetCityInteractions(ctx, in, opts...)
 }
 
 func (b *Broker) RecordInteraction(ctx context.Context, in *c.RecordInteractionRequest, opts ...grpc.CallOption) (*c.RecordInteractionResponse, error) {
-	return b.client.RecordInteraction(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.RecordInteraction(ctx, in, opts...)
 }
 
 func (b *Broker) GetInteractionHistory(ctx context.Context, in *c.GetInteractionHistoryRequest, opts ...grpc.CallOption) (*c.GetInteractionHistoryResponse, error) {
-	return b.client.GetInteractionHistory(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetInteractionHistory(ctx, in, opts...)
 }
 
 func (b *Broker) GetFrequentPlaces(ctx context.Context, in *c.GetFrequentPlacesRequest, opts ...grpc.CallOption) (*c.GetFrequentPlacesResponse, error) {
-	return b.client.GetFrequentPlaces(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetFrequentPlaces(ctx, in, opts...)
 }
\ No newline at end of file
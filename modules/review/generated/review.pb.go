@@ -8,6 +8,7 @@ package v1
 
 import (
 	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -1294,7 +1295,7 @@ type CreateReviewRequest struct {
 	VisitDate     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=visit_date,json=visitDate,proto3" json:"visit_date,omitempty"`
 	Aspects       *ReviewAspects         `protobuf:"bytes,8,opt,name=aspects,proto3" json:"aspects,omitempty"`
 	Language      string                 `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1392,7 +1393,7 @@ func (x *CreateReviewRequest) GetLanguage() string {
 	return ""
 }
 
-func (x *CreateReviewRequest) GetRequest() *BaseRequest {
+func (x *CreateReviewRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1400,10 +1401,10 @@ func (x *CreateReviewRequest) GetRequest() *BaseRequest {
 }
 
 type CreateReviewResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *generated.Response    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Review        *Review                `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
-	BaseResponse  *BaseResponse          `protobuf:"bytes,100,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Response      *generated.Response     `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Review        *Review                 `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+	BaseResponse  *generated.BaseResponse `protobuf:"bytes,100,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1452,7 +1453,7 @@ func (x *CreateReviewResponse) GetReview() *Review {
 	return nil
 }
 
-func (x *CreateReviewResponse) GetBaseResponse() *BaseResponse {
+func (x *CreateReviewResponse) GetBaseResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.BaseResponse
 	}
@@ -1464,7 +1465,7 @@ type GetPOIReviewsRequest struct {
 	PoiId         string                       `protobuf:"bytes,1,opt,name=poi_id,json=poiId,proto3" json:"poi_id,omitempty"`
 	Pagination    *generated.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	Filter        *ReviewFilter                `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	Request       *BaseRequest                 `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest       `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1520,7 +1521,7 @@ func (x *GetPOIReviewsRequest) GetFilter() *ReviewFilter {
 	return nil
 }
 
-func (x *GetPOIReviewsRequest) GetRequest() *BaseRequest {
+func (x *GetPOIReviewsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1532,7 +1533,7 @@ type GetPOIReviewsResponse struct {
 	Reviews       []*Review                     `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
 	Pagination    *generated.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	Statistics    *ReviewStatistics             `protobuf:"bytes,3,opt,name=statistics,proto3" json:"statistics,omitempty"`
-	Response      *BaseResponse                 `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Response      *generated.BaseResponse       `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1588,7 +1589,7 @@ func (x *GetPOIReviewsResponse) GetStatistics() *ReviewStatistics {
 	return nil
 }
 
-func (x *GetPOIReviewsResponse) GetResponse() *BaseResponse {
+func (x *GetPOIReviewsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1599,7 +1600,7 @@ type GetReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Optional, for permission checking
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1648,7 +1649,7 @@ func (x *GetReviewRequest) GetUserId() string {
 	return ""
 }
 
-func (x *GetReviewRequest) GetRequest() *BaseRequest {
+func (x *GetReviewRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1656,11 +1657,11 @@ func (x *GetReviewRequest) GetRequest() *BaseRequest {
 }
 
 type GetReviewResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
-	CanEdit       bool                   `protobuf:"varint,2,opt,name=can_edit,json=canEdit,proto3" json:"can_edit,omitempty"`
-	CanDelete     bool                   `protobuf:"varint,3,opt,name=can_delete,json=canDelete,proto3" json:"can_delete,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Review        *Review                 `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	CanEdit       bool                    `protobuf:"varint,2,opt,name=can_edit,json=canEdit,proto3" json:"can_edit,omitempty"`
+	CanDelete     bool                    `protobuf:"varint,3,opt,name=can_delete,json=canDelete,proto3" json:"can_delete,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1716,7 +1717,7 @@ func (x *GetReviewResponse) GetCanDelete() bool {
 	return false
 }
 
-func (x *GetReviewResponse) GetResponse() *BaseResponse {
+func (x *GetReviewResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1733,7 +1734,7 @@ type UpdateReviewRequest struct {
 	PhotoUrls     []string               `protobuf:"bytes,6,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
 	VisitDate     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=visit_date,json=visitDate,proto3" json:"visit_date,omitempty"`
 	Aspects       *ReviewAspects         `protobuf:"bytes,8,opt,name=aspects,proto3" json:"aspects,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1824,7 +1825,7 @@ func (x *UpdateReviewRequest) GetAspects() *ReviewAspects {
 	return nil
 }
 
-func (x *UpdateReviewRequest) GetRequest() *BaseRequest {
+func (x *UpdateReviewRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1832,10 +1833,10 @@ func (x *UpdateReviewRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateReviewResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *generated.Response    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Review        *Review                `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
-	BaseResponse  *BaseResponse          `protobuf:"bytes,100,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Response      *generated.Response     `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Review        *Review                 `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+	BaseResponse  *generated.BaseResponse `protobuf:"bytes,100,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1884,7 +1885,7 @@ func (x *UpdateReviewResponse) GetReview() *Review {
 	return nil
 }
 
-func (x *UpdateReviewResponse) GetBaseResponse() *BaseResponse {
+func (x *UpdateReviewResponse) GetBaseResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.BaseResponse
 	}
@@ -1895,7 +1896,7 @@ type DeleteReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ReviewId      string                 `protobuf:"bytes,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1944,7 +1945,7 @@ func (x *DeleteReviewRequest) GetReviewId() string {
 	return ""
 }
 
-func (x *DeleteReviewRequest) GetRequest() *BaseRequest {
+func (x *DeleteReviewRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1952,9 +1953,9 @@ func (x *DeleteReviewRequest) GetRequest() *BaseRequest {
 }
 
 type DeleteReviewResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *generated.Response    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	BaseResponse  *BaseResponse          `protobuf:"bytes,100,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Response      *generated.Response     `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	BaseResponse  *generated.BaseResponse `protobuf:"bytes,100,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1996,7 +1997,7 @@ func (x *DeleteReviewResponse) GetResponse() *generated.Response {
 	return nil
 }
 
-func (x *DeleteReviewResponse) GetBaseResponse() *BaseResponse {
+func (x *DeleteReviewResponse) GetBaseResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.BaseResponse
 	}
@@ -2008,7 +2009,7 @@ type GetUserReviewsRequest struct {
 	UserId        string                       `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Pagination    *generated.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	Filter        *ReviewFilter                `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	Request       *BaseRequest                 `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest       `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2064,7 +2065,7 @@ func (x *GetUserReviewsRequest) GetFilter() *ReviewFilter {
 	return nil
 }
 
-func (x *GetUserReviewsRequest) GetRequest() *BaseRequest {
+func (x *GetUserReviewsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2076,7 +2077,7 @@ type GetUserReviewsResponse struct {
 	Reviews       []*Review                     `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
 	Pagination    *generated.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	Statistics    *UserReviewStatistics         `protobuf:"bytes,3,opt,name=statistics,proto3" json:"statistics,omitempty"`
-	Response      *BaseResponse                 `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	Response      *generated.BaseResponse       `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2132,7 +2133,7 @@ func (x *GetUserReviewsResponse) GetStatistics() *UserReviewStatistics {
 	return nil
 }
 
-func (x *GetUserReviewsResponse) GetResponse() *BaseResponse {
+func (x *GetUserReviewsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2220,7 +2221,7 @@ type LikeReviewRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ReviewId      string                 `protobuf:"bytes,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
 	IsLike        bool                   `protobuf:"varint,3,opt,name=is_like,json=isLike,proto3" json:"is_like,omitempty"` // true for like, false for unlike
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2276,7 +2277,7 @@ func (x *LikeReviewRequest) GetIsLike() bool {
 	return false
 }
 
-func (x *LikeReviewRequest) GetRequest() *BaseRequest {
+func (x *LikeReviewRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2284,10 +2285,10 @@ func (x *LikeReviewRequest) GetRequest() *BaseRequest {
 }
 
 type LikeReviewResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Response        *generated.Response    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	NewHelpfulCount int32                  `protobuf:"varint,2,opt,name=new_helpful_count,json=newHelpfulCount,proto3" json:"new_helpful_count,omitempty"`
-	BaseResponse    *BaseResponse          `protobuf:"bytes,100,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Response        *generated.Response     `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	NewHelpfulCount int32                   `protobuf:"varint,2,opt,name=new_helpful_count,json=newHelpfulCount,proto3" json:"new_helpful_count,omitempty"`
+	BaseResponse    *generated.BaseResponse `protobuf:"bytes,100,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -2336,7 +2337,7 @@ func (x *LikeReviewResponse) GetNewHelpfulCount() int32 {
 	return 0
 }
 
-func (x *LikeReviewResponse) GetBaseResponse() *BaseResponse {
+func (x *LikeReviewResponse) GetBaseResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.BaseResponse
 	}
@@ -2349,7 +2350,7 @@ type ReportReviewRequest struct {
 	ReviewId      string                 `protobuf:"bytes,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
 	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // "spam", "inappropriate", "fake", "offensive"
 	Details       string                 `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2412,7 +2413,7 @@ func (x *ReportReviewRequest) GetDetails() string {
 	return ""
 }
 
-func (x *ReportReviewRequest) GetRequest() *BaseRequest {
+func (x *ReportReviewRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2420,9 +2421,9 @@ func (x *ReportReviewRequest) GetRequest() *BaseRequest {
 }
 
 type ReportReviewResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      *generated.Response    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	BaseResponse  *BaseResponse          `protobuf:"bytes,100,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Response      *generated.Response     `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	BaseResponse  *generated.BaseResponse `protobuf:"bytes,100,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2464,7 +2465,7 @@ func (x *ReportReviewResponse) GetResponse() *generated.Response {
 	return nil
 }
 
-func (x *ReportReviewResponse) GetBaseResponse() *BaseResponse {
+func (x *ReportReviewResponse) GetBaseResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.BaseResponse
 	}
@@ -2476,7 +2477,7 @@ type GetReviewStatisticsRequest struct {
 	PoiId         string                 `protobuf:"bytes,1,opt,name=poi_id,json=poiId,proto3" json:"poi_id,omitempty"`
 	IncludeTrends bool                   `protobuf:"varint,2,opt,name=include_trends,json=includeTrends,proto3" json:"include_trends,omitempty"`
 	IncludeTags   bool                   `protobuf:"varint,3,opt,name=include_tags,json=includeTags,proto3" json:"include_tags,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2532,7 +2533,7 @@ func (x *GetReviewStatisticsRequest) GetIncludeTags() bool {
 	return false
 }
 
-func (x *GetReviewStatisticsRequest) GetRequest() *BaseRequest {
+func (x *GetReviewStatisticsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2540,9 +2541,9 @@ func (x *GetReviewStatisticsRequest) GetRequest() *BaseRequest {
 }
 
 type GetReviewStatisticsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statistics    *ReviewStatistics      `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Statistics    *ReviewStatistics       `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2584,13 +2585,17 @@ func (x *GetReviewStatisticsResponse) GetStatistics() *ReviewStatistics {
 	return nil
 }
 
-func (x *GetReviewStatisticsResponse) GetResponse() *BaseResponse {
+func (x *GetReviewStatisticsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in review.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -2643,6 +2648,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in review.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -2707,12 +2716,12 @@ var File_review_proto protoreflect.FileDescriptor
 
 const file_review_proto_rawDesc = "" +
 	"\n" +
-	"\freview.proto\x12\x10ai_poi.review.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xde\x05\n" +
+	"\freview.proto\x12\x10ai_poi.review.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xa3\x06\n" +
 	"\x06Review\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x15\n" +
-	"\x06poi_id\x18\x03 \x01(\tR\x05poiId\x12\x16\n" +
-	"\x06rating\x18\x04 \x01(\x01R\x06rating\x12\x14\n" +
+	"\x06poi_id\x18\x03 \x01(\tR\x05poiId\x12/\n" +
+	"\x06rating\x18\x04 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?R\x06rating\x12\x14\n" +
 	"\x05title\x18\x05 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x06 \x01(\tR\acontent\x12\x16\n" +
 	"\x06photos\x18\a \x03(\tR\x06photos\x126\n" +
@@ -2727,25 +2736,25 @@ const file_review_proto_rawDesc = "" +
 	"\rhelpful_count\x18\f \x01(\x05R\fhelpfulCount\x12!\n" +
 	"\freport_count\x18\r \x01(\x05R\vreportCount\x12\x1f\n" +
 	"\vis_verified\x18\x0e \x01(\bR\n" +
-	"isVerified\x12\x1a\n" +
-	"\blanguage\x18\x0f \x01(\tR\blanguage\x129\n" +
+	"isVerified\x12F\n" +
+	"\blanguage\x18\x0f \x01(\tB*\xfaB'r%2 ^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\blanguage\x129\n" +
 	"\aaspects\x18\x10 \x01(\v2\x1f.ai_poi.review.v1.ReviewAspectsR\aaspects\x12:\n" +
 	"\breviewer\x18\x11 \x01(\v2\x1e.ai_poi.review.v1.ReviewerInfoR\breviewer\x12O\n" +
-	"\x11business_response\x18\x12 \x01(\v2\".ai_poi.review.v1.BusinessResponseR\x10businessResponse\"\x95\x03\n" +
-	"\rReviewAspects\x12%\n" +
-	"\x0eservice_rating\x18\x01 \x01(\x01R\rserviceRating\x12%\n" +
-	"\x0equality_rating\x18\x02 \x01(\x01R\rqualityRating\x12!\n" +
-	"\fvalue_rating\x18\x03 \x01(\x01R\vvalueRating\x12+\n" +
-	"\x11atmosphere_rating\x18\x04 \x01(\x01R\x10atmosphereRating\x12-\n" +
-	"\x12cleanliness_rating\x18\x05 \x01(\x01R\x11cleanlinessRating\x12'\n" +
-	"\x0flocation_rating\x18\x06 \x01(\x01R\x0elocationRating\x12\x1f\n" +
-	"\vfood_rating\x18\a \x01(\x01R\n" +
-	"foodRating\x12\x1f\n" +
-	"\vroom_rating\x18\b \x01(\x01R\n" +
-	"roomRating\x12)\n" +
-	"\x10amenities_rating\x18\t \x01(\x01R\x0famenitiesRating\x12!\n" +
+	"\x11business_response\x18\x12 \x01(\v2\".ai_poi.review.v1.BusinessResponseR\x10businessResponse\"\xa3\x05\n" +
+	"\rReviewAspects\x12@\n" +
+	"\x0eservice_rating\x18\x01 \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\rserviceRating\x12@\n" +
+	"\x0equality_rating\x18\x02 \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\rqualityRating\x12<\n" +
+	"\fvalue_rating\x18\x03 \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\vvalueRating\x12F\n" +
+	"\x11atmosphere_rating\x18\x04 \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\x10atmosphereRating\x12H\n" +
+	"\x12cleanliness_rating\x18\x05 \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\x11cleanlinessRating\x12B\n" +
+	"\x0flocation_rating\x18\x06 \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\x0elocationRating\x12:\n" +
+	"\vfood_rating\x18\a \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\n" +
+	"foodRating\x12:\n" +
+	"\vroom_rating\x18\b \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\n" +
+	"roomRating\x12D\n" +
+	"\x10amenities_rating\x18\t \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\x0famenitiesRating\x12<\n" +
 	"\fstaff_rating\x18\n" +
-	" \x01(\x01R\vstaffRating\"\x82\x02\n" +
+	" \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\vstaffRating\"\x82\x02\n" +
 	"\fReviewerInfo\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1d\n" +
@@ -2803,9 +2812,9 @@ const file_review_proto_rawDesc = "" +
 	"\x0fsentiment_score\x18\x03 \x01(\x01R\x0esentimentScore\x12\x1a\n" +
 	"\bcategory\x18\x04 \x01(\tR\bcategory\"U\n" +
 	"\x14LanguageDistribution\x12=\n" +
-	"\tlanguages\x18\x01 \x03(\v2\x1f.ai_poi.review.v1.LanguageCountR\tlanguages\"\x8f\x01\n" +
-	"\rLanguageCount\x12#\n" +
-	"\rlanguage_code\x18\x01 \x01(\tR\flanguageCode\x12#\n" +
+	"\tlanguages\x18\x01 \x03(\v2\x1f.ai_poi.review.v1.LanguageCountR\tlanguages\"\xbb\x01\n" +
+	"\rLanguageCount\x12O\n" +
+	"\rlanguage_code\x18\x01 \x01(\tB*\xfaB'r%2 ^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\flanguageCode\x12#\n" +
 	"\rlanguage_name\x18\x02 \x01(\tR\flanguageName\x12\x14\n" +
 	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x1e\n" +
 	"\n" +
@@ -2821,31 +2830,31 @@ const file_review_proto_rawDesc = "" +
 	"\x10with_photos_only\x18\x06 \x01(\bR\x0ewithPhotosOnly\x12\x1a\n" +
 	"\bkeywords\x18\a \x03(\tR\bkeywords\x127\n" +
 	"\asort_by\x18\b \x01(\x0e2\x1e.ai_poi.review.v1.ReviewSortByR\x06sortBy\x12F\n" +
-	"\x0esort_direction\x18\t \x01(\x0e2\x1f.ai_poi.common.v1.SortDirectionR\rsortDirection\"\xf7\x02\n" +
+	"\x0esort_direction\x18\t \x01(\x0e2\x1f.ai_poi.common.v1.SortDirectionR\rsortDirection\"\xbc\x03\n" +
 	"\x13CreateReviewRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
-	"\x06poi_id\x18\x02 \x01(\tR\x05poiId\x12\x16\n" +
-	"\x06rating\x18\x03 \x01(\x01R\x06rating\x12\x14\n" +
+	"\x06poi_id\x18\x02 \x01(\tR\x05poiId\x12/\n" +
+	"\x06rating\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?R\x06rating\x12\x14\n" +
 	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x05 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
 	"photo_urls\x18\x06 \x03(\tR\tphotoUrls\x129\n" +
 	"\n" +
 	"visit_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tvisitDate\x129\n" +
-	"\aaspects\x18\b \x01(\v2\x1f.ai_poi.review.v1.ReviewAspectsR\aaspects\x12\x1a\n" +
-	"\blanguage\x18\t \x01(\tR\blanguage\x127\n" +
-	"\arequest\x18d \x01(\v2\x1d.ai_poi.review.v1.BaseRequestR\arequest\"\xc5\x01\n" +
+	"\aaspects\x18\b \x01(\v2\x1f.ai_poi.review.v1.ReviewAspectsR\aaspects\x12F\n" +
+	"\blanguage\x18\t \x01(\tB*\xfaB'r%2 ^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\blanguage\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc5\x01\n" +
 	"\x14CreateReviewResponse\x126\n" +
 	"\bresponse\x18\x01 \x01(\v2\x1a.ai_poi.common.v1.ResponseR\bresponse\x120\n" +
 	"\x06review\x18\x02 \x01(\v2\x18.ai_poi.review.v1.ReviewR\x06review\x12C\n" +
-	"\rbase_response\x18d \x01(\v2\x1e.ai_poi.review.v1.BaseResponseR\fbaseResponse\"\xe3\x01\n" +
+	"\rbase_response\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\fbaseResponse\"\xe3\x01\n" +
 	"\x14GetPOIReviewsRequest\x12\x15\n" +
 	"\x06poi_id\x18\x01 \x01(\tR\x05poiId\x12C\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2#.ai_poi.common.v1.PaginationRequestR\n" +
 	"pagination\x126\n" +
 	"\x06filter\x18\x03 \x01(\v2\x1e.ai_poi.review.v1.ReviewFilterR\x06filter\x127\n" +
-	"\arequest\x18d \x01(\v2\x1d.ai_poi.review.v1.BaseRequestR\arequest\"\x91\x02\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x91\x02\n" +
 	"\x15GetPOIReviewsResponse\x122\n" +
 	"\areviews\x18\x01 \x03(\v2\x18.ai_poi.review.v1.ReviewR\areviews\x12D\n" +
 	"\n" +
@@ -2854,21 +2863,21 @@ const file_review_proto_rawDesc = "" +
 	"\n" +
 	"statistics\x18\x03 \x01(\v2\".ai_poi.review.v1.ReviewStatisticsR\n" +
 	"statistics\x12:\n" +
-	"\bresponse\x18d \x01(\v2\x1e.ai_poi.review.v1.BaseResponseR\bresponse\"\x81\x01\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x81\x01\n" +
 	"\x10GetReviewRequest\x12\x1b\n" +
 	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x127\n" +
-	"\arequest\x18d \x01(\v2\x1d.ai_poi.review.v1.BaseRequestR\arequest\"\xbb\x01\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xbb\x01\n" +
 	"\x11GetReviewResponse\x120\n" +
 	"\x06review\x18\x01 \x01(\v2\x18.ai_poi.review.v1.ReviewR\x06review\x12\x19\n" +
 	"\bcan_edit\x18\x02 \x01(\bR\acanEdit\x12\x1d\n" +
 	"\n" +
 	"can_delete\x18\x03 \x01(\bR\tcanDelete\x12:\n" +
-	"\bresponse\x18d \x01(\v2\x1e.ai_poi.review.v1.BaseResponseR\bresponse\"\xe1\x02\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xfc\x02\n" +
 	"\x13UpdateReviewRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\treview_id\x18\x02 \x01(\tR\breviewId\x12\x16\n" +
-	"\x06rating\x18\x03 \x01(\x01R\x06rating\x12\x14\n" +
+	"\treview_id\x18\x02 \x01(\tR\breviewId\x121\n" +
+	"\x06rating\x18\x03 \x01(\x01B\x19\xfaB\x16\x12\x14\x19\x00\x00\x00\x00\x00\x00\x14@)\x00\x00\x00\x00\x00\x00\xf0?@\x01R\x06rating\x12\x14\n" +
 	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x05 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
@@ -2876,25 +2885,25 @@ const file_review_proto_rawDesc = "" +
 	"\n" +
 	"visit_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tvisitDate\x129\n" +
 	"\aaspects\x18\b \x01(\v2\x1f.ai_poi.review.v1.ReviewAspectsR\aaspects\x127\n" +
-	"\arequest\x18d \x01(\v2\x1d.ai_poi.review.v1.BaseRequestR\arequest\"\xc5\x01\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc5\x01\n" +
 	"\x14UpdateReviewResponse\x126\n" +
 	"\bresponse\x18\x01 \x01(\v2\x1a.ai_poi.common.v1.ResponseR\bresponse\x120\n" +
 	"\x06review\x18\x02 \x01(\v2\x18.ai_poi.review.v1.ReviewR\x06review\x12C\n" +
-	"\rbase_response\x18d \x01(\v2\x1e.ai_poi.review.v1.BaseResponseR\fbaseResponse\"\x84\x01\n" +
+	"\rbase_response\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\fbaseResponse\"\x84\x01\n" +
 	"\x13DeleteReviewRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\treview_id\x18\x02 \x01(\tR\breviewId\x127\n" +
-	"\arequest\x18d \x01(\v2\x1d.ai_poi.review.v1.BaseRequestR\arequest\"\x93\x01\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x93\x01\n" +
 	"\x14DeleteReviewResponse\x126\n" +
 	"\bresponse\x18\x01 \x01(\v2\x1a.ai_poi.common.v1.ResponseR\bresponse\x12C\n" +
-	"\rbase_response\x18d \x01(\v2\x1e.ai_poi.review.v1.BaseResponseR\fbaseResponse\"\xe6\x01\n" +
+	"\rbase_response\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\fbaseResponse\"\xe6\x01\n" +
 	"\x15GetUserReviewsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12C\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2#.ai_poi.common.v1.PaginationRequestR\n" +
 	"pagination\x126\n" +
 	"\x06filter\x18\x03 \x01(\v2\x1e.ai_poi.review.v1.ReviewFilterR\x06filter\x127\n" +
-	"\arequest\x18d \x01(\v2\x1d.ai_poi.review.v1.BaseRequestR\arequest\"\x96\x02\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x96\x02\n" +
 	"\x16GetUserReviewsResponse\x122\n" +
 	"\areviews\x18\x01 \x03(\v2\x18.ai_poi.review.v1.ReviewR\areviews\x12D\n" +
 	"\n" +
@@ -2903,7 +2912,7 @@ const file_review_proto_rawDesc = "" +
 	"\n" +
 	"statistics\x18\x03 \x01(\v2&.ai_poi.review.v1.UserReviewStatisticsR\n" +
 	"statistics\x12:\n" +
-	"\bresponse\x18d \x01(\v2\x1e.ai_poi.review.v1.BaseResponseR\bresponse\"\x82\x02\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x82\x02\n" +
 	"\x14UserReviewStatistics\x12#\n" +
 	"\rtotal_reviews\x18\x01 \x01(\x05R\ftotalReviews\x120\n" +
 	"\x14average_rating_given\x18\x02 \x01(\x01R\x12averageRatingGiven\x124\n" +
@@ -2914,41 +2923,41 @@ const file_review_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\treview_id\x18\x02 \x01(\tR\breviewId\x12\x17\n" +
 	"\ais_like\x18\x03 \x01(\bR\x06isLike\x127\n" +
-	"\arequest\x18d \x01(\v2\x1d.ai_poi.review.v1.BaseRequestR\arequest\"\xbd\x01\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xbd\x01\n" +
 	"\x12LikeReviewResponse\x126\n" +
 	"\bresponse\x18\x01 \x01(\v2\x1a.ai_poi.common.v1.ResponseR\bresponse\x12*\n" +
 	"\x11new_helpful_count\x18\x02 \x01(\x05R\x0fnewHelpfulCount\x12C\n" +
-	"\rbase_response\x18d \x01(\v2\x1e.ai_poi.review.v1.BaseResponseR\fbaseResponse\"\xb6\x01\n" +
+	"\rbase_response\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\fbaseResponse\"\xb6\x01\n" +
 	"\x13ReportReviewRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\treview_id\x18\x02 \x01(\tR\breviewId\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
 	"\adetails\x18\x04 \x01(\tR\adetails\x127\n" +
-	"\arequest\x18d \x01(\v2\x1d.ai_poi.review.v1.BaseRequestR\arequest\"\x93\x01\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x93\x01\n" +
 	"\x14ReportReviewResponse\x126\n" +
 	"\bresponse\x18\x01 \x01(\v2\x1a.ai_poi.common.v1.ResponseR\bresponse\x12C\n" +
-	"\rbase_response\x18d \x01(\v2\x1e.ai_poi.review.v1.BaseResponseR\fbaseResponse\"\xb6\x01\n" +
+	"\rbase_response\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\fbaseResponse\"\xb6\x01\n" +
 	"\x1aGetReviewStatisticsRequest\x12\x15\n" +
 	"\x06poi_id\x18\x01 \x01(\tR\x05poiId\x12%\n" +
 	"\x0einclude_trends\x18\x02 \x01(\bR\rincludeTrends\x12!\n" +
 	"\finclude_tags\x18\x03 \x01(\bR\vincludeTags\x127\n" +
-	"\arequest\x18d \x01(\v2\x1d.ai_poi.review.v1.BaseRequestR\arequest\"\x9d\x01\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x9d\x01\n" +
 	"\x1bGetReviewStatisticsResponse\x12B\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2\".ai_poi.review.v1.ReviewStatisticsR\n" +
 	"statistics\x12:\n" +
-	"\bresponse\x18d \x01(\v2\x1e.ai_poi.review.v1.BaseResponseR\bresponse\"N\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status*\xb5\x01\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x01*\xb5\x01\n" +
 	"\fReviewStatus\x12\x1d\n" +
 	"\x19REVIEW_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15REVIEW_STATUS_PENDING\x10\x01\x12\x1b\n" +
@@ -3027,9 +3036,11 @@ var file_review_proto_goTypes = []any{
 	(*timestamppb.Timestamp)(nil),        // 35: google.protobuf.Timestamp
 	(*generated.RatingBreakdown)(nil),    // 36: ai_poi.common.v1.RatingBreakdown
 	(generated.SortDirection)(0),         // 37: ai_poi.common.v1.SortDirection
-	(*generated.Response)(nil),           // 38: ai_poi.common.v1.Response
-	(*generated.PaginationRequest)(nil),  // 39: ai_poi.common.v1.PaginationRequest
-	(*generated.PaginationResponse)(nil), // 40: ai_poi.common.v1.PaginationResponse
+	(*generated.BaseRequest)(nil),        // 38: ai_poi.common.v1.BaseRequest
+	(*generated.Response)(nil),           // 39: ai_poi.common.v1.Response
+	(*generated.BaseResponse)(nil),       // 40: ai_poi.common.v1.BaseResponse
+	(*generated.PaginationRequest)(nil),  // 41: ai_poi.common.v1.PaginationRequest
+	(*generated.PaginationResponse)(nil), // 42: ai_poi.common.v1.PaginationResponse
 }
 var file_review_proto_depIdxs = []int32{
 	0,  // 0: ai_poi.review.v1.Review.status:type_name -> ai_poi.review.v1.ReviewStatus
@@ -3055,45 +3066,45 @@ var file_review_proto_depIdxs = []int32{
 	37, // 20: ai_poi.review.v1.ReviewFilter.sort_direction:type_name -> ai_poi.common.v1.SortDirection
 	35, // 21: ai_poi.review.v1.CreateReviewRequest.visit_date:type_name -> google.protobuf.Timestamp
 	3,  // 22: ai_poi.review.v1.CreateReviewRequest.aspects:type_name -> ai_poi.review.v1.ReviewAspects
-	33, // 23: ai_poi.review.v1.CreateReviewRequest.request:type_name -> ai_poi.review.v1.BaseRequest
-	38, // 24: ai_poi.review.v1.CreateReviewResponse.response:type_name -> ai_poi.common.v1.Response
+	38, // 23: ai_poi.review.v1.CreateReviewRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	39, // 24: ai_poi.review.v1.CreateReviewResponse.response:type_name -> ai_poi.common.v1.Response
 	2,  // 25: ai_poi.review.v1.CreateReviewResponse.review:type_name -> ai_poi.review.v1.Review
-	34, // 26: ai_poi.review.v1.CreateReviewResponse.base_response:type_name -> ai_poi.review.v1.BaseResponse
-	39, // 27: ai_poi.review.v1.GetPOIReviewsRequest.pagination:type_name -> ai_poi.common.v1.PaginationRequest
+	40, // 26: ai_poi.review.v1.CreateReviewResponse.base_response:type_name -> ai_poi.common.v1.BaseResponse
+	41, // 27: ai_poi.review.v1.GetPOIReviewsRequest.pagination:type_name -> ai_poi.common.v1.PaginationRequest
 	13, // 28: ai_poi.review.v1.GetPOIReviewsRequest.filter:type_name -> ai_poi.review.v1.ReviewFilter
-	33, // 29: ai_poi.review.v1.GetPOIReviewsRequest.request:type_name -> ai_poi.review.v1.BaseRequest
+	38, // 29: ai_poi.review.v1.GetPOIReviewsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	2,  // 30: ai_poi.review.v1.GetPOIReviewsResponse.reviews:type_name -> ai_poi.review.v1.Review
-	40, // 31: ai_poi.review.v1.GetPOIReviewsResponse.pagination:type_name -> ai_poi.common.v1.PaginationResponse
+	42, // 31: ai_poi.review.v1.GetPOIReviewsResponse.pagination:type_name -> ai_poi.common.v1.PaginationResponse
 	6,  // 32: ai_poi.review.v1.GetPOIReviewsResponse.statistics:type_name -> ai_poi.review.v1.ReviewStatistics
-	34, // 33: ai_poi.review.v1.GetPOIReviewsResponse.response:type_name -> ai_poi.review.v1.BaseResponse
-	33, // 34: ai_poi.review.v1.GetReviewRequest.request:type_name -> ai_poi.review.v1.BaseRequest
+	40, // 33: ai_poi.review.v1.GetPOIReviewsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	38, // 34: ai_poi.review.v1.GetReviewRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	2,  // 35: ai_poi.review.v1.GetReviewResponse.review:type_name -> ai_poi.review.v1.Review
-	34, // 36: ai_poi.review.v1.GetReviewResponse.response:type_name -> ai_poi.review.v1.BaseResponse
+	40, // 36: ai_poi.review.v1.GetReviewResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	35, // 37: ai_poi.review.v1.UpdateReviewRequest.visit_date:type_name -> google.protobuf.Timestamp
 	3,  // 38: ai_poi.review.v1.UpdateReviewRequest.aspects:type_name -> ai_poi.review.v1.ReviewAspects
-	33, // 39: ai_poi.review.v1.UpdateReviewRequest.request:type_name -> ai_poi.review.v1.BaseRequest
-	38, // 40: ai_poi.review.v1.UpdateReviewResponse.response:type_name -> ai_poi.common.v1.Response
+	38, // 39: ai_poi.review.v1.UpdateReviewRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	39, // 40: ai_poi.review.v1.UpdateReviewResponse.response:type_name -> ai_poi.common.v1.Response
 	2,  // 41: ai_poi.review.v1.UpdateReviewResponse.review:type_name -> ai_poi.review.v1.Review
-	34, // 42: ai_poi.review.v1.UpdateReviewResponse.base_response:type_name -> ai_poi.review.v1.BaseResponse
-	33, // 43: ai_poi.review.v1.DeleteReviewRequest.request:type_name -> ai_poi.review.v1.BaseRequest
-	38, // 44: ai_poi.review.v1.DeleteReviewResponse.response:type_name -> ai_poi.common.v1.Response
-	34, // 45: ai_poi.review.v1.DeleteReviewResponse.base_response:type_name -> ai_poi.review.v1.BaseResponse
-	39, // 46: ai_poi.review.v1.GetUserReviewsRequest.pagination:type_name -> ai_poi.common.v1.PaginationRequest
+	40, // 42: ai_poi.review.v1.UpdateReviewResponse.base_response:type_name -> ai_poi.common.v1.BaseResponse
+	38, // 43: ai_poi.review.v1.DeleteReviewRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	39, // 44: ai_poi.review.v1.DeleteReviewResponse.response:type_name -> ai_poi.common.v1.Response
+	40, // 45: ai_poi.review.v1.DeleteReviewResponse.base_response:type_name -> ai_poi.common.v1.BaseResponse
+	41, // 46: ai_poi.review.v1.GetUserReviewsRequest.pagination:type_name -> ai_poi.common.v1.PaginationRequest
 	13, // 47: ai_poi.review.v1.GetUserReviewsRequest.filter:type_name -> ai_poi.review.v1.ReviewFilter
-	33, // 48: ai_poi.review.v1.GetUserReviewsRequest.request:type_name -> ai_poi.review.v1.BaseRequest
+	38, // 48: ai_poi.review.v1.GetUserReviewsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	2,  // 49: ai_poi.review.v1.GetUserReviewsResponse.reviews:type_name -> ai_poi.review.v1.Review
-	40, // 50: ai_poi.review.v1.GetUserReviewsResponse.pagination:type_name -> ai_poi.common.v1.PaginationResponse
+	42, // 50: ai_poi.review.v1.GetUserReviewsResponse.pagination:type_name -> ai_poi.common.v1.PaginationResponse
 	26, // 51: ai_poi.review.v1.GetUserReviewsResponse.statistics:type_name -> ai_poi.review.v1.UserReviewStatistics
-	34, // 52: ai_poi.review.v1.GetUserReviewsResponse.response:type_name -> ai_poi.review.v1.BaseResponse
-	33, // 53: ai_poi.review.v1.LikeReviewRequest.request:type_name -> ai_poi.review.v1.BaseRequest
-	38, // 54: ai_poi.review.v1.LikeReviewResponse.response:type_name -> ai_poi.common.v1.Response
-	34, // 55: ai_poi.review.v1.LikeReviewResponse.base_response:type_name -> ai_poi.review.v1.BaseResponse
-	33, // 56: ai_poi.review.v1.ReportReviewRequest.request:type_name -> ai_poi.review.v1.BaseRequest
-	38, // 57: ai_poi.review.v1.ReportReviewResponse.response:type_name -> ai_poi.common.v1.Response
-	34, // 58: ai_poi.review.v1.ReportReviewResponse.base_response:type_name -> ai_poi.review.v1.BaseResponse
-	33, // 59: ai_poi.review.v1.GetReviewStatisticsRequest.request:type_name -> ai_poi.review.v1.BaseRequest
+	40, // 52: ai_poi.review.v1.GetUserReviewsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	38, // 53: ai_poi.review.v1.LikeReviewRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	39, // 54: ai_poi.review.v1.LikeReviewResponse.response:type_name -> ai_poi.common.v1.Response
+	40, // 55: ai_poi.review.v1.LikeReviewResponse.base_response:type_name -> ai_poi.common.v1.BaseResponse
+	38, // 56: ai_poi.review.v1.ReportReviewRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	39, // 57: ai_poi.review.v1.ReportReviewResponse.response:type_name -> ai_poi.common.v1.Response
+	40, // 58: ai_poi.review.v1.ReportReviewResponse.base_response:type_name -> ai_poi.common.v1.BaseResponse
+	38, // 59: ai_poi.review.v1.GetReviewStatisticsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	6,  // 60: ai_poi.review.v1.GetReviewStatisticsResponse.statistics:type_name -> ai_poi.review.v1.ReviewStatistics
-	34, // 61: ai_poi.review.v1.GetReviewStatisticsResponse.response:type_name -> ai_poi.review.v1.BaseResponse
+	40, // 61: ai_poi.review.v1.GetReviewStatisticsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	14, // 62: ai_poi.review.v1.ReviewService.CreateReview:input_type -> ai_poi.review.v1.CreateReviewRequest
 	16, // 63: ai_poi.review.v1.ReviewService.GetPOIReviews:input_type -> ai_poi.review.v1.GetPOIReviewsRequest
 	18, // 64: ai_poi.review.v1.ReviewService.GetReview:input_type -> ai_poi.review.v1.GetReviewRequest
@@ -0,0 +1,5528 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: review.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = generated.SortDirection(0)
+)
+
+// Validate checks the field values on Review with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Review) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Review with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReviewMultiError, or nil if none found.
+func (m *Review) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Review) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for PoiId
+
+	if val := m.GetRating(); val < 1 || val > 5 {
+		err := ReviewValidationError{
+			field:  "Rating",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetVisitDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "VisitDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "VisitDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVisitDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "VisitDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HelpfulCount
+
+	// no validation rules for ReportCount
+
+	// no validation rules for IsVerified
+
+	if m.GetLanguage() != "" {
+
+		if !_Review_Language_Pattern.MatchString(m.GetLanguage()) {
+			err := ReviewValidationError{
+				field:  "Language",
+				reason: "value does not match regex pattern \"^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAspects()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "Aspects",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "Aspects",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAspects()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "Aspects",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReviewer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "Reviewer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "Reviewer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReviewer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "Reviewer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBusinessResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "BusinessResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "BusinessResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBusinessResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "BusinessResponse",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewMultiError is an error wrapping multiple validation errors returned by
+// Review.ValidateAll() if the designated constraints aren't met.
+type ReviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewMultiError) AllErrors() []error { return m }
+
+// ReviewValidationError is the validation error returned by Review.Validate if
+// the designated constraints aren't met.
+type ReviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewValidationError) ErrorName() string { return "ReviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewValidationError{}
+
+var _Review_Language_Pattern = regexp.MustCompile("^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on ReviewAspects with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewAspects) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewAspects with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewAspectsMultiError, or
+// nil if none found.
+func (m *ReviewAspects) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewAspects) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetServiceRating() != 0 {
+
+		if val := m.GetServiceRating(); val < 1 || val > 5 {
+			err := ReviewAspectsValidationError{
+				field:  "ServiceRating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetQualityRating() != 0 {
+
+		if val := m.GetQualityRating(); val < 1 || val > 5 {
+			err := ReviewAspectsValidationError{
+				field:  "QualityRating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetValueRating() != 0 {
+
+		if val := m.GetValueRating(); val < 1 || val > 5 {
+			err := ReviewAspectsValidationError{
+				field:  "ValueRating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetAtmosphereRating() != 0 {
+
+		if val := m.GetAtmosphereRating(); val < 1 || val > 5 {
+			err := ReviewAspectsValidationError{
+				field:  "AtmosphereRating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetCleanlinessRating() != 0 {
+
+		if val := m.GetCleanlinessRating(); val < 1 || val > 5 {
+			err := ReviewAspectsValidationError{
+				field:  "CleanlinessRating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetLocationRating() != 0 {
+
+		if val := m.GetLocationRating(); val < 1 || val > 5 {
+			err := ReviewAspectsValidationError{
+				field:  "LocationRating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetFoodRating() != 0 {
+
+		if val := m.GetFoodRating(); val < 1 || val > 5 {
+			err := ReviewAspectsValidationError{
+				field:  "FoodRating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetRoomRating() != 0 {
+
+		if val := m.GetRoomRating(); val < 1 || val > 5 {
+			err := ReviewAspectsValidationError{
+				field:  "RoomRating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetAmenitiesRating() != 0 {
+
+		if val := m.GetAmenitiesRating(); val < 1 || val > 5 {
+			err := ReviewAspectsValidationError{
+				field:  "AmenitiesRating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetStaffRating() != 0 {
+
+		if val := m.GetStaffRating(); val < 1 || val > 5 {
+			err := ReviewAspectsValidationError{
+				field:  "StaffRating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReviewAspectsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewAspectsMultiError is an error wrapping multiple validation errors
+// returned by ReviewAspects.ValidateAll() if the designated constraints
+// aren't met.
+type ReviewAspectsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewAspectsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewAspectsMultiError) AllErrors() []error { return m }
+
+// ReviewAspectsValidationError is the validation error returned by
+// ReviewAspects.Validate if the designated constraints aren't met.
+type ReviewAspectsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewAspectsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewAspectsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewAspectsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewAspectsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewAspectsValidationError) ErrorName() string { return "ReviewAspectsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewAspectsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewAspects.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewAspectsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewAspectsValidationError{}
+
+// Validate checks the field values on ReviewerInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewerInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewerInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewerInfoMultiError, or
+// nil if none found.
+func (m *ReviewerInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewerInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for DisplayName
+
+	// no validation rules for AvatarUrl
+
+	// no validation rules for ReviewCount
+
+	// no validation rules for IsVerified
+
+	// no validation rules for Level
+
+	if all {
+		switch v := interface{}(m.GetMemberSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewerInfoValidationError{
+					field:  "MemberSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewerInfoValidationError{
+					field:  "MemberSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMemberSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewerInfoValidationError{
+				field:  "MemberSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewerInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewerInfoMultiError is an error wrapping multiple validation errors
+// returned by ReviewerInfo.ValidateAll() if the designated constraints aren't met.
+type ReviewerInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewerInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewerInfoMultiError) AllErrors() []error { return m }
+
+// ReviewerInfoValidationError is the validation error returned by
+// ReviewerInfo.Validate if the designated constraints aren't met.
+type ReviewerInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewerInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewerInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewerInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewerInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewerInfoValidationError) ErrorName() string { return "ReviewerInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewerInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewerInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewerInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewerInfoValidationError{}
+
+// Validate checks the field values on BusinessResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BusinessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BusinessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BusinessResponseMultiError, or nil if none found.
+func (m *BusinessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BusinessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for BusinessUserId
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BusinessResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BusinessResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BusinessResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResponderName
+
+	// no validation rules for ResponderTitle
+
+	if len(errors) > 0 {
+		return BusinessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BusinessResponseMultiError is an error wrapping multiple validation errors
+// returned by BusinessResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BusinessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BusinessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BusinessResponseMultiError) AllErrors() []error { return m }
+
+// BusinessResponseValidationError is the validation error returned by
+// BusinessResponse.Validate if the designated constraints aren't met.
+type BusinessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BusinessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BusinessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BusinessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BusinessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BusinessResponseValidationError) ErrorName() string { return "BusinessResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BusinessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBusinessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BusinessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BusinessResponseValidationError{}
+
+// Validate checks the field values on ReviewStatistics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReviewStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewStatisticsMultiError, or nil if none found.
+func (m *ReviewStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PoiId
+
+	// no validation rules for OverallRating
+
+	// no validation rules for TotalReviews
+
+	if all {
+		switch v := interface{}(m.GetRatingBreakdown()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewStatisticsValidationError{
+					field:  "RatingBreakdown",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewStatisticsValidationError{
+					field:  "RatingBreakdown",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRatingBreakdown()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewStatisticsValidationError{
+				field:  "RatingBreakdown",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAspectAverages()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewStatisticsValidationError{
+					field:  "AspectAverages",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewStatisticsValidationError{
+					field:  "AspectAverages",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAspectAverages()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewStatisticsValidationError{
+				field:  "AspectAverages",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTrends()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewStatisticsValidationError{
+					field:  "Trends",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewStatisticsValidationError{
+					field:  "Trends",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTrends()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewStatisticsValidationError{
+				field:  "Trends",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReviewStatisticsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReviewStatisticsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReviewStatisticsValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLanguageDistribution()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewStatisticsValidationError{
+					field:  "LanguageDistribution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewStatisticsValidationError{
+					field:  "LanguageDistribution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLanguageDistribution()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewStatisticsValidationError{
+				field:  "LanguageDistribution",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUpdated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewStatisticsValidationError{
+					field:  "LastUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewStatisticsValidationError{
+					field:  "LastUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUpdated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewStatisticsValidationError{
+				field:  "LastUpdated",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewStatisticsMultiError is an error wrapping multiple validation errors
+// returned by ReviewStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type ReviewStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewStatisticsMultiError) AllErrors() []error { return m }
+
+// ReviewStatisticsValidationError is the validation error returned by
+// ReviewStatistics.Validate if the designated constraints aren't met.
+type ReviewStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewStatisticsValidationError) ErrorName() string { return "ReviewStatisticsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewStatisticsValidationError{}
+
+// Validate checks the field values on ReviewAspectAverages with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReviewAspectAverages) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewAspectAverages with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewAspectAveragesMultiError, or nil if none found.
+func (m *ReviewAspectAverages) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewAspectAverages) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceAverage
+
+	// no validation rules for QualityAverage
+
+	// no validation rules for ValueAverage
+
+	// no validation rules for AtmosphereAverage
+
+	// no validation rules for CleanlinessAverage
+
+	// no validation rules for LocationAverage
+
+	// no validation rules for FoodAverage
+
+	// no validation rules for RoomAverage
+
+	// no validation rules for AmenitiesAverage
+
+	// no validation rules for StaffAverage
+
+	if len(errors) > 0 {
+		return ReviewAspectAveragesMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewAspectAveragesMultiError is an error wrapping multiple validation
+// errors returned by ReviewAspectAverages.ValidateAll() if the designated
+// constraints aren't met.
+type ReviewAspectAveragesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewAspectAveragesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewAspectAveragesMultiError) AllErrors() []error { return m }
+
+// ReviewAspectAveragesValidationError is the validation error returned by
+// ReviewAspectAverages.Validate if the designated constraints aren't met.
+type ReviewAspectAveragesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewAspectAveragesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewAspectAveragesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewAspectAveragesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewAspectAveragesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewAspectAveragesValidationError) ErrorName() string {
+	return "ReviewAspectAveragesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReviewAspectAveragesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewAspectAverages.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewAspectAveragesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewAspectAveragesValidationError{}
+
+// Validate checks the field values on RecentReviewTrends with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecentReviewTrends) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecentReviewTrends with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecentReviewTrendsMultiError, or nil if none found.
+func (m *RecentReviewTrends) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecentReviewTrends) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RatingTrend
+
+	// no validation rules for ReviewsLast_30Days
+
+	// no validation rules for AverageRatingLast_30Days
+
+	// no validation rules for RatingChangePercentage
+
+	for idx, item := range m.GetMonthlyData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecentReviewTrendsValidationError{
+						field:  fmt.Sprintf("MonthlyData[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecentReviewTrendsValidationError{
+						field:  fmt.Sprintf("MonthlyData[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecentReviewTrendsValidationError{
+					field:  fmt.Sprintf("MonthlyData[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecentReviewTrendsMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecentReviewTrendsMultiError is an error wrapping multiple validation errors
+// returned by RecentReviewTrends.ValidateAll() if the designated constraints
+// aren't met.
+type RecentReviewTrendsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecentReviewTrendsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecentReviewTrendsMultiError) AllErrors() []error { return m }
+
+// RecentReviewTrendsValidationError is the validation error returned by
+// RecentReviewTrends.Validate if the designated constraints aren't met.
+type RecentReviewTrendsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecentReviewTrendsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecentReviewTrendsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecentReviewTrendsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecentReviewTrendsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecentReviewTrendsValidationError) ErrorName() string {
+	return "RecentReviewTrendsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecentReviewTrendsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecentReviewTrends.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecentReviewTrendsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecentReviewTrendsValidationError{}
+
+// Validate checks the field values on MonthlyReviewData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MonthlyReviewData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MonthlyReviewData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MonthlyReviewDataMultiError, or nil if none found.
+func (m *MonthlyReviewData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MonthlyReviewData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Year
+
+	// no validation rules for Month
+
+	// no validation rules for ReviewCount
+
+	// no validation rules for AverageRating
+
+	if len(errors) > 0 {
+		return MonthlyReviewDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// MonthlyReviewDataMultiError is an error wrapping multiple validation errors
+// returned by MonthlyReviewData.ValidateAll() if the designated constraints
+// aren't met.
+type MonthlyReviewDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MonthlyReviewDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MonthlyReviewDataMultiError) AllErrors() []error { return m }
+
+// MonthlyReviewDataValidationError is the validation error returned by
+// MonthlyReviewData.Validate if the designated constraints aren't met.
+type MonthlyReviewDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MonthlyReviewDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MonthlyReviewDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MonthlyReviewDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MonthlyReviewDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MonthlyReviewDataValidationError) ErrorName() string {
+	return "MonthlyReviewDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MonthlyReviewDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMonthlyReviewData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MonthlyReviewDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MonthlyReviewDataValidationError{}
+
+// Validate checks the field values on ReviewTag with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewTag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewTag with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewTagMultiError, or nil
+// if none found.
+func (m *ReviewTag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewTag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tag
+
+	// no validation rules for Count
+
+	// no validation rules for SentimentScore
+
+	// no validation rules for Category
+
+	if len(errors) > 0 {
+		return ReviewTagMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewTagMultiError is an error wrapping multiple validation errors returned
+// by ReviewTag.ValidateAll() if the designated constraints aren't met.
+type ReviewTagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewTagMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewTagMultiError) AllErrors() []error { return m }
+
+// ReviewTagValidationError is the validation error returned by
+// ReviewTag.Validate if the designated constraints aren't met.
+type ReviewTagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewTagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewTagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewTagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewTagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewTagValidationError) ErrorName() string { return "ReviewTagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewTagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewTagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewTagValidationError{}
+
+// Validate checks the field values on LanguageDistribution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LanguageDistribution) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LanguageDistribution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LanguageDistributionMultiError, or nil if none found.
+func (m *LanguageDistribution) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LanguageDistribution) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLanguages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LanguageDistributionValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LanguageDistributionValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LanguageDistributionValidationError{
+					field:  fmt.Sprintf("Languages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LanguageDistributionMultiError(errors)
+	}
+
+	return nil
+}
+
+// LanguageDistributionMultiError is an error wrapping multiple validation
+// errors returned by LanguageDistribution.ValidateAll() if the designated
+// constraints aren't met.
+type LanguageDistributionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LanguageDistributionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LanguageDistributionMultiError) AllErrors() []error { return m }
+
+// LanguageDistributionValidationError is the validation error returned by
+// LanguageDistribution.Validate if the designated constraints aren't met.
+type LanguageDistributionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LanguageDistributionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LanguageDistributionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LanguageDistributionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LanguageDistributionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LanguageDistributionValidationError) ErrorName() string {
+	return "LanguageDistributionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LanguageDistributionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLanguageDistribution.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LanguageDistributionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LanguageDistributionValidationError{}
+
+// Validate checks the field values on LanguageCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LanguageCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LanguageCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LanguageCountMultiError, or
+// nil if none found.
+func (m *LanguageCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LanguageCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLanguageCode() != "" {
+
+		if !_LanguageCount_LanguageCode_Pattern.MatchString(m.GetLanguageCode()) {
+			err := LanguageCountValidationError{
+				field:  "LanguageCode",
+				reason: "value does not match regex pattern \"^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for LanguageName
+
+	// no validation rules for Count
+
+	// no validation rules for Percentage
+
+	if len(errors) > 0 {
+		return LanguageCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// LanguageCountMultiError is an error wrapping multiple validation errors
+// returned by LanguageCount.ValidateAll() if the designated constraints
+// aren't met.
+type LanguageCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LanguageCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LanguageCountMultiError) AllErrors() []error { return m }
+
+// LanguageCountValidationError is the validation error returned by
+// LanguageCount.Validate if the designated constraints aren't met.
+type LanguageCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LanguageCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LanguageCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LanguageCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LanguageCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LanguageCountValidationError) ErrorName() string { return "LanguageCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LanguageCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLanguageCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LanguageCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LanguageCountValidationError{}
+
+var _LanguageCount_LanguageCode_Pattern = regexp.MustCompile("^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on ReviewFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewFilterMultiError, or
+// nil if none found.
+func (m *ReviewFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewFilterValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewFilterValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewFilterValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewFilterValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewFilterValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewFilterValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for VerifiedOnly
+
+	// no validation rules for WithPhotosOnly
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortDirection
+
+	if len(errors) > 0 {
+		return ReviewFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewFilterMultiError is an error wrapping multiple validation errors
+// returned by ReviewFilter.ValidateAll() if the designated constraints aren't met.
+type ReviewFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewFilterMultiError) AllErrors() []error { return m }
+
+// ReviewFilterValidationError is the validation error returned by
+// ReviewFilter.Validate if the designated constraints aren't met.
+type ReviewFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewFilterValidationError) ErrorName() string { return "ReviewFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewFilterValidationError{}
+
+// Validate checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewRequestMultiError, or nil if none found.
+func (m *CreateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for PoiId
+
+	if val := m.GetRating(); val < 1 || val > 5 {
+		err := CreateReviewRequestValidationError{
+			field:  "Rating",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetVisitDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReviewRequestValidationError{
+					field:  "VisitDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReviewRequestValidationError{
+					field:  "VisitDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVisitDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReviewRequestValidationError{
+				field:  "VisitDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAspects()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReviewRequestValidationError{
+					field:  "Aspects",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReviewRequestValidationError{
+					field:  "Aspects",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAspects()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReviewRequestValidationError{
+				field:  "Aspects",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetLanguage() != "" {
+
+		if !_CreateReviewRequest_Language_Pattern.MatchString(m.GetLanguage()) {
+			err := CreateReviewRequestValidationError{
+				field:  "Language",
+				reason: "value does not match regex pattern \"^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReviewRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewRequestMultiError) AllErrors() []error { return m }
+
+// CreateReviewRequestValidationError is the validation error returned by
+// CreateReviewRequest.Validate if the designated constraints aren't met.
+type CreateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewRequestValidationError) ErrorName() string {
+	return "CreateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewRequestValidationError{}
+
+var _CreateReviewRequest_Language_Pattern = regexp.MustCompile("^[a-z]{2,3}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on CreateReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewResponseMultiError, or nil if none found.
+func (m *CreateReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReviewResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReviewResponseValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReviewResponseValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReviewResponseValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBaseResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReviewResponseValidationError{
+					field:  "BaseResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReviewResponseValidationError{
+					field:  "BaseResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaseResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReviewResponseValidationError{
+				field:  "BaseResponse",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewResponseMultiError) AllErrors() []error { return m }
+
+// CreateReviewResponseValidationError is the validation error returned by
+// CreateReviewResponse.Validate if the designated constraints aren't met.
+type CreateReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewResponseValidationError) ErrorName() string {
+	return "CreateReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewResponseValidationError{}
+
+// Validate checks the field values on GetPOIReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPOIReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPOIReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPOIReviewsRequestMultiError, or nil if none found.
+func (m *GetPOIReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPOIReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PoiId
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIReviewsRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIReviewsRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIReviewsRequestValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIReviewsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIReviewsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIReviewsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIReviewsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIReviewsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIReviewsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPOIReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPOIReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPOIReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPOIReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPOIReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPOIReviewsRequestMultiError) AllErrors() []error { return m }
+
+// GetPOIReviewsRequestValidationError is the validation error returned by
+// GetPOIReviewsRequest.Validate if the designated constraints aren't met.
+type GetPOIReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPOIReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPOIReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPOIReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPOIReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPOIReviewsRequestValidationError) ErrorName() string {
+	return "GetPOIReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPOIReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPOIReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPOIReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPOIReviewsRequestValidationError{}
+
+// Validate checks the field values on GetPOIReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPOIReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPOIReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPOIReviewsResponseMultiError, or nil if none found.
+func (m *GetPOIReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPOIReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPOIReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPOIReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPOIReviewsResponseValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIReviewsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIReviewsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIReviewsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIReviewsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIReviewsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIReviewsResponseValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPOIReviewsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPOIReviewsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPOIReviewsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPOIReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPOIReviewsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPOIReviewsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPOIReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPOIReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPOIReviewsResponseMultiError) AllErrors() []error { return m }
+
+// GetPOIReviewsResponseValidationError is the validation error returned by
+// GetPOIReviewsResponse.Validate if the designated constraints aren't met.
+type GetPOIReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPOIReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPOIReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPOIReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPOIReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPOIReviewsResponseValidationError) ErrorName() string {
+	return "GetPOIReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPOIReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPOIReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPOIReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPOIReviewsResponseValidationError{}
+
+// Validate checks the field values on GetReviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewRequestMultiError, or nil if none found.
+func (m *GetReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewId
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by GetReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewRequestMultiError) AllErrors() []error { return m }
+
+// GetReviewRequestValidationError is the validation error returned by
+// GetReviewRequest.Validate if the designated constraints aren't met.
+type GetReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewRequestValidationError) ErrorName() string { return "GetReviewRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewRequestValidationError{}
+
+// Validate checks the field values on GetReviewResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewResponseMultiError, or nil if none found.
+func (m *GetReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewResponseValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewResponseValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewResponseValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CanEdit
+
+	// no validation rules for CanDelete
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewResponseMultiError is an error wrapping multiple validation errors
+// returned by GetReviewResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewResponseMultiError) AllErrors() []error { return m }
+
+// GetReviewResponseValidationError is the validation error returned by
+// GetReviewResponse.Validate if the designated constraints aren't met.
+type GetReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewResponseValidationError) ErrorName() string {
+	return "GetReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewResponseValidationError{}
+
+// Validate checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewRequestMultiError, or nil if none found.
+func (m *UpdateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ReviewId
+
+	if m.GetRating() != 0 {
+
+		if val := m.GetRating(); val < 1 || val > 5 {
+			err := UpdateReviewRequestValidationError{
+				field:  "Rating",
+				reason: "value must be inside range [1, 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetVisitDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReviewRequestValidationError{
+					field:  "VisitDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReviewRequestValidationError{
+					field:  "VisitDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVisitDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReviewRequestValidationError{
+				field:  "VisitDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAspects()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReviewRequestValidationError{
+					field:  "Aspects",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReviewRequestValidationError{
+					field:  "Aspects",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAspects()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReviewRequestValidationError{
+				field:  "Aspects",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReviewRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReviewRequestValidationError is the validation error returned by
+// UpdateReviewRequest.Validate if the designated constraints aren't met.
+type UpdateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewRequestValidationError) ErrorName() string {
+	return "UpdateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewRequestValidationError{}
+
+// Validate checks the field values on UpdateReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewResponseMultiError, or nil if none found.
+func (m *UpdateReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReviewResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReviewResponseValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReviewResponseValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReviewResponseValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBaseResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateReviewResponseValidationError{
+					field:  "BaseResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateReviewResponseValidationError{
+					field:  "BaseResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaseResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReviewResponseValidationError{
+				field:  "BaseResponse",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateReviewResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewResponseMultiError) AllErrors() []error { return m }
+
+// UpdateReviewResponseValidationError is the validation error returned by
+// UpdateReviewResponse.Validate if the designated constraints aren't met.
+type UpdateReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewResponseValidationError) ErrorName() string {
+	return "UpdateReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewResponseValidationError{}
+
+// Validate checks the field values on DeleteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReviewRequestMultiError, or nil if none found.
+func (m *DeleteReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ReviewId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteReviewRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReviewRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReviewRequestValidationError is the validation error returned by
+// DeleteReviewRequest.Validate if the designated constraints aren't met.
+type DeleteReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReviewRequestValidationError) ErrorName() string {
+	return "DeleteReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReviewRequestValidationError{}
+
+// Validate checks the field values on DeleteReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReviewResponseMultiError, or nil if none found.
+func (m *DeleteReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteReviewResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBaseResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteReviewResponseValidationError{
+					field:  "BaseResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteReviewResponseValidationError{
+					field:  "BaseResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaseResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteReviewResponseValidationError{
+				field:  "BaseResponse",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReviewResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteReviewResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReviewResponseMultiError) AllErrors() []error { return m }
+
+// DeleteReviewResponseValidationError is the validation error returned by
+// DeleteReviewResponse.Validate if the designated constraints aren't met.
+type DeleteReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReviewResponseValidationError) ErrorName() string {
+	return "DeleteReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReviewResponseValidationError{}
+
+// Validate checks the field values on GetUserReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserReviewsRequestMultiError, or nil if none found.
+func (m *GetUserReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserReviewsRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserReviewsRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserReviewsRequestValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserReviewsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserReviewsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserReviewsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserReviewsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserReviewsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserReviewsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserReviewsRequestMultiError) AllErrors() []error { return m }
+
+// GetUserReviewsRequestValidationError is the validation error returned by
+// GetUserReviewsRequest.Validate if the designated constraints aren't met.
+type GetUserReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserReviewsRequestValidationError) ErrorName() string {
+	return "GetUserReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserReviewsRequestValidationError{}
+
+// Validate checks the field values on GetUserReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserReviewsResponseMultiError, or nil if none found.
+func (m *GetUserReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserReviewsResponseValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserReviewsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserReviewsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserReviewsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserReviewsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserReviewsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserReviewsResponseValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserReviewsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserReviewsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserReviewsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserReviewsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUserReviewsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserReviewsResponseMultiError) AllErrors() []error { return m }
+
+// GetUserReviewsResponseValidationError is the validation error returned by
+// GetUserReviewsResponse.Validate if the designated constraints aren't met.
+type GetUserReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserReviewsResponseValidationError) ErrorName() string {
+	return "GetUserReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserReviewsResponseValidationError{}
+
+// Validate checks the field values on UserReviewStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserReviewStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserReviewStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserReviewStatisticsMultiError, or nil if none found.
+func (m *UserReviewStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserReviewStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalReviews
+
+	// no validation rules for AverageRatingGiven
+
+	// no validation rules for HelpfulVotesReceived
+
+	// no validation rules for ReviewerLevel
+
+	if len(errors) > 0 {
+		return UserReviewStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserReviewStatisticsMultiError is an error wrapping multiple validation
+// errors returned by UserReviewStatistics.ValidateAll() if the designated
+// constraints aren't met.
+type UserReviewStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserReviewStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserReviewStatisticsMultiError) AllErrors() []error { return m }
+
+// UserReviewStatisticsValidationError is the validation error returned by
+// UserReviewStatistics.Validate if the designated constraints aren't met.
+type UserReviewStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserReviewStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserReviewStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserReviewStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserReviewStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserReviewStatisticsValidationError) ErrorName() string {
+	return "UserReviewStatisticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserReviewStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserReviewStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserReviewStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserReviewStatisticsValidationError{}
+
+// Validate checks the field values on LikeReviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LikeReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LikeReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LikeReviewRequestMultiError, or nil if none found.
+func (m *LikeReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LikeReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ReviewId
+
+	// no validation rules for IsLike
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LikeReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LikeReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LikeReviewRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LikeReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LikeReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by LikeReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LikeReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LikeReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LikeReviewRequestMultiError) AllErrors() []error { return m }
+
+// LikeReviewRequestValidationError is the validation error returned by
+// LikeReviewRequest.Validate if the designated constraints aren't met.
+type LikeReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LikeReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LikeReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LikeReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LikeReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LikeReviewRequestValidationError) ErrorName() string {
+	return "LikeReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LikeReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLikeReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LikeReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LikeReviewRequestValidationError{}
+
+// Validate checks the field values on LikeReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LikeReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LikeReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LikeReviewResponseMultiError, or nil if none found.
+func (m *LikeReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LikeReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LikeReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LikeReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LikeReviewResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NewHelpfulCount
+
+	if all {
+		switch v := interface{}(m.GetBaseResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LikeReviewResponseValidationError{
+					field:  "BaseResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LikeReviewResponseValidationError{
+					field:  "BaseResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaseResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LikeReviewResponseValidationError{
+				field:  "BaseResponse",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LikeReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LikeReviewResponseMultiError is an error wrapping multiple validation errors
+// returned by LikeReviewResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LikeReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LikeReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LikeReviewResponseMultiError) AllErrors() []error { return m }
+
+// LikeReviewResponseValidationError is the validation error returned by
+// LikeReviewResponse.Validate if the designated constraints aren't met.
+type LikeReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LikeReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LikeReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LikeReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LikeReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LikeReviewResponseValidationError) ErrorName() string {
+	return "LikeReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LikeReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLikeReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LikeReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LikeReviewResponseValidationError{}
+
+// Validate checks the field values on ReportReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportReviewRequestMultiError, or nil if none found.
+func (m *ReportReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ReviewId
+
+	// no validation rules for Reason
+
+	// no validation rules for Details
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportReviewRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportReviewRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReportReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportReviewRequestMultiError) AllErrors() []error { return m }
+
+// ReportReviewRequestValidationError is the validation error returned by
+// ReportReviewRequest.Validate if the designated constraints aren't met.
+type ReportReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportReviewRequestValidationError) ErrorName() string {
+	return "ReportReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportReviewRequestValidationError{}
+
+// Validate checks the field values on ReportReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportReviewResponseMultiError, or nil if none found.
+func (m *ReportReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportReviewResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportReviewResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBaseResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportReviewResponseValidationError{
+					field:  "BaseResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportReviewResponseValidationError{
+					field:  "BaseResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaseResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportReviewResponseValidationError{
+				field:  "BaseResponse",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReportReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportReviewResponseMultiError is an error wrapping multiple validation
+// errors returned by ReportReviewResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReportReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportReviewResponseMultiError) AllErrors() []error { return m }
+
+// ReportReviewResponseValidationError is the validation error returned by
+// ReportReviewResponse.Validate if the designated constraints aren't met.
+type ReportReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportReviewResponseValidationError) ErrorName() string {
+	return "ReportReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportReviewResponseValidationError{}
+
+// Validate checks the field values on GetReviewStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewStatisticsRequestMultiError, or nil if none found.
+func (m *GetReviewStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PoiId
+
+	// no validation rules for IncludeTrends
+
+	// no validation rules for IncludeTags
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewStatisticsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReviewStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetReviewStatisticsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetReviewStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewStatisticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// GetReviewStatisticsRequestValidationError is the validation error returned
+// by GetReviewStatisticsRequest.Validate if the designated constraints aren't met.
+type GetReviewStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewStatisticsRequestValidationError) ErrorName() string {
+	return "GetReviewStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewStatisticsRequestValidationError{}
+
+// Validate checks the field values on GetReviewStatisticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewStatisticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewStatisticsResponseMultiError, or nil if none found.
+func (m *GetReviewStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewStatisticsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewStatisticsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewStatisticsResponseValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewStatisticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewStatisticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewStatisticsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReviewStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewStatisticsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetReviewStatisticsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetReviewStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewStatisticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// GetReviewStatisticsResponseValidationError is the validation error returned
+// by GetReviewStatisticsResponse.Validate if the designated constraints
+// aren't met.
+type GetReviewStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewStatisticsResponseValidationError) ErrorName() string {
+	return "GetReviewStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewStatisticsResponseValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
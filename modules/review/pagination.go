@@ -0,0 +1,27 @@
+package review
+
+import (
+	"context"
+	"iter"
+
+	"google.golang.org/protobuf/proto"
+
+	c "github.com/FACorreiaa/loci-proto/modules/review/generated"
+	"github.com/FACorreiaa/loci-proto/pagination"
+)
+
+// AllPOIReviews iterates over every review matching in, fetching them a page
+// at a time. The pagination of in is ignored in favour of opts.
+func (b *Broker) AllPOIReviews(ctx context.Context, in *c.GetPOIReviewsRequest, opts ...pagination.Option) iter.Seq2[*c.Review, error] {
+	return pagination.New(func(ctx context.Context, req pagination.Request) (pagination.Page[*c.Review], error) {
+		page := proto.CloneOf(in)
+		page.Pagination = req.Pagination()
+
+		resp, err := b.GetPOIReviews(ctx, page)
+		if err != nil {
+			return pagination.Page[*c.Review]{}, err
+		}
+
+		return pagination.PageFromResponse(resp.GetReviews(), resp.GetPagination(), req), nil
+	}, opts...).All(ctx)
+}
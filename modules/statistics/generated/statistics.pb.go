@@ -7,6 +7,8 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -1235,16 +1237,19 @@ func (x *AchievementBadges) GetAvailableBadges() []*Badge {
 }
 
 type Badge struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	IconUrl       string                 `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	EarnedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=earned_at,json=earnedAt,proto3" json:"earned_at,omitempty"`
-	Progress      int32                  `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"` // 0-100 for progress towards earning
-	Target        int32                  `protobuf:"varint,7,opt,name=target,proto3" json:"target,omitempty"`     // Target value to earn the badge
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IconUrl     string                 `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	EarnedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=earned_at,json=earnedAt,proto3" json:"earned_at,omitempty"`
+	Progress    int32                  `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"` // 0-100 for progress towards earning
+	Target      int32                  `protobuf:"varint,7,opt,name=target,proto3" json:"target,omitempty"`     // Target value to earn the badge
+	// Translations of name and description, which hold the default language
+	LocalizedName        *generated.MultilingualText `protobuf:"bytes,8,opt,name=localized_name,json=localizedName,proto3" json:"localized_name,omitempty"`
+	LocalizedDescription *generated.MultilingualText `protobuf:"bytes,9,opt,name=localized_description,json=localizedDescription,proto3" json:"localized_description,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Badge) Reset() {
@@ -1326,6 +1331,20 @@ func (x *Badge) GetTarget() int32 {
 	return 0
 }
 
+func (x *Badge) GetLocalizedName() *generated.MultilingualText {
+	if x != nil {
+		return x.LocalizedName
+	}
+	return nil
+}
+
+func (x *Badge) GetLocalizedDescription() *generated.MultilingualText {
+	if x != nil {
+		return x.LocalizedDescription
+	}
+	return nil
+}
+
 // System-wide analytics (admin only)
 type SystemAnalytics struct {
 	state                  protoimpl.MessageState  `protogen:"open.v1"`
@@ -2443,7 +2462,7 @@ type GetMainPageStatisticsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IncludeTrends bool                   `protobuf:"varint,1,opt,name=include_trends,json=includeTrends,proto3" json:"include_trends,omitempty"`
 	TimeRange     string                 `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"` // "24h", "7d", "30d"
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2492,7 +2511,7 @@ func (x *GetMainPageStatisticsRequest) GetTimeRange() string {
 	return ""
 }
 
-func (x *GetMainPageStatisticsRequest) GetRequest() *BaseRequest {
+func (x *GetMainPageStatisticsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2500,9 +2519,9 @@ func (x *GetMainPageStatisticsRequest) GetRequest() *BaseRequest {
 }
 
 type GetMainPageStatisticsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statistics    *MainPageStatistics    `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Statistics    *MainPageStatistics     `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2544,7 +2563,7 @@ func (x *GetMainPageStatisticsResponse) GetStatistics() *MainPageStatistics {
 	return nil
 }
 
-func (x *GetMainPageStatisticsResponse) GetResponse() *BaseResponse {
+func (x *GetMainPageStatisticsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2555,7 +2574,7 @@ type StreamMainPageStatisticsRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	UpdateIntervalSeconds int32                  `protobuf:"varint,1,opt,name=update_interval_seconds,json=updateIntervalSeconds,proto3" json:"update_interval_seconds,omitempty"` // Default: 30 seconds
 	MetricFilters         []string               `protobuf:"bytes,2,rep,name=metric_filters,json=metricFilters,proto3" json:"metric_filters,omitempty"`                            // Which metrics to include
-	Request               *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request               *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -2604,7 +2623,7 @@ func (x *StreamMainPageStatisticsRequest) GetMetricFilters() []string {
 	return nil
 }
 
-func (x *StreamMainPageStatisticsRequest) GetRequest() *BaseRequest {
+func (x *StreamMainPageStatisticsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2616,7 +2635,7 @@ type GetDetailedPOIStatisticsRequest struct {
 	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	TimeRange          string                 `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"` // "7d", "30d", "90d", "1y"
 	IncludePredictions bool                   `protobuf:"varint,3,opt,name=include_predictions,json=includePredictions,proto3" json:"include_predictions,omitempty"`
-	Request            *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request            *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -2672,7 +2691,7 @@ func (x *GetDetailedPOIStatisticsRequest) GetIncludePredictions() bool {
 	return false
 }
 
-func (x *GetDetailedPOIStatisticsRequest) GetRequest() *BaseRequest {
+func (x *GetDetailedPOIStatisticsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2680,10 +2699,10 @@ func (x *GetDetailedPOIStatisticsRequest) GetRequest() *BaseRequest {
 }
 
 type GetDetailedPOIStatisticsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statistics    *DetailedPOIStatistics `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
-	Predictions   []*Prediction          `protobuf:"bytes,2,rep,name=predictions,proto3" json:"predictions,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Statistics    *DetailedPOIStatistics  `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Predictions   []*Prediction           `protobuf:"bytes,2,rep,name=predictions,proto3" json:"predictions,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2732,7 +2751,7 @@ func (x *GetDetailedPOIStatisticsResponse) GetPredictions() []*Prediction {
 	return nil
 }
 
-func (x *GetDetailedPOIStatisticsResponse) GetResponse() *BaseResponse {
+func (x *GetDetailedPOIStatisticsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2810,7 +2829,7 @@ func (x *Prediction) GetTimeHorizon() string {
 type GetLandingPageStatisticsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2852,7 +2871,7 @@ func (x *GetLandingPageStatisticsRequest) GetUserId() string {
 	return ""
 }
 
-func (x *GetLandingPageStatisticsRequest) GetRequest() *BaseRequest {
+func (x *GetLandingPageStatisticsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2860,9 +2879,9 @@ func (x *GetLandingPageStatisticsRequest) GetRequest() *BaseRequest {
 }
 
 type GetLandingPageStatisticsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statistics    *LandingPageUserStats  `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Statistics    *LandingPageUserStats   `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2904,7 +2923,7 @@ func (x *GetLandingPageStatisticsResponse) GetStatistics() *LandingPageUserStats
 	return nil
 }
 
-func (x *GetLandingPageStatisticsResponse) GetResponse() *BaseResponse {
+func (x *GetLandingPageStatisticsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2917,7 +2936,7 @@ type GetUserActivityAnalyticsRequest struct {
 	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	Granularity   string                 `protobuf:"bytes,4,opt,name=granularity,proto3" json:"granularity,omitempty"` // "hour", "day", "week", "month"
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2980,7 +2999,7 @@ func (x *GetUserActivityAnalyticsRequest) GetGranularity() string {
 	return ""
 }
 
-func (x *GetUserActivityAnalyticsRequest) GetRequest() *BaseRequest {
+func (x *GetUserActivityAnalyticsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2988,10 +3007,10 @@ func (x *GetUserActivityAnalyticsRequest) GetRequest() *BaseRequest {
 }
 
 type GetUserActivityAnalyticsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActivityData  []*ActivityDataPoint   `protobuf:"bytes,1,rep,name=activity_data,json=activityData,proto3" json:"activity_data,omitempty"`
-	Summary       *ActivitySummary       `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ActivityData  []*ActivityDataPoint    `protobuf:"bytes,1,rep,name=activity_data,json=activityData,proto3" json:"activity_data,omitempty"`
+	Summary       *ActivitySummary        `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3040,7 +3059,7 @@ func (x *GetUserActivityAnalyticsResponse) GetSummary() *ActivitySummary {
 	return nil
 }
 
-func (x *GetUserActivityAnalyticsResponse) GetResponse() *BaseResponse {
+func (x *GetUserActivityAnalyticsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -3203,7 +3222,7 @@ type GetSystemAnalyticsRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	TimeRange        string                 `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`                      // "24h", "7d", "30d"
 	MetricCategories []string               `protobuf:"bytes,2,rep,name=metric_categories,json=metricCategories,proto3" json:"metric_categories,omitempty"` // "users", "performance", "errors", etc.
-	Request          *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request          *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -3252,7 +3271,7 @@ func (x *GetSystemAnalyticsRequest) GetMetricCategories() []string {
 	return nil
 }
 
-func (x *GetSystemAnalyticsRequest) GetRequest() *BaseRequest {
+func (x *GetSystemAnalyticsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -3260,9 +3279,9 @@ func (x *GetSystemAnalyticsRequest) GetRequest() *BaseRequest {
 }
 
 type GetSystemAnalyticsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Analytics     *SystemAnalytics       `protobuf:"bytes,1,opt,name=analytics,proto3" json:"analytics,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Analytics     *SystemAnalytics        `protobuf:"bytes,1,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3304,13 +3323,17 @@ func (x *GetSystemAnalyticsResponse) GetAnalytics() *SystemAnalytics {
 	return nil
 }
 
-func (x *GetSystemAnalyticsResponse) GetResponse() *BaseResponse {
+func (x *GetSystemAnalyticsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in statistics.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -3363,6 +3386,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in statistics.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -3427,7 +3454,7 @@ var File_statistics_proto protoreflect.FileDescriptor
 
 const file_statistics_proto_rawDesc = "" +
 	"\n" +
-	"\x10statistics.proto\x12\x14ai_poi.statistics.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xca\x04\n" +
+	"\x10statistics.proto\x12\x14ai_poi.statistics.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xca\x04\n" +
 	"\x12MainPageStatistics\x12\x1d\n" +
 	"\n" +
 	"total_pois\x18\x01 \x01(\x03R\ttotalPois\x12!\n" +
@@ -3493,9 +3520,9 @@ const file_statistics_proto_rawDesc = "" +
 	"\x12TimeBasedAnalytics\x12M\n" +
 	"\x0fhourly_activity\x18\x01 \x03(\v2$.ai_poi.statistics.v1.HourlyActivityR\x0ehourlyActivity\x12J\n" +
 	"\x0edaily_activity\x18\x02 \x03(\v2#.ai_poi.statistics.v1.DailyActivityR\rdailyActivity\x12P\n" +
-	"\x10monthly_activity\x18\x03 \x03(\v2%.ai_poi.statistics.v1.MonthlyActivityR\x0fmonthlyActivity\"K\n" +
-	"\x0eHourlyActivity\x12\x12\n" +
-	"\x04hour\x18\x01 \x01(\x05R\x04hour\x12%\n" +
+	"\x10monthly_activity\x18\x03 \x03(\v2%.ai_poi.statistics.v1.MonthlyActivityR\x0fmonthlyActivity\"V\n" +
+	"\x0eHourlyActivity\x12\x1d\n" +
+	"\x04hour\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x17(\x00R\x04hour\x12%\n" +
 	"\x0eactivity_count\x18\x02 \x01(\x05R\ractivityCount\"\xb5\x01\n" +
 	"\rDailyActivity\x12.\n" +
 	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1a\n" +
@@ -3531,15 +3558,17 @@ const file_statistics_proto_rawDesc = "" +
 	"\x15recommendation_reason\x18\x03 \x01(\tR\x14recommendationReason\"\x9d\x01\n" +
 	"\x11AchievementBadges\x12@\n" +
 	"\rearned_badges\x18\x01 \x03(\v2\x1b.ai_poi.statistics.v1.BadgeR\fearnedBadges\x12F\n" +
-	"\x10available_badges\x18\x02 \x03(\v2\x1b.ai_poi.statistics.v1.BadgeR\x0favailableBadges\"\xd5\x01\n" +
+	"\x10available_badges\x18\x02 \x03(\v2\x1b.ai_poi.statistics.v1.BadgeR\x0favailableBadges\"\x84\x03\n" +
 	"\x05Badge\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
 	"\bicon_url\x18\x04 \x01(\tR\aiconUrl\x127\n" +
-	"\tearned_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bearnedAt\x12\x1a\n" +
-	"\bprogress\x18\x06 \x01(\x05R\bprogress\x12\x16\n" +
-	"\x06target\x18\a \x01(\x05R\x06target\"\xb7\x04\n" +
+	"\tearned_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bearnedAt\x12%\n" +
+	"\bprogress\x18\x06 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bprogress\x12\x16\n" +
+	"\x06target\x18\a \x01(\x05R\x06target\x12I\n" +
+	"\x0elocalized_name\x18\b \x01(\v2\".ai_poi.common.v1.MultilingualTextR\rlocalizedName\x12W\n" +
+	"\x15localized_description\x18\t \x01(\v2\".ai_poi.common.v1.MultilingualTextR\x14localizedDescription\"\xb7\x04\n" +
 	"\x0fSystemAnalytics\x12H\n" +
 	"\vuser_growth\x18\x01 \x01(\v2'.ai_poi.statistics.v1.UserGrowthMetricsR\n" +
 	"userGrowth\x12G\n" +
@@ -3592,9 +3621,10 @@ const file_statistics_proto_rawDesc = "" +
 	"\x16GeographicDistribution\x12G\n" +
 	"\rcountry_stats\x18\x01 \x03(\v2\".ai_poi.statistics.v1.CountryStatsR\fcountryStats\x12>\n" +
 	"\n" +
-	"city_stats\x18\x02 \x03(\v2\x1f.ai_poi.statistics.v1.CityStatsR\tcityStats\"\xc6\x01\n" +
-	"\fCountryStats\x12!\n" +
-	"\fcountry_code\x18\x01 \x01(\tR\vcountryCode\x12!\n" +
+	"city_stats\x18\x02 \x03(\v2\x1f.ai_poi.statistics.v1.CityStatsR\tcityStats\"\xdc\x01\n" +
+	"\fCountryStats\x127\n" +
+	"\fcountry_code\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f2\n" +
+	"^[A-Z]{2}$\xd0\x01\x01R\vcountryCode\x12!\n" +
 	"\fcountry_name\x18\x02 \x01(\tR\vcountryName\x12\x1d\n" +
 	"\n" +
 	"user_count\x18\x03 \x01(\x05R\tuserCount\x12!\n" +
@@ -3642,58 +3672,58 @@ const file_statistics_proto_rawDesc = "" +
 	"\adetails\x18\x04 \x03(\v2..ai_poi.statistics.v1.SystemAlert.DetailsEntryR\adetails\x1a:\n" +
 	"\fDetailsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa1\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9d\x01\n" +
 	"\x1cGetMainPageStatisticsRequest\x12%\n" +
 	"\x0einclude_trends\x18\x01 \x01(\bR\rincludeTrends\x12\x1d\n" +
 	"\n" +
-	"time_range\x18\x02 \x01(\tR\ttimeRange\x12;\n" +
-	"\arequest\x18d \x01(\v2!.ai_poi.statistics.v1.BaseRequestR\arequest\"\xa9\x01\n" +
+	"time_range\x18\x02 \x01(\tR\ttimeRange\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xa5\x01\n" +
 	"\x1dGetMainPageStatisticsResponse\x12H\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2(.ai_poi.statistics.v1.MainPageStatisticsR\n" +
-	"statistics\x12>\n" +
-	"\bresponse\x18d \x01(\v2\".ai_poi.statistics.v1.BaseResponseR\bresponse\"\xbd\x01\n" +
+	"statistics\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb9\x01\n" +
 	"\x1fStreamMainPageStatisticsRequest\x126\n" +
 	"\x17update_interval_seconds\x18\x01 \x01(\x05R\x15updateIntervalSeconds\x12%\n" +
-	"\x0emetric_filters\x18\x02 \x03(\tR\rmetricFilters\x12;\n" +
-	"\arequest\x18d \x01(\v2!.ai_poi.statistics.v1.BaseRequestR\arequest\"\xc7\x01\n" +
+	"\x0emetric_filters\x18\x02 \x03(\tR\rmetricFilters\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc3\x01\n" +
 	"\x1fGetDetailedPOIStatisticsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"time_range\x18\x02 \x01(\tR\ttimeRange\x12/\n" +
-	"\x13include_predictions\x18\x03 \x01(\bR\x12includePredictions\x12;\n" +
-	"\arequest\x18d \x01(\v2!.ai_poi.statistics.v1.BaseRequestR\arequest\"\xf3\x01\n" +
+	"\x13include_predictions\x18\x03 \x01(\bR\x12includePredictions\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xef\x01\n" +
 	" GetDetailedPOIStatisticsResponse\x12K\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2+.ai_poi.statistics.v1.DetailedPOIStatisticsR\n" +
 	"statistics\x12B\n" +
-	"\vpredictions\x18\x02 \x03(\v2 .ai_poi.statistics.v1.PredictionR\vpredictions\x12>\n" +
-	"\bresponse\x18d \x01(\v2\".ai_poi.statistics.v1.BaseResponseR\bresponse\"\x9b\x01\n" +
+	"\vpredictions\x18\x02 \x03(\v2 .ai_poi.statistics.v1.PredictionR\vpredictions\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x9b\x01\n" +
 	"\n" +
 	"Prediction\x12\x16\n" +
 	"\x06metric\x18\x01 \x01(\tR\x06metric\x12'\n" +
 	"\x0fpredicted_value\x18\x02 \x01(\x01R\x0epredictedValue\x12)\n" +
 	"\x10confidence_level\x18\x03 \x01(\tR\x0fconfidenceLevel\x12!\n" +
-	"\ftime_horizon\x18\x04 \x01(\tR\vtimeHorizon\"w\n" +
+	"\ftime_horizon\x18\x04 \x01(\tR\vtimeHorizon\"s\n" +
 	"\x1fGetLandingPageStatisticsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
-	"\arequest\x18d \x01(\v2!.ai_poi.statistics.v1.BaseRequestR\arequest\"\xae\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xaa\x01\n" +
 	" GetLandingPageStatisticsResponse\x12J\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2*.ai_poi.statistics.v1.LandingPageUserStatsR\n" +
-	"statistics\x12>\n" +
-	"\bresponse\x18d \x01(\v2\".ai_poi.statistics.v1.BaseResponseR\bresponse\"\x8b\x02\n" +
+	"statistics\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x87\x02\n" +
 	"\x1fGetUserActivityAnalyticsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
 	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12 \n" +
-	"\vgranularity\x18\x04 \x01(\tR\vgranularity\x12;\n" +
-	"\arequest\x18d \x01(\v2!.ai_poi.statistics.v1.BaseRequestR\arequest\"\xf1\x01\n" +
+	"\vgranularity\x18\x04 \x01(\tR\vgranularity\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xed\x01\n" +
 	" GetUserActivityAnalyticsResponse\x12L\n" +
 	"\ractivity_data\x18\x01 \x03(\v2'.ai_poi.statistics.v1.ActivityDataPointR\factivityData\x12?\n" +
-	"\asummary\x18\x02 \x01(\v2%.ai_poi.statistics.v1.ActivitySummaryR\asummary\x12>\n" +
-	"\bresponse\x18d \x01(\v2\".ai_poi.statistics.v1.BaseResponseR\bresponse\"\xd9\x01\n" +
+	"\asummary\x18\x02 \x01(\v2%.ai_poi.statistics.v1.ActivitySummaryR\asummary\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xd9\x01\n" +
 	"\x11ActivityDataPoint\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1a\n" +
 	"\bsearches\x18\x02 \x01(\x05R\bsearches\x12\x1c\n" +
@@ -3705,26 +3735,26 @@ const file_statistics_proto_rawDesc = "" +
 	"\x0ftotal_favorites\x18\x02 \x01(\x05R\x0etotalFavorites\x126\n" +
 	"\x17total_itinerary_actions\x18\x03 \x01(\x05R\x15totalItineraryActions\x12(\n" +
 	"\x10most_active_hour\x18\x04 \x01(\x05R\x0emostActiveHour\x12&\n" +
-	"\x0fmost_active_day\x18\x05 \x01(\tR\rmostActiveDay\"\xa4\x01\n" +
+	"\x0fmost_active_day\x18\x05 \x01(\tR\rmostActiveDay\"\xa0\x01\n" +
 	"\x19GetSystemAnalyticsRequest\x12\x1d\n" +
 	"\n" +
 	"time_range\x18\x01 \x01(\tR\ttimeRange\x12+\n" +
-	"\x11metric_categories\x18\x02 \x03(\tR\x10metricCategories\x12;\n" +
-	"\arequest\x18d \x01(\v2!.ai_poi.statistics.v1.BaseRequestR\arequest\"\xa1\x01\n" +
+	"\x11metric_categories\x18\x02 \x03(\tR\x10metricCategories\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x9d\x01\n" +
 	"\x1aGetSystemAnalyticsResponse\x12C\n" +
-	"\tanalytics\x18\x01 \x01(\v2%.ai_poi.statistics.v1.SystemAnalyticsR\tanalytics\x12>\n" +
-	"\bresponse\x18d \x01(\v2\".ai_poi.statistics.v1.BaseResponseR\bresponse\"N\n" +
+	"\tanalytics\x18\x01 \x01(\v2%.ai_poi.statistics.v1.SystemAnalyticsR\tanalytics\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status2\xaf\x06\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x012\xaf\x06\n" +
 	"\x11StatisticsService\x12\x80\x01\n" +
 	"\x15GetMainPageStatistics\x122.ai_poi.statistics.v1.GetMainPageStatisticsRequest\x1a3.ai_poi.statistics.v1.GetMainPageStatisticsResponse\x12z\n" +
 	"\x18StreamMainPageStatistics\x125.ai_poi.statistics.v1.StreamMainPageStatisticsRequest\x1a%.ai_poi.statistics.v1.StatisticsEvent0\x01\x12\x89\x01\n" +
@@ -3797,6 +3827,9 @@ var file_statistics_proto_goTypes = []any{
 	nil,                                      // 47: ai_poi.statistics.v1.RecentInteraction.MetadataEntry
 	nil,                                      // 48: ai_poi.statistics.v1.SystemAlert.DetailsEntry
 	(*timestamppb.Timestamp)(nil),            // 49: google.protobuf.Timestamp
+	(*generated.MultilingualText)(nil),       // 50: ai_poi.common.v1.MultilingualText
+	(*generated.BaseRequest)(nil),            // 51: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),           // 52: ai_poi.common.v1.BaseResponse
 }
 var file_statistics_proto_depIdxs = []int32{
 	1,  // 0: ai_poi.statistics.v1.MainPageStatistics.recent_activity:type_name -> ai_poi.statistics.v1.RecentActivity
@@ -3821,60 +3854,62 @@ var file_statistics_proto_depIdxs = []int32{
 	16, // 19: ai_poi.statistics.v1.AchievementBadges.earned_badges:type_name -> ai_poi.statistics.v1.Badge
 	16, // 20: ai_poi.statistics.v1.AchievementBadges.available_badges:type_name -> ai_poi.statistics.v1.Badge
 	49, // 21: ai_poi.statistics.v1.Badge.earned_at:type_name -> google.protobuf.Timestamp
-	18, // 22: ai_poi.statistics.v1.SystemAnalytics.user_growth:type_name -> ai_poi.statistics.v1.UserGrowthMetrics
-	19, // 23: ai_poi.statistics.v1.SystemAnalytics.usage_metrics:type_name -> ai_poi.statistics.v1.UsageMetrics
-	20, // 24: ai_poi.statistics.v1.SystemAnalytics.performance_metrics:type_name -> ai_poi.statistics.v1.PerformanceMetrics
-	21, // 25: ai_poi.statistics.v1.SystemAnalytics.error_metrics:type_name -> ai_poi.statistics.v1.ErrorMetrics
-	23, // 26: ai_poi.statistics.v1.SystemAnalytics.geographic_distribution:type_name -> ai_poi.statistics.v1.GeographicDistribution
-	26, // 27: ai_poi.statistics.v1.SystemAnalytics.feature_usage:type_name -> ai_poi.statistics.v1.FeatureUsage
-	49, // 28: ai_poi.statistics.v1.SystemAnalytics.generated_at:type_name -> google.protobuf.Timestamp
-	22, // 29: ai_poi.statistics.v1.ErrorMetrics.error_breakdown:type_name -> ai_poi.statistics.v1.ErrorBreakdown
-	24, // 30: ai_poi.statistics.v1.GeographicDistribution.country_stats:type_name -> ai_poi.statistics.v1.CountryStats
-	25, // 31: ai_poi.statistics.v1.GeographicDistribution.city_stats:type_name -> ai_poi.statistics.v1.CityStats
-	27, // 32: ai_poi.statistics.v1.FeatureUsage.feature_metrics:type_name -> ai_poi.statistics.v1.FeatureMetric
-	49, // 33: ai_poi.statistics.v1.StatisticsEvent.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 34: ai_poi.statistics.v1.StatisticsEvent.main_stats:type_name -> ai_poi.statistics.v1.MainPageStatistics
-	29, // 35: ai_poi.statistics.v1.StatisticsEvent.metric_update:type_name -> ai_poi.statistics.v1.MetricUpdate
-	30, // 36: ai_poi.statistics.v1.StatisticsEvent.system_alert:type_name -> ai_poi.statistics.v1.SystemAlert
-	48, // 37: ai_poi.statistics.v1.SystemAlert.details:type_name -> ai_poi.statistics.v1.SystemAlert.DetailsEntry
-	45, // 38: ai_poi.statistics.v1.GetMainPageStatisticsRequest.request:type_name -> ai_poi.statistics.v1.BaseRequest
-	0,  // 39: ai_poi.statistics.v1.GetMainPageStatisticsResponse.statistics:type_name -> ai_poi.statistics.v1.MainPageStatistics
-	46, // 40: ai_poi.statistics.v1.GetMainPageStatisticsResponse.response:type_name -> ai_poi.statistics.v1.BaseResponse
-	45, // 41: ai_poi.statistics.v1.StreamMainPageStatisticsRequest.request:type_name -> ai_poi.statistics.v1.BaseRequest
-	45, // 42: ai_poi.statistics.v1.GetDetailedPOIStatisticsRequest.request:type_name -> ai_poi.statistics.v1.BaseRequest
-	5,  // 43: ai_poi.statistics.v1.GetDetailedPOIStatisticsResponse.statistics:type_name -> ai_poi.statistics.v1.DetailedPOIStatistics
-	36, // 44: ai_poi.statistics.v1.GetDetailedPOIStatisticsResponse.predictions:type_name -> ai_poi.statistics.v1.Prediction
-	46, // 45: ai_poi.statistics.v1.GetDetailedPOIStatisticsResponse.response:type_name -> ai_poi.statistics.v1.BaseResponse
-	45, // 46: ai_poi.statistics.v1.GetLandingPageStatisticsRequest.request:type_name -> ai_poi.statistics.v1.BaseRequest
-	12, // 47: ai_poi.statistics.v1.GetLandingPageStatisticsResponse.statistics:type_name -> ai_poi.statistics.v1.LandingPageUserStats
-	46, // 48: ai_poi.statistics.v1.GetLandingPageStatisticsResponse.response:type_name -> ai_poi.statistics.v1.BaseResponse
-	49, // 49: ai_poi.statistics.v1.GetUserActivityAnalyticsRequest.start_date:type_name -> google.protobuf.Timestamp
-	49, // 50: ai_poi.statistics.v1.GetUserActivityAnalyticsRequest.end_date:type_name -> google.protobuf.Timestamp
-	45, // 51: ai_poi.statistics.v1.GetUserActivityAnalyticsRequest.request:type_name -> ai_poi.statistics.v1.BaseRequest
-	41, // 52: ai_poi.statistics.v1.GetUserActivityAnalyticsResponse.activity_data:type_name -> ai_poi.statistics.v1.ActivityDataPoint
-	42, // 53: ai_poi.statistics.v1.GetUserActivityAnalyticsResponse.summary:type_name -> ai_poi.statistics.v1.ActivitySummary
-	46, // 54: ai_poi.statistics.v1.GetUserActivityAnalyticsResponse.response:type_name -> ai_poi.statistics.v1.BaseResponse
-	49, // 55: ai_poi.statistics.v1.ActivityDataPoint.timestamp:type_name -> google.protobuf.Timestamp
-	45, // 56: ai_poi.statistics.v1.GetSystemAnalyticsRequest.request:type_name -> ai_poi.statistics.v1.BaseRequest
-	17, // 57: ai_poi.statistics.v1.GetSystemAnalyticsResponse.analytics:type_name -> ai_poi.statistics.v1.SystemAnalytics
-	46, // 58: ai_poi.statistics.v1.GetSystemAnalyticsResponse.response:type_name -> ai_poi.statistics.v1.BaseResponse
-	31, // 59: ai_poi.statistics.v1.StatisticsService.GetMainPageStatistics:input_type -> ai_poi.statistics.v1.GetMainPageStatisticsRequest
-	33, // 60: ai_poi.statistics.v1.StatisticsService.StreamMainPageStatistics:input_type -> ai_poi.statistics.v1.StreamMainPageStatisticsRequest
-	34, // 61: ai_poi.statistics.v1.StatisticsService.GetDetailedPOIStatistics:input_type -> ai_poi.statistics.v1.GetDetailedPOIStatisticsRequest
-	37, // 62: ai_poi.statistics.v1.StatisticsService.GetLandingPageStatistics:input_type -> ai_poi.statistics.v1.GetLandingPageStatisticsRequest
-	39, // 63: ai_poi.statistics.v1.StatisticsService.GetUserActivityAnalytics:input_type -> ai_poi.statistics.v1.GetUserActivityAnalyticsRequest
-	43, // 64: ai_poi.statistics.v1.StatisticsService.GetSystemAnalytics:input_type -> ai_poi.statistics.v1.GetSystemAnalyticsRequest
-	32, // 65: ai_poi.statistics.v1.StatisticsService.GetMainPageStatistics:output_type -> ai_poi.statistics.v1.GetMainPageStatisticsResponse
-	28, // 66: ai_poi.statistics.v1.StatisticsService.StreamMainPageStatistics:output_type -> ai_poi.statistics.v1.StatisticsEvent
-	35, // 67: ai_poi.statistics.v1.StatisticsService.GetDetailedPOIStatistics:output_type -> ai_poi.statistics.v1.GetDetailedPOIStatisticsResponse
-	38, // 68: ai_poi.statistics.v1.StatisticsService.GetLandingPageStatistics:output_type -> ai_poi.statistics.v1.GetLandingPageStatisticsResponse
-	40, // 69: ai_poi.statistics.v1.StatisticsService.GetUserActivityAnalytics:output_type -> ai_poi.statistics.v1.GetUserActivityAnalyticsResponse
-	44, // 70: ai_poi.statistics.v1.StatisticsService.GetSystemAnalytics:output_type -> ai_poi.statistics.v1.GetSystemAnalyticsResponse
-	65, // [65:71] is the sub-list for method output_type
-	59, // [59:65] is the sub-list for method input_type
-	59, // [59:59] is the sub-list for extension type_name
-	59, // [59:59] is the sub-list for extension extendee
-	0,  // [0:59] is the sub-list for field type_name
+	50, // 22: ai_poi.statistics.v1.Badge.localized_name:type_name -> ai_poi.common.v1.MultilingualText
+	50, // 23: ai_poi.statistics.v1.Badge.localized_description:type_name -> ai_poi.common.v1.MultilingualText
+	18, // 24: ai_poi.statistics.v1.SystemAnalytics.user_growth:type_name -> ai_poi.statistics.v1.UserGrowthMetrics
+	19, // 25: ai_poi.statistics.v1.SystemAnalytics.usage_metrics:type_name -> ai_poi.statistics.v1.UsageMetrics
+	20, // 26: ai_poi.statistics.v1.SystemAnalytics.performance_metrics:type_name -> ai_poi.statistics.v1.PerformanceMetrics
+	21, // 27: ai_poi.statistics.v1.SystemAnalytics.error_metrics:type_name -> ai_poi.statistics.v1.ErrorMetrics
+	23, // 28: ai_poi.statistics.v1.SystemAnalytics.geographic_distribution:type_name -> ai_poi.statistics.v1.GeographicDistribution
+	26, // 29: ai_poi.statistics.v1.SystemAnalytics.feature_usage:type_name -> ai_poi.statistics.v1.FeatureUsage
+	49, // 30: ai_poi.statistics.v1.SystemAnalytics.generated_at:type_name -> google.protobuf.Timestamp
+	22, // 31: ai_poi.statistics.v1.ErrorMetrics.error_breakdown:type_name -> ai_poi.statistics.v1.ErrorBreakdown
+	24, // 32: ai_poi.statistics.v1.GeographicDistribution.country_stats:type_name -> ai_poi.statistics.v1.CountryStats
+	25, // 33: ai_poi.statistics.v1.GeographicDistribution.city_stats:type_name -> ai_poi.statistics.v1.CityStats
+	27, // 34: ai_poi.statistics.v1.FeatureUsage.feature_metrics:type_name -> ai_poi.statistics.v1.FeatureMetric
+	49, // 35: ai_poi.statistics.v1.StatisticsEvent.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 36: ai_poi.statistics.v1.StatisticsEvent.main_stats:type_name -> ai_poi.statistics.v1.MainPageStatistics
+	29, // 37: ai_poi.statistics.v1.StatisticsEvent.metric_update:type_name -> ai_poi.statistics.v1.MetricUpdate
+	30, // 38: ai_poi.statistics.v1.StatisticsEvent.system_alert:type_name -> ai_poi.statistics.v1.SystemAlert
+	48, // 39: ai_poi.statistics.v1.SystemAlert.details:type_name -> ai_poi.statistics.v1.SystemAlert.DetailsEntry
+	51, // 40: ai_poi.statistics.v1.GetMainPageStatisticsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 41: ai_poi.statistics.v1.GetMainPageStatisticsResponse.statistics:type_name -> ai_poi.statistics.v1.MainPageStatistics
+	52, // 42: ai_poi.statistics.v1.GetMainPageStatisticsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	51, // 43: ai_poi.statistics.v1.StreamMainPageStatisticsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	51, // 44: ai_poi.statistics.v1.GetDetailedPOIStatisticsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	5,  // 45: ai_poi.statistics.v1.GetDetailedPOIStatisticsResponse.statistics:type_name -> ai_poi.statistics.v1.DetailedPOIStatistics
+	36, // 46: ai_poi.statistics.v1.GetDetailedPOIStatisticsResponse.predictions:type_name -> ai_poi.statistics.v1.Prediction
+	52, // 47: ai_poi.statistics.v1.GetDetailedPOIStatisticsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	51, // 48: ai_poi.statistics.v1.GetLandingPageStatisticsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	12, // 49: ai_poi.statistics.v1.GetLandingPageStatisticsResponse.statistics:type_name -> ai_poi.statistics.v1.LandingPageUserStats
+	52, // 50: ai_poi.statistics.v1.GetLandingPageStatisticsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 51: ai_poi.statistics.v1.GetUserActivityAnalyticsRequest.start_date:type_name -> google.protobuf.Timestamp
+	49, // 52: ai_poi.statistics.v1.GetUserActivityAnalyticsRequest.end_date:type_name -> google.protobuf.Timestamp
+	51, // 53: ai_poi.statistics.v1.GetUserActivityAnalyticsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	41, // 54: ai_poi.statistics.v1.GetUserActivityAnalyticsResponse.activity_data:type_name -> ai_poi.statistics.v1.ActivityDataPoint
+	42, // 55: ai_poi.statistics.v1.GetUserActivityAnalyticsResponse.summary:type_name -> ai_poi.statistics.v1.ActivitySummary
+	52, // 56: ai_poi.statistics.v1.GetUserActivityAnalyticsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	49, // 57: ai_poi.statistics.v1.ActivityDataPoint.timestamp:type_name -> google.protobuf.Timestamp
+	51, // 58: ai_poi.statistics.v1.GetSystemAnalyticsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	17, // 59: ai_poi.statistics.v1.GetSystemAnalyticsResponse.analytics:type_name -> ai_poi.statistics.v1.SystemAnalytics
+	52, // 60: ai_poi.statistics.v1.GetSystemAnalyticsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	31, // 61: ai_poi.statistics.v1.StatisticsService.GetMainPageStatistics:input_type -> ai_poi.statistics.v1.GetMainPageStatisticsRequest
+	33, // 62: ai_poi.statistics.v1.StatisticsService.StreamMainPageStatistics:input_type -> ai_poi.statistics.v1.StreamMainPageStatisticsRequest
+	34, // 63: ai_poi.statistics.v1.StatisticsService.GetDetailedPOIStatistics:input_type -> ai_poi.statistics.v1.GetDetailedPOIStatisticsRequest
+	37, // 64: ai_poi.statistics.v1.StatisticsService.GetLandingPageStatistics:input_type -> ai_poi.statistics.v1.GetLandingPageStatisticsRequest
+	39, // 65: ai_poi.statistics.v1.StatisticsService.GetUserActivityAnalytics:input_type -> ai_poi.statistics.v1.GetUserActivityAnalyticsRequest
+	43, // 66: ai_poi.statistics.v1.StatisticsService.GetSystemAnalytics:input_type -> ai_poi.statistics.v1.GetSystemAnalyticsRequest
+	32, // 67: ai_poi.statistics.v1.StatisticsService.GetMainPageStatistics:output_type -> ai_poi.statistics.v1.GetMainPageStatisticsResponse
+	28, // 68: ai_poi.statistics.v1.StatisticsService.StreamMainPageStatistics:output_type -> ai_poi.statistics.v1.StatisticsEvent
+	35, // 69: ai_poi.statistics.v1.StatisticsService.GetDetailedPOIStatistics:output_type -> ai_poi.statistics.v1.GetDetailedPOIStatisticsResponse
+	38, // 70: ai_poi.statistics.v1.StatisticsService.GetLandingPageStatistics:output_type -> ai_poi.statistics.v1.GetLandingPageStatisticsResponse
+	40, // 71: ai_poi.statistics.v1.StatisticsService.GetUserActivityAnalytics:output_type -> ai_poi.statistics.v1.GetUserActivityAnalyticsResponse
+	44, // 72: ai_poi.statistics.v1.StatisticsService.GetSystemAnalytics:output_type -> ai_poi.statistics.v1.GetSystemAnalyticsResponse
+	67, // [67:73] is the sub-list for method output_type
+	61, // [61:67] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_statistics_proto_init() }
@@ -0,0 +1,6934 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: statistics.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on MainPageStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MainPageStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MainPageStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MainPageStatisticsMultiError, or nil if none found.
+func (m *MainPageStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MainPageStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalPois
+
+	// no validation rules for TotalCities
+
+	// no validation rules for TotalUsers
+
+	// no validation rules for TotalItineraries
+
+	// no validation rules for TotalSearchesToday
+
+	// no validation rules for ActiveUsersToday
+
+	if all {
+		switch v := interface{}(m.GetRecentActivity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MainPageStatisticsValidationError{
+					field:  "RecentActivity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MainPageStatisticsValidationError{
+					field:  "RecentActivity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecentActivity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MainPageStatisticsValidationError{
+				field:  "RecentActivity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPopularDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MainPageStatisticsValidationError{
+						field:  fmt.Sprintf("PopularDestinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MainPageStatisticsValidationError{
+						field:  fmt.Sprintf("PopularDestinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MainPageStatisticsValidationError{
+					field:  fmt.Sprintf("PopularDestinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTrendingCategories()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MainPageStatisticsValidationError{
+					field:  "TrendingCategories",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MainPageStatisticsValidationError{
+					field:  "TrendingCategories",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTrendingCategories()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MainPageStatisticsValidationError{
+				field:  "TrendingCategories",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUpdated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MainPageStatisticsValidationError{
+					field:  "LastUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MainPageStatisticsValidationError{
+					field:  "LastUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUpdated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MainPageStatisticsValidationError{
+				field:  "LastUpdated",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MainPageStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MainPageStatisticsMultiError is an error wrapping multiple validation errors
+// returned by MainPageStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type MainPageStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MainPageStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MainPageStatisticsMultiError) AllErrors() []error { return m }
+
+// MainPageStatisticsValidationError is the validation error returned by
+// MainPageStatistics.Validate if the designated constraints aren't met.
+type MainPageStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MainPageStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MainPageStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MainPageStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MainPageStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MainPageStatisticsValidationError) ErrorName() string {
+	return "MainPageStatisticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MainPageStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMainPageStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MainPageStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MainPageStatisticsValidationError{}
+
+// Validate checks the field values on RecentActivity with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecentActivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecentActivity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecentActivityMultiError,
+// or nil if none found.
+func (m *RecentActivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecentActivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SearchesLastHour
+
+	// no validation rules for NewUsersToday
+
+	// no validation rules for ItinerariesCreatedToday
+
+	// no validation rules for PoisFavoritedToday
+
+	if len(errors) > 0 {
+		return RecentActivityMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecentActivityMultiError is an error wrapping multiple validation errors
+// returned by RecentActivity.ValidateAll() if the designated constraints
+// aren't met.
+type RecentActivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecentActivityMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecentActivityMultiError) AllErrors() []error { return m }
+
+// RecentActivityValidationError is the validation error returned by
+// RecentActivity.Validate if the designated constraints aren't met.
+type RecentActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecentActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecentActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecentActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecentActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecentActivityValidationError) ErrorName() string { return "RecentActivityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecentActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecentActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecentActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecentActivityValidationError{}
+
+// Validate checks the field values on PopularDestination with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PopularDestination) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PopularDestination with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PopularDestinationMultiError, or nil if none found.
+func (m *PopularDestination) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PopularDestination) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	// no validation rules for CityName
+
+	// no validation rules for Country
+
+	// no validation rules for SearchCount
+
+	// no validation rules for UserCount
+
+	// no validation rules for GrowthPercentage
+
+	if len(errors) > 0 {
+		return PopularDestinationMultiError(errors)
+	}
+
+	return nil
+}
+
+// PopularDestinationMultiError is an error wrapping multiple validation errors
+// returned by PopularDestination.ValidateAll() if the designated constraints
+// aren't met.
+type PopularDestinationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PopularDestinationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PopularDestinationMultiError) AllErrors() []error { return m }
+
+// PopularDestinationValidationError is the validation error returned by
+// PopularDestination.Validate if the designated constraints aren't met.
+type PopularDestinationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PopularDestinationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PopularDestinationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PopularDestinationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PopularDestinationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PopularDestinationValidationError) ErrorName() string {
+	return "PopularDestinationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PopularDestinationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPopularDestination.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PopularDestinationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PopularDestinationValidationError{}
+
+// Validate checks the field values on TrendingCategories with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TrendingCategories) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrendingCategories with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TrendingCategoriesMultiError, or nil if none found.
+func (m *TrendingCategories) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrendingCategories) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TrendingCategoriesValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TrendingCategoriesValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrendingCategoriesValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TimePeriod
+
+	if len(errors) > 0 {
+		return TrendingCategoriesMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrendingCategoriesMultiError is an error wrapping multiple validation errors
+// returned by TrendingCategories.ValidateAll() if the designated constraints
+// aren't met.
+type TrendingCategoriesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrendingCategoriesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrendingCategoriesMultiError) AllErrors() []error { return m }
+
+// TrendingCategoriesValidationError is the validation error returned by
+// TrendingCategories.Validate if the designated constraints aren't met.
+type TrendingCategoriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrendingCategoriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrendingCategoriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrendingCategoriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrendingCategoriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrendingCategoriesValidationError) ErrorName() string {
+	return "TrendingCategoriesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrendingCategoriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrendingCategories.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrendingCategoriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrendingCategoriesValidationError{}
+
+// Validate checks the field values on CategoryTrend with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryTrend) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryTrend with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryTrendMultiError, or
+// nil if none found.
+func (m *CategoryTrend) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryTrend) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Category
+
+	// no validation rules for SearchCount
+
+	// no validation rules for GrowthPercentage
+
+	// no validation rules for Rank
+
+	if len(errors) > 0 {
+		return CategoryTrendMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryTrendMultiError is an error wrapping multiple validation errors
+// returned by CategoryTrend.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryTrendMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryTrendMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryTrendMultiError) AllErrors() []error { return m }
+
+// CategoryTrendValidationError is the validation error returned by
+// CategoryTrend.Validate if the designated constraints aren't met.
+type CategoryTrendValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryTrendValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryTrendValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryTrendValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryTrendValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryTrendValidationError) ErrorName() string { return "CategoryTrendValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryTrendValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryTrend.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryTrendValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryTrendValidationError{}
+
+// Validate checks the field values on DetailedPOIStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DetailedPOIStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DetailedPOIStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DetailedPOIStatisticsMultiError, or nil if none found.
+func (m *DetailedPOIStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DetailedPOIStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for TotalPoiSearches
+
+	// no validation rules for FavoritePoisCount
+
+	// no validation rules for VisitedCitiesCount
+
+	for idx, item := range m.GetCityInteractions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DetailedPOIStatisticsValidationError{
+						field:  fmt.Sprintf("CityInteractions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DetailedPOIStatisticsValidationError{
+						field:  fmt.Sprintf("CityInteractions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DetailedPOIStatisticsValidationError{
+					field:  fmt.Sprintf("CityInteractions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSearchPatterns()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DetailedPOIStatisticsValidationError{
+					field:  "SearchPatterns",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DetailedPOIStatisticsValidationError{
+					field:  "SearchPatterns",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSearchPatterns()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DetailedPOIStatisticsValidationError{
+				field:  "SearchPatterns",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeAnalytics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DetailedPOIStatisticsValidationError{
+					field:  "TimeAnalytics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DetailedPOIStatisticsValidationError{
+					field:  "TimeAnalytics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeAnalytics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DetailedPOIStatisticsValidationError{
+				field:  "TimeAnalytics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DetailedPOIStatisticsValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DetailedPOIStatisticsValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DetailedPOIStatisticsValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DetailedPOIStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DetailedPOIStatisticsMultiError is an error wrapping multiple validation
+// errors returned by DetailedPOIStatistics.ValidateAll() if the designated
+// constraints aren't met.
+type DetailedPOIStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DetailedPOIStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DetailedPOIStatisticsMultiError) AllErrors() []error { return m }
+
+// DetailedPOIStatisticsValidationError is the validation error returned by
+// DetailedPOIStatistics.Validate if the designated constraints aren't met.
+type DetailedPOIStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DetailedPOIStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DetailedPOIStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DetailedPOIStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DetailedPOIStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DetailedPOIStatisticsValidationError) ErrorName() string {
+	return "DetailedPOIStatisticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DetailedPOIStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDetailedPOIStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DetailedPOIStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DetailedPOIStatisticsValidationError{}
+
+// Validate checks the field values on CityInteractionStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CityInteractionStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityInteractionStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CityInteractionStatsMultiError, or nil if none found.
+func (m *CityInteractionStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityInteractionStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	// no validation rules for CityName
+
+	// no validation rules for SearchCount
+
+	// no validation rules for PoisFavorited
+
+	// no validation rules for ItinerariesCreated
+
+	if all {
+		switch v := interface{}(m.GetLastInteraction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CityInteractionStatsValidationError{
+					field:  "LastInteraction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CityInteractionStatsValidationError{
+					field:  "LastInteraction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastInteraction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CityInteractionStatsValidationError{
+				field:  "LastInteraction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CityInteractionStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityInteractionStatsMultiError is an error wrapping multiple validation
+// errors returned by CityInteractionStats.ValidateAll() if the designated
+// constraints aren't met.
+type CityInteractionStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityInteractionStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityInteractionStatsMultiError) AllErrors() []error { return m }
+
+// CityInteractionStatsValidationError is the validation error returned by
+// CityInteractionStats.Validate if the designated constraints aren't met.
+type CityInteractionStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityInteractionStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityInteractionStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityInteractionStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityInteractionStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityInteractionStatsValidationError) ErrorName() string {
+	return "CityInteractionStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CityInteractionStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityInteractionStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityInteractionStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityInteractionStatsValidationError{}
+
+// Validate checks the field values on SearchPatterns with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchPatterns) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPatterns with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchPatternsMultiError,
+// or nil if none found.
+func (m *SearchPatterns) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPatterns) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PreferredPriceRange
+
+	// no validation rules for AverageSearchRadius
+
+	// no validation rules for MostActiveTimeOfDay
+
+	// no validation rules for MostActiveDayOfWeek
+
+	if len(errors) > 0 {
+		return SearchPatternsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPatternsMultiError is an error wrapping multiple validation errors
+// returned by SearchPatterns.ValidateAll() if the designated constraints
+// aren't met.
+type SearchPatternsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPatternsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPatternsMultiError) AllErrors() []error { return m }
+
+// SearchPatternsValidationError is the validation error returned by
+// SearchPatterns.Validate if the designated constraints aren't met.
+type SearchPatternsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPatternsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPatternsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPatternsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPatternsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPatternsValidationError) ErrorName() string { return "SearchPatternsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchPatternsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPatterns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPatternsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPatternsValidationError{}
+
+// Validate checks the field values on TimeBasedAnalytics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeBasedAnalytics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeBasedAnalytics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeBasedAnalyticsMultiError, or nil if none found.
+func (m *TimeBasedAnalytics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeBasedAnalytics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHourlyActivity() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeBasedAnalyticsValidationError{
+						field:  fmt.Sprintf("HourlyActivity[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeBasedAnalyticsValidationError{
+						field:  fmt.Sprintf("HourlyActivity[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeBasedAnalyticsValidationError{
+					field:  fmt.Sprintf("HourlyActivity[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDailyActivity() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeBasedAnalyticsValidationError{
+						field:  fmt.Sprintf("DailyActivity[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeBasedAnalyticsValidationError{
+						field:  fmt.Sprintf("DailyActivity[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeBasedAnalyticsValidationError{
+					field:  fmt.Sprintf("DailyActivity[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMonthlyActivity() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeBasedAnalyticsValidationError{
+						field:  fmt.Sprintf("MonthlyActivity[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeBasedAnalyticsValidationError{
+						field:  fmt.Sprintf("MonthlyActivity[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeBasedAnalyticsValidationError{
+					field:  fmt.Sprintf("MonthlyActivity[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TimeBasedAnalyticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeBasedAnalyticsMultiError is an error wrapping multiple validation errors
+// returned by TimeBasedAnalytics.ValidateAll() if the designated constraints
+// aren't met.
+type TimeBasedAnalyticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeBasedAnalyticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeBasedAnalyticsMultiError) AllErrors() []error { return m }
+
+// TimeBasedAnalyticsValidationError is the validation error returned by
+// TimeBasedAnalytics.Validate if the designated constraints aren't met.
+type TimeBasedAnalyticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeBasedAnalyticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeBasedAnalyticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeBasedAnalyticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeBasedAnalyticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeBasedAnalyticsValidationError) ErrorName() string {
+	return "TimeBasedAnalyticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeBasedAnalyticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeBasedAnalytics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeBasedAnalyticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeBasedAnalyticsValidationError{}
+
+// Validate checks the field values on HourlyActivity with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HourlyActivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HourlyActivity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HourlyActivityMultiError,
+// or nil if none found.
+func (m *HourlyActivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HourlyActivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetHour(); val < 0 || val > 23 {
+		err := HourlyActivityValidationError{
+			field:  "Hour",
+			reason: "value must be inside range [0, 23]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ActivityCount
+
+	if len(errors) > 0 {
+		return HourlyActivityMultiError(errors)
+	}
+
+	return nil
+}
+
+// HourlyActivityMultiError is an error wrapping multiple validation errors
+// returned by HourlyActivity.ValidateAll() if the designated constraints
+// aren't met.
+type HourlyActivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HourlyActivityMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HourlyActivityMultiError) AllErrors() []error { return m }
+
+// HourlyActivityValidationError is the validation error returned by
+// HourlyActivity.Validate if the designated constraints aren't met.
+type HourlyActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HourlyActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HourlyActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HourlyActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HourlyActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HourlyActivityValidationError) ErrorName() string { return "HourlyActivityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HourlyActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHourlyActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HourlyActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HourlyActivityValidationError{}
+
+// Validate checks the field values on DailyActivity with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DailyActivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyActivity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DailyActivityMultiError, or
+// nil if none found.
+func (m *DailyActivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyActivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DailyActivityValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DailyActivityValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DailyActivityValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Searches
+
+	// no validation rules for FavoritesAdded
+
+	// no validation rules for ItinerariesCreated
+
+	if len(errors) > 0 {
+		return DailyActivityMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyActivityMultiError is an error wrapping multiple validation errors
+// returned by DailyActivity.ValidateAll() if the designated constraints
+// aren't met.
+type DailyActivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyActivityMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyActivityMultiError) AllErrors() []error { return m }
+
+// DailyActivityValidationError is the validation error returned by
+// DailyActivity.Validate if the designated constraints aren't met.
+type DailyActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DailyActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyActivityValidationError) ErrorName() string { return "DailyActivityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DailyActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDailyActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DailyActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DailyActivityValidationError{}
+
+// Validate checks the field values on MonthlyActivity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MonthlyActivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MonthlyActivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MonthlyActivityMultiError, or nil if none found.
+func (m *MonthlyActivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MonthlyActivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Year
+
+	// no validation rules for Month
+
+	// no validation rules for TotalActivity
+
+	// no validation rules for GrowthPercentage
+
+	if len(errors) > 0 {
+		return MonthlyActivityMultiError(errors)
+	}
+
+	return nil
+}
+
+// MonthlyActivityMultiError is an error wrapping multiple validation errors
+// returned by MonthlyActivity.ValidateAll() if the designated constraints
+// aren't met.
+type MonthlyActivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MonthlyActivityMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MonthlyActivityMultiError) AllErrors() []error { return m }
+
+// MonthlyActivityValidationError is the validation error returned by
+// MonthlyActivity.Validate if the designated constraints aren't met.
+type MonthlyActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MonthlyActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MonthlyActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MonthlyActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MonthlyActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MonthlyActivityValidationError) ErrorName() string { return "MonthlyActivityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MonthlyActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMonthlyActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MonthlyActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MonthlyActivityValidationError{}
+
+// Validate checks the field values on LandingPageUserStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LandingPageUserStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LandingPageUserStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LandingPageUserStatsMultiError, or nil if none found.
+func (m *LandingPageUserStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LandingPageUserStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for SearchesThisWeek
+
+	// no validation rules for NewFavoritesThisWeek
+
+	// no validation rules for ItinerariesCreatedThisMonth
+
+	for idx, item := range m.GetRecentInteractions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LandingPageUserStatsValidationError{
+						field:  fmt.Sprintf("RecentInteractions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LandingPageUserStatsValidationError{
+						field:  fmt.Sprintf("RecentInteractions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LandingPageUserStatsValidationError{
+					field:  fmt.Sprintf("RecentInteractions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecommendations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LandingPageUserStatsValidationError{
+					field:  "Recommendations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LandingPageUserStatsValidationError{
+					field:  "Recommendations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecommendations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LandingPageUserStatsValidationError{
+				field:  "Recommendations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBadges()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LandingPageUserStatsValidationError{
+					field:  "Badges",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LandingPageUserStatsValidationError{
+					field:  "Badges",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBadges()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LandingPageUserStatsValidationError{
+				field:  "Badges",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LandingPageUserStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// LandingPageUserStatsMultiError is an error wrapping multiple validation
+// errors returned by LandingPageUserStats.ValidateAll() if the designated
+// constraints aren't met.
+type LandingPageUserStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LandingPageUserStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LandingPageUserStatsMultiError) AllErrors() []error { return m }
+
+// LandingPageUserStatsValidationError is the validation error returned by
+// LandingPageUserStats.Validate if the designated constraints aren't met.
+type LandingPageUserStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LandingPageUserStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LandingPageUserStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LandingPageUserStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LandingPageUserStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LandingPageUserStatsValidationError) ErrorName() string {
+	return "LandingPageUserStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LandingPageUserStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLandingPageUserStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LandingPageUserStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LandingPageUserStatsValidationError{}
+
+// Validate checks the field values on RecentInteraction with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RecentInteraction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecentInteraction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecentInteractionMultiError, or nil if none found.
+func (m *RecentInteraction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecentInteraction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecentInteractionValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecentInteractionValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecentInteractionValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CityName
+
+	// no validation rules for Metadata
+
+	if len(errors) > 0 {
+		return RecentInteractionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecentInteractionMultiError is an error wrapping multiple validation errors
+// returned by RecentInteraction.ValidateAll() if the designated constraints
+// aren't met.
+type RecentInteractionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecentInteractionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecentInteractionMultiError) AllErrors() []error { return m }
+
+// RecentInteractionValidationError is the validation error returned by
+// RecentInteraction.Validate if the designated constraints aren't met.
+type RecentInteractionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecentInteractionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecentInteractionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecentInteractionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecentInteractionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecentInteractionValidationError) ErrorName() string {
+	return "RecentInteractionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecentInteractionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecentInteraction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecentInteractionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecentInteractionValidationError{}
+
+// Validate checks the field values on PersonalizedRecommendations with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PersonalizedRecommendations) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PersonalizedRecommendations with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PersonalizedRecommendationsMultiError, or nil if none found.
+func (m *PersonalizedRecommendations) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PersonalizedRecommendations) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RecommendationReason
+
+	if len(errors) > 0 {
+		return PersonalizedRecommendationsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PersonalizedRecommendationsMultiError is an error wrapping multiple
+// validation errors returned by PersonalizedRecommendations.ValidateAll() if
+// the designated constraints aren't met.
+type PersonalizedRecommendationsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PersonalizedRecommendationsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PersonalizedRecommendationsMultiError) AllErrors() []error { return m }
+
+// PersonalizedRecommendationsValidationError is the validation error returned
+// by PersonalizedRecommendations.Validate if the designated constraints
+// aren't met.
+type PersonalizedRecommendationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PersonalizedRecommendationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PersonalizedRecommendationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PersonalizedRecommendationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PersonalizedRecommendationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PersonalizedRecommendationsValidationError) ErrorName() string {
+	return "PersonalizedRecommendationsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PersonalizedRecommendationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPersonalizedRecommendations.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PersonalizedRecommendationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PersonalizedRecommendationsValidationError{}
+
+// Validate checks the field values on AchievementBadges with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AchievementBadges) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AchievementBadges with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AchievementBadgesMultiError, or nil if none found.
+func (m *AchievementBadges) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AchievementBadges) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEarnedBadges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AchievementBadgesValidationError{
+						field:  fmt.Sprintf("EarnedBadges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AchievementBadgesValidationError{
+						field:  fmt.Sprintf("EarnedBadges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AchievementBadgesValidationError{
+					field:  fmt.Sprintf("EarnedBadges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAvailableBadges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AchievementBadgesValidationError{
+						field:  fmt.Sprintf("AvailableBadges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AchievementBadgesValidationError{
+						field:  fmt.Sprintf("AvailableBadges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AchievementBadgesValidationError{
+					field:  fmt.Sprintf("AvailableBadges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AchievementBadgesMultiError(errors)
+	}
+
+	return nil
+}
+
+// AchievementBadgesMultiError is an error wrapping multiple validation errors
+// returned by AchievementBadges.ValidateAll() if the designated constraints
+// aren't met.
+type AchievementBadgesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AchievementBadgesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AchievementBadgesMultiError) AllErrors() []error { return m }
+
+// AchievementBadgesValidationError is the validation error returned by
+// AchievementBadges.Validate if the designated constraints aren't met.
+type AchievementBadgesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AchievementBadgesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AchievementBadgesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AchievementBadgesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AchievementBadgesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AchievementBadgesValidationError) ErrorName() string {
+	return "AchievementBadgesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AchievementBadgesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAchievementBadges.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AchievementBadgesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AchievementBadgesValidationError{}
+
+// Validate checks the field values on Badge with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Badge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Badge with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BadgeMultiError, or nil if none found.
+func (m *Badge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Badge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for IconUrl
+
+	if all {
+		switch v := interface{}(m.GetEarnedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BadgeValidationError{
+					field:  "EarnedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BadgeValidationError{
+					field:  "EarnedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEarnedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BadgeValidationError{
+				field:  "EarnedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetProgress(); val < 0 || val > 100 {
+		err := BadgeValidationError{
+			field:  "Progress",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Target
+
+	if all {
+		switch v := interface{}(m.GetLocalizedName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BadgeValidationError{
+					field:  "LocalizedName",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BadgeValidationError{
+					field:  "LocalizedName",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalizedName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BadgeValidationError{
+				field:  "LocalizedName",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalizedDescription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BadgeValidationError{
+					field:  "LocalizedDescription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BadgeValidationError{
+					field:  "LocalizedDescription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalizedDescription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BadgeValidationError{
+				field:  "LocalizedDescription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BadgeMultiError(errors)
+	}
+
+	return nil
+}
+
+// BadgeMultiError is an error wrapping multiple validation errors returned by
+// Badge.ValidateAll() if the designated constraints aren't met.
+type BadgeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BadgeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BadgeMultiError) AllErrors() []error { return m }
+
+// BadgeValidationError is the validation error returned by Badge.Validate if
+// the designated constraints aren't met.
+type BadgeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BadgeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BadgeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BadgeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BadgeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BadgeValidationError) ErrorName() string { return "BadgeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BadgeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBadge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BadgeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BadgeValidationError{}
+
+// Validate checks the field values on SystemAnalytics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SystemAnalytics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SystemAnalytics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SystemAnalyticsMultiError, or nil if none found.
+func (m *SystemAnalytics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SystemAnalytics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUserGrowth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "UserGrowth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "UserGrowth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserGrowth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SystemAnalyticsValidationError{
+				field:  "UserGrowth",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUsageMetrics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "UsageMetrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "UsageMetrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsageMetrics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SystemAnalyticsValidationError{
+				field:  "UsageMetrics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPerformanceMetrics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "PerformanceMetrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "PerformanceMetrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerformanceMetrics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SystemAnalyticsValidationError{
+				field:  "PerformanceMetrics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetErrorMetrics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "ErrorMetrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "ErrorMetrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetErrorMetrics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SystemAnalyticsValidationError{
+				field:  "ErrorMetrics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGeographicDistribution()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "GeographicDistribution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "GeographicDistribution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeographicDistribution()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SystemAnalyticsValidationError{
+				field:  "GeographicDistribution",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFeatureUsage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "FeatureUsage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "FeatureUsage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFeatureUsage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SystemAnalyticsValidationError{
+				field:  "FeatureUsage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SystemAnalyticsValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SystemAnalyticsValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SystemAnalyticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SystemAnalyticsMultiError is an error wrapping multiple validation errors
+// returned by SystemAnalytics.ValidateAll() if the designated constraints
+// aren't met.
+type SystemAnalyticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SystemAnalyticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SystemAnalyticsMultiError) AllErrors() []error { return m }
+
+// SystemAnalyticsValidationError is the validation error returned by
+// SystemAnalytics.Validate if the designated constraints aren't met.
+type SystemAnalyticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SystemAnalyticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SystemAnalyticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SystemAnalyticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SystemAnalyticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SystemAnalyticsValidationError) ErrorName() string { return "SystemAnalyticsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SystemAnalyticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSystemAnalytics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SystemAnalyticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SystemAnalyticsValidationError{}
+
+// Validate checks the field values on UserGrowthMetrics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserGrowthMetrics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserGrowthMetrics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserGrowthMetricsMultiError, or nil if none found.
+func (m *UserGrowthMetrics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserGrowthMetrics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalUsers
+
+	// no validation rules for NewUsersToday
+
+	// no validation rules for NewUsersThisWeek
+
+	// no validation rules for NewUsersThisMonth
+
+	// no validation rules for GrowthRateWeekly
+
+	// no validation rules for GrowthRateMonthly
+
+	// no validation rules for ActiveUsersToday
+
+	// no validation rules for ActiveUsersThisWeek
+
+	// no validation rules for RetentionRateWeekly
+
+	// no validation rules for RetentionRateMonthly
+
+	if len(errors) > 0 {
+		return UserGrowthMetricsMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserGrowthMetricsMultiError is an error wrapping multiple validation errors
+// returned by UserGrowthMetrics.ValidateAll() if the designated constraints
+// aren't met.
+type UserGrowthMetricsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserGrowthMetricsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserGrowthMetricsMultiError) AllErrors() []error { return m }
+
+// UserGrowthMetricsValidationError is the validation error returned by
+// UserGrowthMetrics.Validate if the designated constraints aren't met.
+type UserGrowthMetricsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserGrowthMetricsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserGrowthMetricsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserGrowthMetricsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserGrowthMetricsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserGrowthMetricsValidationError) ErrorName() string {
+	return "UserGrowthMetricsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserGrowthMetricsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserGrowthMetrics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserGrowthMetricsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserGrowthMetricsValidationError{}
+
+// Validate checks the field values on UsageMetrics with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UsageMetrics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UsageMetrics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UsageMetricsMultiError, or
+// nil if none found.
+func (m *UsageMetrics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UsageMetrics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalSearches
+
+	// no validation rules for SearchesToday
+
+	// no validation rules for SearchesThisWeek
+
+	// no validation rules for AverageSearchesPerUser
+
+	// no validation rules for TotalApiCalls
+
+	// no validation rules for AverageResponseTimeMs
+
+	// no validation rules for PeakConcurrentUsers
+
+	if len(errors) > 0 {
+		return UsageMetricsMultiError(errors)
+	}
+
+	return nil
+}
+
+// UsageMetricsMultiError is an error wrapping multiple validation errors
+// returned by UsageMetrics.ValidateAll() if the designated constraints aren't met.
+type UsageMetricsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UsageMetricsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UsageMetricsMultiError) AllErrors() []error { return m }
+
+// UsageMetricsValidationError is the validation error returned by
+// UsageMetrics.Validate if the designated constraints aren't met.
+type UsageMetricsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsageMetricsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsageMetricsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsageMetricsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsageMetricsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsageMetricsValidationError) ErrorName() string { return "UsageMetricsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UsageMetricsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsageMetrics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsageMetricsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsageMetricsValidationError{}
+
+// Validate checks the field values on PerformanceMetrics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PerformanceMetrics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PerformanceMetrics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PerformanceMetricsMultiError, or nil if none found.
+func (m *PerformanceMetrics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PerformanceMetrics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CpuUsagePercentage
+
+	// no validation rules for MemoryUsagePercentage
+
+	// no validation rules for DiskUsagePercentage
+
+	// no validation rules for ActiveDatabaseConnections
+
+	// no validation rules for AverageDbQueryTimeMs
+
+	// no validation rules for CacheHitRatePercentage
+
+	if len(errors) > 0 {
+		return PerformanceMetricsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PerformanceMetricsMultiError is an error wrapping multiple validation errors
+// returned by PerformanceMetrics.ValidateAll() if the designated constraints
+// aren't met.
+type PerformanceMetricsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PerformanceMetricsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PerformanceMetricsMultiError) AllErrors() []error { return m }
+
+// PerformanceMetricsValidationError is the validation error returned by
+// PerformanceMetrics.Validate if the designated constraints aren't met.
+type PerformanceMetricsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PerformanceMetricsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PerformanceMetricsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PerformanceMetricsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PerformanceMetricsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PerformanceMetricsValidationError) ErrorName() string {
+	return "PerformanceMetricsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PerformanceMetricsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPerformanceMetrics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PerformanceMetricsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PerformanceMetricsValidationError{}
+
+// Validate checks the field values on ErrorMetrics with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorMetrics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErrorMetrics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorMetricsMultiError, or
+// nil if none found.
+func (m *ErrorMetrics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErrorMetrics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalErrorsToday
+
+	// no validation rules for TotalErrorsThisWeek
+
+	// no validation rules for ErrorRatePercentage
+
+	for idx, item := range m.GetErrorBreakdown() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ErrorMetricsValidationError{
+						field:  fmt.Sprintf("ErrorBreakdown[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ErrorMetricsValidationError{
+						field:  fmt.Sprintf("ErrorBreakdown[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ErrorMetricsValidationError{
+					field:  fmt.Sprintf("ErrorBreakdown[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ErrorMetricsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErrorMetricsMultiError is an error wrapping multiple validation errors
+// returned by ErrorMetrics.ValidateAll() if the designated constraints aren't met.
+type ErrorMetricsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorMetricsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErrorMetricsMultiError) AllErrors() []error { return m }
+
+// ErrorMetricsValidationError is the validation error returned by
+// ErrorMetrics.Validate if the designated constraints aren't met.
+type ErrorMetricsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErrorMetricsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErrorMetricsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErrorMetricsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErrorMetricsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErrorMetricsValidationError) ErrorName() string { return "ErrorMetricsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErrorMetricsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErrorMetrics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErrorMetricsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorMetricsValidationError{}
+
+// Validate checks the field values on ErrorBreakdown with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorBreakdown) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErrorBreakdown with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorBreakdownMultiError,
+// or nil if none found.
+func (m *ErrorBreakdown) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErrorBreakdown) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ErrorType
+
+	// no validation rules for Count
+
+	// no validation rules for Percentage
+
+	if len(errors) > 0 {
+		return ErrorBreakdownMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErrorBreakdownMultiError is an error wrapping multiple validation errors
+// returned by ErrorBreakdown.ValidateAll() if the designated constraints
+// aren't met.
+type ErrorBreakdownMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorBreakdownMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErrorBreakdownMultiError) AllErrors() []error { return m }
+
+// ErrorBreakdownValidationError is the validation error returned by
+// ErrorBreakdown.Validate if the designated constraints aren't met.
+type ErrorBreakdownValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErrorBreakdownValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErrorBreakdownValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErrorBreakdownValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErrorBreakdownValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErrorBreakdownValidationError) ErrorName() string { return "ErrorBreakdownValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErrorBreakdownValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErrorBreakdown.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErrorBreakdownValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorBreakdownValidationError{}
+
+// Validate checks the field values on GeographicDistribution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GeographicDistribution) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GeographicDistribution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GeographicDistributionMultiError, or nil if none found.
+func (m *GeographicDistribution) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GeographicDistribution) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCountryStats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GeographicDistributionValidationError{
+						field:  fmt.Sprintf("CountryStats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GeographicDistributionValidationError{
+						field:  fmt.Sprintf("CountryStats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GeographicDistributionValidationError{
+					field:  fmt.Sprintf("CountryStats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCityStats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GeographicDistributionValidationError{
+						field:  fmt.Sprintf("CityStats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GeographicDistributionValidationError{
+						field:  fmt.Sprintf("CityStats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GeographicDistributionValidationError{
+					field:  fmt.Sprintf("CityStats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GeographicDistributionMultiError(errors)
+	}
+
+	return nil
+}
+
+// GeographicDistributionMultiError is an error wrapping multiple validation
+// errors returned by GeographicDistribution.ValidateAll() if the designated
+// constraints aren't met.
+type GeographicDistributionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GeographicDistributionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GeographicDistributionMultiError) AllErrors() []error { return m }
+
+// GeographicDistributionValidationError is the validation error returned by
+// GeographicDistribution.Validate if the designated constraints aren't met.
+type GeographicDistributionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GeographicDistributionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GeographicDistributionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GeographicDistributionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GeographicDistributionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GeographicDistributionValidationError) ErrorName() string {
+	return "GeographicDistributionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GeographicDistributionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGeographicDistribution.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GeographicDistributionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GeographicDistributionValidationError{}
+
+// Validate checks the field values on CountryStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CountryStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CountryStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CountryStatsMultiError, or
+// nil if none found.
+func (m *CountryStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CountryStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCountryCode() != "" {
+
+		if !_CountryStats_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := CountryStatsValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^[A-Z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for CountryName
+
+	// no validation rules for UserCount
+
+	// no validation rules for SearchCount
+
+	// no validation rules for PercentageOfTotal
+
+	if len(errors) > 0 {
+		return CountryStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CountryStatsMultiError is an error wrapping multiple validation errors
+// returned by CountryStats.ValidateAll() if the designated constraints aren't met.
+type CountryStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CountryStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CountryStatsMultiError) AllErrors() []error { return m }
+
+// CountryStatsValidationError is the validation error returned by
+// CountryStats.Validate if the designated constraints aren't met.
+type CountryStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CountryStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CountryStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CountryStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CountryStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CountryStatsValidationError) ErrorName() string { return "CountryStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CountryStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCountryStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CountryStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CountryStatsValidationError{}
+
+var _CountryStats_CountryCode_Pattern = regexp.MustCompile("^[A-Z]{2}$")
+
+// Validate checks the field values on CityStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CityStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CityStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CityStatsMultiError, or nil
+// if none found.
+func (m *CityStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CityStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CityId
+
+	// no validation rules for CityName
+
+	// no validation rules for SearchCount
+
+	// no validation rules for PoiCount
+
+	// no validation rules for PopularityScore
+
+	if len(errors) > 0 {
+		return CityStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CityStatsMultiError is an error wrapping multiple validation errors returned
+// by CityStats.ValidateAll() if the designated constraints aren't met.
+type CityStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CityStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CityStatsMultiError) AllErrors() []error { return m }
+
+// CityStatsValidationError is the validation error returned by
+// CityStats.Validate if the designated constraints aren't met.
+type CityStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CityStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CityStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CityStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CityStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CityStatsValidationError) ErrorName() string { return "CityStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CityStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCityStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CityStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CityStatsValidationError{}
+
+// Validate checks the field values on FeatureUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeatureUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeatureUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FeatureUsageMultiError, or
+// nil if none found.
+func (m *FeatureUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeatureUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SemanticSearches
+
+	// no validation rules for FavoritesAdded
+
+	// no validation rules for ItinerariesCreated
+
+	// no validation rules for ListsCreated
+
+	// no validation rules for ChatSessions
+
+	for idx, item := range m.GetFeatureMetrics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FeatureUsageValidationError{
+						field:  fmt.Sprintf("FeatureMetrics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FeatureUsageValidationError{
+						field:  fmt.Sprintf("FeatureMetrics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FeatureUsageValidationError{
+					field:  fmt.Sprintf("FeatureMetrics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FeatureUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeatureUsageMultiError is an error wrapping multiple validation errors
+// returned by FeatureUsage.ValidateAll() if the designated constraints aren't met.
+type FeatureUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeatureUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeatureUsageMultiError) AllErrors() []error { return m }
+
+// FeatureUsageValidationError is the validation error returned by
+// FeatureUsage.Validate if the designated constraints aren't met.
+type FeatureUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeatureUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeatureUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeatureUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeatureUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeatureUsageValidationError) ErrorName() string { return "FeatureUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeatureUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeatureUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeatureUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeatureUsageValidationError{}
+
+// Validate checks the field values on FeatureMetric with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeatureMetric) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeatureMetric with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FeatureMetricMultiError, or
+// nil if none found.
+func (m *FeatureMetric) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeatureMetric) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FeatureName
+
+	// no validation rules for UsageCount
+
+	// no validation rules for AdoptionRate
+
+	if len(errors) > 0 {
+		return FeatureMetricMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeatureMetricMultiError is an error wrapping multiple validation errors
+// returned by FeatureMetric.ValidateAll() if the designated constraints
+// aren't met.
+type FeatureMetricMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeatureMetricMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeatureMetricMultiError) AllErrors() []error { return m }
+
+// FeatureMetricValidationError is the validation error returned by
+// FeatureMetric.Validate if the designated constraints aren't met.
+type FeatureMetricValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeatureMetricValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeatureMetricValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeatureMetricValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeatureMetricValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeatureMetricValidationError) ErrorName() string { return "FeatureMetricValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeatureMetricValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeatureMetric.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeatureMetricValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeatureMetricValidationError{}
+
+// Validate checks the field values on StatisticsEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StatisticsEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatisticsEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatisticsEventMultiError, or nil if none found.
+func (m *StatisticsEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatisticsEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventType
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatisticsEventValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatisticsEventValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatisticsEventValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Payload.(type) {
+	case *StatisticsEvent_MainStats:
+		if v == nil {
+			err := StatisticsEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMainStats()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StatisticsEventValidationError{
+						field:  "MainStats",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StatisticsEventValidationError{
+						field:  "MainStats",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMainStats()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StatisticsEventValidationError{
+					field:  "MainStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StatisticsEvent_MetricUpdate:
+		if v == nil {
+			err := StatisticsEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMetricUpdate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StatisticsEventValidationError{
+						field:  "MetricUpdate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StatisticsEventValidationError{
+						field:  "MetricUpdate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetricUpdate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StatisticsEventValidationError{
+					field:  "MetricUpdate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StatisticsEvent_SystemAlert:
+		if v == nil {
+			err := StatisticsEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSystemAlert()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StatisticsEventValidationError{
+						field:  "SystemAlert",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StatisticsEventValidationError{
+						field:  "SystemAlert",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSystemAlert()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StatisticsEventValidationError{
+					field:  "SystemAlert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StatisticsEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatisticsEventMultiError is an error wrapping multiple validation errors
+// returned by StatisticsEvent.ValidateAll() if the designated constraints
+// aren't met.
+type StatisticsEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatisticsEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatisticsEventMultiError) AllErrors() []error { return m }
+
+// StatisticsEventValidationError is the validation error returned by
+// StatisticsEvent.Validate if the designated constraints aren't met.
+type StatisticsEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatisticsEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatisticsEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatisticsEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatisticsEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatisticsEventValidationError) ErrorName() string { return "StatisticsEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatisticsEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatisticsEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatisticsEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatisticsEventValidationError{}
+
+// Validate checks the field values on MetricUpdate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricUpdate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricUpdate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricUpdateMultiError, or
+// nil if none found.
+func (m *MetricUpdate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricUpdate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MetricName
+
+	// no validation rules for Value
+
+	// no validation rules for ChangeType
+
+	// no validation rules for PreviousValue
+
+	if len(errors) > 0 {
+		return MetricUpdateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricUpdateMultiError is an error wrapping multiple validation errors
+// returned by MetricUpdate.ValidateAll() if the designated constraints aren't met.
+type MetricUpdateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricUpdateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricUpdateMultiError) AllErrors() []error { return m }
+
+// MetricUpdateValidationError is the validation error returned by
+// MetricUpdate.Validate if the designated constraints aren't met.
+type MetricUpdateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricUpdateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricUpdateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricUpdateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricUpdateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricUpdateValidationError) ErrorName() string { return "MetricUpdateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricUpdateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricUpdate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricUpdateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricUpdateValidationError{}
+
+// Validate checks the field values on SystemAlert with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SystemAlert) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SystemAlert with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SystemAlertMultiError, or
+// nil if none found.
+func (m *SystemAlert) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SystemAlert) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AlertType
+
+	// no validation rules for Message
+
+	// no validation rules for Severity
+
+	// no validation rules for Details
+
+	if len(errors) > 0 {
+		return SystemAlertMultiError(errors)
+	}
+
+	return nil
+}
+
+// SystemAlertMultiError is an error wrapping multiple validation errors
+// returned by SystemAlert.ValidateAll() if the designated constraints aren't met.
+type SystemAlertMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SystemAlertMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SystemAlertMultiError) AllErrors() []error { return m }
+
+// SystemAlertValidationError is the validation error returned by
+// SystemAlert.Validate if the designated constraints aren't met.
+type SystemAlertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SystemAlertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SystemAlertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SystemAlertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SystemAlertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SystemAlertValidationError) ErrorName() string { return "SystemAlertValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SystemAlertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSystemAlert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SystemAlertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SystemAlertValidationError{}
+
+// Validate checks the field values on GetMainPageStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMainPageStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMainPageStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMainPageStatisticsRequestMultiError, or nil if none found.
+func (m *GetMainPageStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMainPageStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeTrends
+
+	// no validation rules for TimeRange
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMainPageStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMainPageStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMainPageStatisticsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetMainPageStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMainPageStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetMainPageStatisticsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetMainPageStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMainPageStatisticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMainPageStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// GetMainPageStatisticsRequestValidationError is the validation error returned
+// by GetMainPageStatisticsRequest.Validate if the designated constraints
+// aren't met.
+type GetMainPageStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMainPageStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMainPageStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMainPageStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMainPageStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMainPageStatisticsRequestValidationError) ErrorName() string {
+	return "GetMainPageStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMainPageStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMainPageStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMainPageStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMainPageStatisticsRequestValidationError{}
+
+// Validate checks the field values on GetMainPageStatisticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMainPageStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMainPageStatisticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetMainPageStatisticsResponseMultiError, or nil if none found.
+func (m *GetMainPageStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMainPageStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMainPageStatisticsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMainPageStatisticsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMainPageStatisticsResponseValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMainPageStatisticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMainPageStatisticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMainPageStatisticsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetMainPageStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMainPageStatisticsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetMainPageStatisticsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetMainPageStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMainPageStatisticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMainPageStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// GetMainPageStatisticsResponseValidationError is the validation error
+// returned by GetMainPageStatisticsResponse.Validate if the designated
+// constraints aren't met.
+type GetMainPageStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMainPageStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMainPageStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMainPageStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMainPageStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMainPageStatisticsResponseValidationError) ErrorName() string {
+	return "GetMainPageStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMainPageStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMainPageStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMainPageStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMainPageStatisticsResponseValidationError{}
+
+// Validate checks the field values on StreamMainPageStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamMainPageStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamMainPageStatisticsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StreamMainPageStatisticsRequestMultiError, or nil if none found.
+func (m *StreamMainPageStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamMainPageStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UpdateIntervalSeconds
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamMainPageStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamMainPageStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamMainPageStatisticsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StreamMainPageStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamMainPageStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by StreamMainPageStatisticsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type StreamMainPageStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamMainPageStatisticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamMainPageStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// StreamMainPageStatisticsRequestValidationError is the validation error
+// returned by StreamMainPageStatisticsRequest.Validate if the designated
+// constraints aren't met.
+type StreamMainPageStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamMainPageStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamMainPageStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamMainPageStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamMainPageStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamMainPageStatisticsRequestValidationError) ErrorName() string {
+	return "StreamMainPageStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamMainPageStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamMainPageStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamMainPageStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamMainPageStatisticsRequestValidationError{}
+
+// Validate checks the field values on GetDetailedPOIStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDetailedPOIStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDetailedPOIStatisticsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDetailedPOIStatisticsRequestMultiError, or nil if none found.
+func (m *GetDetailedPOIStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDetailedPOIStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for TimeRange
+
+	// no validation rules for IncludePredictions
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDetailedPOIStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDetailedPOIStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDetailedPOIStatisticsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDetailedPOIStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDetailedPOIStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDetailedPOIStatisticsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetDetailedPOIStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDetailedPOIStatisticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDetailedPOIStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// GetDetailedPOIStatisticsRequestValidationError is the validation error
+// returned by GetDetailedPOIStatisticsRequest.Validate if the designated
+// constraints aren't met.
+type GetDetailedPOIStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDetailedPOIStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDetailedPOIStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDetailedPOIStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDetailedPOIStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDetailedPOIStatisticsRequestValidationError) ErrorName() string {
+	return "GetDetailedPOIStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDetailedPOIStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDetailedPOIStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDetailedPOIStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDetailedPOIStatisticsRequestValidationError{}
+
+// Validate checks the field values on GetDetailedPOIStatisticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetDetailedPOIStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDetailedPOIStatisticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDetailedPOIStatisticsResponseMultiError, or nil if none found.
+func (m *GetDetailedPOIStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDetailedPOIStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDetailedPOIStatisticsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDetailedPOIStatisticsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDetailedPOIStatisticsResponseValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPredictions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDetailedPOIStatisticsResponseValidationError{
+						field:  fmt.Sprintf("Predictions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDetailedPOIStatisticsResponseValidationError{
+						field:  fmt.Sprintf("Predictions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDetailedPOIStatisticsResponseValidationError{
+					field:  fmt.Sprintf("Predictions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDetailedPOIStatisticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDetailedPOIStatisticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDetailedPOIStatisticsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDetailedPOIStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDetailedPOIStatisticsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDetailedPOIStatisticsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDetailedPOIStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDetailedPOIStatisticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDetailedPOIStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// GetDetailedPOIStatisticsResponseValidationError is the validation error
+// returned by GetDetailedPOIStatisticsResponse.Validate if the designated
+// constraints aren't met.
+type GetDetailedPOIStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDetailedPOIStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDetailedPOIStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDetailedPOIStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDetailedPOIStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDetailedPOIStatisticsResponseValidationError) ErrorName() string {
+	return "GetDetailedPOIStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDetailedPOIStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDetailedPOIStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDetailedPOIStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDetailedPOIStatisticsResponseValidationError{}
+
+// Validate checks the field values on Prediction with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Prediction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Prediction with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PredictionMultiError, or
+// nil if none found.
+func (m *Prediction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Prediction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Metric
+
+	// no validation rules for PredictedValue
+
+	// no validation rules for ConfidenceLevel
+
+	// no validation rules for TimeHorizon
+
+	if len(errors) > 0 {
+		return PredictionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PredictionMultiError is an error wrapping multiple validation errors
+// returned by Prediction.ValidateAll() if the designated constraints aren't met.
+type PredictionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PredictionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PredictionMultiError) AllErrors() []error { return m }
+
+// PredictionValidationError is the validation error returned by
+// Prediction.Validate if the designated constraints aren't met.
+type PredictionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PredictionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PredictionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PredictionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PredictionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PredictionValidationError) ErrorName() string { return "PredictionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PredictionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrediction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PredictionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PredictionValidationError{}
+
+// Validate checks the field values on GetLandingPageStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLandingPageStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLandingPageStatisticsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetLandingPageStatisticsRequestMultiError, or nil if none found.
+func (m *GetLandingPageStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLandingPageStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetLandingPageStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetLandingPageStatisticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetLandingPageStatisticsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetLandingPageStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLandingPageStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetLandingPageStatisticsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetLandingPageStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLandingPageStatisticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLandingPageStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// GetLandingPageStatisticsRequestValidationError is the validation error
+// returned by GetLandingPageStatisticsRequest.Validate if the designated
+// constraints aren't met.
+type GetLandingPageStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLandingPageStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLandingPageStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLandingPageStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLandingPageStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLandingPageStatisticsRequestValidationError) ErrorName() string {
+	return "GetLandingPageStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLandingPageStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLandingPageStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLandingPageStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLandingPageStatisticsRequestValidationError{}
+
+// Validate checks the field values on GetLandingPageStatisticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetLandingPageStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLandingPageStatisticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetLandingPageStatisticsResponseMultiError, or nil if none found.
+func (m *GetLandingPageStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLandingPageStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetLandingPageStatisticsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetLandingPageStatisticsResponseValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetLandingPageStatisticsResponseValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetLandingPageStatisticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetLandingPageStatisticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetLandingPageStatisticsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetLandingPageStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLandingPageStatisticsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetLandingPageStatisticsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetLandingPageStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLandingPageStatisticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLandingPageStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// GetLandingPageStatisticsResponseValidationError is the validation error
+// returned by GetLandingPageStatisticsResponse.Validate if the designated
+// constraints aren't met.
+type GetLandingPageStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLandingPageStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLandingPageStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLandingPageStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLandingPageStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLandingPageStatisticsResponseValidationError) ErrorName() string {
+	return "GetLandingPageStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLandingPageStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLandingPageStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLandingPageStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLandingPageStatisticsResponseValidationError{}
+
+// Validate checks the field values on GetUserActivityAnalyticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserActivityAnalyticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserActivityAnalyticsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetUserActivityAnalyticsRequestMultiError, or nil if none found.
+func (m *GetUserActivityAnalyticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserActivityAnalyticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserActivityAnalyticsRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserActivityAnalyticsRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserActivityAnalyticsRequestValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserActivityAnalyticsRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserActivityAnalyticsRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserActivityAnalyticsRequestValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Granularity
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserActivityAnalyticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserActivityAnalyticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserActivityAnalyticsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserActivityAnalyticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserActivityAnalyticsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUserActivityAnalyticsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetUserActivityAnalyticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserActivityAnalyticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserActivityAnalyticsRequestMultiError) AllErrors() []error { return m }
+
+// GetUserActivityAnalyticsRequestValidationError is the validation error
+// returned by GetUserActivityAnalyticsRequest.Validate if the designated
+// constraints aren't met.
+type GetUserActivityAnalyticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserActivityAnalyticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserActivityAnalyticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserActivityAnalyticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserActivityAnalyticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserActivityAnalyticsRequestValidationError) ErrorName() string {
+	return "GetUserActivityAnalyticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserActivityAnalyticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserActivityAnalyticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserActivityAnalyticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserActivityAnalyticsRequestValidationError{}
+
+// Validate checks the field values on GetUserActivityAnalyticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetUserActivityAnalyticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserActivityAnalyticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetUserActivityAnalyticsResponseMultiError, or nil if none found.
+func (m *GetUserActivityAnalyticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserActivityAnalyticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetActivityData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserActivityAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("ActivityData[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserActivityAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("ActivityData[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserActivityAnalyticsResponseValidationError{
+					field:  fmt.Sprintf("ActivityData[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserActivityAnalyticsResponseValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserActivityAnalyticsResponseValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserActivityAnalyticsResponseValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserActivityAnalyticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserActivityAnalyticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserActivityAnalyticsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserActivityAnalyticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserActivityAnalyticsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetUserActivityAnalyticsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserActivityAnalyticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserActivityAnalyticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserActivityAnalyticsResponseMultiError) AllErrors() []error { return m }
+
+// GetUserActivityAnalyticsResponseValidationError is the validation error
+// returned by GetUserActivityAnalyticsResponse.Validate if the designated
+// constraints aren't met.
+type GetUserActivityAnalyticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserActivityAnalyticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserActivityAnalyticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserActivityAnalyticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserActivityAnalyticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserActivityAnalyticsResponseValidationError) ErrorName() string {
+	return "GetUserActivityAnalyticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserActivityAnalyticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserActivityAnalyticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserActivityAnalyticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserActivityAnalyticsResponseValidationError{}
+
+// Validate checks the field values on ActivityDataPoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ActivityDataPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivityDataPoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActivityDataPointMultiError, or nil if none found.
+func (m *ActivityDataPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivityDataPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActivityDataPointValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActivityDataPointValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActivityDataPointValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Searches
+
+	// no validation rules for Favorites
+
+	// no validation rules for ItineraryActions
+
+	// no validation rules for ChatMessages
+
+	if len(errors) > 0 {
+		return ActivityDataPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivityDataPointMultiError is an error wrapping multiple validation errors
+// returned by ActivityDataPoint.ValidateAll() if the designated constraints
+// aren't met.
+type ActivityDataPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivityDataPointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivityDataPointMultiError) AllErrors() []error { return m }
+
+// ActivityDataPointValidationError is the validation error returned by
+// ActivityDataPoint.Validate if the designated constraints aren't met.
+type ActivityDataPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivityDataPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivityDataPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivityDataPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivityDataPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivityDataPointValidationError) ErrorName() string {
+	return "ActivityDataPointValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActivityDataPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivityDataPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivityDataPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivityDataPointValidationError{}
+
+// Validate checks the field values on ActivitySummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ActivitySummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivitySummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActivitySummaryMultiError, or nil if none found.
+func (m *ActivitySummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivitySummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalSearches
+
+	// no validation rules for TotalFavorites
+
+	// no validation rules for TotalItineraryActions
+
+	// no validation rules for MostActiveHour
+
+	// no validation rules for MostActiveDay
+
+	if len(errors) > 0 {
+		return ActivitySummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivitySummaryMultiError is an error wrapping multiple validation errors
+// returned by ActivitySummary.ValidateAll() if the designated constraints
+// aren't met.
+type ActivitySummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivitySummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivitySummaryMultiError) AllErrors() []error { return m }
+
+// ActivitySummaryValidationError is the validation error returned by
+// ActivitySummary.Validate if the designated constraints aren't met.
+type ActivitySummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivitySummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivitySummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivitySummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivitySummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivitySummaryValidationError) ErrorName() string { return "ActivitySummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActivitySummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivitySummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivitySummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivitySummaryValidationError{}
+
+// Validate checks the field values on GetSystemAnalyticsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSystemAnalyticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSystemAnalyticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSystemAnalyticsRequestMultiError, or nil if none found.
+func (m *GetSystemAnalyticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSystemAnalyticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TimeRange
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSystemAnalyticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSystemAnalyticsRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSystemAnalyticsRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSystemAnalyticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSystemAnalyticsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSystemAnalyticsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetSystemAnalyticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSystemAnalyticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSystemAnalyticsRequestMultiError) AllErrors() []error { return m }
+
+// GetSystemAnalyticsRequestValidationError is the validation error returned by
+// GetSystemAnalyticsRequest.Validate if the designated constraints aren't met.
+type GetSystemAnalyticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSystemAnalyticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSystemAnalyticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSystemAnalyticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSystemAnalyticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSystemAnalyticsRequestValidationError) ErrorName() string {
+	return "GetSystemAnalyticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSystemAnalyticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSystemAnalyticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSystemAnalyticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSystemAnalyticsRequestValidationError{}
+
+// Validate checks the field values on GetSystemAnalyticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSystemAnalyticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSystemAnalyticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSystemAnalyticsResponseMultiError, or nil if none found.
+func (m *GetSystemAnalyticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSystemAnalyticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAnalytics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSystemAnalyticsResponseValidationError{
+					field:  "Analytics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSystemAnalyticsResponseValidationError{
+					field:  "Analytics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnalytics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSystemAnalyticsResponseValidationError{
+				field:  "Analytics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSystemAnalyticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSystemAnalyticsResponseValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSystemAnalyticsResponseValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSystemAnalyticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSystemAnalyticsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSystemAnalyticsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetSystemAnalyticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSystemAnalyticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSystemAnalyticsResponseMultiError) AllErrors() []error { return m }
+
+// GetSystemAnalyticsResponseValidationError is the validation error returned
+// by GetSystemAnalyticsResponse.Validate if the designated constraints aren't met.
+type GetSystemAnalyticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSystemAnalyticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSystemAnalyticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSystemAnalyticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSystemAnalyticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSystemAnalyticsResponseValidationError) ErrorName() string {
+	return "GetSystemAnalyticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSystemAnalyticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSystemAnalyticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSystemAnalyticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSystemAnalyticsResponseValidationError{}
+
+// Validate checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseRequestMultiError, or
+// nil if none found.
+func (m *BaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Downstream
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return BaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseRequestMultiError is an error wrapping multiple validation errors
+// returned by BaseRequest.ValidateAll() if the designated constraints aren't met.
+type BaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseRequestMultiError) AllErrors() []error { return m }
+
+// BaseRequestValidationError is the validation error returned by
+// BaseRequest.Validate if the designated constraints aren't met.
+type BaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseRequestValidationError) ErrorName() string { return "BaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseRequestValidationError{}
+
+// Validate checks the field values on BaseResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BaseResponseMultiError, or
+// nil if none found.
+func (m *BaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Upstream
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BaseResponseMultiError is an error wrapping multiple validation errors
+// returned by BaseResponse.ValidateAll() if the designated constraints aren't met.
+type BaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BaseResponseMultiError) AllErrors() []error { return m }
+
+// BaseResponseValidationError is the validation error returned by
+// BaseResponse.Validate if the designated constraints aren't met.
+type BaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BaseResponseValidationError) ErrorName() string { return "BaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BaseResponseValidationError{}
@@ -12,9 +12,9 @@ import (
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.StatisticsServiceClient
 }
 
 var (
@@ -22,7 +22,7 @@ var (
 	_ core.Broker               = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -30,47 +30,82 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewStatisticsServiceClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.StatisticsServiceClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewStatisticsServiceClient(conn), nil
+}
+
 // StatisticsService method implementations
 func (b *Broker) GetMainPageStatistics(ctx context.Context, in *c.GetMainPageStatisticsRequest, opts ...grpc.CallOption) (*c.GetMainPageStatisticsResponse, error) {
-	return b.client.GetMainPageStatistics(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetMainPageStatistics(ctx, in, opts...)
 }
 
 // Streaming method
 func (b *Broker) StreamMainPageStatistics(ctx context.Context, in *c.StreamMainPageStatisticsRequest, opts ...grpc.CallOption) (c.StatisticsService_StreamMainPageStatisticsClient, error) {
-	return b.client.StreamMainPageStatistics(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.StreamMainPageStatistics(ctx, in, opts...)
 }
 
 func (b *Broker) GetDetailedPOIStatistics(ctx context.Context, in *c.GetDetailedPOIStatisticsRequest, opts ...grpc.CallOption) (*c.GetDetailedPOIStatisticsResponse, error) {
-	return b.client.GetDetailedPOIStatistics(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetDetailedPOIStatistics(ctx, in, opts...)
 }
 
 func (b *Broker) GetLandingPageStatistics(ctx context.Context, in *c.GetLandingPageStatisticsRequest, opts ...grpc.CallOption) (*c.GetLandingPageStatisticsResponse, error) {
-	return b.client.GetLandingPageStatistics(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetLandingPageStatistics(ctx, in, opts...)
 }
 
 func (b *Broker) GetUserActivityAnalytics(ctx context.Context, in *c.GetUserActivityAnalyticsRequest, opts ...grpc.CallOption) (*c.GetUserActivityAnalyticsResponse, error) {
-	return b.client.GetUserActivityAnalytics(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetUserActivityAnalytics(ctx, in, opts...)
 }
 
 func (b *Broker) GetSystemAnalytics(ctx context.Context, in *c.GetSystemAnalyticsRequest, opts ...grpc.CallOption) (*c.GetSystemAnalyticsResponse, error) {
-	return b.client.GetSystemAnalytics(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetSystemAnalytics(ctx, in, opts...)
 }
\ No newline at end of file
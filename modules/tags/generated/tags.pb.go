@@ -7,6 +7,7 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -265,7 +266,7 @@ func (x *UpdatePersonalTagParams) GetActive() bool {
 type GetTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -307,7 +308,7 @@ func (x *GetTagsRequest) GetUserId() string {
 	return ""
 }
 
-func (x *GetTagsRequest) GetRequest() *BaseRequest {
+func (x *GetTagsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -315,9 +316,9 @@ func (x *GetTagsRequest) GetRequest() *BaseRequest {
 }
 
 type GetTagsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []*PersonalTag         `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Tags          []*PersonalTag          `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -359,7 +360,7 @@ func (x *GetTagsResponse) GetTags() []*PersonalTag {
 	return nil
 }
 
-func (x *GetTagsResponse) GetResponse() *BaseResponse {
+func (x *GetTagsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -370,7 +371,7 @@ type GetTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	TagId         string                 `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -419,7 +420,7 @@ func (x *GetTagRequest) GetTagId() string {
 	return ""
 }
 
-func (x *GetTagRequest) GetRequest() *BaseRequest {
+func (x *GetTagRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -427,9 +428,9 @@ func (x *GetTagRequest) GetRequest() *BaseRequest {
 }
 
 type GetTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tag           *PersonalTag           `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Tag           *PersonalTag            `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -471,7 +472,7 @@ func (x *GetTagResponse) GetTag() *PersonalTag {
 	return nil
 }
 
-func (x *GetTagResponse) GetResponse() *BaseResponse {
+func (x *GetTagResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -482,7 +483,7 @@ type CreateTagRequest struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	UserId        string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Tag           *CreatePersonalTagParams `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	Request       *BaseRequest             `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest   `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -531,7 +532,7 @@ func (x *CreateTagRequest) GetTag() *CreatePersonalTagParams {
 	return nil
 }
 
-func (x *CreateTagRequest) GetRequest() *BaseRequest {
+func (x *CreateTagRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -539,11 +540,11 @@ func (x *CreateTagRequest) GetRequest() *BaseRequest {
 }
 
 type CreateTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Tag           *PersonalTag           `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tag           *PersonalTag            `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -599,7 +600,7 @@ func (x *CreateTagResponse) GetTag() *PersonalTag {
 	return nil
 }
 
-func (x *CreateTagResponse) GetResponse() *BaseResponse {
+func (x *CreateTagResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -611,7 +612,7 @@ type UpdateTagRequest struct {
 	UserId        string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	TagId         string                   `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
 	Tag           *UpdatePersonalTagParams `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Request       *BaseRequest             `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest   `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -667,7 +668,7 @@ func (x *UpdateTagRequest) GetTag() *UpdatePersonalTagParams {
 	return nil
 }
 
-func (x *UpdateTagRequest) GetRequest() *BaseRequest {
+func (x *UpdateTagRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -675,11 +676,11 @@ func (x *UpdateTagRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Tag           *PersonalTag           `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tag           *PersonalTag            `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -735,7 +736,7 @@ func (x *UpdateTagResponse) GetTag() *PersonalTag {
 	return nil
 }
 
-func (x *UpdateTagResponse) GetResponse() *BaseResponse {
+func (x *UpdateTagResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -746,7 +747,7 @@ type DeleteTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	TagId         string                 `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -795,7 +796,7 @@ func (x *DeleteTagRequest) GetTagId() string {
 	return ""
 }
 
-func (x *DeleteTagRequest) GetRequest() *BaseRequest {
+func (x *DeleteTagRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -803,10 +804,10 @@ func (x *DeleteTagRequest) GetRequest() *BaseRequest {
 }
 
 type DeleteTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -855,13 +856,17 @@ func (x *DeleteTagResponse) GetMessage() string {
 	return ""
 }
 
-func (x *DeleteTagResponse) GetResponse() *BaseResponse {
+func (x *DeleteTagResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in tags.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -914,6 +919,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in tags.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -979,7 +988,7 @@ var File_tags_proto protoreflect.FileDescriptor
 const file_tags_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"tags.proto\x12\x0eai_poi.tags.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x95\x02\n" +
+	"tags.proto\x12\x0eai_poi.tags.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x95\x02\n" +
 	"\vPersonalTag\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
@@ -1000,58 +1009,58 @@ const file_tags_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
 	"\btag_type\x18\x03 \x01(\tR\atagType\x12\x16\n" +
-	"\x06active\x18\x04 \x01(\bR\x06active\"`\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\"b\n" +
 	"\x0eGetTagsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.tags.v1.BaseRequestR\arequest\"|\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"~\n" +
 	"\x0fGetTagsResponse\x12/\n" +
-	"\x04tags\x18\x01 \x03(\v2\x1b.ai_poi.tags.v1.PersonalTagR\x04tags\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.tags.v1.BaseResponseR\bresponse\"v\n" +
+	"\x04tags\x18\x01 \x03(\v2\x1b.ai_poi.tags.v1.PersonalTagR\x04tags\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"x\n" +
 	"\rGetTagRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
-	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.tags.v1.BaseRequestR\arequest\"y\n" +
+	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"{\n" +
 	"\x0eGetTagResponse\x12-\n" +
-	"\x03tag\x18\x01 \x01(\v2\x1b.ai_poi.tags.v1.PersonalTagR\x03tag\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.tags.v1.BaseResponseR\bresponse\"\x9d\x01\n" +
+	"\x03tag\x18\x01 \x01(\v2\x1b.ai_poi.tags.v1.PersonalTagR\x03tag\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x9f\x01\n" +
 	"\x10CreateTagRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
-	"\x03tag\x18\x02 \x01(\v2'.ai_poi.tags.v1.CreatePersonalTagParamsR\x03tag\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.tags.v1.BaseRequestR\arequest\"\xb0\x01\n" +
+	"\x03tag\x18\x02 \x01(\v2'.ai_poi.tags.v1.CreatePersonalTagParamsR\x03tag\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xb2\x01\n" +
 	"\x11CreateTagResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
-	"\x03tag\x18\x03 \x01(\v2\x1b.ai_poi.tags.v1.PersonalTagR\x03tag\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.tags.v1.BaseResponseR\bresponse\"\xb4\x01\n" +
+	"\x03tag\x18\x03 \x01(\v2\x1b.ai_poi.tags.v1.PersonalTagR\x03tag\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xb6\x01\n" +
 	"\x10UpdateTagRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
 	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x129\n" +
-	"\x03tag\x18\x03 \x01(\v2'.ai_poi.tags.v1.UpdatePersonalTagParamsR\x03tag\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.tags.v1.BaseRequestR\arequest\"\xb0\x01\n" +
+	"\x03tag\x18\x03 \x01(\v2'.ai_poi.tags.v1.UpdatePersonalTagParamsR\x03tag\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xb2\x01\n" +
 	"\x11UpdateTagResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
-	"\x03tag\x18\x03 \x01(\v2\x1b.ai_poi.tags.v1.PersonalTagR\x03tag\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.tags.v1.BaseResponseR\bresponse\"y\n" +
+	"\x03tag\x18\x03 \x01(\v2\x1b.ai_poi.tags.v1.PersonalTagR\x03tag\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"{\n" +
 	"\x10DeleteTagRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
-	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.tags.v1.BaseRequestR\arequest\"\x81\x01\n" +
+	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x83\x01\n" +
 	"\x11DeleteTagResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.tags.v1.BaseResponseR\bresponse\"N\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status2\x98\x03\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x012\x98\x03\n" +
 	"\vTagsService\x12J\n" +
 	"\aGetTags\x12\x1e.ai_poi.tags.v1.GetTagsRequest\x1a\x1f.ai_poi.tags.v1.GetTagsResponse\x12G\n" +
 	"\x06GetTag\x12\x1d.ai_poi.tags.v1.GetTagRequest\x1a\x1e.ai_poi.tags.v1.GetTagResponse\x12P\n" +
@@ -1089,26 +1098,28 @@ var file_tags_proto_goTypes = []any{
 	(*BaseRequest)(nil),             // 13: ai_poi.tags.v1.BaseRequest
 	(*BaseResponse)(nil),            // 14: ai_poi.tags.v1.BaseResponse
 	(*timestamppb.Timestamp)(nil),   // 15: google.protobuf.Timestamp
+	(*generated.BaseRequest)(nil),   // 16: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),  // 17: ai_poi.common.v1.BaseResponse
 }
 var file_tags_proto_depIdxs = []int32{
 	15, // 0: ai_poi.tags.v1.PersonalTag.created_at:type_name -> google.protobuf.Timestamp
 	15, // 1: ai_poi.tags.v1.PersonalTag.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 2: ai_poi.tags.v1.GetTagsRequest.request:type_name -> ai_poi.tags.v1.BaseRequest
+	16, // 2: ai_poi.tags.v1.GetTagsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	0,  // 3: ai_poi.tags.v1.GetTagsResponse.tags:type_name -> ai_poi.tags.v1.PersonalTag
-	14, // 4: ai_poi.tags.v1.GetTagsResponse.response:type_name -> ai_poi.tags.v1.BaseResponse
-	13, // 5: ai_poi.tags.v1.GetTagRequest.request:type_name -> ai_poi.tags.v1.BaseRequest
+	17, // 4: ai_poi.tags.v1.GetTagsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	16, // 5: ai_poi.tags.v1.GetTagRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	0,  // 6: ai_poi.tags.v1.GetTagResponse.tag:type_name -> ai_poi.tags.v1.PersonalTag
-	14, // 7: ai_poi.tags.v1.GetTagResponse.response:type_name -> ai_poi.tags.v1.BaseResponse
+	17, // 7: ai_poi.tags.v1.GetTagResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	1,  // 8: ai_poi.tags.v1.CreateTagRequest.tag:type_name -> ai_poi.tags.v1.CreatePersonalTagParams
-	13, // 9: ai_poi.tags.v1.CreateTagRequest.request:type_name -> ai_poi.tags.v1.BaseRequest
+	16, // 9: ai_poi.tags.v1.CreateTagRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	0,  // 10: ai_poi.tags.v1.CreateTagResponse.tag:type_name -> ai_poi.tags.v1.PersonalTag
-	14, // 11: ai_poi.tags.v1.CreateTagResponse.response:type_name -> ai_poi.tags.v1.BaseResponse
+	17, // 11: ai_poi.tags.v1.CreateTagResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	2,  // 12: ai_poi.tags.v1.UpdateTagRequest.tag:type_name -> ai_poi.tags.v1.UpdatePersonalTagParams
-	13, // 13: ai_poi.tags.v1.UpdateTagRequest.request:type_name -> ai_poi.tags.v1.BaseRequest
+	16, // 13: ai_poi.tags.v1.UpdateTagRequest.request:type_name -> ai_poi.common.v1.BaseRequest
 	0,  // 14: ai_poi.tags.v1.UpdateTagResponse.tag:type_name -> ai_poi.tags.v1.PersonalTag
-	14, // 15: ai_poi.tags.v1.UpdateTagResponse.response:type_name -> ai_poi.tags.v1.BaseResponse
-	13, // 16: ai_poi.tags.v1.DeleteTagRequest.request:type_name -> ai_poi.tags.v1.BaseRequest
-	14, // 17: ai_poi.tags.v1.DeleteTagResponse.response:type_name -> ai_poi.tags.v1.BaseResponse
+	17, // 15: ai_poi.tags.v1.UpdateTagResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	16, // 16: ai_poi.tags.v1.DeleteTagRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	17, // 17: ai_poi.tags.v1.DeleteTagResponse.response:type_name -> ai_poi.common.v1.BaseResponse
 	3,  // 18: ai_poi.tags.v1.TagsService.GetTags:input_type -> ai_poi.tags.v1.GetTagsRequest
 	5,  // 19: ai_poi.tags.v1.TagsService.GetTag:input_type -> ai_poi.tags.v1.GetTagRequest
 	7,  // 20: ai_poi.tags.v1.TagsService.CreateTag:input_type -> ai_poi.tags.v1.CreateTagRequest
@@ -14,9 +14,9 @@ import (
 )
 
 type Broker struct {
+	*core.Lifecycle
+
 	serverAddr string
-	conn       *grpc.ClientConn
-	client     c.TagsServiceClient
 }
 
 var (
@@ -24,7 +24,7 @@ var (
 	_ core.Broker         = (*Broker)(nil)
 )
 
-func NewBroker(serverAddr string) (*Broker, error) {
+func NewBroker(serverAddr string, opts ...utils.Option) (*Broker, error) {
 	b := new(Broker)
 	b.serverAddr = serverAddr
 
@@ -32,41 +32,71 @@ func NewBroker(serverAddr string) (*Broker, error) {
 		return nil, errors.New("null routed upstream host")
 	}
 
+	b.Lifecycle = core.NewLifecycle(b.serverAddr, utils.NewOptions(opts...).Dialer())
+
 	return b, nil
 }
 
+// NewConnection dials the upstream host eagerly. Calling it is optional:
+// every method dials on first use.
 func (b *Broker) NewConnection() (*grpc.ClientConn, error) {
-	conn, err := utils.NewConnection(b.serverAddr)
-	if err != nil {
-		return nil, errors.New("could not open connection")
-	}
-
-	b.conn = conn
-	b.client = c.NewTagsServiceClient(b.conn)
-
-	return b.conn, nil
+	return b.ClientConn()
 }
 
 func (b *Broker) GetAddress() string {
 	return b.serverAddr
 }
 
+func (b *Broker) client() (c.TagsServiceClient, error) {
+	conn, err := b.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewTagsServiceClient(conn), nil
+}
+
 func (b *Broker) GetTags(ctx context.Context, in *c.GetTagsRequest, opts ...grpc.CallOption) (*c.GetTagsResponse, error) {
-	return b.client.GetTags(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetTags(ctx, in, opts...)
 }
 
 func (b *Broker) GetTag(ctx context.Context, in *c.GetTagRequest, opts ...grpc.CallOption) (*c.GetTagResponse, error) {
-	return b.client.GetTag(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetTag(ctx, in, opts...)
 }
 
 func (b *Broker) CreateTag(ctx context.Context, in *c.CreateTagRequest, opts ...grpc.CallOption) (*c.CreateTagResponse, error) {
-	return b.client.CreateTag(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CreateTag(ctx, in, opts...)
 }
 
 func (b *Broker) UpdateTag(ctx context.Context, in *c.UpdateTagRequest, opts ...grpc.CallOption) (*c.UpdateTagResponse, error) {
-	return b.client.UpdateTag(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.UpdateTag(ctx, in, opts...)
 }
 
 func (b *Broker) DeleteTag(ctx context.Context, in *c.DeleteTagRequest, opts ...grpc.CallOption) (*c.DeleteTagResponse, error) {
-	return b.client.DeleteTag(ctx, in, opts...)
+	client, err := b.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DeleteTag(ctx, in, opts...)
 }
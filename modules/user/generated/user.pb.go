@@ -7,6 +7,7 @@
 package v1
 
 import (
+	generated "github.com/FACorreiaa/loci-proto/modules/common/generated"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -641,8 +642,15 @@ type BudgetPreferences struct {
 	FoodBudget          float64                `protobuf:"fixed64,5,opt,name=food_budget,json=foodBudget,proto3" json:"food_budget,omitempty"`
 	ActivityBudget      float64                `protobuf:"fixed64,6,opt,name=activity_budget,json=activityBudget,proto3" json:"activity_budget,omitempty"`
 	TransportBudget     float64                `protobuf:"fixed64,7,opt,name=transport_budget,json=transportBudget,proto3" json:"transport_budget,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// The budgets as Money. Servers set both these and the plain budgets
+	// above, which are in currency.
+	DailyBudgetAmount         *generated.Money `protobuf:"bytes,8,opt,name=daily_budget_amount,json=dailyBudgetAmount,proto3" json:"daily_budget_amount,omitempty"`
+	AccommodationBudgetAmount *generated.Money `protobuf:"bytes,9,opt,name=accommodation_budget_amount,json=accommodationBudgetAmount,proto3" json:"accommodation_budget_amount,omitempty"`
+	FoodBudgetAmount          *generated.Money `protobuf:"bytes,10,opt,name=food_budget_amount,json=foodBudgetAmount,proto3" json:"food_budget_amount,omitempty"`
+	ActivityBudgetAmount      *generated.Money `protobuf:"bytes,11,opt,name=activity_budget_amount,json=activityBudgetAmount,proto3" json:"activity_budget_amount,omitempty"`
+	TransportBudgetAmount     *generated.Money `protobuf:"bytes,12,opt,name=transport_budget_amount,json=transportBudgetAmount,proto3" json:"transport_budget_amount,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *BudgetPreferences) Reset() {
@@ -724,6 +732,41 @@ func (x *BudgetPreferences) GetTransportBudget() float64 {
 	return 0
 }
 
+func (x *BudgetPreferences) GetDailyBudgetAmount() *generated.Money {
+	if x != nil {
+		return x.DailyBudgetAmount
+	}
+	return nil
+}
+
+func (x *BudgetPreferences) GetAccommodationBudgetAmount() *generated.Money {
+	if x != nil {
+		return x.AccommodationBudgetAmount
+	}
+	return nil
+}
+
+func (x *BudgetPreferences) GetFoodBudgetAmount() *generated.Money {
+	if x != nil {
+		return x.FoodBudgetAmount
+	}
+	return nil
+}
+
+func (x *BudgetPreferences) GetActivityBudgetAmount() *generated.Money {
+	if x != nil {
+		return x.ActivityBudgetAmount
+	}
+	return nil
+}
+
+func (x *BudgetPreferences) GetTransportBudgetAmount() *generated.Money {
+	if x != nil {
+		return x.TransportBudgetAmount
+	}
+	return nil
+}
+
 // Accessibility needs
 type AccessibilityNeeds struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -1108,7 +1151,7 @@ func (x *UserStats) GetMemberSince() *timestamppb.Timestamp {
 type GetUserProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1150,7 +1193,7 @@ func (x *GetUserProfileRequest) GetUserId() string {
 	return ""
 }
 
-func (x *GetUserProfileRequest) GetRequest() *BaseRequest {
+func (x *GetUserProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1158,10 +1201,10 @@ func (x *GetUserProfileRequest) GetRequest() *BaseRequest {
 }
 
 type GetUserProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
-	Stats         *UserStats             `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Profile       *UserProfile            `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Stats         *UserStats              `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1210,7 +1253,7 @@ func (x *GetUserProfileResponse) GetStats() *UserStats {
 	return nil
 }
 
-func (x *GetUserProfileResponse) GetResponse() *BaseResponse {
+func (x *GetUserProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1222,7 +1265,7 @@ type UpdateUserProfileRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Profile       *UserProfile           `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
 	UpdateFields  []string               `protobuf:"bytes,3,rep,name=update_fields,json=updateFields,proto3" json:"update_fields,omitempty"` // Field mask for partial updates
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1278,7 +1321,7 @@ func (x *UpdateUserProfileRequest) GetUpdateFields() []string {
 	return nil
 }
 
-func (x *UpdateUserProfileRequest) GetRequest() *BaseRequest {
+func (x *UpdateUserProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1286,11 +1329,11 @@ func (x *UpdateUserProfileRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateUserProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Profile       *UserProfile           `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Profile       *UserProfile            `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1346,7 +1389,7 @@ func (x *UpdateUserProfileResponse) GetProfile() *UserProfile {
 	return nil
 }
 
-func (x *UpdateUserProfileResponse) GetResponse() *BaseResponse {
+func (x *UpdateUserProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1357,7 +1400,7 @@ func (x *UpdateUserProfileResponse) GetResponse() *BaseResponse {
 type GetSearchProfilesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1399,7 +1442,7 @@ func (x *GetSearchProfilesRequest) GetUserId() string {
 	return ""
 }
 
-func (x *GetSearchProfilesRequest) GetRequest() *BaseRequest {
+func (x *GetSearchProfilesRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1407,10 +1450,10 @@ func (x *GetSearchProfilesRequest) GetRequest() *BaseRequest {
 }
 
 type GetSearchProfilesResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Profiles         []*SearchProfile       `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
-	DefaultProfileId string                 `protobuf:"bytes,2,opt,name=default_profile_id,json=defaultProfileId,proto3" json:"default_profile_id,omitempty"`
-	Response         *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Profiles         []*SearchProfile        `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	DefaultProfileId string                  `protobuf:"bytes,2,opt,name=default_profile_id,json=defaultProfileId,proto3" json:"default_profile_id,omitempty"`
+	Response         *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1459,7 +1502,7 @@ func (x *GetSearchProfilesResponse) GetDefaultProfileId() string {
 	return ""
 }
 
-func (x *GetSearchProfilesResponse) GetResponse() *BaseResponse {
+func (x *GetSearchProfilesResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1470,7 +1513,7 @@ type GetSearchProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ProfileId     string                 `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1519,7 +1562,7 @@ func (x *GetSearchProfileRequest) GetProfileId() string {
 	return ""
 }
 
-func (x *GetSearchProfileRequest) GetRequest() *BaseRequest {
+func (x *GetSearchProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1527,9 +1570,9 @@ func (x *GetSearchProfileRequest) GetRequest() *BaseRequest {
 }
 
 type GetSearchProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *SearchProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Profile       *SearchProfile          `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1571,7 +1614,7 @@ func (x *GetSearchProfileResponse) GetProfile() *SearchProfile {
 	return nil
 }
 
-func (x *GetSearchProfileResponse) GetResponse() *BaseResponse {
+func (x *GetSearchProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1582,7 +1625,7 @@ type CreateSearchProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Profile       *SearchProfile         `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1631,7 +1674,7 @@ func (x *CreateSearchProfileRequest) GetProfile() *SearchProfile {
 	return nil
 }
 
-func (x *CreateSearchProfileRequest) GetRequest() *BaseRequest {
+func (x *CreateSearchProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1639,11 +1682,11 @@ func (x *CreateSearchProfileRequest) GetRequest() *BaseRequest {
 }
 
 type CreateSearchProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Profile       *SearchProfile         `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Profile       *SearchProfile          `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1699,7 +1742,7 @@ func (x *CreateSearchProfileResponse) GetProfile() *SearchProfile {
 	return nil
 }
 
-func (x *CreateSearchProfileResponse) GetResponse() *BaseResponse {
+func (x *CreateSearchProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1712,7 +1755,7 @@ type UpdateSearchProfileRequest struct {
 	ProfileId     string                 `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
 	Profile       *SearchProfile         `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
 	UpdateFields  []string               `protobuf:"bytes,4,rep,name=update_fields,json=updateFields,proto3" json:"update_fields,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1775,7 +1818,7 @@ func (x *UpdateSearchProfileRequest) GetUpdateFields() []string {
 	return nil
 }
 
-func (x *UpdateSearchProfileRequest) GetRequest() *BaseRequest {
+func (x *UpdateSearchProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1783,11 +1826,11 @@ func (x *UpdateSearchProfileRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateSearchProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Profile       *SearchProfile         `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Profile       *SearchProfile          `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1843,7 +1886,7 @@ func (x *UpdateSearchProfileResponse) GetProfile() *SearchProfile {
 	return nil
 }
 
-func (x *UpdateSearchProfileResponse) GetResponse() *BaseResponse {
+func (x *UpdateSearchProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1854,7 +1897,7 @@ type DeleteSearchProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ProfileId     string                 `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1903,7 +1946,7 @@ func (x *DeleteSearchProfileRequest) GetProfileId() string {
 	return ""
 }
 
-func (x *DeleteSearchProfileRequest) GetRequest() *BaseRequest {
+func (x *DeleteSearchProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -1911,10 +1954,10 @@ func (x *DeleteSearchProfileRequest) GetRequest() *BaseRequest {
 }
 
 type DeleteSearchProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1963,7 +2006,7 @@ func (x *DeleteSearchProfileResponse) GetMessage() string {
 	return ""
 }
 
-func (x *DeleteSearchProfileResponse) GetResponse() *BaseResponse {
+func (x *DeleteSearchProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -1973,7 +2016,7 @@ func (x *DeleteSearchProfileResponse) GetResponse() *BaseResponse {
 type GetDefaultProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2015,7 +2058,7 @@ func (x *GetDefaultProfileRequest) GetUserId() string {
 	return ""
 }
 
-func (x *GetDefaultProfileRequest) GetRequest() *BaseRequest {
+func (x *GetDefaultProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2023,9 +2066,9 @@ func (x *GetDefaultProfileRequest) GetRequest() *BaseRequest {
 }
 
 type GetDefaultProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *SearchProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Profile       *SearchProfile          `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2067,7 +2110,7 @@ func (x *GetDefaultProfileResponse) GetProfile() *SearchProfile {
 	return nil
 }
 
-func (x *GetDefaultProfileResponse) GetResponse() *BaseResponse {
+func (x *GetDefaultProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2078,7 +2121,7 @@ type SetDefaultProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ProfileId     string                 `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2127,7 +2170,7 @@ func (x *SetDefaultProfileRequest) GetProfileId() string {
 	return ""
 }
 
-func (x *SetDefaultProfileRequest) GetRequest() *BaseRequest {
+func (x *SetDefaultProfileRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2135,10 +2178,10 @@ func (x *SetDefaultProfileRequest) GetRequest() *BaseRequest {
 }
 
 type SetDefaultProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2187,7 +2230,7 @@ func (x *SetDefaultProfileResponse) GetMessage() string {
 	return ""
 }
 
-func (x *SetDefaultProfileResponse) GetResponse() *BaseResponse {
+func (x *SetDefaultProfileResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2198,7 +2241,7 @@ func (x *SetDefaultProfileResponse) GetResponse() *BaseResponse {
 type GetInterestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2240,7 +2283,7 @@ func (x *GetInterestsRequest) GetUserId() string {
 	return ""
 }
 
-func (x *GetInterestsRequest) GetRequest() *BaseRequest {
+func (x *GetInterestsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2248,9 +2291,9 @@ func (x *GetInterestsRequest) GetRequest() *BaseRequest {
 }
 
 type GetInterestsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Interests     []*Interest            `protobuf:"bytes,1,rep,name=interests,proto3" json:"interests,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Interests     []*Interest             `protobuf:"bytes,1,rep,name=interests,proto3" json:"interests,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2292,7 +2335,7 @@ func (x *GetInterestsResponse) GetInterests() []*Interest {
 	return nil
 }
 
-func (x *GetInterestsResponse) GetResponse() *BaseResponse {
+func (x *GetInterestsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2303,7 +2346,7 @@ type CreateInterestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Interest      *Interest              `protobuf:"bytes,2,opt,name=interest,proto3" json:"interest,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2352,7 +2395,7 @@ func (x *CreateInterestRequest) GetInterest() *Interest {
 	return nil
 }
 
-func (x *CreateInterestRequest) GetRequest() *BaseRequest {
+func (x *CreateInterestRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2360,11 +2403,11 @@ func (x *CreateInterestRequest) GetRequest() *BaseRequest {
 }
 
 type CreateInterestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Interest      *Interest              `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Interest      *Interest               `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2420,7 +2463,7 @@ func (x *CreateInterestResponse) GetInterest() *Interest {
 	return nil
 }
 
-func (x *CreateInterestResponse) GetResponse() *BaseResponse {
+func (x *CreateInterestResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2432,7 +2475,7 @@ type UpdateInterestRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	InterestId    string                 `protobuf:"bytes,2,opt,name=interest_id,json=interestId,proto3" json:"interest_id,omitempty"`
 	Interest      *Interest              `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2488,7 +2531,7 @@ func (x *UpdateInterestRequest) GetInterest() *Interest {
 	return nil
 }
 
-func (x *UpdateInterestRequest) GetRequest() *BaseRequest {
+func (x *UpdateInterestRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2496,11 +2539,11 @@ func (x *UpdateInterestRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateInterestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Interest      *Interest              `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Interest      *Interest               `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2556,7 +2599,7 @@ func (x *UpdateInterestResponse) GetInterest() *Interest {
 	return nil
 }
 
-func (x *UpdateInterestResponse) GetResponse() *BaseResponse {
+func (x *UpdateInterestResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2567,7 +2610,7 @@ type DeleteInterestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	InterestId    string                 `protobuf:"bytes,2,opt,name=interest_id,json=interestId,proto3" json:"interest_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2616,7 +2659,7 @@ func (x *DeleteInterestRequest) GetInterestId() string {
 	return ""
 }
 
-func (x *DeleteInterestRequest) GetRequest() *BaseRequest {
+func (x *DeleteInterestRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2624,10 +2667,10 @@ func (x *DeleteInterestRequest) GetRequest() *BaseRequest {
 }
 
 type DeleteInterestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2676,7 +2719,7 @@ func (x *DeleteInterestResponse) GetMessage() string {
 	return ""
 }
 
-func (x *DeleteInterestResponse) GetResponse() *BaseResponse {
+func (x *DeleteInterestResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2687,7 +2730,7 @@ func (x *DeleteInterestResponse) GetResponse() *BaseResponse {
 type GetTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2729,7 +2772,7 @@ func (x *GetTagsRequest) GetUserId() string {
 	return ""
 }
 
-func (x *GetTagsRequest) GetRequest() *BaseRequest {
+func (x *GetTagsRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2737,9 +2780,9 @@ func (x *GetTagsRequest) GetRequest() *BaseRequest {
 }
 
 type GetTagsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Tags          []*Tag                  `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2781,7 +2824,7 @@ func (x *GetTagsResponse) GetTags() []*Tag {
 	return nil
 }
 
-func (x *GetTagsResponse) GetResponse() *BaseResponse {
+func (x *GetTagsResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2792,7 +2835,7 @@ type GetTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	TagId         string                 `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2841,7 +2884,7 @@ func (x *GetTagRequest) GetTagId() string {
 	return ""
 }
 
-func (x *GetTagRequest) GetRequest() *BaseRequest {
+func (x *GetTagRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2849,9 +2892,9 @@ func (x *GetTagRequest) GetRequest() *BaseRequest {
 }
 
 type GetTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Tag           *Tag                    `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2893,7 +2936,7 @@ func (x *GetTagResponse) GetTag() *Tag {
 	return nil
 }
 
-func (x *GetTagResponse) GetResponse() *BaseResponse {
+func (x *GetTagResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -2904,7 +2947,7 @@ type CreateTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Tag           *Tag                   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2953,7 +2996,7 @@ func (x *CreateTagRequest) GetTag() *Tag {
 	return nil
 }
 
-func (x *CreateTagRequest) GetRequest() *BaseRequest {
+func (x *CreateTagRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -2961,11 +3004,11 @@ func (x *CreateTagRequest) GetRequest() *BaseRequest {
 }
 
 type CreateTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Tag           *Tag                   `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tag           *Tag                    `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3021,7 +3064,7 @@ func (x *CreateTagResponse) GetTag() *Tag {
 	return nil
 }
 
-func (x *CreateTagResponse) GetResponse() *BaseResponse {
+func (x *CreateTagResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -3033,7 +3076,7 @@ type UpdateTagRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	TagId         string                 `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
 	Tag           *Tag                   `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3089,7 +3132,7 @@ func (x *UpdateTagRequest) GetTag() *Tag {
 	return nil
 }
 
-func (x *UpdateTagRequest) GetRequest() *BaseRequest {
+func (x *UpdateTagRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -3097,11 +3140,11 @@ func (x *UpdateTagRequest) GetRequest() *BaseRequest {
 }
 
 type UpdateTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Tag           *Tag                   `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tag           *Tag                    `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3157,7 +3200,7 @@ func (x *UpdateTagResponse) GetTag() *Tag {
 	return nil
 }
 
-func (x *UpdateTagResponse) GetResponse() *BaseResponse {
+func (x *UpdateTagResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
@@ -3168,7 +3211,7 @@ type DeleteTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	TagId         string                 `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
-	Request       *BaseRequest           `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
+	Request       *generated.BaseRequest `protobuf:"bytes,100,opt,name=request,proto3" json:"request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3217,7 +3260,7 @@ func (x *DeleteTagRequest) GetTagId() string {
 	return ""
 }
 
-func (x *DeleteTagRequest) GetRequest() *BaseRequest {
+func (x *DeleteTagRequest) GetRequest() *generated.BaseRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -3225,10 +3268,10 @@ func (x *DeleteTagRequest) GetRequest() *BaseRequest {
 }
 
 type DeleteTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Response      *BaseResponse          `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Response      *generated.BaseResponse `protobuf:"bytes,100,opt,name=response,proto3" json:"response,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3277,13 +3320,17 @@ func (x *DeleteTagResponse) GetMessage() string {
 	return ""
 }
 
-func (x *DeleteTagResponse) GetResponse() *BaseResponse {
+func (x *DeleteTagResponse) GetResponse() *generated.BaseResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
+// Use ai_poi.common.v1.BaseRequest instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in user.proto.
 type BaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Downstream    string                 `protobuf:"bytes,998,opt,name=downstream,proto3" json:"downstream,omitempty"`
@@ -3336,6 +3383,10 @@ func (x *BaseRequest) GetRequestId() string {
 	return ""
 }
 
+// Use ai_poi.common.v1.BaseResponse instead. It has the same field
+// numbers, so both encode to the same bytes.
+//
+// Deprecated: Marked as deprecated in user.proto.
 type BaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Upstream      string                 `protobuf:"bytes,998,opt,name=upstream,proto3" json:"upstream,omitempty"`
@@ -3401,7 +3452,7 @@ var File_user_proto protoreflect.FileDescriptor
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"user.proto\x12\x0eai_poi.user.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x05\n" +
+	"user.proto\x12\x0eai_poi.user.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x05\n" +
 	"\vUserProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
@@ -3470,7 +3521,7 @@ const file_user_proto_rawDesc = "" +
 	"\x15prefers_popular_spots\x18\b \x01(\bR\x13prefersPopularSpots\x12.\n" +
 	"\x13prefers_hidden_gems\x18\t \x01(\bR\x11prefersHiddenGems\x12;\n" +
 	"\x19transportation_preference\x18\n" +
-	" \x01(\tR\x18transportationPreference\"\x9d\x02\n" +
+	" \x01(\tR\x18transportationPreference\"\xa6\x05\n" +
 	"\x11BudgetPreferences\x12!\n" +
 	"\fbudget_level\x18\x01 \x01(\tR\vbudgetLevel\x12\x1a\n" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12!\n" +
@@ -3479,7 +3530,13 @@ const file_user_proto_rawDesc = "" +
 	"\vfood_budget\x18\x05 \x01(\x01R\n" +
 	"foodBudget\x12'\n" +
 	"\x0factivity_budget\x18\x06 \x01(\x01R\x0eactivityBudget\x12)\n" +
-	"\x10transport_budget\x18\a \x01(\x01R\x0ftransportBudget\"\xbc\x02\n" +
+	"\x10transport_budget\x18\a \x01(\x01R\x0ftransportBudget\x12G\n" +
+	"\x13daily_budget_amount\x18\b \x01(\v2\x17.ai_poi.common.v1.MoneyR\x11dailyBudgetAmount\x12W\n" +
+	"\x1baccommodation_budget_amount\x18\t \x01(\v2\x17.ai_poi.common.v1.MoneyR\x19accommodationBudgetAmount\x12E\n" +
+	"\x12food_budget_amount\x18\n" +
+	" \x01(\v2\x17.ai_poi.common.v1.MoneyR\x10foodBudgetAmount\x12M\n" +
+	"\x16activity_budget_amount\x18\v \x01(\v2\x17.ai_poi.common.v1.MoneyR\x14activityBudgetAmount\x12O\n" +
+	"\x17transport_budget_amount\x18\f \x01(\v2\x17.ai_poi.common.v1.MoneyR\x15transportBudgetAmount\"\xbc\x02\n" +
 	"\x12AccessibilityNeeds\x123\n" +
 	"\x15wheelchair_accessible\x18\x01 \x01(\bR\x14wheelchairAccessible\x12-\n" +
 	"\x12hearing_assistance\x18\x02 \x01(\bR\x11hearingAssistance\x12+\n" +
@@ -3518,170 +3575,170 @@ const file_user_proto_rawDesc = "" +
 	"\x11countries_visited\x18\x04 \x01(\x05R\x10countriesVisited\x12'\n" +
 	"\x0fcities_explored\x18\x05 \x01(\x05R\x0ecitiesExplored\x12?\n" +
 	"\rlast_activity\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity\x12=\n" +
-	"\fmember_since\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vmemberSince\"g\n" +
+	"\fmember_since\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vmemberSince\"i\n" +
 	"\x15GetUserProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\xba\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xbc\x01\n" +
 	"\x16GetUserProfileResponse\x125\n" +
 	"\aprofile\x18\x01 \x01(\v2\x1b.ai_poi.user.v1.UserProfileR\aprofile\x12/\n" +
-	"\x05stats\x18\x02 \x01(\v2\x19.ai_poi.user.v1.UserStatsR\x05stats\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\xc6\x01\n" +
+	"\x05stats\x18\x02 \x01(\v2\x19.ai_poi.user.v1.UserStatsR\x05stats\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xc8\x01\n" +
 	"\x18UpdateUserProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
 	"\aprofile\x18\x02 \x01(\v2\x1b.ai_poi.user.v1.UserProfileR\aprofile\x12#\n" +
-	"\rupdate_fields\x18\x03 \x03(\tR\fupdateFields\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\xc0\x01\n" +
+	"\rupdate_fields\x18\x03 \x03(\tR\fupdateFields\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc2\x01\n" +
 	"\x19UpdateUserProfileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
-	"\aprofile\x18\x03 \x01(\v2\x1b.ai_poi.user.v1.UserProfileR\aprofile\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"j\n" +
+	"\aprofile\x18\x03 \x01(\v2\x1b.ai_poi.user.v1.UserProfileR\aprofile\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"l\n" +
 	"\x18GetSearchProfilesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\xbe\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc0\x01\n" +
 	"\x19GetSearchProfilesResponse\x129\n" +
 	"\bprofiles\x18\x01 \x03(\v2\x1d.ai_poi.user.v1.SearchProfileR\bprofiles\x12,\n" +
-	"\x12default_profile_id\x18\x02 \x01(\tR\x10defaultProfileId\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\x88\x01\n" +
+	"\x12default_profile_id\x18\x02 \x01(\tR\x10defaultProfileId\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8a\x01\n" +
 	"\x17GetSearchProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
-	"profile_id\x18\x02 \x01(\tR\tprofileId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\x8d\x01\n" +
+	"profile_id\x18\x02 \x01(\tR\tprofileId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x8f\x01\n" +
 	"\x18GetSearchProfileResponse\x127\n" +
-	"\aprofile\x18\x01 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\xa5\x01\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xa7\x01\n" +
 	"\x1aCreateSearchProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
-	"\aprofile\x18\x02 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\xc4\x01\n" +
+	"\aprofile\x18\x02 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc6\x01\n" +
 	"\x1bCreateSearchProfileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
-	"\aprofile\x18\x03 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\xe9\x01\n" +
+	"\aprofile\x18\x03 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xeb\x01\n" +
 	"\x1aUpdateSearchProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"profile_id\x18\x02 \x01(\tR\tprofileId\x127\n" +
 	"\aprofile\x18\x03 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x12#\n" +
-	"\rupdate_fields\x18\x04 \x03(\tR\fupdateFields\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\xc4\x01\n" +
+	"\rupdate_fields\x18\x04 \x03(\tR\fupdateFields\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xc6\x01\n" +
 	"\x1bUpdateSearchProfileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
-	"\aprofile\x18\x03 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\x8b\x01\n" +
+	"\aprofile\x18\x03 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8d\x01\n" +
 	"\x1aDeleteSearchProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
-	"profile_id\x18\x02 \x01(\tR\tprofileId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\x8b\x01\n" +
+	"profile_id\x18\x02 \x01(\tR\tprofileId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x8d\x01\n" +
 	"\x1bDeleteSearchProfileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"j\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"l\n" +
 	"\x18GetDefaultProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\x8e\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x90\x01\n" +
 	"\x19GetDefaultProfileResponse\x127\n" +
-	"\aprofile\x18\x01 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\x89\x01\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1d.ai_poi.user.v1.SearchProfileR\aprofile\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8b\x01\n" +
 	"\x18SetDefaultProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
-	"profile_id\x18\x02 \x01(\tR\tprofileId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\x89\x01\n" +
+	"profile_id\x18\x02 \x01(\tR\tprofileId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x8b\x01\n" +
 	"\x19SetDefaultProfileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"e\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"g\n" +
 	"\x13GetInterestsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\x88\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x8a\x01\n" +
 	"\x14GetInterestsResponse\x126\n" +
-	"\tinterests\x18\x01 \x03(\v2\x18.ai_poi.user.v1.InterestR\tinterests\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\x9d\x01\n" +
+	"\tinterests\x18\x01 \x03(\v2\x18.ai_poi.user.v1.InterestR\tinterests\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x9f\x01\n" +
 	"\x15CreateInterestRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x124\n" +
-	"\binterest\x18\x02 \x01(\v2\x18.ai_poi.user.v1.InterestR\binterest\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\xbc\x01\n" +
+	"\binterest\x18\x02 \x01(\v2\x18.ai_poi.user.v1.InterestR\binterest\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xbe\x01\n" +
 	"\x16CreateInterestResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
-	"\binterest\x18\x03 \x01(\v2\x18.ai_poi.user.v1.InterestR\binterest\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\xbe\x01\n" +
+	"\binterest\x18\x03 \x01(\v2\x18.ai_poi.user.v1.InterestR\binterest\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xc0\x01\n" +
 	"\x15UpdateInterestRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\vinterest_id\x18\x02 \x01(\tR\n" +
 	"interestId\x124\n" +
-	"\binterest\x18\x03 \x01(\v2\x18.ai_poi.user.v1.InterestR\binterest\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\xbc\x01\n" +
+	"\binterest\x18\x03 \x01(\v2\x18.ai_poi.user.v1.InterestR\binterest\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xbe\x01\n" +
 	"\x16UpdateInterestResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
-	"\binterest\x18\x03 \x01(\v2\x18.ai_poi.user.v1.InterestR\binterest\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\x88\x01\n" +
+	"\binterest\x18\x03 \x01(\v2\x18.ai_poi.user.v1.InterestR\binterest\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8a\x01\n" +
 	"\x15DeleteInterestRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\vinterest_id\x18\x02 \x01(\tR\n" +
-	"interestId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\x86\x01\n" +
+	"interestId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x88\x01\n" +
 	"\x16DeleteInterestResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"`\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"b\n" +
 	"\x0eGetTagsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"t\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"v\n" +
 	"\x0fGetTagsResponse\x12'\n" +
-	"\x04tags\x18\x01 \x03(\v2\x13.ai_poi.user.v1.TagR\x04tags\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"v\n" +
+	"\x04tags\x18\x01 \x03(\v2\x13.ai_poi.user.v1.TagR\x04tags\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"x\n" +
 	"\rGetTagRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
-	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"q\n" +
+	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"s\n" +
 	"\x0eGetTagResponse\x12%\n" +
-	"\x03tag\x18\x01 \x01(\v2\x13.ai_poi.user.v1.TagR\x03tag\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\x89\x01\n" +
+	"\x03tag\x18\x01 \x01(\v2\x13.ai_poi.user.v1.TagR\x03tag\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\x8b\x01\n" +
 	"\x10CreateTagRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
-	"\x03tag\x18\x02 \x01(\v2\x13.ai_poi.user.v1.TagR\x03tag\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\xa8\x01\n" +
+	"\x03tag\x18\x02 \x01(\v2\x13.ai_poi.user.v1.TagR\x03tag\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xaa\x01\n" +
 	"\x11CreateTagResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
-	"\x03tag\x18\x03 \x01(\v2\x13.ai_poi.user.v1.TagR\x03tag\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"\xa0\x01\n" +
+	"\x03tag\x18\x03 \x01(\v2\x13.ai_poi.user.v1.TagR\x03tag\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"\xa2\x01\n" +
 	"\x10UpdateTagRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
 	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x12%\n" +
-	"\x03tag\x18\x03 \x01(\v2\x13.ai_poi.user.v1.TagR\x03tag\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\xa8\x01\n" +
+	"\x03tag\x18\x03 \x01(\v2\x13.ai_poi.user.v1.TagR\x03tag\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\xaa\x01\n" +
 	"\x11UpdateTagResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
-	"\x03tag\x18\x03 \x01(\v2\x13.ai_poi.user.v1.TagR\x03tag\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"y\n" +
+	"\x03tag\x18\x03 \x01(\v2\x13.ai_poi.user.v1.TagR\x03tag\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"{\n" +
 	"\x10DeleteTagRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
-	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x125\n" +
-	"\arequest\x18d \x01(\v2\x1b.ai_poi.user.v1.BaseRequestR\arequest\"\x81\x01\n" +
+	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x127\n" +
+	"\arequest\x18d \x01(\v2\x1d.ai_poi.common.v1.BaseRequestR\arequest\"\x83\x01\n" +
 	"\x11DeleteTagResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\bresponse\x18d \x01(\v2\x1c.ai_poi.user.v1.BaseResponseR\bresponse\"N\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\bresponse\x18d \x01(\v2\x1e.ai_poi.common.v1.BaseResponseR\bresponse\"R\n" +
 	"\vBaseRequest\x12\x1f\n" +
 	"\n" +
 	"downstream\x18\xe6\a \x01(\tR\n" +
 	"downstream\x12\x1e\n" +
 	"\n" +
-	"request_id\x18\xe7\a \x01(\tR\trequestId\"d\n" +
+	"request_id\x18\xe7\a \x01(\tR\trequestId:\x02\x18\x01\"h\n" +
 	"\fBaseResponse\x12\x1b\n" +
 	"\bupstream\x18\xe6\a \x01(\tR\bupstream\x12\x1e\n" +
 	"\n" +
 	"request_id\x18\xe7\a \x01(\tR\trequestId\x12\x17\n" +
-	"\x06status\x18\xe8\a \x01(\tR\x06status2\xd6\r\n" +
+	"\x06status\x18\xe8\a \x01(\tR\x06status:\x02\x18\x012\xd6\r\n" +
 	"\vUserService\x12_\n" +
 	"\x0eGetUserProfile\x12%.ai_poi.user.v1.GetUserProfileRequest\x1a&.ai_poi.user.v1.GetUserProfileResponse\x12h\n" +
 	"\x11UpdateUserProfile\x12(.ai_poi.user.v1.UpdateUserProfileRequest\x1a).ai_poi.user.v1.UpdateUserProfileResponse\x12h\n" +
@@ -3765,6 +3822,9 @@ var file_user_proto_goTypes = []any{
 	(*BaseRequest)(nil),                 // 46: ai_poi.user.v1.BaseRequest
 	(*BaseResponse)(nil),                // 47: ai_poi.user.v1.BaseResponse
 	(*timestamppb.Timestamp)(nil),       // 48: google.protobuf.Timestamp
+	(*generated.Money)(nil),             // 49: ai_poi.common.v1.Money
+	(*generated.BaseRequest)(nil),       // 50: ai_poi.common.v1.BaseRequest
+	(*generated.BaseResponse)(nil),      // 51: ai_poi.common.v1.BaseResponse
 }
 var file_user_proto_depIdxs = []int32{
 	48, // 0: ai_poi.user.v1.UserProfile.date_of_birth:type_name -> google.protobuf.Timestamp
@@ -3777,111 +3837,116 @@ var file_user_proto_depIdxs = []int32{
 	6,  // 7: ai_poi.user.v1.SearchProfile.accessibility_needs:type_name -> ai_poi.user.v1.AccessibilityNeeds
 	48, // 8: ai_poi.user.v1.SearchProfile.created_at:type_name -> google.protobuf.Timestamp
 	48, // 9: ai_poi.user.v1.SearchProfile.updated_at:type_name -> google.protobuf.Timestamp
-	48, // 10: ai_poi.user.v1.Interest.created_at:type_name -> google.protobuf.Timestamp
-	48, // 11: ai_poi.user.v1.Interest.updated_at:type_name -> google.protobuf.Timestamp
-	48, // 12: ai_poi.user.v1.Tag.created_at:type_name -> google.protobuf.Timestamp
-	48, // 13: ai_poi.user.v1.Tag.updated_at:type_name -> google.protobuf.Timestamp
-	48, // 14: ai_poi.user.v1.UserStats.last_activity:type_name -> google.protobuf.Timestamp
-	48, // 15: ai_poi.user.v1.UserStats.member_since:type_name -> google.protobuf.Timestamp
-	46, // 16: ai_poi.user.v1.GetUserProfileRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	0,  // 17: ai_poi.user.v1.GetUserProfileResponse.profile:type_name -> ai_poi.user.v1.UserProfile
-	9,  // 18: ai_poi.user.v1.GetUserProfileResponse.stats:type_name -> ai_poi.user.v1.UserStats
-	47, // 19: ai_poi.user.v1.GetUserProfileResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	0,  // 20: ai_poi.user.v1.UpdateUserProfileRequest.profile:type_name -> ai_poi.user.v1.UserProfile
-	46, // 21: ai_poi.user.v1.UpdateUserProfileRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	0,  // 22: ai_poi.user.v1.UpdateUserProfileResponse.profile:type_name -> ai_poi.user.v1.UserProfile
-	47, // 23: ai_poi.user.v1.UpdateUserProfileResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	46, // 24: ai_poi.user.v1.GetSearchProfilesRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	3,  // 25: ai_poi.user.v1.GetSearchProfilesResponse.profiles:type_name -> ai_poi.user.v1.SearchProfile
-	47, // 26: ai_poi.user.v1.GetSearchProfilesResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	46, // 27: ai_poi.user.v1.GetSearchProfileRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	3,  // 28: ai_poi.user.v1.GetSearchProfileResponse.profile:type_name -> ai_poi.user.v1.SearchProfile
-	47, // 29: ai_poi.user.v1.GetSearchProfileResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	3,  // 30: ai_poi.user.v1.CreateSearchProfileRequest.profile:type_name -> ai_poi.user.v1.SearchProfile
-	46, // 31: ai_poi.user.v1.CreateSearchProfileRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	3,  // 32: ai_poi.user.v1.CreateSearchProfileResponse.profile:type_name -> ai_poi.user.v1.SearchProfile
-	47, // 33: ai_poi.user.v1.CreateSearchProfileResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	3,  // 34: ai_poi.user.v1.UpdateSearchProfileRequest.profile:type_name -> ai_poi.user.v1.SearchProfile
-	46, // 35: ai_poi.user.v1.UpdateSearchProfileRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	3,  // 36: ai_poi.user.v1.UpdateSearchProfileResponse.profile:type_name -> ai_poi.user.v1.SearchProfile
-	47, // 37: ai_poi.user.v1.UpdateSearchProfileResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	46, // 38: ai_poi.user.v1.DeleteSearchProfileRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	47, // 39: ai_poi.user.v1.DeleteSearchProfileResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	46, // 40: ai_poi.user.v1.GetDefaultProfileRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	3,  // 41: ai_poi.user.v1.GetDefaultProfileResponse.profile:type_name -> ai_poi.user.v1.SearchProfile
-	47, // 42: ai_poi.user.v1.GetDefaultProfileResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	46, // 43: ai_poi.user.v1.SetDefaultProfileRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	47, // 44: ai_poi.user.v1.SetDefaultProfileResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	46, // 45: ai_poi.user.v1.GetInterestsRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	7,  // 46: ai_poi.user.v1.GetInterestsResponse.interests:type_name -> ai_poi.user.v1.Interest
-	47, // 47: ai_poi.user.v1.GetInterestsResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	7,  // 48: ai_poi.user.v1.CreateInterestRequest.interest:type_name -> ai_poi.user.v1.Interest
-	46, // 49: ai_poi.user.v1.CreateInterestRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	7,  // 50: ai_poi.user.v1.CreateInterestResponse.interest:type_name -> ai_poi.user.v1.Interest
-	47, // 51: ai_poi.user.v1.CreateInterestResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	7,  // 52: ai_poi.user.v1.UpdateInterestRequest.interest:type_name -> ai_poi.user.v1.Interest
-	46, // 53: ai_poi.user.v1.UpdateInterestRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	7,  // 54: ai_poi.user.v1.UpdateInterestResponse.interest:type_name -> ai_poi.user.v1.Interest
-	47, // 55: ai_poi.user.v1.UpdateInterestResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	46, // 56: ai_poi.user.v1.DeleteInterestRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	47, // 57: ai_poi.user.v1.DeleteInterestResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	46, // 58: ai_poi.user.v1.GetTagsRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	8,  // 59: ai_poi.user.v1.GetTagsResponse.tags:type_name -> ai_poi.user.v1.Tag
-	47, // 60: ai_poi.user.v1.GetTagsResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	46, // 61: ai_poi.user.v1.GetTagRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	8,  // 62: ai_poi.user.v1.GetTagResponse.tag:type_name -> ai_poi.user.v1.Tag
-	47, // 63: ai_poi.user.v1.GetTagResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	8,  // 64: ai_poi.user.v1.CreateTagRequest.tag:type_name -> ai_poi.user.v1.Tag
-	46, // 65: ai_poi.user.v1.CreateTagRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	8,  // 66: ai_poi.user.v1.CreateTagResponse.tag:type_name -> ai_poi.user.v1.Tag
-	47, // 67: ai_poi.user.v1.CreateTagResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	8,  // 68: ai_poi.user.v1.UpdateTagRequest.tag:type_name -> ai_poi.user.v1.Tag
-	46, // 69: ai_poi.user.v1.UpdateTagRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	8,  // 70: ai_poi.user.v1.UpdateTagResponse.tag:type_name -> ai_poi.user.v1.Tag
-	47, // 71: ai_poi.user.v1.UpdateTagResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	46, // 72: ai_poi.user.v1.DeleteTagRequest.request:type_name -> ai_poi.user.v1.BaseRequest
-	47, // 73: ai_poi.user.v1.DeleteTagResponse.response:type_name -> ai_poi.user.v1.BaseResponse
-	10, // 74: ai_poi.user.v1.UserService.GetUserProfile:input_type -> ai_poi.user.v1.GetUserProfileRequest
-	12, // 75: ai_poi.user.v1.UserService.UpdateUserProfile:input_type -> ai_poi.user.v1.UpdateUserProfileRequest
-	14, // 76: ai_poi.user.v1.UserService.GetSearchProfiles:input_type -> ai_poi.user.v1.GetSearchProfilesRequest
-	16, // 77: ai_poi.user.v1.UserService.GetSearchProfile:input_type -> ai_poi.user.v1.GetSearchProfileRequest
-	18, // 78: ai_poi.user.v1.UserService.CreateSearchProfile:input_type -> ai_poi.user.v1.CreateSearchProfileRequest
-	20, // 79: ai_poi.user.v1.UserService.UpdateSearchProfile:input_type -> ai_poi.user.v1.UpdateSearchProfileRequest
-	22, // 80: ai_poi.user.v1.UserService.DeleteSearchProfile:input_type -> ai_poi.user.v1.DeleteSearchProfileRequest
-	24, // 81: ai_poi.user.v1.UserService.GetDefaultProfile:input_type -> ai_poi.user.v1.GetDefaultProfileRequest
-	26, // 82: ai_poi.user.v1.UserService.SetDefaultProfile:input_type -> ai_poi.user.v1.SetDefaultProfileRequest
-	28, // 83: ai_poi.user.v1.UserService.GetInterests:input_type -> ai_poi.user.v1.GetInterestsRequest
-	30, // 84: ai_poi.user.v1.UserService.CreateInterest:input_type -> ai_poi.user.v1.CreateInterestRequest
-	32, // 85: ai_poi.user.v1.UserService.UpdateInterest:input_type -> ai_poi.user.v1.UpdateInterestRequest
-	34, // 86: ai_poi.user.v1.UserService.DeleteInterest:input_type -> ai_poi.user.v1.DeleteInterestRequest
-	36, // 87: ai_poi.user.v1.UserService.GetTags:input_type -> ai_poi.user.v1.GetTagsRequest
-	38, // 88: ai_poi.user.v1.UserService.GetTag:input_type -> ai_poi.user.v1.GetTagRequest
-	40, // 89: ai_poi.user.v1.UserService.CreateTag:input_type -> ai_poi.user.v1.CreateTagRequest
-	42, // 90: ai_poi.user.v1.UserService.UpdateTag:input_type -> ai_poi.user.v1.UpdateTagRequest
-	44, // 91: ai_poi.user.v1.UserService.DeleteTag:input_type -> ai_poi.user.v1.DeleteTagRequest
-	11, // 92: ai_poi.user.v1.UserService.GetUserProfile:output_type -> ai_poi.user.v1.GetUserProfileResponse
-	13, // 93: ai_poi.user.v1.UserService.UpdateUserProfile:output_type -> ai_poi.user.v1.UpdateUserProfileResponse
-	15, // 94: ai_poi.user.v1.UserService.GetSearchProfiles:output_type -> ai_poi.user.v1.GetSearchProfilesResponse
-	17, // 95: ai_poi.user.v1.UserService.GetSearchProfile:output_type -> ai_poi.user.v1.GetSearchProfileResponse
-	19, // 96: ai_poi.user.v1.UserService.CreateSearchProfile:output_type -> ai_poi.user.v1.CreateSearchProfileResponse
-	21, // 97: ai_poi.user.v1.UserService.UpdateSearchProfile:output_type -> ai_poi.user.v1.UpdateSearchProfileResponse
-	23, // 98: ai_poi.user.v1.UserService.DeleteSearchProfile:output_type -> ai_poi.user.v1.DeleteSearchProfileResponse
-	25, // 99: ai_poi.user.v1.UserService.GetDefaultProfile:output_type -> ai_poi.user.v1.GetDefaultProfileResponse
-	27, // 100: ai_poi.user.v1.UserService.SetDefaultProfile:output_type -> ai_poi.user.v1.SetDefaultProfileResponse
-	29, // 101: ai_poi.user.v1.UserService.GetInterests:output_type -> ai_poi.user.v1.GetInterestsResponse
-	31, // 102: ai_poi.user.v1.UserService.CreateInterest:output_type -> ai_poi.user.v1.CreateInterestResponse
-	33, // 103: ai_poi.user.v1.UserService.UpdateInterest:output_type -> ai_poi.user.v1.UpdateInterestResponse
-	35, // 104: ai_poi.user.v1.UserService.DeleteInterest:output_type -> ai_poi.user.v1.DeleteInterestResponse
-	37, // 105: ai_poi.user.v1.UserService.GetTags:output_type -> ai_poi.user.v1.GetTagsResponse
-	39, // 106: ai_poi.user.v1.UserService.GetTag:output_type -> ai_poi.user.v1.GetTagResponse
-	41, // 107: ai_poi.user.v1.UserService.CreateTag:output_type -> ai_poi.user.v1.CreateTagResponse
-	43, // 108: ai_poi.user.v1.UserService.UpdateTag:output_type -> ai_poi.user.v1.UpdateTagResponse
-	45, // 109: ai_poi.user.v1.UserService.DeleteTag:output_type -> ai_poi.user.v1.DeleteTagResponse
-	92, // [92:110] is the sub-list for method output_type
-	74, // [74:92] is the sub-list for method input_type
-	74, // [74:74] is the sub-list for extension type_name
-	74, // [74:74] is the sub-list for extension extendee
-	0,  // [0:74] is the sub-list for field type_name
+	49, // 10: ai_poi.user.v1.BudgetPreferences.daily_budget_amount:type_name -> ai_poi.common.v1.Money
+	49, // 11: ai_poi.user.v1.BudgetPreferences.accommodation_budget_amount:type_name -> ai_poi.common.v1.Money
+	49, // 12: ai_poi.user.v1.BudgetPreferences.food_budget_amount:type_name -> ai_poi.common.v1.Money
+	49, // 13: ai_poi.user.v1.BudgetPreferences.activity_budget_amount:type_name -> ai_poi.common.v1.Money
+	49, // 14: ai_poi.user.v1.BudgetPreferences.transport_budget_amount:type_name -> ai_poi.common.v1.Money
+	48, // 15: ai_poi.user.v1.Interest.created_at:type_name -> google.protobuf.Timestamp
+	48, // 16: ai_poi.user.v1.Interest.updated_at:type_name -> google.protobuf.Timestamp
+	48, // 17: ai_poi.user.v1.Tag.created_at:type_name -> google.protobuf.Timestamp
+	48, // 18: ai_poi.user.v1.Tag.updated_at:type_name -> google.protobuf.Timestamp
+	48, // 19: ai_poi.user.v1.UserStats.last_activity:type_name -> google.protobuf.Timestamp
+	48, // 20: ai_poi.user.v1.UserStats.member_since:type_name -> google.protobuf.Timestamp
+	50, // 21: ai_poi.user.v1.GetUserProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 22: ai_poi.user.v1.GetUserProfileResponse.profile:type_name -> ai_poi.user.v1.UserProfile
+	9,  // 23: ai_poi.user.v1.GetUserProfileResponse.stats:type_name -> ai_poi.user.v1.UserStats
+	51, // 24: ai_poi.user.v1.GetUserProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	0,  // 25: ai_poi.user.v1.UpdateUserProfileRequest.profile:type_name -> ai_poi.user.v1.UserProfile
+	50, // 26: ai_poi.user.v1.UpdateUserProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	0,  // 27: ai_poi.user.v1.UpdateUserProfileResponse.profile:type_name -> ai_poi.user.v1.UserProfile
+	51, // 28: ai_poi.user.v1.UpdateUserProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	50, // 29: ai_poi.user.v1.GetSearchProfilesRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	3,  // 30: ai_poi.user.v1.GetSearchProfilesResponse.profiles:type_name -> ai_poi.user.v1.SearchProfile
+	51, // 31: ai_poi.user.v1.GetSearchProfilesResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	50, // 32: ai_poi.user.v1.GetSearchProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	3,  // 33: ai_poi.user.v1.GetSearchProfileResponse.profile:type_name -> ai_poi.user.v1.SearchProfile
+	51, // 34: ai_poi.user.v1.GetSearchProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	3,  // 35: ai_poi.user.v1.CreateSearchProfileRequest.profile:type_name -> ai_poi.user.v1.SearchProfile
+	50, // 36: ai_poi.user.v1.CreateSearchProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	3,  // 37: ai_poi.user.v1.CreateSearchProfileResponse.profile:type_name -> ai_poi.user.v1.SearchProfile
+	51, // 38: ai_poi.user.v1.CreateSearchProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	3,  // 39: ai_poi.user.v1.UpdateSearchProfileRequest.profile:type_name -> ai_poi.user.v1.SearchProfile
+	50, // 40: ai_poi.user.v1.UpdateSearchProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	3,  // 41: ai_poi.user.v1.UpdateSearchProfileResponse.profile:type_name -> ai_poi.user.v1.SearchProfile
+	51, // 42: ai_poi.user.v1.UpdateSearchProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	50, // 43: ai_poi.user.v1.DeleteSearchProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	51, // 44: ai_poi.user.v1.DeleteSearchProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	50, // 45: ai_poi.user.v1.GetDefaultProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	3,  // 46: ai_poi.user.v1.GetDefaultProfileResponse.profile:type_name -> ai_poi.user.v1.SearchProfile
+	51, // 47: ai_poi.user.v1.GetDefaultProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	50, // 48: ai_poi.user.v1.SetDefaultProfileRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	51, // 49: ai_poi.user.v1.SetDefaultProfileResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	50, // 50: ai_poi.user.v1.GetInterestsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	7,  // 51: ai_poi.user.v1.GetInterestsResponse.interests:type_name -> ai_poi.user.v1.Interest
+	51, // 52: ai_poi.user.v1.GetInterestsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	7,  // 53: ai_poi.user.v1.CreateInterestRequest.interest:type_name -> ai_poi.user.v1.Interest
+	50, // 54: ai_poi.user.v1.CreateInterestRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	7,  // 55: ai_poi.user.v1.CreateInterestResponse.interest:type_name -> ai_poi.user.v1.Interest
+	51, // 56: ai_poi.user.v1.CreateInterestResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	7,  // 57: ai_poi.user.v1.UpdateInterestRequest.interest:type_name -> ai_poi.user.v1.Interest
+	50, // 58: ai_poi.user.v1.UpdateInterestRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	7,  // 59: ai_poi.user.v1.UpdateInterestResponse.interest:type_name -> ai_poi.user.v1.Interest
+	51, // 60: ai_poi.user.v1.UpdateInterestResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	50, // 61: ai_poi.user.v1.DeleteInterestRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	51, // 62: ai_poi.user.v1.DeleteInterestResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	50, // 63: ai_poi.user.v1.GetTagsRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	8,  // 64: ai_poi.user.v1.GetTagsResponse.tags:type_name -> ai_poi.user.v1.Tag
+	51, // 65: ai_poi.user.v1.GetTagsResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	50, // 66: ai_poi.user.v1.GetTagRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	8,  // 67: ai_poi.user.v1.GetTagResponse.tag:type_name -> ai_poi.user.v1.Tag
+	51, // 68: ai_poi.user.v1.GetTagResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	8,  // 69: ai_poi.user.v1.CreateTagRequest.tag:type_name -> ai_poi.user.v1.Tag
+	50, // 70: ai_poi.user.v1.CreateTagRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	8,  // 71: ai_poi.user.v1.CreateTagResponse.tag:type_name -> ai_poi.user.v1.Tag
+	51, // 72: ai_poi.user.v1.CreateTagResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	8,  // 73: ai_poi.user.v1.UpdateTagRequest.tag:type_name -> ai_poi.user.v1.Tag
+	50, // 74: ai_poi.user.v1.UpdateTagRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	8,  // 75: ai_poi.user.v1.UpdateTagResponse.tag:type_name -> ai_poi.user.v1.Tag
+	51, // 76: ai_poi.user.v1.UpdateTagResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	50, // 77: ai_poi.user.v1.DeleteTagRequest.request:type_name -> ai_poi.common.v1.BaseRequest
+	51, // 78: ai_poi.user.v1.DeleteTagResponse.response:type_name -> ai_poi.common.v1.BaseResponse
+	10, // 79: ai_poi.user.v1.UserService.GetUserProfile:input_type -> ai_poi.user.v1.GetUserProfileRequest
+	12, // 80: ai_poi.user.v1.UserService.UpdateUserProfile:input_type -> ai_poi.user.v1.UpdateUserProfileRequest
+	14, // 81: ai_poi.user.v1.UserService.GetSearchProfiles:input_type -> ai_poi.user.v1.GetSearchProfilesRequest
+	16, // 82: ai_poi.user.v1.UserService.GetSearchProfile:input_type -> ai_poi.user.v1.GetSearchProfileRequest
+	18, // 83: ai_poi.user.v1.UserService.CreateSearchProfile:input_type -> ai_poi.user.v1.CreateSearchProfileRequest
+	20, // 84: ai_poi.user.v1.UserService.UpdateSearchProfile:input_type -> ai_poi.user.v1.UpdateSearchProfileRequest
+	22, // 85: ai_poi.user.v1.UserService.DeleteSearchProfile:input_type -> ai_poi.user.v1.DeleteSearchProfileRequest
+	24, // 86: ai_poi.user.v1.UserService.GetDefaultProfile:input_type -> ai_poi.user.v1.GetDefaultProfileRequest
+	26, // 87: ai_poi.user.v1.UserService.SetDefaultProfile:input_type -> ai_poi.user.v1.SetDefaultProfileRequest
+	28, // 88: ai_poi.user.v1.UserService.GetInterests:input_type -> ai_poi.user.v1.GetInterestsRequest
+	30, // 89: ai_poi.user.v1.UserService.CreateInterest:input_type -> ai_poi.user.v1.CreateInterestRequest
+	32, // 90: ai_poi.user.v1.UserService.UpdateInterest:input_type -> ai_poi.user.v1.UpdateInterestRequest
+	34, // 91: ai_poi.user.v1.UserService.DeleteInterest:input_type -> ai_poi.user.v1.DeleteInterestRequest
+	36, // 92: ai_poi.user.v1.UserService.GetTags:input_type -> ai_poi.user.v1.GetTagsRequest
+	38, // 93: ai_poi.user.v1.UserService.GetTag:input_type -> ai_poi.user.v1.GetTagRequest
+	40, // 94: ai_poi.user.v1.UserService.CreateTag:input_type -> ai_poi.user.v1.CreateTagRequest
+	42, // 95: ai_poi.user.v1.UserService.UpdateTag:input_type -> ai_poi.user.v1.UpdateTagRequest
+	44, // 96: ai_poi.user.v1.UserService.DeleteTag:input_type -> ai_poi.user.v1.DeleteTagRequest
+	11, // 97: ai_poi.user.v1.UserService.GetUserProfile:output_type -> ai_poi.user.v1.GetUserProfileResponse
+	13, // 98: ai_poi.user.v1.UserService.UpdateUserProfile:output_type -> ai_poi.user.v1.UpdateUserProfileResponse
+	15, // 99: ai_poi.user.v1.UserService.GetSearchProfiles:output_type -> ai_poi.user.v1.GetSearchProfilesResponse
+	17, // 100: ai_poi.user.v1.UserService.GetSearchProfile:output_type -> ai_poi.user.v1.GetSearchProfileResponse
+	19, // 101: ai_poi.user.v1.UserService.CreateSearchProfile:output_type -> ai_poi.user.v1.CreateSearchProfileResponse
+	21, // 102: ai_poi.user.v1.UserService.UpdateSearchProfile:output_type -> ai_poi.user.v1.UpdateSearchProfileResponse
+	23, // 103: ai_poi.user.v1.UserService.DeleteSearchProfile:output_type -> ai_poi.user.v1.DeleteSearchProfileResponse
+	25, // 104: ai_poi.user.v1.UserService.GetDefaultProfile:output_type -> ai_poi.user.v1.GetDefaultProfileResponse
+	27, // 105: ai_poi.user.v1.UserService.SetDefaultProfile:output_type -> ai_poi.user.v1.SetDefaultProfileResponse
+	29, // 106: ai_poi.user.v1.UserService.GetInterests:output_type -> ai_poi.user.v1.GetInterestsResponse
+	31, // 107: ai_poi.user.v1.UserService.CreateInterest:output_type -> ai_poi.user.v1.CreateInterestResponse
+	33, // 108: ai_poi.user.v1.UserService.UpdateInterest:output_type -> ai_poi.user.v1.UpdateInterestResponse
+	35, // 109: ai_poi.user.v1.UserService.DeleteInterest:output_type -> ai_poi.user.v1.DeleteInterestResponse
+	37, // 110: ai_poi.user.v1.UserService.GetTags:output_type -> ai_poi.user.v1.GetTagsResponse
+	39, // 111: ai_poi.user.v1.UserService.GetTag:output_type -> ai_poi.user.v1.GetTagResponse
+	41, // 112: ai_poi.user.v1.UserService.CreateTag:output_type -> ai_poi.user.v1.CreateTagResponse
+	43, // 113: ai_poi.user.v1.UserService.UpdateTag:output_type -> ai_poi.user.v1.UpdateTagResponse
+	45, // 114: ai_poi.user.v1.UserService.DeleteTag:output_type -> ai_poi.user.v1.DeleteTagResponse
+	97, // [97:115] is the sub-list for method output_type
+	79, // [79:97] is the sub-list for method input_type
+	79, // [79:79] is the sub-list for extension type_name
+	79, // [79:79] is the sub-list for extension extendee
+	0,  // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
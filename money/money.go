@@ -0,0 +1,336 @@
+// Package money does arithmetic on common.Money, converts between
+// currencies through a pluggable RateProvider, and reads the plain double
+// amounts older messages carry.
+//
+// Amounts are worked out exactly, to the nano, and only rounded where a
+// result cannot be represented, such as a third of a euro.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"golang.org/x/text/currency"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+const nanosPerUnit = 1_000_000_000
+
+var (
+	// ErrInvalid is returned for Money with an unknown currency code,
+	// nanos out of range or units and nanos of different signs.
+	ErrInvalid = errors.New("invalid money")
+
+	// ErrCurrencyMismatch is returned when combining amounts in different
+	// currencies. Convert them first.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+
+	// ErrOverflow is returned when a result does not fit in Money.
+	ErrOverflow = errors.New("money overflow")
+)
+
+var (
+	bigNanosPerUnit = big.NewInt(nanosPerUnit)
+	maxNanos        = new(big.Int).Add(new(big.Int).Mul(big.NewInt(math.MaxInt64), bigNanosPerUnit), big.NewInt(nanosPerUnit-1))
+	minNanos        = new(big.Int).Neg(maxNanos)
+)
+
+// New creates the Money of units and nanos in currency, an ISO 4217 code.
+// units and nanos may have different signs, e.g. units 2 and nanos -250000000
+// is 1.75, and nanos may exceed a unit.
+func New(currency string, units int64, nanos int64) (*common.Money, error) {
+	if err := validateCurrency(currency); err != nil {
+		return nil, err
+	}
+
+	total := new(big.Int).Mul(big.NewInt(units), bigNanosPerUnit)
+	total.Add(total, big.NewInt(nanos))
+
+	return fromNanos(currency, total)
+}
+
+// FromFloat creates the Money of amount in currency, rounded to the nano.
+func FromFloat(currency string, amount float64) (*common.Money, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, amount)
+	}
+
+	r, _ := new(big.Rat).SetString(fmt.Sprintf("%.9f", amount))
+
+	return fromRat(currency, r)
+}
+
+// Parse creates the Money of a decimal amount such as "12.5" or "-0.99" in
+// currency, exactly.
+func Parse(currency, amount string) (*common.Money, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok {
+		return nil, fmt.Errorf("%w: amount %q", ErrInvalid, amount)
+	}
+
+	if !new(big.Rat).Mul(r, new(big.Rat).SetInt(bigNanosPerUnit)).IsInt() {
+		return nil, fmt.Errorf("%w: amount %q is finer than a nano", ErrInvalid, amount)
+	}
+
+	return fromRat(currency, r)
+}
+
+// OrLegacy returns m, or the Money of the plain amount in currency older
+// servers send next to it when m is unset. For example:
+//
+//	low := money.OrLegacy(costs.GetBudgetLowAmount(), costs.GetCurrency(), costs.GetBudgetLow())
+//
+// It returns nil when neither is usable.
+func OrLegacy(m *common.Money, currency string, amount float64) *common.Money {
+	if m != nil {
+		return m
+	}
+
+	legacy, err := FromFloat(currency, amount)
+	if err != nil {
+		return nil
+	}
+
+	return legacy
+}
+
+// Validate reports whether m is well formed.
+func Validate(m *common.Money) error {
+	if m == nil {
+		return fmt.Errorf("%w: nil", ErrInvalid)
+	}
+
+	if err := validateCurrency(m.GetCurrencyCode()); err != nil {
+		return err
+	}
+
+	units, nanos := m.GetUnits(), m.GetNanos()
+	if nanos <= -nanosPerUnit || nanos >= nanosPerUnit {
+		return fmt.Errorf("%w: nanos %d out of range", ErrInvalid, nanos)
+	}
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return fmt.Errorf("%w: units %d and nanos %d have different signs", ErrInvalid, units, nanos)
+	}
+
+	return nil
+}
+
+func validateCurrency(code string) error {
+	if len(code) != 3 || strings.ToUpper(code) != code {
+		return fmt.Errorf("%w: currency code %q", ErrInvalid, code)
+	}
+
+	if _, err := currency.ParseISO(code); err != nil {
+		return fmt.Errorf("%w: unknown currency %q", ErrInvalid, code)
+	}
+
+	return nil
+}
+
+// Float returns m as a float64, losing precision past 15 or so digits.
+func Float(m *common.Money) float64 {
+	return float64(m.GetUnits()) + float64(m.GetNanos())/nanosPerUnit
+}
+
+// IsZero reports whether m is zero, or unset.
+func IsZero(m *common.Money) bool {
+	return m.GetUnits() == 0 && m.GetNanos() == 0
+}
+
+// IsNegative reports whether m is below zero.
+func IsNegative(m *common.Money) bool {
+	return m.GetUnits() < 0 || m.GetNanos() < 0
+}
+
+// Add returns a + b.
+func Add(a, b *common.Money) (*common.Money, error) {
+	if err := sameCurrency(a, b); err != nil {
+		return nil, err
+	}
+
+	return fromNanos(a.GetCurrencyCode(), new(big.Int).Add(nanosOf(a), nanosOf(b)))
+}
+
+// Sub returns a - b.
+func Sub(a, b *common.Money) (*common.Money, error) {
+	if err := sameCurrency(a, b); err != nil {
+		return nil, err
+	}
+
+	return fromNanos(a.GetCurrencyCode(), new(big.Int).Sub(nanosOf(a), nanosOf(b)))
+}
+
+// Neg returns -m.
+func Neg(m *common.Money) *common.Money {
+	return &common.Money{CurrencyCode: m.GetCurrencyCode(), Units: -m.GetUnits(), Nanos: -m.GetNanos()}
+}
+
+// Sum returns the total of ms, all of which must be in currency. The sum of
+// nothing is zero.
+func Sum(currency string, ms ...*common.Money) (*common.Money, error) {
+	if err := validateCurrency(currency); err != nil {
+		return nil, err
+	}
+
+	total := new(big.Int)
+	for _, m := range ms {
+		if m.GetCurrencyCode() != currency {
+			return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, currency, m.GetCurrencyCode())
+		}
+		total.Add(total, nanosOf(m))
+	}
+
+	return fromNanos(currency, total)
+}
+
+// Multiply returns m times factor, rounded half to even to the nano.
+func Multiply(m *common.Money, factor float64) (*common.Money, error) {
+	if math.IsNaN(factor) || math.IsInf(factor, 0) {
+		return nil, fmt.Errorf("%w: factor %v", ErrInvalid, factor)
+	}
+
+	f := new(big.Rat)
+	f.SetFloat64(factor)
+
+	return fromRat(m.GetCurrencyCode(), f.Mul(f, ratOf(m)))
+}
+
+// Split divides m into n parts that differ by at most one minor unit of its
+// currency, such as a cent, and add up to m exactly. m is first rounded to
+// the minor unit.
+func Split(m *common.Money, n int) ([]*common.Money, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: cannot split into %d parts", ErrInvalid, n)
+	}
+
+	step := minorUnit(m.GetCurrencyCode())
+	total := nanosOf(Round(m))
+	count := new(big.Int).Div(total, step)
+	q, r := new(big.Int).QuoRem(count, big.NewInt(int64(n)), new(big.Int))
+
+	parts := make([]*common.Money, 0, n)
+	for i := range n {
+		part := new(big.Int).Set(q)
+		// Hand the remainder out one minor unit at a time, away from zero.
+		if int64(i) < new(big.Int).Abs(r).Int64() {
+			part.Add(part, big.NewInt(int64(r.Sign())))
+		}
+
+		p, err := fromNanos(m.GetCurrencyCode(), part.Mul(part, step))
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, p)
+	}
+
+	return parts, nil
+}
+
+// Compare returns -1, 0 or +1 as a is less than, equal to or greater than
+// b.
+func Compare(a, b *common.Money) (int, error) {
+	if err := sameCurrency(a, b); err != nil {
+		return 0, err
+	}
+
+	return nanosOf(a).Cmp(nanosOf(b)), nil
+}
+
+// Round rounds m half to even to the minor unit of its currency, e.g. to
+// the cent for EUR and to the yen for JPY.
+func Round(m *common.Money) *common.Money {
+	step := minorUnit(m.GetCurrencyCode())
+	n := nanosOf(m)
+	q, r := new(big.Int).QuoRem(n, step, new(big.Int))
+
+	// Compare twice the remainder against the step to round half to even.
+	twice := new(big.Int).Abs(r)
+	twice.Lsh(twice, 1)
+	if c := twice.Cmp(step); c > 0 || (c == 0 && q.Bit(0) == 1) {
+		q.Add(q, big.NewInt(int64(n.Sign())))
+	}
+
+	rounded, err := fromNanos(m.GetCurrencyCode(), q.Mul(q, step))
+	if err != nil {
+		return m
+	}
+
+	return rounded
+}
+
+// Format writes m rounded to the minor unit of its currency, followed by
+// its code, e.g. "12.50 EUR" or "1500 JPY".
+func Format(m *common.Money) string {
+	if m == nil {
+		return ""
+	}
+
+	return ratOf(Round(m)).FloatString(digits(m.GetCurrencyCode())) + " " + m.GetCurrencyCode()
+}
+
+// digits returns the number of decimals of the minor unit of code.
+func digits(code string) int {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return 2
+	}
+
+	scale, _ := currency.Standard.Rounding(unit)
+
+	return scale
+}
+
+// minorUnit returns the number of nanos in a minor unit of code.
+func minorUnit(code string) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(9-digits(code))), nil)
+}
+
+func sameCurrency(a, b *common.Money) error {
+	if a.GetCurrencyCode() != b.GetCurrencyCode() {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.GetCurrencyCode(), b.GetCurrencyCode())
+	}
+
+	return nil
+}
+
+func nanosOf(m *common.Money) *big.Int {
+	n := new(big.Int).Mul(big.NewInt(m.GetUnits()), bigNanosPerUnit)
+
+	return n.Add(n, big.NewInt(int64(m.GetNanos())))
+}
+
+func ratOf(m *common.Money) *big.Rat {
+	return new(big.Rat).SetFrac(nanosOf(m), bigNanosPerUnit)
+}
+
+// fromRat rounds r half to even to the nano.
+func fromRat(currency string, r *big.Rat) (*common.Money, error) {
+	if err := validateCurrency(currency); err != nil {
+		return nil, err
+	}
+
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(bigNanosPerUnit))
+	q, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+
+	twice := new(big.Int).Abs(rem)
+	twice.Lsh(twice, 1)
+	if c := twice.Cmp(scaled.Denom()); c > 0 || (c == 0 && q.Bit(0) == 1) {
+		q.Add(q, big.NewInt(int64(scaled.Sign())))
+	}
+
+	return fromNanos(currency, q)
+}
+
+func fromNanos(currency string, total *big.Int) (*common.Money, error) {
+	if total.Cmp(maxNanos) > 0 || total.Cmp(minNanos) < 0 {
+		return nil, ErrOverflow
+	}
+
+	units, nanos := new(big.Int).QuoRem(total, bigNanosPerUnit, new(big.Int))
+
+	return &common.Money{CurrencyCode: currency, Units: units.Int64(), Nanos: int32(nanos.Int64())}, nil
+}
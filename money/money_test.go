@@ -0,0 +1,253 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+func mustParse(t *testing.T, currency, amount string) *common.Money {
+	t.Helper()
+
+	m, err := Parse(currency, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+// amount returns m as a decimal string, to the nano.
+func amount(m *common.Money) string {
+	return ratOf(m).FloatString(9)
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name         string
+		units, nanos int64
+		want         *common.Money
+		wantErr      error
+	}{
+		{name: "whole", units: 12, want: &common.Money{CurrencyCode: "EUR", Units: 12}},
+		{name: "mixed signs", units: 2, nanos: -250_000_000, want: &common.Money{CurrencyCode: "EUR", Units: 1, Nanos: 750_000_000}},
+		{name: "nanos carry", units: 1, nanos: 2_500_000_000, want: &common.Money{CurrencyCode: "EUR", Units: 3, Nanos: 500_000_000}},
+		{name: "negative", units: 0, nanos: -1, want: &common.Money{CurrencyCode: "EUR", Nanos: -1}},
+		{name: "largest", units: math.MaxInt64, nanos: nanosPerUnit - 1, want: &common.Money{CurrencyCode: "EUR", Units: math.MaxInt64, Nanos: nanosPerUnit - 1}},
+		{name: "overflow", units: math.MaxInt64, nanos: nanosPerUnit, wantErr: ErrOverflow},
+		{name: "underflow", units: math.MinInt64, wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New("EUR", tt.units, tt.nanos)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("New() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (got.GetUnits() != tt.want.GetUnits() || got.GetNanos() != tt.want.GetNanos()) {
+				t.Errorf("New() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr == nil {
+				if err := Validate(got); err != nil {
+					t.Errorf("New() = %v, which is invalid: %v", got, err)
+				}
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		currency, amount string
+		want             string
+		wantErr          error
+	}{
+		{currency: "EUR", amount: "12.5", want: "12.500000000"},
+		{currency: "EUR", amount: " -0.99 ", want: "-0.990000000"},
+		{currency: "EUR", amount: "0.000000001", want: "0.000000001"},
+		{currency: "EUR", amount: "0.0000000001", wantErr: ErrInvalid},
+		{currency: "EUR", amount: "twelve", wantErr: ErrInvalid},
+		{currency: "EUR", amount: "9223372036854775808", wantErr: ErrOverflow},
+		{currency: "eur", amount: "1", wantErr: ErrInvalid},
+		{currency: "XYZ", amount: "1", wantErr: ErrInvalid},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.currency, tt.amount)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Parse(%q, %q) error = %v, want %v", tt.currency, tt.amount, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr == nil && amount(got) != tt.want {
+			t.Errorf("Parse(%q, %q) = %s, want %s", tt.currency, tt.amount, amount(got), tt.want)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	eur := func(s string) *common.Money { return mustParse(t, "EUR", s) }
+	largest := &common.Money{CurrencyCode: "EUR", Units: math.MaxInt64, Nanos: nanosPerUnit - 1}
+
+	tests := []struct {
+		name    string
+		op      func() (*common.Money, error)
+		want    string
+		wantErr error
+	}{
+		{name: "add", op: func() (*common.Money, error) { return Add(eur("0.75"), eur("0.5")) }, want: "1.250000000"},
+		{name: "add across zero", op: func() (*common.Money, error) { return Add(eur("-1.25"), eur("0.5")) }, want: "-0.750000000"},
+		{name: "add past the largest", op: func() (*common.Money, error) { return Add(largest, eur("0.000000001")) }, wantErr: ErrOverflow},
+		{name: "add other currency", op: func() (*common.Money, error) { return Add(eur("1"), mustParse(t, "USD", "1")) }, wantErr: ErrCurrencyMismatch},
+		{name: "sub", op: func() (*common.Money, error) { return Sub(eur("1"), eur("1.000000001")) }, want: "-0.000000001"},
+		{name: "sub past the smallest", op: func() (*common.Money, error) { return Sub(Neg(largest), eur("1")) }, wantErr: ErrOverflow},
+		{name: "sum", op: func() (*common.Money, error) { return Sum("EUR", eur("0.1"), eur("0.2"), eur("-0.3")) }, want: "0.000000000"},
+		{name: "sum of nothing", op: func() (*common.Money, error) { return Sum("EUR") }, want: "0.000000000"},
+		{name: "sum other currency", op: func() (*common.Money, error) { return Sum("EUR", eur("1"), mustParse(t, "USD", "1")) }, wantErr: ErrCurrencyMismatch},
+		{name: "multiply", op: func() (*common.Money, error) { return Multiply(eur("10"), 0.1) }, want: "1.000000000"},
+		{name: "multiply to half a nano", op: func() (*common.Money, error) { return Multiply(eur("0.000000001"), 0.5) }, want: "0.000000000"},
+		{name: "multiply to three halves of a nano", op: func() (*common.Money, error) { return Multiply(eur("0.000000003"), 0.5) }, want: "0.000000002"},
+		{name: "multiply by NaN", op: func() (*common.Money, error) { return Multiply(eur("1"), math.NaN()) }, wantErr: ErrInvalid},
+		{name: "multiply past the largest", op: func() (*common.Money, error) { return Multiply(largest, 2) }, wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if amount(got) != tt.want {
+				t.Errorf("got %s, want %s", amount(got), tt.want)
+			}
+			if err := Validate(got); err != nil {
+				t.Errorf("got %v, which is invalid: %v", got, err)
+			}
+		})
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		currency, amount string
+		want             string
+	}{
+		{currency: "EUR", amount: "0.004999999", want: "0.000000000"},
+		{currency: "EUR", amount: "0.005", want: "0.000000000"},
+		{currency: "EUR", amount: "0.005000001", want: "0.010000000"},
+		{currency: "EUR", amount: "0.015", want: "0.020000000"},
+		{currency: "EUR", amount: "0.025", want: "0.020000000"},
+		{currency: "EUR", amount: "-0.015", want: "-0.020000000"},
+		{currency: "EUR", amount: "-0.025", want: "-0.020000000"},
+		{currency: "EUR", amount: "-0.995", want: "-1.000000000"},
+		{currency: "EUR", amount: "1.999999999", want: "2.000000000"},
+		{currency: "JPY", amount: "2.5", want: "2.000000000"},
+		{currency: "JPY", amount: "3.5", want: "4.000000000"},
+		{currency: "JPY", amount: "-3.5", want: "-4.000000000"},
+		{currency: "KWD", amount: "1.0005", want: "1.000000000"},
+		{currency: "KWD", amount: "1.0015", want: "1.002000000"},
+	}
+	for _, tt := range tests {
+		if got := Round(mustParse(t, tt.currency, tt.amount)); amount(got) != tt.want {
+			t.Errorf("Round(%s %s) = %s, want %s", tt.amount, tt.currency, amount(got), tt.want)
+		}
+	}
+
+	largest := &common.Money{CurrencyCode: "EUR", Units: math.MaxInt64, Nanos: nanosPerUnit - 1}
+	if got := Round(largest); got != largest {
+		t.Errorf("Round(%v) = %v, want it unchanged as rounding up overflows", largest, got)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		currency, amount string
+		n                int
+		want             []string
+	}{
+		{currency: "EUR", amount: "10", n: 3, want: []string{"3.34", "3.33", "3.33"}},
+		{currency: "EUR", amount: "10", n: 4, want: []string{"2.50", "2.50", "2.50", "2.50"}},
+		{currency: "EUR", amount: "0.05", n: 3, want: []string{"0.02", "0.02", "0.01"}},
+		{currency: "EUR", amount: "0.01", n: 3, want: []string{"0.01", "0.00", "0.00"}},
+		{currency: "EUR", amount: "-10", n: 3, want: []string{"-3.34", "-3.33", "-3.33"}},
+		{currency: "EUR", amount: "-0.05", n: 3, want: []string{"-0.02", "-0.02", "-0.01"}},
+		{currency: "EUR", amount: "0", n: 2, want: []string{"0.00", "0.00"}},
+		{currency: "EUR", amount: "7.77", n: 1, want: []string{"7.77"}},
+		// Rounded half to even to 10.00 before splitting.
+		{currency: "EUR", amount: "10.005", n: 4, want: []string{"2.50", "2.50", "2.50", "2.50"}},
+		{currency: "EUR", amount: "10.015", n: 4, want: []string{"2.51", "2.51", "2.50", "2.50"}},
+		{currency: "JPY", amount: "100", n: 3, want: []string{"34", "33", "33"}},
+		{currency: "KWD", amount: "1", n: 3, want: []string{"0.334", "0.333", "0.333"}},
+	}
+	for _, tt := range tests {
+		m := mustParse(t, tt.currency, tt.amount)
+		parts, err := Split(m, tt.n)
+		if err != nil {
+			t.Errorf("Split(%s %s, %d) error = %v", tt.amount, tt.currency, tt.n, err)
+			continue
+		}
+
+		var got []string
+		for _, p := range parts {
+			got = append(got, ratOf(p).FloatString(digits(tt.currency)))
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Split(%s %s, %d) = %v, want %v", tt.amount, tt.currency, tt.n, got, tt.want)
+		}
+
+		sum, err := Sum(tt.currency, parts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c, _ := Compare(sum, Round(m)); c != 0 {
+			t.Errorf("Split(%s %s, %d) adds up to %s", tt.amount, tt.currency, tt.n, amount(sum))
+		}
+	}
+
+	for _, n := range []int{0, -1} {
+		if _, err := Split(mustParse(t, "EUR", "1"), n); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Split(1 EUR, %d) error = %v, want %v", n, err, ErrInvalid)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		m    *common.Money
+		want string
+	}{
+		{m: mustParse(t, "EUR", "12.5"), want: "12.50 EUR"},
+		{m: mustParse(t, "EUR", "-0.995"), want: "-1.00 EUR"},
+		{m: mustParse(t, "EUR", "0.004"), want: "0.00 EUR"},
+		{m: mustParse(t, "JPY", "1500"), want: "1500 JPY"},
+		{m: mustParse(t, "JPY", "1500.5"), want: "1500 JPY"},
+		{m: mustParse(t, "KWD", "1.2345"), want: "1.234 KWD"},
+		{m: nil, want: ""},
+	}
+	for _, tt := range tests {
+		if got := Format(tt.m); got != tt.want {
+			t.Errorf("Format(%v) = %q, want %q", tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		m     *common.Money
+		valid bool
+	}{
+		{m: &common.Money{CurrencyCode: "EUR", Units: 1, Nanos: 500_000_000}, valid: true},
+		{m: &common.Money{CurrencyCode: "EUR", Units: -1, Nanos: -500_000_000}, valid: true},
+		{m: &common.Money{CurrencyCode: "EUR", Units: 1, Nanos: -500_000_000}},
+		{m: &common.Money{CurrencyCode: "EUR", Nanos: nanosPerUnit}},
+		{m: &common.Money{CurrencyCode: "EURO"}},
+		{m: nil},
+	}
+	for _, tt := range tests {
+		if err := Validate(tt.m); (err == nil) != tt.valid || (err != nil && !errors.Is(err, ErrInvalid)) {
+			t.Errorf("Validate(%v) = %v, want valid %t", tt.m, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package money
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// ErrNoRate is returned by a RateProvider that has no rate between two
+// currencies.
+var ErrNoRate = errors.New("no exchange rate")
+
+// RateProvider supplies exchange rates. Rate returns how many units of to
+// one unit of from buys.
+type RateProvider interface {
+	Rate(ctx context.Context, from, to string) (float64, error)
+}
+
+// Convert returns m in currency to, at the rate p gives, rounded to the
+// nano. Money already in to is returned unchanged.
+func Convert(ctx context.Context, p RateProvider, m *common.Money, to string) (*common.Money, error) {
+	if m.GetCurrencyCode() == to {
+		return m, nil
+	}
+
+	rate, err := p.Rate(ctx, m.GetCurrencyCode(), to)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(big.Rat)
+	if r.SetFloat64(rate) == nil || rate <= 0 {
+		return nil, fmt.Errorf("%w: invalid rate %v from %s to %s", ErrNoRate, rate, m.GetCurrencyCode(), to)
+	}
+
+	return fromRat(to, r.Mul(r, ratOf(m)))
+}
+
+// CompareIn compares a and b like Compare, converting b to the currency of
+// a first.
+func CompareIn(ctx context.Context, p RateProvider, a, b *common.Money) (int, error) {
+	converted, err := Convert(ctx, p, b, a.GetCurrencyCode())
+	if err != nil {
+		return 0, err
+	}
+
+	return Compare(a, converted)
+}
+
+// WithinBudget reports whether cost, in any currency, does not exceed
+// budget, comparing them in the currency of the budget. It answers whether
+// an itinerary's EstimatedCosts fit a user's BudgetPreferences.
+func WithinBudget(ctx context.Context, p RateProvider, cost, budget *common.Money) (bool, error) {
+	c, err := CompareIn(ctx, p, budget, cost)
+	if err != nil {
+		return false, err
+	}
+
+	return c >= 0, nil
+}
+
+// Rates is a RateProvider holding the rates of every currency against a
+// base currency in memory. Rates between two other currencies are crossed
+// through the base. It is safe for concurrent use.
+type Rates struct {
+	mu    sync.RWMutex
+	base  string
+	rates map[string]float64
+}
+
+// NewRates creates a provider with rates, the number of units of each
+// currency one unit of base buys.
+func NewRates(base string, rates map[string]float64) (*Rates, error) {
+	r := &Rates{base: base, rates: make(map[string]float64, len(rates))}
+	if err := r.SetAll(base, rates); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Set sets the number of units of currency one unit of the base buys.
+func (r *Rates) Set(currency string, rate float64) error {
+	if err := validateRate(currency, rate); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rates[currency] = rate
+
+	return nil
+}
+
+// SetAll replaces every rate, and the base they are against.
+func (r *Rates) SetAll(base string, rates map[string]float64) error {
+	if err := validateCurrency(base); err != nil {
+		return err
+	}
+
+	next := make(map[string]float64, len(rates)+1)
+	for currency, rate := range rates {
+		if err := validateRate(currency, rate); err != nil {
+			return err
+		}
+		next[currency] = rate
+	}
+	next[base] = 1
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.base, r.rates = base, next
+
+	return nil
+}
+
+// Rate returns how many units of to one unit of from buys.
+func (r *Rates) Rate(_ context.Context, from, to string) (float64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fromRate, ok := r.rates[from]
+	if !ok {
+		return 0, fmt.Errorf("%w from %s to %s", ErrNoRate, from, to)
+	}
+
+	toRate, ok := r.rates[to]
+	if !ok {
+		return 0, fmt.Errorf("%w from %s to %s", ErrNoRate, from, to)
+	}
+
+	return toRate / fromRate, nil
+}
+
+func validateRate(currency string, rate float64) error {
+	if err := validateCurrency(currency); err != nil {
+		return err
+	}
+
+	if !(rate > 0) {
+		return fmt.Errorf("%w: rate %v for %s", ErrInvalid, rate, currency)
+	}
+
+	return nil
+}
+
+// FileRates is a RateProvider reading its rates from a JSON file such as
+//
+//	{"base": "EUR", "rates": {"USD": 1.0842, "GBP": 0.8561}}
+//
+// Lookups use the rates read last. The file is read again by Reload, on
+// demand, or by Watch on a timer, so that a cron job can refresh it without
+// restarting the services. When a change cannot be read, the rates read
+// last are kept, and Err reports why.
+type FileRates struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	rates   *Rates
+	err     error
+}
+
+// ratesFile is the format of the file FileRates reads.
+type ratesFile struct {
+	Base  string             `json:"base"`
+	Rates map[string]float64 `json:"rates"`
+}
+
+// NewFileRates creates a provider reading its rates from path, which must
+// already hold valid rates.
+func NewFileRates(path string) (*FileRates, error) {
+	f := &FileRates{path: path}
+	if err := f.Reload(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Reload reads the file again if it changed since it was last read.
+func (f *FileRates) Reload() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.err = f.reload()
+
+	return f.err
+}
+
+func (f *FileRates) reload() error {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return err
+	}
+	if f.rates != nil && info.ModTime().Equal(f.modTime) {
+		return nil
+	}
+
+	b, err := os.ReadFile(f.path)
+	if err != nil {
+		return err
+	}
+
+	var file ratesFile
+	if err := json.Unmarshal(b, &file); err != nil {
+		return fmt.Errorf("reading exchange rates from %s: %w", f.path, err)
+	}
+
+	rates, err := NewRates(file.Base, file.Rates)
+	if err != nil {
+		return fmt.Errorf("reading exchange rates from %s: %w", f.path, err)
+	}
+
+	f.rates, f.modTime = rates, info.ModTime()
+
+	return nil
+}
+
+// Watch calls Reload every interval until ctx is done.
+func (f *FileRates) Watch(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			_ = f.Reload()
+		}
+	}
+}
+
+// Err returns the error of the last Reload, or nil if it succeeded.
+func (f *FileRates) Err() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.err
+}
+
+// Rate returns how many units of to one unit of from buys, as of the last
+// version of the file that could be read.
+func (f *FileRates) Rate(ctx context.Context, from, to string) (float64, error) {
+	f.mu.Lock()
+	rates := f.rates
+	f.mu.Unlock()
+
+	return rates.Rate(ctx, from, to)
+}
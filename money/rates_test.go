@@ -0,0 +1,123 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRates(t *testing.T) {
+	rates, err := NewRates("EUR", map[string]float64{"USD": 1.25, "GBP": 0.8})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		from, to string
+		want     float64
+		wantErr  error
+	}{
+		{from: "EUR", to: "USD", want: 1.25},
+		{from: "USD", to: "EUR", want: 0.8},
+		{from: "USD", to: "GBP", want: 0.64},
+		{from: "EUR", to: "EUR", want: 1},
+		{from: "EUR", to: "JPY", wantErr: ErrNoRate},
+	}
+	for _, tt := range tests {
+		got, err := rates.Rate(context.Background(), tt.from, tt.to)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("Rate(%s, %s) = %v, %v, want %v, %v", tt.from, tt.to, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	if err := rates.Set("USD", 0); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Set(USD, 0) error = %v, want %v", err, ErrInvalid)
+	}
+
+	converted, err := Convert(context.Background(), rates, mustParse(t, "USD", "10"), "GBP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := Format(converted); got != "6.40 GBP" {
+		t.Errorf("Convert(10 USD, GBP) = %s, want 6.40 GBP", got)
+	}
+}
+
+func TestFileRates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	modTime := time.Now().Add(-time.Hour)
+	write := func(data string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		// File systems with a coarse clock could otherwise give two writes
+		// the same time.
+		modTime = modTime.Add(time.Second)
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rate := func(f *FileRates) float64 {
+		t.Helper()
+		r, err := f.Rate(context.Background(), "EUR", "USD")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	write(`{"base": "EUR", "rates": {"USD": 1.25}}`)
+	f, err := NewFileRates(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	write(`{"base": "EUR", "rates": {"USD": 1.5}}`)
+	if got := rate(f); got != 1.25 {
+		t.Errorf("before Reload, Rate() = %v, want 1.25", got)
+	}
+	if err := f.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if got := rate(f); got != 1.5 {
+		t.Errorf("after Reload, Rate() = %v, want 1.5", got)
+	}
+
+	write(`{"base": "EUR", "rates": {"USD": -1}}`)
+	if err := f.Reload(); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Reload() error = %v, want %v", err, ErrInvalid)
+	}
+	if err := f.Err(); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Err() = %v, want %v", err, ErrInvalid)
+	}
+	if got := rate(f); got != 1.5 {
+		t.Errorf("after a failed Reload, Rate() = %v, want the last rate 1.5", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		f.Watch(ctx, time.Millisecond)
+		close(done)
+	}()
+	write(`{"base": "EUR", "rates": {"USD": 2}}`)
+	for deadline := time.Now().Add(5 * time.Second); rate(f) != 2; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("Watch did not reload the rates")
+		}
+	}
+	cancel()
+	<-done
+
+	if err := f.Err(); err != nil {
+		t.Errorf("after a successful reload, Err() = %v", err)
+	}
+
+	if _, err := NewFileRates(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("NewFileRates() of a missing file succeeded")
+	}
+}
@@ -0,0 +1,155 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	review "github.com/FACorreiaa/loci-proto/modules/review/generated"
+)
+
+var (
+	key        = bytes.Repeat([]byte("k"), MinKeySize)
+	rotatedKey = bytes.Repeat([]byte("r"), MinKeySize)
+)
+
+func newCodec(t *testing.T, ttl time.Duration, key []byte, previous ...[]byte) *CursorCodec {
+	t.Helper()
+
+	c, err := NewCursorCodec(key, ttl, previous...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func TestCursorCodec(t *testing.T) {
+	c := newCodec(t, 0, key)
+	encoded, err := c.Encode(Cursor{Offset: 40, After: "r40", Query: "q"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.Decode(encoded, "q")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Offset != 40 || got.After != "r40" || got.Query != "q" || got.IssuedAt == 0 {
+		t.Errorf("Decode(Encode()) = %+v, want offset 40 after r40 of query q", got)
+	}
+	if _, err := c.Decode(encoded, ""); err != nil {
+		t.Errorf("Decode() without a query: %v", err)
+	}
+
+	payload, signature, _ := strings.Cut(encoded, ".")
+	forged, _ := base64.RawURLEncoding.DecodeString(payload)
+	forged = bytes.Replace(forged, []byte(`"o":40`), []byte(`"o":80`), 1)
+
+	tests := []struct {
+		name  string
+		codec *CursorCodec
+		s     string
+		query string
+	}{
+		{name: "other query", s: encoded, query: "other"},
+		{name: "forged payload", s: base64.RawURLEncoding.EncodeToString(forged) + "." + signature},
+		{name: "no signature", s: payload},
+		{name: "bad signature", s: payload + ".AAAA"},
+		{name: "bad encoding", s: "!!." + signature},
+		{name: "other key", codec: newCodec(t, 0, rotatedKey), s: encoded, query: "q"},
+		{name: "empty"},
+	}
+	for _, tt := range tests {
+		codec := c
+		if tt.codec != nil {
+			codec = tt.codec
+		}
+		if _, err := codec.Decode(tt.s, tt.query); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%s: Decode() error = %v, want %v", tt.name, err, ErrInvalidCursor)
+		}
+	}
+}
+
+func TestCursorCodecRotation(t *testing.T) {
+	old := newCodec(t, 0, key)
+	encoded, err := old.Encode(Cursor{Offset: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated := newCodec(t, 0, rotatedKey, key)
+	if got, err := rotated.Decode(encoded, ""); err != nil || got.Offset != 20 {
+		t.Errorf("Decode() of a cursor signed with the previous key = %+v, %v, want offset 20", got, err)
+	}
+
+	// New cursors are signed with the new key only.
+	encoded, err = rotated.Encode(Cursor{Offset: 40})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := old.Decode(encoded, ""); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Decode() with the previous key only: error = %v, want %v", err, ErrInvalidCursor)
+	}
+}
+
+func TestCursorCodecTTL(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := newCodec(t, time.Hour, key)
+	c.now = func() time.Time { return now }
+
+	encoded, err := c.Encode(Cursor{Offset: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(time.Hour)
+	if _, err := c.Decode(encoded, ""); err != nil {
+		t.Errorf("Decode() after the TTL: %v", err)
+	}
+
+	now = now.Add(time.Second)
+	if _, err := c.Decode(encoded, ""); !errors.Is(err, ErrExpiredCursor) {
+		t.Errorf("Decode() past the TTL: error = %v, want %v", err, ErrExpiredCursor)
+	}
+
+	never := newCodec(t, 0, key)
+	never.now = c.now
+	if _, err := never.Decode(encoded, ""); err != nil {
+		t.Errorf("Decode() without a TTL: %v", err)
+	}
+}
+
+func TestNewCursorCodecKeySize(t *testing.T) {
+	short := key[:MinKeySize-1]
+	if _, err := NewCursorCodec(short, 0); err == nil {
+		t.Error("NewCursorCodec() accepted a short key")
+	}
+	if _, err := NewCursorCodec(key, 0, short); err == nil {
+		t.Error("NewCursorCodec() accepted a short previous key")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	query := &review.GetPOIReviewsRequest{PoiId: "p1"}
+	paged := &review.GetPOIReviewsRequest{
+		PoiId:      "p1",
+		Pagination: &common.PaginationRequest{Page: 3, PageSize: 20, Cursor: "next"},
+		Request:    &common.BaseRequest{RequestId: "r1"},
+	}
+	other := &review.GetPOIReviewsRequest{PoiId: "p2"}
+
+	if Fingerprint(query) != Fingerprint(paged) {
+		t.Error("Fingerprint() depends on the paging fields")
+	}
+	if Fingerprint(query) == Fingerprint(other) {
+		t.Error("Fingerprint() of two queries is the same")
+	}
+	if paged.GetPagination() == nil || paged.GetRequest() == nil {
+		t.Error("Fingerprint() changed its request")
+	}
+}
@@ -0,0 +1,212 @@
+// Package pagination walks the list RPCs of the loci services page by page,
+// whichever of page, offset or cursor based pagination they use, and
+// provides the signed cursors servers hand out in
+// PaginationResponse.next_cursor.
+package pagination
+
+import (
+	"context"
+	"iter"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+// DefaultPageSize is the page size the services use when none is asked for.
+const DefaultPageSize = 20
+
+// MaxPageSize is the largest page size the services accept.
+const MaxPageSize = 100
+
+// Request describes the page a Fetcher should get. Cursor is the one
+// returned with the previous page, if any; servers that do not hand out
+// cursors are asked for Offset instead.
+type Request struct {
+	Offset int32
+	Limit  int32
+	Cursor string
+}
+
+// Pagination returns r as the common PaginationRequest. It asks for a page
+// number and page_size when Offset falls on a page boundary, and for offset
+// and limit otherwise, never both: a server honouring both pairs would skip
+// the items twice. Cursor, when set, takes precedence on the server.
+func (r Request) Pagination() *common.PaginationRequest {
+	p := &common.PaginationRequest{Cursor: r.Cursor}
+	if r.Limit > 0 && r.Offset%r.Limit == 0 {
+		p.Page, p.PageSize = r.Offset/r.Limit+1, r.Limit
+	} else {
+		p.Offset, p.Limit = r.Offset, r.Limit
+	}
+
+	return p
+}
+
+// Page is a page of items returned by a list RPC.
+type Page[T any] struct {
+	Items []T
+
+	// HasNext reports whether another page follows, and NextCursor is the
+	// cursor to ask for it with, if the server handed one out.
+	HasNext    bool
+	NextCursor string
+}
+
+// PageFromResponse builds the page of items described by resp. Without a
+// PaginationResponse, another page is assumed to follow a full one.
+func PageFromResponse[T any](items []T, resp *common.PaginationResponse, req Request) Page[T] {
+	if resp == nil {
+		return Page[T]{Items: items, HasNext: len(items) > 0 && int32(len(items)) >= req.Limit}
+	}
+
+	return Page[T]{
+		Items:      items,
+		HasNext:    resp.GetHasNextPage() || resp.GetNextCursor() != "",
+		NextCursor: resp.GetNextCursor(),
+	}
+}
+
+// PageFromTotal builds the page of items of a response that only reports
+// the total number of items. A zero total is taken as unknown, in which
+// case another page is assumed to follow a full one.
+func PageFromTotal[T any](items []T, total int32, req Request) Page[T] {
+	if total <= 0 {
+		return Page[T]{Items: items, HasNext: len(items) > 0 && int32(len(items)) >= req.Limit}
+	}
+
+	return Page[T]{Items: items, HasNext: len(items) > 0 && req.Offset+int32(len(items)) < total}
+}
+
+// Fetcher gets a single page, usually by calling a list RPC.
+type Fetcher[T any] func(ctx context.Context, req Request) (Page[T], error)
+
+// Option configures a Paginator.
+type Option func(*config)
+
+type config struct {
+	pageSize int32
+	offset   int32
+	cursor   string
+	maxItems int
+}
+
+// WithPageSize sets the number of items asked for per call, capped at
+// MaxPageSize. It defaults to DefaultPageSize.
+func WithPageSize(size int32) Option {
+	return func(c *config) {
+		c.pageSize = min(max(size, 1), MaxPageSize)
+	}
+}
+
+// WithOffset starts from the item at offset instead of the first one.
+func WithOffset(offset int32) Option {
+	return func(c *config) {
+		c.offset = max(offset, 0)
+	}
+}
+
+// WithCursor resumes from a cursor handed out by an earlier call.
+func WithCursor(cursor string) Option {
+	return func(c *config) {
+		c.cursor = cursor
+	}
+}
+
+// WithMaxItems stops after n items.
+func WithMaxItems(n int) Option {
+	return func(c *config) {
+		c.maxItems = n
+	}
+}
+
+// Paginator walks a list RPC page by page. Pages are only fetched as the
+// iteration reaches them, so breaking out of a loop early saves the calls
+// for the remaining pages.
+//
+//	reviews := pagination.New(func(ctx context.Context, req pagination.Request) (pagination.Page[*c.Review], error) {
+//		resp, err := client.GetPOIReviews(ctx, &c.GetPOIReviewsRequest{PoiId: id, Pagination: req.Pagination()})
+//		if err != nil {
+//			return pagination.Page[*c.Review]{}, err
+//		}
+//		return pagination.PageFromResponse(resp.GetReviews(), resp.GetPagination(), req), nil
+//	})
+//	for review, err := range reviews.All(ctx) {
+//		...
+//	}
+type Paginator[T any] struct {
+	fetch  Fetcher[T]
+	config config
+}
+
+// New creates a Paginator getting its pages from fetch.
+func New[T any](fetch Fetcher[T], opts ...Option) *Paginator[T] {
+	p := &Paginator[T]{
+		fetch:  fetch,
+		config: config{pageSize: DefaultPageSize},
+	}
+	for _, opt := range opts {
+		opt(&p.config)
+	}
+
+	return p
+}
+
+// All yields every item, then stops. A failed call is yielded as an error
+// and ends the iteration.
+func (p *Paginator[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for items, err := range p.Pages(ctx) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Pages yields the items page by page, then stops. A failed call is yielded
+// as an error and ends the iteration.
+func (p *Paginator[T]) Pages(ctx context.Context) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		req := Request{Offset: p.config.offset, Limit: p.config.pageSize, Cursor: p.config.cursor}
+		seen := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			page, err := p.fetch(ctx, req)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			items := page.Items
+			if p.config.maxItems > 0 && seen+len(items) >= p.config.maxItems {
+				items = items[:p.config.maxItems-seen]
+				page.HasNext = false
+			}
+			seen += len(items)
+
+			if len(items) > 0 && !yield(items, nil) {
+				return
+			}
+
+			// A page without items cannot move the offset on, so it ends
+			// the iteration even when the server says more follow.
+			if !page.HasNext || len(page.Items) == 0 {
+				return
+			}
+
+			req.Offset += int32(len(page.Items))
+			req.Cursor = page.NextCursor
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+func TestRequestPagination(t *testing.T) {
+	tests := []struct {
+		req  Request
+		want *common.PaginationRequest
+	}{
+		{req: Request{Limit: 20}, want: &common.PaginationRequest{Page: 1, PageSize: 20}},
+		{req: Request{Offset: 40, Limit: 20}, want: &common.PaginationRequest{Page: 3, PageSize: 20}},
+		{req: Request{Offset: 45, Limit: 20}, want: &common.PaginationRequest{Offset: 45, Limit: 20}},
+		{req: Request{Offset: 40, Limit: 20, Cursor: "next"}, want: &common.PaginationRequest{Page: 3, PageSize: 20, Cursor: "next"}},
+		{req: Request{Offset: 5}, want: &common.PaginationRequest{Offset: 5}},
+	}
+	for _, tt := range tests {
+		if got := tt.req.Pagination(); !proto.Equal(got, tt.want) {
+			t.Errorf("%+v.Pagination() = %v, want %v", tt.req, got, tt.want)
+		}
+	}
+}
+
+// listing is a list RPC over n items numbered from zero, recording the
+// requests it gets.
+type listing struct {
+	n    int
+	reqs []Request
+	err  error
+}
+
+func (l *listing) fetch(_ context.Context, req Request) (Page[int], error) {
+	l.reqs = append(l.reqs, req)
+	if l.err != nil && len(l.reqs) > 1 {
+		return Page[int]{}, l.err
+	}
+
+	var items []int
+	for i := int(req.Offset); i < l.n && i < int(req.Offset+req.Limit); i++ {
+		items = append(items, i)
+	}
+
+	return PageFromTotal(items, int32(l.n), req), nil
+}
+
+func (l *listing) offsets() []int32 {
+	var offsets []int32
+	for _, req := range l.reqs {
+		offsets = append(offsets, req.Offset)
+	}
+
+	return offsets
+}
+
+func collect(t *testing.T, p *Paginator[int]) []int {
+	t.Helper()
+
+	var items []int
+	for item, err := range p.All(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
+
+func count(from, to int) []int {
+	var items []int
+	for i := from; i < to; i++ {
+		items = append(items, i)
+	}
+
+	return items
+}
+
+func TestPaginatorAll(t *testing.T) {
+	tests := []struct {
+		name        string
+		n           int
+		opts        []Option
+		want        []int
+		wantOffsets []int32
+	}{
+		{name: "default page size", n: 45, want: count(0, 45), wantOffsets: []int32{0, 20, 40}},
+		{name: "page size", n: 45, opts: []Option{WithPageSize(30)}, want: count(0, 45), wantOffsets: []int32{0, 30}},
+		{name: "page size capped", n: 250, opts: []Option{WithPageSize(1000)}, want: count(0, 250), wantOffsets: []int32{0, 100, 200}},
+		{name: "full last page", n: 40, want: count(0, 40), wantOffsets: []int32{0, 20}},
+		{name: "offset", n: 45, opts: []Option{WithOffset(30)}, want: count(30, 45), wantOffsets: []int32{30}},
+		{name: "max items", n: 45, opts: []Option{WithMaxItems(25)}, want: count(0, 25), wantOffsets: []int32{0, 20}},
+		{name: "max items on a page boundary", n: 45, opts: []Option{WithMaxItems(20)}, want: count(0, 20), wantOffsets: []int32{0}},
+		{name: "empty", n: 0, wantOffsets: []int32{0}},
+	}
+	for _, tt := range tests {
+		l := &listing{n: tt.n}
+		got := collect(t, New(l.fetch, tt.opts...))
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: All() = %v, want %v", tt.name, got, tt.want)
+		}
+		if offsets := l.offsets(); !slices.Equal(offsets, tt.wantOffsets) {
+			t.Errorf("%s: fetched offsets %v, want %v", tt.name, offsets, tt.wantOffsets)
+		}
+	}
+}
+
+func TestPaginatorAllStopsEarly(t *testing.T) {
+	l := &listing{n: 100}
+	var got []int
+	for item, err := range New(l.fetch).All(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+		if len(got) == 25 {
+			break
+		}
+	}
+
+	if !slices.Equal(got, count(0, 25)) {
+		t.Errorf("All() = %v, want the first 25 items", got)
+	}
+	if offsets := l.offsets(); !slices.Equal(offsets, []int32{0, 20}) {
+		t.Errorf("fetched offsets %v, want [0 20]", offsets)
+	}
+}
+
+func TestPaginatorPages(t *testing.T) {
+	l := &listing{n: 45}
+	var sizes []int
+	for items, err := range New(l.fetch).Pages(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		sizes = append(sizes, len(items))
+	}
+	if !slices.Equal(sizes, []int{20, 20, 5}) {
+		t.Errorf("Pages() yielded pages of %v items, want [20 20 5]", sizes)
+	}
+
+	l = &listing{n: 45}
+	for range New(l.fetch).Pages(context.Background()) {
+		break
+	}
+	if len(l.reqs) != 1 {
+		t.Errorf("breaking after the first page fetched %d pages, want 1", len(l.reqs))
+	}
+}
+
+func TestPaginatorError(t *testing.T) {
+	errFailed := errors.New("failed")
+	l := &listing{n: 45, err: errFailed}
+
+	var items int
+	var errs []error
+	for _, err := range New(l.fetch).All(context.Background()) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items++
+	}
+
+	if items != 20 || len(errs) != 1 || !errors.Is(errs[0], errFailed) {
+		t.Errorf("All() yielded %d items and errors %v, want 20 items and %v", items, errs, errFailed)
+	}
+	if len(l.reqs) != 2 {
+		t.Errorf("fetched %d pages after an error, want 2", len(l.reqs))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	l = &listing{n: 45}
+	for _, err := range New(l.fetch).All(ctx) {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("All() of a canceled context yielded %v, want %v", err, context.Canceled)
+		}
+	}
+	if len(l.reqs) != 0 {
+		t.Errorf("fetched %d pages with a canceled context", len(l.reqs))
+	}
+}
+
+func TestPaginatorCursor(t *testing.T) {
+	var cursors []string
+	fetch := func(_ context.Context, req Request) (Page[int], error) {
+		cursors = append(cursors, req.Cursor)
+		next := len(cursors)
+		if next == 3 {
+			return Page[int]{Items: []int{next}}, nil
+		}
+
+		return PageFromResponse([]int{next}, &common.PaginationResponse{NextCursor: "c" + strconv.Itoa(next)}, req), nil
+	}
+
+	got := collect(t, New(fetch, WithCursor("c0")))
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("All() = %v, want [1 2 3]", got)
+	}
+	if !slices.Equal(cursors, []string{"c0", "c1", "c2"}) {
+		t.Errorf("fetched with cursors %v, want [c0 c1 c2]", cursors)
+	}
+}
+
+func TestPaginatorEmptyPage(t *testing.T) {
+	fetches := 0
+	fetch := func(context.Context, Request) (Page[int], error) {
+		fetches++
+		return Page[int]{HasNext: true}, nil
+	}
+
+	if got := collect(t, New(fetch)); len(got) != 0 || fetches != 1 {
+		t.Errorf("All() over empty pages = %v after %d fetches, want nothing after 1", got, fetches)
+	}
+}
+
+func TestPageFromResponse(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []int
+		resp  *common.PaginationResponse
+		want  bool
+	}{
+		{name: "has next page", items: count(0, 5), resp: &common.PaginationResponse{HasNextPage: true}, want: true},
+		{name: "next cursor", items: count(0, 5), resp: &common.PaginationResponse{NextCursor: "next"}, want: true},
+		{name: "last page", items: count(0, 20), resp: &common.PaginationResponse{}},
+		{name: "no response, full page", items: count(0, 20), want: true},
+		{name: "no response, short page", items: count(0, 5)},
+		{name: "no response, empty page"},
+	}
+	for _, tt := range tests {
+		if got := PageFromResponse(tt.items, tt.resp, Request{Limit: 20}).HasNext; got != tt.want {
+			t.Errorf("%s: HasNext = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
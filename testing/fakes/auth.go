@@ -0,0 +1,290 @@
+package fakes
+
+import (
+	"context"
+	"crypto/rand"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+	auth "github.com/FACorreiaa/loci-proto/modules/auth/generated"
+	helpers "github.com/FACorreiaa/loci-proto/modules/common"
+)
+
+// accessTokenTTL is how long an access token issued by the AuthServer
+// stays valid.
+const accessTokenTTL = 15 * time.Minute
+
+// AuthServer is an in-memory AuthService. Tokens are opaque random strings
+// that are only meaningful to the server that issued them; refreshing
+// rotates both tokens, and logging out revokes every token of the user.
+// Passwords are kept in the clear, so never seed it with real ones.
+type AuthServer struct {
+	auth.UnimplementedAuthServiceServer
+
+	// Google returns the account a Google authorization code signs in. It
+	// defaults to an account named after the code.
+	Google func(code string) (email, username string)
+
+	mu        sync.Mutex
+	users     []*auth.UserAuth
+	passwords map[string]string
+	access    map[string]session
+	refresh   map[string]string
+	states    map[string]bool
+}
+
+// session is the user an access token was issued to, and when it expires.
+type session struct {
+	userID  string
+	expires time.Time
+}
+
+var _ auth.AuthServiceServer = (*AuthServer)(nil)
+
+// NewAuthServer creates an AuthService holding no users.
+func NewAuthServer() *AuthServer {
+	return &AuthServer{
+		passwords: make(map[string]string),
+		access:    make(map[string]session),
+		refresh:   make(map[string]string),
+		states:    make(map[string]bool),
+	}
+}
+
+// AddUser adds a user that can log in with password, and returns it with
+// its ID filled in. Users without a role are given the "user" role.
+func (s *AuthServer) AddUser(user *auth.UserAuth, password string) *auth.UserAuth {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return proto.CloneOf(s.add(user, password))
+}
+
+func (s *AuthServer) Register(_ context.Context, in *auth.RegisterRequest) (*auth.RegisterResponse, error) {
+	switch {
+	case in.GetUsername() == "":
+		return nil, required("username")
+	case in.GetEmail() == "":
+		return nil, required("email")
+	case in.GetPassword() == "":
+		return nil, required("password")
+	case in.GetPassword() != in.GetConfirmPassword():
+		message := "passwords do not match"
+		return nil, rpcerrors.Validation(message, helpers.NewFieldError("confirm_password", message, rpcerrors.InvalidValue))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.byEmail(in.GetEmail()) != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "user %q already exists", in.GetEmail())
+	}
+	user := s.add(&auth.UserAuth{Username: in.GetUsername(), Email: in.GetEmail()}, in.GetPassword())
+
+	return &auth.RegisterResponse{Success: true, Message: "user registered", User: proto.CloneOf(user)}, nil
+}
+
+func (s *AuthServer) Login(_ context.Context, in *auth.LoginRequest) (*auth.LoginResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := s.byEmail(in.GetEmail())
+	if user == nil || s.passwords[user.GetId()] != in.GetPassword() {
+		return nil, rpcerrors.Unauthenticated(helpers.NewErrorDetails("INVALID_CREDENTIALS", "invalid email or password"))
+	}
+
+	return s.login(user), nil
+}
+
+func (s *AuthServer) RefreshToken(_ context.Context, in *auth.RefreshTokenRequest) (*auth.TokenResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userID, ok := s.refresh[in.GetRefreshToken()]
+	if !ok {
+		return nil, rpcerrors.Unauthenticated(helpers.NewErrorDetails("INVALID_REFRESH_TOKEN", "invalid refresh token"))
+	}
+	delete(s.refresh, in.GetRefreshToken())
+
+	access, refresh := s.issue(userID)
+
+	return &auth.TokenResponse{AccessToken: access, RefreshToken: refresh, ExpiresIn: int64(accessTokenTTL.Seconds())}, nil
+}
+
+func (s *AuthServer) Logout(_ context.Context, in *auth.LogoutRequest) (*auth.LogoutResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for token, session := range s.access {
+		if session.userID == in.GetUserId() {
+			delete(s.access, token)
+		}
+	}
+	for token, userID := range s.refresh {
+		if userID == in.GetUserId() {
+			delete(s.refresh, token)
+		}
+	}
+
+	return &auth.LogoutResponse{Success: true, Message: "logged out"}, nil
+}
+
+// ValidateSession reports unknown, revoked and expired tokens as invalid
+// rather than failing.
+func (s *AuthServer) ValidateSession(_ context.Context, in *auth.ValidateSessionRequest) (*auth.ValidateSessionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.access[in.GetAccessToken()]
+	if !ok || time.Now().After(session.expires) {
+		return &auth.ValidateSessionResponse{}, nil
+	}
+	user := s.byID(session.userID)
+	if user == nil {
+		return &auth.ValidateSessionResponse{}, nil
+	}
+
+	return &auth.ValidateSessionResponse{
+		Valid:     true,
+		UserId:    user.GetId(),
+		Email:     user.GetEmail(),
+		Role:      user.GetRole(),
+		ExpiresAt: session.expires.Unix(),
+	}, nil
+}
+
+func (s *AuthServer) UpdatePassword(_ context.Context, in *auth.UpdatePasswordRequest) (*auth.UpdatePasswordResponse, error) {
+	if in.GetNewPassword() == "" {
+		return nil, required("new_password")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := s.byID(in.GetUserId())
+	if user == nil {
+		return nil, notFound("user", in.GetUserId())
+	}
+	if s.passwords[user.GetId()] != in.GetCurrentPassword() {
+		return nil, rpcerrors.Unauthenticated(helpers.NewErrorDetails("INVALID_CREDENTIALS", "current password is incorrect"))
+	}
+	s.passwords[user.GetId()] = in.GetNewPassword()
+	user.UpdatedAt = timestamppb.Now()
+
+	return &auth.UpdatePasswordResponse{Success: true, Message: "password updated"}, nil
+}
+
+// GoogleLogin returns a consent URL whose state GoogleCallback accepts
+// once.
+func (s *AuthServer) GoogleLogin(_ context.Context, in *auth.GoogleLoginRequest) (*auth.GoogleLoginResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := token()
+	s.states[state] = true
+
+	query := url.Values{"redirect_uri": {in.GetRedirectUri()}, "state": {state}, "response_type": {"code"}}
+
+	return &auth.GoogleLoginResponse{AuthUrl: "https://accounts.google.com/o/oauth2/v2/auth?" + query.Encode()}, nil
+}
+
+// GoogleCallback signs in the account of the code, registering it on its
+// first sign-in.
+func (s *AuthServer) GoogleCallback(_ context.Context, in *auth.GoogleCallbackRequest) (*auth.LoginResponse, error) {
+	if in.GetCode() == "" {
+		return nil, required("code")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.states[in.GetState()] {
+		return nil, rpcerrors.Unauthenticated(helpers.NewErrorDetails("INVALID_STATE", "unknown OAuth state"))
+	}
+	delete(s.states, in.GetState())
+
+	email, username := in.GetCode()+"@gmail.com", in.GetCode()
+	if s.Google != nil {
+		email, username = s.Google(in.GetCode())
+	}
+
+	user := s.byEmail(email)
+	if user == nil {
+		user = s.add(&auth.UserAuth{Username: username, Email: email}, "")
+	}
+
+	return s.login(user), nil
+}
+
+func (s *AuthServer) add(user *auth.UserAuth, password string) *auth.UserAuth {
+	user = proto.CloneOf(user)
+	if user.Id == "" {
+		user.Id = newID()
+	}
+	if user.Role == "" {
+		user.Role = "user"
+	}
+	if user.CreatedAt == nil {
+		user.CreatedAt = timestamppb.Now()
+		user.UpdatedAt = user.CreatedAt
+	}
+	s.users = append(s.users, user)
+	s.passwords[user.GetId()] = password
+
+	return user
+}
+
+func (s *AuthServer) login(user *auth.UserAuth) *auth.LoginResponse {
+	access, refresh := s.issue(user.GetId())
+
+	return &auth.LoginResponse{
+		Success:      true,
+		Message:      "logged in",
+		AccessToken:  access,
+		RefreshToken: refresh,
+		ExpiresIn:    int64(accessTokenTTL.Seconds()),
+		User:         proto.CloneOf(user),
+	}
+}
+
+// issue creates an access and a refresh token for a user.
+func (s *AuthServer) issue(userID string) (string, string) {
+	access, refresh := token(), token()
+	s.access[access] = session{userID: userID, expires: time.Now().Add(accessTokenTTL)}
+	s.refresh[refresh] = userID
+
+	return access, refresh
+}
+
+func (s *AuthServer) byID(id string) *auth.UserAuth {
+	for _, u := range s.users {
+		if u.GetId() == id {
+			return u
+		}
+	}
+
+	return nil
+}
+
+func (s *AuthServer) byEmail(email string) *auth.UserAuth {
+	for _, u := range s.users {
+		if strings.EqualFold(u.GetEmail(), email) {
+			return u
+		}
+	}
+
+	return nil
+}
+
+// token returns a random opaque token.
+func token() string {
+	return rand.Text()
+}
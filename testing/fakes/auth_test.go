@@ -0,0 +1,54 @@
+package fakes
+
+import (
+	"errors"
+	"testing"
+
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+	auth "github.com/FACorreiaa/loci-proto/modules/auth/generated"
+)
+
+func TestAuthServer(t *testing.T) {
+	_, brokers := start(t)
+
+	registered, err := brokers.Auth.Register(ctx, &auth.RegisterRequest{Username: "ana", Email: "ana@example.com", Password: "secret", ConfirmPassword: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	userID := registered.GetUser().GetId()
+
+	login, err := brokers.Auth.Login(ctx, &auth.LoginRequest{Email: "ana@example.com", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid, err := brokers.Auth.ValidateSession(ctx, &auth.ValidateSessionRequest{AccessToken: login.GetAccessToken()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid.GetValid() || valid.GetUserId() != userID {
+		t.Errorf("ValidateSession() = %v, want a valid session of %s", valid, userID)
+	}
+
+	// Refreshing rotates the refresh token.
+	refreshed, err := brokers.Auth.RefreshToken(ctx, &auth.RefreshTokenRequest{RefreshToken: login.GetRefreshToken()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed.GetAccessToken() == "" || refreshed.GetRefreshToken() == login.GetRefreshToken() {
+		t.Errorf("RefreshToken() = %v, want new tokens", refreshed)
+	}
+	_, err = brokers.Auth.RefreshToken(ctx, &auth.RefreshTokenRequest{RefreshToken: login.GetRefreshToken()})
+	var unauthenticated *rpcerrors.ErrUnauthenticated
+	if !errors.As(err, &unauthenticated) {
+		t.Errorf("RefreshToken() of a used token = %v, want *errors.ErrUnauthenticated", err)
+	}
+
+	_, err = brokers.Auth.Login(ctx, &auth.LoginRequest{Email: "ana@example.com", Password: "wrong"})
+	if !errors.As(err, &unauthenticated) {
+		t.Errorf("Login() with a wrong password = %v, want *errors.ErrUnauthenticated", err)
+	}
+
+	_, err = brokers.Auth.Register(ctx, &auth.RegisterRequest{Username: "bo", Email: "bo@example.com", Password: "a", ConfirmPassword: "b"})
+	wantValidation(t, err, "confirm_password")
+}
@@ -0,0 +1,309 @@
+package fakes
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+)
+
+// ChatServer is an in-memory ChatService. Every message streams back a
+// thinking event, the answer and a complete event; the answer echoes the
+// message unless Reply is set. The POIs GetPOIDetails serves are seeded
+// with AddPOIs.
+type ChatServer struct {
+	chat.UnimplementedChatServiceServer
+
+	// Reply returns the events answering message in a session. The session
+	// ID and timestamp of the events are filled in, and the messages among
+	// them are recorded in the session. It is called with the lock of the
+	// server held, so it must not call back into it.
+	Reply func(sessionID, message string) []*chat.ChatEvent
+
+	mu          sync.Mutex
+	sessions    []*chat.ChatSession
+	messages    map[string][]*chat.ChatMessage
+	itineraries []*chat.UserSavedItinerary
+	pois        map[string]*chat.POIDetailedInfo
+}
+
+var _ chat.ChatServiceServer = (*ChatServer)(nil)
+
+// NewChatServer creates a ChatService holding no sessions.
+func NewChatServer() *ChatServer {
+	return &ChatServer{
+		messages: make(map[string][]*chat.ChatMessage),
+		pois:     make(map[string]*chat.POIDetailedInfo),
+	}
+}
+
+// AddPOIs adds the POIs GetPOIDetails serves, replacing those with the same
+// ID.
+func (s *ChatServer) AddPOIs(pois ...*chat.POIDetailedInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range pois {
+		p = proto.CloneOf(p)
+		if p.Id == "" {
+			p.Id = newID()
+		}
+		s.pois[p.GetId()] = p
+	}
+}
+
+// Messages returns the messages of a session, oldest first.
+func (s *ChatServer) Messages(sessionID string) []*chat.ChatMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]*chat.ChatMessage, 0, len(s.messages[sessionID]))
+	for _, m := range s.messages[sessionID] {
+		out = append(out, proto.CloneOf(m))
+	}
+
+	return out
+}
+
+func (s *ChatServer) StartChatStream(in *chat.StartChatRequest, stream grpc.ServerStreamingServer[chat.ChatEvent]) error {
+	if in.GetUserId() == "" {
+		return required("user_id")
+	}
+
+	s.mu.Lock()
+	session := s.newSession(in.GetUserId(), in.GetProfileId(), in.GetContextType(), in.GetInitialMessage())
+	events := s.converse(session, in.GetInitialMessage())
+	s.mu.Unlock()
+
+	return send(stream, events)
+}
+
+func (s *ChatServer) ContinueChatStream(in *chat.ContinueChatRequest, stream grpc.ServerStreamingServer[chat.ChatEvent]) error {
+	s.mu.Lock()
+	session := s.find(in.GetSessionId())
+	if session == nil || session.GetUserId() != in.GetUserId() {
+		s.mu.Unlock()
+		return notFound("chat session", in.GetSessionId())
+	}
+	if in.GetContextType() != chat.ChatContextType_CHAT_CONTEXT_TYPE_UNSPECIFIED {
+		session.ContextType = in.GetContextType()
+	}
+	events := s.converse(session, in.GetMessage())
+	s.mu.Unlock()
+
+	return send(stream, events)
+}
+
+// FreeChatStream continues the anonymous session its token names, starting
+// one when there is none.
+func (s *ChatServer) FreeChatStream(in *chat.FreeChatRequest, stream grpc.ServerStreamingServer[chat.ChatEvent]) error {
+	s.mu.Lock()
+	session := s.find(in.GetSessionToken())
+	if session == nil || session.GetUserId() != "" {
+		session = s.newSession("", "", in.GetContextType(), in.GetMessage())
+	}
+	events := s.converse(session, in.GetMessage())
+	s.mu.Unlock()
+
+	return send(stream, events)
+}
+
+func (s *ChatServer) GetChatSessions(_ context.Context, in *chat.GetChatSessionsRequest) (*chat.GetChatSessionsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var sessions []*chat.ChatSession
+	for _, session := range s.sessions {
+		if session.GetUserId() == in.GetUserId() && (in.GetProfileId() == "" || session.GetProfileId() == in.GetProfileId()) {
+			sessions = append(sessions, proto.CloneOf(session))
+		}
+	}
+	slices.SortStableFunc(sessions, func(a, b *chat.ChatSession) int {
+		return b.GetUpdatedAt().AsTime().Compare(a.GetUpdatedAt().AsTime())
+	})
+
+	return &chat.GetChatSessionsResponse{
+		Sessions:   window(sessions, in.GetOffset(), in.GetLimit()),
+		TotalCount: int32(len(sessions)),
+	}, nil
+}
+
+func (s *ChatServer) SaveItinerary(_ context.Context, in *chat.SaveItineraryRequest) (*chat.SaveItineraryResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, required("user_id")
+	}
+
+	data, err := protojson.Marshal(in.GetItineraryData())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	title := in.GetTitle()
+	if title == "" {
+		title = in.GetItineraryData().GetTitle()
+	}
+
+	now := timestamppb.Now()
+	it := &chat.UserSavedItinerary{
+		Id:            newID(),
+		UserId:        in.GetUserId(),
+		SessionId:     in.GetSessionId(),
+		Title:         title,
+		Description:   in.GetDescription(),
+		ItineraryData: string(data),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	s.itineraries = append(s.itineraries, it)
+
+	return &chat.SaveItineraryResponse{ItineraryId: it.GetId(), Success: true, Message: "itinerary saved"}, nil
+}
+
+func (s *ChatServer) GetSavedItineraries(_ context.Context, in *chat.GetSavedItinerariesRequest) (*chat.GetSavedItinerariesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var itineraries []*chat.UserSavedItinerary
+	for _, it := range s.itineraries {
+		if it.GetUserId() == in.GetUserId() {
+			itineraries = append(itineraries, proto.CloneOf(it))
+		}
+	}
+
+	return &chat.GetSavedItinerariesResponse{
+		Itineraries: window(itineraries, in.GetOffset(), in.GetLimit()),
+		TotalCount:  int32(len(itineraries)),
+	}, nil
+}
+
+func (s *ChatServer) RemoveItinerary(_ context.Context, in *chat.RemoveItineraryRequest) (*chat.RemoveItineraryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := slices.IndexFunc(s.itineraries, func(it *chat.UserSavedItinerary) bool {
+		return it.GetId() == in.GetItineraryId() && it.GetUserId() == in.GetUserId()
+	})
+	if i < 0 {
+		return nil, notFound("itinerary", in.GetItineraryId())
+	}
+	s.itineraries = slices.Delete(s.itineraries, i, i+1)
+
+	return &chat.RemoveItineraryResponse{Success: true, Message: "itinerary removed"}, nil
+}
+
+func (s *ChatServer) GetPOIDetails(_ context.Context, in *chat.GetPOIDetailsRequest) (*chat.GetPOIDetailsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.pois[in.GetPoiId()]
+	if !ok {
+		return nil, notFound("POI", in.GetPoiId())
+	}
+
+	p = proto.CloneOf(p)
+	if !in.GetIncludePhotos() {
+		p.Photos = nil
+	}
+
+	return &chat.GetPOIDetailsResponse{Poi: p}, nil
+}
+
+func (s *ChatServer) find(id string) *chat.ChatSession {
+	for _, session := range s.sessions {
+		if session.GetId() == id {
+			return session
+		}
+	}
+
+	return nil
+}
+
+func (s *ChatServer) newSession(userID, profileID string, contextType chat.ChatContextType, firstMessage string) *chat.ChatSession {
+	title := []rune(firstMessage)
+	if len(title) > 50 {
+		title = title[:50]
+	}
+
+	now := timestamppb.Now()
+	session := &chat.ChatSession{
+		Id:          newID(),
+		UserId:      userID,
+		ProfileId:   profileID,
+		Title:       string(title),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		ContextType: contextType,
+	}
+	s.sessions = append(s.sessions, session)
+
+	return session
+}
+
+// converse records message in session and returns the events answering
+// it, ending with a complete event.
+func (s *ChatServer) converse(session *chat.ChatSession, message string) []*chat.ChatEvent {
+	s.record(session, &chat.ChatMessage{Content: message, Role: "user"})
+
+	var events []*chat.ChatEvent
+	if s.Reply != nil {
+		events = s.Reply(session.GetId(), message)
+	} else {
+		events = []*chat.ChatEvent{
+			{EventType: "thinking", Payload: &chat.ChatEvent_Thinking{Thinking: &chat.ThinkingEvent{Message: "thinking", Progress: 50}}},
+			{EventType: "message", Data: message, Payload: &chat.ChatEvent_Message{Message: &chat.ChatMessage{Content: message, Role: "assistant"}}},
+		}
+	}
+
+	now := timestamppb.Now()
+	for _, e := range events {
+		e.SessionId, e.Timestamp = session.GetId(), cmp.Or(e.GetTimestamp(), now)
+		if m := e.GetMessage(); m != nil {
+			if m.Role == "" {
+				m.Role = "assistant"
+			}
+			s.record(session, m)
+		}
+	}
+
+	return append(events, &chat.ChatEvent{
+		EventType: "complete",
+		SessionId: session.GetId(),
+		Timestamp: now,
+		Payload: &chat.ChatEvent_Complete{Complete: &chat.CompleteEvent{
+			SessionId:     session.GetId(),
+			TotalMessages: session.GetMessageCount(),
+			CompletedAt:   now,
+		}},
+	})
+}
+
+func (s *ChatServer) record(session *chat.ChatSession, m *chat.ChatMessage) {
+	m.Id, m.SessionId, m.CreatedAt = newID(), session.GetId(), timestamppb.Now()
+	if m.ContextType == chat.ChatContextType_CHAT_CONTEXT_TYPE_UNSPECIFIED {
+		m.ContextType = session.GetContextType()
+	}
+
+	s.messages[session.GetId()] = append(s.messages[session.GetId()], proto.CloneOf(m))
+	session.MessageCount++
+	session.UpdatedAt = m.GetCreatedAt()
+}
+
+func send(stream grpc.ServerStreamingServer[chat.ChatEvent], events []*chat.ChatEvent) error {
+	for _, e := range events {
+		if err := stream.Send(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
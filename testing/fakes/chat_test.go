@@ -0,0 +1,69 @@
+package fakes
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+)
+
+// recvAll receives from stream until it ends.
+func recvAll[T any](stream interface{ Recv() (T, error) }) ([]T, error) {
+	var out []T
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return out, err
+		}
+		out = append(out, msg)
+	}
+}
+
+func TestChatServer(t *testing.T) {
+	srv, brokers := start(t)
+
+	stream, err := brokers.Chat.StartChatStream(ctx, &chat.StartChatRequest{UserId: "u1", InitialMessage: "Plan a day in Lisbon"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := recvAll(stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) == 0 || events[len(events)-1].GetComplete() == nil {
+		t.Fatalf("StartChatStream() sent %v, want events ending with a complete event", events)
+	}
+	session := events[0].GetSessionId()
+
+	continued, err := brokers.Chat.ContinueChatStream(ctx, &chat.ContinueChatRequest{UserId: "u1", SessionId: session, Message: "And dinner?"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recvAll(continued); err != nil {
+		t.Fatal(err)
+	}
+
+	// Both questions and both answers are kept in the session.
+	if got := len(srv.Chat.Messages(session)); got != 4 {
+		t.Errorf("session holds %d messages, want 4", got)
+	}
+	sessions, err := brokers.Chat.GetChatSessions(ctx, &chat.GetChatSessionsRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sessions.GetSessions(); len(got) != 1 || got[0].GetId() != session || got[0].GetMessageCount() != 4 {
+		t.Errorf("GetChatSessions() = %v, want session %s with 4 messages", got, session)
+	}
+
+	// Errors reach the client typed, on the first Recv.
+	other, err := brokers.Chat.ContinueChatStream(ctx, &chat.ContinueChatRequest{UserId: "u2", SessionId: session, Message: "Hi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = recvAll(other)
+	wantNotFound(t, err)
+}
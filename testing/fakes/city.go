@@ -0,0 +1,255 @@
+package fakes
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	city "github.com/FACorreiaa/loci-proto/modules/city/generated"
+)
+
+// maxFuzzyDistance is the largest number of edits a fuzzy city search
+// tolerates between the query and a city name.
+const maxFuzzyDistance = 2
+
+// CityServer is an in-memory CityService. Cities are read-only through the
+// service; they, their statistics, weather and trends are seeded with the
+// Add and Set methods.
+type CityServer struct {
+	city.UnimplementedCityServiceServer
+
+	mu         sync.Mutex
+	cities     []*city.City
+	statistics map[string]*city.CityStatistics
+	weather    map[string]*city.WeatherInfo
+	trends     map[string][]*city.TrendData
+}
+
+var _ city.CityServiceServer = (*CityServer)(nil)
+
+// NewCityServer creates a CityService holding no cities.
+func NewCityServer() *CityServer {
+	return &CityServer{
+		statistics: make(map[string]*city.CityStatistics),
+		weather:    make(map[string]*city.WeatherInfo),
+		trends:     make(map[string][]*city.TrendData),
+	}
+}
+
+// AddCities adds cities, replacing those with the same ID. Cities without
+// an ID are given one.
+func (s *CityServer) AddCities(cities ...*city.City) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range cities {
+		c = proto.CloneOf(c)
+		if c.Id == "" {
+			c.Id = newID()
+		}
+		if i := slices.IndexFunc(s.cities, func(o *city.City) bool { return o.GetId() == c.GetId() }); i >= 0 {
+			s.cities[i] = c
+		} else {
+			s.cities = append(s.cities, c)
+		}
+	}
+}
+
+// SetStatistics sets the statistics of the cities they name.
+func (s *CityServer) SetStatistics(statistics ...*city.CityStatistics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, st := range statistics {
+		s.statistics[st.GetCityId()] = proto.CloneOf(st)
+	}
+}
+
+// SetWeather sets the weather of the cities it names.
+func (s *CityServer) SetWeather(weather ...*city.WeatherInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, w := range weather {
+		s.weather[w.GetCityId()] = proto.CloneOf(w)
+	}
+}
+
+// SetTrends sets the trends GetCityStatistics returns for a city.
+func (s *CityServer) SetTrends(cityID string, trends ...*city.TrendData) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.trends[cityID] = nil
+	for _, t := range trends {
+		s.trends[cityID] = append(s.trends[cityID], proto.CloneOf(t))
+	}
+}
+
+func (s *CityServer) GetCities(_ context.Context, in *city.GetCitiesRequest) (*city.GetCitiesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var cities []*city.City
+	for _, c := range s.cities {
+		if (in.GetCountryCode() == "" || strings.EqualFold(c.GetCountryCode(), in.GetCountryCode())) &&
+			(!in.GetPopularOnly() || c.GetMetadata().GetIsPopularDestination()) {
+			cities = append(cities, proto.CloneOf(c))
+		}
+	}
+
+	return &city.GetCitiesResponse{
+		Cities:     window(cities, in.GetOffset(), in.GetLimit()),
+		TotalCount: int32(len(cities)),
+	}, nil
+}
+
+func (s *CityServer) GetCity(_ context.Context, in *city.GetCityRequest) (*city.GetCityResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.find(in.GetCityId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &city.GetCityResponse{City: proto.CloneOf(c)}
+	if in.GetIncludeStatistics() {
+		resp.Statistics = s.cityStatistics(c.GetId())
+	}
+	if w, ok := s.weather[c.GetId()]; ok && in.GetIncludeWeather() {
+		resp.Weather = proto.CloneOf(w)
+	}
+
+	return resp, nil
+}
+
+// SearchCities ranks exact name matches first, then name prefixes, names
+// containing the query, and cities in a matching country. Fuzzy searches
+// also match names within a couple of typos of the query.
+func (s *CityServer) SearchCities(_ context.Context, in *city.SearchCitiesRequest) (*city.SearchCitiesResponse, error) {
+	if strings.TrimSpace(in.GetQuery()) == "" {
+		return nil, required("query")
+	}
+
+	start := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := strings.ToLower(strings.TrimSpace(in.GetQuery()))
+	var results []*city.CitySearchResult
+	fuzzy := false
+	for _, c := range s.cities {
+		if in.GetCountryCode() != "" && !strings.EqualFold(c.GetCountryCode(), in.GetCountryCode()) {
+			continue
+		}
+
+		name := strings.ToLower(c.GetName())
+		result := &city.CitySearchResult{City: proto.CloneOf(c)}
+		switch {
+		case name == query:
+			result.RelevanceScore, result.MatchReason = 1, "exact name match"
+		case strings.HasPrefix(name, query):
+			result.RelevanceScore, result.MatchReason = 0.8, "name prefix match"
+		case strings.Contains(name, query):
+			result.RelevanceScore, result.MatchReason = 0.6, "partial name match"
+		case in.GetFuzzySearch() && distance(name, query) <= maxFuzzyDistance:
+			result.RelevanceScore, result.MatchReason = 0.5, "fuzzy name match"
+			fuzzy = true
+		case strings.EqualFold(c.GetCountry(), query) || strings.EqualFold(c.GetCountryCode(), query):
+			result.RelevanceScore, result.MatchReason = 0.4, "country match"
+		default:
+			continue
+		}
+		results = append(results, result)
+	}
+	slices.SortStableFunc(results, func(a, b *city.CitySearchResult) int {
+		return cmp.Or(
+			cmp.Compare(b.GetRelevanceScore(), a.GetRelevanceScore()),
+			cmp.Compare(b.GetCity().GetPopulation(), a.GetCity().GetPopulation()),
+		)
+	})
+
+	method := "text"
+	if in.GetFuzzySearch() {
+		method = "fuzzy"
+	}
+
+	return &city.SearchCitiesResponse{
+		Results:    window(results, in.GetOffset(), in.GetLimit()),
+		TotalCount: int32(len(results)),
+		Metadata: &city.SearchMetadata{
+			QueryTimeMs:       float64(time.Since(start).Microseconds()) / 1000,
+			SearchMethod:      method,
+			FuzzyMatchingUsed: fuzzy,
+		},
+	}, nil
+}
+
+func (s *CityServer) GetCityStatistics(_ context.Context, in *city.GetCityStatisticsRequest) (*city.GetCityStatisticsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.find(in.GetCityId()); err != nil {
+		return nil, err
+	}
+
+	resp := &city.GetCityStatisticsResponse{Statistics: s.cityStatistics(in.GetCityId())}
+	if in.GetIncludeTrends() {
+		for _, t := range s.trends[in.GetCityId()] {
+			resp.Trends = append(resp.Trends, proto.CloneOf(t))
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *CityServer) find(id string) (*city.City, error) {
+	for _, c := range s.cities {
+		if c.GetId() == id {
+			return c, nil
+		}
+	}
+
+	return nil, notFound("city", id)
+}
+
+// cityStatistics returns the statistics set for a city, or empty ones.
+func (s *CityServer) cityStatistics(id string) *city.CityStatistics {
+	if st, ok := s.statistics[id]; ok {
+		return proto.CloneOf(st)
+	}
+
+	return &city.CityStatistics{CityId: id, LastUpdated: timestamppb.Now()}
+}
+
+// distance returns the Levenshtein distance between a and b.
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := range ra {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i + 1
+		for j := range rb {
+			cost := 1
+			if ra[i] == rb[j] {
+				cost = 0
+			}
+			cur[j+1] = min(prev[j+1]+1, cur[j]+1, prev[j]+cost)
+		}
+		prev = cur
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,47 @@
+package fakes
+
+import (
+	"testing"
+
+	city "github.com/FACorreiaa/loci-proto/modules/city/generated"
+)
+
+func TestCityServer(t *testing.T) {
+	srv, brokers := start(t)
+
+	srv.City.AddCities(
+		&city.City{Id: "lisbon", Name: "Lisbon", Country: "Portugal", CountryCode: "PT", Population: 545000},
+		&city.City{Id: "porto", Name: "Porto", Country: "Portugal", CountryCode: "PT", Population: 232000},
+	)
+
+	got, err := brokers.City.GetCity(ctx, &city.GetCityRequest{CityId: "lisbon", IncludeStatistics: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetCity().GetName() != "Lisbon" || got.GetStatistics().GetCityId() != "lisbon" {
+		t.Errorf("GetCity() = %v, %v, want Lisbon with its statistics", got.GetCity(), got.GetStatistics())
+	}
+
+	found, err := brokers.City.SearchCities(ctx, &city.SearchCitiesRequest{Query: "Lisbn", FuzzySearch: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results := found.GetResults(); len(results) != 1 || results[0].GetCity().GetId() != "lisbon" {
+		t.Errorf("SearchCities(Lisbn) = %v, want lisbon", results)
+	}
+
+	// Both cities match their country, the larger first.
+	found, err = brokers.City.SearchCities(ctx, &city.SearchCitiesRequest{Query: "portugal"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results := found.GetResults(); len(results) != 2 || results[0].GetCity().GetId() != "lisbon" {
+		t.Errorf("SearchCities(portugal) = %v, want lisbon then porto", results)
+	}
+
+	_, err = brokers.City.GetCity(ctx, &city.GetCityRequest{CityId: "madrid"})
+	wantNotFound(t, err)
+
+	_, err = brokers.City.SearchCities(ctx, new(city.SearchCitiesRequest))
+	wantValidation(t, err, "query")
+}
@@ -0,0 +1,152 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+	helpers "github.com/FACorreiaa/loci-proto/modules/common"
+	customer "github.com/FACorreiaa/loci-proto/modules/customer/generated"
+)
+
+// CustomerServer is an in-memory Customer service. Customers are addressed
+// by their public ID. An update is a list of diffs on dotted field paths,
+// such as "name.first", each applied only if the field still holds its old
+// value. Soft-deleted customers are kept but no longer found.
+type CustomerServer struct {
+	customer.UnimplementedCustomerServer
+
+	mu        sync.Mutex
+	customers []*customer.XCustomer
+	deleted   map[string]bool
+}
+
+var _ customer.CustomerServer = (*CustomerServer)(nil)
+
+// NewCustomerServer creates a Customer service holding no customers.
+func NewCustomerServer() *CustomerServer {
+	return &CustomerServer{deleted: make(map[string]bool)}
+}
+
+func (s *CustomerServer) GetCustomer(_ context.Context, in *customer.GetCustomerReq) (*customer.GetCustomerRes, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.find(in.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &customer.GetCustomerRes{Success: true, Customer: proto.CloneOf(c)}, nil
+}
+
+// CreateCustomer keeps the public ID of the customer when it is set, and
+// always assigns a new private ID.
+func (s *CustomerServer) CreateCustomer(_ context.Context, in *customer.CreateCustomerReq) (*customer.CreateCustomerRes, error) {
+	if in.GetCustomer().GetEmail() == "" {
+		return nil, required("customer.email")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := proto.CloneOf(in.GetCustomer())
+	if c.PublicId == "" {
+		c.PublicId = newID()
+	}
+	c.PrivateId = newID()
+	if slices.ContainsFunc(s.customers, func(o *customer.XCustomer) bool { return o.GetPublicId() == c.GetPublicId() }) {
+		return nil, status.Errorf(codes.AlreadyExists, "customer %q already exists", c.GetPublicId())
+	}
+	s.customers = append(s.customers, c)
+
+	return &customer.CreateCustomerRes{Success: true, Customer: proto.CloneOf(c)}, nil
+}
+
+// UpdateCustomer applies every diff or none: an unknown field is a
+// validation error, and a field that no longer holds the old value of its
+// diff fails the precondition.
+func (s *CustomerServer) UpdateCustomer(_ context.Context, in *customer.UpdateCustomerReq) (*customer.UpdateCustomerRes, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.find(in.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+
+	updated := proto.CloneOf(c)
+	for i, diff := range in.GetUpdates() {
+		m, fd := fieldPath(updated.ProtoReflect(), diff.GetField())
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			message := "unknown customer field " + diff.GetField()
+			return nil, rpcerrors.Validation(message, helpers.NewFieldError(fmt.Sprintf("updates[%d].field", i), message, rpcerrors.InvalidValue))
+		}
+		if got := m.Get(fd).String(); got != diff.GetOldValue() {
+			return nil, status.Errorf(codes.FailedPrecondition, "customer field %s is %q, not %q", diff.GetField(), got, diff.GetOldValue())
+		}
+		m.Set(fd, protoreflect.ValueOfString(diff.GetNewValue()))
+	}
+	proto.Reset(c)
+	proto.Merge(c, updated)
+
+	return &customer.UpdateCustomerRes{Success: true, Customer: proto.CloneOf(c)}, nil
+}
+
+func (s *CustomerServer) DeleteCustomer(_ context.Context, in *customer.DeleteCustomerReq) (*customer.NilRes, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.find(in.GetCustomerId()); err != nil {
+		return nil, err
+	}
+
+	if in.GetHardDelete() {
+		s.customers = slices.DeleteFunc(s.customers, func(c *customer.XCustomer) bool { return c.GetPublicId() == in.GetCustomerId() })
+	} else {
+		s.deleted[in.GetCustomerId()] = true
+	}
+
+	return new(customer.NilRes), nil
+}
+
+func (s *CustomerServer) find(publicID string) (*customer.XCustomer, error) {
+	for _, c := range s.customers {
+		if c.GetPublicId() == publicID && !s.deleted[publicID] {
+			return c, nil
+		}
+	}
+
+	return nil, notFound("customer", publicID)
+}
+
+// fieldPath resolves a dotted path of field names from m, returning the
+// message holding the last field and that field. Messages along the path
+// are created as needed. It returns a nil field when the path does not
+// resolve.
+func fieldPath(m protoreflect.Message, path string) (protoreflect.Message, protoreflect.FieldDescriptor) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, nil
+		}
+		if i == len(names)-1 {
+			return m, fd
+		}
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, nil
+		}
+		m = m.Mutable(fd).Message()
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,60 @@
+package fakes
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	customer "github.com/FACorreiaa/loci-proto/modules/customer/generated"
+)
+
+func TestCustomerServer(t *testing.T) {
+	_, brokers := start(t)
+
+	created, err := brokers.Customer.CreateCustomer(ctx, &customer.CreateCustomerReq{Customer: &customer.XCustomer{
+		Email: "ana@example.com",
+		Name:  &customer.XName{First: "Ana"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetCustomer().GetPublicId()
+
+	updated, err := brokers.Customer.UpdateCustomer(ctx, &customer.UpdateCustomerReq{
+		CustomerId: id,
+		Updates:    []*customer.XDiff{{Field: "name.first", OldValue: "Ana", NewValue: "Anna"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := updated.GetCustomer().GetName().GetFirst(); got != "Anna" {
+		t.Errorf("UpdateCustomer() first name = %s, want Anna", got)
+	}
+
+	// A diff against a stale value is refused.
+	_, err = brokers.Customer.UpdateCustomer(ctx, &customer.UpdateCustomerReq{
+		CustomerId: id,
+		Updates:    []*customer.XDiff{{Field: "name.first", OldValue: "Ana", NewValue: "Anita"}},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateCustomer() of a stale value = %v, want %v", err, codes.FailedPrecondition)
+	}
+
+	got, err := brokers.Customer.GetCustomer(ctx, &customer.GetCustomerReq{PublicId: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetCustomer().GetEmail() != "ana@example.com" || got.GetCustomer().GetName().GetFirst() != "Anna" {
+		t.Errorf("GetCustomer() = %v, want Anna", got.GetCustomer())
+	}
+
+	if _, err := brokers.Customer.DeleteCustomer(ctx, &customer.DeleteCustomerReq{CustomerId: id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = brokers.Customer.GetCustomer(ctx, &customer.GetCustomerReq{PublicId: id})
+	wantNotFound(t, err)
+
+	_, err = brokers.Customer.CreateCustomer(ctx, &customer.CreateCustomerReq{Customer: new(customer.XCustomer)})
+	wantValidation(t, err, "customer.email")
+}
@@ -0,0 +1,145 @@
+package fakes
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	interests "github.com/FACorreiaa/loci-proto/modules/interests/generated"
+)
+
+// InterestsServer is an in-memory InterestsService. Interests are shared by
+// every user; the catalogue the service ships with is seeded with
+// AddInterests.
+type InterestsServer struct {
+	interests.UnimplementedInterestsServiceServer
+
+	mu        sync.Mutex
+	interests []*interests.Interest
+}
+
+var _ interests.InterestsServiceServer = (*InterestsServer)(nil)
+
+// NewInterestsServer creates an InterestsService holding no interests.
+func NewInterestsServer() *InterestsServer {
+	return new(InterestsServer)
+}
+
+// AddInterests adds interests as they are. Interests without an ID are
+// given one.
+func (s *InterestsServer) AddInterests(items ...*interests.Interest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, i := range items {
+		i = proto.CloneOf(i)
+		if i.Id == "" {
+			i.Id = newID()
+		}
+		s.interests = append(s.interests, i)
+	}
+}
+
+func (s *InterestsServer) GetAllInterests(context.Context, *interests.GetAllInterestsRequest) (*interests.GetAllInterestsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]*interests.Interest, 0, len(s.interests))
+	for _, i := range s.interests {
+		out = append(out, proto.CloneOf(i))
+	}
+
+	return &interests.GetAllInterestsResponse{Interests: out}, nil
+}
+
+func (s *InterestsServer) CreateInterest(_ context.Context, in *interests.CreateInterestRequest) (*interests.CreateInterestResponse, error) {
+	if in.GetInterest().GetName() == "" {
+		return nil, required("interest.name")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.unique("", in.GetInterest().GetName()); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.Now()
+	i := &interests.Interest{
+		Id:          newID(),
+		Name:        in.GetInterest().GetName(),
+		Description: in.GetInterest().GetDescription(),
+		Active:      in.GetInterest().GetActive(),
+		Source:      "user",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	s.interests = append(s.interests, i)
+
+	return &interests.CreateInterestResponse{Success: true, Message: "interest created", Interest: proto.CloneOf(i)}, nil
+}
+
+// UpdateInterest overwrites the name and description of the interest when
+// set in the request, and always its active flag.
+func (s *InterestsServer) UpdateInterest(_ context.Context, in *interests.UpdateInterestRequest) (*interests.UpdateInterestResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, err := s.find(in.GetInterestId())
+	if err != nil {
+		return nil, err
+	}
+	if name := in.GetInterest().GetName(); name != "" {
+		if err := s.unique(i.GetId(), name); err != nil {
+			return nil, err
+		}
+	}
+
+	i.Name = cmp.Or(in.GetInterest().GetName(), i.GetName())
+	i.Description = cmp.Or(in.GetInterest().GetDescription(), i.GetDescription())
+	i.Active = in.GetInterest().GetActive()
+	i.UpdatedAt = timestamppb.Now()
+
+	return &interests.UpdateInterestResponse{Success: true, Message: "interest updated", Interest: proto.CloneOf(i)}, nil
+}
+
+func (s *InterestsServer) RemoveInterest(_ context.Context, in *interests.RemoveInterestRequest) (*interests.RemoveInterestResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.find(in.GetInterestId()); err != nil {
+		return nil, err
+	}
+	s.interests = slices.DeleteFunc(s.interests, func(i *interests.Interest) bool { return i.GetId() == in.GetInterestId() })
+
+	return &interests.RemoveInterestResponse{Success: true, Message: "interest removed"}, nil
+}
+
+func (s *InterestsServer) find(id string) (*interests.Interest, error) {
+	for _, i := range s.interests {
+		if i.GetId() == id {
+			return i, nil
+		}
+	}
+
+	return nil, notFound("interest", id)
+}
+
+// unique reports an error when another interest, than the one with ID id,
+// is named name.
+func (s *InterestsServer) unique(id, name string) error {
+	for _, i := range s.interests {
+		if i.GetId() != id && strings.EqualFold(i.GetName(), name) {
+			return status.Errorf(codes.AlreadyExists, "interest %q already exists", name)
+		}
+	}
+
+	return nil
+}
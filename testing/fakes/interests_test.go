@@ -0,0 +1,38 @@
+package fakes
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	interests "github.com/FACorreiaa/loci-proto/modules/interests/generated"
+)
+
+func TestInterestsServer(t *testing.T) {
+	_, brokers := start(t)
+
+	created, err := brokers.Interests.CreateInterest(ctx, &interests.CreateInterestRequest{Interest: &interests.CreateInterestParams{Name: "Museums", Active: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := brokers.Interests.GetAllInterests(ctx, new(interests.GetAllInterestsRequest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := all.GetInterests(); len(got) != 1 || got[0].GetId() != created.GetInterest().GetId() || got[0].GetName() != "Museums" {
+		t.Errorf("GetAllInterests() = %v, want Museums", got)
+	}
+
+	_, err = brokers.Interests.CreateInterest(ctx, &interests.CreateInterestRequest{Interest: &interests.CreateInterestParams{Name: "museums"}})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("CreateInterest() of a taken name = %v, want %v", err, codes.AlreadyExists)
+	}
+
+	_, err = brokers.Interests.RemoveInterest(ctx, &interests.RemoveInterestRequest{InterestId: "missing"})
+	wantNotFound(t, err)
+
+	_, err = brokers.Interests.CreateInterest(ctx, new(interests.CreateInterestRequest))
+	wantValidation(t, err, "interest.name")
+}
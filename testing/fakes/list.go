@@ -0,0 +1,559 @@
+package fakes
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	list "github.com/FACorreiaa/loci-proto/modules/list/generated"
+)
+
+// ListServer is an in-memory ListService. Lists can only be changed by
+// their owner, and only read by others once public.
+//
+// The content of list items only carries their ID, unless Content is set
+// to resolve it, e.g. from the POIs seeded on a POIServer.
+type ListServer struct {
+	list.UnimplementedListServiceServer
+
+	// Content resolves the content of an item. It is called with the lock
+	// of the server held, so it must not call back into it.
+	Content func(item *list.ListItem) *list.ListItemWithContent
+
+	mu    sync.Mutex
+	lists []*list.List
+	items map[string][]*list.ListItem
+	saved map[string][]string
+}
+
+var _ list.ListServiceServer = (*ListServer)(nil)
+
+// NewListServer creates a ListService holding no lists.
+func NewListServer() *ListServer {
+	return &ListServer{
+		items: make(map[string][]*list.ListItem),
+		saved: make(map[string][]string),
+	}
+}
+
+func (s *ListServer) CreateList(_ context.Context, in *list.CreateListRequest) (*list.CreateListResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, required("user_id")
+	}
+	if in.GetName() == "" {
+		return nil, required("name")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l := s.add(&list.List{
+		UserId:      in.GetUserId(),
+		Name:        in.GetName(),
+		Description: in.GetDescription(),
+		CityId:      in.GetCityId(),
+		IsItinerary: in.GetIsItinerary(),
+		IsPublic:    in.GetIsPublic(),
+	})
+
+	return &list.CreateListResponse{Success: true, Message: "list created", List: proto.CloneOf(l)}, nil
+}
+
+func (s *ListServer) add(l *list.List) *list.List {
+	now := timestamppb.Now()
+	l.Id, l.CreatedAt, l.UpdatedAt = newID(), now, now
+	s.lists = append(s.lists, l)
+
+	return l
+}
+
+func (s *ListServer) GetLists(_ context.Context, in *list.GetListsRequest) (*list.GetListsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var lists []*list.List
+	for _, l := range s.lists {
+		if l.GetUserId() == in.GetUserId() {
+			lists = append(lists, l)
+		}
+	}
+
+	return &list.GetListsResponse{
+		Lists:      s.withItems(window(lists, in.GetOffset(), in.GetLimit()), in.GetIncludeItems()),
+		TotalCount: int32(len(lists)),
+	}, nil
+}
+
+func (s *ListServer) GetList(_ context.Context, in *list.GetListRequest) (*list.GetListResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.readable(in.GetUserId(), in.GetListId())
+	if err != nil {
+		return nil, err
+	}
+	if l.GetUserId() != in.GetUserId() {
+		l.ViewCount++
+	}
+
+	detailed := &list.ListWithDetailedItems{List: proto.CloneOf(l)}
+	if in.GetIncludeDetailedItems() {
+		detailed.Items = s.contents(l.GetId(), list.ContentType_CONTENT_TYPE_UNSPECIFIED)
+	}
+
+	return &list.GetListResponse{List: detailed}, nil
+}
+
+func (s *ListServer) UpdateList(_ context.Context, in *list.UpdateListRequest) (*list.UpdateListResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.owned(in.GetUserId(), in.GetListId())
+	if err != nil {
+		return nil, err
+	}
+
+	if in.GetName() != "" {
+		l.Name = in.GetName()
+	}
+	if in.GetDescription() != "" {
+		l.Description = in.GetDescription()
+	}
+	if in.GetImageUrl() != "" {
+		l.ImageUrl = in.GetImageUrl()
+	}
+	if in.GetCityId() != "" {
+		l.CityId = in.GetCityId()
+	}
+	l.IsPublic = in.GetIsPublic()
+	l.UpdatedAt = timestamppb.Now()
+
+	return &list.UpdateListResponse{Success: true, Message: "list updated", List: proto.CloneOf(l)}, nil
+}
+
+func (s *ListServer) DeleteList(_ context.Context, in *list.DeleteListRequest) (*list.DeleteListResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.owned(in.GetUserId(), in.GetListId()); err != nil {
+		return nil, err
+	}
+
+	s.lists = slices.DeleteFunc(s.lists, func(l *list.List) bool { return l.GetId() == in.GetListId() })
+	delete(s.items, in.GetListId())
+	for user, ids := range s.saved {
+		s.saved[user] = slices.DeleteFunc(ids, func(id string) bool { return id == in.GetListId() })
+	}
+
+	return &list.DeleteListResponse{Success: true, Message: "list deleted"}, nil
+}
+
+func (s *ListServer) CreateItinerary(_ context.Context, in *list.CreateItineraryRequest) (*list.CreateItineraryResponse, error) {
+	if in.GetName() == "" {
+		return nil, required("name")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parent, err := s.owned(in.GetUserId(), in.GetParentListId())
+	if err != nil {
+		return nil, err
+	}
+
+	it := s.add(&list.List{
+		UserId:       in.GetUserId(),
+		Name:         in.GetName(),
+		Description:  in.GetDescription(),
+		IsPublic:     in.GetIsPublic(),
+		IsItinerary:  true,
+		ParentListId: parent.GetId(),
+		CityId:       parent.GetCityId(),
+	})
+
+	return &list.CreateItineraryResponse{Success: true, Message: "itinerary created", Itinerary: proto.CloneOf(it)}, nil
+}
+
+func (s *ListServer) AddListItem(_ context.Context, in *list.AddListItemRequest) (*list.AddListItemResponse, error) {
+	if in.GetItemId() == "" {
+		return nil, required("item_id")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.owned(in.GetUserId(), in.GetListId())
+	if err != nil {
+		return nil, err
+	}
+
+	items := s.items[l.GetId()]
+	if s.findItem(l.GetId(), in.GetItemId(), in.GetContentType()) >= 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "item %q is already in list %q", in.GetItemId(), l.GetId())
+	}
+
+	now := timestamppb.Now()
+	item := &list.ListItem{
+		ListId:                 l.GetId(),
+		ItemId:                 in.GetItemId(),
+		ContentType:            in.GetContentType(),
+		Position:               in.GetPosition(),
+		Notes:                  in.GetNotes(),
+		DayNumber:              in.GetDayNumber(),
+		TimeSlot:               in.GetTimeSlot(),
+		Duration:               in.GetDurationMinutes(),
+		CreatedAt:              now,
+		UpdatedAt:              now,
+		SourceLlmInteractionId: in.GetSourceLlmInteractionId(),
+		ItemAiDescription:      in.GetItemAiDescription(),
+	}
+	if item.Position == 0 {
+		item.Position = int32(len(items)) + 1
+	}
+	if item.ContentType == list.ContentType_CONTENT_TYPE_UNSPECIFIED || item.ContentType == list.ContentType_CONTENT_TYPE_POI {
+		item.PoiId = item.ItemId
+	}
+
+	s.items[l.GetId()] = sortItems(append(items, item))
+	l.ItemCount = int32(len(s.items[l.GetId()]))
+	l.UpdatedAt = now
+
+	return &list.AddListItemResponse{Success: true, Message: "item added", Item: proto.CloneOf(item)}, nil
+}
+
+func (s *ListServer) UpdateListItem(_ context.Context, in *list.UpdateListItemRequest) (*list.UpdateListItemResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.owned(in.GetUserId(), in.GetListId())
+	if err != nil {
+		return nil, err
+	}
+
+	i := s.findItem(l.GetId(), in.GetItemId(), in.GetContentType())
+	if i < 0 {
+		return nil, notFound("list item", in.GetItemId())
+	}
+
+	item := s.items[l.GetId()][i]
+	if in.GetPosition() != 0 {
+		item.Position = in.GetPosition()
+	}
+	if in.GetNotes() != "" {
+		item.Notes = in.GetNotes()
+	}
+	if in.GetDayNumber() != 0 {
+		item.DayNumber = in.GetDayNumber()
+	}
+	if in.GetTimeSlot() != nil {
+		item.TimeSlot = in.GetTimeSlot()
+	}
+	if in.GetDurationMinutes() != 0 {
+		item.Duration = in.GetDurationMinutes()
+	}
+	if in.GetSourceLlmInteractionId() != "" {
+		item.SourceLlmInteractionId = in.GetSourceLlmInteractionId()
+	}
+	if in.GetItemAiDescription() != "" {
+		item.ItemAiDescription = in.GetItemAiDescription()
+	}
+	item.UpdatedAt = timestamppb.Now()
+	s.items[l.GetId()] = sortItems(s.items[l.GetId()])
+
+	return &list.UpdateListItemResponse{Success: true, Message: "item updated", Item: proto.CloneOf(item)}, nil
+}
+
+func (s *ListServer) RemoveListItem(_ context.Context, in *list.RemoveListItemRequest) (*list.RemoveListItemResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.owned(in.GetUserId(), in.GetListId())
+	if err != nil {
+		return nil, err
+	}
+
+	i := s.findItem(l.GetId(), in.GetItemId(), in.GetContentType())
+	if i < 0 {
+		return nil, notFound("list item", in.GetItemId())
+	}
+
+	s.items[l.GetId()] = slices.Delete(s.items[l.GetId()], i, i+1)
+	l.ItemCount = int32(len(s.items[l.GetId()]))
+	l.UpdatedAt = timestamppb.Now()
+
+	return &list.RemoveListItemResponse{Success: true, Message: "item removed"}, nil
+}
+
+func (s *ListServer) GetListItems(_ context.Context, in *list.GetListItemsRequest) (*list.GetListItemsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.readable(in.GetUserId(), in.GetListId())
+	if err != nil {
+		return nil, err
+	}
+
+	items := s.contents(l.GetId(), list.ContentType_CONTENT_TYPE_UNSPECIFIED)
+	if !in.GetIncludeContentDetails() {
+		for _, item := range items {
+			item.Poi, item.Restaurant, item.Hotel, item.Itinerary = nil, nil, nil, nil
+		}
+	}
+
+	return &list.GetListItemsResponse{Items: items, TotalCount: int32(len(items))}, nil
+}
+
+func (s *ListServer) GetListRestaurants(_ context.Context, in *list.GetListRestaurantsRequest) (*list.GetListRestaurantsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.readable(in.GetUserId(), in.GetListId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(list.GetListRestaurantsResponse)
+	for _, item := range s.contents(l.GetId(), list.ContentType_CONTENT_TYPE_RESTAURANT) {
+		resp.Restaurants = append(resp.Restaurants, item.GetRestaurant())
+	}
+
+	return resp, nil
+}
+
+func (s *ListServer) GetListHotels(_ context.Context, in *list.GetListHotelsRequest) (*list.GetListHotelsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.readable(in.GetUserId(), in.GetListId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(list.GetListHotelsResponse)
+	for _, item := range s.contents(l.GetId(), list.ContentType_CONTENT_TYPE_HOTEL) {
+		resp.Hotels = append(resp.Hotels, item.GetHotel())
+	}
+
+	return resp, nil
+}
+
+func (s *ListServer) GetListItineraries(_ context.Context, in *list.GetListItinerariesRequest) (*list.GetListItinerariesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.readable(in.GetUserId(), in.GetListId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(list.GetListItinerariesResponse)
+	for _, item := range s.contents(l.GetId(), list.ContentType_CONTENT_TYPE_ITINERARY) {
+		resp.Itineraries = append(resp.Itineraries, item.GetItinerary())
+	}
+
+	return resp, nil
+}
+
+func (s *ListServer) SavePublicList(_ context.Context, in *list.SavePublicListRequest) (*list.SavePublicListResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, required("user_id")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l := s.find(in.GetListId())
+	if l == nil || !l.GetIsPublic() {
+		return nil, notFound("list", in.GetListId())
+	}
+
+	if slices.Contains(s.saved[in.GetUserId()], l.GetId()) {
+		return &list.SavePublicListResponse{Success: true, Message: "list is already saved"}, nil
+	}
+
+	s.saved[in.GetUserId()] = append(s.saved[in.GetUserId()], l.GetId())
+	l.SaveCount++
+
+	return &list.SavePublicListResponse{Success: true, Message: "list saved"}, nil
+}
+
+func (s *ListServer) UnsaveList(_ context.Context, in *list.UnsaveListRequest) (*list.UnsaveListResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := s.saved[in.GetUserId()]
+	i := slices.Index(saved, in.GetListId())
+	if i < 0 {
+		return nil, notFound("saved list", in.GetListId())
+	}
+
+	s.saved[in.GetUserId()] = slices.Delete(saved, i, i+1)
+	if l := s.find(in.GetListId()); l != nil {
+		l.SaveCount--
+	}
+
+	return &list.UnsaveListResponse{Success: true, Message: "list unsaved"}, nil
+}
+
+func (s *ListServer) GetSavedLists(_ context.Context, in *list.GetSavedListsRequest) (*list.GetSavedListsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var lists []*list.List
+	for _, id := range s.saved[in.GetUserId()] {
+		if l := s.find(id); l != nil {
+			lists = append(lists, l)
+		}
+	}
+
+	return &list.GetSavedListsResponse{
+		Lists:      s.withItems(window(lists, in.GetOffset(), in.GetLimit()), true),
+		TotalCount: int32(len(lists)),
+	}, nil
+}
+
+// SearchPublicLists matches the query against the names and descriptions
+// of the public lists. Lists carry no categories, so those are ignored.
+func (s *ListServer) SearchPublicLists(_ context.Context, in *list.SearchPublicListsRequest) (*list.SearchPublicListsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	filters := make(map[string]string)
+	var lists []*list.List
+	for _, l := range s.lists {
+		if !l.GetIsPublic() || (in.GetCityId() != "" && l.GetCityId() != in.GetCityId()) {
+			continue
+		}
+		if in.GetQuery() != "" && !contains(l.GetName(), in.GetQuery()) && !contains(l.GetDescription(), in.GetQuery()) {
+			continue
+		}
+		lists = append(lists, l)
+	}
+	if in.GetCityId() != "" {
+		filters["city_id"] = in.GetCityId()
+	}
+
+	switch in.GetSortBy() {
+	case "popularity":
+		slices.SortStableFunc(lists, func(a, b *list.List) int { return cmp.Compare(b.GetSaveCount(), a.GetSaveCount()) })
+	case "recent":
+		slices.SortStableFunc(lists, func(a, b *list.List) int { return b.GetCreatedAt().AsTime().Compare(a.GetCreatedAt().AsTime()) })
+	case "name":
+		slices.SortStableFunc(lists, func(a, b *list.List) int { return strings.Compare(a.GetName(), b.GetName()) })
+	}
+	if in.GetSortBy() != "" {
+		filters["sort_by"] = in.GetSortBy()
+	}
+
+	return &list.SearchPublicListsResponse{
+		Lists:      s.withItems(window(lists, in.GetOffset(), in.GetLimit()), true),
+		TotalCount: int32(len(lists)),
+		Metadata:   &list.SearchMetadata{SearchMethod: "text", FiltersApplied: filters},
+	}, nil
+}
+
+func (s *ListServer) find(id string) *list.List {
+	for _, l := range s.lists {
+		if l.GetId() == id {
+			return l
+		}
+	}
+
+	return nil
+}
+
+// readable returns the list userID may read: one of theirs, or a public
+// one.
+func (s *ListServer) readable(userID, listID string) (*list.List, error) {
+	l := s.find(listID)
+	if l == nil || (l.GetUserId() != userID && !l.GetIsPublic()) {
+		return nil, notFound("list", listID)
+	}
+
+	return l, nil
+}
+
+// owned returns the list userID may change.
+func (s *ListServer) owned(userID, listID string) (*list.List, error) {
+	l := s.find(listID)
+	if l == nil || l.GetUserId() != userID {
+		return nil, notFound("list", listID)
+	}
+
+	return l, nil
+}
+
+// findItem returns the index of the item of the list, or -1. An
+// unspecified content type matches any.
+func (s *ListServer) findItem(listID, itemID string, contentType list.ContentType) int {
+	return slices.IndexFunc(s.items[listID], func(item *list.ListItem) bool {
+		return item.GetItemId() == itemID &&
+			(contentType == list.ContentType_CONTENT_TYPE_UNSPECIFIED || item.GetContentType() == contentType)
+	})
+}
+
+func (s *ListServer) withItems(lists []*list.List, includeItems bool) []*list.ListWithItems {
+	out := make([]*list.ListWithItems, 0, len(lists))
+	for _, l := range lists {
+		withItems := &list.ListWithItems{List: proto.CloneOf(l)}
+		if includeItems {
+			for _, item := range s.items[l.GetId()] {
+				withItems.Items = append(withItems.Items, proto.CloneOf(item))
+			}
+		}
+		out = append(out, withItems)
+	}
+
+	return out
+}
+
+// contents returns the items of the list with their content, keeping those
+// of contentType only, unless it is unspecified.
+func (s *ListServer) contents(listID string, contentType list.ContentType) []*list.ListItemWithContent {
+	var out []*list.ListItemWithContent
+	for _, item := range s.items[listID] {
+		if contentType != list.ContentType_CONTENT_TYPE_UNSPECIFIED && item.GetContentType() != contentType {
+			continue
+		}
+
+		item = proto.CloneOf(item)
+		if s.Content != nil {
+			if content := s.Content(item); content != nil {
+				content.ListItem = item
+				out = append(out, content)
+				continue
+			}
+		}
+
+		content := &list.ListItemWithContent{ListItem: item}
+		ref := &list.POIDetailedInfo{Id: item.GetItemId()}
+		switch item.GetContentType() {
+		case list.ContentType_CONTENT_TYPE_RESTAURANT:
+			content.Restaurant = &list.RestaurantDetailedInfo{Poi: ref}
+		case list.ContentType_CONTENT_TYPE_HOTEL:
+			content.Hotel = &list.HotelDetailedInfo{Poi: ref}
+		case list.ContentType_CONTENT_TYPE_ITINERARY:
+			content.Itinerary = &list.UserSavedItinerary{Id: item.GetItemId()}
+		default:
+			content.Poi = ref
+		}
+		out = append(out, content)
+	}
+
+	return out
+}
+
+func sortItems(items []*list.ListItem) []*list.ListItem {
+	slices.SortStableFunc(items, func(a, b *list.ListItem) int {
+		return cmp.Or(cmp.Compare(a.GetDayNumber(), b.GetDayNumber()), cmp.Compare(a.GetPosition(), b.GetPosition()))
+	})
+
+	return items
+}
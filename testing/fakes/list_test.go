@@ -0,0 +1,59 @@
+package fakes
+
+import (
+	"testing"
+
+	list "github.com/FACorreiaa/loci-proto/modules/list/generated"
+)
+
+func TestListServer(t *testing.T) {
+	_, brokers := start(t)
+
+	created, err := brokers.List.CreateList(ctx, &list.CreateListRequest{UserId: "u1", Name: "Lisbon", IsPublic: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetList().GetId()
+	if id == "" || created.GetList().GetName() != "Lisbon" {
+		t.Fatalf("CreateList() = %v, want a list named Lisbon with an ID", created.GetList())
+	}
+
+	if _, err := brokers.List.AddListItem(ctx, &list.AddListItemRequest{UserId: "u1", ListId: id, ItemId: "poi-1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := brokers.List.GetList(ctx, &list.GetListRequest{UserId: "u1", ListId: id, IncludeDetailedItems: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetList().GetList().GetName() != "Lisbon" || got.GetList().GetList().GetItemCount() != 1 {
+		t.Errorf("GetList() = %v, want Lisbon with one item", got.GetList().GetList())
+	}
+	if items := got.GetList().GetItems(); len(items) != 1 || items[0].GetPoi().GetId() != "poi-1" {
+		t.Errorf("GetList() items = %v, want poi-1", items)
+	}
+
+	lists, err := brokers.List.GetLists(ctx, &list.GetListsRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lists.GetTotalCount() != 1 {
+		t.Errorf("GetLists() found %d lists, want 1", lists.GetTotalCount())
+	}
+
+	// Others can read the list once it is public, but not change it.
+	if _, err := brokers.List.GetList(ctx, &list.GetListRequest{UserId: "u2", ListId: id}); err != nil {
+		t.Errorf("GetList() of a public list by another user: %v", err)
+	}
+	_, err = brokers.List.DeleteList(ctx, &list.DeleteListRequest{UserId: "u2", ListId: id})
+	wantNotFound(t, err)
+
+	if _, err := brokers.List.DeleteList(ctx, &list.DeleteListRequest{UserId: "u1", ListId: id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = brokers.List.GetList(ctx, &list.GetListRequest{UserId: "u1", ListId: id})
+	wantNotFound(t, err)
+
+	_, err = brokers.List.CreateList(ctx, &list.CreateListRequest{UserId: "u1"})
+	wantValidation(t, err, "name")
+}
@@ -0,0 +1,664 @@
+package fakes
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/FACorreiaa/loci-proto/geo"
+	helpers "github.com/FACorreiaa/loci-proto/modules/common"
+	poi "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+)
+
+// POIServer is an in-memory POIService. The POIs it serves are seeded with
+// AddPOIs, AddRestaurants and AddHotels. Semantic and hybrid searches are
+// approximated by the share of query words a POI mentions.
+type POIServer struct {
+	poi.UnimplementedPOIServiceServer
+
+	mu          sync.Mutex
+	pois        []*poi.POIDetailedInfo
+	restaurants []*poi.RestaurantDetailedInfo
+	hotels      []*poi.HotelDetailedInfo
+	favorites   map[string][]favorite
+	itineraries []*poi.UserItinerary
+}
+
+type favorite struct {
+	id, poiID string
+}
+
+var _ poi.POIServiceServer = (*POIServer)(nil)
+
+// NewPOIServer creates a POIService serving no POIs.
+func NewPOIServer() *POIServer {
+	return &POIServer{favorites: make(map[string][]favorite)}
+}
+
+// AddPOIs adds pois, replacing those with the same ID. POIs without an ID
+// are given one.
+func (s *POIServer) AddPOIs(pois ...*poi.POIDetailedInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range pois {
+		s.addPOI(p)
+	}
+}
+
+// AddRestaurants adds restaurants, and their POIs.
+func (s *POIServer) AddRestaurants(restaurants ...*poi.RestaurantDetailedInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range restaurants {
+		r = proto.CloneOf(r)
+		r.Poi = s.addPOI(r.GetPoi())
+		s.restaurants = slices.DeleteFunc(s.restaurants, func(x *poi.RestaurantDetailedInfo) bool {
+			return x.GetPoi().GetId() == r.GetPoi().GetId()
+		})
+		s.restaurants = append(s.restaurants, r)
+	}
+}
+
+// AddHotels adds hotels, and their POIs.
+func (s *POIServer) AddHotels(hotels ...*poi.HotelDetailedInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, h := range hotels {
+		h = proto.CloneOf(h)
+		h.Poi = s.addPOI(h.GetPoi())
+		s.hotels = slices.DeleteFunc(s.hotels, func(x *poi.HotelDetailedInfo) bool {
+			return x.GetPoi().GetId() == h.GetPoi().GetId()
+		})
+		s.hotels = append(s.hotels, h)
+	}
+}
+
+// AddItineraries adds itineraries, replacing those with the same ID.
+func (s *POIServer) AddItineraries(itineraries ...*poi.UserItinerary) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, it := range itineraries {
+		it = proto.CloneOf(it)
+		if it.Id == "" {
+			it.Id = newID()
+		}
+		if it.CreatedAt == nil {
+			it.CreatedAt = timestamppb.Now()
+		}
+		s.itineraries = slices.DeleteFunc(s.itineraries, func(x *poi.UserItinerary) bool { return x.GetId() == it.GetId() })
+		s.itineraries = append(s.itineraries, it)
+	}
+}
+
+func (s *POIServer) addPOI(p *poi.POIDetailedInfo) *poi.POIDetailedInfo {
+	p = proto.CloneOf(p)
+	if p == nil {
+		p = new(poi.POIDetailedInfo)
+	}
+	if p.Id == "" {
+		p.Id = newID()
+	}
+	if p.CreatedAt == nil {
+		p.CreatedAt = timestamppb.Now()
+	}
+
+	s.pois = slices.DeleteFunc(s.pois, func(x *poi.POIDetailedInfo) bool { return x.GetId() == p.GetId() })
+	s.pois = append(s.pois, p)
+
+	return p
+}
+
+func (s *POIServer) find(id string) *poi.POIDetailedInfo {
+	for _, p := range s.pois {
+		if p.GetId() == id {
+			return p
+		}
+	}
+
+	return nil
+}
+
+func (s *POIServer) GetPOIsByCity(_ context.Context, in *poi.GetPOIsByCityRequest) (*poi.GetPOIsByCityResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matches []*poi.POIDetailedInfo
+	for _, p := range s.pois {
+		if p.GetCityId() == in.GetCityId() {
+			matches = append(matches, proto.CloneOf(p))
+		}
+	}
+
+	return &poi.GetPOIsByCityResponse{
+		Pois:       window(matches, in.GetOffset(), in.GetLimit()),
+		TotalCount: int32(len(matches)),
+	}, nil
+}
+
+func (s *POIServer) SearchPOIs(_ context.Context, in *poi.SearchPOIsRequest) (*poi.SearchPOIsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := in.GetFilter()
+	hits := s.search(f)
+	pois := make([]*poi.POIDetailedInfo, 0, len(hits))
+	for _, h := range hits {
+		pois = append(pois, h.poi)
+	}
+
+	return &poi.SearchPOIsResponse{
+		Pois:       window(pois, f.GetOffset(), f.GetLimit()),
+		TotalCount: int32(len(pois)),
+		Metadata:   &poi.SearchMetadata{SearchMethod: "text"},
+	}, nil
+}
+
+func (s *POIServer) SearchPOIsSemantic(_ context.Context, in *poi.SearchPOIsSemanticRequest) (*poi.SearchPOIsSemanticResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.semantic(in.GetQuery(), in.GetSemanticThreshold(), in.GetLimit(), func(p *poi.POIDetailedInfo) (*poi.POIDetailedInfo, bool) {
+		return withDistance(p, in.GetLocation(), 0)
+	}), nil
+}
+
+func (s *POIServer) SearchPOIsSemanticByCity(_ context.Context, in *poi.SearchPOIsSemanticByCityRequest) (*poi.SearchPOIsSemanticResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.semantic(in.GetQuery(), in.GetSemanticThreshold(), in.GetLimit(), func(p *poi.POIDetailedInfo) (*poi.POIDetailedInfo, bool) {
+		return proto.CloneOf(p), in.GetCityId() == "" || p.GetCityId() == in.GetCityId()
+	}), nil
+}
+
+func (s *POIServer) semantic(query string, threshold float64, limit int32, keep func(*poi.POIDetailedInfo) (*poi.POIDetailedInfo, bool)) *poi.SearchPOIsSemanticResponse {
+	var matches []*poi.POISemanticMatch
+	for _, p := range s.pois {
+		score, matched, terms := textScore(query, p)
+		if score == 0 || score < threshold {
+			continue
+		}
+
+		if p, ok := keep(p); ok {
+			matches = append(matches, &poi.POISemanticMatch{
+				Poi:             p,
+				SimilarityScore: score,
+				MatchReason:     fmt.Sprintf("matched %d of %d query terms", matched, terms),
+			})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b *poi.POISemanticMatch) int {
+		return cmp.Compare(b.GetSimilarityScore(), a.GetSimilarityScore())
+	})
+
+	return &poi.SearchPOIsSemanticResponse{
+		Pois:       window(matches, 0, limit),
+		TotalCount: int32(len(matches)),
+		Metadata:   &poi.SearchMetadata{SearchMethod: "semantic"},
+	}
+}
+
+func (s *POIServer) SearchPOIsHybrid(_ context.Context, in *poi.SearchPOIsHybridRequest) (*poi.SearchPOIsHybridResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := in.GetFilter()
+	weight := in.GetSemanticWeight()
+
+	var matches []*poi.POIHybridMatch
+	for _, h := range s.search(f) {
+		semantic := 1.0
+		if in.GetSemanticQuery() != "" {
+			if semantic, _, _ = textScore(in.GetSemanticQuery(), h.poi); semantic == 0 {
+				continue
+			}
+		}
+
+		spatial := 1.0
+		if f.GetLocation() != nil && f.GetRadiusMeters() > 0 {
+			spatial = max(0, 1-h.distance/f.GetRadiusMeters())
+		}
+
+		matches = append(matches, &poi.POIHybridMatch{
+			Poi:           h.poi,
+			SpatialScore:  spatial,
+			SemanticScore: semantic,
+			CombinedScore: weight*semantic + (1-weight)*spatial,
+		})
+	}
+
+	slices.SortStableFunc(matches, func(a, b *poi.POIHybridMatch) int {
+		return cmp.Compare(b.GetCombinedScore(), a.GetCombinedScore())
+	})
+
+	return &poi.SearchPOIsHybridResponse{
+		Pois:       window(matches, f.GetOffset(), f.GetLimit()),
+		TotalCount: int32(len(matches)),
+		Metadata:   &poi.SearchMetadata{SearchMethod: "hybrid"},
+	}, nil
+}
+
+func (s *POIServer) GetNearbyRecommendations(_ context.Context, in *poi.GetNearbyRecommendationsRequest) (*poi.GetNearbyRecommendationsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var recommendations []*poi.POIRecommendation
+	for _, p := range s.pois {
+		if !anyEqual(p.GetCategory(), in.GetPreferredCategories()) {
+			continue
+		}
+
+		p, ok := withDistance(p, in.GetLocation(), in.GetRadiusMeters())
+		if !ok {
+			continue
+		}
+
+		var tagNames []string
+		for _, t := range p.GetTags() {
+			tagNames = append(tagNames, t.GetName())
+		}
+
+		recommendations = append(recommendations, &poi.POIRecommendation{
+			Poi:                  p,
+			RecommendationScore:  p.GetRating() / 5,
+			RecommendationReason: fmt.Sprintf("rated %.1f by %d visitors", p.GetRating(), p.GetReviewCount()),
+			Tags:                 tagNames,
+		})
+	}
+
+	slices.SortStableFunc(recommendations, func(a, b *poi.POIRecommendation) int {
+		return cmp.Compare(b.GetRecommendationScore(), a.GetRecommendationScore())
+	})
+
+	filters := []string{"radius"}
+	if len(in.GetPreferredCategories()) > 0 {
+		filters = append(filters, "preferred_categories")
+	}
+
+	return &poi.GetNearbyRecommendationsResponse{
+		Recommendations: window(recommendations, 0, in.GetLimit()),
+		Metadata: &poi.RecommendationMetadata{
+			PersonalizationLevel: "none",
+			AppliedFilters:       filters,
+		},
+	}, nil
+}
+
+func (s *POIServer) DiscoverRestaurants(_ context.Context, in *poi.DiscoverRestaurantsRequest) (*poi.DiscoverRestaurantsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matches []*poi.RestaurantDetailedInfo
+	for _, r := range s.restaurants {
+		if !anyEqual(r.GetCuisineType(), in.GetCuisineTypes()) ||
+			!anyEqual(r.GetPoi().GetPriceRange(), in.GetPriceRanges()) ||
+			r.GetPoi().GetRating() < in.GetMinRating() {
+			continue
+		}
+
+		p, ok := withDistance(r.GetPoi(), in.GetLocation(), in.GetRadiusMeters())
+		if !ok {
+			continue
+		}
+
+		r = proto.CloneOf(r)
+		r.Poi = p
+		matches = append(matches, r)
+	}
+
+	return &poi.DiscoverRestaurantsResponse{
+		Restaurants: window(matches, 0, in.GetLimit()),
+		TotalCount:  int32(len(matches)),
+	}, nil
+}
+
+func (s *POIServer) DiscoverActivities(_ context.Context, in *poi.DiscoverActivitiesRequest) (*poi.DiscoverActivitiesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matches := s.discover(in.GetLocation(), in.GetRadiusMeters(), in.GetActivityTypes(), "activity")
+
+	return &poi.DiscoverActivitiesResponse{
+		Activities: window(matches, 0, in.GetLimit()),
+		TotalCount: int32(len(matches)),
+	}, nil
+}
+
+func (s *POIServer) DiscoverHotels(_ context.Context, in *poi.DiscoverHotelsRequest) (*poi.DiscoverHotelsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matches []*poi.HotelDetailedInfo
+	for _, h := range s.hotels {
+		if !anyEqual(h.GetPropertyType(), in.GetPropertyTypes()) ||
+			!anyEqual(h.GetPoi().GetPriceRange(), in.GetPriceRanges()) ||
+			(len(in.GetStarRatings()) > 0 && !slices.Contains(in.GetStarRatings(), h.GetStarRating())) {
+			continue
+		}
+
+		p, ok := withDistance(h.GetPoi(), in.GetLocation(), in.GetRadiusMeters())
+		if !ok {
+			continue
+		}
+
+		h = proto.CloneOf(h)
+		h.Poi = p
+		matches = append(matches, h)
+	}
+
+	return &poi.DiscoverHotelsResponse{
+		Hotels:     window(matches, 0, in.GetLimit()),
+		TotalCount: int32(len(matches)),
+	}, nil
+}
+
+func (s *POIServer) DiscoverAttractions(_ context.Context, in *poi.DiscoverAttractionsRequest) (*poi.DiscoverAttractionsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matches := s.discover(in.GetLocation(), in.GetRadiusMeters(), in.GetAttractionTypes(), "attraction")
+
+	return &poi.DiscoverAttractionsResponse{
+		Attractions: window(matches, 0, in.GetLimit()),
+		TotalCount:  int32(len(matches)),
+	}, nil
+}
+
+// discover returns the POIs around location whose category or subcategory
+// is one of types, or is category when no types are given.
+func (s *POIServer) discover(location *poi.GeoPoint, radius float64, types []string, category string) []*poi.POIDetailedInfo {
+	if len(types) == 0 {
+		types = []string{category}
+	}
+
+	var matches []*poi.POIDetailedInfo
+	for _, p := range s.pois {
+		if !anyEqual(p.GetCategory(), types) && !anyEqual(p.GetSubcategory(), types) {
+			continue
+		}
+
+		if p, ok := withDistance(p, location, radius); ok {
+			matches = append(matches, p)
+		}
+	}
+
+	return matches
+}
+
+func (s *POIServer) AddToFavorites(_ context.Context, in *poi.AddToFavoritesRequest) (*poi.AddToFavoritesResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, required("user_id")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	poiID := in.GetPoiId()
+	if in.GetIsLlmPoi() && in.GetPoiData() != nil {
+		data := proto.CloneOf(in.GetPoiData())
+		if data.Id == "" {
+			data.Id = poiID
+		}
+		poiID = s.addPOI(data).GetId()
+	}
+
+	if s.find(poiID) == nil {
+		return nil, notFound("POI", poiID)
+	}
+
+	for _, f := range s.favorites[in.GetUserId()] {
+		if f.poiID == poiID {
+			return &poi.AddToFavoritesResponse{Success: true, Message: "POI is already a favorite", PoiId: poiID, FavoriteId: f.id}, nil
+		}
+	}
+
+	f := favorite{id: newID(), poiID: poiID}
+	s.favorites[in.GetUserId()] = append(s.favorites[in.GetUserId()], f)
+
+	return &poi.AddToFavoritesResponse{Success: true, Message: "POI added to favorites", PoiId: poiID, FavoriteId: f.id}, nil
+}
+
+func (s *POIServer) RemoveFromFavorites(_ context.Context, in *poi.RemoveFromFavoritesRequest) (*poi.RemoveFromFavoritesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	poiID := in.GetPoiId()
+	if poiID == "" {
+		poiID = in.GetPoiData().GetId()
+	}
+
+	favorites := s.favorites[in.GetUserId()]
+	i := slices.IndexFunc(favorites, func(f favorite) bool { return f.poiID == poiID })
+	if i < 0 {
+		return nil, notFound("favorite", poiID)
+	}
+	s.favorites[in.GetUserId()] = slices.Delete(favorites, i, i+1)
+
+	return &poi.RemoveFromFavoritesResponse{Success: true, Message: "POI removed from favorites", PoiId: poiID}, nil
+}
+
+func (s *POIServer) GetFavorites(_ context.Context, in *poi.GetFavoritesRequest) (*poi.GetFavoritesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var favorites []*poi.POIDetailedInfo
+	for _, f := range s.favorites[in.GetUserId()] {
+		if p := s.find(f.poiID); p != nil {
+			favorites = append(favorites, proto.CloneOf(p))
+		}
+	}
+
+	offset, limit := pageBounds(in.GetPage(), in.GetPageSize(), in.GetOffset(), in.GetLimit())
+	page, pages := pageCount(int32(len(favorites)), offset, limit)
+
+	return &poi.GetFavoritesResponse{
+		Favorites:   window(favorites, offset, limit),
+		TotalCount:  int32(len(favorites)),
+		CurrentPage: page,
+		TotalPages:  pages,
+		Limit:       limit,
+		Offset:      offset,
+	}, nil
+}
+
+func (s *POIServer) GetItineraries(_ context.Context, in *poi.GetItinerariesRequest) (*poi.GetItinerariesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var itineraries []*poi.UserItinerary
+	for _, it := range s.itineraries {
+		if it.GetUserId() == in.GetUserId() {
+			itineraries = append(itineraries, proto.CloneOf(it))
+		}
+	}
+
+	offset, limit := pageBounds(in.GetPage(), in.GetPageSize(), in.GetOffset(), in.GetLimit())
+	page, _ := pageCount(int32(len(itineraries)), offset, limit)
+
+	return &poi.GetItinerariesResponse{
+		Itineraries: window(itineraries, offset, limit),
+		TotalCount:  int32(len(itineraries)),
+		Page:        page,
+		PageSize:    limit,
+	}, nil
+}
+
+func (s *POIServer) GetItinerary(_ context.Context, in *poi.GetItineraryRequest) (*poi.GetItineraryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	it := s.findItinerary(in.GetItineraryId())
+	if it == nil || (it.GetUserId() != in.GetUserId() && !it.GetIsPublic()) {
+		return nil, notFound("itinerary", in.GetItineraryId())
+	}
+
+	return &poi.GetItineraryResponse{Itinerary: proto.CloneOf(it)}, nil
+}
+
+func (s *POIServer) UpdateItinerary(_ context.Context, in *poi.UpdateItineraryRequest) (*poi.UpdateItineraryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	it := s.findItinerary(in.GetItineraryId())
+	if it == nil || it.GetUserId() != in.GetUserId() {
+		return nil, notFound("itinerary", in.GetItineraryId())
+	}
+
+	if in.GetTitle() != "" {
+		it.Title = in.GetTitle()
+	}
+	if in.GetDescription() != "" {
+		it.Description = in.GetDescription()
+	}
+	if in.GetMarkdownContent() != "" {
+		it.MarkdownContent = in.GetMarkdownContent()
+	}
+	it.UpdatedAt = timestamppb.Now()
+
+	return &poi.UpdateItineraryResponse{Success: true, Message: "itinerary updated", Itinerary: proto.CloneOf(it)}, nil
+}
+
+func (s *POIServer) findItinerary(id string) *poi.UserItinerary {
+	for _, it := range s.itineraries {
+		if it.GetId() == id {
+			return it
+		}
+	}
+
+	return nil
+}
+
+func (s *POIServer) GenerateEmbeddings(_ context.Context, in *poi.GenerateEmbeddingsRequest) (*poi.GenerateEmbeddingsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	processed := int32(len(s.pois))
+	updated := int32(0)
+	if in.GetForceRegenerate() {
+		updated = processed
+	}
+
+	return &poi.GenerateEmbeddingsResponse{
+		ProcessedCount: processed,
+		UpdatedCount:   updated,
+		Status:         "completed",
+		Message:        fmt.Sprintf("processed %d POIs", processed),
+		Success:        true,
+	}, nil
+}
+
+// hit is a POI matching a search, and its distance from the search point.
+type hit struct {
+	poi      *poi.POIDetailedInfo
+	distance float64
+}
+
+// search returns clones of the POIs matching f, sorted as f asks.
+func (s *POIServer) search(f *poi.POIFilter) []hit {
+	var hits []hit
+	for _, p := range s.pois {
+		if (f.GetCityId() != "" && p.GetCityId() != f.GetCityId()) ||
+			!anyEqual(p.GetCategory(), f.GetCategories()) ||
+			!anyEqual(p.GetPriceRange(), f.GetPriceRanges()) ||
+			p.GetRating() < f.GetMinRating() {
+			continue
+		}
+
+		if f.GetQuery() != "" {
+			if score, _, _ := textScore(f.GetQuery(), p); score == 0 {
+				continue
+			}
+		}
+
+		p, ok := withDistance(p, f.GetLocation(), f.GetRadiusMeters())
+		if !ok {
+			continue
+		}
+		hits = append(hits, hit{poi: p, distance: p.GetDistanceMeters()})
+	}
+
+	var compare func(a, b hit) int
+	desc := f.GetSortOrder() == "desc"
+	switch f.GetSortBy() {
+	case "rating":
+		desc = f.GetSortOrder() != "asc"
+		compare = func(a, b hit) int { return cmp.Compare(a.poi.GetRating(), b.poi.GetRating()) }
+	case "distance":
+		compare = func(a, b hit) int { return cmp.Compare(a.distance, b.distance) }
+	case "popularity":
+		desc = f.GetSortOrder() != "asc"
+		compare = func(a, b hit) int { return cmp.Compare(a.poi.GetReviewCount(), b.poi.GetReviewCount()) }
+	case "price":
+		compare = func(a, b hit) int {
+			return cmp.Compare(helpers.PriceRangeFromLabel(a.poi.GetPriceRange()), helpers.PriceRangeFromLabel(b.poi.GetPriceRange()))
+		}
+	}
+
+	if compare != nil {
+		slices.SortStableFunc(hits, func(a, b hit) int {
+			if desc {
+				return compare(b, a)
+			}
+
+			return compare(a, b)
+		})
+	}
+
+	return hits
+}
+
+// withDistance returns a clone of p with its distance from location set,
+// and reports whether p lies within radius of it. Without a location, or
+// with a zero radius, every POI is kept.
+func withDistance(p *poi.POIDetailedInfo, location *poi.GeoPoint, radius float64) (*poi.POIDetailedInfo, bool) {
+	p = proto.CloneOf(p)
+	if location == nil {
+		return p, true
+	}
+
+	d := geo.Haversine(geo.PointOf(location), geo.PointOf(p))
+	if radius > 0 && d > radius {
+		return nil, false
+	}
+
+	p.DistanceMeters = d
+	p.Distance = geo.FormatDistance(d)
+
+	return p, true
+}
+
+// textScore returns the share of the words of query that p mentions in its
+// name, description, categories or tags, along with the counts it is
+// worked out from.
+func textScore(query string, p *poi.POIDetailedInfo) (float64, int, int) {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return 0, 0, 0
+	}
+
+	fields := []string{p.GetName(), p.GetDescription(), p.GetCategory(), p.GetSubcategory()}
+	for _, t := range p.GetTags() {
+		fields = append(fields, t.GetName())
+	}
+	text := strings.ToLower(strings.Join(fields, " "))
+
+	matched := 0
+	for _, term := range terms {
+		if strings.Contains(text, term) {
+			matched++
+		}
+	}
+
+	return float64(matched) / float64(len(terms)), matched, len(terms)
+}
@@ -0,0 +1,65 @@
+package fakes
+
+import (
+	"testing"
+
+	poibroker "github.com/FACorreiaa/loci-proto/modules/poi"
+	poi "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+	"github.com/FACorreiaa/loci-proto/pagination"
+)
+
+func TestPOIServer(t *testing.T) {
+	srv, brokers := start(t)
+	srv.POI.AddPOIs(
+		&poi.POIDetailedInfo{Id: "belem", Name: "Torre de Belém", CityId: "lisbon"},
+		&poi.POIDetailedInfo{Id: "ribeira", Name: "Ribeira", CityId: "porto"},
+	)
+
+	byCity, err := brokers.POI.GetPOIsByCity(ctx, &poi.GetPOIsByCityRequest{CityId: "lisbon"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pois := byCity.GetPois(); len(pois) != 1 || pois[0].GetId() != "belem" {
+		t.Errorf("GetPOIsByCity(lisbon) = %v, want belem", pois)
+	}
+
+	for _, id := range []string{"belem", "ribeira"} {
+		if _, err := brokers.POI.AddToFavorites(ctx, &poi.AddToFavoritesRequest{UserId: "u1", PoiId: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = brokers.POI.AddToFavorites(ctx, &poi.AddToFavoritesRequest{UserId: "u1", PoiId: "missing"})
+	wantNotFound(t, err)
+
+	favorites, err := brokers.POI.GetFavorites(ctx, &poi.GetFavoritesRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if favorites.GetTotalCount() != 2 {
+		t.Errorf("GetFavorites() found %d favorites, want 2", favorites.GetTotalCount())
+	}
+
+	// Walking the favorites a page at a time sees each of them once.
+	b, err := poibroker.NewBroker(Address, srv.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	var ids []string
+	for p, err := range b.AllFavorites(ctx, &poi.GetFavoritesRequest{UserId: "u1"}, pagination.WithPageSize(1)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, p.GetId())
+	}
+	if len(ids) != 2 || ids[0] == ids[1] {
+		t.Errorf("AllFavorites() = %v, want both favorites once", ids)
+	}
+
+	if _, err := brokers.POI.RemoveFromFavorites(ctx, &poi.RemoveFromFavoritesRequest{UserId: "u1", PoiId: "belem"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = brokers.POI.RemoveFromFavorites(ctx, &poi.RemoveFromFavoritesRequest{UserId: "u1", PoiId: "belem"})
+	wantNotFound(t, err)
+}
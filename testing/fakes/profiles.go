@@ -0,0 +1,287 @@
+package fakes
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+	helpers "github.com/FACorreiaa/loci-proto/modules/common"
+	profiles "github.com/FACorreiaa/loci-proto/modules/profiles/generated"
+)
+
+// ProfilesServer is an in-memory ProfilesService. Every user has exactly
+// one default profile as soon as they have any: the first profile created
+// becomes the default, and deleting the default promotes the oldest
+// remaining one. The tag and interest IDs of a profile are resolved against
+// the TagsServer and InterestsServer it was created with.
+type ProfilesServer struct {
+	profiles.UnimplementedProfilesServiceServer
+
+	tags      *TagsServer
+	interests *InterestsServer
+
+	mu       sync.Mutex
+	profiles []*profiles.UserPreferenceProfile
+}
+
+var _ profiles.ProfilesServiceServer = (*ProfilesServer)(nil)
+
+// NewProfilesServer creates a ProfilesService holding no profiles. tags and
+// interests may be nil, in which case references are named after their ID.
+func NewProfilesServer(tags *TagsServer, interests *InterestsServer) *ProfilesServer {
+	return &ProfilesServer{tags: tags, interests: interests}
+}
+
+func (s *ProfilesServer) GetSearchProfiles(_ context.Context, in *profiles.GetSearchProfilesRequest) (*profiles.GetSearchProfilesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := new(profiles.GetSearchProfilesResponse)
+	for _, p := range s.profiles {
+		if p.GetUserId() != in.GetUserId() {
+			continue
+		}
+		resp.Profiles = append(resp.Profiles, proto.CloneOf(p))
+		if p.GetIsDefault() {
+			resp.DefaultProfileId = p.GetId()
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *ProfilesServer) GetSearchProfile(_ context.Context, in *profiles.GetSearchProfileRequest) (*profiles.GetSearchProfileResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.find(in.GetUserId(), in.GetProfileId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &profiles.GetSearchProfileResponse{Profile: proto.CloneOf(p)}, nil
+}
+
+func (s *ProfilesServer) GetDefaultSearchProfile(_ context.Context, in *profiles.GetDefaultSearchProfileRequest) (*profiles.GetDefaultSearchProfileResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.profiles {
+		if p.GetUserId() == in.GetUserId() && p.GetIsDefault() {
+			return &profiles.GetDefaultSearchProfileResponse{Profile: proto.CloneOf(p)}, nil
+		}
+	}
+
+	return nil, rpcerrors.NotFound(helpers.NewErrorDetails("PROFILE_NOT_FOUND", "user has no default search profile"))
+}
+
+func (s *ProfilesServer) CreateSearchProfile(_ context.Context, in *profiles.CreateSearchProfileRequest) (*profiles.CreateSearchProfileResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, required("user_id")
+	}
+	if in.GetProfile().GetProfileName() == "" {
+		return nil, required("profile.profile_name")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := timestamppb.Now()
+	p := &profiles.UserPreferenceProfile{
+		Id:        newID(),
+		UserId:    in.GetUserId(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	params := in.GetProfile()
+	copyFields(p.ProtoReflect(), params.ProtoReflect(), nil)
+	p.Tags, p.Interests = s.tagReferences(in.GetUserId(), params.GetTags()), s.interestReferences(params.GetInterests())
+
+	first := !slices.ContainsFunc(s.profiles, func(o *profiles.UserPreferenceProfile) bool {
+		return o.GetUserId() == in.GetUserId()
+	})
+	s.profiles = append(s.profiles, p)
+	if first || p.GetIsDefault() {
+		s.setDefault(p)
+	}
+
+	return &profiles.CreateSearchProfileResponse{Success: true, Message: "profile created", Profile: proto.CloneOf(p)}, nil
+}
+
+// UpdateSearchProfile applies the fields named in update_fields, or every
+// populated field when none are named. is_default can only be set; clearing
+// it is done by making another profile the default.
+func (s *ProfilesServer) UpdateSearchProfile(_ context.Context, in *profiles.UpdateSearchProfileRequest) (*profiles.UpdateSearchProfileResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.find(in.GetUserId(), in.GetProfileId())
+	if err != nil {
+		return nil, err
+	}
+
+	params := in.GetProfile()
+	fields := params.ProtoReflect().Descriptor().Fields()
+	for _, name := range in.GetUpdateFields() {
+		if fields.ByName(protoreflect.Name(name)) == nil {
+			message := "unknown profile field " + name
+			return nil, rpcerrors.Validation(message, helpers.NewFieldError("update_fields", message, rpcerrors.InvalidValue))
+		}
+	}
+
+	isDefault := p.GetIsDefault()
+	copyFields(p.ProtoReflect(), params.ProtoReflect(), in.GetUpdateFields())
+	if updates(in.GetUpdateFields(), "tags", len(params.GetTags()) > 0) {
+		p.Tags = s.tagReferences(in.GetUserId(), params.GetTags())
+	}
+	if updates(in.GetUpdateFields(), "interests", len(params.GetInterests()) > 0) {
+		p.Interests = s.interestReferences(params.GetInterests())
+	}
+	p.IsDefault = isDefault
+	if params.GetIsDefault() {
+		s.setDefault(p)
+	}
+	p.UpdatedAt = timestamppb.Now()
+
+	return &profiles.UpdateSearchProfileResponse{Success: true, Message: "profile updated", Profile: proto.CloneOf(p)}, nil
+}
+
+func (s *ProfilesServer) DeleteSearchProfile(_ context.Context, in *profiles.DeleteSearchProfileRequest) (*profiles.DeleteSearchProfileResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.find(in.GetUserId(), in.GetProfileId())
+	if err != nil {
+		return nil, err
+	}
+	s.profiles = slices.DeleteFunc(s.profiles, func(o *profiles.UserPreferenceProfile) bool { return o == p })
+
+	if p.GetIsDefault() {
+		for _, o := range s.profiles {
+			if o.GetUserId() == in.GetUserId() {
+				s.setDefault(o)
+				break
+			}
+		}
+	}
+
+	return &profiles.DeleteSearchProfileResponse{Success: true, Message: "profile deleted"}, nil
+}
+
+func (s *ProfilesServer) SetDefaultSearchProfile(_ context.Context, in *profiles.SetDefaultSearchProfileRequest) (*profiles.SetDefaultSearchProfileResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.find(in.GetUserId(), in.GetProfileId())
+	if err != nil {
+		return nil, err
+	}
+	s.setDefault(p)
+
+	return &profiles.SetDefaultSearchProfileResponse{Success: true, Message: "default profile set"}, nil
+}
+
+func (s *ProfilesServer) find(userID, id string) (*profiles.UserPreferenceProfile, error) {
+	for _, p := range s.profiles {
+		if p.GetId() == id && p.GetUserId() == userID {
+			return p, nil
+		}
+	}
+
+	return nil, notFound("profile", id)
+}
+
+// setDefault makes p the only default profile of its user.
+func (s *ProfilesServer) setDefault(p *profiles.UserPreferenceProfile) {
+	for _, o := range s.profiles {
+		if o.GetUserId() == p.GetUserId() {
+			o.IsDefault = o == p
+		}
+	}
+}
+
+func (s *ProfilesServer) tagReferences(userID string, ids []string) []*profiles.TagReference {
+	out := make([]*profiles.TagReference, 0, len(ids))
+	for _, id := range ids {
+		ref := &profiles.TagReference{Id: id, Name: id}
+		if s.tags != nil {
+			s.tags.mu.Lock()
+			if t, err := s.tags.find(userID, id); err == nil {
+				ref.Name, ref.TagType = t.GetName(), t.GetTagType()
+			}
+			s.tags.mu.Unlock()
+		}
+		out = append(out, ref)
+	}
+
+	return out
+}
+
+func (s *ProfilesServer) interestReferences(ids []string) []*profiles.InterestReference {
+	out := make([]*profiles.InterestReference, 0, len(ids))
+	for _, id := range ids {
+		ref := &profiles.InterestReference{Id: id, Name: id}
+		if s.interests != nil {
+			s.interests.mu.Lock()
+			if i, err := s.interests.find(id); err == nil {
+				ref.Name = i.GetName()
+			}
+			s.interests.mu.Unlock()
+		}
+		out = append(out, ref)
+	}
+
+	return out
+}
+
+// copyFields copies the fields of src named in names onto the fields of dst
+// with the same name and kind. With no names, every populated field of src
+// is copied.
+func copyFields(dst, src protoreflect.Message, names []string) {
+	targets := dst.Descriptor().Fields()
+	fields := src.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if !updates(names, string(fd.Name()), src.Has(fd)) {
+			continue
+		}
+
+		target := targets.ByName(fd.Name())
+		if target == nil || target.Kind() != fd.Kind() || target.Cardinality() != fd.Cardinality() ||
+			(fd.Message() != nil && target.Message().FullName() != fd.Message().FullName()) {
+			continue
+		}
+
+		if !src.Has(fd) {
+			dst.Clear(target)
+			continue
+		}
+		switch {
+		case fd.IsList():
+			list := dst.Mutable(target).List()
+			list.Truncate(0)
+			for j := range src.Get(fd).List().Len() {
+				list.Append(src.Get(fd).List().Get(j))
+			}
+		case fd.Message() != nil:
+			dst.Set(target, protoreflect.ValueOfMessage(proto.Clone(src.Get(fd).Message().Interface()).ProtoReflect()))
+		default:
+			dst.Set(target, src.Get(fd))
+		}
+	}
+}
+
+// updates reports whether a field is updated: when names are given, if it
+// is among them, and otherwise if it is populated.
+func updates(names []string, name string, populated bool) bool {
+	if len(names) == 0 {
+		return populated
+	}
+
+	return slices.Contains(names, name)
+}
@@ -0,0 +1,53 @@
+package fakes
+
+import (
+	"testing"
+
+	profiles "github.com/FACorreiaa/loci-proto/modules/profiles/generated"
+	tags "github.com/FACorreiaa/loci-proto/modules/tags/generated"
+)
+
+func TestProfilesServer(t *testing.T) {
+	_, brokers := start(t)
+
+	_, err := brokers.Profiles.GetDefaultSearchProfile(ctx, &profiles.GetDefaultSearchProfileRequest{UserId: "u1"})
+	wantNotFound(t, err)
+
+	tag, err := brokers.Tags.CreateTag(ctx, &tags.CreateTagRequest{UserId: "u1", Tag: &tags.CreatePersonalTagParams{Name: "Rooftops"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := brokers.Profiles.CreateSearchProfile(ctx, &profiles.CreateSearchProfileRequest{
+		UserId:  "u1",
+		Profile: &profiles.CreateUserPreferenceProfileParams{ProfileName: "Weekend", SearchRadiusKm: 5, Tags: []string{tag.GetTag().GetId()}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetProfile().GetId()
+
+	got, err := brokers.Profiles.GetSearchProfile(ctx, &profiles.GetSearchProfileRequest{UserId: "u1", ProfileId: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := got.GetProfile(); p.GetProfileName() != "Weekend" || p.GetSearchRadiusKm() != 5 {
+		t.Errorf("GetSearchProfile() = %v, want Weekend with a 5 km radius", p)
+	}
+	// Tags are resolved through the TagsServer the fakes share.
+	if refs := got.GetProfile().GetTags(); len(refs) != 1 || refs[0].GetName() != "Rooftops" {
+		t.Errorf("GetSearchProfile() tags = %v, want Rooftops", refs)
+	}
+
+	// The first profile of a user becomes the default.
+	def, err := brokers.Profiles.GetDefaultSearchProfile(ctx, &profiles.GetDefaultSearchProfileRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.GetProfile().GetId() != id {
+		t.Errorf("GetDefaultSearchProfile() = %s, want %s", def.GetProfile().GetId(), id)
+	}
+
+	_, err = brokers.Profiles.CreateSearchProfile(ctx, &profiles.CreateSearchProfileRequest{UserId: "u1", Profile: new(profiles.CreateUserPreferenceProfileParams)})
+	wantValidation(t, err, "profile.profile_name")
+}
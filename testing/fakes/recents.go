@@ -0,0 +1,452 @@
+package fakes
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	recents "github.com/FACorreiaa/loci-proto/modules/recents/generated"
+)
+
+// defaultRecentLimit is the number of interactions GetRecentInteractions
+// returns when the request sets no limit.
+const defaultRecentLimit = 20
+
+// defaultFrequentLimit is the number of places GetFrequentPlaces returns
+// when the request sets no limit.
+const defaultFrequentLimit = 10
+
+// RecentsServer is an in-memory RecentsService. The city and country of a
+// recorded interaction are taken from the location of its context, and its
+// category from its "category" metadata.
+type RecentsServer struct {
+	recents.UnimplementedRecentsServiceServer
+
+	mu           sync.Mutex
+	interactions []*recents.RecentInteraction
+}
+
+var _ recents.RecentsServiceServer = (*RecentsServer)(nil)
+
+// NewRecentsServer creates a RecentsService holding no interactions.
+func NewRecentsServer() *RecentsServer {
+	return new(RecentsServer)
+}
+
+// AddInteractions adds interactions as they are, e.g. to seed a history
+// older than the test. Interactions without an ID or a creation time are
+// given one.
+func (s *RecentsServer) AddInteractions(interactions ...*recents.RecentInteraction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, in := range interactions {
+		in = proto.CloneOf(in)
+		if in.Id == "" {
+			in.Id = newID()
+		}
+		if in.CreatedAt == nil {
+			in.CreatedAt = timestamppb.Now()
+		}
+		s.interactions = append(s.interactions, in)
+	}
+}
+
+func (s *RecentsServer) RecordInteraction(_ context.Context, in *recents.RecordInteractionRequest) (*recents.RecordInteractionResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, required("user_id")
+	}
+	if in.GetInteractionType() == recents.InteractionType_INTERACTION_TYPE_UNSPECIFIED {
+		return nil, required("interaction_type")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	interaction := &recents.RecentInteraction{
+		Id:              newID(),
+		UserId:          in.GetUserId(),
+		InteractionType: in.GetInteractionType(),
+		EntityId:        in.GetEntityId(),
+		EntityType:      in.GetEntityType(),
+		EntityName:      in.GetEntityName(),
+		CityId:          in.GetCityId(),
+		CityName:        in.GetContext().GetLocation().GetCity(),
+		Country:         in.GetContext().GetLocation().GetCountry(),
+		Context:         in.GetContext(),
+		Metadata:        in.GetMetadata(),
+		CreatedAt:       timestamppb.Now(),
+	}
+	s.interactions = append(s.interactions, interaction)
+
+	return &recents.RecordInteractionResponse{Success: true, InteractionId: interaction.GetId(), Message: "interaction recorded"}, nil
+}
+
+func (s *RecentsServer) GetRecentInteractions(_ context.Context, in *recents.GetRecentInteractionsRequest) (*recents.GetRecentInteractionsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	all := s.history(in.GetUserId(), nil)
+	matches := s.history(in.GetUserId(), in.GetFilter())
+
+	limit := in.GetLimit()
+	if limit == 0 {
+		limit = defaultRecentLimit
+	}
+
+	resp := &recents.GetRecentInteractionsResponse{
+		Interactions: window(matches, in.GetOffset(), limit),
+		TotalCount:   int32(len(matches)),
+		Analytics:    analytics(all),
+	}
+
+	if in.GetGroupByCity() {
+		summaries := make(map[string]*recents.CityInteractionSummary)
+		var order []string
+		for _, interaction := range matches {
+			key := cmp.Or(interaction.GetCityId(), interaction.GetCityName())
+			summary, ok := summaries[key]
+			if !ok {
+				summary = &recents.CityInteractionSummary{
+					CityId:            interaction.GetCityId(),
+					CityName:          interaction.GetCityName(),
+					Country:           interaction.GetCountry(),
+					LatestInteraction: interaction.GetCreatedAt(),
+				}
+				summaries[key] = summary
+				order = append(order, key)
+			}
+			summary.InteractionCount++
+			if len(summary.RecentInteractions) < 5 {
+				summary.RecentInteractions = append(summary.RecentInteractions, interaction)
+			}
+		}
+		for _, key := range order {
+			resp.CitySummaries = append(resp.CitySummaries, summaries[key])
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *RecentsServer) GetCityInteractions(_ context.Context, in *recents.GetCityInteractionsRequest) (*recents.GetCityInteractionsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	city := &recents.CityInteractions{CityName: in.GetCityName()}
+	var matches []*recents.RecentInteraction
+	for _, interaction := range s.history(in.GetUserId(), &recents.InteractionFilter{StartDate: in.GetStartDate(), EndDate: in.GetEndDate()}) {
+		if strings.EqualFold(interaction.GetCityName(), in.GetCityName()) {
+			matches = append(matches, interaction)
+		}
+	}
+
+	for _, interaction := range matches {
+		city.CityId, city.Country = interaction.GetCityId(), interaction.GetCountry()
+		city.TotalInteractions++
+		switch interaction.GetInteractionType() {
+		case recents.InteractionType_INTERACTION_TYPE_SEARCH:
+			city.Searches++
+		case recents.InteractionType_INTERACTION_TYPE_FAVORITE:
+			city.Favorites++
+		case recents.InteractionType_INTERACTION_TYPE_SAVE_ITINERARY:
+			city.ItinerariesCreated++
+		case recents.InteractionType_INTERACTION_TYPE_VIEW:
+			city.PoisViewed++
+		}
+	}
+
+	resp := &recents.GetCityInteractionsResponse{CityInteractions: city}
+	if len(matches) > 0 {
+		// matches are newest first.
+		city.LastInteraction, city.FirstInteraction = matches[0].GetCreatedAt(), matches[len(matches)-1].GetCreatedAt()
+		city.TopCategories = topCategories(matches, 3)
+
+		pattern, frequency := "focused", "occasional"
+		switch {
+		case len(matches) == 1:
+			frequency = "first_time"
+		case len(matches) >= 10:
+			frequency = "frequent"
+		}
+		if len(city.GetTopCategories()) > 2 {
+			pattern = "explorer"
+		}
+		resp.Insights = &recents.CityInsights{
+			DiscoveryPattern:    pattern,
+			SuggestedCategories: city.GetTopCategories(),
+			EngagementScore:     min(100, float64(len(matches))*10),
+			VisitFrequency:      frequency,
+		}
+	}
+	if in.GetIncludeDetails() {
+		resp.DetailedInteractions = matches
+	}
+
+	return resp, nil
+}
+
+func (s *RecentsServer) GetInteractionHistory(_ context.Context, in *recents.GetInteractionHistoryRequest) (*recents.GetInteractionHistoryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matches := s.history(in.GetUserId(), in.GetFilter())
+	switch in.GetSortBy() {
+	case "frequency":
+		counts := make(map[string]int)
+		for _, interaction := range matches {
+			counts[interaction.GetEntityId()]++
+		}
+		slices.SortStableFunc(matches, func(a, b *recents.RecentInteraction) int {
+			return cmp.Compare(counts[b.GetEntityId()], counts[a.GetEntityId()])
+		})
+	default:
+		if in.GetSortOrder() == "asc" {
+			slices.Reverse(matches)
+		}
+	}
+
+	resp := &recents.GetInteractionHistoryResponse{
+		Interactions: window(matches, in.GetOffset(), in.GetLimit()),
+		TotalCount:   int32(len(matches)),
+	}
+
+	if in.GetIncludeAnalytics() {
+		resp.Analytics = analytics(matches)
+
+		days := make(map[time.Time]*recents.TrendData)
+		for _, interaction := range matches {
+			day := interaction.GetCreatedAt().AsTime().Truncate(24 * time.Hour)
+			trend, ok := days[day]
+			if !ok {
+				trend = &recents.TrendData{Date: timestamppb.New(day)}
+				days[day] = trend
+			}
+			trend.InteractionCount++
+
+			i := slices.IndexFunc(trend.TypeBreakdown, func(c *recents.InteractionTypeCount) bool {
+				return c.GetType() == interaction.GetInteractionType()
+			})
+			if i < 0 {
+				trend.TypeBreakdown = append(trend.TypeBreakdown, &recents.InteractionTypeCount{Type: interaction.GetInteractionType()})
+				i = len(trend.TypeBreakdown) - 1
+			}
+			trend.TypeBreakdown[i].Count++
+		}
+		for _, day := range slices.SortedFunc(maps.Keys(days), time.Time.Compare) {
+			resp.Trends = append(resp.Trends, days[day])
+		}
+	}
+
+	return resp, nil
+}
+
+// GetFrequentPlaces groups the interactions of the user by entity. Searches
+// are not places, and are left out unless asked for.
+func (s *RecentsServer) GetFrequentPlaces(_ context.Context, in *recents.GetFrequentPlacesRequest) (*recents.GetFrequentPlacesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	filter := new(recents.InteractionFilter)
+	if d, ok := timeRange(in.GetTimeRange()); ok {
+		filter.StartDate = timestamppb.New(time.Now().Add(-d))
+	}
+
+	places := make(map[string]*recents.FrequentPlace)
+	var order []string
+	for _, interaction := range s.history(in.GetUserId(), filter) {
+		if interaction.GetEntityId() == "" ||
+			(len(in.GetPlaceTypes()) == 0 && interaction.GetEntityType() == "search") ||
+			!anyEqual(interaction.GetEntityType(), in.GetPlaceTypes()) {
+			continue
+		}
+
+		place, ok := places[interaction.GetEntityId()]
+		if !ok {
+			location := interaction.GetContext().GetLocation()
+			place = &recents.FrequentPlace{
+				PlaceId:   interaction.GetEntityId(),
+				PlaceName: interaction.GetEntityName(),
+				PlaceType: interaction.GetEntityType(),
+				Category:  interaction.GetMetadata()["category"],
+				Latitude:  location.GetLatitude(),
+				Longitude: location.GetLongitude(),
+				CityName:  interaction.GetCityName(),
+				LastVisit: interaction.GetCreatedAt(),
+			}
+			places[interaction.GetEntityId()] = place
+			order = append(order, interaction.GetEntityId())
+		}
+
+		place.InteractionCount++
+		place.FirstVisit = interaction.GetCreatedAt()
+		if interaction.GetInteractionType() == recents.InteractionType_INTERACTION_TYPE_VIEW {
+			place.VisitCount++
+		}
+		if !slices.Contains(place.InteractionTypes, interaction.GetInteractionType()) {
+			place.InteractionTypes = append(place.InteractionTypes, interaction.GetInteractionType())
+		}
+	}
+
+	var most int32
+	for _, place := range places {
+		most = max(most, place.GetInteractionCount())
+	}
+
+	var out []*recents.FrequentPlace
+	categories := make(map[string]int)
+	for _, id := range order {
+		place := places[id]
+		place.VisitFrequencyScore = 100 * float64(place.GetInteractionCount()) / float64(most)
+		if place.GetVisitFrequencyScore() < in.GetMinFrequencyScore() {
+			continue
+		}
+		out = append(out, place)
+		if place.GetCategory() != "" {
+			categories[place.GetCategory()]++
+		}
+	}
+	slices.SortStableFunc(out, func(a, b *recents.FrequentPlace) int {
+		return cmp.Compare(b.GetVisitFrequencyScore(), a.GetVisitFrequencyScore())
+	})
+
+	limit := in.GetLimit()
+	if limit == 0 {
+		limit = defaultFrequentLimit
+	}
+
+	return &recents.GetFrequentPlacesResponse{
+		Places: window(out, 0, limit),
+		Insights: &recents.FrequentPlaceInsights{
+			TravelPattern:             "local_explorer",
+			FavoriteCategories:        mostCommon(categories, 3),
+			ExplorationDiversityScore: min(100, float64(len(categories))*20),
+			LoyaltyLevel:              "low",
+		},
+	}, nil
+}
+
+// history returns clones of the interactions of the user matching f,
+// newest first.
+func (s *RecentsServer) history(userID string, f *recents.InteractionFilter) []*recents.RecentInteraction {
+	var out []*recents.RecentInteraction
+	for _, interaction := range slices.Backward(s.interactions) {
+		if interaction.GetUserId() == userID && matchesInteraction(interaction, f) {
+			out = append(out, proto.CloneOf(interaction))
+		}
+	}
+
+	slices.SortStableFunc(out, func(a, b *recents.RecentInteraction) int {
+		return b.GetCreatedAt().AsTime().Compare(a.GetCreatedAt().AsTime())
+	})
+
+	return out
+}
+
+func matchesInteraction(in *recents.RecentInteraction, f *recents.InteractionFilter) bool {
+	if f == nil {
+		return true
+	}
+
+	created := in.GetCreatedAt().AsTime()
+	switch {
+	case len(f.GetInteractionTypes()) > 0 && !slices.Contains(f.GetInteractionTypes(), in.GetInteractionType()),
+		!anyEqual(in.GetEntityType(), f.GetEntityTypes()),
+		f.GetCityId() != "" && in.GetCityId() != f.GetCityId(),
+		f.GetStartDate() != nil && created.Before(f.GetStartDate().AsTime()),
+		f.GetEndDate() != nil && created.After(f.GetEndDate().AsTime()),
+		f.GetSearchQuery() != "" && !contains(in.GetEntityName(), f.GetSearchQuery()) && !contains(in.GetDescription(), f.GetSearchQuery()),
+		!anyEqual(in.GetMetadata()["category"], f.GetCategories()):
+		return false
+	}
+
+	return true
+}
+
+// analytics sums up interactions, which are newest first.
+func analytics(interactions []*recents.RecentInteraction) *recents.InteractionAnalytics {
+	a := new(recents.InteractionAnalytics)
+	if len(interactions) == 0 {
+		return a
+	}
+
+	now := time.Now()
+	today := now.Truncate(24 * time.Hour)
+	week := now.AddDate(0, 0, -7)
+	cities := make(map[string]bool)
+	var hours [24]int
+	for _, in := range interactions {
+		created := in.GetCreatedAt().AsTime()
+		if !created.Before(today) {
+			a.TotalInteractionsToday++
+		}
+		if created.After(week) {
+			a.TotalInteractionsThisWeek++
+		}
+		if city := cmp.Or(in.GetCityId(), in.GetCityName()); city != "" {
+			cities[city] = true
+		}
+		hours[created.Hour()]++
+	}
+	a.UniqueCitiesVisited = int32(len(cities))
+	a.TopCategories = topCategories(interactions, 5)
+
+	switch busiest := slices.Index(hours[:], slices.Max(hours[:])); {
+	case busiest < 12:
+		a.MostActiveTimeOfDay = "morning"
+	case busiest < 18:
+		a.MostActiveTimeOfDay = "afternoon"
+	default:
+		a.MostActiveTimeOfDay = "evening"
+	}
+
+	first := interactions[len(interactions)-1].GetCreatedAt().AsTime()
+	days := max(1, now.Sub(first).Hours()/24)
+	a.AverageInteractionsPerDay = float64(len(interactions)) / days
+
+	return a
+}
+
+func topCategories(interactions []*recents.RecentInteraction, n int) []string {
+	counts := make(map[string]int)
+	for _, in := range interactions {
+		if category := in.GetMetadata()["category"]; category != "" {
+			counts[category]++
+		}
+	}
+
+	return mostCommon(counts, n)
+}
+
+// mostCommon returns the n keys with the highest counts.
+func mostCommon(counts map[string]int, n int) []string {
+	keys := slices.SortedFunc(maps.Keys(counts), func(a, b string) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), strings.Compare(a, b))
+	})
+
+	return keys[:min(n, len(keys))]
+}
+
+// timeRange reads the time ranges of GetFrequentPlacesRequest. "all", or
+// no range, reports false.
+func timeRange(s string) (time.Duration, bool) {
+	const day = 24 * time.Hour
+
+	switch s {
+	case "30d":
+		return 30 * day, true
+	case "90d":
+		return 90 * day, true
+	case "1y":
+		return 365 * day, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,45 @@
+package fakes
+
+import (
+	"testing"
+
+	recents "github.com/FACorreiaa/loci-proto/modules/recents/generated"
+)
+
+func TestRecentsServer(t *testing.T) {
+	_, brokers := start(t)
+
+	for _, poi := range []string{"belem", "alfama"} {
+		_, err := brokers.Recents.RecordInteraction(ctx, &recents.RecordInteractionRequest{
+			UserId:          "u1",
+			InteractionType: recents.InteractionType_INTERACTION_TYPE_VIEW,
+			EntityId:        poi,
+			CityId:          "lisbon",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := brokers.Recents.GetRecentInteractions(ctx, &recents.GetRecentInteractionsRequest{UserId: "u1", GroupByCity: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetTotalCount() != 2 || len(got.GetInteractions()) != 2 {
+		t.Errorf("GetRecentInteractions() = %v, want the 2 recorded interactions", got.GetInteractions())
+	}
+	if summaries := got.GetCitySummaries(); len(summaries) != 1 || summaries[0].GetInteractionCount() != 2 {
+		t.Errorf("GetRecentInteractions() city summaries = %v, want lisbon with 2 interactions", summaries)
+	}
+
+	others, err := brokers.Recents.GetRecentInteractions(ctx, &recents.GetRecentInteractionsRequest{UserId: "u2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if others.GetTotalCount() != 0 {
+		t.Errorf("GetRecentInteractions() of another user found %d interactions, want 0", others.GetTotalCount())
+	}
+
+	_, err = brokers.Recents.RecordInteraction(ctx, &recents.RecordInteractionRequest{UserId: "u1"})
+	wantValidation(t, err, "interaction_type")
+}
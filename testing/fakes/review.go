@@ -0,0 +1,474 @@
+package fakes
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+	helpers "github.com/FACorreiaa/loci-proto/modules/common"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	review "github.com/FACorreiaa/loci-proto/modules/review/generated"
+	"github.com/FACorreiaa/loci-proto/pagination"
+)
+
+// ReviewServer is an in-memory ReviewService. Reviews are published as
+// soon as they are created, and listings hand out signed pagination
+// cursors.
+type ReviewServer struct {
+	review.UnimplementedReviewServiceServer
+
+	cursors *pagination.CursorCodec
+
+	mu      sync.Mutex
+	reviews []*review.Review
+	likes   map[string]map[string]bool
+	reports map[string]map[string]bool
+}
+
+var _ review.ReviewServiceServer = (*ReviewServer)(nil)
+
+// NewReviewServer creates a ReviewService holding no reviews, encoding its
+// pagination cursors with cursors.
+func NewReviewServer(cursors *pagination.CursorCodec) *ReviewServer {
+	return &ReviewServer{
+		cursors: cursors,
+		likes:   make(map[string]map[string]bool),
+		reports: make(map[string]map[string]bool),
+	}
+}
+
+func (s *ReviewServer) CreateReview(_ context.Context, in *review.CreateReviewRequest) (*review.CreateReviewResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, required("user_id")
+	}
+	if in.GetPoiId() == "" {
+		return nil, required("poi_id")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range s.reviews {
+		if r.GetUserId() == in.GetUserId() && r.GetPoiId() == in.GetPoiId() && r.GetStatus() != review.ReviewStatus_REVIEW_STATUS_DELETED {
+			return nil, status.Errorf(codes.AlreadyExists, "user %q already reviewed POI %q", in.GetUserId(), in.GetPoiId())
+		}
+	}
+
+	now := timestamppb.Now()
+	r := &review.Review{
+		Id:        newID(),
+		UserId:    in.GetUserId(),
+		PoiId:     in.GetPoiId(),
+		Rating:    in.GetRating(),
+		Title:     in.GetTitle(),
+		Content:   in.GetContent(),
+		Photos:    in.GetPhotoUrls(),
+		Status:    review.ReviewStatus_REVIEW_STATUS_PUBLISHED,
+		VisitDate: in.GetVisitDate(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Language:  in.GetLanguage(),
+		Aspects:   in.GetAspects(),
+		Reviewer:  &review.ReviewerInfo{UserId: in.GetUserId()},
+	}
+	s.reviews = append(s.reviews, r)
+
+	return &review.CreateReviewResponse{
+		Response: helpers.NewSuccessResponse("review created"),
+		Review:   proto.CloneOf(r),
+	}, nil
+}
+
+func (s *ReviewServer) GetPOIReviews(_ context.Context, in *review.GetPOIReviewsRequest) (*review.GetPOIReviewsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var all, matches []*review.Review
+	for _, r := range s.published() {
+		if r.GetPoiId() != in.GetPoiId() {
+			continue
+		}
+		all = append(all, r)
+		if matchesFilter(r, in.GetFilter()) {
+			matches = append(matches, r)
+		}
+	}
+	sortReviews(matches, in.GetFilter())
+
+	page, p, err := s.paginate(matches, in.GetPagination(), in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &review.GetPOIReviewsResponse{
+		Reviews:    page,
+		Pagination: p,
+		Statistics: reviewStatistics(in.GetPoiId(), all, false),
+	}, nil
+}
+
+func (s *ReviewServer) GetReview(_ context.Context, in *review.GetReviewRequest) (*review.GetReviewResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.find(in.GetReviewId())
+	if r == nil {
+		return nil, notFound("review", in.GetReviewId())
+	}
+
+	own := in.GetUserId() != "" && r.GetUserId() == in.GetUserId()
+
+	return &review.GetReviewResponse{Review: proto.CloneOf(r), CanEdit: own, CanDelete: own}, nil
+}
+
+func (s *ReviewServer) UpdateReview(_ context.Context, in *review.UpdateReviewRequest) (*review.UpdateReviewResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.find(in.GetReviewId())
+	if r == nil || r.GetUserId() != in.GetUserId() {
+		return nil, notFound("review", in.GetReviewId())
+	}
+
+	if in.GetRating() != 0 {
+		r.Rating = in.GetRating()
+	}
+	if in.GetTitle() != "" {
+		r.Title = in.GetTitle()
+	}
+	if in.GetContent() != "" {
+		r.Content = in.GetContent()
+	}
+	if len(in.GetPhotoUrls()) > 0 {
+		r.Photos = in.GetPhotoUrls()
+	}
+	if in.GetVisitDate() != nil {
+		r.VisitDate = in.GetVisitDate()
+	}
+	if in.GetAspects() != nil {
+		r.Aspects = in.GetAspects()
+	}
+	r.UpdatedAt = timestamppb.Now()
+
+	return &review.UpdateReviewResponse{
+		Response: helpers.NewSuccessResponse("review updated"),
+		Review:   proto.CloneOf(r),
+	}, nil
+}
+
+func (s *ReviewServer) DeleteReview(_ context.Context, in *review.DeleteReviewRequest) (*review.DeleteReviewResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.find(in.GetReviewId())
+	if r == nil || r.GetUserId() != in.GetUserId() {
+		return nil, notFound("review", in.GetReviewId())
+	}
+
+	r.Status = review.ReviewStatus_REVIEW_STATUS_DELETED
+	r.UpdatedAt = timestamppb.Now()
+
+	return &review.DeleteReviewResponse{Response: helpers.NewSuccessResponse("review deleted")}, nil
+}
+
+func (s *ReviewServer) GetUserReviews(_ context.Context, in *review.GetUserReviewsRequest) (*review.GetUserReviewsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var all, matches []*review.Review
+	for _, r := range s.reviews {
+		if r.GetUserId() != in.GetUserId() || r.GetStatus() == review.ReviewStatus_REVIEW_STATUS_DELETED {
+			continue
+		}
+		all = append(all, r)
+		if matchesFilter(r, in.GetFilter()) {
+			matches = append(matches, r)
+		}
+	}
+	sortReviews(matches, in.GetFilter())
+
+	page, p, err := s.paginate(matches, in.GetPagination(), in)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &review.UserReviewStatistics{TotalReviews: int32(len(all))}
+	for _, r := range all {
+		stats.AverageRatingGiven += r.GetRating() / float64(len(all))
+		stats.HelpfulVotesReceived += r.GetHelpfulCount()
+	}
+
+	return &review.GetUserReviewsResponse{Reviews: page, Pagination: p, Statistics: stats}, nil
+}
+
+func (s *ReviewServer) LikeReview(_ context.Context, in *review.LikeReviewRequest) (*review.LikeReviewResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, required("user_id")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.find(in.GetReviewId())
+	if r == nil {
+		return nil, notFound("review", in.GetReviewId())
+	}
+
+	likes := s.likes[r.GetId()]
+	if likes == nil {
+		likes = make(map[string]bool)
+		s.likes[r.GetId()] = likes
+	}
+	if in.GetIsLike() {
+		likes[in.GetUserId()] = true
+	} else {
+		delete(likes, in.GetUserId())
+	}
+	r.HelpfulCount = int32(len(likes))
+
+	return &review.LikeReviewResponse{
+		Response:        helpers.NewSuccessResponse("review liked"),
+		NewHelpfulCount: r.GetHelpfulCount(),
+	}, nil
+}
+
+func (s *ReviewServer) ReportReview(_ context.Context, in *review.ReportReviewRequest) (*review.ReportReviewResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, required("user_id")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.find(in.GetReviewId())
+	if r == nil {
+		return nil, notFound("review", in.GetReviewId())
+	}
+
+	reports := s.reports[r.GetId()]
+	if reports == nil {
+		reports = make(map[string]bool)
+		s.reports[r.GetId()] = reports
+	}
+	reports[in.GetUserId()] = true
+	r.ReportCount = int32(len(reports))
+
+	return &review.ReportReviewResponse{Response: helpers.NewSuccessResponse("review reported")}, nil
+}
+
+func (s *ReviewServer) GetReviewStatistics(_ context.Context, in *review.GetReviewStatisticsRequest) (*review.GetReviewStatisticsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var reviews []*review.Review
+	for _, r := range s.published() {
+		if r.GetPoiId() == in.GetPoiId() {
+			reviews = append(reviews, r)
+		}
+	}
+
+	return &review.GetReviewStatisticsResponse{Statistics: reviewStatistics(in.GetPoiId(), reviews, in.GetIncludeTrends())}, nil
+}
+
+func (s *ReviewServer) find(id string) *review.Review {
+	for _, r := range s.reviews {
+		if r.GetId() == id && r.GetStatus() != review.ReviewStatus_REVIEW_STATUS_DELETED {
+			return r
+		}
+	}
+
+	return nil
+}
+
+func (s *ReviewServer) published() []*review.Review {
+	var published []*review.Review
+	for _, r := range s.reviews {
+		if r.GetStatus() == review.ReviewStatus_REVIEW_STATUS_PUBLISHED {
+			published = append(published, r)
+		}
+	}
+
+	return published
+}
+
+// paginate returns clones of the page of reviews p asks for, and the
+// PaginationResponse describing it. Cursors are bound to the query of req.
+func (s *ReviewServer) paginate(reviews []*review.Review, p *common.PaginationRequest, req proto.Message) ([]*review.Review, *common.PaginationResponse, error) {
+	offset, limit := pageBounds(p.GetPage(), p.GetPageSize(), p.GetOffset(), p.GetLimit())
+	if limit <= 0 {
+		limit = pagination.DefaultPageSize
+	}
+
+	query := pagination.Fingerprint(req)
+	if p.GetCursor() != "" {
+		cursor, err := s.cursors.Decode(p.GetCursor(), query)
+		if err != nil {
+			return nil, nil, rpcerrors.Validation(err.Error(), helpers.NewFieldError("pagination.cursor", err.Error(), rpcerrors.InvalidValue))
+		}
+		offset = cursor.Offset
+	}
+
+	total := int32(len(reviews))
+	page, pages := pageCount(total, offset, limit)
+	resp := &common.PaginationResponse{
+		CurrentPage:     page,
+		PageSize:        limit,
+		TotalItems:      total,
+		TotalPages:      pages,
+		HasNextPage:     offset+limit < total,
+		HasPreviousPage: offset > 0,
+	}
+	if resp.HasNextPage {
+		next, err := s.cursors.Encode(pagination.Cursor{Offset: offset + limit, Query: query})
+		if err != nil {
+			return nil, nil, err
+		}
+		resp.NextCursor = next
+	}
+
+	var out []*review.Review
+	for _, r := range window(reviews, offset, limit) {
+		out = append(out, proto.CloneOf(r))
+	}
+
+	return out, resp, nil
+}
+
+func matchesFilter(r *review.Review, f *review.ReviewFilter) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.GetRatingFilters()) > 0 && !slices.Contains(f.GetRatingFilters(), math.Round(r.GetRating())) {
+		return false
+	}
+	if f.GetStartDate() != nil && r.GetCreatedAt().AsTime().Before(f.GetStartDate().AsTime()) {
+		return false
+	}
+	if f.GetEndDate() != nil && r.GetCreatedAt().AsTime().After(f.GetEndDate().AsTime()) {
+		return false
+	}
+	if !anyEqual(r.GetLanguage(), f.GetLanguages()) {
+		return false
+	}
+	if (f.GetVerifiedOnly() && !r.GetIsVerified()) || (f.GetWithPhotosOnly() && len(r.GetPhotos()) == 0) {
+		return false
+	}
+	for _, keyword := range f.GetKeywords() {
+		if !contains(r.GetTitle(), keyword) && !contains(r.GetContent(), keyword) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortReviews sorts reviews the way f asks, most recent first by default.
+func sortReviews(reviews []*review.Review, f *review.ReviewFilter) {
+	var compare func(a, b *review.Review) int
+	switch f.GetSortBy() {
+	case review.ReviewSortBy_REVIEW_SORT_BY_RATING:
+		compare = func(a, b *review.Review) int { return cmp.Compare(a.GetRating(), b.GetRating()) }
+	case review.ReviewSortBy_REVIEW_SORT_BY_HELPFUL, review.ReviewSortBy_REVIEW_SORT_BY_RELEVANCE:
+		compare = func(a, b *review.Review) int { return cmp.Compare(a.GetHelpfulCount(), b.GetHelpfulCount()) }
+	default:
+		compare = func(a, b *review.Review) int { return a.GetCreatedAt().AsTime().Compare(b.GetCreatedAt().AsTime()) }
+	}
+
+	asc := f.GetSortDirection() == common.SortDirection_SORT_DIRECTION_ASC
+	slices.SortStableFunc(reviews, func(a, b *review.Review) int {
+		if asc {
+			return compare(a, b)
+		}
+
+		return compare(b, a)
+	})
+}
+
+// reviewStatistics sums up the published reviews of a POI.
+func reviewStatistics(poiID string, reviews []*review.Review, trends bool) *review.ReviewStatistics {
+	stats := &review.ReviewStatistics{
+		PoiId:          poiID,
+		TotalReviews:   int32(len(reviews)),
+		AspectAverages: new(review.ReviewAspectAverages),
+		LastUpdated:    timestamppb.Now(),
+	}
+
+	var stars [6]int32
+	languages := make(map[string]int32)
+	for _, r := range reviews {
+		stats.OverallRating += r.GetRating() / float64(len(reviews))
+		stars[min(max(int(math.Round(r.GetRating())), 1), 5)]++
+		if r.GetLanguage() != "" {
+			languages[r.GetLanguage()]++
+		}
+	}
+	stats.RatingBreakdown = helpers.NewRatingBreakdown(stars[5], stars[4], stars[3], stars[2], stars[1])
+	averageAspects(stats.AspectAverages, reviews)
+
+	stats.LanguageDistribution = new(review.LanguageDistribution)
+	for code, count := range languages {
+		stats.LanguageDistribution.Languages = append(stats.LanguageDistribution.Languages, &review.LanguageCount{
+			LanguageCode: code,
+			Count:        count,
+			Percentage:   100 * float64(count) / float64(len(reviews)),
+		})
+	}
+	slices.SortFunc(stats.LanguageDistribution.Languages, func(a, b *review.LanguageCount) int {
+		return cmp.Or(cmp.Compare(b.GetCount(), a.GetCount()), cmp.Compare(a.GetLanguageCode(), b.GetLanguageCode()))
+	})
+
+	if trends {
+		since := time.Now().AddDate(0, 0, -30)
+		t := new(review.RecentReviewTrends)
+		var sum float64
+		for _, r := range reviews {
+			if r.GetCreatedAt().AsTime().After(since) {
+				t.ReviewsLast_30Days++
+				sum += r.GetRating()
+			}
+		}
+		if t.ReviewsLast_30Days > 0 {
+			t.AverageRatingLast_30Days = sum / float64(t.ReviewsLast_30Days)
+			t.RatingTrend = t.AverageRatingLast_30Days - stats.OverallRating
+		}
+		stats.Trends = t
+	}
+
+	return stats
+}
+
+// averageAspects sets the averages of the aspects the reviews rated. The
+// fields of ReviewAspectAverages are numbered like those of ReviewAspects.
+func averageAspects(averages *review.ReviewAspectAverages, reviews []*review.Review) {
+	out := averages.ProtoReflect()
+	fields := out.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+
+		var sum float64
+		var n int
+		for _, r := range reviews {
+			aspects := r.GetAspects().ProtoReflect()
+			if in := aspects.Descriptor().Fields().ByNumber(fd.Number()); in != nil {
+				if v := aspects.Get(in).Float(); v > 0 {
+					sum += v
+					n++
+				}
+			}
+		}
+		if n > 0 {
+			out.Set(fd, protoreflect.ValueOfFloat64(sum/float64(n)))
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package fakes
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	reviewbroker "github.com/FACorreiaa/loci-proto/modules/review"
+	review "github.com/FACorreiaa/loci-proto/modules/review/generated"
+	"github.com/FACorreiaa/loci-proto/pagination"
+)
+
+func TestReviewServer(t *testing.T) {
+	srv, brokers := start(t)
+
+	var ids []string
+	for _, user := range []string{"u1", "u2", "u3"} {
+		created, err := brokers.Review.CreateReview(ctx, &review.CreateReviewRequest{UserId: user, PoiId: "belem", Rating: 4, Title: "Worth it"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, created.GetReview().GetId())
+	}
+
+	got, err := brokers.Review.GetReview(ctx, &review.GetReviewRequest{ReviewId: ids[0], UserId: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetReview().GetTitle() != "Worth it" || !got.GetCanEdit() {
+		t.Errorf("GetReview() = %v, editable %t, want the review of u1, editable", got.GetReview(), got.GetCanEdit())
+	}
+
+	_, err = brokers.Review.CreateReview(ctx, &review.CreateReviewRequest{UserId: "u1", PoiId: "belem", Rating: 5})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("second review of the same POI = %v, want %v", err, codes.AlreadyExists)
+	}
+	_, err = brokers.Review.CreateReview(ctx, &review.CreateReviewRequest{UserId: "u4", PoiId: "belem", Rating: 7})
+	wantValidation(t, err, "rating")
+
+	// The reviews are walked through the cursors the server hands out.
+	b, err := reviewbroker.NewBroker(Address, srv.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	seen := make(map[string]bool)
+	for r, err := range b.AllPOIReviews(ctx, &review.GetPOIReviewsRequest{PoiId: "belem"}, pagination.WithPageSize(2)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen[r.GetId()] = true
+	}
+	for _, id := range ids {
+		if !seen[id] {
+			t.Errorf("AllPOIReviews() missed review %s", id)
+		}
+	}
+	if len(seen) != len(ids) {
+		t.Errorf("AllPOIReviews() found %d reviews, want %d", len(seen), len(ids))
+	}
+}
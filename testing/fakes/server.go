@@ -0,0 +1,265 @@
+// Package fakes provides stateful in-memory implementations of the loci
+// services, served over an in-process bufconn listener, so that code using
+// the Brokers can be exercised end to end without the real backend:
+//
+//	srv, err := fakes.Start()
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	t.Cleanup(srv.Stop)
+//
+//	brokers, err := srv.Brokers()
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//
+//	created, _ := brokers.List.CreateList(ctx, &list.CreateListRequest{UserId: "u1", Name: "Lisbon"})
+//	got, _ := brokers.List.GetList(ctx, &list.GetListRequest{UserId: "u1", ListId: created.GetList().GetId()})
+//
+// The fakes keep consistent state across calls, validate requests the way
+// the services do and answer with the same typed errors, such as
+// *errors.ErrNotFound. Read-mostly data, such as POIs and cities, is seeded
+// through the Add methods of the fake serving it.
+package fakes
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/FACorreiaa/loci-proto/container"
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+	"github.com/FACorreiaa/loci-proto/middleware"
+	auth "github.com/FACorreiaa/loci-proto/modules/auth/generated"
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	city "github.com/FACorreiaa/loci-proto/modules/city/generated"
+	helpers "github.com/FACorreiaa/loci-proto/modules/common"
+	customer "github.com/FACorreiaa/loci-proto/modules/customer/generated"
+	interests "github.com/FACorreiaa/loci-proto/modules/interests/generated"
+	list "github.com/FACorreiaa/loci-proto/modules/list/generated"
+	poi "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+	profiles "github.com/FACorreiaa/loci-proto/modules/profiles/generated"
+	recents "github.com/FACorreiaa/loci-proto/modules/recents/generated"
+	review "github.com/FACorreiaa/loci-proto/modules/review/generated"
+	tags "github.com/FACorreiaa/loci-proto/modules/tags/generated"
+	"github.com/FACorreiaa/loci-proto/pagination"
+	"github.com/FACorreiaa/loci-proto/utils"
+)
+
+// Address is the address Brokers dial the fakes on. It only resolves
+// through the dial options of a Server.
+const Address = "passthrough:///loci-fakes"
+
+// bufferSize is the size of the in-memory connection buffer.
+const bufferSize = 1 << 20
+
+// Server serves every fake on a single bufconn listener. The fakes are
+// exported so tests can seed and inspect their state.
+type Server struct {
+	POI       *POIServer
+	List      *ListServer
+	Review    *ReviewServer
+	Chat      *ChatServer
+	Recents   *RecentsServer
+	Tags      *TagsServer
+	Interests *InterestsServer
+	Profiles  *ProfilesServer
+	City      *CityServer
+	Auth      *AuthServer
+	Customer  *CustomerServer
+
+	listener *bufconn.Listener
+	server   *grpc.Server
+	pool     *utils.Pool
+	dialer   grpc.DialOption
+}
+
+// Start registers a fresh fake for every service and starts serving them.
+// Requests are validated against the rules declared on their proto fields
+// before they reach a fake, like the services do.
+func Start() (*Server, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	cursors, err := pagination.NewCursorCodec(key, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	_, validation := middleware.ValidationInterceptors()
+	tagsServer, interestsServer := NewTagsServer(), NewInterestsServer()
+	s := &Server{
+		POI:       NewPOIServer(),
+		List:      NewListServer(),
+		Review:    NewReviewServer(cursors),
+		Chat:      NewChatServer(),
+		Recents:   NewRecentsServer(),
+		Tags:      tagsServer,
+		Interests: interestsServer,
+		Profiles:  NewProfilesServer(tagsServer, interestsServer),
+		City:      NewCityServer(),
+		Auth:      NewAuthServer(),
+		Customer:  NewCustomerServer(),
+
+		listener: bufconn.Listen(bufferSize),
+		server: grpc.NewServer(
+			grpc.ChainUnaryInterceptor(validation.Unary),
+			grpc.ChainStreamInterceptor(validation.Stream),
+		),
+		pool: utils.NewPool(1),
+	}
+	s.dialer = grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return s.listener.DialContext(ctx)
+	})
+
+	poi.RegisterPOIServiceServer(s.server, s.POI)
+	list.RegisterListServiceServer(s.server, s.List)
+	review.RegisterReviewServiceServer(s.server, s.Review)
+	chat.RegisterChatServiceServer(s.server, s.Chat)
+	recents.RegisterRecentsServiceServer(s.server, s.Recents)
+	tags.RegisterTagsServiceServer(s.server, s.Tags)
+	interests.RegisterInterestsServiceServer(s.server, s.Interests)
+	profiles.RegisterProfilesServiceServer(s.server, s.Profiles)
+	city.RegisterCityServiceServer(s.server, s.City)
+	auth.RegisterAuthServiceServer(s.server, s.Auth)
+	customer.RegisterCustomerServer(s.server, s.Customer)
+
+	go func() {
+		_ = s.server.Serve(s.listener)
+	}()
+
+	return s, nil
+}
+
+// Options returns the options a Broker needs to reach the fakes on Address,
+// for tests that create a single Broker:
+//
+//	b, err := listbroker.NewBroker(fakes.Address, srv.Options()...)
+func (s *Server) Options() []utils.Option {
+	return []utils.Option{
+		utils.WithInsecure(),
+		utils.WithPool(s.pool),
+		utils.WithDialOptions(s.dialer),
+	}
+}
+
+// Brokers returns Brokers wired to every faked service and connected over
+// the bufconn listener. opts are applied on top of Options. Close the
+// Brokers before calling Stop.
+func (s *Server) Brokers(opts ...utils.Option) (*container.Brokers, error) {
+	addresses := container.Addresses{}
+	for _, service := range []container.Service{
+		container.ServicePOI,
+		container.ServiceList,
+		container.ServiceReview,
+		container.ServiceChat,
+		container.ServiceRecents,
+		container.ServiceTags,
+		container.ServiceInterests,
+		container.ServiceProfiles,
+		container.ServiceCity,
+		container.ServiceAuth,
+		container.ServiceCustomer,
+	} {
+		addresses[service] = Address
+	}
+
+	tu := &utils.TransportUtils{Logger: zap.NewNop()}
+
+	return container.NewBrokers(tu, addresses, append(s.Options(), opts...)...)
+}
+
+// Stop stops serving, closing the connections still open.
+func (s *Server) Stop() {
+	s.server.Stop()
+}
+
+func newID() string {
+	return uuid.NewString()
+}
+
+// notFound builds the error the services return for a missing resource.
+func notFound(kind, id string) error {
+	code := strings.ToUpper(strings.ReplaceAll(kind, " ", "_")) + "_NOT_FOUND"
+
+	return rpcerrors.NotFound(helpers.NewErrorDetails(code, fmt.Sprintf("%s %q not found", kind, id)))
+}
+
+// required builds the error the services return for a missing field.
+func required(field string) error {
+	message := field + " is required"
+
+	return rpcerrors.Validation(message, helpers.NewFieldError(field, message, "REQUIRED"))
+}
+
+// window returns the items of a page starting at offset. A limit of zero or
+// less returns every remaining item.
+func window[T any](items []T, offset, limit int32) []T {
+	if offset < 0 {
+		offset = 0
+	}
+	if int(offset) >= len(items) {
+		return nil
+	}
+
+	items = items[offset:]
+	if limit > 0 && int(limit) < len(items) {
+		items = items[:limit]
+	}
+
+	return items
+}
+
+// pageBounds returns the offset and limit of a request paging either by
+// page number and page size or by offset and limit. A page number without
+// a page size counts in pages of pagination.DefaultPageSize.
+func pageBounds(page, pageSize, offset, limit int32) (int32, int32) {
+	if page > 0 {
+		if pageSize <= 0 {
+			pageSize = pagination.DefaultPageSize
+		}
+		return (page - 1) * pageSize, pageSize
+	}
+
+	return max(offset, 0), limit
+}
+
+// pageCount returns the number of the page starting at offset, counting
+// from one, and the number of pages total items fill.
+func pageCount(total, offset, limit int32) (int32, int32) {
+	if limit <= 0 {
+		return 1, 1
+	}
+
+	return offset/limit + 1, (total + limit - 1) / limit
+}
+
+// contains reports whether s contains substr, ignoring case.
+func contains(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// anyEqual reports whether s equals one of values, ignoring case. An empty
+// values matches anything.
+func anyEqual(s string, values []string) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	for _, v := range values {
+		if strings.EqualFold(s, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+package fakes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/FACorreiaa/loci-proto/container"
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+)
+
+// start serves fresh fakes and returns Brokers connected to them.
+func start(t *testing.T) (*Server, *container.Brokers) {
+	t.Helper()
+
+	srv, err := Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Stop)
+
+	brokers, err := srv.Brokers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = brokers.Close() })
+
+	return srv, brokers
+}
+
+var ctx = context.Background()
+
+// wantNotFound fails the test unless err is the typed error of a missing
+// resource.
+func wantNotFound(t *testing.T, err error) {
+	t.Helper()
+
+	var notFound *rpcerrors.ErrNotFound
+	if !errors.As(err, &notFound) {
+		t.Errorf("error = %v, want *errors.ErrNotFound", err)
+	}
+}
+
+// wantValidation fails the test unless err is the typed error of an invalid
+// request naming field.
+func wantValidation(t *testing.T, err error, field string) {
+	t.Helper()
+
+	var validation *rpcerrors.ErrValidation
+	if !errors.As(err, &validation) {
+		t.Fatalf("error = %v, want *errors.ErrValidation", err)
+	}
+	for _, f := range validation.Fields {
+		if f.GetField() == field {
+			return
+		}
+	}
+	t.Errorf("validation error names %v, want %s", validation.Fields, field)
+}
@@ -0,0 +1,146 @@
+package fakes
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tags "github.com/FACorreiaa/loci-proto/modules/tags/generated"
+)
+
+// TagsServer is an in-memory TagsService. Tag names are unique per user,
+// ignoring case.
+type TagsServer struct {
+	tags.UnimplementedTagsServiceServer
+
+	mu   sync.Mutex
+	tags []*tags.PersonalTag
+}
+
+var _ tags.TagsServiceServer = (*TagsServer)(nil)
+
+// NewTagsServer creates a TagsService holding no tags.
+func NewTagsServer() *TagsServer {
+	return new(TagsServer)
+}
+
+func (s *TagsServer) GetTags(_ context.Context, in *tags.GetTagsRequest) (*tags.GetTagsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []*tags.PersonalTag
+	for _, t := range s.tags {
+		if t.GetUserId() == in.GetUserId() {
+			out = append(out, proto.CloneOf(t))
+		}
+	}
+
+	return &tags.GetTagsResponse{Tags: out}, nil
+}
+
+func (s *TagsServer) GetTag(_ context.Context, in *tags.GetTagRequest) (*tags.GetTagResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.find(in.GetUserId(), in.GetTagId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tags.GetTagResponse{Tag: proto.CloneOf(t)}, nil
+}
+
+func (s *TagsServer) CreateTag(_ context.Context, in *tags.CreateTagRequest) (*tags.CreateTagResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, required("user_id")
+	}
+	if in.GetTag().GetName() == "" {
+		return nil, required("tag.name")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.unique(in.GetUserId(), "", in.GetTag().GetName()); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.Now()
+	t := &tags.PersonalTag{
+		Id:          newID(),
+		UserId:      in.GetUserId(),
+		Name:        in.GetTag().GetName(),
+		TagType:     in.GetTag().GetTagType(),
+		Description: in.GetTag().GetDescription(),
+		Source:      "user",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	s.tags = append(s.tags, t)
+
+	return &tags.CreateTagResponse{Success: true, Message: "tag created", Tag: proto.CloneOf(t)}, nil
+}
+
+// UpdateTag overwrites the fields of the tag set in the request.
+func (s *TagsServer) UpdateTag(_ context.Context, in *tags.UpdateTagRequest) (*tags.UpdateTagResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.find(in.GetUserId(), in.GetTagId())
+	if err != nil {
+		return nil, err
+	}
+	if name := in.GetTag().GetName(); name != "" {
+		if err := s.unique(in.GetUserId(), t.GetId(), name); err != nil {
+			return nil, err
+		}
+	}
+
+	t.Name = cmp.Or(in.GetTag().GetName(), t.GetName())
+	t.TagType = cmp.Or(in.GetTag().GetTagType(), t.GetTagType())
+	t.Description = cmp.Or(in.GetTag().GetDescription(), t.GetDescription())
+	t.UpdatedAt = timestamppb.Now()
+
+	return &tags.UpdateTagResponse{Success: true, Message: "tag updated", Tag: proto.CloneOf(t)}, nil
+}
+
+func (s *TagsServer) DeleteTag(_ context.Context, in *tags.DeleteTagRequest) (*tags.DeleteTagResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.find(in.GetUserId(), in.GetTagId()); err != nil {
+		return nil, err
+	}
+	s.tags = slices.DeleteFunc(s.tags, func(t *tags.PersonalTag) bool { return t.GetId() == in.GetTagId() })
+
+	return &tags.DeleteTagResponse{Success: true, Message: "tag deleted"}, nil
+}
+
+func (s *TagsServer) find(userID, id string) (*tags.PersonalTag, error) {
+	for _, t := range s.tags {
+		if t.GetId() == id && t.GetUserId() == userID {
+			return t, nil
+		}
+	}
+
+	return nil, notFound("tag", id)
+}
+
+// unique reports an error when another tag of the user, than the one with
+// ID id, is named name.
+func (s *TagsServer) unique(userID, id, name string) error {
+	for _, t := range s.tags {
+		if t.GetUserId() == userID && t.GetId() != id && strings.EqualFold(t.GetName(), name) {
+			return status.Errorf(codes.AlreadyExists, "tag %q already exists", name)
+		}
+	}
+
+	return nil
+}
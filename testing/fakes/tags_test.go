@@ -0,0 +1,51 @@
+package fakes
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tags "github.com/FACorreiaa/loci-proto/modules/tags/generated"
+)
+
+func TestTagsServer(t *testing.T) {
+	_, brokers := start(t)
+
+	created, err := brokers.Tags.CreateTag(ctx, &tags.CreateTagRequest{UserId: "u1", Tag: &tags.CreatePersonalTagParams{Name: "Rooftops"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetTag().GetId()
+
+	got, err := brokers.Tags.GetTag(ctx, &tags.GetTagRequest{UserId: "u1", TagId: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetTag().GetName() != "Rooftops" {
+		t.Errorf("GetTag() = %v, want Rooftops", got.GetTag())
+	}
+
+	_, err = brokers.Tags.CreateTag(ctx, &tags.CreateTagRequest{UserId: "u1", Tag: &tags.CreatePersonalTagParams{Name: "rooftops"}})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("CreateTag() of a taken name = %v, want %v", err, codes.AlreadyExists)
+	}
+
+	// Tags are private to their user.
+	_, err = brokers.Tags.GetTag(ctx, &tags.GetTagRequest{UserId: "u2", TagId: id})
+	wantNotFound(t, err)
+
+	if _, err := brokers.Tags.DeleteTag(ctx, &tags.DeleteTagRequest{UserId: "u1", TagId: id}); err != nil {
+		t.Fatal(err)
+	}
+	all, err := brokers.Tags.GetTags(ctx, &tags.GetTagsRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.GetTags()) != 0 {
+		t.Errorf("GetTags() after DeleteTag = %v, want none", all.GetTags())
+	}
+
+	_, err = brokers.Tags.CreateTag(ctx, &tags.CreateTagRequest{UserId: "u1", Tag: new(tags.CreatePersonalTagParams)})
+	wantValidation(t, err, "tag.name")
+}
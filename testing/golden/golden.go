@@ -0,0 +1,245 @@
+// Package golden records the exchanges of gRPC calls to disk as protojson
+// golden files, and replays them through a server that stands in for the
+// real one, so that code using the Brokers can be tested offline against
+// responses a real backend once gave:
+//
+//	// Record, against the real backend.
+//	b, err := poibroker.NewBroker(addr, golden.Record("testdata/golden"))
+//
+//	// Replay, in a test.
+//	srv, err := golden.Start("testdata/golden")
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	t.Cleanup(srv.Stop)
+//	b, err := poibroker.NewBroker(golden.Address, srv.Options()...)
+//
+// Every exchange is stored in <dir>/<service>/<method>/, in a file named
+// after a fingerprint of its request. Files can be renamed, or written by
+// hand: a call is replayed from whichever file of its method recorded an
+// equal request. The BaseRequest of requests, which carries per-call IDs,
+// is ignored when comparing them.
+package golden
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Exchange is the content of a golden file: the request of a call, the
+// responses it received, in order, and how it ended. Unary calls have a
+// single response unless they failed.
+type Exchange struct {
+	Method    string            `json:"method"`
+	Request   json.RawMessage   `json:"request"`
+	Responses []json.RawMessage `json:"responses,omitempty"`
+	Status    json.RawMessage   `json:"status,omitempty"`
+	Header    metadata.MD       `json:"header,omitempty"`
+	Trailer   metadata.MD       `json:"trailer,omitempty"`
+}
+
+// newExchange builds the exchange of a call to method. st is nil for calls
+// that succeeded.
+func newExchange(method string, req proto.Message, responses []proto.Message, st *status.Status, header, trailer metadata.MD) (*Exchange, error) {
+	e := &Exchange{Method: method, Header: custom(header), Trailer: custom(trailer)}
+
+	var err error
+	if e.Request, err = protojson.Marshal(normalize(req)); err != nil {
+		return nil, err
+	}
+	for _, resp := range responses {
+		b, err := protojson.Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		e.Responses = append(e.Responses, b)
+	}
+	if st != nil {
+		if e.Status, err = protojson.Marshal(st.Proto()); err != nil {
+			return nil, err
+		}
+	}
+
+	return e, nil
+}
+
+// custom returns the metadata of md set by the server itself, leaving out
+// the keys the transport sets.
+func custom(md metadata.MD) metadata.MD {
+	out := metadata.MD{}
+	for k, v := range md {
+		if k != "content-type" && !strings.HasPrefix(k, "grpc-") && !strings.HasPrefix(k, ":") {
+			out[k] = v
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+
+	return out
+}
+
+// finalStatus returns the status the call ended with, which is nil if it
+// succeeded.
+func (e *Exchange) finalStatus() (*status.Status, error) {
+	if len(e.Status) == 0 {
+		return nil, nil
+	}
+
+	st := new(spb.Status)
+	if err := protojson.Unmarshal(e.Status, st); err != nil {
+		return nil, fmt.Errorf("golden: status of %s: %w", e.Method, err)
+	}
+
+	return status.FromProto(st), nil
+}
+
+// Path returns the file an exchange of method with req is recorded to.
+func Path(dir, method string, req proto.Message) string {
+	service, name, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+
+	return filepath.Join(dir, service, name, Fingerprint(method, req)+".json")
+}
+
+// Fingerprint identifies a call to method with req, leaving out its
+// BaseRequest.
+func Fingerprint(method string, req proto.Message) string {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(normalize(req))
+	if err != nil {
+		return ""
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write(b)
+
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// normalize returns a copy of req without its BaseRequest.
+func normalize(req proto.Message) proto.Message {
+	m := proto.Clone(req).ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for i := range fields.Len() {
+		if fd := fields.Get(i); fd.Message() != nil && fd.Message().Name() == "BaseRequest" {
+			m.Clear(fd)
+		}
+	}
+
+	return m.Interface()
+}
+
+// write stores e as the golden file of its request.
+func write(dir string, e *Exchange, req proto.Message) error {
+	path := Path(dir, e.Method, req)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("golden: %w", err)
+	}
+
+	b, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return fmt.Errorf("golden: %s: %w", e.Method, err)
+	}
+
+	if err := os.WriteFile(path, append(b, '\n'), 0o644); err != nil {
+		return fmt.Errorf("golden: %w", err)
+	}
+
+	return nil
+}
+
+// Load returns the exchange recorded in dir for a call to method with req,
+// and false if there is none.
+func Load(dir, method string, req proto.Message) (*Exchange, bool, error) {
+	path := Path(dir, method, req)
+	if e, err := read(path); err == nil && e.matches(method, req) {
+		return e, true, nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*.json"))
+	if err != nil {
+		return nil, false, fmt.Errorf("golden: %w", err)
+	}
+	for _, p := range paths {
+		e, err := read(p)
+		if err != nil {
+			return nil, false, err
+		}
+		if e.matches(method, req) {
+			return e, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+func read(path string) (*Exchange, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("golden: %w", err)
+	}
+
+	e := new(Exchange)
+	if err := json.Unmarshal(b, e); err != nil {
+		return nil, fmt.Errorf("golden: %s: %w", path, err)
+	}
+
+	return e, nil
+}
+
+// matches reports whether e recorded a call to method with req.
+func (e *Exchange) matches(method string, req proto.Message) bool {
+	if e.Method != method {
+		return false
+	}
+
+	recorded := req.ProtoReflect().New().Interface()
+	if err := protojson.Unmarshal(e.Request, recorded); err != nil {
+		return false
+	}
+
+	return proto.Equal(normalize(recorded), normalize(req))
+}
+
+// messageTypes returns the request and response types of method, which must
+// be linked into the binary.
+func messageTypes(method string) (protoreflect.MessageType, protoreflect.MessageType, error) {
+	service, name, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, nil, fmt.Errorf("golden: unknown service %s: %w", service, err)
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("golden: %s is not a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(name))
+	if md == nil {
+		return nil, nil, fmt.Errorf("golden: unknown method %s", method)
+	}
+
+	in, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		return nil, nil, fmt.Errorf("golden: %s: %w", method, err)
+	}
+	out, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, nil, fmt.Errorf("golden: %s: %w", method, err)
+	}
+
+	return in, out, nil
+}
@@ -0,0 +1,273 @@
+package golden
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/FACorreiaa/loci-proto/container"
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	city "github.com/FACorreiaa/loci-proto/modules/city/generated"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	"github.com/FACorreiaa/loci-proto/testing/fakes"
+	"github.com/FACorreiaa/loci-proto/utils"
+)
+
+// served is the header and trailer the backend sets on every call, so
+// that recordings have some to replay.
+var served = metadata.Pairs("x-served-by", "backend")
+
+// backend serves the city and chat fakes, setting served as the header and
+// trailer of every call, and returns Brokers for them that record to dir.
+func backend(t *testing.T, dir string) *container.Brokers {
+	t.Helper()
+
+	listener := bufconn.Listen(bufferSize)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			_ = grpc.SetHeader(ctx, served)
+			_ = grpc.SetTrailer(ctx, served)
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			_ = ss.SetHeader(served)
+			ss.SetTrailer(served)
+			return handler(srv, ss)
+		}),
+	)
+
+	cities := fakes.NewCityServer()
+	cities.AddCities(&city.City{Id: "lisbon", Name: "Lisbon", CountryCode: "PT"})
+	city.RegisterCityServiceServer(server, cities)
+	chat.RegisterChatServiceServer(server, fakes.NewChatServer())
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	dialer := grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	})
+	addr := "passthrough:///loci-backend"
+	brokers, err := container.NewBrokers(
+		&utils.TransportUtils{Logger: zap.NewNop()},
+		container.Addresses{container.ServiceCity: addr, container.ServiceChat: addr},
+		utils.WithInsecure(),
+		utils.WithPool(utils.NewPool(1)),
+		utils.WithDialOptions(dialer),
+		Record(dir),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = brokers.Close() })
+
+	return brokers
+}
+
+// replay serves the recordings in dir and returns Brokers for them.
+func replay(t *testing.T, dir string) *container.Brokers {
+	t.Helper()
+
+	srv, err := Start(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Stop)
+
+	brokers, err := srv.Brokers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = brokers.Close() })
+
+	return brokers
+}
+
+// getCityCall is the outcome of a GetCity call.
+type getCityCall struct {
+	resp            *city.GetCityResponse
+	err             error
+	header, trailer metadata.MD
+}
+
+func callGetCity(brokers *container.Brokers, id string) getCityCall {
+	var c getCityCall
+	c.resp, c.err = brokers.City.GetCity(context.Background(), &city.GetCityRequest{CityId: id}, grpc.Header(&c.header), grpc.Trailer(&c.trailer))
+
+	return c
+}
+
+// chatCall is the outcome of a StartChatStream call.
+type chatCall struct {
+	events  []*chat.ChatEvent
+	err     error
+	trailer metadata.MD
+}
+
+func callStartChatStream(t *testing.T, brokers *container.Brokers, req *chat.StartChatRequest) chatCall {
+	t.Helper()
+
+	stream, err := brokers.Chat.StartChatStream(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c chatCall
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			c.err = err
+			break
+		}
+		c.events = append(c.events, event)
+	}
+	c.trailer = stream.Trailer()
+
+	return c
+}
+
+func TestRecordReplay(t *testing.T) {
+	dir := t.TempDir()
+	recorder := backend(t, dir)
+
+	recordedCity := callGetCity(recorder, "lisbon")
+	recordedMissing := callGetCity(recorder, "madrid")
+	recordedChat := callStartChatStream(t, recorder, &chat.StartChatRequest{UserId: "u1", InitialMessage: "Plan a day in Lisbon"})
+	recordedChatError := callStartChatStream(t, recorder, &chat.StartChatRequest{InitialMessage: "Hi"})
+	if recordedCity.err != nil || recordedChat.err != nil {
+		t.Fatalf("recording: GetCity() error = %v, StartChatStream() error = %v", recordedCity.err, recordedChat.err)
+	}
+
+	for _, m := range []string{city.CityService_GetCity_FullMethodName, chat.ChatService_StartChatStream_FullMethodName} {
+		if paths, _ := filepath.Glob(filepath.Join(dir, "ai_poi.*", filepath.Base(m), "*.json")); len(paths) != 2 {
+			t.Errorf("recorded %d exchanges of %s, want 2", len(paths), m)
+		}
+	}
+
+	brokers := replay(t, dir)
+
+	t.Run("unary", func(t *testing.T) {
+		got := callGetCity(brokers, "lisbon")
+		if got.err != nil {
+			t.Fatal(got.err)
+		}
+		if !proto.Equal(got.resp, recordedCity.resp) {
+			t.Errorf("GetCity() = %v, want the recorded %v", got.resp, recordedCity.resp)
+		}
+		if !slices.Equal(got.header.Get("x-served-by"), served.Get("x-served-by")) {
+			t.Errorf("GetCity() header = %v, want %v", got.header, served)
+		}
+		if !slices.Equal(got.trailer.Get("x-served-by"), served.Get("x-served-by")) {
+			t.Errorf("GetCity() trailer = %v, want %v", got.trailer, served)
+		}
+	})
+
+	t.Run("unary error", func(t *testing.T) {
+		got := callGetCity(brokers, "madrid")
+
+		// The details of the status are replayed, so the typed error is too.
+		var notFound *rpcerrors.ErrNotFound
+		if !errors.As(got.err, &notFound) {
+			t.Fatalf("GetCity() error = %v, want *errors.ErrNotFound", got.err)
+		}
+		if status.Convert(got.err).Message() != status.Convert(recordedMissing.err).Message() {
+			t.Errorf("GetCity() error = %v, want the recorded %v", got.err, recordedMissing.err)
+		}
+		if !slices.Equal(got.trailer.Get("x-served-by"), served.Get("x-served-by")) {
+			t.Errorf("GetCity() trailer = %v, want %v", got.trailer, served)
+		}
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		got := callStartChatStream(t, brokers, &chat.StartChatRequest{UserId: "u1", InitialMessage: "Plan a day in Lisbon"})
+		if got.err != nil {
+			t.Fatal(got.err)
+		}
+		if !slices.EqualFunc(got.events, recordedChat.events, func(a, b *chat.ChatEvent) bool { return proto.Equal(a, b) }) {
+			t.Errorf("StartChatStream() sent %v, want the recorded %v", got.events, recordedChat.events)
+		}
+		if !slices.Equal(got.trailer.Get("x-served-by"), served.Get("x-served-by")) {
+			t.Errorf("StartChatStream() trailer = %v, want %v", got.trailer, served)
+		}
+	})
+
+	t.Run("stream error", func(t *testing.T) {
+		got := callStartChatStream(t, brokers, &chat.StartChatRequest{InitialMessage: "Hi"})
+		if status.Code(got.err) != status.Code(recordedChatError.err) || status.Code(got.err) == codes.OK {
+			t.Errorf("StartChatStream() error = %v, want the recorded %v", got.err, recordedChatError.err)
+		}
+		if !slices.Equal(got.trailer.Get("x-served-by"), served.Get("x-served-by")) {
+			t.Errorf("StartChatStream() trailer = %v, want %v", got.trailer, served)
+		}
+	})
+
+	t.Run("unrecorded", func(t *testing.T) {
+		got := callGetCity(brokers, "porto")
+		if status.Code(got.err) != codes.Unimplemented {
+			t.Errorf("GetCity() of an unrecorded request error = %v, want %v", got.err, codes.Unimplemented)
+		}
+	})
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	recorded := &city.GetCityRequest{CityId: "lisbon", Request: &common.BaseRequest{RequestId: "recorded"}}
+	e, err := newExchange(city.CityService_GetCity_FullMethodName, recorded, []proto.Message{&city.GetCityResponse{City: &city.City{Id: "lisbon"}}}, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := write(dir, e, recorded); err != nil {
+		t.Fatal(err)
+	}
+
+	// A file renamed by hand is still found, by comparing its request.
+	path := Path(dir, city.CityService_GetCity_FullMethodName, recorded)
+	if err := os.Rename(path, filepath.Join(filepath.Dir(path), "lisbon.json")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		req    proto.Message
+		want   bool
+	}{
+		{name: "other BaseRequest", method: city.CityService_GetCity_FullMethodName, req: &city.GetCityRequest{CityId: "lisbon", Request: &common.BaseRequest{RequestId: "replayed"}}, want: true},
+		{name: "no BaseRequest", method: city.CityService_GetCity_FullMethodName, req: &city.GetCityRequest{CityId: "lisbon"}, want: true},
+		{name: "other request", method: city.CityService_GetCity_FullMethodName, req: &city.GetCityRequest{CityId: "porto"}},
+		{name: "other method", method: city.CityService_GetCityStatistics_FullMethodName, req: &city.GetCityStatisticsRequest{CityId: "lisbon"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := Load(dir, tt.method, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tt.want {
+				t.Fatalf("Load(%v) found = %t, want %t", tt.req, ok, tt.want)
+			}
+			if ok && got.Method != city.CityService_GetCity_FullMethodName {
+				t.Errorf("Load(%v) = exchange of %s, want %s", tt.req, got.Method, city.CityService_GetCity_FullMethodName)
+			}
+		})
+	}
+}
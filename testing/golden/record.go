@@ -0,0 +1,135 @@
+package golden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/FACorreiaa/loci-proto/middleware"
+	"github.com/FACorreiaa/loci-proto/utils"
+)
+
+// Record returns the option that makes a Broker record its calls to dir.
+// The recording interceptors run last, so they see the requests as sent,
+// and the responses and errors as received.
+func Record(dir string) utils.Option {
+	i := RecordInterceptors(dir)
+
+	return utils.WithDialOptions(
+		grpc.WithChainUnaryInterceptor(i.Unary),
+		grpc.WithChainStreamInterceptor(i.Stream),
+	)
+}
+
+// RecordInterceptors record every call to dir, overwriting earlier
+// recordings of the same request. A stream is recorded once it ends, so
+// streams that are abandoned before their last message are not recorded.
+// Failing to record fails the call.
+func RecordInterceptors(dir string) middleware.ClientInterceptor {
+	return middleware.ClientInterceptor{
+		Unary: func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			var header, trailer metadata.MD
+			err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header), grpc.Trailer(&trailer))...)
+
+			in, ok := req.(proto.Message)
+			if !ok {
+				return err
+			}
+			var responses []proto.Message
+			if err == nil {
+				responses = append(responses, reply.(proto.Message))
+			}
+
+			if werr := record(dir, method, in, responses, err, header, trailer); werr != nil && err == nil {
+				return werr
+			}
+
+			return err
+		},
+		Stream: func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			stream, err := streamer(ctx, desc, cc, method, opts...)
+			if err != nil {
+				return nil, err
+			}
+
+			return &recordingStream{ClientStream: stream, dir: dir, method: method}, nil
+		},
+	}
+}
+
+// recordingStream collects the first request and every response of a
+// stream, and records them when the stream ends.
+type recordingStream struct {
+	grpc.ClientStream
+
+	dir    string
+	method string
+
+	mu        sync.Mutex
+	request   proto.Message
+	responses []proto.Message
+	done      bool
+}
+
+func (s *recordingStream) SendMsg(m any) error {
+	if msg, ok := m.(proto.Message); ok {
+		s.mu.Lock()
+		if s.request == nil {
+			s.request = proto.Clone(msg)
+		}
+		s.mu.Unlock()
+	}
+
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *recordingStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err == nil {
+		if msg, ok := m.(proto.Message); ok {
+			s.responses = append(s.responses, proto.Clone(msg))
+		}
+		return nil
+	}
+	if s.done || s.request == nil {
+		return err
+	}
+	s.done = true
+
+	var end error
+	if !errors.Is(err, io.EOF) {
+		end = err
+	}
+	header, _ := s.ClientStream.Header()
+	if werr := record(s.dir, s.method, s.request, s.responses, end, header, s.ClientStream.Trailer()); werr != nil {
+		return werr
+	}
+
+	return err
+}
+
+// record writes the exchange of a call that ended with err.
+func record(dir, method string, req proto.Message, responses []proto.Message, err error, header, trailer metadata.MD) error {
+	var st *status.Status
+	if err != nil {
+		st = status.Convert(err)
+	}
+
+	e, err := newExchange(method, req, responses, st, header, trailer)
+	if err != nil {
+		return fmt.Errorf("golden: %s: %w", method, err)
+	}
+
+	return write(dir, e, req)
+}
@@ -0,0 +1,163 @@
+package golden
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/FACorreiaa/loci-proto/container"
+	"github.com/FACorreiaa/loci-proto/utils"
+)
+
+// Address is the address Brokers dial a replay Server on. It only resolves
+// through the dial options of a Server.
+const Address = "passthrough:///loci-golden"
+
+// bufferSize is the size of the in-memory connection buffer.
+const bufferSize = 1 << 20
+
+// Handler replays the exchanges recorded in dir. It serves any method whose
+// types are linked into the binary, and is installed as the handler of
+// unknown services:
+//
+//	srv := grpc.NewServer(grpc.UnknownServiceHandler(golden.Handler(dir)))
+//
+// Calls without a recording fail with Unimplemented.
+func Handler(dir string) grpc.StreamHandler {
+	return func(_ any, stream grpc.ServerStream) error {
+		method, ok := grpc.MethodFromServerStream(stream)
+		if !ok {
+			return status.Error(codes.Internal, "golden: no method in stream context")
+		}
+
+		in, out, err := messageTypes(method)
+		if err != nil {
+			return status.Error(codes.Unimplemented, err.Error())
+		}
+
+		// The exchange is found by the first request; later ones, of client
+		// streams, are drained.
+		req := in.New().Interface()
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		for {
+			if err := stream.RecvMsg(in.New().Interface()); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return err
+			}
+		}
+
+		e, ok, err := Load(dir, method, req)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if !ok {
+			return status.Errorf(codes.Unimplemented, "golden: no recording of %s for request %s", method, Fingerprint(method, req))
+		}
+
+		if len(e.Header) > 0 {
+			if err := stream.SetHeader(e.Header); err != nil {
+				return err
+			}
+		}
+		stream.SetTrailer(e.Trailer)
+
+		for _, b := range e.Responses {
+			resp := out.New().Interface()
+			if err := protojson.Unmarshal(b, resp); err != nil {
+				return status.Errorf(codes.Internal, "golden: response of %s: %v", method, err)
+			}
+			if err := stream.SendMsg(resp); err != nil {
+				return err
+			}
+		}
+
+		st, err := e.finalStatus()
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return st.Err()
+	}
+}
+
+// Server replays the exchanges recorded in a directory over a bufconn
+// listener.
+type Server struct {
+	listener *bufconn.Listener
+	server   *grpc.Server
+	pool     *utils.Pool
+	dialer   grpc.DialOption
+}
+
+// Start starts replaying the exchanges recorded in dir.
+func Start(dir string) (*Server, error) {
+	s := &Server{
+		listener: bufconn.Listen(bufferSize),
+		server:   grpc.NewServer(grpc.UnknownServiceHandler(Handler(dir))),
+		pool:     utils.NewPool(1),
+	}
+	s.dialer = grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return s.listener.DialContext(ctx)
+	})
+
+	go func() {
+		_ = s.server.Serve(s.listener)
+	}()
+
+	return s, nil
+}
+
+// Options returns the options a Broker needs to reach the Server on
+// Address.
+func (s *Server) Options() []utils.Option {
+	return []utils.Option{
+		utils.WithInsecure(),
+		utils.WithPool(s.pool),
+		utils.WithDialOptions(s.dialer),
+	}
+}
+
+// Brokers returns Brokers whose every service is replayed by the Server.
+// opts are applied on top of Options. Close the Brokers before calling
+// Stop.
+func (s *Server) Brokers(opts ...utils.Option) (*container.Brokers, error) {
+	addresses := container.Addresses{}
+	for _, service := range []container.Service{
+		container.ServiceAuth,
+		container.ServiceChat,
+		container.ServicePOI,
+		container.ServiceList,
+		container.ServiceCity,
+		container.ServiceReview,
+		container.ServiceRecents,
+		container.ServiceStatistics,
+		container.ServiceProfiles,
+		container.ServiceInterests,
+		container.ServiceTags,
+		container.ServiceUser,
+		container.ServiceAiPoiService,
+		container.ServiceCustomer,
+	} {
+		addresses[service] = Address
+	}
+
+	tu := &utils.TransportUtils{Logger: zap.NewNop()}
+
+	return container.NewBrokers(tu, addresses, append(s.Options(), opts...)...)
+}
+
+// Stop stops serving, closing the connections still open.
+func (s *Server) Stop() {
+	s.server.Stop()
+}
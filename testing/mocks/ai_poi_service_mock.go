@@ -0,0 +1,176 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/ai_poi_service/generated (interfaces: AiPoiServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/ai_poi_service_mock.go github.com/FACorreiaa/loci-proto/modules/ai_poi_service/generated AiPoiServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/ai_poi_service/generated"
+	generated0 "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockAiPoiServiceClient is a mock of AiPoiServiceClient interface.
+type MockAiPoiServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAiPoiServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockAiPoiServiceClientMockRecorder is the mock recorder for MockAiPoiServiceClient.
+type MockAiPoiServiceClientMockRecorder struct {
+	mock *MockAiPoiServiceClient
+}
+
+// NewMockAiPoiServiceClient creates a new mock instance.
+func NewMockAiPoiServiceClient(ctrl *gomock.Controller) *MockAiPoiServiceClient {
+	mock := &MockAiPoiServiceClient{ctrl: ctrl}
+	mock.recorder = &MockAiPoiServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAiPoiServiceClient) EXPECT() *MockAiPoiServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetFeatureFlags mocks base method.
+func (m *MockAiPoiServiceClient) GetFeatureFlags(ctx context.Context, in *generated.GetFeatureFlagsRequest, opts ...grpc.CallOption) (*generated.GetFeatureFlagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFeatureFlags", varargs...)
+	ret0, _ := ret[0].(*generated.GetFeatureFlagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeatureFlags indicates an expected call of GetFeatureFlags.
+func (mr *MockAiPoiServiceClientMockRecorder) GetFeatureFlags(ctx, in any, opts ...any) *MockAiPoiServiceClientGetFeatureFlagsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeatureFlags", reflect.TypeOf((*MockAiPoiServiceClient)(nil).GetFeatureFlags), varargs...)
+	return &MockAiPoiServiceClientGetFeatureFlagsCall{Call: call}
+}
+
+// MockAiPoiServiceClientGetFeatureFlagsCall wrap *gomock.Call
+type MockAiPoiServiceClientGetFeatureFlagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAiPoiServiceClientGetFeatureFlagsCall) Return(arg0 *generated.GetFeatureFlagsResponse, arg1 error) *MockAiPoiServiceClientGetFeatureFlagsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAiPoiServiceClientGetFeatureFlagsCall) Do(f func(context.Context, *generated.GetFeatureFlagsRequest, ...grpc.CallOption) (*generated.GetFeatureFlagsResponse, error)) *MockAiPoiServiceClientGetFeatureFlagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAiPoiServiceClientGetFeatureFlagsCall) DoAndReturn(f func(context.Context, *generated.GetFeatureFlagsRequest, ...grpc.CallOption) (*generated.GetFeatureFlagsResponse, error)) *MockAiPoiServiceClientGetFeatureFlagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetServiceInfo mocks base method.
+func (m *MockAiPoiServiceClient) GetServiceInfo(ctx context.Context, in *generated.GetServiceInfoRequest, opts ...grpc.CallOption) (*generated.GetServiceInfoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetServiceInfo", varargs...)
+	ret0, _ := ret[0].(*generated.GetServiceInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceInfo indicates an expected call of GetServiceInfo.
+func (mr *MockAiPoiServiceClientMockRecorder) GetServiceInfo(ctx, in any, opts ...any) *MockAiPoiServiceClientGetServiceInfoCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceInfo", reflect.TypeOf((*MockAiPoiServiceClient)(nil).GetServiceInfo), varargs...)
+	return &MockAiPoiServiceClientGetServiceInfoCall{Call: call}
+}
+
+// MockAiPoiServiceClientGetServiceInfoCall wrap *gomock.Call
+type MockAiPoiServiceClientGetServiceInfoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAiPoiServiceClientGetServiceInfoCall) Return(arg0 *generated.GetServiceInfoResponse, arg1 error) *MockAiPoiServiceClientGetServiceInfoCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAiPoiServiceClientGetServiceInfoCall) Do(f func(context.Context, *generated.GetServiceInfoRequest, ...grpc.CallOption) (*generated.GetServiceInfoResponse, error)) *MockAiPoiServiceClientGetServiceInfoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAiPoiServiceClientGetServiceInfoCall) DoAndReturn(f func(context.Context, *generated.GetServiceInfoRequest, ...grpc.CallOption) (*generated.GetServiceInfoResponse, error)) *MockAiPoiServiceClientGetServiceInfoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// HealthCheck mocks base method.
+func (m *MockAiPoiServiceClient) HealthCheck(ctx context.Context, in *generated0.HealthCheckRequest, opts ...grpc.CallOption) (*generated0.HealthCheckResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HealthCheck", varargs...)
+	ret0, _ := ret[0].(*generated0.HealthCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HealthCheck indicates an expected call of HealthCheck.
+func (mr *MockAiPoiServiceClientMockRecorder) HealthCheck(ctx, in any, opts ...any) *MockAiPoiServiceClientHealthCheckCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockAiPoiServiceClient)(nil).HealthCheck), varargs...)
+	return &MockAiPoiServiceClientHealthCheckCall{Call: call}
+}
+
+// MockAiPoiServiceClientHealthCheckCall wrap *gomock.Call
+type MockAiPoiServiceClientHealthCheckCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAiPoiServiceClientHealthCheckCall) Return(arg0 *generated0.HealthCheckResponse, arg1 error) *MockAiPoiServiceClientHealthCheckCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAiPoiServiceClientHealthCheckCall) Do(f func(context.Context, *generated0.HealthCheckRequest, ...grpc.CallOption) (*generated0.HealthCheckResponse, error)) *MockAiPoiServiceClientHealthCheckCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAiPoiServiceClientHealthCheckCall) DoAndReturn(f func(context.Context, *generated0.HealthCheckRequest, ...grpc.CallOption) (*generated0.HealthCheckResponse, error)) *MockAiPoiServiceClientHealthCheckCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
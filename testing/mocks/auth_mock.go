@@ -0,0 +1,395 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/auth/generated (interfaces: AuthServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/auth_mock.go github.com/FACorreiaa/loci-proto/modules/auth/generated AuthServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/auth/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockAuthServiceClient is a mock of AuthServiceClient interface.
+type MockAuthServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthServiceClientMockRecorder is the mock recorder for MockAuthServiceClient.
+type MockAuthServiceClientMockRecorder struct {
+	mock *MockAuthServiceClient
+}
+
+// NewMockAuthServiceClient creates a new mock instance.
+func NewMockAuthServiceClient(ctrl *gomock.Controller) *MockAuthServiceClient {
+	mock := &MockAuthServiceClient{ctrl: ctrl}
+	mock.recorder = &MockAuthServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthServiceClient) EXPECT() *MockAuthServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GoogleCallback mocks base method.
+func (m *MockAuthServiceClient) GoogleCallback(ctx context.Context, in *generated.GoogleCallbackRequest, opts ...grpc.CallOption) (*generated.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GoogleCallback", varargs...)
+	ret0, _ := ret[0].(*generated.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GoogleCallback indicates an expected call of GoogleCallback.
+func (mr *MockAuthServiceClientMockRecorder) GoogleCallback(ctx, in any, opts ...any) *MockAuthServiceClientGoogleCallbackCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoogleCallback", reflect.TypeOf((*MockAuthServiceClient)(nil).GoogleCallback), varargs...)
+	return &MockAuthServiceClientGoogleCallbackCall{Call: call}
+}
+
+// MockAuthServiceClientGoogleCallbackCall wrap *gomock.Call
+type MockAuthServiceClientGoogleCallbackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthServiceClientGoogleCallbackCall) Return(arg0 *generated.LoginResponse, arg1 error) *MockAuthServiceClientGoogleCallbackCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthServiceClientGoogleCallbackCall) Do(f func(context.Context, *generated.GoogleCallbackRequest, ...grpc.CallOption) (*generated.LoginResponse, error)) *MockAuthServiceClientGoogleCallbackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthServiceClientGoogleCallbackCall) DoAndReturn(f func(context.Context, *generated.GoogleCallbackRequest, ...grpc.CallOption) (*generated.LoginResponse, error)) *MockAuthServiceClientGoogleCallbackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GoogleLogin mocks base method.
+func (m *MockAuthServiceClient) GoogleLogin(ctx context.Context, in *generated.GoogleLoginRequest, opts ...grpc.CallOption) (*generated.GoogleLoginResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GoogleLogin", varargs...)
+	ret0, _ := ret[0].(*generated.GoogleLoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GoogleLogin indicates an expected call of GoogleLogin.
+func (mr *MockAuthServiceClientMockRecorder) GoogleLogin(ctx, in any, opts ...any) *MockAuthServiceClientGoogleLoginCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoogleLogin", reflect.TypeOf((*MockAuthServiceClient)(nil).GoogleLogin), varargs...)
+	return &MockAuthServiceClientGoogleLoginCall{Call: call}
+}
+
+// MockAuthServiceClientGoogleLoginCall wrap *gomock.Call
+type MockAuthServiceClientGoogleLoginCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthServiceClientGoogleLoginCall) Return(arg0 *generated.GoogleLoginResponse, arg1 error) *MockAuthServiceClientGoogleLoginCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthServiceClientGoogleLoginCall) Do(f func(context.Context, *generated.GoogleLoginRequest, ...grpc.CallOption) (*generated.GoogleLoginResponse, error)) *MockAuthServiceClientGoogleLoginCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthServiceClientGoogleLoginCall) DoAndReturn(f func(context.Context, *generated.GoogleLoginRequest, ...grpc.CallOption) (*generated.GoogleLoginResponse, error)) *MockAuthServiceClientGoogleLoginCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Login mocks base method.
+func (m *MockAuthServiceClient) Login(ctx context.Context, in *generated.LoginRequest, opts ...grpc.CallOption) (*generated.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Login", varargs...)
+	ret0, _ := ret[0].(*generated.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockAuthServiceClientMockRecorder) Login(ctx, in any, opts ...any) *MockAuthServiceClientLoginCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthServiceClient)(nil).Login), varargs...)
+	return &MockAuthServiceClientLoginCall{Call: call}
+}
+
+// MockAuthServiceClientLoginCall wrap *gomock.Call
+type MockAuthServiceClientLoginCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthServiceClientLoginCall) Return(arg0 *generated.LoginResponse, arg1 error) *MockAuthServiceClientLoginCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthServiceClientLoginCall) Do(f func(context.Context, *generated.LoginRequest, ...grpc.CallOption) (*generated.LoginResponse, error)) *MockAuthServiceClientLoginCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthServiceClientLoginCall) DoAndReturn(f func(context.Context, *generated.LoginRequest, ...grpc.CallOption) (*generated.LoginResponse, error)) *MockAuthServiceClientLoginCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Logout mocks base method.
+func (m *MockAuthServiceClient) Logout(ctx context.Context, in *generated.LogoutRequest, opts ...grpc.CallOption) (*generated.LogoutResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Logout", varargs...)
+	ret0, _ := ret[0].(*generated.LogoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceClientMockRecorder) Logout(ctx, in any, opts ...any) *MockAuthServiceClientLogoutCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceClient)(nil).Logout), varargs...)
+	return &MockAuthServiceClientLogoutCall{Call: call}
+}
+
+// MockAuthServiceClientLogoutCall wrap *gomock.Call
+type MockAuthServiceClientLogoutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthServiceClientLogoutCall) Return(arg0 *generated.LogoutResponse, arg1 error) *MockAuthServiceClientLogoutCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthServiceClientLogoutCall) Do(f func(context.Context, *generated.LogoutRequest, ...grpc.CallOption) (*generated.LogoutResponse, error)) *MockAuthServiceClientLogoutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthServiceClientLogoutCall) DoAndReturn(f func(context.Context, *generated.LogoutRequest, ...grpc.CallOption) (*generated.LogoutResponse, error)) *MockAuthServiceClientLogoutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RefreshToken mocks base method.
+func (m *MockAuthServiceClient) RefreshToken(ctx context.Context, in *generated.RefreshTokenRequest, opts ...grpc.CallOption) (*generated.TokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshToken", varargs...)
+	ret0, _ := ret[0].(*generated.TokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockAuthServiceClientMockRecorder) RefreshToken(ctx, in any, opts ...any) *MockAuthServiceClientRefreshTokenCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthServiceClient)(nil).RefreshToken), varargs...)
+	return &MockAuthServiceClientRefreshTokenCall{Call: call}
+}
+
+// MockAuthServiceClientRefreshTokenCall wrap *gomock.Call
+type MockAuthServiceClientRefreshTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthServiceClientRefreshTokenCall) Return(arg0 *generated.TokenResponse, arg1 error) *MockAuthServiceClientRefreshTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthServiceClientRefreshTokenCall) Do(f func(context.Context, *generated.RefreshTokenRequest, ...grpc.CallOption) (*generated.TokenResponse, error)) *MockAuthServiceClientRefreshTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthServiceClientRefreshTokenCall) DoAndReturn(f func(context.Context, *generated.RefreshTokenRequest, ...grpc.CallOption) (*generated.TokenResponse, error)) *MockAuthServiceClientRefreshTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Register mocks base method.
+func (m *MockAuthServiceClient) Register(ctx context.Context, in *generated.RegisterRequest, opts ...grpc.CallOption) (*generated.RegisterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Register", varargs...)
+	ret0, _ := ret[0].(*generated.RegisterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockAuthServiceClientMockRecorder) Register(ctx, in any, opts ...any) *MockAuthServiceClientRegisterCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthServiceClient)(nil).Register), varargs...)
+	return &MockAuthServiceClientRegisterCall{Call: call}
+}
+
+// MockAuthServiceClientRegisterCall wrap *gomock.Call
+type MockAuthServiceClientRegisterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthServiceClientRegisterCall) Return(arg0 *generated.RegisterResponse, arg1 error) *MockAuthServiceClientRegisterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthServiceClientRegisterCall) Do(f func(context.Context, *generated.RegisterRequest, ...grpc.CallOption) (*generated.RegisterResponse, error)) *MockAuthServiceClientRegisterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthServiceClientRegisterCall) DoAndReturn(f func(context.Context, *generated.RegisterRequest, ...grpc.CallOption) (*generated.RegisterResponse, error)) *MockAuthServiceClientRegisterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdatePassword mocks base method.
+func (m *MockAuthServiceClient) UpdatePassword(ctx context.Context, in *generated.UpdatePasswordRequest, opts ...grpc.CallOption) (*generated.UpdatePasswordResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePassword", varargs...)
+	ret0, _ := ret[0].(*generated.UpdatePasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockAuthServiceClientMockRecorder) UpdatePassword(ctx, in any, opts ...any) *MockAuthServiceClientUpdatePasswordCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockAuthServiceClient)(nil).UpdatePassword), varargs...)
+	return &MockAuthServiceClientUpdatePasswordCall{Call: call}
+}
+
+// MockAuthServiceClientUpdatePasswordCall wrap *gomock.Call
+type MockAuthServiceClientUpdatePasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthServiceClientUpdatePasswordCall) Return(arg0 *generated.UpdatePasswordResponse, arg1 error) *MockAuthServiceClientUpdatePasswordCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthServiceClientUpdatePasswordCall) Do(f func(context.Context, *generated.UpdatePasswordRequest, ...grpc.CallOption) (*generated.UpdatePasswordResponse, error)) *MockAuthServiceClientUpdatePasswordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthServiceClientUpdatePasswordCall) DoAndReturn(f func(context.Context, *generated.UpdatePasswordRequest, ...grpc.CallOption) (*generated.UpdatePasswordResponse, error)) *MockAuthServiceClientUpdatePasswordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ValidateSession mocks base method.
+func (m *MockAuthServiceClient) ValidateSession(ctx context.Context, in *generated.ValidateSessionRequest, opts ...grpc.CallOption) (*generated.ValidateSessionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateSession", varargs...)
+	ret0, _ := ret[0].(*generated.ValidateSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateSession indicates an expected call of ValidateSession.
+func (mr *MockAuthServiceClientMockRecorder) ValidateSession(ctx, in any, opts ...any) *MockAuthServiceClientValidateSessionCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSession", reflect.TypeOf((*MockAuthServiceClient)(nil).ValidateSession), varargs...)
+	return &MockAuthServiceClientValidateSessionCall{Call: call}
+}
+
+// MockAuthServiceClientValidateSessionCall wrap *gomock.Call
+type MockAuthServiceClientValidateSessionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuthServiceClientValidateSessionCall) Return(arg0 *generated.ValidateSessionResponse, arg1 error) *MockAuthServiceClientValidateSessionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuthServiceClientValidateSessionCall) Do(f func(context.Context, *generated.ValidateSessionRequest, ...grpc.CallOption) (*generated.ValidateSessionResponse, error)) *MockAuthServiceClientValidateSessionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuthServiceClientValidateSessionCall) DoAndReturn(f func(context.Context, *generated.ValidateSessionRequest, ...grpc.CallOption) (*generated.ValidateSessionResponse, error)) *MockAuthServiceClientValidateSessionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
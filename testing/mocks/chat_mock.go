@@ -0,0 +1,395 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/chat/generated (interfaces: ChatServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/chat_mock.go github.com/FACorreiaa/loci-proto/modules/chat/generated ChatServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockChatServiceClient is a mock of ChatServiceClient interface.
+type MockChatServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockChatServiceClientMockRecorder is the mock recorder for MockChatServiceClient.
+type MockChatServiceClientMockRecorder struct {
+	mock *MockChatServiceClient
+}
+
+// NewMockChatServiceClient creates a new mock instance.
+func NewMockChatServiceClient(ctrl *gomock.Controller) *MockChatServiceClient {
+	mock := &MockChatServiceClient{ctrl: ctrl}
+	mock.recorder = &MockChatServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatServiceClient) EXPECT() *MockChatServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ContinueChatStream mocks base method.
+func (m *MockChatServiceClient) ContinueChatStream(ctx context.Context, in *generated.ContinueChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[generated.ChatEvent], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ContinueChatStream", varargs...)
+	ret0, _ := ret[0].(grpc.ServerStreamingClient[generated.ChatEvent])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContinueChatStream indicates an expected call of ContinueChatStream.
+func (mr *MockChatServiceClientMockRecorder) ContinueChatStream(ctx, in any, opts ...any) *MockChatServiceClientContinueChatStreamCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinueChatStream", reflect.TypeOf((*MockChatServiceClient)(nil).ContinueChatStream), varargs...)
+	return &MockChatServiceClientContinueChatStreamCall{Call: call}
+}
+
+// MockChatServiceClientContinueChatStreamCall wrap *gomock.Call
+type MockChatServiceClientContinueChatStreamCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChatServiceClientContinueChatStreamCall) Return(arg0 grpc.ServerStreamingClient[generated.ChatEvent], arg1 error) *MockChatServiceClientContinueChatStreamCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChatServiceClientContinueChatStreamCall) Do(f func(context.Context, *generated.ContinueChatRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[generated.ChatEvent], error)) *MockChatServiceClientContinueChatStreamCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChatServiceClientContinueChatStreamCall) DoAndReturn(f func(context.Context, *generated.ContinueChatRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[generated.ChatEvent], error)) *MockChatServiceClientContinueChatStreamCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FreeChatStream mocks base method.
+func (m *MockChatServiceClient) FreeChatStream(ctx context.Context, in *generated.FreeChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[generated.ChatEvent], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FreeChatStream", varargs...)
+	ret0, _ := ret[0].(grpc.ServerStreamingClient[generated.ChatEvent])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreeChatStream indicates an expected call of FreeChatStream.
+func (mr *MockChatServiceClientMockRecorder) FreeChatStream(ctx, in any, opts ...any) *MockChatServiceClientFreeChatStreamCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreeChatStream", reflect.TypeOf((*MockChatServiceClient)(nil).FreeChatStream), varargs...)
+	return &MockChatServiceClientFreeChatStreamCall{Call: call}
+}
+
+// MockChatServiceClientFreeChatStreamCall wrap *gomock.Call
+type MockChatServiceClientFreeChatStreamCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChatServiceClientFreeChatStreamCall) Return(arg0 grpc.ServerStreamingClient[generated.ChatEvent], arg1 error) *MockChatServiceClientFreeChatStreamCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChatServiceClientFreeChatStreamCall) Do(f func(context.Context, *generated.FreeChatRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[generated.ChatEvent], error)) *MockChatServiceClientFreeChatStreamCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChatServiceClientFreeChatStreamCall) DoAndReturn(f func(context.Context, *generated.FreeChatRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[generated.ChatEvent], error)) *MockChatServiceClientFreeChatStreamCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetChatSessions mocks base method.
+func (m *MockChatServiceClient) GetChatSessions(ctx context.Context, in *generated.GetChatSessionsRequest, opts ...grpc.CallOption) (*generated.GetChatSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatSessions", varargs...)
+	ret0, _ := ret[0].(*generated.GetChatSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatSessions indicates an expected call of GetChatSessions.
+func (mr *MockChatServiceClientMockRecorder) GetChatSessions(ctx, in any, opts ...any) *MockChatServiceClientGetChatSessionsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatSessions", reflect.TypeOf((*MockChatServiceClient)(nil).GetChatSessions), varargs...)
+	return &MockChatServiceClientGetChatSessionsCall{Call: call}
+}
+
+// MockChatServiceClientGetChatSessionsCall wrap *gomock.Call
+type MockChatServiceClientGetChatSessionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChatServiceClientGetChatSessionsCall) Return(arg0 *generated.GetChatSessionsResponse, arg1 error) *MockChatServiceClientGetChatSessionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChatServiceClientGetChatSessionsCall) Do(f func(context.Context, *generated.GetChatSessionsRequest, ...grpc.CallOption) (*generated.GetChatSessionsResponse, error)) *MockChatServiceClientGetChatSessionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChatServiceClientGetChatSessionsCall) DoAndReturn(f func(context.Context, *generated.GetChatSessionsRequest, ...grpc.CallOption) (*generated.GetChatSessionsResponse, error)) *MockChatServiceClientGetChatSessionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPOIDetails mocks base method.
+func (m *MockChatServiceClient) GetPOIDetails(ctx context.Context, in *generated.GetPOIDetailsRequest, opts ...grpc.CallOption) (*generated.GetPOIDetailsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPOIDetails", varargs...)
+	ret0, _ := ret[0].(*generated.GetPOIDetailsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPOIDetails indicates an expected call of GetPOIDetails.
+func (mr *MockChatServiceClientMockRecorder) GetPOIDetails(ctx, in any, opts ...any) *MockChatServiceClientGetPOIDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPOIDetails", reflect.TypeOf((*MockChatServiceClient)(nil).GetPOIDetails), varargs...)
+	return &MockChatServiceClientGetPOIDetailsCall{Call: call}
+}
+
+// MockChatServiceClientGetPOIDetailsCall wrap *gomock.Call
+type MockChatServiceClientGetPOIDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChatServiceClientGetPOIDetailsCall) Return(arg0 *generated.GetPOIDetailsResponse, arg1 error) *MockChatServiceClientGetPOIDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChatServiceClientGetPOIDetailsCall) Do(f func(context.Context, *generated.GetPOIDetailsRequest, ...grpc.CallOption) (*generated.GetPOIDetailsResponse, error)) *MockChatServiceClientGetPOIDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChatServiceClientGetPOIDetailsCall) DoAndReturn(f func(context.Context, *generated.GetPOIDetailsRequest, ...grpc.CallOption) (*generated.GetPOIDetailsResponse, error)) *MockChatServiceClientGetPOIDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSavedItineraries mocks base method.
+func (m *MockChatServiceClient) GetSavedItineraries(ctx context.Context, in *generated.GetSavedItinerariesRequest, opts ...grpc.CallOption) (*generated.GetSavedItinerariesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSavedItineraries", varargs...)
+	ret0, _ := ret[0].(*generated.GetSavedItinerariesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedItineraries indicates an expected call of GetSavedItineraries.
+func (mr *MockChatServiceClientMockRecorder) GetSavedItineraries(ctx, in any, opts ...any) *MockChatServiceClientGetSavedItinerariesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedItineraries", reflect.TypeOf((*MockChatServiceClient)(nil).GetSavedItineraries), varargs...)
+	return &MockChatServiceClientGetSavedItinerariesCall{Call: call}
+}
+
+// MockChatServiceClientGetSavedItinerariesCall wrap *gomock.Call
+type MockChatServiceClientGetSavedItinerariesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChatServiceClientGetSavedItinerariesCall) Return(arg0 *generated.GetSavedItinerariesResponse, arg1 error) *MockChatServiceClientGetSavedItinerariesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChatServiceClientGetSavedItinerariesCall) Do(f func(context.Context, *generated.GetSavedItinerariesRequest, ...grpc.CallOption) (*generated.GetSavedItinerariesResponse, error)) *MockChatServiceClientGetSavedItinerariesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChatServiceClientGetSavedItinerariesCall) DoAndReturn(f func(context.Context, *generated.GetSavedItinerariesRequest, ...grpc.CallOption) (*generated.GetSavedItinerariesResponse, error)) *MockChatServiceClientGetSavedItinerariesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveItinerary mocks base method.
+func (m *MockChatServiceClient) RemoveItinerary(ctx context.Context, in *generated.RemoveItineraryRequest, opts ...grpc.CallOption) (*generated.RemoveItineraryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveItinerary", varargs...)
+	ret0, _ := ret[0].(*generated.RemoveItineraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItinerary indicates an expected call of RemoveItinerary.
+func (mr *MockChatServiceClientMockRecorder) RemoveItinerary(ctx, in any, opts ...any) *MockChatServiceClientRemoveItineraryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItinerary", reflect.TypeOf((*MockChatServiceClient)(nil).RemoveItinerary), varargs...)
+	return &MockChatServiceClientRemoveItineraryCall{Call: call}
+}
+
+// MockChatServiceClientRemoveItineraryCall wrap *gomock.Call
+type MockChatServiceClientRemoveItineraryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChatServiceClientRemoveItineraryCall) Return(arg0 *generated.RemoveItineraryResponse, arg1 error) *MockChatServiceClientRemoveItineraryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChatServiceClientRemoveItineraryCall) Do(f func(context.Context, *generated.RemoveItineraryRequest, ...grpc.CallOption) (*generated.RemoveItineraryResponse, error)) *MockChatServiceClientRemoveItineraryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChatServiceClientRemoveItineraryCall) DoAndReturn(f func(context.Context, *generated.RemoveItineraryRequest, ...grpc.CallOption) (*generated.RemoveItineraryResponse, error)) *MockChatServiceClientRemoveItineraryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveItinerary mocks base method.
+func (m *MockChatServiceClient) SaveItinerary(ctx context.Context, in *generated.SaveItineraryRequest, opts ...grpc.CallOption) (*generated.SaveItineraryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveItinerary", varargs...)
+	ret0, _ := ret[0].(*generated.SaveItineraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveItinerary indicates an expected call of SaveItinerary.
+func (mr *MockChatServiceClientMockRecorder) SaveItinerary(ctx, in any, opts ...any) *MockChatServiceClientSaveItineraryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveItinerary", reflect.TypeOf((*MockChatServiceClient)(nil).SaveItinerary), varargs...)
+	return &MockChatServiceClientSaveItineraryCall{Call: call}
+}
+
+// MockChatServiceClientSaveItineraryCall wrap *gomock.Call
+type MockChatServiceClientSaveItineraryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChatServiceClientSaveItineraryCall) Return(arg0 *generated.SaveItineraryResponse, arg1 error) *MockChatServiceClientSaveItineraryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChatServiceClientSaveItineraryCall) Do(f func(context.Context, *generated.SaveItineraryRequest, ...grpc.CallOption) (*generated.SaveItineraryResponse, error)) *MockChatServiceClientSaveItineraryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChatServiceClientSaveItineraryCall) DoAndReturn(f func(context.Context, *generated.SaveItineraryRequest, ...grpc.CallOption) (*generated.SaveItineraryResponse, error)) *MockChatServiceClientSaveItineraryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StartChatStream mocks base method.
+func (m *MockChatServiceClient) StartChatStream(ctx context.Context, in *generated.StartChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[generated.ChatEvent], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartChatStream", varargs...)
+	ret0, _ := ret[0].(grpc.ServerStreamingClient[generated.ChatEvent])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartChatStream indicates an expected call of StartChatStream.
+func (mr *MockChatServiceClientMockRecorder) StartChatStream(ctx, in any, opts ...any) *MockChatServiceClientStartChatStreamCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartChatStream", reflect.TypeOf((*MockChatServiceClient)(nil).StartChatStream), varargs...)
+	return &MockChatServiceClientStartChatStreamCall{Call: call}
+}
+
+// MockChatServiceClientStartChatStreamCall wrap *gomock.Call
+type MockChatServiceClientStartChatStreamCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChatServiceClientStartChatStreamCall) Return(arg0 grpc.ServerStreamingClient[generated.ChatEvent], arg1 error) *MockChatServiceClientStartChatStreamCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChatServiceClientStartChatStreamCall) Do(f func(context.Context, *generated.StartChatRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[generated.ChatEvent], error)) *MockChatServiceClientStartChatStreamCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChatServiceClientStartChatStreamCall) DoAndReturn(f func(context.Context, *generated.StartChatRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[generated.ChatEvent], error)) *MockChatServiceClientStartChatStreamCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
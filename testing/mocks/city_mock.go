@@ -0,0 +1,219 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/city/generated (interfaces: CityServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/city_mock.go github.com/FACorreiaa/loci-proto/modules/city/generated CityServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/city/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockCityServiceClient is a mock of CityServiceClient interface.
+type MockCityServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockCityServiceClientMockRecorder is the mock recorder for MockCityServiceClient.
+type MockCityServiceClientMockRecorder struct {
+	mock *MockCityServiceClient
+}
+
+// NewMockCityServiceClient creates a new mock instance.
+func NewMockCityServiceClient(ctrl *gomock.Controller) *MockCityServiceClient {
+	mock := &MockCityServiceClient{ctrl: ctrl}
+	mock.recorder = &MockCityServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCityServiceClient) EXPECT() *MockCityServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetCities mocks base method.
+func (m *MockCityServiceClient) GetCities(ctx context.Context, in *generated.GetCitiesRequest, opts ...grpc.CallOption) (*generated.GetCitiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCities", varargs...)
+	ret0, _ := ret[0].(*generated.GetCitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCities indicates an expected call of GetCities.
+func (mr *MockCityServiceClientMockRecorder) GetCities(ctx, in any, opts ...any) *MockCityServiceClientGetCitiesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCities", reflect.TypeOf((*MockCityServiceClient)(nil).GetCities), varargs...)
+	return &MockCityServiceClientGetCitiesCall{Call: call}
+}
+
+// MockCityServiceClientGetCitiesCall wrap *gomock.Call
+type MockCityServiceClientGetCitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCityServiceClientGetCitiesCall) Return(arg0 *generated.GetCitiesResponse, arg1 error) *MockCityServiceClientGetCitiesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCityServiceClientGetCitiesCall) Do(f func(context.Context, *generated.GetCitiesRequest, ...grpc.CallOption) (*generated.GetCitiesResponse, error)) *MockCityServiceClientGetCitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCityServiceClientGetCitiesCall) DoAndReturn(f func(context.Context, *generated.GetCitiesRequest, ...grpc.CallOption) (*generated.GetCitiesResponse, error)) *MockCityServiceClientGetCitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCity mocks base method.
+func (m *MockCityServiceClient) GetCity(ctx context.Context, in *generated.GetCityRequest, opts ...grpc.CallOption) (*generated.GetCityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCity", varargs...)
+	ret0, _ := ret[0].(*generated.GetCityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCity indicates an expected call of GetCity.
+func (mr *MockCityServiceClientMockRecorder) GetCity(ctx, in any, opts ...any) *MockCityServiceClientGetCityCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCity", reflect.TypeOf((*MockCityServiceClient)(nil).GetCity), varargs...)
+	return &MockCityServiceClientGetCityCall{Call: call}
+}
+
+// MockCityServiceClientGetCityCall wrap *gomock.Call
+type MockCityServiceClientGetCityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCityServiceClientGetCityCall) Return(arg0 *generated.GetCityResponse, arg1 error) *MockCityServiceClientGetCityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCityServiceClientGetCityCall) Do(f func(context.Context, *generated.GetCityRequest, ...grpc.CallOption) (*generated.GetCityResponse, error)) *MockCityServiceClientGetCityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCityServiceClientGetCityCall) DoAndReturn(f func(context.Context, *generated.GetCityRequest, ...grpc.CallOption) (*generated.GetCityResponse, error)) *MockCityServiceClientGetCityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCityStatistics mocks base method.
+func (m *MockCityServiceClient) GetCityStatistics(ctx context.Context, in *generated.GetCityStatisticsRequest, opts ...grpc.CallOption) (*generated.GetCityStatisticsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCityStatistics", varargs...)
+	ret0, _ := ret[0].(*generated.GetCityStatisticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCityStatistics indicates an expected call of GetCityStatistics.
+func (mr *MockCityServiceClientMockRecorder) GetCityStatistics(ctx, in any, opts ...any) *MockCityServiceClientGetCityStatisticsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCityStatistics", reflect.TypeOf((*MockCityServiceClient)(nil).GetCityStatistics), varargs...)
+	return &MockCityServiceClientGetCityStatisticsCall{Call: call}
+}
+
+// MockCityServiceClientGetCityStatisticsCall wrap *gomock.Call
+type MockCityServiceClientGetCityStatisticsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCityServiceClientGetCityStatisticsCall) Return(arg0 *generated.GetCityStatisticsResponse, arg1 error) *MockCityServiceClientGetCityStatisticsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCityServiceClientGetCityStatisticsCall) Do(f func(context.Context, *generated.GetCityStatisticsRequest, ...grpc.CallOption) (*generated.GetCityStatisticsResponse, error)) *MockCityServiceClientGetCityStatisticsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCityServiceClientGetCityStatisticsCall) DoAndReturn(f func(context.Context, *generated.GetCityStatisticsRequest, ...grpc.CallOption) (*generated.GetCityStatisticsResponse, error)) *MockCityServiceClientGetCityStatisticsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchCities mocks base method.
+func (m *MockCityServiceClient) SearchCities(ctx context.Context, in *generated.SearchCitiesRequest, opts ...grpc.CallOption) (*generated.SearchCitiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchCities", varargs...)
+	ret0, _ := ret[0].(*generated.SearchCitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCities indicates an expected call of SearchCities.
+func (mr *MockCityServiceClientMockRecorder) SearchCities(ctx, in any, opts ...any) *MockCityServiceClientSearchCitiesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCities", reflect.TypeOf((*MockCityServiceClient)(nil).SearchCities), varargs...)
+	return &MockCityServiceClientSearchCitiesCall{Call: call}
+}
+
+// MockCityServiceClientSearchCitiesCall wrap *gomock.Call
+type MockCityServiceClientSearchCitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCityServiceClientSearchCitiesCall) Return(arg0 *generated.SearchCitiesResponse, arg1 error) *MockCityServiceClientSearchCitiesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCityServiceClientSearchCitiesCall) Do(f func(context.Context, *generated.SearchCitiesRequest, ...grpc.CallOption) (*generated.SearchCitiesResponse, error)) *MockCityServiceClientSearchCitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCityServiceClientSearchCitiesCall) DoAndReturn(f func(context.Context, *generated.SearchCitiesRequest, ...grpc.CallOption) (*generated.SearchCitiesResponse, error)) *MockCityServiceClientSearchCitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
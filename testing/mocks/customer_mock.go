@@ -0,0 +1,219 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/customer/generated (interfaces: CustomerClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/customer_mock.go github.com/FACorreiaa/loci-proto/modules/customer/generated CustomerClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/customer/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockCustomerClient is a mock of CustomerClient interface.
+type MockCustomerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerClientMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomerClientMockRecorder is the mock recorder for MockCustomerClient.
+type MockCustomerClientMockRecorder struct {
+	mock *MockCustomerClient
+}
+
+// NewMockCustomerClient creates a new mock instance.
+func NewMockCustomerClient(ctrl *gomock.Controller) *MockCustomerClient {
+	mock := &MockCustomerClient{ctrl: ctrl}
+	mock.recorder = &MockCustomerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerClient) EXPECT() *MockCustomerClientMockRecorder {
+	return m.recorder
+}
+
+// CreateCustomer mocks base method.
+func (m *MockCustomerClient) CreateCustomer(ctx context.Context, in *generated.CreateCustomerReq, opts ...grpc.CallOption) (*generated.CreateCustomerRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCustomer", varargs...)
+	ret0, _ := ret[0].(*generated.CreateCustomerRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockCustomerClientMockRecorder) CreateCustomer(ctx, in any, opts ...any) *MockCustomerClientCreateCustomerCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockCustomerClient)(nil).CreateCustomer), varargs...)
+	return &MockCustomerClientCreateCustomerCall{Call: call}
+}
+
+// MockCustomerClientCreateCustomerCall wrap *gomock.Call
+type MockCustomerClientCreateCustomerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCustomerClientCreateCustomerCall) Return(arg0 *generated.CreateCustomerRes, arg1 error) *MockCustomerClientCreateCustomerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCustomerClientCreateCustomerCall) Do(f func(context.Context, *generated.CreateCustomerReq, ...grpc.CallOption) (*generated.CreateCustomerRes, error)) *MockCustomerClientCreateCustomerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCustomerClientCreateCustomerCall) DoAndReturn(f func(context.Context, *generated.CreateCustomerReq, ...grpc.CallOption) (*generated.CreateCustomerRes, error)) *MockCustomerClientCreateCustomerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCustomer mocks base method.
+func (m *MockCustomerClient) DeleteCustomer(ctx context.Context, in *generated.DeleteCustomerReq, opts ...grpc.CallOption) (*generated.NilRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCustomer", varargs...)
+	ret0, _ := ret[0].(*generated.NilRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomer indicates an expected call of DeleteCustomer.
+func (mr *MockCustomerClientMockRecorder) DeleteCustomer(ctx, in any, opts ...any) *MockCustomerClientDeleteCustomerCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomer", reflect.TypeOf((*MockCustomerClient)(nil).DeleteCustomer), varargs...)
+	return &MockCustomerClientDeleteCustomerCall{Call: call}
+}
+
+// MockCustomerClientDeleteCustomerCall wrap *gomock.Call
+type MockCustomerClientDeleteCustomerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCustomerClientDeleteCustomerCall) Return(arg0 *generated.NilRes, arg1 error) *MockCustomerClientDeleteCustomerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCustomerClientDeleteCustomerCall) Do(f func(context.Context, *generated.DeleteCustomerReq, ...grpc.CallOption) (*generated.NilRes, error)) *MockCustomerClientDeleteCustomerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCustomerClientDeleteCustomerCall) DoAndReturn(f func(context.Context, *generated.DeleteCustomerReq, ...grpc.CallOption) (*generated.NilRes, error)) *MockCustomerClientDeleteCustomerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCustomer mocks base method.
+func (m *MockCustomerClient) GetCustomer(ctx context.Context, in *generated.GetCustomerReq, opts ...grpc.CallOption) (*generated.GetCustomerRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCustomer", varargs...)
+	ret0, _ := ret[0].(*generated.GetCustomerRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomer indicates an expected call of GetCustomer.
+func (mr *MockCustomerClientMockRecorder) GetCustomer(ctx, in any, opts ...any) *MockCustomerClientGetCustomerCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomer", reflect.TypeOf((*MockCustomerClient)(nil).GetCustomer), varargs...)
+	return &MockCustomerClientGetCustomerCall{Call: call}
+}
+
+// MockCustomerClientGetCustomerCall wrap *gomock.Call
+type MockCustomerClientGetCustomerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCustomerClientGetCustomerCall) Return(arg0 *generated.GetCustomerRes, arg1 error) *MockCustomerClientGetCustomerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCustomerClientGetCustomerCall) Do(f func(context.Context, *generated.GetCustomerReq, ...grpc.CallOption) (*generated.GetCustomerRes, error)) *MockCustomerClientGetCustomerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCustomerClientGetCustomerCall) DoAndReturn(f func(context.Context, *generated.GetCustomerReq, ...grpc.CallOption) (*generated.GetCustomerRes, error)) *MockCustomerClientGetCustomerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateCustomer mocks base method.
+func (m *MockCustomerClient) UpdateCustomer(ctx context.Context, in *generated.UpdateCustomerReq, opts ...grpc.CallOption) (*generated.UpdateCustomerRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCustomer", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateCustomerRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomer indicates an expected call of UpdateCustomer.
+func (mr *MockCustomerClientMockRecorder) UpdateCustomer(ctx, in any, opts ...any) *MockCustomerClientUpdateCustomerCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomer", reflect.TypeOf((*MockCustomerClient)(nil).UpdateCustomer), varargs...)
+	return &MockCustomerClientUpdateCustomerCall{Call: call}
+}
+
+// MockCustomerClientUpdateCustomerCall wrap *gomock.Call
+type MockCustomerClientUpdateCustomerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCustomerClientUpdateCustomerCall) Return(arg0 *generated.UpdateCustomerRes, arg1 error) *MockCustomerClientUpdateCustomerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCustomerClientUpdateCustomerCall) Do(f func(context.Context, *generated.UpdateCustomerReq, ...grpc.CallOption) (*generated.UpdateCustomerRes, error)) *MockCustomerClientUpdateCustomerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCustomerClientUpdateCustomerCall) DoAndReturn(f func(context.Context, *generated.UpdateCustomerReq, ...grpc.CallOption) (*generated.UpdateCustomerRes, error)) *MockCustomerClientUpdateCustomerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
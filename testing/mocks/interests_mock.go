@@ -0,0 +1,219 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/interests/generated (interfaces: InterestsServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/interests_mock.go github.com/FACorreiaa/loci-proto/modules/interests/generated InterestsServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/interests/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockInterestsServiceClient is a mock of InterestsServiceClient interface.
+type MockInterestsServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterestsServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockInterestsServiceClientMockRecorder is the mock recorder for MockInterestsServiceClient.
+type MockInterestsServiceClientMockRecorder struct {
+	mock *MockInterestsServiceClient
+}
+
+// NewMockInterestsServiceClient creates a new mock instance.
+func NewMockInterestsServiceClient(ctrl *gomock.Controller) *MockInterestsServiceClient {
+	mock := &MockInterestsServiceClient{ctrl: ctrl}
+	mock.recorder = &MockInterestsServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterestsServiceClient) EXPECT() *MockInterestsServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateInterest mocks base method.
+func (m *MockInterestsServiceClient) CreateInterest(ctx context.Context, in *generated.CreateInterestRequest, opts ...grpc.CallOption) (*generated.CreateInterestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateInterest", varargs...)
+	ret0, _ := ret[0].(*generated.CreateInterestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterest indicates an expected call of CreateInterest.
+func (mr *MockInterestsServiceClientMockRecorder) CreateInterest(ctx, in any, opts ...any) *MockInterestsServiceClientCreateInterestCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterest", reflect.TypeOf((*MockInterestsServiceClient)(nil).CreateInterest), varargs...)
+	return &MockInterestsServiceClientCreateInterestCall{Call: call}
+}
+
+// MockInterestsServiceClientCreateInterestCall wrap *gomock.Call
+type MockInterestsServiceClientCreateInterestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterestsServiceClientCreateInterestCall) Return(arg0 *generated.CreateInterestResponse, arg1 error) *MockInterestsServiceClientCreateInterestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterestsServiceClientCreateInterestCall) Do(f func(context.Context, *generated.CreateInterestRequest, ...grpc.CallOption) (*generated.CreateInterestResponse, error)) *MockInterestsServiceClientCreateInterestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterestsServiceClientCreateInterestCall) DoAndReturn(f func(context.Context, *generated.CreateInterestRequest, ...grpc.CallOption) (*generated.CreateInterestResponse, error)) *MockInterestsServiceClientCreateInterestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllInterests mocks base method.
+func (m *MockInterestsServiceClient) GetAllInterests(ctx context.Context, in *generated.GetAllInterestsRequest, opts ...grpc.CallOption) (*generated.GetAllInterestsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAllInterests", varargs...)
+	ret0, _ := ret[0].(*generated.GetAllInterestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllInterests indicates an expected call of GetAllInterests.
+func (mr *MockInterestsServiceClientMockRecorder) GetAllInterests(ctx, in any, opts ...any) *MockInterestsServiceClientGetAllInterestsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllInterests", reflect.TypeOf((*MockInterestsServiceClient)(nil).GetAllInterests), varargs...)
+	return &MockInterestsServiceClientGetAllInterestsCall{Call: call}
+}
+
+// MockInterestsServiceClientGetAllInterestsCall wrap *gomock.Call
+type MockInterestsServiceClientGetAllInterestsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterestsServiceClientGetAllInterestsCall) Return(arg0 *generated.GetAllInterestsResponse, arg1 error) *MockInterestsServiceClientGetAllInterestsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterestsServiceClientGetAllInterestsCall) Do(f func(context.Context, *generated.GetAllInterestsRequest, ...grpc.CallOption) (*generated.GetAllInterestsResponse, error)) *MockInterestsServiceClientGetAllInterestsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterestsServiceClientGetAllInterestsCall) DoAndReturn(f func(context.Context, *generated.GetAllInterestsRequest, ...grpc.CallOption) (*generated.GetAllInterestsResponse, error)) *MockInterestsServiceClientGetAllInterestsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveInterest mocks base method.
+func (m *MockInterestsServiceClient) RemoveInterest(ctx context.Context, in *generated.RemoveInterestRequest, opts ...grpc.CallOption) (*generated.RemoveInterestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveInterest", varargs...)
+	ret0, _ := ret[0].(*generated.RemoveInterestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveInterest indicates an expected call of RemoveInterest.
+func (mr *MockInterestsServiceClientMockRecorder) RemoveInterest(ctx, in any, opts ...any) *MockInterestsServiceClientRemoveInterestCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveInterest", reflect.TypeOf((*MockInterestsServiceClient)(nil).RemoveInterest), varargs...)
+	return &MockInterestsServiceClientRemoveInterestCall{Call: call}
+}
+
+// MockInterestsServiceClientRemoveInterestCall wrap *gomock.Call
+type MockInterestsServiceClientRemoveInterestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterestsServiceClientRemoveInterestCall) Return(arg0 *generated.RemoveInterestResponse, arg1 error) *MockInterestsServiceClientRemoveInterestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterestsServiceClientRemoveInterestCall) Do(f func(context.Context, *generated.RemoveInterestRequest, ...grpc.CallOption) (*generated.RemoveInterestResponse, error)) *MockInterestsServiceClientRemoveInterestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterestsServiceClientRemoveInterestCall) DoAndReturn(f func(context.Context, *generated.RemoveInterestRequest, ...grpc.CallOption) (*generated.RemoveInterestResponse, error)) *MockInterestsServiceClientRemoveInterestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateInterest mocks base method.
+func (m *MockInterestsServiceClient) UpdateInterest(ctx context.Context, in *generated.UpdateInterestRequest, opts ...grpc.CallOption) (*generated.UpdateInterestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateInterest", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateInterestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInterest indicates an expected call of UpdateInterest.
+func (mr *MockInterestsServiceClientMockRecorder) UpdateInterest(ctx, in any, opts ...any) *MockInterestsServiceClientUpdateInterestCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInterest", reflect.TypeOf((*MockInterestsServiceClient)(nil).UpdateInterest), varargs...)
+	return &MockInterestsServiceClientUpdateInterestCall{Call: call}
+}
+
+// MockInterestsServiceClientUpdateInterestCall wrap *gomock.Call
+type MockInterestsServiceClientUpdateInterestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterestsServiceClientUpdateInterestCall) Return(arg0 *generated.UpdateInterestResponse, arg1 error) *MockInterestsServiceClientUpdateInterestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterestsServiceClientUpdateInterestCall) Do(f func(context.Context, *generated.UpdateInterestRequest, ...grpc.CallOption) (*generated.UpdateInterestResponse, error)) *MockInterestsServiceClientUpdateInterestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterestsServiceClientUpdateInterestCall) DoAndReturn(f func(context.Context, *generated.UpdateInterestRequest, ...grpc.CallOption) (*generated.UpdateInterestResponse, error)) *MockInterestsServiceClientUpdateInterestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
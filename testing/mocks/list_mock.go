@@ -0,0 +1,791 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/list/generated (interfaces: ListServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/list_mock.go github.com/FACorreiaa/loci-proto/modules/list/generated ListServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/list/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockListServiceClient is a mock of ListServiceClient interface.
+type MockListServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockListServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockListServiceClientMockRecorder is the mock recorder for MockListServiceClient.
+type MockListServiceClientMockRecorder struct {
+	mock *MockListServiceClient
+}
+
+// NewMockListServiceClient creates a new mock instance.
+func NewMockListServiceClient(ctrl *gomock.Controller) *MockListServiceClient {
+	mock := &MockListServiceClient{ctrl: ctrl}
+	mock.recorder = &MockListServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListServiceClient) EXPECT() *MockListServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddListItem mocks base method.
+func (m *MockListServiceClient) AddListItem(ctx context.Context, in *generated.AddListItemRequest, opts ...grpc.CallOption) (*generated.AddListItemResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddListItem", varargs...)
+	ret0, _ := ret[0].(*generated.AddListItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddListItem indicates an expected call of AddListItem.
+func (mr *MockListServiceClientMockRecorder) AddListItem(ctx, in any, opts ...any) *MockListServiceClientAddListItemCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddListItem", reflect.TypeOf((*MockListServiceClient)(nil).AddListItem), varargs...)
+	return &MockListServiceClientAddListItemCall{Call: call}
+}
+
+// MockListServiceClientAddListItemCall wrap *gomock.Call
+type MockListServiceClientAddListItemCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientAddListItemCall) Return(arg0 *generated.AddListItemResponse, arg1 error) *MockListServiceClientAddListItemCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientAddListItemCall) Do(f func(context.Context, *generated.AddListItemRequest, ...grpc.CallOption) (*generated.AddListItemResponse, error)) *MockListServiceClientAddListItemCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientAddListItemCall) DoAndReturn(f func(context.Context, *generated.AddListItemRequest, ...grpc.CallOption) (*generated.AddListItemResponse, error)) *MockListServiceClientAddListItemCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateItinerary mocks base method.
+func (m *MockListServiceClient) CreateItinerary(ctx context.Context, in *generated.CreateItineraryRequest, opts ...grpc.CallOption) (*generated.CreateItineraryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateItinerary", varargs...)
+	ret0, _ := ret[0].(*generated.CreateItineraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateItinerary indicates an expected call of CreateItinerary.
+func (mr *MockListServiceClientMockRecorder) CreateItinerary(ctx, in any, opts ...any) *MockListServiceClientCreateItineraryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItinerary", reflect.TypeOf((*MockListServiceClient)(nil).CreateItinerary), varargs...)
+	return &MockListServiceClientCreateItineraryCall{Call: call}
+}
+
+// MockListServiceClientCreateItineraryCall wrap *gomock.Call
+type MockListServiceClientCreateItineraryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientCreateItineraryCall) Return(arg0 *generated.CreateItineraryResponse, arg1 error) *MockListServiceClientCreateItineraryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientCreateItineraryCall) Do(f func(context.Context, *generated.CreateItineraryRequest, ...grpc.CallOption) (*generated.CreateItineraryResponse, error)) *MockListServiceClientCreateItineraryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientCreateItineraryCall) DoAndReturn(f func(context.Context, *generated.CreateItineraryRequest, ...grpc.CallOption) (*generated.CreateItineraryResponse, error)) *MockListServiceClientCreateItineraryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateList mocks base method.
+func (m *MockListServiceClient) CreateList(ctx context.Context, in *generated.CreateListRequest, opts ...grpc.CallOption) (*generated.CreateListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateList", varargs...)
+	ret0, _ := ret[0].(*generated.CreateListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockListServiceClientMockRecorder) CreateList(ctx, in any, opts ...any) *MockListServiceClientCreateListCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockListServiceClient)(nil).CreateList), varargs...)
+	return &MockListServiceClientCreateListCall{Call: call}
+}
+
+// MockListServiceClientCreateListCall wrap *gomock.Call
+type MockListServiceClientCreateListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientCreateListCall) Return(arg0 *generated.CreateListResponse, arg1 error) *MockListServiceClientCreateListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientCreateListCall) Do(f func(context.Context, *generated.CreateListRequest, ...grpc.CallOption) (*generated.CreateListResponse, error)) *MockListServiceClientCreateListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientCreateListCall) DoAndReturn(f func(context.Context, *generated.CreateListRequest, ...grpc.CallOption) (*generated.CreateListResponse, error)) *MockListServiceClientCreateListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteList mocks base method.
+func (m *MockListServiceClient) DeleteList(ctx context.Context, in *generated.DeleteListRequest, opts ...grpc.CallOption) (*generated.DeleteListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteList", varargs...)
+	ret0, _ := ret[0].(*generated.DeleteListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteList indicates an expected call of DeleteList.
+func (mr *MockListServiceClientMockRecorder) DeleteList(ctx, in any, opts ...any) *MockListServiceClientDeleteListCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteList", reflect.TypeOf((*MockListServiceClient)(nil).DeleteList), varargs...)
+	return &MockListServiceClientDeleteListCall{Call: call}
+}
+
+// MockListServiceClientDeleteListCall wrap *gomock.Call
+type MockListServiceClientDeleteListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientDeleteListCall) Return(arg0 *generated.DeleteListResponse, arg1 error) *MockListServiceClientDeleteListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientDeleteListCall) Do(f func(context.Context, *generated.DeleteListRequest, ...grpc.CallOption) (*generated.DeleteListResponse, error)) *MockListServiceClientDeleteListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientDeleteListCall) DoAndReturn(f func(context.Context, *generated.DeleteListRequest, ...grpc.CallOption) (*generated.DeleteListResponse, error)) *MockListServiceClientDeleteListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetList mocks base method.
+func (m *MockListServiceClient) GetList(ctx context.Context, in *generated.GetListRequest, opts ...grpc.CallOption) (*generated.GetListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetList", varargs...)
+	ret0, _ := ret[0].(*generated.GetListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockListServiceClientMockRecorder) GetList(ctx, in any, opts ...any) *MockListServiceClientGetListCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockListServiceClient)(nil).GetList), varargs...)
+	return &MockListServiceClientGetListCall{Call: call}
+}
+
+// MockListServiceClientGetListCall wrap *gomock.Call
+type MockListServiceClientGetListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientGetListCall) Return(arg0 *generated.GetListResponse, arg1 error) *MockListServiceClientGetListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientGetListCall) Do(f func(context.Context, *generated.GetListRequest, ...grpc.CallOption) (*generated.GetListResponse, error)) *MockListServiceClientGetListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientGetListCall) DoAndReturn(f func(context.Context, *generated.GetListRequest, ...grpc.CallOption) (*generated.GetListResponse, error)) *MockListServiceClientGetListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetListHotels mocks base method.
+func (m *MockListServiceClient) GetListHotels(ctx context.Context, in *generated.GetListHotelsRequest, opts ...grpc.CallOption) (*generated.GetListHotelsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetListHotels", varargs...)
+	ret0, _ := ret[0].(*generated.GetListHotelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListHotels indicates an expected call of GetListHotels.
+func (mr *MockListServiceClientMockRecorder) GetListHotels(ctx, in any, opts ...any) *MockListServiceClientGetListHotelsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListHotels", reflect.TypeOf((*MockListServiceClient)(nil).GetListHotels), varargs...)
+	return &MockListServiceClientGetListHotelsCall{Call: call}
+}
+
+// MockListServiceClientGetListHotelsCall wrap *gomock.Call
+type MockListServiceClientGetListHotelsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientGetListHotelsCall) Return(arg0 *generated.GetListHotelsResponse, arg1 error) *MockListServiceClientGetListHotelsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientGetListHotelsCall) Do(f func(context.Context, *generated.GetListHotelsRequest, ...grpc.CallOption) (*generated.GetListHotelsResponse, error)) *MockListServiceClientGetListHotelsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientGetListHotelsCall) DoAndReturn(f func(context.Context, *generated.GetListHotelsRequest, ...grpc.CallOption) (*generated.GetListHotelsResponse, error)) *MockListServiceClientGetListHotelsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetListItems mocks base method.
+func (m *MockListServiceClient) GetListItems(ctx context.Context, in *generated.GetListItemsRequest, opts ...grpc.CallOption) (*generated.GetListItemsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetListItems", varargs...)
+	ret0, _ := ret[0].(*generated.GetListItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListItems indicates an expected call of GetListItems.
+func (mr *MockListServiceClientMockRecorder) GetListItems(ctx, in any, opts ...any) *MockListServiceClientGetListItemsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListItems", reflect.TypeOf((*MockListServiceClient)(nil).GetListItems), varargs...)
+	return &MockListServiceClientGetListItemsCall{Call: call}
+}
+
+// MockListServiceClientGetListItemsCall wrap *gomock.Call
+type MockListServiceClientGetListItemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientGetListItemsCall) Return(arg0 *generated.GetListItemsResponse, arg1 error) *MockListServiceClientGetListItemsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientGetListItemsCall) Do(f func(context.Context, *generated.GetListItemsRequest, ...grpc.CallOption) (*generated.GetListItemsResponse, error)) *MockListServiceClientGetListItemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientGetListItemsCall) DoAndReturn(f func(context.Context, *generated.GetListItemsRequest, ...grpc.CallOption) (*generated.GetListItemsResponse, error)) *MockListServiceClientGetListItemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetListItineraries mocks base method.
+func (m *MockListServiceClient) GetListItineraries(ctx context.Context, in *generated.GetListItinerariesRequest, opts ...grpc.CallOption) (*generated.GetListItinerariesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetListItineraries", varargs...)
+	ret0, _ := ret[0].(*generated.GetListItinerariesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListItineraries indicates an expected call of GetListItineraries.
+func (mr *MockListServiceClientMockRecorder) GetListItineraries(ctx, in any, opts ...any) *MockListServiceClientGetListItinerariesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListItineraries", reflect.TypeOf((*MockListServiceClient)(nil).GetListItineraries), varargs...)
+	return &MockListServiceClientGetListItinerariesCall{Call: call}
+}
+
+// MockListServiceClientGetListItinerariesCall wrap *gomock.Call
+type MockListServiceClientGetListItinerariesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientGetListItinerariesCall) Return(arg0 *generated.GetListItinerariesResponse, arg1 error) *MockListServiceClientGetListItinerariesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientGetListItinerariesCall) Do(f func(context.Context, *generated.GetListItinerariesRequest, ...grpc.CallOption) (*generated.GetListItinerariesResponse, error)) *MockListServiceClientGetListItinerariesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientGetListItinerariesCall) DoAndReturn(f func(context.Context, *generated.GetListItinerariesRequest, ...grpc.CallOption) (*generated.GetListItinerariesResponse, error)) *MockListServiceClientGetListItinerariesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetListRestaurants mocks base method.
+func (m *MockListServiceClient) GetListRestaurants(ctx context.Context, in *generated.GetListRestaurantsRequest, opts ...grpc.CallOption) (*generated.GetListRestaurantsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetListRestaurants", varargs...)
+	ret0, _ := ret[0].(*generated.GetListRestaurantsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListRestaurants indicates an expected call of GetListRestaurants.
+func (mr *MockListServiceClientMockRecorder) GetListRestaurants(ctx, in any, opts ...any) *MockListServiceClientGetListRestaurantsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListRestaurants", reflect.TypeOf((*MockListServiceClient)(nil).GetListRestaurants), varargs...)
+	return &MockListServiceClientGetListRestaurantsCall{Call: call}
+}
+
+// MockListServiceClientGetListRestaurantsCall wrap *gomock.Call
+type MockListServiceClientGetListRestaurantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientGetListRestaurantsCall) Return(arg0 *generated.GetListRestaurantsResponse, arg1 error) *MockListServiceClientGetListRestaurantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientGetListRestaurantsCall) Do(f func(context.Context, *generated.GetListRestaurantsRequest, ...grpc.CallOption) (*generated.GetListRestaurantsResponse, error)) *MockListServiceClientGetListRestaurantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientGetListRestaurantsCall) DoAndReturn(f func(context.Context, *generated.GetListRestaurantsRequest, ...grpc.CallOption) (*generated.GetListRestaurantsResponse, error)) *MockListServiceClientGetListRestaurantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetLists mocks base method.
+func (m *MockListServiceClient) GetLists(ctx context.Context, in *generated.GetListsRequest, opts ...grpc.CallOption) (*generated.GetListsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLists", varargs...)
+	ret0, _ := ret[0].(*generated.GetListsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLists indicates an expected call of GetLists.
+func (mr *MockListServiceClientMockRecorder) GetLists(ctx, in any, opts ...any) *MockListServiceClientGetListsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLists", reflect.TypeOf((*MockListServiceClient)(nil).GetLists), varargs...)
+	return &MockListServiceClientGetListsCall{Call: call}
+}
+
+// MockListServiceClientGetListsCall wrap *gomock.Call
+type MockListServiceClientGetListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientGetListsCall) Return(arg0 *generated.GetListsResponse, arg1 error) *MockListServiceClientGetListsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientGetListsCall) Do(f func(context.Context, *generated.GetListsRequest, ...grpc.CallOption) (*generated.GetListsResponse, error)) *MockListServiceClientGetListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientGetListsCall) DoAndReturn(f func(context.Context, *generated.GetListsRequest, ...grpc.CallOption) (*generated.GetListsResponse, error)) *MockListServiceClientGetListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSavedLists mocks base method.
+func (m *MockListServiceClient) GetSavedLists(ctx context.Context, in *generated.GetSavedListsRequest, opts ...grpc.CallOption) (*generated.GetSavedListsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSavedLists", varargs...)
+	ret0, _ := ret[0].(*generated.GetSavedListsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedLists indicates an expected call of GetSavedLists.
+func (mr *MockListServiceClientMockRecorder) GetSavedLists(ctx, in any, opts ...any) *MockListServiceClientGetSavedListsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedLists", reflect.TypeOf((*MockListServiceClient)(nil).GetSavedLists), varargs...)
+	return &MockListServiceClientGetSavedListsCall{Call: call}
+}
+
+// MockListServiceClientGetSavedListsCall wrap *gomock.Call
+type MockListServiceClientGetSavedListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientGetSavedListsCall) Return(arg0 *generated.GetSavedListsResponse, arg1 error) *MockListServiceClientGetSavedListsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientGetSavedListsCall) Do(f func(context.Context, *generated.GetSavedListsRequest, ...grpc.CallOption) (*generated.GetSavedListsResponse, error)) *MockListServiceClientGetSavedListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientGetSavedListsCall) DoAndReturn(f func(context.Context, *generated.GetSavedListsRequest, ...grpc.CallOption) (*generated.GetSavedListsResponse, error)) *MockListServiceClientGetSavedListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveListItem mocks base method.
+func (m *MockListServiceClient) RemoveListItem(ctx context.Context, in *generated.RemoveListItemRequest, opts ...grpc.CallOption) (*generated.RemoveListItemResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveListItem", varargs...)
+	ret0, _ := ret[0].(*generated.RemoveListItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveListItem indicates an expected call of RemoveListItem.
+func (mr *MockListServiceClientMockRecorder) RemoveListItem(ctx, in any, opts ...any) *MockListServiceClientRemoveListItemCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveListItem", reflect.TypeOf((*MockListServiceClient)(nil).RemoveListItem), varargs...)
+	return &MockListServiceClientRemoveListItemCall{Call: call}
+}
+
+// MockListServiceClientRemoveListItemCall wrap *gomock.Call
+type MockListServiceClientRemoveListItemCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientRemoveListItemCall) Return(arg0 *generated.RemoveListItemResponse, arg1 error) *MockListServiceClientRemoveListItemCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientRemoveListItemCall) Do(f func(context.Context, *generated.RemoveListItemRequest, ...grpc.CallOption) (*generated.RemoveListItemResponse, error)) *MockListServiceClientRemoveListItemCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientRemoveListItemCall) DoAndReturn(f func(context.Context, *generated.RemoveListItemRequest, ...grpc.CallOption) (*generated.RemoveListItemResponse, error)) *MockListServiceClientRemoveListItemCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SavePublicList mocks base method.
+func (m *MockListServiceClient) SavePublicList(ctx context.Context, in *generated.SavePublicListRequest, opts ...grpc.CallOption) (*generated.SavePublicListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SavePublicList", varargs...)
+	ret0, _ := ret[0].(*generated.SavePublicListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SavePublicList indicates an expected call of SavePublicList.
+func (mr *MockListServiceClientMockRecorder) SavePublicList(ctx, in any, opts ...any) *MockListServiceClientSavePublicListCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePublicList", reflect.TypeOf((*MockListServiceClient)(nil).SavePublicList), varargs...)
+	return &MockListServiceClientSavePublicListCall{Call: call}
+}
+
+// MockListServiceClientSavePublicListCall wrap *gomock.Call
+type MockListServiceClientSavePublicListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientSavePublicListCall) Return(arg0 *generated.SavePublicListResponse, arg1 error) *MockListServiceClientSavePublicListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientSavePublicListCall) Do(f func(context.Context, *generated.SavePublicListRequest, ...grpc.CallOption) (*generated.SavePublicListResponse, error)) *MockListServiceClientSavePublicListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientSavePublicListCall) DoAndReturn(f func(context.Context, *generated.SavePublicListRequest, ...grpc.CallOption) (*generated.SavePublicListResponse, error)) *MockListServiceClientSavePublicListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchPublicLists mocks base method.
+func (m *MockListServiceClient) SearchPublicLists(ctx context.Context, in *generated.SearchPublicListsRequest, opts ...grpc.CallOption) (*generated.SearchPublicListsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchPublicLists", varargs...)
+	ret0, _ := ret[0].(*generated.SearchPublicListsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPublicLists indicates an expected call of SearchPublicLists.
+func (mr *MockListServiceClientMockRecorder) SearchPublicLists(ctx, in any, opts ...any) *MockListServiceClientSearchPublicListsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPublicLists", reflect.TypeOf((*MockListServiceClient)(nil).SearchPublicLists), varargs...)
+	return &MockListServiceClientSearchPublicListsCall{Call: call}
+}
+
+// MockListServiceClientSearchPublicListsCall wrap *gomock.Call
+type MockListServiceClientSearchPublicListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientSearchPublicListsCall) Return(arg0 *generated.SearchPublicListsResponse, arg1 error) *MockListServiceClientSearchPublicListsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientSearchPublicListsCall) Do(f func(context.Context, *generated.SearchPublicListsRequest, ...grpc.CallOption) (*generated.SearchPublicListsResponse, error)) *MockListServiceClientSearchPublicListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientSearchPublicListsCall) DoAndReturn(f func(context.Context, *generated.SearchPublicListsRequest, ...grpc.CallOption) (*generated.SearchPublicListsResponse, error)) *MockListServiceClientSearchPublicListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UnsaveList mocks base method.
+func (m *MockListServiceClient) UnsaveList(ctx context.Context, in *generated.UnsaveListRequest, opts ...grpc.CallOption) (*generated.UnsaveListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnsaveList", varargs...)
+	ret0, _ := ret[0].(*generated.UnsaveListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsaveList indicates an expected call of UnsaveList.
+func (mr *MockListServiceClientMockRecorder) UnsaveList(ctx, in any, opts ...any) *MockListServiceClientUnsaveListCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsaveList", reflect.TypeOf((*MockListServiceClient)(nil).UnsaveList), varargs...)
+	return &MockListServiceClientUnsaveListCall{Call: call}
+}
+
+// MockListServiceClientUnsaveListCall wrap *gomock.Call
+type MockListServiceClientUnsaveListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientUnsaveListCall) Return(arg0 *generated.UnsaveListResponse, arg1 error) *MockListServiceClientUnsaveListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientUnsaveListCall) Do(f func(context.Context, *generated.UnsaveListRequest, ...grpc.CallOption) (*generated.UnsaveListResponse, error)) *MockListServiceClientUnsaveListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientUnsaveListCall) DoAndReturn(f func(context.Context, *generated.UnsaveListRequest, ...grpc.CallOption) (*generated.UnsaveListResponse, error)) *MockListServiceClientUnsaveListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateList mocks base method.
+func (m *MockListServiceClient) UpdateList(ctx context.Context, in *generated.UpdateListRequest, opts ...grpc.CallOption) (*generated.UpdateListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateList", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateList indicates an expected call of UpdateList.
+func (mr *MockListServiceClientMockRecorder) UpdateList(ctx, in any, opts ...any) *MockListServiceClientUpdateListCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateList", reflect.TypeOf((*MockListServiceClient)(nil).UpdateList), varargs...)
+	return &MockListServiceClientUpdateListCall{Call: call}
+}
+
+// MockListServiceClientUpdateListCall wrap *gomock.Call
+type MockListServiceClientUpdateListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientUpdateListCall) Return(arg0 *generated.UpdateListResponse, arg1 error) *MockListServiceClientUpdateListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientUpdateListCall) Do(f func(context.Context, *generated.UpdateListRequest, ...grpc.CallOption) (*generated.UpdateListResponse, error)) *MockListServiceClientUpdateListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientUpdateListCall) DoAndReturn(f func(context.Context, *generated.UpdateListRequest, ...grpc.CallOption) (*generated.UpdateListResponse, error)) *MockListServiceClientUpdateListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateListItem mocks base method.
+func (m *MockListServiceClient) UpdateListItem(ctx context.Context, in *generated.UpdateListItemRequest, opts ...grpc.CallOption) (*generated.UpdateListItemResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateListItem", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateListItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateListItem indicates an expected call of UpdateListItem.
+func (mr *MockListServiceClientMockRecorder) UpdateListItem(ctx, in any, opts ...any) *MockListServiceClientUpdateListItemCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListItem", reflect.TypeOf((*MockListServiceClient)(nil).UpdateListItem), varargs...)
+	return &MockListServiceClientUpdateListItemCall{Call: call}
+}
+
+// MockListServiceClientUpdateListItemCall wrap *gomock.Call
+type MockListServiceClientUpdateListItemCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListServiceClientUpdateListItemCall) Return(arg0 *generated.UpdateListItemResponse, arg1 error) *MockListServiceClientUpdateListItemCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListServiceClientUpdateListItemCall) Do(f func(context.Context, *generated.UpdateListItemRequest, ...grpc.CallOption) (*generated.UpdateListItemResponse, error)) *MockListServiceClientUpdateListItemCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListServiceClientUpdateListItemCall) DoAndReturn(f func(context.Context, *generated.UpdateListItemRequest, ...grpc.CallOption) (*generated.UpdateListItemResponse, error)) *MockListServiceClientUpdateListItemCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,791 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/poi/generated (interfaces: POIServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/poi_mock.go github.com/FACorreiaa/loci-proto/modules/poi/generated POIServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockPOIServiceClient is a mock of POIServiceClient interface.
+type MockPOIServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPOIServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockPOIServiceClientMockRecorder is the mock recorder for MockPOIServiceClient.
+type MockPOIServiceClientMockRecorder struct {
+	mock *MockPOIServiceClient
+}
+
+// NewMockPOIServiceClient creates a new mock instance.
+func NewMockPOIServiceClient(ctrl *gomock.Controller) *MockPOIServiceClient {
+	mock := &MockPOIServiceClient{ctrl: ctrl}
+	mock.recorder = &MockPOIServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPOIServiceClient) EXPECT() *MockPOIServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddToFavorites mocks base method.
+func (m *MockPOIServiceClient) AddToFavorites(ctx context.Context, in *generated.AddToFavoritesRequest, opts ...grpc.CallOption) (*generated.AddToFavoritesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddToFavorites", varargs...)
+	ret0, _ := ret[0].(*generated.AddToFavoritesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddToFavorites indicates an expected call of AddToFavorites.
+func (mr *MockPOIServiceClientMockRecorder) AddToFavorites(ctx, in any, opts ...any) *MockPOIServiceClientAddToFavoritesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToFavorites", reflect.TypeOf((*MockPOIServiceClient)(nil).AddToFavorites), varargs...)
+	return &MockPOIServiceClientAddToFavoritesCall{Call: call}
+}
+
+// MockPOIServiceClientAddToFavoritesCall wrap *gomock.Call
+type MockPOIServiceClientAddToFavoritesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientAddToFavoritesCall) Return(arg0 *generated.AddToFavoritesResponse, arg1 error) *MockPOIServiceClientAddToFavoritesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientAddToFavoritesCall) Do(f func(context.Context, *generated.AddToFavoritesRequest, ...grpc.CallOption) (*generated.AddToFavoritesResponse, error)) *MockPOIServiceClientAddToFavoritesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientAddToFavoritesCall) DoAndReturn(f func(context.Context, *generated.AddToFavoritesRequest, ...grpc.CallOption) (*generated.AddToFavoritesResponse, error)) *MockPOIServiceClientAddToFavoritesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DiscoverActivities mocks base method.
+func (m *MockPOIServiceClient) DiscoverActivities(ctx context.Context, in *generated.DiscoverActivitiesRequest, opts ...grpc.CallOption) (*generated.DiscoverActivitiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiscoverActivities", varargs...)
+	ret0, _ := ret[0].(*generated.DiscoverActivitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverActivities indicates an expected call of DiscoverActivities.
+func (mr *MockPOIServiceClientMockRecorder) DiscoverActivities(ctx, in any, opts ...any) *MockPOIServiceClientDiscoverActivitiesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverActivities", reflect.TypeOf((*MockPOIServiceClient)(nil).DiscoverActivities), varargs...)
+	return &MockPOIServiceClientDiscoverActivitiesCall{Call: call}
+}
+
+// MockPOIServiceClientDiscoverActivitiesCall wrap *gomock.Call
+type MockPOIServiceClientDiscoverActivitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientDiscoverActivitiesCall) Return(arg0 *generated.DiscoverActivitiesResponse, arg1 error) *MockPOIServiceClientDiscoverActivitiesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientDiscoverActivitiesCall) Do(f func(context.Context, *generated.DiscoverActivitiesRequest, ...grpc.CallOption) (*generated.DiscoverActivitiesResponse, error)) *MockPOIServiceClientDiscoverActivitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientDiscoverActivitiesCall) DoAndReturn(f func(context.Context, *generated.DiscoverActivitiesRequest, ...grpc.CallOption) (*generated.DiscoverActivitiesResponse, error)) *MockPOIServiceClientDiscoverActivitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DiscoverAttractions mocks base method.
+func (m *MockPOIServiceClient) DiscoverAttractions(ctx context.Context, in *generated.DiscoverAttractionsRequest, opts ...grpc.CallOption) (*generated.DiscoverAttractionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiscoverAttractions", varargs...)
+	ret0, _ := ret[0].(*generated.DiscoverAttractionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverAttractions indicates an expected call of DiscoverAttractions.
+func (mr *MockPOIServiceClientMockRecorder) DiscoverAttractions(ctx, in any, opts ...any) *MockPOIServiceClientDiscoverAttractionsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverAttractions", reflect.TypeOf((*MockPOIServiceClient)(nil).DiscoverAttractions), varargs...)
+	return &MockPOIServiceClientDiscoverAttractionsCall{Call: call}
+}
+
+// MockPOIServiceClientDiscoverAttractionsCall wrap *gomock.Call
+type MockPOIServiceClientDiscoverAttractionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientDiscoverAttractionsCall) Return(arg0 *generated.DiscoverAttractionsResponse, arg1 error) *MockPOIServiceClientDiscoverAttractionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientDiscoverAttractionsCall) Do(f func(context.Context, *generated.DiscoverAttractionsRequest, ...grpc.CallOption) (*generated.DiscoverAttractionsResponse, error)) *MockPOIServiceClientDiscoverAttractionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientDiscoverAttractionsCall) DoAndReturn(f func(context.Context, *generated.DiscoverAttractionsRequest, ...grpc.CallOption) (*generated.DiscoverAttractionsResponse, error)) *MockPOIServiceClientDiscoverAttractionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DiscoverHotels mocks base method.
+func (m *MockPOIServiceClient) DiscoverHotels(ctx context.Context, in *generated.DiscoverHotelsRequest, opts ...grpc.CallOption) (*generated.DiscoverHotelsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiscoverHotels", varargs...)
+	ret0, _ := ret[0].(*generated.DiscoverHotelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverHotels indicates an expected call of DiscoverHotels.
+func (mr *MockPOIServiceClientMockRecorder) DiscoverHotels(ctx, in any, opts ...any) *MockPOIServiceClientDiscoverHotelsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverHotels", reflect.TypeOf((*MockPOIServiceClient)(nil).DiscoverHotels), varargs...)
+	return &MockPOIServiceClientDiscoverHotelsCall{Call: call}
+}
+
+// MockPOIServiceClientDiscoverHotelsCall wrap *gomock.Call
+type MockPOIServiceClientDiscoverHotelsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientDiscoverHotelsCall) Return(arg0 *generated.DiscoverHotelsResponse, arg1 error) *MockPOIServiceClientDiscoverHotelsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientDiscoverHotelsCall) Do(f func(context.Context, *generated.DiscoverHotelsRequest, ...grpc.CallOption) (*generated.DiscoverHotelsResponse, error)) *MockPOIServiceClientDiscoverHotelsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientDiscoverHotelsCall) DoAndReturn(f func(context.Context, *generated.DiscoverHotelsRequest, ...grpc.CallOption) (*generated.DiscoverHotelsResponse, error)) *MockPOIServiceClientDiscoverHotelsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DiscoverRestaurants mocks base method.
+func (m *MockPOIServiceClient) DiscoverRestaurants(ctx context.Context, in *generated.DiscoverRestaurantsRequest, opts ...grpc.CallOption) (*generated.DiscoverRestaurantsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiscoverRestaurants", varargs...)
+	ret0, _ := ret[0].(*generated.DiscoverRestaurantsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverRestaurants indicates an expected call of DiscoverRestaurants.
+func (mr *MockPOIServiceClientMockRecorder) DiscoverRestaurants(ctx, in any, opts ...any) *MockPOIServiceClientDiscoverRestaurantsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverRestaurants", reflect.TypeOf((*MockPOIServiceClient)(nil).DiscoverRestaurants), varargs...)
+	return &MockPOIServiceClientDiscoverRestaurantsCall{Call: call}
+}
+
+// MockPOIServiceClientDiscoverRestaurantsCall wrap *gomock.Call
+type MockPOIServiceClientDiscoverRestaurantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientDiscoverRestaurantsCall) Return(arg0 *generated.DiscoverRestaurantsResponse, arg1 error) *MockPOIServiceClientDiscoverRestaurantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientDiscoverRestaurantsCall) Do(f func(context.Context, *generated.DiscoverRestaurantsRequest, ...grpc.CallOption) (*generated.DiscoverRestaurantsResponse, error)) *MockPOIServiceClientDiscoverRestaurantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientDiscoverRestaurantsCall) DoAndReturn(f func(context.Context, *generated.DiscoverRestaurantsRequest, ...grpc.CallOption) (*generated.DiscoverRestaurantsResponse, error)) *MockPOIServiceClientDiscoverRestaurantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GenerateEmbeddings mocks base method.
+func (m *MockPOIServiceClient) GenerateEmbeddings(ctx context.Context, in *generated.GenerateEmbeddingsRequest, opts ...grpc.CallOption) (*generated.GenerateEmbeddingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateEmbeddings", varargs...)
+	ret0, _ := ret[0].(*generated.GenerateEmbeddingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateEmbeddings indicates an expected call of GenerateEmbeddings.
+func (mr *MockPOIServiceClientMockRecorder) GenerateEmbeddings(ctx, in any, opts ...any) *MockPOIServiceClientGenerateEmbeddingsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateEmbeddings", reflect.TypeOf((*MockPOIServiceClient)(nil).GenerateEmbeddings), varargs...)
+	return &MockPOIServiceClientGenerateEmbeddingsCall{Call: call}
+}
+
+// MockPOIServiceClientGenerateEmbeddingsCall wrap *gomock.Call
+type MockPOIServiceClientGenerateEmbeddingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientGenerateEmbeddingsCall) Return(arg0 *generated.GenerateEmbeddingsResponse, arg1 error) *MockPOIServiceClientGenerateEmbeddingsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientGenerateEmbeddingsCall) Do(f func(context.Context, *generated.GenerateEmbeddingsRequest, ...grpc.CallOption) (*generated.GenerateEmbeddingsResponse, error)) *MockPOIServiceClientGenerateEmbeddingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientGenerateEmbeddingsCall) DoAndReturn(f func(context.Context, *generated.GenerateEmbeddingsRequest, ...grpc.CallOption) (*generated.GenerateEmbeddingsResponse, error)) *MockPOIServiceClientGenerateEmbeddingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetFavorites mocks base method.
+func (m *MockPOIServiceClient) GetFavorites(ctx context.Context, in *generated.GetFavoritesRequest, opts ...grpc.CallOption) (*generated.GetFavoritesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFavorites", varargs...)
+	ret0, _ := ret[0].(*generated.GetFavoritesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavorites indicates an expected call of GetFavorites.
+func (mr *MockPOIServiceClientMockRecorder) GetFavorites(ctx, in any, opts ...any) *MockPOIServiceClientGetFavoritesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavorites", reflect.TypeOf((*MockPOIServiceClient)(nil).GetFavorites), varargs...)
+	return &MockPOIServiceClientGetFavoritesCall{Call: call}
+}
+
+// MockPOIServiceClientGetFavoritesCall wrap *gomock.Call
+type MockPOIServiceClientGetFavoritesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientGetFavoritesCall) Return(arg0 *generated.GetFavoritesResponse, arg1 error) *MockPOIServiceClientGetFavoritesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientGetFavoritesCall) Do(f func(context.Context, *generated.GetFavoritesRequest, ...grpc.CallOption) (*generated.GetFavoritesResponse, error)) *MockPOIServiceClientGetFavoritesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientGetFavoritesCall) DoAndReturn(f func(context.Context, *generated.GetFavoritesRequest, ...grpc.CallOption) (*generated.GetFavoritesResponse, error)) *MockPOIServiceClientGetFavoritesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetItineraries mocks base method.
+func (m *MockPOIServiceClient) GetItineraries(ctx context.Context, in *generated.GetItinerariesRequest, opts ...grpc.CallOption) (*generated.GetItinerariesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetItineraries", varargs...)
+	ret0, _ := ret[0].(*generated.GetItinerariesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItineraries indicates an expected call of GetItineraries.
+func (mr *MockPOIServiceClientMockRecorder) GetItineraries(ctx, in any, opts ...any) *MockPOIServiceClientGetItinerariesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItineraries", reflect.TypeOf((*MockPOIServiceClient)(nil).GetItineraries), varargs...)
+	return &MockPOIServiceClientGetItinerariesCall{Call: call}
+}
+
+// MockPOIServiceClientGetItinerariesCall wrap *gomock.Call
+type MockPOIServiceClientGetItinerariesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientGetItinerariesCall) Return(arg0 *generated.GetItinerariesResponse, arg1 error) *MockPOIServiceClientGetItinerariesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientGetItinerariesCall) Do(f func(context.Context, *generated.GetItinerariesRequest, ...grpc.CallOption) (*generated.GetItinerariesResponse, error)) *MockPOIServiceClientGetItinerariesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientGetItinerariesCall) DoAndReturn(f func(context.Context, *generated.GetItinerariesRequest, ...grpc.CallOption) (*generated.GetItinerariesResponse, error)) *MockPOIServiceClientGetItinerariesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetItinerary mocks base method.
+func (m *MockPOIServiceClient) GetItinerary(ctx context.Context, in *generated.GetItineraryRequest, opts ...grpc.CallOption) (*generated.GetItineraryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetItinerary", varargs...)
+	ret0, _ := ret[0].(*generated.GetItineraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItinerary indicates an expected call of GetItinerary.
+func (mr *MockPOIServiceClientMockRecorder) GetItinerary(ctx, in any, opts ...any) *MockPOIServiceClientGetItineraryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItinerary", reflect.TypeOf((*MockPOIServiceClient)(nil).GetItinerary), varargs...)
+	return &MockPOIServiceClientGetItineraryCall{Call: call}
+}
+
+// MockPOIServiceClientGetItineraryCall wrap *gomock.Call
+type MockPOIServiceClientGetItineraryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientGetItineraryCall) Return(arg0 *generated.GetItineraryResponse, arg1 error) *MockPOIServiceClientGetItineraryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientGetItineraryCall) Do(f func(context.Context, *generated.GetItineraryRequest, ...grpc.CallOption) (*generated.GetItineraryResponse, error)) *MockPOIServiceClientGetItineraryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientGetItineraryCall) DoAndReturn(f func(context.Context, *generated.GetItineraryRequest, ...grpc.CallOption) (*generated.GetItineraryResponse, error)) *MockPOIServiceClientGetItineraryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetNearbyRecommendations mocks base method.
+func (m *MockPOIServiceClient) GetNearbyRecommendations(ctx context.Context, in *generated.GetNearbyRecommendationsRequest, opts ...grpc.CallOption) (*generated.GetNearbyRecommendationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNearbyRecommendations", varargs...)
+	ret0, _ := ret[0].(*generated.GetNearbyRecommendationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNearbyRecommendations indicates an expected call of GetNearbyRecommendations.
+func (mr *MockPOIServiceClientMockRecorder) GetNearbyRecommendations(ctx, in any, opts ...any) *MockPOIServiceClientGetNearbyRecommendationsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearbyRecommendations", reflect.TypeOf((*MockPOIServiceClient)(nil).GetNearbyRecommendations), varargs...)
+	return &MockPOIServiceClientGetNearbyRecommendationsCall{Call: call}
+}
+
+// MockPOIServiceClientGetNearbyRecommendationsCall wrap *gomock.Call
+type MockPOIServiceClientGetNearbyRecommendationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientGetNearbyRecommendationsCall) Return(arg0 *generated.GetNearbyRecommendationsResponse, arg1 error) *MockPOIServiceClientGetNearbyRecommendationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientGetNearbyRecommendationsCall) Do(f func(context.Context, *generated.GetNearbyRecommendationsRequest, ...grpc.CallOption) (*generated.GetNearbyRecommendationsResponse, error)) *MockPOIServiceClientGetNearbyRecommendationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientGetNearbyRecommendationsCall) DoAndReturn(f func(context.Context, *generated.GetNearbyRecommendationsRequest, ...grpc.CallOption) (*generated.GetNearbyRecommendationsResponse, error)) *MockPOIServiceClientGetNearbyRecommendationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPOIsByCity mocks base method.
+func (m *MockPOIServiceClient) GetPOIsByCity(ctx context.Context, in *generated.GetPOIsByCityRequest, opts ...grpc.CallOption) (*generated.GetPOIsByCityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPOIsByCity", varargs...)
+	ret0, _ := ret[0].(*generated.GetPOIsByCityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPOIsByCity indicates an expected call of GetPOIsByCity.
+func (mr *MockPOIServiceClientMockRecorder) GetPOIsByCity(ctx, in any, opts ...any) *MockPOIServiceClientGetPOIsByCityCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPOIsByCity", reflect.TypeOf((*MockPOIServiceClient)(nil).GetPOIsByCity), varargs...)
+	return &MockPOIServiceClientGetPOIsByCityCall{Call: call}
+}
+
+// MockPOIServiceClientGetPOIsByCityCall wrap *gomock.Call
+type MockPOIServiceClientGetPOIsByCityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientGetPOIsByCityCall) Return(arg0 *generated.GetPOIsByCityResponse, arg1 error) *MockPOIServiceClientGetPOIsByCityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientGetPOIsByCityCall) Do(f func(context.Context, *generated.GetPOIsByCityRequest, ...grpc.CallOption) (*generated.GetPOIsByCityResponse, error)) *MockPOIServiceClientGetPOIsByCityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientGetPOIsByCityCall) DoAndReturn(f func(context.Context, *generated.GetPOIsByCityRequest, ...grpc.CallOption) (*generated.GetPOIsByCityResponse, error)) *MockPOIServiceClientGetPOIsByCityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveFromFavorites mocks base method.
+func (m *MockPOIServiceClient) RemoveFromFavorites(ctx context.Context, in *generated.RemoveFromFavoritesRequest, opts ...grpc.CallOption) (*generated.RemoveFromFavoritesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveFromFavorites", varargs...)
+	ret0, _ := ret[0].(*generated.RemoveFromFavoritesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromFavorites indicates an expected call of RemoveFromFavorites.
+func (mr *MockPOIServiceClientMockRecorder) RemoveFromFavorites(ctx, in any, opts ...any) *MockPOIServiceClientRemoveFromFavoritesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromFavorites", reflect.TypeOf((*MockPOIServiceClient)(nil).RemoveFromFavorites), varargs...)
+	return &MockPOIServiceClientRemoveFromFavoritesCall{Call: call}
+}
+
+// MockPOIServiceClientRemoveFromFavoritesCall wrap *gomock.Call
+type MockPOIServiceClientRemoveFromFavoritesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientRemoveFromFavoritesCall) Return(arg0 *generated.RemoveFromFavoritesResponse, arg1 error) *MockPOIServiceClientRemoveFromFavoritesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientRemoveFromFavoritesCall) Do(f func(context.Context, *generated.RemoveFromFavoritesRequest, ...grpc.CallOption) (*generated.RemoveFromFavoritesResponse, error)) *MockPOIServiceClientRemoveFromFavoritesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientRemoveFromFavoritesCall) DoAndReturn(f func(context.Context, *generated.RemoveFromFavoritesRequest, ...grpc.CallOption) (*generated.RemoveFromFavoritesResponse, error)) *MockPOIServiceClientRemoveFromFavoritesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchPOIs mocks base method.
+func (m *MockPOIServiceClient) SearchPOIs(ctx context.Context, in *generated.SearchPOIsRequest, opts ...grpc.CallOption) (*generated.SearchPOIsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchPOIs", varargs...)
+	ret0, _ := ret[0].(*generated.SearchPOIsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPOIs indicates an expected call of SearchPOIs.
+func (mr *MockPOIServiceClientMockRecorder) SearchPOIs(ctx, in any, opts ...any) *MockPOIServiceClientSearchPOIsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPOIs", reflect.TypeOf((*MockPOIServiceClient)(nil).SearchPOIs), varargs...)
+	return &MockPOIServiceClientSearchPOIsCall{Call: call}
+}
+
+// MockPOIServiceClientSearchPOIsCall wrap *gomock.Call
+type MockPOIServiceClientSearchPOIsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientSearchPOIsCall) Return(arg0 *generated.SearchPOIsResponse, arg1 error) *MockPOIServiceClientSearchPOIsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientSearchPOIsCall) Do(f func(context.Context, *generated.SearchPOIsRequest, ...grpc.CallOption) (*generated.SearchPOIsResponse, error)) *MockPOIServiceClientSearchPOIsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientSearchPOIsCall) DoAndReturn(f func(context.Context, *generated.SearchPOIsRequest, ...grpc.CallOption) (*generated.SearchPOIsResponse, error)) *MockPOIServiceClientSearchPOIsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchPOIsHybrid mocks base method.
+func (m *MockPOIServiceClient) SearchPOIsHybrid(ctx context.Context, in *generated.SearchPOIsHybridRequest, opts ...grpc.CallOption) (*generated.SearchPOIsHybridResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchPOIsHybrid", varargs...)
+	ret0, _ := ret[0].(*generated.SearchPOIsHybridResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPOIsHybrid indicates an expected call of SearchPOIsHybrid.
+func (mr *MockPOIServiceClientMockRecorder) SearchPOIsHybrid(ctx, in any, opts ...any) *MockPOIServiceClientSearchPOIsHybridCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPOIsHybrid", reflect.TypeOf((*MockPOIServiceClient)(nil).SearchPOIsHybrid), varargs...)
+	return &MockPOIServiceClientSearchPOIsHybridCall{Call: call}
+}
+
+// MockPOIServiceClientSearchPOIsHybridCall wrap *gomock.Call
+type MockPOIServiceClientSearchPOIsHybridCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientSearchPOIsHybridCall) Return(arg0 *generated.SearchPOIsHybridResponse, arg1 error) *MockPOIServiceClientSearchPOIsHybridCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientSearchPOIsHybridCall) Do(f func(context.Context, *generated.SearchPOIsHybridRequest, ...grpc.CallOption) (*generated.SearchPOIsHybridResponse, error)) *MockPOIServiceClientSearchPOIsHybridCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientSearchPOIsHybridCall) DoAndReturn(f func(context.Context, *generated.SearchPOIsHybridRequest, ...grpc.CallOption) (*generated.SearchPOIsHybridResponse, error)) *MockPOIServiceClientSearchPOIsHybridCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchPOIsSemantic mocks base method.
+func (m *MockPOIServiceClient) SearchPOIsSemantic(ctx context.Context, in *generated.SearchPOIsSemanticRequest, opts ...grpc.CallOption) (*generated.SearchPOIsSemanticResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchPOIsSemantic", varargs...)
+	ret0, _ := ret[0].(*generated.SearchPOIsSemanticResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPOIsSemantic indicates an expected call of SearchPOIsSemantic.
+func (mr *MockPOIServiceClientMockRecorder) SearchPOIsSemantic(ctx, in any, opts ...any) *MockPOIServiceClientSearchPOIsSemanticCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPOIsSemantic", reflect.TypeOf((*MockPOIServiceClient)(nil).SearchPOIsSemantic), varargs...)
+	return &MockPOIServiceClientSearchPOIsSemanticCall{Call: call}
+}
+
+// MockPOIServiceClientSearchPOIsSemanticCall wrap *gomock.Call
+type MockPOIServiceClientSearchPOIsSemanticCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientSearchPOIsSemanticCall) Return(arg0 *generated.SearchPOIsSemanticResponse, arg1 error) *MockPOIServiceClientSearchPOIsSemanticCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientSearchPOIsSemanticCall) Do(f func(context.Context, *generated.SearchPOIsSemanticRequest, ...grpc.CallOption) (*generated.SearchPOIsSemanticResponse, error)) *MockPOIServiceClientSearchPOIsSemanticCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientSearchPOIsSemanticCall) DoAndReturn(f func(context.Context, *generated.SearchPOIsSemanticRequest, ...grpc.CallOption) (*generated.SearchPOIsSemanticResponse, error)) *MockPOIServiceClientSearchPOIsSemanticCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchPOIsSemanticByCity mocks base method.
+func (m *MockPOIServiceClient) SearchPOIsSemanticByCity(ctx context.Context, in *generated.SearchPOIsSemanticByCityRequest, opts ...grpc.CallOption) (*generated.SearchPOIsSemanticResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchPOIsSemanticByCity", varargs...)
+	ret0, _ := ret[0].(*generated.SearchPOIsSemanticResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPOIsSemanticByCity indicates an expected call of SearchPOIsSemanticByCity.
+func (mr *MockPOIServiceClientMockRecorder) SearchPOIsSemanticByCity(ctx, in any, opts ...any) *MockPOIServiceClientSearchPOIsSemanticByCityCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPOIsSemanticByCity", reflect.TypeOf((*MockPOIServiceClient)(nil).SearchPOIsSemanticByCity), varargs...)
+	return &MockPOIServiceClientSearchPOIsSemanticByCityCall{Call: call}
+}
+
+// MockPOIServiceClientSearchPOIsSemanticByCityCall wrap *gomock.Call
+type MockPOIServiceClientSearchPOIsSemanticByCityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientSearchPOIsSemanticByCityCall) Return(arg0 *generated.SearchPOIsSemanticResponse, arg1 error) *MockPOIServiceClientSearchPOIsSemanticByCityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientSearchPOIsSemanticByCityCall) Do(f func(context.Context, *generated.SearchPOIsSemanticByCityRequest, ...grpc.CallOption) (*generated.SearchPOIsSemanticResponse, error)) *MockPOIServiceClientSearchPOIsSemanticByCityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientSearchPOIsSemanticByCityCall) DoAndReturn(f func(context.Context, *generated.SearchPOIsSemanticByCityRequest, ...grpc.CallOption) (*generated.SearchPOIsSemanticResponse, error)) *MockPOIServiceClientSearchPOIsSemanticByCityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateItinerary mocks base method.
+func (m *MockPOIServiceClient) UpdateItinerary(ctx context.Context, in *generated.UpdateItineraryRequest, opts ...grpc.CallOption) (*generated.UpdateItineraryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateItinerary", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateItineraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItinerary indicates an expected call of UpdateItinerary.
+func (mr *MockPOIServiceClientMockRecorder) UpdateItinerary(ctx, in any, opts ...any) *MockPOIServiceClientUpdateItineraryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItinerary", reflect.TypeOf((*MockPOIServiceClient)(nil).UpdateItinerary), varargs...)
+	return &MockPOIServiceClientUpdateItineraryCall{Call: call}
+}
+
+// MockPOIServiceClientUpdateItineraryCall wrap *gomock.Call
+type MockPOIServiceClientUpdateItineraryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPOIServiceClientUpdateItineraryCall) Return(arg0 *generated.UpdateItineraryResponse, arg1 error) *MockPOIServiceClientUpdateItineraryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPOIServiceClientUpdateItineraryCall) Do(f func(context.Context, *generated.UpdateItineraryRequest, ...grpc.CallOption) (*generated.UpdateItineraryResponse, error)) *MockPOIServiceClientUpdateItineraryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPOIServiceClientUpdateItineraryCall) DoAndReturn(f func(context.Context, *generated.UpdateItineraryRequest, ...grpc.CallOption) (*generated.UpdateItineraryResponse, error)) *MockPOIServiceClientUpdateItineraryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,351 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/profiles/generated (interfaces: ProfilesServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/profiles_mock.go github.com/FACorreiaa/loci-proto/modules/profiles/generated ProfilesServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/profiles/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockProfilesServiceClient is a mock of ProfilesServiceClient interface.
+type MockProfilesServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfilesServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockProfilesServiceClientMockRecorder is the mock recorder for MockProfilesServiceClient.
+type MockProfilesServiceClientMockRecorder struct {
+	mock *MockProfilesServiceClient
+}
+
+// NewMockProfilesServiceClient creates a new mock instance.
+func NewMockProfilesServiceClient(ctrl *gomock.Controller) *MockProfilesServiceClient {
+	mock := &MockProfilesServiceClient{ctrl: ctrl}
+	mock.recorder = &MockProfilesServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfilesServiceClient) EXPECT() *MockProfilesServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateSearchProfile mocks base method.
+func (m *MockProfilesServiceClient) CreateSearchProfile(ctx context.Context, in *generated.CreateSearchProfileRequest, opts ...grpc.CallOption) (*generated.CreateSearchProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSearchProfile", varargs...)
+	ret0, _ := ret[0].(*generated.CreateSearchProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSearchProfile indicates an expected call of CreateSearchProfile.
+func (mr *MockProfilesServiceClientMockRecorder) CreateSearchProfile(ctx, in any, opts ...any) *MockProfilesServiceClientCreateSearchProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSearchProfile", reflect.TypeOf((*MockProfilesServiceClient)(nil).CreateSearchProfile), varargs...)
+	return &MockProfilesServiceClientCreateSearchProfileCall{Call: call}
+}
+
+// MockProfilesServiceClientCreateSearchProfileCall wrap *gomock.Call
+type MockProfilesServiceClientCreateSearchProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProfilesServiceClientCreateSearchProfileCall) Return(arg0 *generated.CreateSearchProfileResponse, arg1 error) *MockProfilesServiceClientCreateSearchProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProfilesServiceClientCreateSearchProfileCall) Do(f func(context.Context, *generated.CreateSearchProfileRequest, ...grpc.CallOption) (*generated.CreateSearchProfileResponse, error)) *MockProfilesServiceClientCreateSearchProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProfilesServiceClientCreateSearchProfileCall) DoAndReturn(f func(context.Context, *generated.CreateSearchProfileRequest, ...grpc.CallOption) (*generated.CreateSearchProfileResponse, error)) *MockProfilesServiceClientCreateSearchProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSearchProfile mocks base method.
+func (m *MockProfilesServiceClient) DeleteSearchProfile(ctx context.Context, in *generated.DeleteSearchProfileRequest, opts ...grpc.CallOption) (*generated.DeleteSearchProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSearchProfile", varargs...)
+	ret0, _ := ret[0].(*generated.DeleteSearchProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSearchProfile indicates an expected call of DeleteSearchProfile.
+func (mr *MockProfilesServiceClientMockRecorder) DeleteSearchProfile(ctx, in any, opts ...any) *MockProfilesServiceClientDeleteSearchProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSearchProfile", reflect.TypeOf((*MockProfilesServiceClient)(nil).DeleteSearchProfile), varargs...)
+	return &MockProfilesServiceClientDeleteSearchProfileCall{Call: call}
+}
+
+// MockProfilesServiceClientDeleteSearchProfileCall wrap *gomock.Call
+type MockProfilesServiceClientDeleteSearchProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProfilesServiceClientDeleteSearchProfileCall) Return(arg0 *generated.DeleteSearchProfileResponse, arg1 error) *MockProfilesServiceClientDeleteSearchProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProfilesServiceClientDeleteSearchProfileCall) Do(f func(context.Context, *generated.DeleteSearchProfileRequest, ...grpc.CallOption) (*generated.DeleteSearchProfileResponse, error)) *MockProfilesServiceClientDeleteSearchProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProfilesServiceClientDeleteSearchProfileCall) DoAndReturn(f func(context.Context, *generated.DeleteSearchProfileRequest, ...grpc.CallOption) (*generated.DeleteSearchProfileResponse, error)) *MockProfilesServiceClientDeleteSearchProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetDefaultSearchProfile mocks base method.
+func (m *MockProfilesServiceClient) GetDefaultSearchProfile(ctx context.Context, in *generated.GetDefaultSearchProfileRequest, opts ...grpc.CallOption) (*generated.GetDefaultSearchProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDefaultSearchProfile", varargs...)
+	ret0, _ := ret[0].(*generated.GetDefaultSearchProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultSearchProfile indicates an expected call of GetDefaultSearchProfile.
+func (mr *MockProfilesServiceClientMockRecorder) GetDefaultSearchProfile(ctx, in any, opts ...any) *MockProfilesServiceClientGetDefaultSearchProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultSearchProfile", reflect.TypeOf((*MockProfilesServiceClient)(nil).GetDefaultSearchProfile), varargs...)
+	return &MockProfilesServiceClientGetDefaultSearchProfileCall{Call: call}
+}
+
+// MockProfilesServiceClientGetDefaultSearchProfileCall wrap *gomock.Call
+type MockProfilesServiceClientGetDefaultSearchProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProfilesServiceClientGetDefaultSearchProfileCall) Return(arg0 *generated.GetDefaultSearchProfileResponse, arg1 error) *MockProfilesServiceClientGetDefaultSearchProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProfilesServiceClientGetDefaultSearchProfileCall) Do(f func(context.Context, *generated.GetDefaultSearchProfileRequest, ...grpc.CallOption) (*generated.GetDefaultSearchProfileResponse, error)) *MockProfilesServiceClientGetDefaultSearchProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProfilesServiceClientGetDefaultSearchProfileCall) DoAndReturn(f func(context.Context, *generated.GetDefaultSearchProfileRequest, ...grpc.CallOption) (*generated.GetDefaultSearchProfileResponse, error)) *MockProfilesServiceClientGetDefaultSearchProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSearchProfile mocks base method.
+func (m *MockProfilesServiceClient) GetSearchProfile(ctx context.Context, in *generated.GetSearchProfileRequest, opts ...grpc.CallOption) (*generated.GetSearchProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSearchProfile", varargs...)
+	ret0, _ := ret[0].(*generated.GetSearchProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchProfile indicates an expected call of GetSearchProfile.
+func (mr *MockProfilesServiceClientMockRecorder) GetSearchProfile(ctx, in any, opts ...any) *MockProfilesServiceClientGetSearchProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchProfile", reflect.TypeOf((*MockProfilesServiceClient)(nil).GetSearchProfile), varargs...)
+	return &MockProfilesServiceClientGetSearchProfileCall{Call: call}
+}
+
+// MockProfilesServiceClientGetSearchProfileCall wrap *gomock.Call
+type MockProfilesServiceClientGetSearchProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProfilesServiceClientGetSearchProfileCall) Return(arg0 *generated.GetSearchProfileResponse, arg1 error) *MockProfilesServiceClientGetSearchProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProfilesServiceClientGetSearchProfileCall) Do(f func(context.Context, *generated.GetSearchProfileRequest, ...grpc.CallOption) (*generated.GetSearchProfileResponse, error)) *MockProfilesServiceClientGetSearchProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProfilesServiceClientGetSearchProfileCall) DoAndReturn(f func(context.Context, *generated.GetSearchProfileRequest, ...grpc.CallOption) (*generated.GetSearchProfileResponse, error)) *MockProfilesServiceClientGetSearchProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSearchProfiles mocks base method.
+func (m *MockProfilesServiceClient) GetSearchProfiles(ctx context.Context, in *generated.GetSearchProfilesRequest, opts ...grpc.CallOption) (*generated.GetSearchProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSearchProfiles", varargs...)
+	ret0, _ := ret[0].(*generated.GetSearchProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchProfiles indicates an expected call of GetSearchProfiles.
+func (mr *MockProfilesServiceClientMockRecorder) GetSearchProfiles(ctx, in any, opts ...any) *MockProfilesServiceClientGetSearchProfilesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchProfiles", reflect.TypeOf((*MockProfilesServiceClient)(nil).GetSearchProfiles), varargs...)
+	return &MockProfilesServiceClientGetSearchProfilesCall{Call: call}
+}
+
+// MockProfilesServiceClientGetSearchProfilesCall wrap *gomock.Call
+type MockProfilesServiceClientGetSearchProfilesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProfilesServiceClientGetSearchProfilesCall) Return(arg0 *generated.GetSearchProfilesResponse, arg1 error) *MockProfilesServiceClientGetSearchProfilesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProfilesServiceClientGetSearchProfilesCall) Do(f func(context.Context, *generated.GetSearchProfilesRequest, ...grpc.CallOption) (*generated.GetSearchProfilesResponse, error)) *MockProfilesServiceClientGetSearchProfilesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProfilesServiceClientGetSearchProfilesCall) DoAndReturn(f func(context.Context, *generated.GetSearchProfilesRequest, ...grpc.CallOption) (*generated.GetSearchProfilesResponse, error)) *MockProfilesServiceClientGetSearchProfilesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetDefaultSearchProfile mocks base method.
+func (m *MockProfilesServiceClient) SetDefaultSearchProfile(ctx context.Context, in *generated.SetDefaultSearchProfileRequest, opts ...grpc.CallOption) (*generated.SetDefaultSearchProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDefaultSearchProfile", varargs...)
+	ret0, _ := ret[0].(*generated.SetDefaultSearchProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDefaultSearchProfile indicates an expected call of SetDefaultSearchProfile.
+func (mr *MockProfilesServiceClientMockRecorder) SetDefaultSearchProfile(ctx, in any, opts ...any) *MockProfilesServiceClientSetDefaultSearchProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultSearchProfile", reflect.TypeOf((*MockProfilesServiceClient)(nil).SetDefaultSearchProfile), varargs...)
+	return &MockProfilesServiceClientSetDefaultSearchProfileCall{Call: call}
+}
+
+// MockProfilesServiceClientSetDefaultSearchProfileCall wrap *gomock.Call
+type MockProfilesServiceClientSetDefaultSearchProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProfilesServiceClientSetDefaultSearchProfileCall) Return(arg0 *generated.SetDefaultSearchProfileResponse, arg1 error) *MockProfilesServiceClientSetDefaultSearchProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProfilesServiceClientSetDefaultSearchProfileCall) Do(f func(context.Context, *generated.SetDefaultSearchProfileRequest, ...grpc.CallOption) (*generated.SetDefaultSearchProfileResponse, error)) *MockProfilesServiceClientSetDefaultSearchProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProfilesServiceClientSetDefaultSearchProfileCall) DoAndReturn(f func(context.Context, *generated.SetDefaultSearchProfileRequest, ...grpc.CallOption) (*generated.SetDefaultSearchProfileResponse, error)) *MockProfilesServiceClientSetDefaultSearchProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSearchProfile mocks base method.
+func (m *MockProfilesServiceClient) UpdateSearchProfile(ctx context.Context, in *generated.UpdateSearchProfileRequest, opts ...grpc.CallOption) (*generated.UpdateSearchProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSearchProfile", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateSearchProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSearchProfile indicates an expected call of UpdateSearchProfile.
+func (mr *MockProfilesServiceClientMockRecorder) UpdateSearchProfile(ctx, in any, opts ...any) *MockProfilesServiceClientUpdateSearchProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSearchProfile", reflect.TypeOf((*MockProfilesServiceClient)(nil).UpdateSearchProfile), varargs...)
+	return &MockProfilesServiceClientUpdateSearchProfileCall{Call: call}
+}
+
+// MockProfilesServiceClientUpdateSearchProfileCall wrap *gomock.Call
+type MockProfilesServiceClientUpdateSearchProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProfilesServiceClientUpdateSearchProfileCall) Return(arg0 *generated.UpdateSearchProfileResponse, arg1 error) *MockProfilesServiceClientUpdateSearchProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProfilesServiceClientUpdateSearchProfileCall) Do(f func(context.Context, *generated.UpdateSearchProfileRequest, ...grpc.CallOption) (*generated.UpdateSearchProfileResponse, error)) *MockProfilesServiceClientUpdateSearchProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProfilesServiceClientUpdateSearchProfileCall) DoAndReturn(f func(context.Context, *generated.UpdateSearchProfileRequest, ...grpc.CallOption) (*generated.UpdateSearchProfileResponse, error)) *MockProfilesServiceClientUpdateSearchProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,263 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/recents/generated (interfaces: RecentsServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/recents_mock.go github.com/FACorreiaa/loci-proto/modules/recents/generated RecentsServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/recents/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockRecentsServiceClient is a mock of RecentsServiceClient interface.
+type MockRecentsServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecentsServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockRecentsServiceClientMockRecorder is the mock recorder for MockRecentsServiceClient.
+type MockRecentsServiceClientMockRecorder struct {
+	mock *MockRecentsServiceClient
+}
+
+// NewMockRecentsServiceClient creates a new mock instance.
+func NewMockRecentsServiceClient(ctrl *gomock.Controller) *MockRecentsServiceClient {
+	mock := &MockRecentsServiceClient{ctrl: ctrl}
+	mock.recorder = &MockRecentsServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecentsServiceClient) EXPECT() *MockRecentsServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetCityInteractions mocks base method.
+func (m *MockRecentsServiceClient) GetCityInteractions(ctx context.Context, in *generated.GetCityInteractionsRequest, opts ...grpc.CallOption) (*generated.GetCityInteractionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCityInteractions", varargs...)
+	ret0, _ := ret[0].(*generated.GetCityInteractionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCityInteractions indicates an expected call of GetCityInteractions.
+func (mr *MockRecentsServiceClientMockRecorder) GetCityInteractions(ctx, in any, opts ...any) *MockRecentsServiceClientGetCityInteractionsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCityInteractions", reflect.TypeOf((*MockRecentsServiceClient)(nil).GetCityInteractions), varargs...)
+	return &MockRecentsServiceClientGetCityInteractionsCall{Call: call}
+}
+
+// MockRecentsServiceClientGetCityInteractionsCall wrap *gomock.Call
+type MockRecentsServiceClientGetCityInteractionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRecentsServiceClientGetCityInteractionsCall) Return(arg0 *generated.GetCityInteractionsResponse, arg1 error) *MockRecentsServiceClientGetCityInteractionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRecentsServiceClientGetCityInteractionsCall) Do(f func(context.Context, *generated.GetCityInteractionsRequest, ...grpc.CallOption) (*generated.GetCityInteractionsResponse, error)) *MockRecentsServiceClientGetCityInteractionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRecentsServiceClientGetCityInteractionsCall) DoAndReturn(f func(context.Context, *generated.GetCityInteractionsRequest, ...grpc.CallOption) (*generated.GetCityInteractionsResponse, error)) *MockRecentsServiceClientGetCityInteractionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetFrequentPlaces mocks base method.
+func (m *MockRecentsServiceClient) GetFrequentPlaces(ctx context.Context, in *generated.GetFrequentPlacesRequest, opts ...grpc.CallOption) (*generated.GetFrequentPlacesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFrequentPlaces", varargs...)
+	ret0, _ := ret[0].(*generated.GetFrequentPlacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFrequentPlaces indicates an expected call of GetFrequentPlaces.
+func (mr *MockRecentsServiceClientMockRecorder) GetFrequentPlaces(ctx, in any, opts ...any) *MockRecentsServiceClientGetFrequentPlacesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrequentPlaces", reflect.TypeOf((*MockRecentsServiceClient)(nil).GetFrequentPlaces), varargs...)
+	return &MockRecentsServiceClientGetFrequentPlacesCall{Call: call}
+}
+
+// MockRecentsServiceClientGetFrequentPlacesCall wrap *gomock.Call
+type MockRecentsServiceClientGetFrequentPlacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRecentsServiceClientGetFrequentPlacesCall) Return(arg0 *generated.GetFrequentPlacesResponse, arg1 error) *MockRecentsServiceClientGetFrequentPlacesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRecentsServiceClientGetFrequentPlacesCall) Do(f func(context.Context, *generated.GetFrequentPlacesRequest, ...grpc.CallOption) (*generated.GetFrequentPlacesResponse, error)) *MockRecentsServiceClientGetFrequentPlacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRecentsServiceClientGetFrequentPlacesCall) DoAndReturn(f func(context.Context, *generated.GetFrequentPlacesRequest, ...grpc.CallOption) (*generated.GetFrequentPlacesResponse, error)) *MockRecentsServiceClientGetFrequentPlacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetInteractionHistory mocks base method.
+func (m *MockRecentsServiceClient) GetInteractionHistory(ctx context.Context, in *generated.GetInteractionHistoryRequest, opts ...grpc.CallOption) (*generated.GetInteractionHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInteractionHistory", varargs...)
+	ret0, _ := ret[0].(*generated.GetInteractionHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInteractionHistory indicates an expected call of GetInteractionHistory.
+func (mr *MockRecentsServiceClientMockRecorder) GetInteractionHistory(ctx, in any, opts ...any) *MockRecentsServiceClientGetInteractionHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInteractionHistory", reflect.TypeOf((*MockRecentsServiceClient)(nil).GetInteractionHistory), varargs...)
+	return &MockRecentsServiceClientGetInteractionHistoryCall{Call: call}
+}
+
+// MockRecentsServiceClientGetInteractionHistoryCall wrap *gomock.Call
+type MockRecentsServiceClientGetInteractionHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRecentsServiceClientGetInteractionHistoryCall) Return(arg0 *generated.GetInteractionHistoryResponse, arg1 error) *MockRecentsServiceClientGetInteractionHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRecentsServiceClientGetInteractionHistoryCall) Do(f func(context.Context, *generated.GetInteractionHistoryRequest, ...grpc.CallOption) (*generated.GetInteractionHistoryResponse, error)) *MockRecentsServiceClientGetInteractionHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRecentsServiceClientGetInteractionHistoryCall) DoAndReturn(f func(context.Context, *generated.GetInteractionHistoryRequest, ...grpc.CallOption) (*generated.GetInteractionHistoryResponse, error)) *MockRecentsServiceClientGetInteractionHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRecentInteractions mocks base method.
+func (m *MockRecentsServiceClient) GetRecentInteractions(ctx context.Context, in *generated.GetRecentInteractionsRequest, opts ...grpc.CallOption) (*generated.GetRecentInteractionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRecentInteractions", varargs...)
+	ret0, _ := ret[0].(*generated.GetRecentInteractionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentInteractions indicates an expected call of GetRecentInteractions.
+func (mr *MockRecentsServiceClientMockRecorder) GetRecentInteractions(ctx, in any, opts ...any) *MockRecentsServiceClientGetRecentInteractionsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentInteractions", reflect.TypeOf((*MockRecentsServiceClient)(nil).GetRecentInteractions), varargs...)
+	return &MockRecentsServiceClientGetRecentInteractionsCall{Call: call}
+}
+
+// MockRecentsServiceClientGetRecentInteractionsCall wrap *gomock.Call
+type MockRecentsServiceClientGetRecentInteractionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRecentsServiceClientGetRecentInteractionsCall) Return(arg0 *generated.GetRecentInteractionsResponse, arg1 error) *MockRecentsServiceClientGetRecentInteractionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRecentsServiceClientGetRecentInteractionsCall) Do(f func(context.Context, *generated.GetRecentInteractionsRequest, ...grpc.CallOption) (*generated.GetRecentInteractionsResponse, error)) *MockRecentsServiceClientGetRecentInteractionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRecentsServiceClientGetRecentInteractionsCall) DoAndReturn(f func(context.Context, *generated.GetRecentInteractionsRequest, ...grpc.CallOption) (*generated.GetRecentInteractionsResponse, error)) *MockRecentsServiceClientGetRecentInteractionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordInteraction mocks base method.
+func (m *MockRecentsServiceClient) RecordInteraction(ctx context.Context, in *generated.RecordInteractionRequest, opts ...grpc.CallOption) (*generated.RecordInteractionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordInteraction", varargs...)
+	ret0, _ := ret[0].(*generated.RecordInteractionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordInteraction indicates an expected call of RecordInteraction.
+func (mr *MockRecentsServiceClientMockRecorder) RecordInteraction(ctx, in any, opts ...any) *MockRecentsServiceClientRecordInteractionCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordInteraction", reflect.TypeOf((*MockRecentsServiceClient)(nil).RecordInteraction), varargs...)
+	return &MockRecentsServiceClientRecordInteractionCall{Call: call}
+}
+
+// MockRecentsServiceClientRecordInteractionCall wrap *gomock.Call
+type MockRecentsServiceClientRecordInteractionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRecentsServiceClientRecordInteractionCall) Return(arg0 *generated.RecordInteractionResponse, arg1 error) *MockRecentsServiceClientRecordInteractionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRecentsServiceClientRecordInteractionCall) Do(f func(context.Context, *generated.RecordInteractionRequest, ...grpc.CallOption) (*generated.RecordInteractionResponse, error)) *MockRecentsServiceClientRecordInteractionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRecentsServiceClientRecordInteractionCall) DoAndReturn(f func(context.Context, *generated.RecordInteractionRequest, ...grpc.CallOption) (*generated.RecordInteractionResponse, error)) *MockRecentsServiceClientRecordInteractionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
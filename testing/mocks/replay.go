@@ -0,0 +1,61 @@
+// Package mocks holds gomock mocks of every generated gRPC client, along
+// with a mock of the server-streaming client all their streaming methods
+// return. The mocks are generated by `make mock-gen`, which `make proto-gen`
+// runs after regenerating the protos, so they never drift from the
+// contracts:
+//
+//	ctrl := gomock.NewController(t)
+//	client := mocks.NewMockChatServiceClient(ctrl)
+//	client.EXPECT().
+//		StartChatStream(gomock.Any(), gomock.Any()).
+//		Return(mocks.Replay(ctrl, thinking, message, complete), nil)
+//
+// Replay and ReplayError script the events a stream such as
+// chat.ChatService_StartChatStreamClient hands out.
+package mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+)
+
+// Replay returns a stream that receives events in order, then io.EOF.
+func Replay[Res any](ctrl *gomock.Controller, events ...*Res) *MockServerStreamingClient[Res] {
+	return ReplayError(ctrl, io.EOF, events...)
+}
+
+// ReplayError returns a stream that receives events in order, then err on
+// every later call. A nil err ends the stream with io.EOF.
+func ReplayError[Res any](ctrl *gomock.Controller, err error, events ...*Res) *MockServerStreamingClient[Res] {
+	if err == nil {
+		err = io.EOF
+	}
+
+	var (
+		mu   sync.Mutex
+		next int
+	)
+
+	stream := NewMockServerStreamingClient[Res](ctrl)
+	stream.EXPECT().Recv().DoAndReturn(func() (*Res, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if next >= len(events) {
+			return nil, err
+		}
+		next++
+
+		return events[next-1], nil
+	}).AnyTimes()
+	stream.EXPECT().Header().Return(metadata.MD{}, nil).AnyTimes()
+	stream.EXPECT().Trailer().Return(metadata.MD{}).AnyTimes()
+	stream.EXPECT().CloseSend().Return(nil).AnyTimes()
+	stream.EXPECT().Context().Return(context.Background()).AnyTimes()
+
+	return stream
+}
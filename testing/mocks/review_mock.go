@@ -0,0 +1,439 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/review/generated (interfaces: ReviewServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/review_mock.go github.com/FACorreiaa/loci-proto/modules/review/generated ReviewServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/review/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockReviewServiceClient is a mock of ReviewServiceClient interface.
+type MockReviewServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewServiceClientMockRecorder is the mock recorder for MockReviewServiceClient.
+type MockReviewServiceClientMockRecorder struct {
+	mock *MockReviewServiceClient
+}
+
+// NewMockReviewServiceClient creates a new mock instance.
+func NewMockReviewServiceClient(ctrl *gomock.Controller) *MockReviewServiceClient {
+	mock := &MockReviewServiceClient{ctrl: ctrl}
+	mock.recorder = &MockReviewServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewServiceClient) EXPECT() *MockReviewServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateReview mocks base method.
+func (m *MockReviewServiceClient) CreateReview(ctx context.Context, in *generated.CreateReviewRequest, opts ...grpc.CallOption) (*generated.CreateReviewResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateReview", varargs...)
+	ret0, _ := ret[0].(*generated.CreateReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockReviewServiceClientMockRecorder) CreateReview(ctx, in any, opts ...any) *MockReviewServiceClientCreateReviewCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReviewServiceClient)(nil).CreateReview), varargs...)
+	return &MockReviewServiceClientCreateReviewCall{Call: call}
+}
+
+// MockReviewServiceClientCreateReviewCall wrap *gomock.Call
+type MockReviewServiceClientCreateReviewCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReviewServiceClientCreateReviewCall) Return(arg0 *generated.CreateReviewResponse, arg1 error) *MockReviewServiceClientCreateReviewCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReviewServiceClientCreateReviewCall) Do(f func(context.Context, *generated.CreateReviewRequest, ...grpc.CallOption) (*generated.CreateReviewResponse, error)) *MockReviewServiceClientCreateReviewCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReviewServiceClientCreateReviewCall) DoAndReturn(f func(context.Context, *generated.CreateReviewRequest, ...grpc.CallOption) (*generated.CreateReviewResponse, error)) *MockReviewServiceClientCreateReviewCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewServiceClient) DeleteReview(ctx context.Context, in *generated.DeleteReviewRequest, opts ...grpc.CallOption) (*generated.DeleteReviewResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteReview", varargs...)
+	ret0, _ := ret[0].(*generated.DeleteReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewServiceClientMockRecorder) DeleteReview(ctx, in any, opts ...any) *MockReviewServiceClientDeleteReviewCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewServiceClient)(nil).DeleteReview), varargs...)
+	return &MockReviewServiceClientDeleteReviewCall{Call: call}
+}
+
+// MockReviewServiceClientDeleteReviewCall wrap *gomock.Call
+type MockReviewServiceClientDeleteReviewCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReviewServiceClientDeleteReviewCall) Return(arg0 *generated.DeleteReviewResponse, arg1 error) *MockReviewServiceClientDeleteReviewCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReviewServiceClientDeleteReviewCall) Do(f func(context.Context, *generated.DeleteReviewRequest, ...grpc.CallOption) (*generated.DeleteReviewResponse, error)) *MockReviewServiceClientDeleteReviewCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReviewServiceClientDeleteReviewCall) DoAndReturn(f func(context.Context, *generated.DeleteReviewRequest, ...grpc.CallOption) (*generated.DeleteReviewResponse, error)) *MockReviewServiceClientDeleteReviewCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPOIReviews mocks base method.
+func (m *MockReviewServiceClient) GetPOIReviews(ctx context.Context, in *generated.GetPOIReviewsRequest, opts ...grpc.CallOption) (*generated.GetPOIReviewsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPOIReviews", varargs...)
+	ret0, _ := ret[0].(*generated.GetPOIReviewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPOIReviews indicates an expected call of GetPOIReviews.
+func (mr *MockReviewServiceClientMockRecorder) GetPOIReviews(ctx, in any, opts ...any) *MockReviewServiceClientGetPOIReviewsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPOIReviews", reflect.TypeOf((*MockReviewServiceClient)(nil).GetPOIReviews), varargs...)
+	return &MockReviewServiceClientGetPOIReviewsCall{Call: call}
+}
+
+// MockReviewServiceClientGetPOIReviewsCall wrap *gomock.Call
+type MockReviewServiceClientGetPOIReviewsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReviewServiceClientGetPOIReviewsCall) Return(arg0 *generated.GetPOIReviewsResponse, arg1 error) *MockReviewServiceClientGetPOIReviewsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReviewServiceClientGetPOIReviewsCall) Do(f func(context.Context, *generated.GetPOIReviewsRequest, ...grpc.CallOption) (*generated.GetPOIReviewsResponse, error)) *MockReviewServiceClientGetPOIReviewsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReviewServiceClientGetPOIReviewsCall) DoAndReturn(f func(context.Context, *generated.GetPOIReviewsRequest, ...grpc.CallOption) (*generated.GetPOIReviewsResponse, error)) *MockReviewServiceClientGetPOIReviewsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetReview mocks base method.
+func (m *MockReviewServiceClient) GetReview(ctx context.Context, in *generated.GetReviewRequest, opts ...grpc.CallOption) (*generated.GetReviewResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReview", varargs...)
+	ret0, _ := ret[0].(*generated.GetReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReview indicates an expected call of GetReview.
+func (mr *MockReviewServiceClientMockRecorder) GetReview(ctx, in any, opts ...any) *MockReviewServiceClientGetReviewCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockReviewServiceClient)(nil).GetReview), varargs...)
+	return &MockReviewServiceClientGetReviewCall{Call: call}
+}
+
+// MockReviewServiceClientGetReviewCall wrap *gomock.Call
+type MockReviewServiceClientGetReviewCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReviewServiceClientGetReviewCall) Return(arg0 *generated.GetReviewResponse, arg1 error) *MockReviewServiceClientGetReviewCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReviewServiceClientGetReviewCall) Do(f func(context.Context, *generated.GetReviewRequest, ...grpc.CallOption) (*generated.GetReviewResponse, error)) *MockReviewServiceClientGetReviewCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReviewServiceClientGetReviewCall) DoAndReturn(f func(context.Context, *generated.GetReviewRequest, ...grpc.CallOption) (*generated.GetReviewResponse, error)) *MockReviewServiceClientGetReviewCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetReviewStatistics mocks base method.
+func (m *MockReviewServiceClient) GetReviewStatistics(ctx context.Context, in *generated.GetReviewStatisticsRequest, opts ...grpc.CallOption) (*generated.GetReviewStatisticsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReviewStatistics", varargs...)
+	ret0, _ := ret[0].(*generated.GetReviewStatisticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewStatistics indicates an expected call of GetReviewStatistics.
+func (mr *MockReviewServiceClientMockRecorder) GetReviewStatistics(ctx, in any, opts ...any) *MockReviewServiceClientGetReviewStatisticsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewStatistics", reflect.TypeOf((*MockReviewServiceClient)(nil).GetReviewStatistics), varargs...)
+	return &MockReviewServiceClientGetReviewStatisticsCall{Call: call}
+}
+
+// MockReviewServiceClientGetReviewStatisticsCall wrap *gomock.Call
+type MockReviewServiceClientGetReviewStatisticsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReviewServiceClientGetReviewStatisticsCall) Return(arg0 *generated.GetReviewStatisticsResponse, arg1 error) *MockReviewServiceClientGetReviewStatisticsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReviewServiceClientGetReviewStatisticsCall) Do(f func(context.Context, *generated.GetReviewStatisticsRequest, ...grpc.CallOption) (*generated.GetReviewStatisticsResponse, error)) *MockReviewServiceClientGetReviewStatisticsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReviewServiceClientGetReviewStatisticsCall) DoAndReturn(f func(context.Context, *generated.GetReviewStatisticsRequest, ...grpc.CallOption) (*generated.GetReviewStatisticsResponse, error)) *MockReviewServiceClientGetReviewStatisticsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetUserReviews mocks base method.
+func (m *MockReviewServiceClient) GetUserReviews(ctx context.Context, in *generated.GetUserReviewsRequest, opts ...grpc.CallOption) (*generated.GetUserReviewsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserReviews", varargs...)
+	ret0, _ := ret[0].(*generated.GetUserReviewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserReviews indicates an expected call of GetUserReviews.
+func (mr *MockReviewServiceClientMockRecorder) GetUserReviews(ctx, in any, opts ...any) *MockReviewServiceClientGetUserReviewsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReviews", reflect.TypeOf((*MockReviewServiceClient)(nil).GetUserReviews), varargs...)
+	return &MockReviewServiceClientGetUserReviewsCall{Call: call}
+}
+
+// MockReviewServiceClientGetUserReviewsCall wrap *gomock.Call
+type MockReviewServiceClientGetUserReviewsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReviewServiceClientGetUserReviewsCall) Return(arg0 *generated.GetUserReviewsResponse, arg1 error) *MockReviewServiceClientGetUserReviewsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReviewServiceClientGetUserReviewsCall) Do(f func(context.Context, *generated.GetUserReviewsRequest, ...grpc.CallOption) (*generated.GetUserReviewsResponse, error)) *MockReviewServiceClientGetUserReviewsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReviewServiceClientGetUserReviewsCall) DoAndReturn(f func(context.Context, *generated.GetUserReviewsRequest, ...grpc.CallOption) (*generated.GetUserReviewsResponse, error)) *MockReviewServiceClientGetUserReviewsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LikeReview mocks base method.
+func (m *MockReviewServiceClient) LikeReview(ctx context.Context, in *generated.LikeReviewRequest, opts ...grpc.CallOption) (*generated.LikeReviewResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LikeReview", varargs...)
+	ret0, _ := ret[0].(*generated.LikeReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LikeReview indicates an expected call of LikeReview.
+func (mr *MockReviewServiceClientMockRecorder) LikeReview(ctx, in any, opts ...any) *MockReviewServiceClientLikeReviewCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeReview", reflect.TypeOf((*MockReviewServiceClient)(nil).LikeReview), varargs...)
+	return &MockReviewServiceClientLikeReviewCall{Call: call}
+}
+
+// MockReviewServiceClientLikeReviewCall wrap *gomock.Call
+type MockReviewServiceClientLikeReviewCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReviewServiceClientLikeReviewCall) Return(arg0 *generated.LikeReviewResponse, arg1 error) *MockReviewServiceClientLikeReviewCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReviewServiceClientLikeReviewCall) Do(f func(context.Context, *generated.LikeReviewRequest, ...grpc.CallOption) (*generated.LikeReviewResponse, error)) *MockReviewServiceClientLikeReviewCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReviewServiceClientLikeReviewCall) DoAndReturn(f func(context.Context, *generated.LikeReviewRequest, ...grpc.CallOption) (*generated.LikeReviewResponse, error)) *MockReviewServiceClientLikeReviewCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReportReview mocks base method.
+func (m *MockReviewServiceClient) ReportReview(ctx context.Context, in *generated.ReportReviewRequest, opts ...grpc.CallOption) (*generated.ReportReviewResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReportReview", varargs...)
+	ret0, _ := ret[0].(*generated.ReportReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportReview indicates an expected call of ReportReview.
+func (mr *MockReviewServiceClientMockRecorder) ReportReview(ctx, in any, opts ...any) *MockReviewServiceClientReportReviewCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportReview", reflect.TypeOf((*MockReviewServiceClient)(nil).ReportReview), varargs...)
+	return &MockReviewServiceClientReportReviewCall{Call: call}
+}
+
+// MockReviewServiceClientReportReviewCall wrap *gomock.Call
+type MockReviewServiceClientReportReviewCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReviewServiceClientReportReviewCall) Return(arg0 *generated.ReportReviewResponse, arg1 error) *MockReviewServiceClientReportReviewCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReviewServiceClientReportReviewCall) Do(f func(context.Context, *generated.ReportReviewRequest, ...grpc.CallOption) (*generated.ReportReviewResponse, error)) *MockReviewServiceClientReportReviewCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReviewServiceClientReportReviewCall) DoAndReturn(f func(context.Context, *generated.ReportReviewRequest, ...grpc.CallOption) (*generated.ReportReviewResponse, error)) *MockReviewServiceClientReportReviewCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewServiceClient) UpdateReview(ctx context.Context, in *generated.UpdateReviewRequest, opts ...grpc.CallOption) (*generated.UpdateReviewResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateReview", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewServiceClientMockRecorder) UpdateReview(ctx, in any, opts ...any) *MockReviewServiceClientUpdateReviewCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewServiceClient)(nil).UpdateReview), varargs...)
+	return &MockReviewServiceClientUpdateReviewCall{Call: call}
+}
+
+// MockReviewServiceClientUpdateReviewCall wrap *gomock.Call
+type MockReviewServiceClientUpdateReviewCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReviewServiceClientUpdateReviewCall) Return(arg0 *generated.UpdateReviewResponse, arg1 error) *MockReviewServiceClientUpdateReviewCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReviewServiceClientUpdateReviewCall) Do(f func(context.Context, *generated.UpdateReviewRequest, ...grpc.CallOption) (*generated.UpdateReviewResponse, error)) *MockReviewServiceClientUpdateReviewCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReviewServiceClientUpdateReviewCall) DoAndReturn(f func(context.Context, *generated.UpdateReviewRequest, ...grpc.CallOption) (*generated.UpdateReviewResponse, error)) *MockReviewServiceClientUpdateReviewCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,307 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/statistics/generated (interfaces: StatisticsServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/statistics_mock.go github.com/FACorreiaa/loci-proto/modules/statistics/generated StatisticsServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/statistics/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockStatisticsServiceClient is a mock of StatisticsServiceClient interface.
+type MockStatisticsServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatisticsServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockStatisticsServiceClientMockRecorder is the mock recorder for MockStatisticsServiceClient.
+type MockStatisticsServiceClientMockRecorder struct {
+	mock *MockStatisticsServiceClient
+}
+
+// NewMockStatisticsServiceClient creates a new mock instance.
+func NewMockStatisticsServiceClient(ctrl *gomock.Controller) *MockStatisticsServiceClient {
+	mock := &MockStatisticsServiceClient{ctrl: ctrl}
+	mock.recorder = &MockStatisticsServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatisticsServiceClient) EXPECT() *MockStatisticsServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetDetailedPOIStatistics mocks base method.
+func (m *MockStatisticsServiceClient) GetDetailedPOIStatistics(ctx context.Context, in *generated.GetDetailedPOIStatisticsRequest, opts ...grpc.CallOption) (*generated.GetDetailedPOIStatisticsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDetailedPOIStatistics", varargs...)
+	ret0, _ := ret[0].(*generated.GetDetailedPOIStatisticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDetailedPOIStatistics indicates an expected call of GetDetailedPOIStatistics.
+func (mr *MockStatisticsServiceClientMockRecorder) GetDetailedPOIStatistics(ctx, in any, opts ...any) *MockStatisticsServiceClientGetDetailedPOIStatisticsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetailedPOIStatistics", reflect.TypeOf((*MockStatisticsServiceClient)(nil).GetDetailedPOIStatistics), varargs...)
+	return &MockStatisticsServiceClientGetDetailedPOIStatisticsCall{Call: call}
+}
+
+// MockStatisticsServiceClientGetDetailedPOIStatisticsCall wrap *gomock.Call
+type MockStatisticsServiceClientGetDetailedPOIStatisticsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatisticsServiceClientGetDetailedPOIStatisticsCall) Return(arg0 *generated.GetDetailedPOIStatisticsResponse, arg1 error) *MockStatisticsServiceClientGetDetailedPOIStatisticsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatisticsServiceClientGetDetailedPOIStatisticsCall) Do(f func(context.Context, *generated.GetDetailedPOIStatisticsRequest, ...grpc.CallOption) (*generated.GetDetailedPOIStatisticsResponse, error)) *MockStatisticsServiceClientGetDetailedPOIStatisticsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatisticsServiceClientGetDetailedPOIStatisticsCall) DoAndReturn(f func(context.Context, *generated.GetDetailedPOIStatisticsRequest, ...grpc.CallOption) (*generated.GetDetailedPOIStatisticsResponse, error)) *MockStatisticsServiceClientGetDetailedPOIStatisticsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetLandingPageStatistics mocks base method.
+func (m *MockStatisticsServiceClient) GetLandingPageStatistics(ctx context.Context, in *generated.GetLandingPageStatisticsRequest, opts ...grpc.CallOption) (*generated.GetLandingPageStatisticsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLandingPageStatistics", varargs...)
+	ret0, _ := ret[0].(*generated.GetLandingPageStatisticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLandingPageStatistics indicates an expected call of GetLandingPageStatistics.
+func (mr *MockStatisticsServiceClientMockRecorder) GetLandingPageStatistics(ctx, in any, opts ...any) *MockStatisticsServiceClientGetLandingPageStatisticsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLandingPageStatistics", reflect.TypeOf((*MockStatisticsServiceClient)(nil).GetLandingPageStatistics), varargs...)
+	return &MockStatisticsServiceClientGetLandingPageStatisticsCall{Call: call}
+}
+
+// MockStatisticsServiceClientGetLandingPageStatisticsCall wrap *gomock.Call
+type MockStatisticsServiceClientGetLandingPageStatisticsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatisticsServiceClientGetLandingPageStatisticsCall) Return(arg0 *generated.GetLandingPageStatisticsResponse, arg1 error) *MockStatisticsServiceClientGetLandingPageStatisticsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatisticsServiceClientGetLandingPageStatisticsCall) Do(f func(context.Context, *generated.GetLandingPageStatisticsRequest, ...grpc.CallOption) (*generated.GetLandingPageStatisticsResponse, error)) *MockStatisticsServiceClientGetLandingPageStatisticsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatisticsServiceClientGetLandingPageStatisticsCall) DoAndReturn(f func(context.Context, *generated.GetLandingPageStatisticsRequest, ...grpc.CallOption) (*generated.GetLandingPageStatisticsResponse, error)) *MockStatisticsServiceClientGetLandingPageStatisticsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMainPageStatistics mocks base method.
+func (m *MockStatisticsServiceClient) GetMainPageStatistics(ctx context.Context, in *generated.GetMainPageStatisticsRequest, opts ...grpc.CallOption) (*generated.GetMainPageStatisticsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMainPageStatistics", varargs...)
+	ret0, _ := ret[0].(*generated.GetMainPageStatisticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMainPageStatistics indicates an expected call of GetMainPageStatistics.
+func (mr *MockStatisticsServiceClientMockRecorder) GetMainPageStatistics(ctx, in any, opts ...any) *MockStatisticsServiceClientGetMainPageStatisticsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMainPageStatistics", reflect.TypeOf((*MockStatisticsServiceClient)(nil).GetMainPageStatistics), varargs...)
+	return &MockStatisticsServiceClientGetMainPageStatisticsCall{Call: call}
+}
+
+// MockStatisticsServiceClientGetMainPageStatisticsCall wrap *gomock.Call
+type MockStatisticsServiceClientGetMainPageStatisticsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatisticsServiceClientGetMainPageStatisticsCall) Return(arg0 *generated.GetMainPageStatisticsResponse, arg1 error) *MockStatisticsServiceClientGetMainPageStatisticsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatisticsServiceClientGetMainPageStatisticsCall) Do(f func(context.Context, *generated.GetMainPageStatisticsRequest, ...grpc.CallOption) (*generated.GetMainPageStatisticsResponse, error)) *MockStatisticsServiceClientGetMainPageStatisticsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatisticsServiceClientGetMainPageStatisticsCall) DoAndReturn(f func(context.Context, *generated.GetMainPageStatisticsRequest, ...grpc.CallOption) (*generated.GetMainPageStatisticsResponse, error)) *MockStatisticsServiceClientGetMainPageStatisticsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSystemAnalytics mocks base method.
+func (m *MockStatisticsServiceClient) GetSystemAnalytics(ctx context.Context, in *generated.GetSystemAnalyticsRequest, opts ...grpc.CallOption) (*generated.GetSystemAnalyticsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSystemAnalytics", varargs...)
+	ret0, _ := ret[0].(*generated.GetSystemAnalyticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSystemAnalytics indicates an expected call of GetSystemAnalytics.
+func (mr *MockStatisticsServiceClientMockRecorder) GetSystemAnalytics(ctx, in any, opts ...any) *MockStatisticsServiceClientGetSystemAnalyticsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemAnalytics", reflect.TypeOf((*MockStatisticsServiceClient)(nil).GetSystemAnalytics), varargs...)
+	return &MockStatisticsServiceClientGetSystemAnalyticsCall{Call: call}
+}
+
+// MockStatisticsServiceClientGetSystemAnalyticsCall wrap *gomock.Call
+type MockStatisticsServiceClientGetSystemAnalyticsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatisticsServiceClientGetSystemAnalyticsCall) Return(arg0 *generated.GetSystemAnalyticsResponse, arg1 error) *MockStatisticsServiceClientGetSystemAnalyticsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatisticsServiceClientGetSystemAnalyticsCall) Do(f func(context.Context, *generated.GetSystemAnalyticsRequest, ...grpc.CallOption) (*generated.GetSystemAnalyticsResponse, error)) *MockStatisticsServiceClientGetSystemAnalyticsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatisticsServiceClientGetSystemAnalyticsCall) DoAndReturn(f func(context.Context, *generated.GetSystemAnalyticsRequest, ...grpc.CallOption) (*generated.GetSystemAnalyticsResponse, error)) *MockStatisticsServiceClientGetSystemAnalyticsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetUserActivityAnalytics mocks base method.
+func (m *MockStatisticsServiceClient) GetUserActivityAnalytics(ctx context.Context, in *generated.GetUserActivityAnalyticsRequest, opts ...grpc.CallOption) (*generated.GetUserActivityAnalyticsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserActivityAnalytics", varargs...)
+	ret0, _ := ret[0].(*generated.GetUserActivityAnalyticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserActivityAnalytics indicates an expected call of GetUserActivityAnalytics.
+func (mr *MockStatisticsServiceClientMockRecorder) GetUserActivityAnalytics(ctx, in any, opts ...any) *MockStatisticsServiceClientGetUserActivityAnalyticsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserActivityAnalytics", reflect.TypeOf((*MockStatisticsServiceClient)(nil).GetUserActivityAnalytics), varargs...)
+	return &MockStatisticsServiceClientGetUserActivityAnalyticsCall{Call: call}
+}
+
+// MockStatisticsServiceClientGetUserActivityAnalyticsCall wrap *gomock.Call
+type MockStatisticsServiceClientGetUserActivityAnalyticsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatisticsServiceClientGetUserActivityAnalyticsCall) Return(arg0 *generated.GetUserActivityAnalyticsResponse, arg1 error) *MockStatisticsServiceClientGetUserActivityAnalyticsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatisticsServiceClientGetUserActivityAnalyticsCall) Do(f func(context.Context, *generated.GetUserActivityAnalyticsRequest, ...grpc.CallOption) (*generated.GetUserActivityAnalyticsResponse, error)) *MockStatisticsServiceClientGetUserActivityAnalyticsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatisticsServiceClientGetUserActivityAnalyticsCall) DoAndReturn(f func(context.Context, *generated.GetUserActivityAnalyticsRequest, ...grpc.CallOption) (*generated.GetUserActivityAnalyticsResponse, error)) *MockStatisticsServiceClientGetUserActivityAnalyticsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StreamMainPageStatistics mocks base method.
+func (m *MockStatisticsServiceClient) StreamMainPageStatistics(ctx context.Context, in *generated.StreamMainPageStatisticsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[generated.StatisticsEvent], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamMainPageStatistics", varargs...)
+	ret0, _ := ret[0].(grpc.ServerStreamingClient[generated.StatisticsEvent])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamMainPageStatistics indicates an expected call of StreamMainPageStatistics.
+func (mr *MockStatisticsServiceClientMockRecorder) StreamMainPageStatistics(ctx, in any, opts ...any) *MockStatisticsServiceClientStreamMainPageStatisticsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamMainPageStatistics", reflect.TypeOf((*MockStatisticsServiceClient)(nil).StreamMainPageStatistics), varargs...)
+	return &MockStatisticsServiceClientStreamMainPageStatisticsCall{Call: call}
+}
+
+// MockStatisticsServiceClientStreamMainPageStatisticsCall wrap *gomock.Call
+type MockStatisticsServiceClientStreamMainPageStatisticsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatisticsServiceClientStreamMainPageStatisticsCall) Return(arg0 grpc.ServerStreamingClient[generated.StatisticsEvent], arg1 error) *MockStatisticsServiceClientStreamMainPageStatisticsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatisticsServiceClientStreamMainPageStatisticsCall) Do(f func(context.Context, *generated.StreamMainPageStatisticsRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[generated.StatisticsEvent], error)) *MockStatisticsServiceClientStreamMainPageStatisticsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatisticsServiceClientStreamMainPageStatisticsCall) DoAndReturn(f func(context.Context, *generated.StreamMainPageStatisticsRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[generated.StatisticsEvent], error)) *MockStatisticsServiceClientStreamMainPageStatisticsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
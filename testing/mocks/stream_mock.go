@@ -0,0 +1,310 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: google.golang.org/grpc (interfaces: ServerStreamingClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/stream_mock.go google.golang.org/grpc ServerStreamingClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	metadata "google.golang.org/grpc/metadata"
+)
+
+// MockServerStreamingClient is a mock of ServerStreamingClient interface.
+type MockServerStreamingClient[Res any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockServerStreamingClientMockRecorder[Res]
+	isgomock struct{}
+}
+
+// MockServerStreamingClientMockRecorder is the mock recorder for MockServerStreamingClient.
+type MockServerStreamingClientMockRecorder[Res any] struct {
+	mock *MockServerStreamingClient[Res]
+}
+
+// NewMockServerStreamingClient creates a new mock instance.
+func NewMockServerStreamingClient[Res any](ctrl *gomock.Controller) *MockServerStreamingClient[Res] {
+	mock := &MockServerStreamingClient[Res]{ctrl: ctrl}
+	mock.recorder = &MockServerStreamingClientMockRecorder[Res]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServerStreamingClient[Res]) EXPECT() *MockServerStreamingClientMockRecorder[Res] {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockServerStreamingClient[Res]) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockServerStreamingClientMockRecorder[Res]) CloseSend() *MockServerStreamingClientCloseSendCall[Res] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockServerStreamingClient[Res])(nil).CloseSend))
+	return &MockServerStreamingClientCloseSendCall[Res]{Call: call}
+}
+
+// MockServerStreamingClientCloseSendCall wrap *gomock.Call
+type MockServerStreamingClientCloseSendCall[Res any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerStreamingClientCloseSendCall[Res]) Return(arg0 error) *MockServerStreamingClientCloseSendCall[Res] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerStreamingClientCloseSendCall[Res]) Do(f func() error) *MockServerStreamingClientCloseSendCall[Res] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerStreamingClientCloseSendCall[Res]) DoAndReturn(f func() error) *MockServerStreamingClientCloseSendCall[Res] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Context mocks base method.
+func (m *MockServerStreamingClient[Res]) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockServerStreamingClientMockRecorder[Res]) Context() *MockServerStreamingClientContextCall[Res] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockServerStreamingClient[Res])(nil).Context))
+	return &MockServerStreamingClientContextCall[Res]{Call: call}
+}
+
+// MockServerStreamingClientContextCall wrap *gomock.Call
+type MockServerStreamingClientContextCall[Res any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerStreamingClientContextCall[Res]) Return(arg0 context.Context) *MockServerStreamingClientContextCall[Res] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerStreamingClientContextCall[Res]) Do(f func() context.Context) *MockServerStreamingClientContextCall[Res] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerStreamingClientContextCall[Res]) DoAndReturn(f func() context.Context) *MockServerStreamingClientContextCall[Res] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Header mocks base method.
+func (m *MockServerStreamingClient[Res]) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockServerStreamingClientMockRecorder[Res]) Header() *MockServerStreamingClientHeaderCall[Res] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockServerStreamingClient[Res])(nil).Header))
+	return &MockServerStreamingClientHeaderCall[Res]{Call: call}
+}
+
+// MockServerStreamingClientHeaderCall wrap *gomock.Call
+type MockServerStreamingClientHeaderCall[Res any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerStreamingClientHeaderCall[Res]) Return(arg0 metadata.MD, arg1 error) *MockServerStreamingClientHeaderCall[Res] {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerStreamingClientHeaderCall[Res]) Do(f func() (metadata.MD, error)) *MockServerStreamingClientHeaderCall[Res] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerStreamingClientHeaderCall[Res]) DoAndReturn(f func() (metadata.MD, error)) *MockServerStreamingClientHeaderCall[Res] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Recv mocks base method.
+func (m *MockServerStreamingClient[Res]) Recv() (*Res, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*Res)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockServerStreamingClientMockRecorder[Res]) Recv() *MockServerStreamingClientRecvCall[Res] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockServerStreamingClient[Res])(nil).Recv))
+	return &MockServerStreamingClientRecvCall[Res]{Call: call}
+}
+
+// MockServerStreamingClientRecvCall wrap *gomock.Call
+type MockServerStreamingClientRecvCall[Res any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerStreamingClientRecvCall[Res]) Return(arg0 *Res, arg1 error) *MockServerStreamingClientRecvCall[Res] {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerStreamingClientRecvCall[Res]) Do(f func() (*Res, error)) *MockServerStreamingClientRecvCall[Res] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerStreamingClientRecvCall[Res]) DoAndReturn(f func() (*Res, error)) *MockServerStreamingClientRecvCall[Res] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockServerStreamingClient[Res]) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockServerStreamingClientMockRecorder[Res]) RecvMsg(m any) *MockServerStreamingClientRecvMsgCall[Res] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockServerStreamingClient[Res])(nil).RecvMsg), m)
+	return &MockServerStreamingClientRecvMsgCall[Res]{Call: call}
+}
+
+// MockServerStreamingClientRecvMsgCall wrap *gomock.Call
+type MockServerStreamingClientRecvMsgCall[Res any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerStreamingClientRecvMsgCall[Res]) Return(arg0 error) *MockServerStreamingClientRecvMsgCall[Res] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerStreamingClientRecvMsgCall[Res]) Do(f func(any) error) *MockServerStreamingClientRecvMsgCall[Res] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerStreamingClientRecvMsgCall[Res]) DoAndReturn(f func(any) error) *MockServerStreamingClientRecvMsgCall[Res] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockServerStreamingClient[Res]) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockServerStreamingClientMockRecorder[Res]) SendMsg(m any) *MockServerStreamingClientSendMsgCall[Res] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockServerStreamingClient[Res])(nil).SendMsg), m)
+	return &MockServerStreamingClientSendMsgCall[Res]{Call: call}
+}
+
+// MockServerStreamingClientSendMsgCall wrap *gomock.Call
+type MockServerStreamingClientSendMsgCall[Res any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerStreamingClientSendMsgCall[Res]) Return(arg0 error) *MockServerStreamingClientSendMsgCall[Res] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerStreamingClientSendMsgCall[Res]) Do(f func(any) error) *MockServerStreamingClientSendMsgCall[Res] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerStreamingClientSendMsgCall[Res]) DoAndReturn(f func(any) error) *MockServerStreamingClientSendMsgCall[Res] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Trailer mocks base method.
+func (m *MockServerStreamingClient[Res]) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockServerStreamingClientMockRecorder[Res]) Trailer() *MockServerStreamingClientTrailerCall[Res] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockServerStreamingClient[Res])(nil).Trailer))
+	return &MockServerStreamingClientTrailerCall[Res]{Call: call}
+}
+
+// MockServerStreamingClientTrailerCall wrap *gomock.Call
+type MockServerStreamingClientTrailerCall[Res any] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerStreamingClientTrailerCall[Res]) Return(arg0 metadata.MD) *MockServerStreamingClientTrailerCall[Res] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerStreamingClientTrailerCall[Res]) Do(f func() metadata.MD) *MockServerStreamingClientTrailerCall[Res] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerStreamingClientTrailerCall[Res]) DoAndReturn(f func() metadata.MD) *MockServerStreamingClientTrailerCall[Res] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
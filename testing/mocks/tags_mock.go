@@ -0,0 +1,263 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/tags/generated (interfaces: TagsServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/tags_mock.go github.com/FACorreiaa/loci-proto/modules/tags/generated TagsServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/tags/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockTagsServiceClient is a mock of TagsServiceClient interface.
+type MockTagsServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagsServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockTagsServiceClientMockRecorder is the mock recorder for MockTagsServiceClient.
+type MockTagsServiceClientMockRecorder struct {
+	mock *MockTagsServiceClient
+}
+
+// NewMockTagsServiceClient creates a new mock instance.
+func NewMockTagsServiceClient(ctrl *gomock.Controller) *MockTagsServiceClient {
+	mock := &MockTagsServiceClient{ctrl: ctrl}
+	mock.recorder = &MockTagsServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagsServiceClient) EXPECT() *MockTagsServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateTag mocks base method.
+func (m *MockTagsServiceClient) CreateTag(ctx context.Context, in *generated.CreateTagRequest, opts ...grpc.CallOption) (*generated.CreateTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTag", varargs...)
+	ret0, _ := ret[0].(*generated.CreateTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockTagsServiceClientMockRecorder) CreateTag(ctx, in any, opts ...any) *MockTagsServiceClientCreateTagCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockTagsServiceClient)(nil).CreateTag), varargs...)
+	return &MockTagsServiceClientCreateTagCall{Call: call}
+}
+
+// MockTagsServiceClientCreateTagCall wrap *gomock.Call
+type MockTagsServiceClientCreateTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTagsServiceClientCreateTagCall) Return(arg0 *generated.CreateTagResponse, arg1 error) *MockTagsServiceClientCreateTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTagsServiceClientCreateTagCall) Do(f func(context.Context, *generated.CreateTagRequest, ...grpc.CallOption) (*generated.CreateTagResponse, error)) *MockTagsServiceClientCreateTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTagsServiceClientCreateTagCall) DoAndReturn(f func(context.Context, *generated.CreateTagRequest, ...grpc.CallOption) (*generated.CreateTagResponse, error)) *MockTagsServiceClientCreateTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteTag mocks base method.
+func (m *MockTagsServiceClient) DeleteTag(ctx context.Context, in *generated.DeleteTagRequest, opts ...grpc.CallOption) (*generated.DeleteTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTag", varargs...)
+	ret0, _ := ret[0].(*generated.DeleteTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockTagsServiceClientMockRecorder) DeleteTag(ctx, in any, opts ...any) *MockTagsServiceClientDeleteTagCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockTagsServiceClient)(nil).DeleteTag), varargs...)
+	return &MockTagsServiceClientDeleteTagCall{Call: call}
+}
+
+// MockTagsServiceClientDeleteTagCall wrap *gomock.Call
+type MockTagsServiceClientDeleteTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTagsServiceClientDeleteTagCall) Return(arg0 *generated.DeleteTagResponse, arg1 error) *MockTagsServiceClientDeleteTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTagsServiceClientDeleteTagCall) Do(f func(context.Context, *generated.DeleteTagRequest, ...grpc.CallOption) (*generated.DeleteTagResponse, error)) *MockTagsServiceClientDeleteTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTagsServiceClientDeleteTagCall) DoAndReturn(f func(context.Context, *generated.DeleteTagRequest, ...grpc.CallOption) (*generated.DeleteTagResponse, error)) *MockTagsServiceClientDeleteTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTag mocks base method.
+func (m *MockTagsServiceClient) GetTag(ctx context.Context, in *generated.GetTagRequest, opts ...grpc.CallOption) (*generated.GetTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTag", varargs...)
+	ret0, _ := ret[0].(*generated.GetTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTag indicates an expected call of GetTag.
+func (mr *MockTagsServiceClientMockRecorder) GetTag(ctx, in any, opts ...any) *MockTagsServiceClientGetTagCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockTagsServiceClient)(nil).GetTag), varargs...)
+	return &MockTagsServiceClientGetTagCall{Call: call}
+}
+
+// MockTagsServiceClientGetTagCall wrap *gomock.Call
+type MockTagsServiceClientGetTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTagsServiceClientGetTagCall) Return(arg0 *generated.GetTagResponse, arg1 error) *MockTagsServiceClientGetTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTagsServiceClientGetTagCall) Do(f func(context.Context, *generated.GetTagRequest, ...grpc.CallOption) (*generated.GetTagResponse, error)) *MockTagsServiceClientGetTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTagsServiceClientGetTagCall) DoAndReturn(f func(context.Context, *generated.GetTagRequest, ...grpc.CallOption) (*generated.GetTagResponse, error)) *MockTagsServiceClientGetTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTags mocks base method.
+func (m *MockTagsServiceClient) GetTags(ctx context.Context, in *generated.GetTagsRequest, opts ...grpc.CallOption) (*generated.GetTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTags", varargs...)
+	ret0, _ := ret[0].(*generated.GetTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockTagsServiceClientMockRecorder) GetTags(ctx, in any, opts ...any) *MockTagsServiceClientGetTagsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockTagsServiceClient)(nil).GetTags), varargs...)
+	return &MockTagsServiceClientGetTagsCall{Call: call}
+}
+
+// MockTagsServiceClientGetTagsCall wrap *gomock.Call
+type MockTagsServiceClientGetTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTagsServiceClientGetTagsCall) Return(arg0 *generated.GetTagsResponse, arg1 error) *MockTagsServiceClientGetTagsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTagsServiceClientGetTagsCall) Do(f func(context.Context, *generated.GetTagsRequest, ...grpc.CallOption) (*generated.GetTagsResponse, error)) *MockTagsServiceClientGetTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTagsServiceClientGetTagsCall) DoAndReturn(f func(context.Context, *generated.GetTagsRequest, ...grpc.CallOption) (*generated.GetTagsResponse, error)) *MockTagsServiceClientGetTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateTag mocks base method.
+func (m *MockTagsServiceClient) UpdateTag(ctx context.Context, in *generated.UpdateTagRequest, opts ...grpc.CallOption) (*generated.UpdateTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTag", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockTagsServiceClientMockRecorder) UpdateTag(ctx, in any, opts ...any) *MockTagsServiceClientUpdateTagCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockTagsServiceClient)(nil).UpdateTag), varargs...)
+	return &MockTagsServiceClientUpdateTagCall{Call: call}
+}
+
+// MockTagsServiceClientUpdateTagCall wrap *gomock.Call
+type MockTagsServiceClientUpdateTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTagsServiceClientUpdateTagCall) Return(arg0 *generated.UpdateTagResponse, arg1 error) *MockTagsServiceClientUpdateTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTagsServiceClientUpdateTagCall) Do(f func(context.Context, *generated.UpdateTagRequest, ...grpc.CallOption) (*generated.UpdateTagResponse, error)) *MockTagsServiceClientUpdateTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTagsServiceClientUpdateTagCall) DoAndReturn(f func(context.Context, *generated.UpdateTagRequest, ...grpc.CallOption) (*generated.UpdateTagResponse, error)) *MockTagsServiceClientUpdateTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
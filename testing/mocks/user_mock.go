@@ -0,0 +1,835 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/FACorreiaa/loci-proto/modules/user/generated (interfaces: UserServiceClient)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package=mocks -self_package=github.com/FACorreiaa/loci-proto/testing/mocks -destination=./testing/mocks/user_mock.go github.com/FACorreiaa/loci-proto/modules/user/generated UserServiceClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	generated "github.com/FACorreiaa/loci-proto/modules/user/generated"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockUserServiceClient is a mock of UserServiceClient interface.
+type MockUserServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockUserServiceClientMockRecorder is the mock recorder for MockUserServiceClient.
+type MockUserServiceClientMockRecorder struct {
+	mock *MockUserServiceClient
+}
+
+// NewMockUserServiceClient creates a new mock instance.
+func NewMockUserServiceClient(ctrl *gomock.Controller) *MockUserServiceClient {
+	mock := &MockUserServiceClient{ctrl: ctrl}
+	mock.recorder = &MockUserServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserServiceClient) EXPECT() *MockUserServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateInterest mocks base method.
+func (m *MockUserServiceClient) CreateInterest(ctx context.Context, in *generated.CreateInterestRequest, opts ...grpc.CallOption) (*generated.CreateInterestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateInterest", varargs...)
+	ret0, _ := ret[0].(*generated.CreateInterestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterest indicates an expected call of CreateInterest.
+func (mr *MockUserServiceClientMockRecorder) CreateInterest(ctx, in any, opts ...any) *MockUserServiceClientCreateInterestCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterest", reflect.TypeOf((*MockUserServiceClient)(nil).CreateInterest), varargs...)
+	return &MockUserServiceClientCreateInterestCall{Call: call}
+}
+
+// MockUserServiceClientCreateInterestCall wrap *gomock.Call
+type MockUserServiceClientCreateInterestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientCreateInterestCall) Return(arg0 *generated.CreateInterestResponse, arg1 error) *MockUserServiceClientCreateInterestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientCreateInterestCall) Do(f func(context.Context, *generated.CreateInterestRequest, ...grpc.CallOption) (*generated.CreateInterestResponse, error)) *MockUserServiceClientCreateInterestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientCreateInterestCall) DoAndReturn(f func(context.Context, *generated.CreateInterestRequest, ...grpc.CallOption) (*generated.CreateInterestResponse, error)) *MockUserServiceClientCreateInterestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateSearchProfile mocks base method.
+func (m *MockUserServiceClient) CreateSearchProfile(ctx context.Context, in *generated.CreateSearchProfileRequest, opts ...grpc.CallOption) (*generated.CreateSearchProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSearchProfile", varargs...)
+	ret0, _ := ret[0].(*generated.CreateSearchProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSearchProfile indicates an expected call of CreateSearchProfile.
+func (mr *MockUserServiceClientMockRecorder) CreateSearchProfile(ctx, in any, opts ...any) *MockUserServiceClientCreateSearchProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSearchProfile", reflect.TypeOf((*MockUserServiceClient)(nil).CreateSearchProfile), varargs...)
+	return &MockUserServiceClientCreateSearchProfileCall{Call: call}
+}
+
+// MockUserServiceClientCreateSearchProfileCall wrap *gomock.Call
+type MockUserServiceClientCreateSearchProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientCreateSearchProfileCall) Return(arg0 *generated.CreateSearchProfileResponse, arg1 error) *MockUserServiceClientCreateSearchProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientCreateSearchProfileCall) Do(f func(context.Context, *generated.CreateSearchProfileRequest, ...grpc.CallOption) (*generated.CreateSearchProfileResponse, error)) *MockUserServiceClientCreateSearchProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientCreateSearchProfileCall) DoAndReturn(f func(context.Context, *generated.CreateSearchProfileRequest, ...grpc.CallOption) (*generated.CreateSearchProfileResponse, error)) *MockUserServiceClientCreateSearchProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateTag mocks base method.
+func (m *MockUserServiceClient) CreateTag(ctx context.Context, in *generated.CreateTagRequest, opts ...grpc.CallOption) (*generated.CreateTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTag", varargs...)
+	ret0, _ := ret[0].(*generated.CreateTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockUserServiceClientMockRecorder) CreateTag(ctx, in any, opts ...any) *MockUserServiceClientCreateTagCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockUserServiceClient)(nil).CreateTag), varargs...)
+	return &MockUserServiceClientCreateTagCall{Call: call}
+}
+
+// MockUserServiceClientCreateTagCall wrap *gomock.Call
+type MockUserServiceClientCreateTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientCreateTagCall) Return(arg0 *generated.CreateTagResponse, arg1 error) *MockUserServiceClientCreateTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientCreateTagCall) Do(f func(context.Context, *generated.CreateTagRequest, ...grpc.CallOption) (*generated.CreateTagResponse, error)) *MockUserServiceClientCreateTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientCreateTagCall) DoAndReturn(f func(context.Context, *generated.CreateTagRequest, ...grpc.CallOption) (*generated.CreateTagResponse, error)) *MockUserServiceClientCreateTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteInterest mocks base method.
+func (m *MockUserServiceClient) DeleteInterest(ctx context.Context, in *generated.DeleteInterestRequest, opts ...grpc.CallOption) (*generated.DeleteInterestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteInterest", varargs...)
+	ret0, _ := ret[0].(*generated.DeleteInterestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInterest indicates an expected call of DeleteInterest.
+func (mr *MockUserServiceClientMockRecorder) DeleteInterest(ctx, in any, opts ...any) *MockUserServiceClientDeleteInterestCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInterest", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteInterest), varargs...)
+	return &MockUserServiceClientDeleteInterestCall{Call: call}
+}
+
+// MockUserServiceClientDeleteInterestCall wrap *gomock.Call
+type MockUserServiceClientDeleteInterestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientDeleteInterestCall) Return(arg0 *generated.DeleteInterestResponse, arg1 error) *MockUserServiceClientDeleteInterestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientDeleteInterestCall) Do(f func(context.Context, *generated.DeleteInterestRequest, ...grpc.CallOption) (*generated.DeleteInterestResponse, error)) *MockUserServiceClientDeleteInterestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientDeleteInterestCall) DoAndReturn(f func(context.Context, *generated.DeleteInterestRequest, ...grpc.CallOption) (*generated.DeleteInterestResponse, error)) *MockUserServiceClientDeleteInterestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSearchProfile mocks base method.
+func (m *MockUserServiceClient) DeleteSearchProfile(ctx context.Context, in *generated.DeleteSearchProfileRequest, opts ...grpc.CallOption) (*generated.DeleteSearchProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSearchProfile", varargs...)
+	ret0, _ := ret[0].(*generated.DeleteSearchProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSearchProfile indicates an expected call of DeleteSearchProfile.
+func (mr *MockUserServiceClientMockRecorder) DeleteSearchProfile(ctx, in any, opts ...any) *MockUserServiceClientDeleteSearchProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSearchProfile", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteSearchProfile), varargs...)
+	return &MockUserServiceClientDeleteSearchProfileCall{Call: call}
+}
+
+// MockUserServiceClientDeleteSearchProfileCall wrap *gomock.Call
+type MockUserServiceClientDeleteSearchProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientDeleteSearchProfileCall) Return(arg0 *generated.DeleteSearchProfileResponse, arg1 error) *MockUserServiceClientDeleteSearchProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientDeleteSearchProfileCall) Do(f func(context.Context, *generated.DeleteSearchProfileRequest, ...grpc.CallOption) (*generated.DeleteSearchProfileResponse, error)) *MockUserServiceClientDeleteSearchProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientDeleteSearchProfileCall) DoAndReturn(f func(context.Context, *generated.DeleteSearchProfileRequest, ...grpc.CallOption) (*generated.DeleteSearchProfileResponse, error)) *MockUserServiceClientDeleteSearchProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteTag mocks base method.
+func (m *MockUserServiceClient) DeleteTag(ctx context.Context, in *generated.DeleteTagRequest, opts ...grpc.CallOption) (*generated.DeleteTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTag", varargs...)
+	ret0, _ := ret[0].(*generated.DeleteTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockUserServiceClientMockRecorder) DeleteTag(ctx, in any, opts ...any) *MockUserServiceClientDeleteTagCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteTag), varargs...)
+	return &MockUserServiceClientDeleteTagCall{Call: call}
+}
+
+// MockUserServiceClientDeleteTagCall wrap *gomock.Call
+type MockUserServiceClientDeleteTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientDeleteTagCall) Return(arg0 *generated.DeleteTagResponse, arg1 error) *MockUserServiceClientDeleteTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientDeleteTagCall) Do(f func(context.Context, *generated.DeleteTagRequest, ...grpc.CallOption) (*generated.DeleteTagResponse, error)) *MockUserServiceClientDeleteTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientDeleteTagCall) DoAndReturn(f func(context.Context, *generated.DeleteTagRequest, ...grpc.CallOption) (*generated.DeleteTagResponse, error)) *MockUserServiceClientDeleteTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetDefaultProfile mocks base method.
+func (m *MockUserServiceClient) GetDefaultProfile(ctx context.Context, in *generated.GetDefaultProfileRequest, opts ...grpc.CallOption) (*generated.GetDefaultProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDefaultProfile", varargs...)
+	ret0, _ := ret[0].(*generated.GetDefaultProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultProfile indicates an expected call of GetDefaultProfile.
+func (mr *MockUserServiceClientMockRecorder) GetDefaultProfile(ctx, in any, opts ...any) *MockUserServiceClientGetDefaultProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultProfile", reflect.TypeOf((*MockUserServiceClient)(nil).GetDefaultProfile), varargs...)
+	return &MockUserServiceClientGetDefaultProfileCall{Call: call}
+}
+
+// MockUserServiceClientGetDefaultProfileCall wrap *gomock.Call
+type MockUserServiceClientGetDefaultProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientGetDefaultProfileCall) Return(arg0 *generated.GetDefaultProfileResponse, arg1 error) *MockUserServiceClientGetDefaultProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientGetDefaultProfileCall) Do(f func(context.Context, *generated.GetDefaultProfileRequest, ...grpc.CallOption) (*generated.GetDefaultProfileResponse, error)) *MockUserServiceClientGetDefaultProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientGetDefaultProfileCall) DoAndReturn(f func(context.Context, *generated.GetDefaultProfileRequest, ...grpc.CallOption) (*generated.GetDefaultProfileResponse, error)) *MockUserServiceClientGetDefaultProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetInterests mocks base method.
+func (m *MockUserServiceClient) GetInterests(ctx context.Context, in *generated.GetInterestsRequest, opts ...grpc.CallOption) (*generated.GetInterestsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInterests", varargs...)
+	ret0, _ := ret[0].(*generated.GetInterestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInterests indicates an expected call of GetInterests.
+func (mr *MockUserServiceClientMockRecorder) GetInterests(ctx, in any, opts ...any) *MockUserServiceClientGetInterestsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterests", reflect.TypeOf((*MockUserServiceClient)(nil).GetInterests), varargs...)
+	return &MockUserServiceClientGetInterestsCall{Call: call}
+}
+
+// MockUserServiceClientGetInterestsCall wrap *gomock.Call
+type MockUserServiceClientGetInterestsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientGetInterestsCall) Return(arg0 *generated.GetInterestsResponse, arg1 error) *MockUserServiceClientGetInterestsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientGetInterestsCall) Do(f func(context.Context, *generated.GetInterestsRequest, ...grpc.CallOption) (*generated.GetInterestsResponse, error)) *MockUserServiceClientGetInterestsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientGetInterestsCall) DoAndReturn(f func(context.Context, *generated.GetInterestsRequest, ...grpc.CallOption) (*generated.GetInterestsResponse, error)) *MockUserServiceClientGetInterestsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSearchProfile mocks base method.
+func (m *MockUserServiceClient) GetSearchProfile(ctx context.Context, in *generated.GetSearchProfileRequest, opts ...grpc.CallOption) (*generated.GetSearchProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSearchProfile", varargs...)
+	ret0, _ := ret[0].(*generated.GetSearchProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchProfile indicates an expected call of GetSearchProfile.
+func (mr *MockUserServiceClientMockRecorder) GetSearchProfile(ctx, in any, opts ...any) *MockUserServiceClientGetSearchProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchProfile", reflect.TypeOf((*MockUserServiceClient)(nil).GetSearchProfile), varargs...)
+	return &MockUserServiceClientGetSearchProfileCall{Call: call}
+}
+
+// MockUserServiceClientGetSearchProfileCall wrap *gomock.Call
+type MockUserServiceClientGetSearchProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientGetSearchProfileCall) Return(arg0 *generated.GetSearchProfileResponse, arg1 error) *MockUserServiceClientGetSearchProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientGetSearchProfileCall) Do(f func(context.Context, *generated.GetSearchProfileRequest, ...grpc.CallOption) (*generated.GetSearchProfileResponse, error)) *MockUserServiceClientGetSearchProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientGetSearchProfileCall) DoAndReturn(f func(context.Context, *generated.GetSearchProfileRequest, ...grpc.CallOption) (*generated.GetSearchProfileResponse, error)) *MockUserServiceClientGetSearchProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSearchProfiles mocks base method.
+func (m *MockUserServiceClient) GetSearchProfiles(ctx context.Context, in *generated.GetSearchProfilesRequest, opts ...grpc.CallOption) (*generated.GetSearchProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSearchProfiles", varargs...)
+	ret0, _ := ret[0].(*generated.GetSearchProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchProfiles indicates an expected call of GetSearchProfiles.
+func (mr *MockUserServiceClientMockRecorder) GetSearchProfiles(ctx, in any, opts ...any) *MockUserServiceClientGetSearchProfilesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchProfiles", reflect.TypeOf((*MockUserServiceClient)(nil).GetSearchProfiles), varargs...)
+	return &MockUserServiceClientGetSearchProfilesCall{Call: call}
+}
+
+// MockUserServiceClientGetSearchProfilesCall wrap *gomock.Call
+type MockUserServiceClientGetSearchProfilesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientGetSearchProfilesCall) Return(arg0 *generated.GetSearchProfilesResponse, arg1 error) *MockUserServiceClientGetSearchProfilesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientGetSearchProfilesCall) Do(f func(context.Context, *generated.GetSearchProfilesRequest, ...grpc.CallOption) (*generated.GetSearchProfilesResponse, error)) *MockUserServiceClientGetSearchProfilesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientGetSearchProfilesCall) DoAndReturn(f func(context.Context, *generated.GetSearchProfilesRequest, ...grpc.CallOption) (*generated.GetSearchProfilesResponse, error)) *MockUserServiceClientGetSearchProfilesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTag mocks base method.
+func (m *MockUserServiceClient) GetTag(ctx context.Context, in *generated.GetTagRequest, opts ...grpc.CallOption) (*generated.GetTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTag", varargs...)
+	ret0, _ := ret[0].(*generated.GetTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTag indicates an expected call of GetTag.
+func (mr *MockUserServiceClientMockRecorder) GetTag(ctx, in any, opts ...any) *MockUserServiceClientGetTagCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockUserServiceClient)(nil).GetTag), varargs...)
+	return &MockUserServiceClientGetTagCall{Call: call}
+}
+
+// MockUserServiceClientGetTagCall wrap *gomock.Call
+type MockUserServiceClientGetTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientGetTagCall) Return(arg0 *generated.GetTagResponse, arg1 error) *MockUserServiceClientGetTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientGetTagCall) Do(f func(context.Context, *generated.GetTagRequest, ...grpc.CallOption) (*generated.GetTagResponse, error)) *MockUserServiceClientGetTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientGetTagCall) DoAndReturn(f func(context.Context, *generated.GetTagRequest, ...grpc.CallOption) (*generated.GetTagResponse, error)) *MockUserServiceClientGetTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTags mocks base method.
+func (m *MockUserServiceClient) GetTags(ctx context.Context, in *generated.GetTagsRequest, opts ...grpc.CallOption) (*generated.GetTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTags", varargs...)
+	ret0, _ := ret[0].(*generated.GetTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockUserServiceClientMockRecorder) GetTags(ctx, in any, opts ...any) *MockUserServiceClientGetTagsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockUserServiceClient)(nil).GetTags), varargs...)
+	return &MockUserServiceClientGetTagsCall{Call: call}
+}
+
+// MockUserServiceClientGetTagsCall wrap *gomock.Call
+type MockUserServiceClientGetTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientGetTagsCall) Return(arg0 *generated.GetTagsResponse, arg1 error) *MockUserServiceClientGetTagsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientGetTagsCall) Do(f func(context.Context, *generated.GetTagsRequest, ...grpc.CallOption) (*generated.GetTagsResponse, error)) *MockUserServiceClientGetTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientGetTagsCall) DoAndReturn(f func(context.Context, *generated.GetTagsRequest, ...grpc.CallOption) (*generated.GetTagsResponse, error)) *MockUserServiceClientGetTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetUserProfile mocks base method.
+func (m *MockUserServiceClient) GetUserProfile(ctx context.Context, in *generated.GetUserProfileRequest, opts ...grpc.CallOption) (*generated.GetUserProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserProfile", varargs...)
+	ret0, _ := ret[0].(*generated.GetUserProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserProfile indicates an expected call of GetUserProfile.
+func (mr *MockUserServiceClientMockRecorder) GetUserProfile(ctx, in any, opts ...any) *MockUserServiceClientGetUserProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfile", reflect.TypeOf((*MockUserServiceClient)(nil).GetUserProfile), varargs...)
+	return &MockUserServiceClientGetUserProfileCall{Call: call}
+}
+
+// MockUserServiceClientGetUserProfileCall wrap *gomock.Call
+type MockUserServiceClientGetUserProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientGetUserProfileCall) Return(arg0 *generated.GetUserProfileResponse, arg1 error) *MockUserServiceClientGetUserProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientGetUserProfileCall) Do(f func(context.Context, *generated.GetUserProfileRequest, ...grpc.CallOption) (*generated.GetUserProfileResponse, error)) *MockUserServiceClientGetUserProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientGetUserProfileCall) DoAndReturn(f func(context.Context, *generated.GetUserProfileRequest, ...grpc.CallOption) (*generated.GetUserProfileResponse, error)) *MockUserServiceClientGetUserProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetDefaultProfile mocks base method.
+func (m *MockUserServiceClient) SetDefaultProfile(ctx context.Context, in *generated.SetDefaultProfileRequest, opts ...grpc.CallOption) (*generated.SetDefaultProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDefaultProfile", varargs...)
+	ret0, _ := ret[0].(*generated.SetDefaultProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDefaultProfile indicates an expected call of SetDefaultProfile.
+func (mr *MockUserServiceClientMockRecorder) SetDefaultProfile(ctx, in any, opts ...any) *MockUserServiceClientSetDefaultProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultProfile", reflect.TypeOf((*MockUserServiceClient)(nil).SetDefaultProfile), varargs...)
+	return &MockUserServiceClientSetDefaultProfileCall{Call: call}
+}
+
+// MockUserServiceClientSetDefaultProfileCall wrap *gomock.Call
+type MockUserServiceClientSetDefaultProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientSetDefaultProfileCall) Return(arg0 *generated.SetDefaultProfileResponse, arg1 error) *MockUserServiceClientSetDefaultProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientSetDefaultProfileCall) Do(f func(context.Context, *generated.SetDefaultProfileRequest, ...grpc.CallOption) (*generated.SetDefaultProfileResponse, error)) *MockUserServiceClientSetDefaultProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientSetDefaultProfileCall) DoAndReturn(f func(context.Context, *generated.SetDefaultProfileRequest, ...grpc.CallOption) (*generated.SetDefaultProfileResponse, error)) *MockUserServiceClientSetDefaultProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateInterest mocks base method.
+func (m *MockUserServiceClient) UpdateInterest(ctx context.Context, in *generated.UpdateInterestRequest, opts ...grpc.CallOption) (*generated.UpdateInterestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateInterest", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateInterestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInterest indicates an expected call of UpdateInterest.
+func (mr *MockUserServiceClientMockRecorder) UpdateInterest(ctx, in any, opts ...any) *MockUserServiceClientUpdateInterestCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInterest", reflect.TypeOf((*MockUserServiceClient)(nil).UpdateInterest), varargs...)
+	return &MockUserServiceClientUpdateInterestCall{Call: call}
+}
+
+// MockUserServiceClientUpdateInterestCall wrap *gomock.Call
+type MockUserServiceClientUpdateInterestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientUpdateInterestCall) Return(arg0 *generated.UpdateInterestResponse, arg1 error) *MockUserServiceClientUpdateInterestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientUpdateInterestCall) Do(f func(context.Context, *generated.UpdateInterestRequest, ...grpc.CallOption) (*generated.UpdateInterestResponse, error)) *MockUserServiceClientUpdateInterestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientUpdateInterestCall) DoAndReturn(f func(context.Context, *generated.UpdateInterestRequest, ...grpc.CallOption) (*generated.UpdateInterestResponse, error)) *MockUserServiceClientUpdateInterestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSearchProfile mocks base method.
+func (m *MockUserServiceClient) UpdateSearchProfile(ctx context.Context, in *generated.UpdateSearchProfileRequest, opts ...grpc.CallOption) (*generated.UpdateSearchProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSearchProfile", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateSearchProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSearchProfile indicates an expected call of UpdateSearchProfile.
+func (mr *MockUserServiceClientMockRecorder) UpdateSearchProfile(ctx, in any, opts ...any) *MockUserServiceClientUpdateSearchProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSearchProfile", reflect.TypeOf((*MockUserServiceClient)(nil).UpdateSearchProfile), varargs...)
+	return &MockUserServiceClientUpdateSearchProfileCall{Call: call}
+}
+
+// MockUserServiceClientUpdateSearchProfileCall wrap *gomock.Call
+type MockUserServiceClientUpdateSearchProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientUpdateSearchProfileCall) Return(arg0 *generated.UpdateSearchProfileResponse, arg1 error) *MockUserServiceClientUpdateSearchProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientUpdateSearchProfileCall) Do(f func(context.Context, *generated.UpdateSearchProfileRequest, ...grpc.CallOption) (*generated.UpdateSearchProfileResponse, error)) *MockUserServiceClientUpdateSearchProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientUpdateSearchProfileCall) DoAndReturn(f func(context.Context, *generated.UpdateSearchProfileRequest, ...grpc.CallOption) (*generated.UpdateSearchProfileResponse, error)) *MockUserServiceClientUpdateSearchProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateTag mocks base method.
+func (m *MockUserServiceClient) UpdateTag(ctx context.Context, in *generated.UpdateTagRequest, opts ...grpc.CallOption) (*generated.UpdateTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTag", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockUserServiceClientMockRecorder) UpdateTag(ctx, in any, opts ...any) *MockUserServiceClientUpdateTagCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockUserServiceClient)(nil).UpdateTag), varargs...)
+	return &MockUserServiceClientUpdateTagCall{Call: call}
+}
+
+// MockUserServiceClientUpdateTagCall wrap *gomock.Call
+type MockUserServiceClientUpdateTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientUpdateTagCall) Return(arg0 *generated.UpdateTagResponse, arg1 error) *MockUserServiceClientUpdateTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientUpdateTagCall) Do(f func(context.Context, *generated.UpdateTagRequest, ...grpc.CallOption) (*generated.UpdateTagResponse, error)) *MockUserServiceClientUpdateTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientUpdateTagCall) DoAndReturn(f func(context.Context, *generated.UpdateTagRequest, ...grpc.CallOption) (*generated.UpdateTagResponse, error)) *MockUserServiceClientUpdateTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateUserProfile mocks base method.
+func (m *MockUserServiceClient) UpdateUserProfile(ctx context.Context, in *generated.UpdateUserProfileRequest, opts ...grpc.CallOption) (*generated.UpdateUserProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUserProfile", varargs...)
+	ret0, _ := ret[0].(*generated.UpdateUserProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserProfile indicates an expected call of UpdateUserProfile.
+func (mr *MockUserServiceClientMockRecorder) UpdateUserProfile(ctx, in any, opts ...any) *MockUserServiceClientUpdateUserProfileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProfile", reflect.TypeOf((*MockUserServiceClient)(nil).UpdateUserProfile), varargs...)
+	return &MockUserServiceClientUpdateUserProfileCall{Call: call}
+}
+
+// MockUserServiceClientUpdateUserProfileCall wrap *gomock.Call
+type MockUserServiceClientUpdateUserProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserServiceClientUpdateUserProfileCall) Return(arg0 *generated.UpdateUserProfileResponse, arg1 error) *MockUserServiceClientUpdateUserProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserServiceClientUpdateUserProfileCall) Do(f func(context.Context, *generated.UpdateUserProfileRequest, ...grpc.CallOption) (*generated.UpdateUserProfileResponse, error)) *MockUserServiceClientUpdateUserProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserServiceClientUpdateUserProfileCall) DoAndReturn(f func(context.Context, *generated.UpdateUserProfileRequest, ...grpc.CallOption) (*generated.UpdateUserProfileResponse, error)) *MockUserServiceClientUpdateUserProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,389 @@
+package property
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"math/rand/v2"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Generator builds random messages. The messages it builds pass their
+// generated Validate methods: fields follow the validation rules declared
+// in the protos, enums only take declared values, strings are valid UTF-8
+// and well-known types hold values their JSON form can represent.
+//
+// A Generator is not safe for concurrent use.
+type Generator struct {
+	rand *rand.Rand
+
+	maxDepth int
+	maxItems int
+	absent   float64
+
+	patterns map[string]*pattern
+}
+
+// Option configures a Generator.
+type Option func(*Generator)
+
+// WithMaxDepth sets how deep sub-messages are nested, 4 by default. Deeper
+// message fields are left unset.
+func WithMaxDepth(depth int) Option {
+	return func(g *Generator) {
+		g.maxDepth = depth
+	}
+}
+
+// WithMaxItems sets the largest number of entries of repeated and map
+// fields, 3 by default.
+func WithMaxItems(n int) Option {
+	return func(g *Generator) {
+		g.maxItems = n
+	}
+}
+
+// WithAbsent sets the chance, between 0 and 1, that a field or oneof is
+// left unset, 0.3 by default.
+func WithAbsent(p float64) Option {
+	return func(g *Generator) {
+		g.absent = p
+	}
+}
+
+// New returns a Generator seeded with seed. Generators with the same seed
+// and options build the same messages.
+func New(seed uint64, opts ...Option) *Generator {
+	return newGenerator(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15), opts)
+}
+
+// FromBytes returns a Generator seeded from data, such as the input of a
+// fuzz target.
+func FromBytes(data []byte, opts ...Option) *Generator {
+	sum := sha256.Sum256(data)
+
+	return newGenerator(rand.NewPCG(binary.LittleEndian.Uint64(sum[:8]), binary.LittleEndian.Uint64(sum[8:16])), opts)
+}
+
+func newGenerator(src rand.Source, opts []Option) *Generator {
+	g := &Generator{
+		rand:     rand.New(src),
+		maxDepth: 4,
+		maxItems: 3,
+		absent:   0.3,
+		patterns: map[string]*pattern{},
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
+
+// Make returns a random message of type M:
+//
+//	event := property.Make[*chat.ChatEvent](g)
+func Make[M proto.Message](g *Generator) M {
+	var zero M
+	m := zero.ProtoReflect().Type().New()
+	g.fill(m, 0)
+
+	return m.Interface().(M)
+}
+
+// Message returns a random message of type mt.
+func (g *Generator) Message(mt protoreflect.MessageType) proto.Message {
+	m := mt.New()
+	g.fill(m, 0)
+
+	return m.Interface()
+}
+
+// Fill replaces the content of m with random values.
+func (g *Generator) Fill(m proto.Message) {
+	proto.Reset(m)
+	g.fill(m.ProtoReflect(), 0)
+}
+
+func (g *Generator) fill(m protoreflect.Message, depth int) {
+	md := m.Descriptor()
+	if g.wellKnown(m) {
+		return
+	}
+
+	fields := md.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if o := fd.ContainingOneof(); o != nil && !o.IsSynthetic() {
+			continue
+		}
+		if g.chance(g.absent) && zeroValid(fd) {
+			continue
+		}
+		g.field(m, fd, depth)
+	}
+
+	oneofs := md.Oneofs()
+	for i := range oneofs.Len() {
+		o := oneofs.Get(i)
+		if o.IsSynthetic() || g.chance(g.absent) {
+			continue
+		}
+		g.field(m, o.Fields().Get(g.rand.IntN(o.Fields().Len())), depth)
+	}
+}
+
+func (g *Generator) field(m protoreflect.Message, fd protoreflect.FieldDescriptor, depth int) {
+	if fd.Message() != nil && !fd.IsMap() && depth >= g.maxDepth {
+		return
+	}
+	r := rulesOf(fd)
+
+	switch {
+	case fd.IsMap():
+		entries := m.Mutable(fd).Map()
+		for range g.items(r) {
+			k := g.scalar(fd.MapKey(), nil)
+			if fd.MapValue().Message() != nil {
+				if depth >= g.maxDepth {
+					return
+				}
+				v := entries.NewValue()
+				g.fill(v.Message(), depth+1)
+				entries.Set(k.MapKey(), v)
+				continue
+			}
+			entries.Set(k.MapKey(), g.scalar(fd.MapValue(), r.mapValue()))
+		}
+	case fd.IsList():
+		list := m.Mutable(fd).List()
+		for range g.items(r) {
+			if fd.Message() != nil {
+				v := list.NewElement()
+				g.fill(v.Message(), depth+1)
+				list.Append(v)
+				continue
+			}
+			list.Append(g.scalar(fd, r.item()))
+		}
+	case fd.Message() != nil:
+		g.fill(m.Mutable(fd).Message(), depth+1)
+	default:
+		m.Set(fd, g.scalar(fd, r))
+	}
+}
+
+// items returns the number of entries of a repeated or map field.
+func (g *Generator) items(r *rules) int {
+	lo, hi := r.items()
+	hi = min(hi, max(lo, g.maxItems))
+	if hi <= lo {
+		return lo
+	}
+
+	return lo + g.rand.IntN(hi-lo+1)
+}
+
+// scalar returns a random value of the kind of fd, following r.
+func (g *Generator) scalar(fd protoreflect.FieldDescriptor, r *rules) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(g.chance(0.5))
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(g.rand.IntN(values.Len())).Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(g.integer(r, math.MinInt32, math.MaxInt32)))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(g.integer(r, math.MinInt64, math.MaxInt64))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(g.integer(r, 0, math.MaxUint32)))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// Values above MaxInt64 are left out, as the rules are read as int64.
+		return protoreflect.ValueOfUint64(uint64(g.integer(r, 0, math.MaxInt64)))
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(g.float(r, -math.MaxFloat32, math.MaxFloat32)))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(g.float(r, -math.MaxFloat64, math.MaxFloat64))
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(g.str(r))
+	case protoreflect.BytesKind:
+		b := make([]byte, g.rand.IntN(17))
+		for i := range b {
+			b[i] = byte(g.rand.Uint32())
+		}
+		return protoreflect.ValueOfBytes(b)
+	default:
+		return fd.Default()
+	}
+}
+
+// integer returns a random integer between lo and hi, narrowed by r, with
+// the bounds and zero more likely than other values.
+func (g *Generator) integer(r *rules, lo, hi int64) int64 {
+	if v, ok := r.constant(); ok {
+		return int64(v)
+	}
+	if in := r.in(); len(in) > 0 {
+		return int64(in[g.rand.IntN(len(in))])
+	}
+	if r.ignoreEmpty() && g.chance(0.1) {
+		return 0
+	}
+	if l, ok := r.lower(); ok {
+		lo = max(lo, int64(math.Ceil(l.value)))
+		if l.exclusive && float64(lo) == l.value {
+			lo++
+		}
+	}
+	if u, ok := r.upper(); ok {
+		hi = min(hi, int64(math.Floor(u.value)))
+		if u.exclusive && float64(hi) == u.value {
+			hi--
+		}
+	}
+	if hi <= lo {
+		return lo
+	}
+
+	switch n := g.rand.IntN(10); {
+	case n == 0:
+		return lo
+	case n == 1:
+		return hi
+	case n == 2 && lo <= 0 && hi >= 0:
+		return 0
+	case n < 6:
+		// Small values, as most fields hold.
+		return min(hi, max(lo, int64(g.rand.IntN(201)-100)))
+	default:
+		span := uint64(hi-lo) + 1
+		if span == 0 {
+			// The whole range of int64.
+			return int64(g.rand.Uint64())
+		}
+		return lo + int64(g.rand.Uint64N(span))
+	}
+}
+
+// float returns a random finite number between lo and hi, narrowed by r.
+func (g *Generator) float(r *rules, lo, hi float64) float64 {
+	if v, ok := r.constant(); ok {
+		return v
+	}
+	if in := r.in(); len(in) > 0 {
+		return in[g.rand.IntN(len(in))]
+	}
+	if r.ignoreEmpty() && g.chance(0.1) {
+		return 0
+	}
+	if l, ok := r.lower(); ok {
+		lo = max(lo, l.value)
+		if l.exclusive {
+			lo = math.Nextafter(lo, math.Inf(1))
+		}
+	}
+	if u, ok := r.upper(); ok {
+		hi = min(hi, u.value)
+		if u.exclusive {
+			hi = math.Nextafter(hi, math.Inf(-1))
+		}
+	}
+	if hi <= lo {
+		return lo
+	}
+
+	switch n := g.rand.IntN(10); {
+	case n == 0:
+		return lo
+	case n == 1:
+		return hi
+	case n == 2 && lo <= 0 && hi >= 0:
+		return 0
+	case n < 6:
+		return min(hi, max(lo, g.rand.Float64()*200-100))
+	default:
+		// Weighing the bounds rather than adding to lo, which could overflow.
+		f := g.rand.Float64()
+		return min(hi, max(lo, lo*(1-f)+hi*f))
+	}
+}
+
+// alphabet mixes ASCII with characters that need escaping or several bytes
+// in UTF-8.
+var alphabet = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 _-./:\"\\\n\téüçãÆ東京€😀")
+
+// str returns a random string, following r.
+func (g *Generator) str(r *rules) string {
+	if r.ignoreEmpty() && g.chance(0.1) {
+		return ""
+	}
+	if s, ok := r.constantString(); ok {
+		return s
+	}
+	if in := r.inStrings(); len(in) > 0 {
+		return in[g.rand.IntN(len(in))]
+	}
+
+	lo, hi := r.length()
+	if p := r.pattern(); p != "" {
+		for range 10 {
+			if s, ok := g.match(p); ok && inLength(s, lo, hi) {
+				return s
+			}
+		}
+		return ""
+	}
+
+	hi = min(hi, max(lo, 16))
+	n := lo
+	if hi > lo {
+		n += g.rand.IntN(hi - lo + 1)
+	}
+	s := make([]rune, n)
+	for i := range s {
+		s[i] = alphabet[g.rand.IntN(len(alphabet))]
+	}
+
+	return string(s)
+}
+
+func inLength(s string, lo, hi int) bool {
+	n := len([]rune(s))
+
+	return n >= lo && n <= hi
+}
+
+// chance returns true with probability p.
+func (g *Generator) chance(p float64) bool {
+	return g.rand.Float64() < p
+}
+
+// wellKnown fills the well-known types whose JSON form restricts their
+// values, and reports whether m was one of them. Any, Struct, Value,
+// ListValue and FieldMask, which the protos do not use, are left empty.
+func (g *Generator) wellKnown(m protoreflect.Message) bool {
+	fields := m.Descriptor().Fields()
+
+	switch m.Descriptor().FullName() {
+	case "google.protobuf.Timestamp":
+		// From 0001-01-01 to 9999-12-31, as RFC 3339 allows.
+		m.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(-62135596800+g.rand.Int64N(253402300799+62135596800+1)))
+		m.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(g.rand.Int32N(1e9)))
+	case "google.protobuf.Duration":
+		// Up to 10000 years either way, with nanos of the sign of seconds.
+		seconds, nanos := g.rand.Int64N(315576000000+1), g.rand.Int32N(1e9)
+		if g.chance(0.5) {
+			seconds, nanos = -seconds, -nanos
+		}
+		m.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(seconds))
+		m.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(nanos))
+	case "google.protobuf.Any", "google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue", "google.protobuf.FieldMask":
+	default:
+		return false
+	}
+
+	return true
+}
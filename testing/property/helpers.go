@@ -0,0 +1,171 @@
+package property
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	chatconv "github.com/FACorreiaa/loci-proto/modules/chat"
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	helpers "github.com/FACorreiaa/loci-proto/modules/common"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	listconv "github.com/FACorreiaa/loci-proto/modules/list"
+	list "github.com/FACorreiaa/loci-proto/modules/list/generated"
+	poiconv "github.com/FACorreiaa/loci-proto/modules/poi"
+	poi "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+	"github.com/FACorreiaa/loci-proto/pagination"
+)
+
+// Lossless checks that converting m with to, then back with from, gives a
+// message equal to m, and that both conversions map nil to nil.
+func Lossless[A, B proto.Message](m A, to func(A) B, from func(B) A) error {
+	name := m.ProtoReflect().Descriptor().FullName()
+
+	var nilA A
+	var nilB B
+	if out := to(nilA); out.ProtoReflect().IsValid() {
+		return fmt.Errorf("%s: converting nil gave %v", name, out)
+	}
+	if out := from(nilB); out.ProtoReflect().IsValid() {
+		return fmt.Errorf("%s: converting back nil gave %v", name, out)
+	}
+
+	if got := from(to(m)); !proto.Equal(m, got) {
+		return fmt.Errorf("%s: conversion is lossy:\nwant: %v\ngot:  %v", name, m, got)
+	}
+
+	return nil
+}
+
+// checkConverters checks the converters between the per-service POI
+// messages and the canonical POI.
+func checkConverters(g *Generator) error {
+	return errors.Join(
+		Lossless(Make[*poi.POIDetailedInfo](g), poiconv.POIToCommon, poiconv.POIFromCommon),
+		Lossless(Make[*chat.POIDetailedInfo](g), chatconv.POIToCommon, chatconv.POIFromCommon),
+		Lossless(Make[*chat.POIReference](g), chatconv.POIReferenceToCommon, chatconv.POIReferenceFromCommon),
+		Lossless(Make[*list.POIDetailedInfo](g), listconv.POIToCommon, listconv.POIFromCommon),
+	)
+}
+
+// checkAuditInfo checks that UpdateAuditInfo bumps the version and the
+// update time and author, and keeps the creation ones.
+func checkAuditInfo(g *Generator) error {
+	var info *common.AuditInfo
+	if !g.chance(0.1) {
+		info = Make[*common.AuditInfo](g)
+	}
+	before := proto.CloneOf(info)
+	by := g.str(nil)
+
+	start := time.Now()
+	got := helpers.UpdateAuditInfo(info, by)
+	end := time.Now()
+
+	switch {
+	case got == nil:
+		return fmt.Errorf("UpdateAuditInfo(%v) returned nil", before)
+	case info != nil && got != info:
+		return fmt.Errorf("UpdateAuditInfo(%v) did not update in place", before)
+	case got.GetUpdatedBy() != by:
+		return fmt.Errorf("UpdateAuditInfo(%v, %q) set updated_by to %q", before, by, got.GetUpdatedBy())
+	case got.GetVersion()-before.GetVersion() != 1:
+		return fmt.Errorf("UpdateAuditInfo(%v) set version to %d", before, got.GetVersion())
+	case got.GetUpdatedAt().AsTime().Before(start.Truncate(time.Microsecond)) || got.GetUpdatedAt().AsTime().After(end):
+		return fmt.Errorf("UpdateAuditInfo(%v) set updated_at to %v, called between %v and %v", before, got.GetUpdatedAt().AsTime(), start, end)
+	}
+
+	if info == nil {
+		if !proto.Equal(got.GetCreatedAt(), got.GetUpdatedAt()) || got.GetCreatedBy() != "" {
+			return fmt.Errorf("UpdateAuditInfo(nil) created %v", got)
+		}
+		return nil
+	}
+	if !proto.Equal(got.GetCreatedAt(), before.GetCreatedAt()) || got.GetCreatedBy() != before.GetCreatedBy() {
+		return fmt.Errorf("UpdateAuditInfo(%v) changed the creation to %v", before, got)
+	}
+
+	return nil
+}
+
+// checkValidators checks that the validation helpers reject what the
+// generated validators reject, and do not panic on nil. Values are drawn
+// from wider ranges than the valid ones, so that both outcomes are
+// covered.
+func checkValidators(g *Generator) error {
+	if helpers.ValidateCoordinates(nil) {
+		return errors.New("ValidateCoordinates accepts nil")
+	}
+	helpers.ValidatePagination(nil)
+	helpers.ValidateRating(nil)
+
+	coords := helpers.NewCoordinates(g.float(nil, -360, 360), g.float(nil, -360, 360))
+	if helpers.ValidateCoordinates(coords) {
+		if err := coords.ValidateAll(); err != nil {
+			return fmt.Errorf("ValidateCoordinates accepts %v: %w", coords, err)
+		}
+	}
+
+	page := helpers.NewPaginationRequest(int32(g.integer(nil, -10, 200)), int32(g.integer(nil, -10, 200)))
+	if helpers.ValidatePagination(page) {
+		if err := page.ValidateAll(); err != nil {
+			return fmt.Errorf("ValidatePagination accepts %v: %w", page, err)
+		}
+	}
+
+	rating := helpers.NewRating(g.float(nil, -2, 7), int32(g.integer(nil, -10, 1000)))
+	if helpers.ValidateRating(rating) {
+		if err := rating.ValidateAll(); err != nil {
+			return fmt.Errorf("ValidateRating accepts %v: %w", rating, err)
+		}
+	}
+
+	return nil
+}
+
+// checkPagination checks the builders of pagination requests and pages.
+func checkPagination(g *Generator) error {
+	p, size := int32(g.integer(nil, -10, 200)), int32(g.integer(nil, -10, 200))
+	if req := helpers.NewPaginationRequest(p, size); req.GetPage() != p || req.GetPageSize() != size {
+		return fmt.Errorf("NewPaginationRequest(%d, %d) built %v", p, size, req)
+	}
+
+	current, total := int32(g.integer(nil, 0, 1000)), int32(g.integer(nil, 0, 100000))
+	hasNext, hasPrev := g.chance(0.5), g.chance(0.5)
+	resp := helpers.NewPaginationResponse(current, size, total, total/max(size, 1), hasNext, hasPrev)
+	if resp.GetCurrentPage() != current || resp.GetPageSize() != size || resp.GetTotalItems() != total ||
+		resp.GetHasNextPage() != hasNext || resp.GetHasPreviousPage() != hasPrev {
+		return fmt.Errorf("NewPaginationResponse built %v", resp)
+	}
+
+	req := pagination.Request{
+		Offset: int32(g.integer(nil, 0, 10000)),
+		Limit:  int32(g.integer(nil, 1, pagination.MaxPageSize)),
+		Cursor: g.str(nil),
+	}
+	built := req.Pagination()
+	if err := built.ValidateAll(); err != nil {
+		return fmt.Errorf("%+v.Pagination() is invalid: %w", req, err)
+	}
+	if built.GetPage() != 0 && (built.GetPage()-1)*req.Limit != req.Offset {
+		return fmt.Errorf("%+v.Pagination() asks for page %d", req, built.GetPage())
+	}
+	if built.GetPage() != 0 && (built.GetOffset() != 0 || built.GetLimit() != 0) {
+		return fmt.Errorf("%+v.Pagination() asks for both page %d and offset %d", req, built.GetPage(), built.GetOffset())
+	}
+
+	items := make([]int, g.integer(nil, 0, int64(req.Limit)))
+	if page := pagination.PageFromTotal(items, total, req); page.HasNext && total > 0 && req.Offset+int32(len(items)) >= total {
+		return fmt.Errorf("page of %d items at offset %d has a next page past the %d items", len(items), req.Offset, total)
+	}
+	if page := pagination.PageFromResponse(items, nil, req); page.HasNext && int32(len(items)) < req.Limit {
+		return fmt.Errorf("short page of %d items out of %d has a next page", len(items), req.Limit)
+	}
+	if page := pagination.PageFromResponse(items, resp, req); page.HasNext != (hasNext || resp.GetNextCursor() != "") {
+		return fmt.Errorf("page of %v has next page %t", resp, page.HasNext)
+	}
+
+	return nil
+}
@@ -0,0 +1,309 @@
+package property
+
+import (
+	"bytes"
+	"fmt"
+	"iter"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// validator is implemented by the messages protoc-gen-validate generates
+// code for.
+type validator interface {
+	Validate() error
+	ValidateAll() error
+}
+
+// RoundTrip checks that m decodes back to an equal message from its binary,
+// protojson and text encodings, and that its deterministic binary encoding
+// is stable.
+func RoundTrip(m proto.Message) error {
+	name := m.ProtoReflect().Descriptor().FullName()
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("%s: binary: %w", name, err)
+	}
+	if size := proto.Size(m); size != len(b) {
+		return fmt.Errorf("%s: binary: size %d, encoded to %d bytes", name, size, len(b))
+	}
+	got := m.ProtoReflect().New().Interface()
+	if err := proto.Unmarshal(b, got); err != nil {
+		return fmt.Errorf("%s: binary: %w", name, err)
+	}
+	if !proto.Equal(m, got) {
+		return mismatch(name, "binary", m, got)
+	}
+	again, err := proto.MarshalOptions{Deterministic: true}.Marshal(got)
+	if err != nil {
+		return fmt.Errorf("%s: binary: %w", name, err)
+	}
+	if !bytes.Equal(b, again) {
+		return fmt.Errorf("%s: binary: deterministic encoding changed after a round trip", name)
+	}
+
+	j, err := protojson.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("%s: protojson: %w", name, err)
+	}
+	got = m.ProtoReflect().New().Interface()
+	if err := protojson.Unmarshal(j, got); err != nil {
+		return fmt.Errorf("%s: protojson: %w: %s", name, err, j)
+	}
+	if !proto.Equal(m, got) {
+		return mismatch(name, "protojson", m, got)
+	}
+
+	t, err := prototext.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("%s: text: %w", name, err)
+	}
+	got = m.ProtoReflect().New().Interface()
+	if err := prototext.Unmarshal(t, got); err != nil {
+		return fmt.Errorf("%s: text: %w: %s", name, err, t)
+	}
+	if !proto.Equal(m, got) {
+		return mismatch(name, "text", m, got)
+	}
+
+	return nil
+}
+
+func mismatch(name protoreflect.FullName, encoding string, want, got proto.Message) error {
+	return fmt.Errorf("%s: %s round trip changed the message:\nwant: %v\ngot:  %v", name, encoding, want, got)
+}
+
+// Decode checks that data, decoded as a message of type mt, is handled
+// safely: decoding and encoding never panic, and a message that decodes,
+// unknown fields included, survives a binary round trip. Data that does not
+// decode is not an error.
+func Decode(mt protoreflect.MessageType, data []byte) (err error) {
+	name := mt.Descriptor().FullName()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: panic decoding %x: %v", name, data, r)
+		}
+	}()
+
+	m := mt.New().Interface()
+	if proto.Unmarshal(data, m) != nil {
+		return nil
+	}
+
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("%s: binary: %w", name, err)
+	}
+	got := mt.New().Interface()
+	if err := proto.Unmarshal(b, got); err != nil {
+		return fmt.Errorf("%s: binary: %w", name, err)
+	}
+	if !proto.Equal(m, got) {
+		return mismatch(name, "binary", m, got)
+	}
+
+	// Decoded values need not be representable in JSON, such as timestamps
+	// out of range, so only panics matter.
+	_, _ = protojson.Marshal(m)
+	_, _ = prototext.Marshal(m)
+
+	return NilSafe(m)
+}
+
+// NilSafe checks that the validators and getters of m, and of every
+// message reachable from it, do not panic, including on absent
+// sub-messages. Run it against the variants of Absent to cover every field
+// that may be missing on the wire.
+func NilSafe(m proto.Message) (err error) {
+	name := m.ProtoReflect().Descriptor().FullName()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: panic: %v", name, r)
+		}
+	}()
+
+	if v, ok := m.(validator); ok {
+		_ = v.Validate()
+		_ = v.ValidateAll()
+	}
+	getters(reflect.ValueOf(m), map[reflect.Type]bool{})
+
+	return nil
+}
+
+var messageType = reflect.TypeFor[proto.Message]()
+
+// getters calls every getter of the message v, then those of the messages
+// they return. Absent messages are only visited once per type, which is
+// enough to cover their getters and keeps recursive types finite.
+func getters(v reflect.Value, seen map[reflect.Type]bool) {
+	if v.IsNil() {
+		if seen[v.Type()] {
+			return
+		}
+		seen[v.Type()] = true
+	}
+	if msg, ok := v.Interface().(validator); ok {
+		_ = msg.ValidateAll()
+	}
+
+	for i := range v.NumMethod() {
+		method := v.Type().Method(i)
+		if !strings.HasPrefix(method.Name, "Get") || method.Type.NumIn() != 1 {
+			continue
+		}
+
+		for _, out := range v.Method(i).Call(nil) {
+			switch {
+			case out.Kind() == reflect.Pointer && out.Type().Implements(messageType):
+				getters(out, seen)
+			case out.Kind() == reflect.Slice && out.Type().Elem().Implements(messageType):
+				for j := range out.Len() {
+					getters(out.Index(j), seen)
+				}
+			case out.Kind() == reflect.Map && out.Type().Elem().Implements(messageType):
+				for it := out.MapRange(); it.Next(); {
+					getters(it.Value(), seen)
+				}
+			}
+		}
+	}
+}
+
+// Absent returns copies of m, each with one of its populated sub-messages
+// or oneofs cleared, at any depth, as a peer that never sets them would
+// send it. For a ChatEvent with a message payload, the variants include the
+// event without a payload, and the event with a payload message without its
+// timestamp.
+func Absent(m proto.Message) iter.Seq[proto.Message] {
+	return func(yield func(proto.Message) bool) {
+		for i := 0; ; i++ {
+			variant := proto.Clone(m)
+			n := i
+			if !clearNth(variant.ProtoReflect(), &n) {
+				return
+			}
+			if !yield(variant) {
+				return
+			}
+		}
+	}
+}
+
+// clearNth clears the nth populated sub-message or oneof member of m,
+// counting depth first in field order, and reports whether there was one.
+// n is decremented by the number of candidates skipped.
+func clearNth(m protoreflect.Message, n *int) bool {
+	fields := m.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if !m.Has(fd) || fd.IsMap() {
+			continue
+		}
+
+		o := fd.ContainingOneof()
+		if fd.Message() == nil && (o == nil || o.IsSynthetic()) {
+			continue
+		}
+		if !fd.IsList() {
+			if *n == 0 {
+				m.Clear(fd)
+				return true
+			}
+			*n--
+		}
+
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := m.Get(fd).List()
+			for j := range list.Len() {
+				if clearNth(list.Get(j).Message(), n) {
+					return true
+				}
+			}
+		case fd.Message() != nil:
+			if clearNth(m.Get(fd).Message(), n) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func checkValid(g *Generator) error {
+	for _, mt := range Messages() {
+		m := g.Message(mt)
+		if v, ok := m.(validator); ok {
+			if err := v.ValidateAll(); err != nil {
+				return fmt.Errorf("generated %s fails validation: %w", mt.Descriptor().FullName(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkRoundTrips(g *Generator) error {
+	for _, mt := range Messages() {
+		if err := RoundTrip(g.Message(mt)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkDecode decodes corrupted encodings of random messages.
+func checkDecode(g *Generator) error {
+	for _, mt := range Messages() {
+		b, err := proto.Marshal(g.Message(mt))
+		if err != nil {
+			return fmt.Errorf("%s: %w", mt.Descriptor().FullName(), err)
+		}
+		if err := Decode(mt, g.corrupt(b)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// corrupt flips, drops or inserts a few bytes of b.
+func (g *Generator) corrupt(b []byte) []byte {
+	b = bytes.Clone(b)
+	for range 1 + g.rand.IntN(3) {
+		switch i := g.rand.IntN(len(b) + 1); {
+		case i == len(b) || g.chance(0.3):
+			b = append(b[:i], append([]byte{byte(g.rand.Uint32())}, b[i:]...)...)
+		case g.chance(0.5):
+			b[i] ^= byte(1 << g.rand.IntN(8))
+		default:
+			b = append(b[:i], b[i+1:]...)
+		}
+	}
+
+	return b
+}
+
+func checkNilSafe(g *Generator) error {
+	for _, mt := range Messages() {
+		m := g.Message(mt)
+		if err := NilSafe(m); err != nil {
+			return err
+		}
+		for variant := range Absent(m) {
+			if err := NilSafe(variant); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
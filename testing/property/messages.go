@@ -0,0 +1,67 @@
+package property
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	aipoi "github.com/FACorreiaa/loci-proto/modules/ai_poi_service/generated"
+	auth "github.com/FACorreiaa/loci-proto/modules/auth/generated"
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	city "github.com/FACorreiaa/loci-proto/modules/city/generated"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+	customer "github.com/FACorreiaa/loci-proto/modules/customer/generated"
+	interests "github.com/FACorreiaa/loci-proto/modules/interests/generated"
+	list "github.com/FACorreiaa/loci-proto/modules/list/generated"
+	poi "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+	profiles "github.com/FACorreiaa/loci-proto/modules/profiles/generated"
+	recents "github.com/FACorreiaa/loci-proto/modules/recents/generated"
+	review "github.com/FACorreiaa/loci-proto/modules/review/generated"
+	statistics "github.com/FACorreiaa/loci-proto/modules/statistics/generated"
+	tags "github.com/FACorreiaa/loci-proto/modules/tags/generated"
+	user "github.com/FACorreiaa/loci-proto/modules/user/generated"
+)
+
+// Files returns the files of the loci protos.
+func Files() []protoreflect.FileDescriptor {
+	return []protoreflect.FileDescriptor{
+		aipoi.File_ai_poi_service_proto,
+		auth.File_auth_proto,
+		chat.File_chat_proto,
+		city.File_city_proto,
+		common.File_common_proto,
+		customer.File_customer_proto,
+		interests.File_interests_proto,
+		list.File_list_proto,
+		poi.File_poi_proto,
+		profiles.File_profiles_proto,
+		recents.File_recents_proto,
+		review.File_review_proto,
+		statistics.File_statistics_proto,
+		tags.File_tags_proto,
+		user.File_user_proto,
+	}
+}
+
+// Messages returns the type of every message declared in the loci protos,
+// nested ones included, leaving out map entries.
+func Messages() []protoreflect.MessageType {
+	var out []protoreflect.MessageType
+	var walk func(protoreflect.MessageDescriptors)
+	walk = func(mds protoreflect.MessageDescriptors) {
+		for i := range mds.Len() {
+			md := mds.Get(i)
+			if md.IsMapEntry() {
+				continue
+			}
+			if mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName()); err == nil {
+				out = append(out, mt)
+			}
+			walk(md.Messages())
+		}
+	}
+	for _, fd := range Files() {
+		walk(fd.Messages())
+	}
+
+	return out
+}
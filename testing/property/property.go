@@ -0,0 +1,80 @@
+// Package property generates random but valid instances of the loci
+// messages and checks the invariants the contracts and their helpers are
+// expected to keep: every message survives the binary, protojson and text
+// encodings, the converters between the duplicated POI types are lossless,
+// and validators and getters never panic on absent sub-messages.
+//
+// Run checks every property with randomness drawn from its input, so that
+// it can back a Go fuzz target:
+//
+//	func FuzzContracts(f *testing.F) {
+//		f.Add([]byte("seed"))
+//		f.Fuzz(func(t *testing.T, data []byte) {
+//			if err := property.Run(data); err != nil {
+//				t.Fatal(err)
+//			}
+//		})
+//	}
+//
+// The Generator is also usable on its own, for instance to feed random
+// events to code that consumes a ChatEvent stream:
+//
+//	g := property.New(seed)
+//	event := property.Make[*chat.ChatEvent](g)
+//	for variant := range property.Absent(event) {
+//		handle(variant.(*chat.ChatEvent))
+//	}
+package property
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Property is an invariant checked against random input.
+type Property struct {
+	Name  string
+	Check func(g *Generator) error
+}
+
+// Properties returns every property of the contracts and helpers.
+func Properties() []Property {
+	return []Property{
+		{Name: "valid", Check: checkValid},
+		{Name: "round-trip", Check: checkRoundTrips},
+		{Name: "decode", Check: checkDecode},
+		{Name: "nil-safe", Check: checkNilSafe},
+		{Name: "poi-converters", Check: checkConverters},
+		{Name: "audit-info", Check: checkAuditInfo},
+		{Name: "validators", Check: checkValidators},
+		{Name: "pagination", Check: checkPagination},
+	}
+}
+
+// Run checks every property with a Generator seeded from data, and returns
+// the failures joined.
+func Run(data []byte, opts ...Option) error {
+	var errs []error
+	for _, p := range Properties() {
+		if err := Check(p, FromBytes(data, opts...)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Check checks p with g, turning panics into errors.
+func Check(p Property, g *Generator) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("property: %s: panic: %v", p.Name, r)
+		}
+	}()
+
+	if err := p.Check(g); err != nil {
+		return fmt.Errorf("property: %s: %w", p.Name, err)
+	}
+
+	return nil
+}
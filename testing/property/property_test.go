@@ -0,0 +1,44 @@
+package property
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// seeds are the inputs the properties are always checked with, and the
+// seed corpus of FuzzContracts.
+var seeds = [][]byte{
+	nil,
+	[]byte("seed"),
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	binary.LittleEndian.AppendUint64(nil, 20260101),
+}
+
+func TestRun(t *testing.T) {
+	for _, seed := range seeds {
+		if err := Run(seed); err != nil {
+			t.Errorf("Run(%x): %v", seed, err)
+		}
+	}
+}
+
+// TestRunShallow checks the properties with the smallest messages, where
+// sub-messages are mostly absent.
+func TestRunShallow(t *testing.T) {
+	for _, seed := range seeds {
+		if err := Run(seed, WithMaxDepth(1), WithMaxItems(0), WithAbsent(0.9)); err != nil {
+			t.Errorf("Run(%x): %v", seed, err)
+		}
+	}
+}
+
+func FuzzContracts(f *testing.F) {
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := Run(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
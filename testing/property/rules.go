@@ -0,0 +1,353 @@
+package property
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strings"
+
+	"github.com/envoyproxy/protoc-gen-validate/validate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// rules are the validation rules of a field, read from its
+// (validate.rules) option. Only the rules the protos use, and a few close
+// to them, are followed: numeric bounds, constants and sets, string
+// patterns, lengths and sets, and the number of items of repeated and map
+// fields. A nil *rules has none.
+type rules struct {
+	field *validate.FieldRules
+	// typed holds the rules of the type of the field, such as a
+	// validate.DoubleRules.
+	typed protoreflect.Message
+}
+
+func rulesOf(fd protoreflect.FieldDescriptor) *rules {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, validate.E_Rules) {
+		return nil
+	}
+
+	return newRules(proto.GetExtension(opts, validate.E_Rules).(*validate.FieldRules))
+}
+
+func newRules(fr *validate.FieldRules) *rules {
+	if fr == nil {
+		return nil
+	}
+
+	m := fr.ProtoReflect()
+	which := m.WhichOneof(m.Descriptor().Oneofs().ByName("type"))
+	if which == nil || which.Message() == nil {
+		return &rules{field: fr}
+	}
+
+	return &rules{field: fr, typed: m.Get(which).Message()}
+}
+
+// get returns the rule called name, if it is set.
+func (r *rules) get(name protoreflect.Name) (protoreflect.Value, bool) {
+	if r == nil || r.typed == nil {
+		return protoreflect.Value{}, false
+	}
+
+	fd := r.typed.Descriptor().Fields().ByName(name)
+	if fd == nil || !r.typed.Has(fd) {
+		return protoreflect.Value{}, false
+	}
+
+	return r.typed.Get(fd), true
+}
+
+func (r *rules) ignoreEmpty() bool {
+	v, ok := r.get("ignore_empty")
+
+	return ok && v.Bool()
+}
+
+// bound is a numeric bound, which the value may only equal if it is not
+// exclusive.
+type bound struct {
+	value     float64
+	exclusive bool
+}
+
+func (r *rules) lower() (bound, bool) {
+	if v, ok := r.number("gte"); ok {
+		return bound{value: v}, true
+	}
+	if v, ok := r.number("gt"); ok {
+		return bound{value: v, exclusive: true}, true
+	}
+
+	return bound{}, false
+}
+
+func (r *rules) upper() (bound, bool) {
+	if v, ok := r.number("lte"); ok {
+		return bound{value: v}, true
+	}
+	if v, ok := r.number("lt"); ok {
+		return bound{value: v, exclusive: true}, true
+	}
+
+	return bound{}, false
+}
+
+func (r *rules) constant() (float64, bool) {
+	return r.number("const")
+}
+
+func (r *rules) in() []float64 {
+	v, ok := r.get("in")
+	if !ok {
+		return nil
+	}
+
+	var out []float64
+	for i := range v.List().Len() {
+		if f, ok := number(v.List().Get(i)); ok {
+			out = append(out, f)
+		}
+	}
+
+	return out
+}
+
+func (r *rules) number(name protoreflect.Name) (float64, bool) {
+	v, ok := r.get(name)
+	if !ok {
+		return 0, false
+	}
+
+	return number(v)
+}
+
+func number(v protoreflect.Value) (float64, bool) {
+	switch n := v.Interface().(type) {
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+// str returns the string rules, if the field has them.
+func (r *rules) str() *validate.StringRules {
+	if r == nil {
+		return nil
+	}
+
+	return r.field.GetString_()
+}
+
+func (r *rules) constantString() (string, bool) {
+	if r.str() == nil {
+		return "", false
+	}
+	v, ok := r.get("const")
+
+	return v.String(), ok
+}
+
+func (r *rules) inStrings() []string {
+	return r.str().GetIn()
+}
+
+func (r *rules) pattern() string {
+	return r.str().GetPattern()
+}
+
+// length returns the smallest and largest number of characters of a string.
+func (r *rules) length() (int, int) {
+	s := r.str()
+	if s == nil {
+		return 0, int(^uint(0) >> 1)
+	}
+	if s.Len != nil {
+		return int(s.GetLen()), int(s.GetLen())
+	}
+
+	hi := int(^uint(0) >> 1)
+	if s.MaxLen != nil {
+		hi = int(s.GetMaxLen())
+	}
+
+	return int(s.GetMinLen()), hi
+}
+
+// items returns the smallest and largest number of entries of a repeated
+// or map field.
+func (r *rules) items() (int, int) {
+	hi := int(^uint(0) >> 1)
+	switch {
+	case r == nil:
+		return 0, hi
+	case r.field.GetRepeated() != nil:
+		rep := r.field.GetRepeated()
+		if rep.MaxItems != nil {
+			hi = int(rep.GetMaxItems())
+		}
+		return int(rep.GetMinItems()), hi
+	case r.field.GetMap() != nil:
+		m := r.field.GetMap()
+		if m.MaxPairs != nil {
+			hi = int(m.GetMaxPairs())
+		}
+		return int(m.GetMinPairs()), hi
+	default:
+		return 0, hi
+	}
+}
+
+// item returns the rules of the items of a repeated field.
+func (r *rules) item() *rules {
+	if r == nil {
+		return nil
+	}
+
+	return newRules(r.field.GetRepeated().GetItems())
+}
+
+// mapValue returns the rules of the values of a map field.
+func (r *rules) mapValue() *rules {
+	if r == nil {
+		return nil
+	}
+
+	return newRules(r.field.GetMap().GetValues())
+}
+
+// zeroValid reports whether the rules of fd accept its zero value, so that
+// it may be left unset.
+func zeroValid(fd protoreflect.FieldDescriptor) bool {
+	r := rulesOf(fd)
+	if r == nil || r.ignoreEmpty() {
+		return true
+	}
+	if lo, _ := r.items(); lo > 0 {
+		return false
+	}
+	if fd.IsList() || fd.IsMap() {
+		return true
+	}
+
+	if fd.Kind() == protoreflect.StringKind {
+		if s, ok := r.constantString(); ok {
+			return s == ""
+		}
+		if in := r.inStrings(); len(in) > 0 {
+			return slices.Contains(in, "")
+		}
+		if lo, _ := r.length(); lo > 0 {
+			return false
+		}
+		if p := r.pattern(); p != "" {
+			re, err := regexp.Compile(p)
+			return err == nil && re.MatchString("")
+		}
+		return true
+	}
+
+	if v, ok := r.constant(); ok {
+		return v == 0
+	}
+	if in := r.in(); len(in) > 0 {
+		return slices.Contains(in, 0)
+	}
+	if l, ok := r.lower(); ok && (l.value > 0 || l.value == 0 && l.exclusive) {
+		return false
+	}
+	if u, ok := r.upper(); ok && (u.value < 0 || u.value == 0 && u.exclusive) {
+		return false
+	}
+
+	return true
+}
+
+// pattern is a compiled string pattern, and the syntax tree strings matching
+// it are built from.
+type pattern struct {
+	re   *regexp.Regexp
+	tree *syntax.Regexp
+}
+
+// match returns a random string matching the regular expression expr, and
+// false if none could be built.
+func (g *Generator) match(expr string) (string, bool) {
+	p, ok := g.patterns[expr]
+	if !ok {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return "", false
+		}
+		tree, err := syntax.Parse(expr, syntax.Perl)
+		if err != nil {
+			return "", false
+		}
+		p = &pattern{re: re, tree: tree.Simplify()}
+		g.patterns[expr] = p
+	}
+
+	var b strings.Builder
+	g.build(&b, p.tree)
+	s := b.String()
+
+	return s, p.re.MatchString(s)
+}
+
+// build writes a random string matching re to b. Anchors and boundaries are
+// ignored, so the result is checked against the compiled expression.
+func (g *Generator) build(b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			b.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		// Rune holds inclusive ranges, as pairs of bounds.
+		if len(re.Rune) == 0 {
+			return
+		}
+		i := 2 * g.rand.IntN(len(re.Rune)/2)
+		lo, hi := re.Rune[i], re.Rune[i+1]
+		b.WriteRune(lo + g.rand.Int32N(min(hi-lo, 0x7f)+1))
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteRune(alphabet[g.rand.IntN(len(alphabet))])
+	case syntax.OpCapture:
+		g.build(b, re.Sub[0])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		lo, hi := 0, 3
+		switch re.Op {
+		case syntax.OpPlus:
+			lo = 1
+		case syntax.OpQuest:
+			hi = 1
+		case syntax.OpRepeat:
+			lo, hi = re.Min, re.Max
+			if hi < 0 {
+				hi = lo + 3
+			}
+		}
+		for range lo + g.rand.IntN(hi-lo+1) {
+			g.build(b, re.Sub[0])
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			g.build(b, sub)
+		}
+	case syntax.OpAlternate:
+		g.build(b, re.Sub[g.rand.IntN(len(re.Sub))])
+	}
+}
@@ -0,0 +1,384 @@
+package utils
+
+import (
+	"context"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BreakerState is the state of one circuit breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed lets every call through.
+	BreakerClosed BreakerState = iota
+	// BreakerHalfOpen lets a few probe calls through to find out whether the
+	// upstream has recovered.
+	BreakerHalfOpen
+	// BreakerOpen fails every call with codes.Unavailable.
+	BreakerOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerHalfOpen:
+		return "half_open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerConfig tunes a CircuitBreaker.
+type BreakerConfig struct {
+	// FailureRatio is the share of failed calls within Window that opens
+	// the breaker, once at least MinRequests calls were made.
+	FailureRatio float64
+	MinRequests  int
+	Window       time.Duration
+
+	// OpenTimeout is how long the breaker stays open before it lets
+	// HalfOpenProbes calls through. The breaker closes again once all of
+	// them succeed, and reopens on the first failure.
+	OpenTimeout    time.Duration
+	HalfOpenProbes int
+
+	// PerMethod tracks every method separately instead of one breaker per
+	// service, so that one slow method does not cut off its siblings.
+	PerMethod bool
+
+	// FailureCodes are the status codes counted as failures. Other errors,
+	// such as NotFound, mean the upstream is healthy and answering.
+	FailureCodes []codes.Code
+}
+
+// DefaultBreakerConfig opens a breaker when half of at least 20 calls made
+// within 10 seconds failed, and probes again after 5 seconds.
+var DefaultBreakerConfig = BreakerConfig{
+	FailureRatio:   0.5,
+	MinRequests:    20,
+	Window:         10 * time.Second,
+	OpenTimeout:    5 * time.Second,
+	HalfOpenProbes: 3,
+	FailureCodes: []codes.Code{
+		codes.Unavailable,
+		codes.DeadlineExceeded,
+		codes.Internal,
+		codes.Unknown,
+	},
+}
+
+// CircuitBreaker fails calls fast with codes.Unavailable while their
+// upstream service, or method, keeps failing. Its state is exported through
+// the registry it was created with.
+type CircuitBreaker struct {
+	config  BreakerConfig
+	metrics *breakerMetrics
+	now     func() time.Time
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+}
+
+// NewCircuitBreaker creates a circuit breaker configured by config. Its
+// metrics are registered with registry unless it is nil.
+func NewCircuitBreaker(config BreakerConfig, registry *prometheus.Registry) (*CircuitBreaker, error) {
+	if config.FailureRatio <= 0 || config.FailureRatio > 1 {
+		return nil, errors.Errorf("failure ratio must be in (0, 1], got %v", config.FailureRatio)
+	}
+	if config.MinRequests < 1 {
+		config.MinRequests = 1
+	}
+	if config.HalfOpenProbes < 1 {
+		config.HalfOpenProbes = 1
+	}
+
+	cb := &CircuitBreaker{
+		config:   config,
+		now:      time.Now,
+		breakers: make(map[string]*breaker),
+	}
+
+	if registry != nil {
+		metrics, err := newBreakerMetrics(registry)
+		if err != nil {
+			return nil, err
+		}
+		cb.metrics = metrics
+	}
+
+	return cb, nil
+}
+
+// State reports the state of the breaker guarding target, which is a full
+// method name or a service name depending on BreakerConfig.PerMethod.
+func (cb *CircuitBreaker) State(target string) BreakerState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	b, ok := cb.breakers[target]
+	if !ok {
+		return BreakerClosed
+	}
+	b.advance(cb.now())
+
+	return b.state
+}
+
+func (cb *CircuitBreaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		done, err := cb.allow(method)
+		if err != nil {
+			return err
+		}
+
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		done(err)
+
+		return err
+	}
+}
+
+// StreamClientInterceptor guards opening streams. The outcome of a stream is
+// recorded once it ends: when opening it fails, when RecvMsg returns an
+// error, or when the context it was opened with is done. A stream that is
+// never drained nor canceled keeps its outcome, and a half-open probe, to
+// itself.
+func (cb *CircuitBreaker) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		done, err := cb.allow(method)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			done(err)
+			return nil, err
+		}
+
+		s := &breakerStream{ClientStream: stream, done: done}
+		s.stop = context.AfterFunc(ctx, func() {
+			s.finish(status.FromContextError(ctx.Err()).Err())
+		})
+
+		return s, nil
+	}
+}
+
+// breakerStream records the outcome of a stream with its breaker once the
+// stream ends.
+type breakerStream struct {
+	grpc.ClientStream
+
+	once sync.Once
+	done func(error)
+	stop func() bool
+}
+
+func (s *breakerStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.stop()
+		if err == io.EOF {
+			s.finish(nil)
+		} else {
+			s.finish(err)
+		}
+	}
+
+	return err
+}
+
+func (s *breakerStream) finish(err error) {
+	s.once.Do(func() {
+		s.done(err)
+	})
+}
+
+// allow admits a call to method, or rejects it when its breaker is open.
+// The returned function records the outcome of an admitted call.
+func (cb *CircuitBreaker) allow(method string) (func(error), error) {
+	target := method
+	if !cb.config.PerMethod {
+		target, _ = splitMethod(method)
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	b, ok := cb.breakers[target]
+	if !ok {
+		b = &breaker{target: target, config: &cb.config, metrics: cb.metrics}
+		b.reset(cb.now())
+		cb.breakers[target] = b
+		if cb.metrics != nil {
+			cb.metrics.state.WithLabelValues(target).Set(float64(BreakerClosed))
+		}
+	}
+
+	now := cb.now()
+	b.advance(now)
+
+	switch {
+	case b.state == BreakerOpen,
+		b.state == BreakerHalfOpen && b.probes >= cb.config.HalfOpenProbes:
+		if cb.metrics != nil {
+			cb.metrics.rejected.WithLabelValues(target).Inc()
+		}
+
+		return nil, status.Errorf(codes.Unavailable, "circuit breaker for %s is open", target)
+	}
+
+	if b.state == BreakerHalfOpen {
+		b.probes++
+	}
+	generation := b.generation
+
+	return func(err error) {
+		failed := err != nil && slices.Contains(cb.config.FailureCodes, status.Code(err))
+
+		cb.mu.Lock()
+		defer cb.mu.Unlock()
+
+		// Outcomes of calls admitted before the last transition say nothing
+		// about the current state.
+		if b.generation != generation {
+			return
+		}
+		b.record(cb.now(), failed)
+	}, nil
+}
+
+// breaker is the state kept for one target. It is guarded by the
+// CircuitBreaker's mutex.
+type breaker struct {
+	target  string
+	config  *BreakerConfig
+	metrics *breakerMetrics
+
+	state      BreakerState
+	generation uint64
+	since      time.Time
+
+	requests  int
+	failures  int
+	probes    int
+	successes int
+}
+
+// advance moves an open breaker to half-open once its timeout has passed,
+// and starts a new window for a closed one whose window is over.
+func (b *breaker) advance(now time.Time) {
+	switch b.state {
+	case BreakerOpen:
+		if now.Sub(b.since) >= b.config.OpenTimeout {
+			b.transition(now, BreakerHalfOpen)
+		}
+	case BreakerClosed:
+		if b.config.Window > 0 && now.Sub(b.since) >= b.config.Window {
+			b.reset(now)
+		}
+	}
+}
+
+func (b *breaker) record(now time.Time, failed bool) {
+	switch b.state {
+	case BreakerClosed:
+		b.requests++
+		if failed {
+			b.failures++
+		}
+		if b.requests >= b.config.MinRequests && float64(b.failures)/float64(b.requests) >= b.config.FailureRatio {
+			b.transition(now, BreakerOpen)
+		}
+	case BreakerHalfOpen:
+		if failed {
+			b.transition(now, BreakerOpen)
+			return
+		}
+		b.successes++
+		if b.successes >= b.config.HalfOpenProbes {
+			b.transition(now, BreakerClosed)
+		}
+	}
+}
+
+func (b *breaker) transition(now time.Time, state BreakerState) {
+	b.state = state
+	b.generation++
+	b.reset(now)
+
+	if b.metrics != nil {
+		b.metrics.state.WithLabelValues(b.target).Set(float64(state))
+		b.metrics.transitions.WithLabelValues(b.target, state.String()).Inc()
+	}
+}
+
+func (b *breaker) reset(now time.Time) {
+	b.since = now
+	b.requests, b.failures = 0, 0
+	b.probes, b.successes = 0, 0
+}
+
+type breakerMetrics struct {
+	state       *prometheus.GaugeVec
+	transitions *prometheus.CounterVec
+	rejected    *prometheus.CounterVec
+}
+
+func newBreakerMetrics(registry *prometheus.Registry) (*breakerMetrics, error) {
+	state, err := register(registry, prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grpc_client_circuit_breaker_state",
+		Help: "State of the client circuit breaker: 0 closed, 1 half-open, 2 open.",
+	}, []string{"target"}))
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := register(registry, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_circuit_breaker_transitions_total",
+		Help: "Number of state changes of the client circuit breaker.",
+	}, []string{"target", "state"}))
+	if err != nil {
+		return nil, err
+	}
+
+	rejected, err := register(registry, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_circuit_breaker_rejected_total",
+		Help: "Number of calls failed fast by an open client circuit breaker.",
+	}, []string{"target"}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &breakerMetrics{state: state, transitions: transitions, rejected: rejected}, nil
+}
+
+// register registers c with registry, returning the collector registered
+// earlier under the same name when there is one.
+func register[C prometheus.Collector](registry *prometheus.Registry, c C) (C, error) {
+	if err := registry.Register(c); err != nil {
+		var already prometheus.AlreadyRegisteredError
+		if errors.As(err, &already) {
+			if existing, ok := already.ExistingCollector.(C); ok {
+				return existing, nil
+			}
+		}
+
+		return c, errors.Wrap(err, "failed to register metrics")
+	}
+
+	return c, nil
+}
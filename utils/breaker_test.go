@@ -0,0 +1,287 @@
+package utils
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	breakerMethod = "/loci.test.Service/Method"
+	breakerTarget = "loci.test.Service"
+)
+
+var (
+	errUnavailable = status.Error(codes.Unavailable, "unavailable")
+	errNotFound    = status.Error(codes.NotFound, "not found")
+)
+
+var testBreakerConfig = BreakerConfig{
+	FailureRatio:   0.5,
+	MinRequests:    4,
+	Window:         10 * time.Second,
+	OpenTimeout:    5 * time.Second,
+	HalfOpenProbes: 2,
+	FailureCodes:   []codes.Code{codes.Unavailable},
+}
+
+// newTestBreaker returns a breaker whose clock only moves with the returned
+// function.
+func newTestBreaker(t *testing.T, config BreakerConfig) (*CircuitBreaker, func(time.Duration)) {
+	t.Helper()
+
+	cb, err := NewCircuitBreaker(config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cb.now = func() time.Time { return now }
+
+	return cb, func(d time.Duration) { now = now.Add(d) }
+}
+
+// call makes a unary call through cb that fails with err, and reports
+// whether the call reached the invoker.
+func call(t *testing.T, cb *CircuitBreaker, method string, err error) bool {
+	t.Helper()
+
+	invoked := false
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		invoked = true
+		return err
+	}
+	got := cb.UnaryClientInterceptor()(context.Background(), method, nil, nil, nil, invoker)
+	if !invoked && status.Code(got) != codes.Unavailable {
+		t.Errorf("rejected call failed with %v, want %v", got, codes.Unavailable)
+	}
+
+	return invoked
+}
+
+func wantState(t *testing.T, cb *CircuitBreaker, target string, want BreakerState) {
+	t.Helper()
+
+	if got := cb.State(target); got != want {
+		t.Errorf("State(%s) = %v, want %v", target, got, want)
+	}
+}
+
+func TestCircuitBreakerTrips(t *testing.T) {
+	cb, advance := newTestBreaker(t, testBreakerConfig)
+
+	// Errors that are not failures count as successes.
+	for _, err := range []error{nil, errNotFound, errUnavailable} {
+		call(t, cb, breakerMethod, err)
+	}
+	wantState(t, cb, breakerTarget, BreakerClosed)
+
+	// A new window forgets the calls of the last one.
+	advance(testBreakerConfig.Window)
+	call(t, cb, breakerMethod, errUnavailable)
+	call(t, cb, breakerMethod, nil)
+	call(t, cb, breakerMethod, errUnavailable)
+	wantState(t, cb, breakerTarget, BreakerClosed)
+
+	call(t, cb, breakerMethod, nil)
+	wantState(t, cb, breakerTarget, BreakerOpen)
+	if call(t, cb, breakerMethod, nil) {
+		t.Error("an open breaker let a call through")
+	}
+
+	// Other services have breakers of their own.
+	if !call(t, cb, "/loci.test.Other/Method", nil) {
+		t.Error("the breaker of another service rejected a call")
+	}
+}
+
+func TestCircuitBreakerPerMethod(t *testing.T) {
+	config := testBreakerConfig
+	config.PerMethod = true
+	config.MinRequests = 1
+	cb, _ := newTestBreaker(t, config)
+
+	call(t, cb, breakerMethod, errUnavailable)
+	wantState(t, cb, breakerMethod, BreakerOpen)
+	if !call(t, cb, "/loci.test.Service/Other", nil) {
+		t.Error("the breaker of another method rejected a call")
+	}
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	tests := []struct {
+		name   string
+		probes []error
+		want   BreakerState
+	}{
+		{name: "recovered", probes: []error{nil, errNotFound}, want: BreakerClosed},
+		{name: "still failing", probes: []error{nil, errUnavailable}, want: BreakerOpen},
+		{name: "first probe failing", probes: []error{errUnavailable}, want: BreakerOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testBreakerConfig
+			config.MinRequests = 1
+			cb, advance := newTestBreaker(t, config)
+
+			call(t, cb, breakerMethod, errUnavailable)
+			advance(config.OpenTimeout - time.Millisecond)
+			wantState(t, cb, breakerTarget, BreakerOpen)
+			advance(time.Millisecond)
+			wantState(t, cb, breakerTarget, BreakerHalfOpen)
+
+			for _, err := range tt.probes {
+				if !call(t, cb, breakerMethod, err) {
+					t.Fatal("a half-open breaker rejected a probe")
+				}
+			}
+			wantState(t, cb, breakerTarget, tt.want)
+		})
+	}
+}
+
+func TestCircuitBreakerHalfOpenProbes(t *testing.T) {
+	config := testBreakerConfig
+	config.MinRequests = 1
+	cb, advance := newTestBreaker(t, config)
+
+	call(t, cb, breakerMethod, errUnavailable)
+	advance(config.OpenTimeout)
+
+	// Only HalfOpenProbes calls are let through until they finish.
+	var pending []func(error)
+	for range config.HalfOpenProbes {
+		done, err := cb.allow(breakerMethod)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pending = append(pending, done)
+	}
+	if _, err := cb.allow(breakerMethod); status.Code(err) != codes.Unavailable {
+		t.Errorf("allow() past the probes = %v, want %v", err, codes.Unavailable)
+	}
+
+	for _, done := range pending {
+		done(nil)
+	}
+	wantState(t, cb, breakerTarget, BreakerClosed)
+}
+
+func TestCircuitBreakerStaleGeneration(t *testing.T) {
+	config := testBreakerConfig
+	config.MinRequests = 1
+	config.HalfOpenProbes = 1
+	cb, advance := newTestBreaker(t, config)
+
+	// A call admitted while closed finishes after the breaker opened.
+	stale, err := cb.allow(breakerMethod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call(t, cb, breakerMethod, errUnavailable)
+	advance(config.OpenTimeout)
+	wantState(t, cb, breakerTarget, BreakerHalfOpen)
+
+	// Its success must not close the breaker in place of a probe.
+	stale(nil)
+	wantState(t, cb, breakerTarget, BreakerHalfOpen)
+
+	probe, err := cb.allow(breakerMethod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	probe(nil)
+	wantState(t, cb, breakerTarget, BreakerClosed)
+
+	// Nor must a stale failure open it again.
+	stale, err = cb.allow(breakerMethod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call(t, cb, breakerMethod, errUnavailable)
+	advance(config.OpenTimeout)
+	call(t, cb, breakerMethod, nil)
+	wantState(t, cb, breakerTarget, BreakerClosed)
+	stale(errUnavailable)
+	wantState(t, cb, breakerTarget, BreakerClosed)
+}
+
+// endingStream is a client stream whose RecvMsg returns err.
+type endingStream struct {
+	grpc.ClientStream
+
+	err error
+}
+
+func (s *endingStream) RecvMsg(any) error { return s.err }
+
+func TestCircuitBreakerStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		openErr error
+		recvErr error
+		cancel  bool
+		want    BreakerState
+	}{
+		{name: "open failed", openErr: errUnavailable, want: BreakerOpen},
+		{name: "stream failed", recvErr: errUnavailable, want: BreakerOpen},
+		{name: "stream ended", recvErr: io.EOF, want: BreakerClosed},
+		{name: "stream answered not found", recvErr: errNotFound, want: BreakerClosed},
+		{name: "canceled", cancel: true, want: BreakerClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testBreakerConfig
+			config.MinRequests = 1
+			cb, _ := newTestBreaker(t, config)
+
+			streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+				if tt.openErr != nil {
+					return nil, tt.openErr
+				}
+				return &endingStream{err: tt.recvErr}, nil
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stream, err := cb.StreamClientInterceptor()(ctx, &grpc.StreamDesc{ServerStreams: true}, nil, breakerMethod, streamer)
+			if err != nil {
+				wantState(t, cb, breakerTarget, tt.want)
+				return
+			}
+
+			// Until it ends, a stream is not counted.
+			wantState(t, cb, breakerTarget, BreakerClosed)
+			if tt.cancel {
+				cancel()
+				recorded := func() bool {
+					cb.mu.Lock()
+					defer cb.mu.Unlock()
+					return cb.breakers[breakerTarget].requests > 0
+				}
+				for deadline := time.Now().Add(5 * time.Second); !recorded(); time.Sleep(time.Millisecond) {
+					if time.Now().After(deadline) {
+						t.Fatal("canceling the stream did not record its outcome")
+					}
+				}
+			} else if err := stream.RecvMsg(nil); err != tt.recvErr {
+				t.Fatalf("RecvMsg() = %v, want %v", err, tt.recvErr)
+			}
+			wantState(t, cb, breakerTarget, tt.want)
+		})
+	}
+}
+
+func TestNewCircuitBreaker(t *testing.T) {
+	for _, ratio := range []float64{0, -1, 1.5} {
+		config := testBreakerConfig
+		config.FailureRatio = ratio
+		if _, err := NewCircuitBreaker(config, nil); err == nil {
+			t.Errorf("NewCircuitBreaker() accepted a failure ratio of %v", ratio)
+		}
+	}
+}
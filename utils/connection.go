@@ -1,26 +1,81 @@
 package utils
 
 import (
-	g "github.com/FACorreiaa/go-poi-au-suggestions/protocol/grpc"
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/FACorreiaa/loci-proto/middleware"
 )
 
-func NewConnection(serverAddress string) (*grpc.ClientConn, error) {
+// NewConnection opens a dedicated connection to serverAddress using the
+// package-level Transport.
+//
+// Deprecated: Use Connect with explicit transport utils, or configure Brokers
+// with options such as WithLogger.
+func NewConnection(serverAddress string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	tu := Transport
 	if tu == nil {
 		return nil, errors.New("transport utils are required")
 	}
 
-	conn, err := g.BootstrapClient(
-		serverAddress,
-		tu.Logger,
-		tu.TraceProvider,
-		tu.Prometheus,
-	)
+	return Connect(serverAddress, tu, opts...)
+}
+
+// Connect opens a dedicated connection to serverAddress instrumented with tu.
+func Connect(serverAddress string, tu *TransportUtils, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	if tu == nil || tu.Logger == nil {
+		return nil, errors.New("transport utils are required")
+	}
+
+	conn, err := bootstrapClient(serverAddress, tu, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to connect to upstream host")
 	}
 
 	return conn, nil
 }
+
+// bootstrapClient builds the client connection the same way upstream's
+// protocol/grpc.BootstrapClient does, but everything it instruments the
+// connection with comes from tu rather than from process-wide state.
+func bootstrapClient(address string, tu *TransportUtils, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	var otelOpts []otelgrpc.Option
+	if tu.TraceProvider != nil {
+		otelOpts = append(otelOpts, otelgrpc.WithTracerProvider(tu.TraceProvider))
+	}
+	spanHandler := middleware.NewOtelClientHandler(otelOpts...)
+	logInterceptor := middleware.ZapClientInterceptors(tu.Logger)
+
+	unary := []grpc.UnaryClientInterceptor{logInterceptor.Unary}
+	stream := []grpc.StreamClientInterceptor{logInterceptor.Stream}
+	if tu.Prometheus != nil {
+		metrics, err := clientMetrics(tu.Prometheus)
+		if err != nil {
+			return nil, err
+		}
+		unary = append(unary, metrics.UnaryClientInterceptor())
+		stream = append(stream, metrics.StreamClientInterceptor())
+	}
+
+	connOptions := []grpc.DialOption{
+		// For local dev/demo, we use insecure. For production, use TLS.
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"round_robin"}`),
+		grpc.WithStatsHandler(spanHandler.Handler),
+		grpc.WithChainUnaryInterceptor(unary...),
+		grpc.WithChainStreamInterceptor(stream...),
+	}
+	connOptions = append(connOptions, opts...)
+
+	return grpc.NewClient(address, connOptions...)
+}
+
+// clientMetrics registers the gRPC client metrics with registry, reusing the
+// collector when another connection already registered it.
+func clientMetrics(registry *prometheus.Registry) (*grpcprom.ClientMetrics, error) {
+	return register(registry, grpcprom.NewClientMetrics())
+}
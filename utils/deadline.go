@@ -0,0 +1,176 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	aipoi "github.com/FACorreiaa/loci-proto/modules/ai_poi_service/generated"
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	poi "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+	statistics "github.com/FACorreiaa/loci-proto/modules/statistics/generated"
+)
+
+// DefaultDeadline bounds every unary call made without a deadline that has
+// no entry of its own in the deadline table.
+const DefaultDeadline = 10 * time.Second
+
+// Deadlines is the table of timeouts applied to calls whose context carries
+// no deadline. Calls that already have one are left alone.
+type Deadlines struct {
+	// Default applies to unary calls without an entry in Methods. Zero
+	// leaves them unbounded.
+	Default time.Duration
+
+	// Methods maps a full method name, such as
+	// "/ai_poi.poi.v1.POIService/SearchPOIsHybrid", or a whole service, such
+	// as "ai_poi.poi.v1.POIService", to its timeout. Method entries take
+	// precedence over service entries. Streams are only bounded when they
+	// have an entry, since most of them are meant to stay open.
+	Methods map[string]time.Duration
+}
+
+// defaultDeadlines is shared by every Broker dialed without WithDeadlines,
+// so that they can share pooled connections.
+var defaultDeadlines = DefaultDeadlines()
+
+// DefaultDeadlines bounds unary calls by DefaultDeadline and gives the
+// LLM-backed searches, analytics and chat streams the longer timeouts they
+// need.
+func DefaultDeadlines() *Deadlines {
+	return &Deadlines{
+		Default: DefaultDeadline,
+		Methods: map[string]time.Duration{
+			aipoi.AiPoiService_HealthCheck_FullMethodName: 2 * time.Second,
+
+			poi.POIService_SearchPOIsSemantic_FullMethodName:       20 * time.Second,
+			poi.POIService_SearchPOIsSemanticByCity_FullMethodName: 20 * time.Second,
+			poi.POIService_SearchPOIsHybrid_FullMethodName:         20 * time.Second,
+			poi.POIService_GenerateEmbeddings_FullMethodName:       time.Minute,
+
+			statistics.StatisticsService_GetUserActivityAnalytics_FullMethodName: 30 * time.Second,
+			statistics.StatisticsService_GetSystemAnalytics_FullMethodName:       30 * time.Second,
+
+			chat.ChatService_StartChatStream_FullMethodName:    5 * time.Minute,
+			chat.ChatService_ContinueChatStream_FullMethodName: 5 * time.Minute,
+			chat.ChatService_FreeChatStream_FullMethodName:     5 * time.Minute,
+		},
+	}
+}
+
+// Timeout returns the timeout for fullMethod. Unary calls fall back to
+// Default; streams report false when they have no entry.
+func (d *Deadlines) Timeout(fullMethod string, stream bool) (time.Duration, bool) {
+	if t, ok := d.Methods[fullMethod]; ok {
+		return t, t > 0
+	}
+
+	service, _ := splitMethod(fullMethod)
+	if t, ok := d.Methods[service]; ok {
+		return t, t > 0
+	}
+
+	if stream {
+		return 0, false
+	}
+
+	return d.Default, d.Default > 0
+}
+
+// UnaryClientInterceptor bounds unary calls made without a deadline by
+// their timeout in d.
+func (d *Deadlines) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			if timeout, ok := d.Timeout(method, false); ok {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor bounds streams opened without a deadline by their
+// timeout in d, if they have one.
+func (d *Deadlines) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if _, ok := ctx.Deadline(); ok {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		timeout, ok := d.Timeout(method, true)
+		if !ok {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		// The stream context is canceled once the stream finishes, which is
+		// when the timer can be released.
+		go func() {
+			<-stream.Context().Done()
+			cancel()
+		}()
+
+		return stream, nil
+	}
+}
+
+// Budget divides what is left of a context's deadline between the calls a
+// request fans out to, so that one slow Broker cannot use up the time the
+// others need. Calls made one after another each get an equal share of the
+// time remaining when they start; calls made concurrently should share a
+// single context from Next instead.
+type Budget struct {
+	ctx     context.Context
+	reserve time.Duration
+
+	mu        sync.Mutex
+	remaining int
+}
+
+// NewBudget splits the deadline of ctx over the given number of calls.
+// reserve is held back for the caller to build its own response after the
+// last call. A ctx without a deadline yields contexts without one, leaving
+// the calls to the deadline table.
+func NewBudget(ctx context.Context, calls int, reserve time.Duration) *Budget {
+	if calls < 1 {
+		calls = 1
+	}
+
+	return &Budget{ctx: ctx, reserve: reserve, remaining: calls}
+}
+
+// Next returns the context for the next call, whose deadline is its share
+// of the time left. The cancel function must be called once the call is
+// done. Calls past the planned number get whatever time is left.
+func (b *Budget) Next() (context.Context, context.CancelFunc) {
+	deadline, ok := b.ctx.Deadline()
+	if !ok {
+		return context.WithCancel(b.ctx)
+	}
+
+	b.mu.Lock()
+	share := b.remaining
+	if share > 1 {
+		b.remaining--
+	}
+	b.mu.Unlock()
+
+	left := time.Until(deadline) - b.reserve
+	if left < 0 {
+		left = 0
+	}
+
+	return context.WithTimeout(b.ctx, left/time.Duration(share))
+}
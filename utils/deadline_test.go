@@ -0,0 +1,201 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// remaining returns how long ctx has left, and false when it has no deadline.
+func remaining(ctx context.Context) (time.Duration, bool) {
+	deadline, ok := ctx.Deadline()
+
+	return time.Until(deadline), ok
+}
+
+// within reports whether got is at most want, and not more than a second
+// short of it.
+func within(got, want time.Duration) bool {
+	return got <= want && got > want-time.Second
+}
+
+func TestDeadlinesTimeout(t *testing.T) {
+	d := &Deadlines{
+		Default: 10 * time.Second,
+		Methods: map[string]time.Duration{
+			"loci.test.Service":          30 * time.Second,
+			"/loci.test.Service/Slow":    time.Minute,
+			"/loci.test.Service/Forever": 0,
+		},
+	}
+
+	tests := []struct {
+		method string
+		stream bool
+		want   time.Duration
+		wantOK bool
+	}{
+		{method: "/loci.test.Service/Slow", want: time.Minute, wantOK: true},
+		{method: "/loci.test.Service/Slow", stream: true, want: time.Minute, wantOK: true},
+		{method: "/loci.test.Service/Get", want: 30 * time.Second, wantOK: true},
+		{method: "/loci.test.Service/Forever"},
+		{method: "/loci.test.Other/Get", want: 10 * time.Second, wantOK: true},
+		{method: "/loci.test.Other/Stream", stream: true},
+	}
+	for _, tt := range tests {
+		got, ok := d.Timeout(tt.method, tt.stream)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Timeout(%s, %t) = %v, %t, want %v, %t", tt.method, tt.stream, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	if _, ok := (&Deadlines{}).Timeout("/loci.test.Other/Get", false); ok {
+		t.Error("a zero Default bounded a call")
+	}
+}
+
+func TestDeadlinesUnaryClientInterceptor(t *testing.T) {
+	d := &Deadlines{
+		Default: 10 * time.Second,
+		Methods: map[string]time.Duration{"/loci.test.Service/Slow": time.Minute},
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		timeout time.Duration
+		want    time.Duration
+	}{
+		{name: "default", method: "/loci.test.Service/Get", want: 10 * time.Second},
+		{name: "per method", method: "/loci.test.Service/Slow", want: time.Minute},
+		{name: "caller's shorter deadline", method: "/loci.test.Service/Slow", timeout: time.Second, want: time.Second},
+		{name: "caller's longer deadline", method: "/loci.test.Service/Get", timeout: time.Hour, want: time.Hour},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+			defer cancel()
+		}
+
+		var got time.Duration
+		var ok bool
+		invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			got, ok = remaining(ctx)
+			return nil
+		}
+		if err := d.UnaryClientInterceptor()(ctx, tt.method, nil, nil, nil, invoker); err != nil {
+			t.Fatal(err)
+		}
+		if !ok || !within(got, tt.want) {
+			t.Errorf("%s: call has %v left, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// contextStream is a client stream that ends when its context is done.
+type contextStream struct {
+	grpc.ClientStream
+
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context { return s.ctx }
+
+func TestDeadlinesStreamClientInterceptor(t *testing.T) {
+	d := &Deadlines{
+		Default: 10 * time.Second,
+		Methods: map[string]time.Duration{"/loci.test.Service/Chat": time.Minute},
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		timeout  time.Duration
+		want     time.Duration
+		wantNone bool
+	}{
+		{name: "per method", method: "/loci.test.Service/Chat", want: time.Minute},
+		{name: "no entry", method: "/loci.test.Service/Watch", wantNone: true},
+		{name: "caller's shorter deadline", method: "/loci.test.Service/Chat", timeout: time.Second, want: time.Second},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+			defer cancel()
+		}
+
+		var streamCtx context.Context
+		streamer := func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+			streamCtx = ctx
+			return &contextStream{ctx: ctx}, nil
+		}
+		if _, err := d.StreamClientInterceptor()(ctx, &grpc.StreamDesc{ServerStreams: true}, nil, tt.method, streamer); err != nil {
+			t.Fatal(err)
+		}
+
+		got, ok := remaining(streamCtx)
+		if tt.wantNone {
+			if ok {
+				t.Errorf("%s: stream has %v left, want no deadline", tt.name, got)
+			}
+			continue
+		}
+		if !ok || !within(got, tt.want) {
+			t.Errorf("%s: stream has %v left, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBudget(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
+	defer cancel()
+
+	// A second of the four is held back. Calls finishing early leave their
+	// time to the calls after them.
+	b := NewBudget(ctx, 3, time.Second)
+	for i, want := range []time.Duration{time.Second, 1500 * time.Millisecond, 3 * time.Second} {
+		call, done := b.Next()
+		got, ok := remaining(call)
+		done()
+		if !ok || !within(got, want) {
+			t.Errorf("call %d has %v left, want %v", i, got, want)
+		}
+	}
+
+	// Calls past the planned three get what is left, less the reserve.
+	call, done := b.Next()
+	defer done()
+	if got, _ := remaining(call); !within(got, 3*time.Second) {
+		t.Errorf("extra call has %v left, want 3s", got)
+	}
+}
+
+func TestBudgetExhausted(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	call, done := NewBudget(ctx, 2, 2*time.Second).Next()
+	defer done()
+	if call.Err() != context.DeadlineExceeded {
+		t.Errorf("call of a budget the reserve used up = %v, want %v", call.Err(), context.DeadlineExceeded)
+	}
+}
+
+func TestBudgetWithoutDeadline(t *testing.T) {
+	call, done := NewBudget(context.Background(), 2, time.Second).Next()
+	defer done()
+	if _, ok := call.Deadline(); ok {
+		t.Error("a budget without a deadline handed out a deadline")
+	}
+
+	done()
+	if call.Err() != context.Canceled {
+		t.Errorf("call after its cancel = %v, want %v", call.Err(), context.Canceled)
+	}
+}
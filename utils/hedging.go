@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// HedgingInterceptor carries out the hedging policies in sc. grpc-go parses
+// hedgingPolicy from the service config but does not act on it, so hedged
+// methods are handled here instead. Calls to other methods pass straight
+// through.
+//
+// Hedged attempts run concurrently, so call options that write back into
+// the caller's memory, such as grpc.Header, must not be used with them.
+func HedgingInterceptor(sc *ServiceConfig) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		policy, ok := sc.Policy(method)
+		out, isProto := reply.(proto.Message)
+		if !ok || policy.Hedging == nil || policy.Hedging.MaxAttempts < 2 || !isProto {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		return hedge(ctx, policy.Hedging, out, func(ctx context.Context, reply proto.Message) error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+	}
+}
+
+// hedge runs attempt until one copy succeeds or fails fatally, starting a
+// new copy every HedgingDelay and straight away after a non-fatal failure.
+// The winning reply is merged into out.
+func hedge(ctx context.Context, policy *HedgingPolicy, out proto.Message, attempt func(context.Context, proto.Message) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		reply proto.Message
+		err   error
+	}
+	results := make(chan result, policy.MaxAttempts)
+
+	launched := 0
+	launch := func() {
+		launched++
+		reply := proto.Clone(out)
+		go func() {
+			results <- result{reply: reply, err: attempt(ctx, reply)}
+		}()
+	}
+
+	timer := time.NewTimer(policy.HedgingDelay)
+	defer timer.Stop()
+
+	launch()
+	pending := 1
+
+	var lastErr error
+	for pending > 0 {
+		select {
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				proto.Merge(out, res.reply)
+				return nil
+			}
+
+			lastErr = res.err
+			if !slices.Contains(policy.NonFatalStatusCodes, status.Code(res.err)) {
+				return res.err
+			}
+		case <-timer.C:
+		}
+
+		if launched < policy.MaxAttempts && ctx.Err() == nil {
+			launch()
+			pending++
+			timer.Reset(policy.HedgingDelay)
+		}
+	}
+
+	return lastErr
+}
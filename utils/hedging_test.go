@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+func TestHedgeFirstSuccessWins(t *testing.T) {
+	policy := &HedgingPolicy{MaxAttempts: 3, HedgingDelay: 10 * time.Millisecond}
+
+	var attempts atomic.Int32
+	canceled := make(chan struct{})
+	out := new(common.BaseRequest)
+	err := hedge(context.Background(), policy, out, func(ctx context.Context, reply proto.Message) error {
+		if attempts.Add(1) == 1 {
+			// The first copy hangs until the winner cancels it.
+			<-ctx.Done()
+			close(canceled)
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		reply.(*common.BaseRequest).RequestId = "hedged"
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.GetRequestId() != "hedged" {
+		t.Errorf("reply = %q, want the winning reply hedged", out.GetRequestId())
+	}
+
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the losing copy was not canceled")
+	}
+}
+
+func TestHedge(t *testing.T) {
+	errUnavailable := status.Error(codes.Unavailable, "unavailable")
+	errInvalid := status.Error(codes.InvalidArgument, "invalid")
+
+	tests := []struct {
+		name         string
+		maxAttempts  int
+		errs         []error
+		want         error
+		wantAttempts int32
+	}{
+		{name: "first copy succeeds", maxAttempts: 3, errs: []error{nil}, wantAttempts: 1},
+		{name: "non-fatal error hedges straight away", maxAttempts: 3, errs: []error{errUnavailable, nil}, wantAttempts: 2},
+		{name: "fatal error", maxAttempts: 3, errs: []error{errInvalid}, want: errInvalid, wantAttempts: 1},
+		{name: "fatal error after a non-fatal one", maxAttempts: 3, errs: []error{errUnavailable, errInvalid}, want: errInvalid, wantAttempts: 2},
+		{name: "out of attempts", maxAttempts: 2, errs: []error{errUnavailable, errUnavailable}, want: errUnavailable, wantAttempts: 2},
+	}
+	for _, tt := range tests {
+		// Copies only follow each other on failures, never on the delay.
+		policy := &HedgingPolicy{MaxAttempts: tt.maxAttempts, HedgingDelay: time.Hour, NonFatalStatusCodes: []codes.Code{codes.Unavailable}}
+
+		var attempts atomic.Int32
+		out := new(common.BaseRequest)
+		err := hedge(context.Background(), policy, out, func(_ context.Context, reply proto.Message) error {
+			n := attempts.Add(1)
+			if err := tt.errs[n-1]; err != nil {
+				return err
+			}
+			reply.(*common.BaseRequest).RequestId = "ok"
+			return nil
+		})
+
+		if !errors.Is(err, tt.want) || attempts.Load() != tt.wantAttempts {
+			t.Errorf("%s: hedge() = %v after %d attempts, want %v after %d", tt.name, err, attempts.Load(), tt.want, tt.wantAttempts)
+		}
+		if tt.want == nil && out.GetRequestId() != "ok" {
+			t.Errorf("%s: reply = %q, want ok", tt.name, out.GetRequestId())
+		}
+	}
+}
+
+func TestHedgingInterceptor(t *testing.T) {
+	sc := &ServiceConfig{Methods: map[string]Policy{
+		"/loci.test.Service/Hedged":  {Hedging: &HedgingPolicy{MaxAttempts: 2, HedgingDelay: time.Millisecond}},
+		"/loci.test.Service/Retried": {Retry: &DefaultRetryPolicy},
+	}}
+
+	tests := []struct {
+		method     string
+		wantHedged bool
+	}{
+		{method: "/loci.test.Service/Hedged", wantHedged: true},
+		{method: "/loci.test.Service/Retried"},
+		{method: "/loci.test.Service/Other"},
+	}
+	for _, tt := range tests {
+		reply := new(common.BaseRequest)
+		var got any
+		invoker := func(_ context.Context, _ string, _, r any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			got = r
+			r.(*common.BaseRequest).RequestId = "ok"
+			return nil
+		}
+
+		if err := HedgingInterceptor(sc)(context.Background(), tt.method, nil, reply, nil, invoker); err != nil {
+			t.Fatal(err)
+		}
+		if hedged := got != reply; hedged != tt.wantHedged {
+			t.Errorf("%s: hedged = %t, want %t", tt.method, hedged, tt.wantHedged)
+		}
+		if reply.GetRequestId() != "ok" {
+			t.Errorf("%s: reply = %q, want ok", tt.method, reply.GetRequestId())
+		}
+	}
+}
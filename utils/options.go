@@ -0,0 +1,312 @@
+package utils
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/FACorreiaa/loci-proto/core"
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+	"github.com/FACorreiaa/loci-proto/middleware"
+)
+
+// Options configures how a Broker connects to its upstream host. Fields left
+// unset fall back to the deprecated package-level Transport.
+type Options struct {
+	Logger         *zap.Logger
+	Registry       *prometheus.Registry
+	TracerProvider trace.TracerProvider
+	DialOptions    []grpc.DialOption
+	Pool           *Pool
+
+	// TLS secures the connection. Connections are plaintext when it is nil,
+	// which is kept for compatibility; prefer WithInsecure to say so.
+	TLS *TLSConfig
+
+	// Credentials are attached to every call made through the connection.
+	Credentials credentials.PerRPCCredentials
+
+	// ServiceConfig holds the retry and hedging policies of the connection.
+	// DefaultServiceConfig is used when it is nil.
+	ServiceConfig *ServiceConfig
+
+	// Deadlines bounds calls made without a deadline. DefaultDeadlines is
+	// used when it is nil.
+	Deadlines *Deadlines
+
+	// Breaker enables a circuit breaker when set. Its metrics are exported
+	// through the registry of the transport utils.
+	Breaker *BreakerConfig
+
+	// RateLimit enables client-side rate limiting when set.
+	RateLimit *RateLimit
+
+	// ServiceName is put in the downstream field of every outgoing
+	// BaseRequest.
+	ServiceName string
+
+	// Language is sent as the accept-language metadata of calls whose
+	// context carries no languages of its own, see middleware.WithLanguage.
+	Language string
+}
+
+// Option configures a Broker, see the With* functions.
+type Option func(*Options)
+
+// NewOptions applies opts on top of the zero Options.
+func NewOptions(opts ...Option) *Options {
+	o := new(Options)
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithLogger sets the logger the Broker's calls are logged to.
+func WithLogger(logger *zap.Logger) Option {
+	return func(o *Options) {
+		o.Logger = logger
+	}
+}
+
+// WithTracerProvider sets the tracer provider the Broker's spans are created with.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *Options) {
+		o.TracerProvider = tp
+	}
+}
+
+// WithRegistry sets the registry the Broker's client metrics are registered with.
+func WithRegistry(registry *prometheus.Registry) Option {
+	return func(o *Options) {
+		o.Registry = registry
+	}
+}
+
+// WithDialOptions appends extra dial options for the Broker's connection.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *Options) {
+		o.DialOptions = append(o.DialOptions, opts...)
+	}
+}
+
+// WithPool sets the pool the Broker shares its connection through. Brokers
+// use DefaultPool otherwise.
+func WithPool(pool *Pool) Option {
+	return func(o *Options) {
+		o.Pool = pool
+	}
+}
+
+// WithTLS secures the Broker's connection as described by cfg.
+func WithTLS(cfg TLSConfig) Option {
+	return func(o *Options) {
+		o.TLS = &cfg
+	}
+}
+
+// WithInsecure explicitly disables transport security. Only use it for
+// local development.
+func WithInsecure() Option {
+	return WithTLS(TLSConfig{Insecure: true})
+}
+
+// WithCredentials attaches creds to every call the Broker makes. When creds
+// also provide UnaryClientInterceptor and StreamClientInterceptor methods,
+// as auth.Credentials does, those are installed as well.
+func WithCredentials(creds credentials.PerRPCCredentials) Option {
+	return func(o *Options) {
+		o.Credentials = creds
+	}
+}
+
+// WithServiceConfig replaces the default retry and hedging policies with sc.
+func WithServiceConfig(sc *ServiceConfig) Option {
+	return func(o *Options) {
+		o.ServiceConfig = sc
+	}
+}
+
+// WithDeadlines replaces the default deadline table with d.
+func WithDeadlines(d *Deadlines) Option {
+	return func(o *Options) {
+		o.Deadlines = d
+	}
+}
+
+// WithCircuitBreaker fails calls fast while their upstream keeps failing,
+// see CircuitBreaker.
+func WithCircuitBreaker(config BreakerConfig) Option {
+	return func(o *Options) {
+		o.Breaker = &config
+	}
+}
+
+// WithRateLimit keeps calls below limit and honours the retry-after hints
+// of a throttled upstream, see RateLimiter. Brokers sharing a pooled
+// connection share its limiter.
+func WithRateLimit(limit RateLimit) Option {
+	return func(o *Options) {
+		o.RateLimit = &limit
+	}
+}
+
+// WithServiceName names the calling service in the BaseRequest of every
+// outgoing message. Request IDs are filled in regardless.
+func WithServiceName(name string) Option {
+	return func(o *Options) {
+		o.ServiceName = name
+	}
+}
+
+// WithLanguage sets the languages, such as "pt-PT" or a whole
+// Accept-Language header, servers answer in when the call does not say.
+func WithLanguage(language string) Option {
+	return func(o *Options) {
+		o.Language = language
+	}
+}
+
+// nopLogger is shared by every Broker given no logger, so that they can
+// share pooled connections.
+var nopLogger = zap.NewNop()
+
+// Transport resolves the transport utils described by o. Unset fields are
+// taken from the package-level Transport when it is set, and a no-op logger
+// is used when neither provides one.
+func (o *Options) Transport() *TransportUtils {
+	tu := &TransportUtils{
+		Logger:        o.Logger,
+		Prometheus:    o.Registry,
+		TraceProvider: o.TracerProvider,
+	}
+
+	if fallback := Transport; fallback != nil {
+		if tu.Logger == nil {
+			tu.Logger = fallback.Logger
+		}
+		if tu.Prometheus == nil {
+			tu.Prometheus = fallback.Prometheus
+		}
+		if tu.TraceProvider == nil {
+			tu.TraceProvider = fallback.TraceProvider
+		}
+	}
+
+	if tu.Logger == nil {
+		tu.Logger = nopLogger
+	}
+
+	return tu
+}
+
+// Dialer returns the core.DialFunc a Broker configured with o connects through.
+func (o *Options) Dialer() core.DialFunc {
+	pool := o.Pool
+	if pool == nil {
+		pool = DefaultPool
+	}
+
+	return pool.Dialer(o)
+}
+
+func (o *Options) serviceConfig() *ServiceConfig {
+	if o.ServiceConfig != nil {
+		return o.ServiceConfig
+	}
+
+	return defaultServiceConfig
+}
+
+func (o *Options) deadlines() *Deadlines {
+	if o.Deadlines != nil {
+		return o.Deadlines
+	}
+
+	return defaultDeadlines
+}
+
+// interceptorProvider is implemented by options that need to wrap calls as
+// well as configure the connection.
+type interceptorProvider interface {
+	UnaryClientInterceptor() grpc.UnaryClientInterceptor
+	StreamClientInterceptor() grpc.StreamClientInterceptor
+}
+
+// dialOptions returns the dial options described by o, in the order they
+// should be applied on top of the bootstrap defaults.
+func (o *Options) dialOptions(tu *TransportUtils) ([]grpc.DialOption, error) {
+	sc := o.serviceConfig()
+	serviceConfig, err := sc.JSON()
+	if err != nil {
+		return nil, err
+	}
+
+	validation, _ := middleware.ValidationInterceptors()
+	baseRequest := middleware.BaseRequestInterceptors(o.ServiceName)
+	language := middleware.LanguageInterceptors(o.Language)
+	deadlines := o.deadlines()
+	unary := []grpc.UnaryClientInterceptor{
+		rpcerrors.UnaryClientInterceptor(),
+		validation.Unary,
+		baseRequest.Unary,
+		language.Unary,
+		deadlines.UnaryClientInterceptor(),
+	}
+	stream := []grpc.StreamClientInterceptor{
+		rpcerrors.StreamClientInterceptor(),
+		validation.Stream,
+		baseRequest.Stream,
+		language.Stream,
+		deadlines.StreamClientInterceptor(),
+	}
+
+	if o.Breaker != nil {
+		cb, err := NewCircuitBreaker(*o.Breaker, tu.Prometheus)
+		if err != nil {
+			return nil, err
+		}
+		unary = append(unary, cb.UnaryClientInterceptor())
+		stream = append(stream, cb.StreamClientInterceptor())
+	}
+
+	// The interceptors above hedging see one call, those below it see each
+	// copy that is sent.
+	unary = append(unary, HedgingInterceptor(sc))
+
+	if o.RateLimit != nil {
+		limiter := NewRateLimiter(*o.RateLimit)
+		unary = append(unary, limiter.UnaryClientInterceptor())
+		stream = append(stream, limiter.StreamClientInterceptor())
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(unary...),
+		grpc.WithChainStreamInterceptor(stream...),
+	}
+
+	if o.TLS != nil {
+		creds, err := o.TLS.Credentials()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to set up transport security")
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	}
+
+	if o.Credentials != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(o.Credentials))
+		if i, ok := o.Credentials.(interceptorProvider); ok {
+			opts = append(opts,
+				grpc.WithChainUnaryInterceptor(i.UnaryClientInterceptor()),
+				grpc.WithChainStreamInterceptor(i.StreamClientInterceptor()),
+			)
+		}
+	}
+
+	return append(opts, o.DialOptions...), nil
+}
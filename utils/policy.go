@@ -0,0 +1,281 @@
+package utils
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+
+	rpcerrors "github.com/FACorreiaa/loci-proto/errors"
+	aipoi "github.com/FACorreiaa/loci-proto/modules/ai_poi_service/generated"
+	chat "github.com/FACorreiaa/loci-proto/modules/chat/generated"
+	city "github.com/FACorreiaa/loci-proto/modules/city/generated"
+	customer "github.com/FACorreiaa/loci-proto/modules/customer/generated"
+	interests "github.com/FACorreiaa/loci-proto/modules/interests/generated"
+	list "github.com/FACorreiaa/loci-proto/modules/list/generated"
+	poi "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+	profiles "github.com/FACorreiaa/loci-proto/modules/profiles/generated"
+	recents "github.com/FACorreiaa/loci-proto/modules/recents/generated"
+	review "github.com/FACorreiaa/loci-proto/modules/review/generated"
+	statistics "github.com/FACorreiaa/loci-proto/modules/statistics/generated"
+	tags "github.com/FACorreiaa/loci-proto/modules/tags/generated"
+	user "github.com/FACorreiaa/loci-proto/modules/user/generated"
+)
+
+// RetryPolicy retries a call that failed with one of RetryableStatusCodes,
+// backing off exponentially between attempts. Only use it for idempotent
+// methods.
+type RetryPolicy struct {
+	MaxAttempts          int
+	InitialBackoff       time.Duration
+	MaxBackoff           time.Duration
+	BackoffMultiplier    float64
+	RetryableStatusCodes []codes.Code
+}
+
+// HedgingPolicy sends another copy of a call every HedgingDelay until one
+// of them succeeds, fails with a code outside NonFatalStatusCodes, or
+// MaxAttempts copies are in flight. Only use it for idempotent methods.
+type HedgingPolicy struct {
+	MaxAttempts         int
+	HedgingDelay        time.Duration
+	NonFatalStatusCodes []codes.Code
+}
+
+// Policy is what a method is configured with. A method has either a retry
+// or a hedging policy, never both.
+type Policy struct {
+	Retry   *RetryPolicy
+	Hedging *HedgingPolicy
+}
+
+// RetryThrottling stops retries and hedges on a channel once too many calls
+// have failed, so a struggling backend is not buried under retries.
+type RetryThrottling struct {
+	MaxTokens  int
+	TokenRatio float64
+}
+
+// ServiceConfig is the Go form of the gRPC service config Brokers dial with.
+type ServiceConfig struct {
+	LoadBalancingPolicy string
+
+	// Methods maps a full method name, such as
+	// "/ai_poi.poi.v1.POIService/GetPOIsByCity", or a whole service, such as
+	// "ai_poi.poi.v1.POIService", to its policy. Method entries take
+	// precedence over service entries.
+	Methods map[string]Policy
+
+	RetryThrottling *RetryThrottling
+}
+
+var (
+	// DefaultRetryPolicy is applied to the idempotent reads in
+	// DefaultServiceConfig.
+	DefaultRetryPolicy = RetryPolicy{
+		MaxAttempts:          4,
+		InitialBackoff:       100 * time.Millisecond,
+		MaxBackoff:           2 * time.Second,
+		BackoffMultiplier:    2,
+		RetryableStatusCodes: []codes.Code{codes.Unavailable},
+	}
+
+	// DefaultHedgingPolicy is applied to the latency-sensitive searches in
+	// DefaultServiceConfig.
+	DefaultHedgingPolicy = HedgingPolicy{
+		MaxAttempts:         2,
+		HedgingDelay:        150 * time.Millisecond,
+		NonFatalStatusCodes: []codes.Code{codes.Unavailable},
+	}
+)
+
+// defaultServiceConfig is shared by every Broker dialed without
+// WithServiceConfig, so that they can share pooled connections.
+var defaultServiceConfig = DefaultServiceConfig()
+
+// DefaultServiceConfig retries the idempotent reads of every service, hedges
+// the POI searches and leaves mutations and streams alone.
+func DefaultServiceConfig() *ServiceConfig {
+	reads := map[string][]string{
+		aipoi.AiPoiService_ServiceDesc.ServiceName:         {"HealthCheck", "GetServiceInfo", "GetFeatureFlags"},
+		chat.ChatService_ServiceDesc.ServiceName:           {"GetChatSessions", "GetSavedItineraries", "GetPOIDetails"},
+		city.CityService_ServiceDesc.ServiceName:           {"GetCities", "GetCity", "SearchCities", "GetCityStatistics"},
+		customer.Customer_ServiceDesc.ServiceName:          {"GetCustomer"},
+		interests.InterestsService_ServiceDesc.ServiceName: {"GetAllInterests"},
+		list.ListService_ServiceDesc.ServiceName: {
+			"GetLists", "GetList", "GetListItems", "GetListRestaurants", "GetListHotels",
+			"GetListItineraries", "GetSavedLists", "SearchPublicLists",
+		},
+		poi.POIService_ServiceDesc.ServiceName: {
+			"GetPOIsByCity", "GetNearbyRecommendations", "DiscoverRestaurants", "DiscoverActivities",
+			"DiscoverHotels", "DiscoverAttractions", "GetFavorites", "GetItineraries", "GetItinerary",
+		},
+		profiles.ProfilesService_ServiceDesc.ServiceName: {"GetSearchProfiles", "GetSearchProfile", "GetDefaultSearchProfile"},
+		recents.RecentsService_ServiceDesc.ServiceName: {
+			"GetRecentInteractions", "GetCityInteractions", "GetInteractionHistory", "GetFrequentPlaces",
+		},
+		review.ReviewService_ServiceDesc.ServiceName: {"GetPOIReviews", "GetReview", "GetUserReviews", "GetReviewStatistics"},
+		statistics.StatisticsService_ServiceDesc.ServiceName: {
+			"GetMainPageStatistics", "GetDetailedPOIStatistics", "GetLandingPageStatistics",
+			"GetUserActivityAnalytics", "GetSystemAnalytics",
+		},
+		tags.TagsService_ServiceDesc.ServiceName: {"GetTags", "GetTag"},
+		user.UserService_ServiceDesc.ServiceName: {
+			"GetUserProfile", "GetSearchProfiles", "GetSearchProfile", "GetDefaultProfile",
+			"GetInterests", "GetTags", "GetTag",
+		},
+	}
+
+	searches := []string{
+		poi.POIService_SearchPOIs_FullMethodName,
+		poi.POIService_SearchPOIsSemantic_FullMethodName,
+		poi.POIService_SearchPOIsSemanticByCity_FullMethodName,
+		poi.POIService_SearchPOIsHybrid_FullMethodName,
+	}
+
+	sc := &ServiceConfig{
+		LoadBalancingPolicy: "round_robin",
+		Methods:             make(map[string]Policy),
+		RetryThrottling:     &RetryThrottling{MaxTokens: 10, TokenRatio: 0.1},
+	}
+
+	for service, methods := range reads {
+		for _, method := range methods {
+			retry := DefaultRetryPolicy
+			sc.Methods["/"+service+"/"+method] = Policy{Retry: &retry}
+		}
+	}
+
+	for _, method := range searches {
+		hedging := DefaultHedgingPolicy
+		sc.Methods[method] = Policy{Hedging: &hedging}
+	}
+
+	return sc
+}
+
+// Policy returns the policy that applies to fullMethod.
+func (sc *ServiceConfig) Policy(fullMethod string) (Policy, bool) {
+	if p, ok := sc.Methods[fullMethod]; ok {
+		return p, true
+	}
+
+	service, _ := splitMethod(fullMethod)
+	p, ok := sc.Methods[service]
+
+	return p, ok
+}
+
+// JSON renders sc as the service config JSON understood by
+// grpc.WithDefaultServiceConfig.
+func (sc *ServiceConfig) JSON() (string, error) {
+	type jsonName struct {
+		Service string `json:"service"`
+		Method  string `json:"method,omitempty"`
+	}
+	type jsonRetry struct {
+		MaxAttempts          int      `json:"maxAttempts"`
+		InitialBackoff       string   `json:"initialBackoff"`
+		MaxBackoff           string   `json:"maxBackoff"`
+		BackoffMultiplier    float64  `json:"backoffMultiplier"`
+		RetryableStatusCodes []string `json:"retryableStatusCodes"`
+	}
+	type jsonHedging struct {
+		MaxAttempts         int      `json:"maxAttempts"`
+		HedgingDelay        string   `json:"hedgingDelay"`
+		NonFatalStatusCodes []string `json:"nonFatalStatusCodes,omitempty"`
+	}
+	type jsonMethodConfig struct {
+		Name          []jsonName   `json:"name"`
+		RetryPolicy   *jsonRetry   `json:"retryPolicy,omitempty"`
+		HedgingPolicy *jsonHedging `json:"hedgingPolicy,omitempty"`
+	}
+	type jsonThrottling struct {
+		MaxTokens  int     `json:"maxTokens"`
+		TokenRatio float64 `json:"tokenRatio"`
+	}
+	type jsonConfig struct {
+		LoadBalancingPolicy string             `json:"loadBalancingPolicy,omitempty"`
+		MethodConfig        []jsonMethodConfig `json:"methodConfig,omitempty"`
+		RetryThrottling     *jsonThrottling    `json:"retryThrottling,omitempty"`
+	}
+
+	cfg := jsonConfig{LoadBalancingPolicy: sc.LoadBalancingPolicy}
+
+	names := make([]string, 0, len(sc.Methods))
+	for name := range sc.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		p := sc.Methods[name]
+		if p.Retry != nil && p.Hedging != nil {
+			return "", errors.Errorf("%s has both a retry and a hedging policy", name)
+		}
+
+		service, method := splitMethod(name)
+		mc := jsonMethodConfig{Name: []jsonName{{Service: service, Method: method}}}
+
+		if r := p.Retry; r != nil {
+			mc.RetryPolicy = &jsonRetry{
+				MaxAttempts:          r.MaxAttempts,
+				InitialBackoff:       durationJSON(r.InitialBackoff),
+				MaxBackoff:           durationJSON(r.MaxBackoff),
+				BackoffMultiplier:    r.BackoffMultiplier,
+				RetryableStatusCodes: codeNames(r.RetryableStatusCodes),
+			}
+		}
+
+		if h := p.Hedging; h != nil {
+			mc.HedgingPolicy = &jsonHedging{
+				MaxAttempts:         h.MaxAttempts,
+				HedgingDelay:        durationJSON(h.HedgingDelay),
+				NonFatalStatusCodes: codeNames(h.NonFatalStatusCodes),
+			}
+		}
+
+		cfg.MethodConfig = append(cfg.MethodConfig, mc)
+	}
+
+	if t := sc.RetryThrottling; t != nil {
+		cfg.RetryThrottling = &jsonThrottling{MaxTokens: t.MaxTokens, TokenRatio: t.TokenRatio}
+	}
+
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode service config")
+	}
+
+	return string(b), nil
+}
+
+// splitMethod splits "/pkg.Service/Method" into its service and method.
+// Anything else is taken to be a service name.
+func splitMethod(name string) (service, method string) {
+	trimmed := strings.TrimPrefix(name, "/")
+	if i := strings.LastIndex(trimmed, "/"); i >= 0 {
+		return trimmed[:i], trimmed[i+1:]
+	}
+
+	return trimmed, ""
+}
+
+// durationJSON formats d the way the service config expects, e.g. "0.1s".
+func durationJSON(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
+// codeNames converts codes to their canonical names, e.g. "UNAVAILABLE".
+func codeNames(cs []codes.Code) []string {
+	names := make([]string, 0, len(cs))
+	for _, c := range cs {
+		names = append(names, rpcerrors.CodeName(c))
+	}
+
+	return names
+}
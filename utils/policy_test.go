@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	poi "github.com/FACorreiaa/loci-proto/modules/poi/generated"
+)
+
+func TestDefaultServiceConfigJSON(t *testing.T) {
+	sc, err := DefaultServiceConfig().JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// grpc.NewClient rejects a default service config it cannot parse.
+	conn, err := grpc.NewClient("passthrough:///localhost:8080",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(sc),
+	)
+	if err != nil {
+		t.Fatalf("grpc.WithDefaultServiceConfig(%s): %v", sc, err)
+	}
+	_ = conn.Close()
+}
+
+func TestServiceConfigJSONBothPolicies(t *testing.T) {
+	sc := &ServiceConfig{Methods: map[string]Policy{
+		"loci.test.Service": {Retry: &DefaultRetryPolicy, Hedging: &DefaultHedgingPolicy},
+	}}
+	if _, err := sc.JSON(); err == nil {
+		t.Error("JSON() accepted a method with both a retry and a hedging policy")
+	}
+}
+
+func TestServiceConfigPolicy(t *testing.T) {
+	sc := &ServiceConfig{Methods: map[string]Policy{
+		"loci.test.Service":        {Retry: &DefaultRetryPolicy},
+		"/loci.test.Service/Hedge": {Hedging: &DefaultHedgingPolicy},
+	}}
+
+	tests := []struct {
+		method      string
+		wantOK      bool
+		wantHedging bool
+	}{
+		{method: "/loci.test.Service/Hedge", wantOK: true, wantHedging: true},
+		{method: "/loci.test.Service/Get", wantOK: true},
+		{method: "/loci.test.Other/Get"},
+	}
+	for _, tt := range tests {
+		p, ok := sc.Policy(tt.method)
+		if ok != tt.wantOK || (p.Hedging != nil) != tt.wantHedging {
+			t.Errorf("Policy(%s) = %+v, %t, want ok %t and hedging %t", tt.method, p, ok, tt.wantOK, tt.wantHedging)
+		}
+	}
+
+	if p, _ := DefaultServiceConfig().Policy(poi.POIService_SearchPOIs_FullMethodName); p.Hedging == nil {
+		t.Errorf("DefaultServiceConfig() does not hedge %s", poi.POIService_SearchPOIs_FullMethodName)
+	}
+}
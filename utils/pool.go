@@ -0,0 +1,297 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/FACorreiaa/loci-proto/core"
+)
+
+// DefaultPool is the pool Brokers dial through unless told otherwise, so
+// every Broker pointed at the same host shares one connection.
+var DefaultPool = NewPool(1)
+
+// Pool shares client connections between Brokers. Connections are keyed by
+// address, service name, language, transport utils, TLS config and the
+// other Options and are reference counted: the underlying connections are
+// only closed once every SharedConn handed out for a key has been closed.
+//
+// Everything but the address, service name, language and TLS config is
+// compared by identity, so Brokers that should share a connection must be
+// given the same values, typically built once at startup. Brokers sharing a
+// connection also share its circuit breaker and rate limiter.
+type Pool struct {
+	mu      sync.Mutex
+	size    int
+	sizes   map[string]int
+	entries map[string]*poolEntry
+}
+
+type poolEntry struct {
+	key   string
+	conns []*grpc.ClientConn
+	refs  int
+	next  atomic.Uint64
+}
+
+// NewPool creates a pool that opens size sub-connections per key. Calls are
+// spread over the sub-connections round-robin; a size of 1 disables that.
+func NewPool(size int) *Pool {
+	if size < 1 {
+		size = 1
+	}
+
+	return &Pool{
+		size:    size,
+		sizes:   make(map[string]int),
+		entries: make(map[string]*poolEntry),
+	}
+}
+
+// SetSize overrides the number of sub-connections opened for address. This
+// is meant for hosts serving heavy streaming load, such as ChatService, and
+// only affects connections opened after the call.
+func (p *Pool) SetSize(address string, size int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if size < 1 {
+		size = 1
+	}
+	p.sizes[address] = size
+}
+
+// Dial returns a handle on the shared connection for address configured
+// with o, opening it if no Broker holds one yet.
+func (p *Pool) Dial(address string, o *Options) (*SharedConn, error) {
+	tu := o.Transport()
+	key := poolKey(address, tu, o)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	entry, ok := p.entries[key]
+	if !ok {
+		size, ok := p.sizes[address]
+		if !ok {
+			size = p.size
+		}
+
+		opts, err := o.dialOptions(tu)
+		if err != nil {
+			return nil, err
+		}
+
+		conns := make([]*grpc.ClientConn, 0, size)
+		for range size {
+			conn, err := Connect(address, tu, opts...)
+			if err != nil {
+				for _, c := range conns {
+					_ = c.Close()
+				}
+				return nil, err
+			}
+			conns = append(conns, conn)
+		}
+
+		entry = &poolEntry{key: key, conns: conns}
+		p.entries[key] = entry
+	}
+
+	entry.refs++
+
+	return &SharedConn{pool: p, entry: entry}, nil
+}
+
+// Dialer returns a core.DialFunc that dials through the pool with o.
+func (p *Pool) Dialer(o *Options) core.DialFunc {
+	return func(address string) (core.Conn, error) {
+		conn, err := p.Dial(address, o)
+		if err != nil {
+			return nil, err
+		}
+
+		return conn, nil
+	}
+}
+
+// release drops one reference on entry and closes its connections when it
+// was the last one.
+func (p *Pool) release(entry *poolEntry) error {
+	p.mu.Lock()
+	entry.refs--
+	last := entry.refs == 0
+	if last {
+		delete(p.entries, entry.key)
+	}
+	p.mu.Unlock()
+
+	if !last {
+		return nil
+	}
+
+	var errs []string
+	for _, conn := range entry.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Errorf("failed to close pooled connections: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func poolKey(address string, tu *TransportUtils, o *Options) string {
+	var sb strings.Builder
+	sb.WriteString(address)
+	sb.WriteString("|" + o.ServiceName)
+	sb.WriteString("|" + o.Language)
+	fmt.Fprintf(&sb, "|%p|%p|%p|%p|%p", tu.Logger, tu.Prometheus, tu.TraceProvider, o.serviceConfig(), o.deadlines())
+	if o.TLS != nil {
+		fmt.Fprintf(&sb, "|%+v", *o.TLS)
+	}
+	if o.Credentials != nil {
+		fmt.Fprintf(&sb, "|%p", o.Credentials)
+	}
+	if o.Breaker != nil {
+		fmt.Fprintf(&sb, "|%p", o.Breaker)
+	}
+	if o.RateLimit != nil {
+		fmt.Fprintf(&sb, "|%p", o.RateLimit)
+	}
+	for _, opt := range o.DialOptions {
+		fmt.Fprintf(&sb, "|%p", opt)
+	}
+
+	return sb.String()
+}
+
+// SharedConn is one Broker's handle on a pooled connection. It satisfies
+// core.Conn; closing it releases the handle rather than the connection.
+type SharedConn struct {
+	pool     *Pool
+	entry    *poolEntry
+	released atomic.Bool
+}
+
+var _ core.Conn = (*SharedConn)(nil)
+
+// ClientConns returns the sub-connections behind the handle.
+func (s *SharedConn) ClientConns() []*grpc.ClientConn {
+	return s.entry.conns
+}
+
+func (s *SharedConn) pick() *grpc.ClientConn {
+	conns := s.entry.conns
+	if len(conns) == 1 {
+		return conns[0]
+	}
+
+	return conns[(s.entry.next.Add(1)-1)%uint64(len(conns))]
+}
+
+func (s *SharedConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	return s.pick().Invoke(ctx, method, args, reply, opts...)
+}
+
+func (s *SharedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return s.pick().NewStream(ctx, desc, method, opts...)
+}
+
+// GetState reports the healthiest state among the sub-connections, or
+// Shutdown once the handle has been released.
+func (s *SharedConn) GetState() connectivity.State {
+	if s.released.Load() {
+		return connectivity.Shutdown
+	}
+
+	best := connectivity.Shutdown
+	for _, conn := range s.entry.conns {
+		if state := conn.GetState(); stateRank(state) < stateRank(best) {
+			best = state
+		}
+	}
+
+	return best
+}
+
+// WaitForStateChange blocks until GetState differs from sourceState or ctx
+// is done, in which case it returns false.
+func (s *SharedConn) WaitForStateChange(ctx context.Context, sourceState connectivity.State) bool {
+	conns := s.entry.conns
+	if len(conns) == 1 && !s.released.Load() {
+		return conns[0].WaitForStateChange(ctx, sourceState)
+	}
+
+	for s.GetState() == sourceState {
+		if !s.waitAny(ctx) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// waitAny blocks until any sub-connection changes state.
+func (s *SharedConn) waitAny(ctx context.Context) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	changed := make(chan struct{}, len(s.entry.conns))
+	for _, conn := range s.entry.conns {
+		state := conn.GetState()
+		go func() {
+			if conn.WaitForStateChange(ctx, state) {
+				changed <- struct{}{}
+			}
+		}()
+	}
+
+	select {
+	case <-changed:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (s *SharedConn) Connect() {
+	for _, conn := range s.entry.conns {
+		conn.Connect()
+	}
+}
+
+// Close releases the handle. The pooled connections are closed once no
+// handle refers to them anymore.
+func (s *SharedConn) Close() error {
+	if s.released.Swap(true) {
+		return nil
+	}
+
+	return s.pool.release(s.entry)
+}
+
+// stateRank orders connectivity states from most to least usable.
+func stateRank(state connectivity.State) int {
+	switch state {
+	case connectivity.Ready:
+		return 0
+	case connectivity.Connecting:
+		return 1
+	case connectivity.Idle:
+		return 2
+	case connectivity.TransientFailure:
+		return 3
+	default:
+		return 4
+	}
+}
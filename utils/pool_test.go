@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/connectivity"
+)
+
+func TestPoolKey(t *testing.T) {
+	logger := zap.NewNop()
+	key := func(opts ...Option) string {
+		o := NewOptions(append([]Option{WithLogger(logger)}, opts...)...)
+		return poolKey("localhost:8080", o.Transport(), o)
+	}
+
+	tests := []struct {
+		name      string
+		a, b      []Option
+		wantEqual bool
+	}{
+		{name: "same options", a: []Option{WithLanguage("pt-PT")}, b: []Option{WithLanguage("pt-PT")}, wantEqual: true},
+		{name: "language", a: []Option{WithLanguage("pt-PT")}, b: []Option{WithLanguage("en")}},
+		{name: "service name", a: []Option{WithServiceName("gateway")}, b: []Option{WithServiceName("worker")}},
+		{name: "TLS", a: []Option{WithInsecure()}, b: nil},
+	}
+	for _, tt := range tests {
+		if got := key(tt.a...) == key(tt.b...); got != tt.wantEqual {
+			t.Errorf("%s: keys equal = %t, want %t", tt.name, got, tt.wantEqual)
+		}
+	}
+}
+
+func TestPoolKeyWithoutLogger(t *testing.T) {
+	a, b := NewOptions(), NewOptions()
+	if poolKey("localhost:8080", a.Transport(), a) != poolKey("localhost:8080", b.Transport(), b) {
+		t.Error("Options without a logger got different pool keys")
+	}
+}
+
+func TestPoolRefCounting(t *testing.T) {
+	const address = "passthrough:///loci-test"
+	p := NewPool(2)
+	o := NewOptions(WithInsecure())
+
+	dial := func(o *Options) *SharedConn {
+		t.Helper()
+		conn, err := p.Dial(address, o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return conn
+	}
+
+	a, b := dial(o), dial(o)
+	if a.entry != b.entry {
+		t.Fatal("Brokers with the same options got different connections")
+	}
+	if got := len(a.ClientConns()); got != 2 {
+		t.Errorf("got %d sub-connections, want 2", got)
+	}
+
+	other := dial(NewOptions(WithInsecure(), WithLanguage("pt-PT")))
+	if other.entry == a.entry {
+		t.Error("Brokers with different options share a connection")
+	}
+	defer other.Close()
+
+	conns := a.ClientConns()
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if a.GetState() != connectivity.Shutdown {
+		t.Errorf("released handle is %v, want %v", a.GetState(), connectivity.Shutdown)
+	}
+	for _, conn := range conns {
+		if conn.GetState() == connectivity.Shutdown {
+			t.Fatal("closing one handle closed the connection another one holds")
+		}
+	}
+
+	// Releasing a handle twice must not release the other one's reference.
+	if got := b.entry.refs; got != 1 {
+		t.Errorf("refs = %d after releasing one of two handles, want 1", got)
+	}
+
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+	for _, conn := range conns {
+		if conn.GetState() != connectivity.Shutdown {
+			t.Errorf("connection is %v after its last handle was closed, want %v", conn.GetState(), connectivity.Shutdown)
+		}
+	}
+	if len(p.entries) != 1 {
+		t.Errorf("pool holds %d entries, want only the other one", len(p.entries))
+	}
+
+	// The next Broker dials afresh.
+	c := dial(o)
+	defer c.Close()
+	if c.entry == a.entry {
+		t.Error("a closed connection was handed out again")
+	}
+}
+
+func TestPoolSetSize(t *testing.T) {
+	p := NewPool(1)
+	p.SetSize("passthrough:///chat", 3)
+
+	for address, want := range map[string]int{"passthrough:///chat": 3, "passthrough:///poi": 1} {
+		conn, err := p.Dial(address, NewOptions(WithInsecure()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := len(conn.ClientConns()); got != want {
+			t.Errorf("%s: got %d sub-connections, want %d", address, got, want)
+		}
+		_ = conn.Close()
+	}
+}
@@ -0,0 +1,250 @@
+package utils
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+const (
+	// RetryAfterKey is the trailer carrying the number of seconds to wait
+	// before calling a throttled upstream again.
+	RetryAfterKey = "retry-after"
+
+	// RateLimitInfoKey is the trailer carrying a binary common.RateLimitInfo.
+	RateLimitInfoKey = "ratelimit-info-bin"
+)
+
+// RateLimit configures the token bucket a RateLimiter draws from.
+type RateLimit struct {
+	// Rate is the number of calls per second, and Burst the number of calls
+	// that may be made at once.
+	Rate  float64
+	Burst int
+
+	// Backoff is how long calls are held back after a ResourceExhausted
+	// error that did not say how long to wait.
+	Backoff time.Duration
+
+	// MaxRetries is how many times a call rejected with ResourceExhausted is
+	// made again once the wait is over. Zero returns the error straight
+	// away.
+	MaxRetries int
+}
+
+// DefaultRateLimit mirrors the limit the upstream servers enforce, 10 calls
+// per second with bursts of 20.
+var DefaultRateLimit = RateLimit{
+	Rate:    10,
+	Burst:   20,
+	Backoff: time.Second,
+}
+
+// RateLimiter keeps a client below its upstream's rate limit. Besides its
+// own token bucket it honours the retry-after hints a throttled upstream
+// sends back, through trailers, error details or a RateLimitInfo in the
+// response, and holds every call back until the hint has passed.
+type RateLimiter struct {
+	limit   RateLimit
+	limiter *rate.Limiter
+
+	mu           sync.Mutex
+	blockedUntil time.Time
+}
+
+// NewRateLimiter creates a rate limiter for limit. A zero Rate disables
+// the token bucket while still honouring retry-after hints.
+func NewRateLimiter(limit RateLimit) *RateLimiter {
+	r := rate.Limit(limit.Rate)
+	if limit.Rate <= 0 {
+		r = rate.Inf
+	}
+
+	return &RateLimiter{
+		limit:   limit,
+		limiter: rate.NewLimiter(r, max(limit.Burst, 1)),
+	}
+}
+
+// Wait blocks until a call may be made. It fails with ResourceExhausted
+// straight away when ctx would expire before then.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	until := l.blockedUntil
+	l.mu.Unlock()
+
+	if wait := time.Until(until); wait > 0 {
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(until) {
+			return status.Errorf(codes.ResourceExhausted, "upstream asked to wait %s, which exceeds the deadline", wait.Round(time.Millisecond))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	if err := l.limiter.Wait(ctx); err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+
+		return status.Errorf(codes.ResourceExhausted, "client rate limit exceeded: %v", err)
+	}
+
+	return nil
+}
+
+// Backoff holds every call back for d.
+func (l *RateLimiter) Backoff(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	until := time.Now().Add(d)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+}
+
+func (l *RateLimiter) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for attempt := 0; ; attempt++ {
+			if err := l.Wait(ctx); err != nil {
+				return err
+			}
+
+			// opts may be shared with concurrent hedged attempts, so the
+			// trailer option must not be appended in place.
+			var trailer metadata.MD
+			err := invoker(ctx, method, req, reply, cc, append(opts[:len(opts):len(opts)], grpc.Trailer(&trailer))...)
+
+			wait, throttled := retryAfter(trailer, err, reply)
+			if !throttled && status.Code(err) == codes.ResourceExhausted {
+				wait, throttled = l.limit.Backoff, true
+			}
+			if !throttled {
+				return err
+			}
+			l.Backoff(wait)
+
+			if err == nil || status.Code(err) != codes.ResourceExhausted || attempt >= l.limit.MaxRetries {
+				return err
+			}
+		}
+	}
+}
+
+// StreamClientInterceptor holds back opening streams while the upstream is
+// throttling. Hints sent on the stream itself are not looked at.
+func (l *RateLimiter) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if err := l.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if wait, throttled := retryAfter(nil, err, nil); throttled {
+			l.Backoff(wait)
+		} else if status.Code(err) == codes.ResourceExhausted {
+			l.Backoff(l.limit.Backoff)
+		}
+
+		return stream, err
+	}
+}
+
+// retryAfter looks for a hint to stop calling the upstream for a while, in
+// the trailer, the error details and the reply, in that order.
+func retryAfter(trailer metadata.MD, err error, reply any) (time.Duration, bool) {
+	if v := trailer.Get(RetryAfterKey); len(v) > 0 {
+		if seconds, err := strconv.ParseFloat(v[0], 64); err == nil {
+			return time.Duration(seconds * float64(time.Second)), true
+		}
+	}
+
+	if v := trailer.Get(RateLimitInfoKey); len(v) > 0 {
+		info := new(common.RateLimitInfo)
+		if err := proto.Unmarshal([]byte(v[0]), info); err == nil {
+			if wait, ok := rateLimitWait(info); ok {
+				return wait, true
+			}
+		}
+	}
+
+	if err != nil {
+		for _, detail := range status.Convert(err).Details() {
+			switch d := detail.(type) {
+			case *common.RateLimitInfo:
+				if wait, ok := rateLimitWait(d); ok {
+					return wait, true
+				}
+			case *errdetails.RetryInfo:
+				return d.GetRetryDelay().AsDuration(), true
+			}
+		}
+
+		return 0, false
+	}
+
+	if m, ok := reply.(proto.Message); ok {
+		if info := findRateLimitInfo(m.ProtoReflect()); info != nil {
+			return rateLimitWait(info)
+		}
+	}
+
+	return 0, false
+}
+
+// rateLimitWait reads how long info asks to wait. A RateLimitInfo with
+// requests left asks for nothing.
+func rateLimitWait(info *common.RateLimitInfo) (time.Duration, bool) {
+	if seconds := info.GetRetryAfterSeconds(); seconds > 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if info.GetRequestsLimit() > 0 && info.GetRequestsRemaining() <= 0 && info.GetResetTime() != nil {
+		return time.Until(info.GetResetTime().AsTime()), true
+	}
+
+	return 0, false
+}
+
+// findRateLimitInfo returns the first RateLimitInfo set among the top-level
+// fields of m.
+func findRateLimitInfo(m protoreflect.Message) *common.RateLimitInfo {
+	var found *common.RateLimitInfo
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return true
+		}
+
+		info, ok := v.Message().Interface().(*common.RateLimitInfo)
+		if ok {
+			found = info
+		}
+
+		return !ok
+	})
+
+	return found
+}
@@ -0,0 +1,179 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	aipoi "github.com/FACorreiaa/loci-proto/modules/ai_poi_service/generated"
+	common "github.com/FACorreiaa/loci-proto/modules/common/generated"
+)
+
+func exhausted(t *testing.T, details ...proto.Message) error {
+	t.Helper()
+
+	st := status.New(codes.ResourceExhausted, "slow down")
+	for _, d := range details {
+		var err error
+		if st, err = st.WithDetails(protoadapt.MessageV1Of(d)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return st.Err()
+}
+
+func rateLimitTrailer(t *testing.T, info *common.RateLimitInfo) metadata.MD {
+	t.Helper()
+
+	b, err := proto.Marshal(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return metadata.Pairs(RateLimitInfoKey, string(b))
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		trailer metadata.MD
+		err     error
+		reply   any
+		want    time.Duration
+		wantOK  bool
+	}{
+		{name: "retry-after seconds", trailer: metadata.Pairs(RetryAfterKey, "2"), want: 2 * time.Second, wantOK: true},
+		{name: "retry-after fraction", trailer: metadata.Pairs(RetryAfterKey, "0.5"), want: 500 * time.Millisecond, wantOK: true},
+		{name: "retry-after malformed", trailer: metadata.Pairs(RetryAfterKey, "soon")},
+		{name: "retry-after over the error", trailer: metadata.Pairs(RetryAfterKey, "2"), err: exhausted(t, &errdetails.RetryInfo{RetryDelay: durationpb.New(time.Minute)}), want: 2 * time.Second, wantOK: true},
+		{name: "ratelimit-info-bin", trailer: rateLimitTrailer(t, &common.RateLimitInfo{RetryAfterSeconds: 3}), want: 3 * time.Second, wantOK: true},
+		{name: "ratelimit-info-bin with requests left", trailer: rateLimitTrailer(t, &common.RateLimitInfo{RequestsRemaining: 5, RequestsLimit: 10})},
+		{name: "ratelimit-info-bin malformed", trailer: metadata.Pairs(RateLimitInfoKey, "\xff")},
+		{name: "RetryInfo", err: exhausted(t, &errdetails.RetryInfo{RetryDelay: durationpb.New(1500 * time.Millisecond)}), want: 1500 * time.Millisecond, wantOK: true},
+		{name: "RateLimitInfo detail", err: exhausted(t, &common.RateLimitInfo{RetryAfterSeconds: 4}), want: 4 * time.Second, wantOK: true},
+		{name: "error without details", err: exhausted(t)},
+		{name: "RateLimitInfo in the reply", reply: &aipoi.ServiceEndpoint{RateLimit: &common.RateLimitInfo{RetryAfterSeconds: 5}}, want: 5 * time.Second, wantOK: true},
+		{name: "reply without RateLimitInfo", reply: &aipoi.ServiceEndpoint{}},
+		{name: "reply ignored on error", err: exhausted(t), reply: &aipoi.ServiceEndpoint{RateLimit: &common.RateLimitInfo{RetryAfterSeconds: 5}}},
+		{name: "nothing"},
+	}
+	for _, tt := range tests {
+		got, ok := retryAfter(tt.trailer, tt.err, tt.reply)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("%s: retryAfter() = %v, %t, want %v, %t", tt.name, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestRetryAfterResetTime(t *testing.T) {
+	info := &common.RateLimitInfo{RequestsLimit: 10, ResetTime: timestamppb.New(time.Now().Add(time.Minute))}
+
+	got, ok := retryAfter(rateLimitTrailer(t, info), nil, nil)
+	if !ok || got <= 55*time.Second || got > time.Minute {
+		t.Errorf("retryAfter() of a spent limit resetting in a minute = %v, %t", got, ok)
+	}
+}
+
+func TestRateLimiterTokenBucket(t *testing.T) {
+	l := NewRateLimiter(RateLimit{Rate: 1, Burst: 2})
+
+	for range 2 {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The next token is a second away, past the deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Wait() past the burst = %v, want %v", err, codes.ResourceExhausted)
+	}
+}
+
+func TestRateLimiterBackoff(t *testing.T) {
+	l := NewRateLimiter(RateLimit{})
+	l.Backoff(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Wait() with a deadline before the backoff = %v, want %v", err, codes.ResourceExhausted)
+	}
+
+	start := time.Now()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Wait() returned after %v, before the backoff was over", elapsed)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	l.Backoff(time.Hour)
+	l.Backoff(time.Millisecond)
+	if err := l.Wait(ctx); status.Code(err) != codes.Canceled {
+		t.Errorf("Wait() with a canceled context = %v, want %v", err, codes.Canceled)
+	}
+}
+
+func TestRateLimiterUnaryClientInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		limit      RateLimit
+		errs       []error
+		trailer    metadata.MD
+		wantCode   codes.Code
+		wantCalls  int
+		wantBlocks bool
+	}{
+		{name: "ok", errs: []error{nil}, wantCalls: 1},
+		{name: "retried after RetryInfo", limit: RateLimit{MaxRetries: 1}, errs: []error{exhausted(t, &errdetails.RetryInfo{RetryDelay: durationpb.New(20 * time.Millisecond)}), nil}, wantCalls: 2},
+		{name: "out of retries", limit: RateLimit{MaxRetries: 1}, errs: []error{exhausted(t), exhausted(t)}, wantCode: codes.ResourceExhausted, wantCalls: 2, wantBlocks: true},
+		{name: "not retried", errs: []error{exhausted(t, &errdetails.RetryInfo{RetryDelay: durationpb.New(time.Hour)})}, wantCode: codes.ResourceExhausted, wantCalls: 1, wantBlocks: true},
+		{name: "retry-after on success", errs: []error{nil}, trailer: metadata.Pairs(RetryAfterKey, "3600"), wantCalls: 1, wantBlocks: true},
+		{name: "other error", limit: RateLimit{MaxRetries: 1}, errs: []error{status.Error(codes.Internal, "boom")}, wantCode: codes.Internal, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit := tt.limit
+			limit.Backoff = 200 * time.Millisecond
+			l := NewRateLimiter(limit)
+
+			calls := 0
+			invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+				calls++
+				for _, opt := range opts {
+					if tr, ok := opt.(grpc.TrailerCallOption); ok && tt.trailer != nil {
+						*tr.TrailerAddr = tt.trailer
+					}
+				}
+				return tt.errs[calls-1]
+			}
+
+			err := l.UnaryClientInterceptor()(context.Background(), breakerMethod, nil, nil, nil, invoker)
+			if status.Code(err) != tt.wantCode || calls != tt.wantCalls {
+				t.Errorf("call = %v after %d attempts, want %v after %d", err, calls, tt.wantCode, tt.wantCalls)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+			defer cancel()
+			blocked := status.Code(l.Wait(ctx)) == codes.ResourceExhausted
+			if blocked != tt.wantBlocks {
+				t.Errorf("next call held back = %t, want %t", blocked, tt.wantBlocks)
+			}
+		})
+	}
+}
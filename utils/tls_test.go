@@ -0,0 +1,317 @@
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// testCA is a self-signed CA that issues certificates for the tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a certificate for hosts, as PEM, along with its key.
+func (ca *testCA) issue(t *testing.T, client bool, hosts ...string) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if client {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, h)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func (ca *testCA) keyPair(t *testing.T, client bool, hosts ...string) tls.Certificate {
+	t.Helper()
+
+	certPEM, keyPEM := ca.issue(t, client, hosts...)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert
+}
+
+// testServer is a local TLS listener whose configuration can be swapped
+// between handshakes.
+type testServer struct {
+	addr    string
+	configs chan *tls.Config
+	results chan error
+}
+
+func startTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+
+	s := &testServer{addr: lis.Addr().String(), configs: make(chan *tls.Config, 1), results: make(chan error, 1)}
+	go func() {
+		for {
+			raw, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			// gRPC requires the server to select HTTP/2 through ALPN.
+			cfg := (<-s.configs).Clone()
+			cfg.NextProtos = []string{"h2"}
+			conn := tls.Server(raw, cfg)
+			err = conn.Handshake()
+			if err == nil {
+				// Client certificates are only checked once the client has
+				// finished its side of a TLS 1.3 handshake, so wait for a byte.
+				_, err = conn.Write([]byte{1})
+			}
+			s.results <- err
+			conn.Close()
+		}
+	}()
+
+	return s
+}
+
+// connect hands server to s and connects to it with creds, as a Broker
+// dialing authority would. It returns the error of either side.
+func (s *testServer) connect(t *testing.T, creds credentials.TransportCredentials, server *tls.Config, authority string) error {
+	t.Helper()
+
+	s.configs <- server
+	raw, err := net.Dial("tcp", s.addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer raw.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, _, err := creds.ClientHandshake(ctx, authority, raw)
+	if err == nil {
+		_, err = conn.Read(make([]byte, 1))
+	}
+	if serverErr := <-s.results; err == nil {
+		err = serverErr
+	}
+
+	return err
+}
+
+func writeFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestTLSConfigCredentials(t *testing.T) {
+	ca := newTestCA(t, "ca")
+	other := newTestCA(t, "other")
+	srv := startTestServer(t)
+	_, port, _ := net.SplitHostPort(srv.addr)
+
+	dir := t.TempDir()
+	caFile := writeFile(t, dir, "ca.pem", ca.pem)
+	otherCAFile := writeFile(t, dir, "other.pem", other.pem)
+	clientCert, clientKey := ca.issue(t, true, "client")
+	certFile := writeFile(t, dir, "client.pem", clientCert)
+	keyFile := writeFile(t, dir, "client.key", clientKey)
+	strangerCert, strangerKey := other.issue(t, true, "client")
+	strangerCertFile := writeFile(t, dir, "stranger.pem", strangerCert)
+	strangerKeyFile := writeFile(t, dir, "stranger.key", strangerKey)
+
+	serverTLS := &tls.Config{Certificates: []tls.Certificate{ca.keyPair(t, false, "localhost", "127.0.0.1")}}
+	otherHostTLS := &tls.Config{Certificates: []tls.Certificate{ca.keyPair(t, false, "example.test")}}
+	mutualTLS := &tls.Config{
+		Certificates: serverTLS.Certificates,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    x509.NewCertPool(),
+	}
+	mutualTLS.ClientCAs.AddCert(ca.cert)
+
+	tests := []struct {
+		name      string
+		cfg       TLSConfig
+		server    *tls.Config
+		authority string
+		wantErr   bool
+	}{
+		{name: "server CA", cfg: TLSConfig{CAFile: caFile}, server: serverTLS, authority: "localhost:" + port},
+		{name: "server CA by IP", cfg: TLSConfig{CAFile: caFile}, server: serverTLS, authority: srv.addr},
+		{name: "server name override", cfg: TLSConfig{CAFile: caFile, ServerName: "example.test"}, server: otherHostTLS, authority: srv.addr},
+		{name: "wrong host", cfg: TLSConfig{CAFile: caFile}, server: otherHostTLS, authority: "localhost:" + port, wantErr: true},
+		{name: "wrong host by IP", cfg: TLSConfig{CAFile: caFile}, server: otherHostTLS, authority: srv.addr, wantErr: true},
+		{name: "wrong CA", cfg: TLSConfig{CAFile: otherCAFile}, server: serverTLS, authority: "localhost:" + port, wantErr: true},
+		{name: "mutual TLS", cfg: TLSConfig{CAFile: caFile, CertFile: certFile, KeyFile: keyFile}, server: mutualTLS, authority: "localhost:" + port},
+		{name: "mutual TLS without certificate", cfg: TLSConfig{CAFile: caFile}, server: mutualTLS, authority: "localhost:" + port, wantErr: true},
+		{name: "mutual TLS with unknown certificate", cfg: TLSConfig{CAFile: caFile, CertFile: strangerCertFile, KeyFile: strangerKeyFile}, server: mutualTLS, authority: "localhost:" + port, wantErr: true},
+	}
+	for _, tt := range tests {
+		for _, reload := range []bool{false, true} {
+			cfg := tt.cfg
+			cfg.Reload = reload
+			name := tt.name
+			if reload {
+				name += " with reload"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				creds, err := cfg.Credentials()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = srv.connect(t, creds, tt.server, tt.authority)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("connect() error = %v, want error %t", err, tt.wantErr)
+				}
+			})
+		}
+	}
+}
+
+func TestTLSConfigCredentialsReload(t *testing.T) {
+	srv := startTestServer(t)
+	_, port, _ := net.SplitHostPort(srv.addr)
+	authority := "localhost:" + port
+	dir := t.TempDir()
+
+	// rotate writes the certificates of a new CA, as an agent renewing
+	// short-lived certificates would, and returns the configuration of a
+	// server that trusts and is trusted through it.
+	rotate := func(name string, modTime time.Time) *tls.Config {
+		ca := newTestCA(t, name)
+		clientCert, clientKey := ca.issue(t, true, "client")
+		for file, data := range map[string][]byte{"ca.pem": ca.pem, "client.pem": clientCert, "client.key": clientKey} {
+			path := writeFile(t, dir, file, data)
+			if err := os.Chtimes(path, modTime, modTime); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		server := &tls.Config{
+			Certificates: []tls.Certificate{ca.keyPair(t, false, "localhost")},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    x509.NewCertPool(),
+		}
+		server.ClientCAs.AddCert(ca.cert)
+
+		return server
+	}
+
+	now := time.Now()
+	first := rotate("first", now.Add(-time.Minute))
+	cfg := TLSConfig{
+		CAFile:   filepath.Join(dir, "ca.pem"),
+		CertFile: filepath.Join(dir, "client.pem"),
+		KeyFile:  filepath.Join(dir, "client.key"),
+	}
+	reloading := cfg
+	reloading.Reload = true
+
+	static, err := cfg.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := reloading.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := srv.connect(t, reloaded, first, authority); err != nil {
+		t.Fatalf("before rotation: %v", err)
+	}
+
+	second := rotate("second", now)
+	if err := srv.connect(t, reloaded, second, authority); err != nil {
+		t.Errorf("after rotation, reloading credentials failed: %v", err)
+	}
+	if err := srv.connect(t, static, second, authority); err == nil {
+		t.Error("after rotation, static credentials still connected")
+	}
+}
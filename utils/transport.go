@@ -6,6 +6,11 @@ import (
 	"go.uber.org/zap"
 )
 
+// Transport is the process-wide fallback for Brokers created without
+// transport options.
+//
+// Deprecated: Pass WithLogger, WithTracerProvider and WithRegistry to each
+// Broker instead.
 var Transport *TransportUtils
 
 type TransportUtils struct {